@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"streamify/admindata"
+	"streamify/catalog"
+	"streamify/ent"
+	"streamify/ent/schema"
+	"streamify/operations"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// getIntegrityReport scans the database for orphaned rows and reports them
+func getIntegrityReport(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reports, err := catalog.ScanOrphans(c.Request.Context(), client)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		total := 0
+		for _, r := range reports {
+			total += r.Count
+		}
+		c.JSON(http.StatusOK, gin.H{"total": total, "checks": reports})
+	}
+}
+
+// fixIntegrityRequest is the request body for fixIntegrity
+type fixIntegrityRequest struct {
+	Mode      string `json:"mode" binding:"required,oneof=repair purge"`
+	BatchSize *int   `json:"batch_size" binding:"omitempty,min=1,max=5000"`
+}
+
+// fixIntegrity starts an operation repairing or purging orphaned rows in batches
+func fixIntegrity(client *ent.Client, ops *operations.Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body fixIntegrityRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		batchSize := 500
+		if body.BatchSize != nil {
+			batchSize = *body.BatchSize
+		}
+
+		mode := catalog.FixMode(body.Mode)
+		op, err := ops.Start(c.Request.Context(), "integrity."+body.Mode, func(ctx context.Context, p *operations.Progress) (any, error) {
+			results, err := catalog.FixOrphans(ctx, client, mode, batchSize, func(results []catalog.FixResult) {
+				p.Set(fixedRows(results), 0, gin.H{"results": results})
+			})
+			return gin.H{"results": results}, err
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		operations.Accepted(c, op)
+	}
+}
+
+// fixedRows totals the rows fixed across checks
+func fixedRows(results []catalog.FixResult) int {
+	n := 0
+	for _, r := range results {
+		n += r.Fixed
+	}
+	return n
+}
+
+// bulkDeleteAlbumsRequest is the request body for bulkDeleteAlbums. Albums
+// are selected by IDs or, when none are listed, by Filter.
+type bulkDeleteAlbumsRequest struct {
+	IDs       []uuid.UUID          `json:"ids" binding:"max=10000"`
+	Filter    *catalog.AlbumFilter `json:"filter"`
+	DryRun    bool                 `json:"dry_run"`
+	Confirm   string               `json:"confirmation_token"`
+	BatchSize *int                 `json:"batch_size" binding:"omitempty,min=1,max=1000"`
+}
+
+// bulkDeleteAlbums archives (soft-deletes) or, when hard, permanently deletes
+// many albums with their tracks. A dry run reports the selection and a
+// confirmation token; the real run must send that token back and is refused
+// with 409 if the selection has changed since. Albums are then deleted in
+// batches by an operation.
+func bulkDeleteAlbums(client *ent.Client, ops *operations.Manager, hard bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body bulkDeleteAlbumsRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if len(body.IDs) > 0 && body.Filter != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "give either ids or filter, not both"})
+			return
+		}
+		if !body.DryRun && body.Confirm == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "confirmation_token is required; run with dry_run first to get one"})
+			return
+		}
+
+		batchSize := 100
+		if body.BatchSize != nil {
+			batchSize = *body.BatchSize
+		}
+
+		impact, err := catalog.SelectAlbums(c.Request.Context(), client, body.IDs, body.Filter, hard)
+		if err != nil {
+			if errors.Is(err, catalog.ErrEmptyFilter) || errors.Is(err, catalog.ErrTooManyAlbums) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if body.DryRun {
+			c.JSON(http.StatusOK, gin.H{"dry_run": true, "impact": impact})
+			return
+		}
+		if body.Confirm != impact.Token {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":  "confirmation_token does not match the current selection; review the new dry run",
+				"impact": impact,
+			})
+			return
+		}
+
+		kind := "albums.bulk_archive"
+		if hard {
+			kind = "albums.bulk_delete"
+		}
+		total := len(impact.Albums)
+		op, err := ops.Start(c.Request.Context(), kind, func(ctx context.Context, p *operations.Progress) (any, error) {
+			p.Set(0, total, nil)
+			result, err := catalog.DeleteAlbums(ctx, client, impact.Albums, hard, batchSize, func(r catalog.BulkResult) {
+				p.Set(r.Albums, total, gin.H{"result": r, "missing": impact.Missing})
+			})
+			return gin.H{"result": result, "missing": impact.Missing}, err
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		operations.Accepted(c, op)
+	}
+}
+
+// dataEntities are the entities admins browse and edit through the data API
+// under /api/v1/admin/data. Entities with dedicated admin endpoints for
+// changes that need more than a row write, such as deleting an artist with
+// its albums or rotating a secret, only allow what those endpoints don't
+// cover. Plays, likes and playlists are left out since they live in the
+// owner's regional database, as are secrets and short-lived tokens.
+var dataEntities = []admindata.Entity{
+	{Name: "artists", Schema: schema.Artist{}, Allow: admindata.Read | admindata.Create | admindata.Update},
+	{Name: "albums", Schema: schema.Album{}, Allow: admindata.Read | admindata.Create | admindata.Update},
+	{Name: "tracks", Schema: schema.Track{}, Allow: admindata.Read | admindata.Create | admindata.Update, ReadOnly: []string{"audio_key"}},
+	{Name: "track-credits", Schema: schema.TrackCredit{}, Allow: admindata.All},
+	{Name: "users", Schema: schema.User{}, Allow: admindata.Read | admindata.Update, ReadOnly: []string{"email", "region", "login_countries", "sessions_valid_after"}},
+	{Name: "entitlements", Schema: schema.Entitlement{}, Allow: admindata.All},
+	{Name: "invites", Schema: schema.Invite{}, Allow: admindata.Read | admindata.Update | admindata.Delete, ReadOnly: []string{"uses"}},
+	{Name: "waitlist-entries", Schema: schema.WaitlistEntry{}, Allow: admindata.Read | admindata.Delete},
+	{Name: "share-links", Schema: schema.ShareLink{}, Allow: admindata.Read | admindata.Delete},
+	{Name: "follows", Schema: schema.Follow{}, Allow: admindata.Read},
+	{Name: "blocks", Schema: schema.Block{}, Allow: admindata.Read},
+	{Name: "api-keys", Schema: schema.APIKey{}, Allow: admindata.Read | admindata.Update, ReadOnly: []string{"last_used_at"}},
+	{Name: "api-key-usage", Schema: schema.APIKeyUsage{}, Allow: admindata.Read},
+	{Name: "security-alerts", Schema: schema.SecurityAlert{}, Allow: admindata.Read},
+	{Name: "audit-logs", Schema: schema.AuditLog{}, Allow: admindata.Read},
+	{Name: "policy-versions", Schema: schema.PolicyVersion{}, Allow: admindata.Read},
+	{Name: "policy-acceptances", Schema: schema.PolicyAcceptance{}, Allow: admindata.Read},
+	{Name: "sso-providers", Schema: schema.SSOProvider{}, Allow: admindata.Read},
+	{Name: "external-identities", Schema: schema.ExternalIdentity{}, Allow: admindata.Read},
+	{Name: "scim-groups", Schema: schema.SCIMGroup{}, Allow: admindata.Read},
+	{Name: "audio-fingerprints", Schema: schema.AudioFingerprint{}, Allow: admindata.Read},
+	{Name: "duplicate-reviews", Schema: schema.DuplicateReview{}, Allow: admindata.Read},
+	{Name: "upload-sessions", Schema: schema.UploadSession{}, Allow: admindata.Read},
+	{Name: "backups", Schema: schema.Backup{}, Allow: admindata.Read},
+	{Name: "dead-letters", Schema: schema.DeadLetter{}, Allow: admindata.Read},
+	{Name: "operations", Schema: schema.Operation{}, Allow: admindata.Read},
+	{Name: "tombstones", Schema: schema.Tombstone{}, Allow: admindata.Read},
+}
@@ -0,0 +1,454 @@
+// Package admindata serves list, get, create, update and delete for Ent
+// entities under /api/v1/admin/data/:entity, without a handler per entity.
+// Entities are registered with what admins may do with them; the generated
+// client of each is found and called by reflection, so Ent's hooks, privacy
+// policies and validators apply as they do to hand-written handlers.
+package admindata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"streamify/ent"
+	entprivacy "streamify/ent/privacy"
+	"streamify/filtering"
+	"streamify/ids"
+
+	entgo "entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Action is a set of things admins may do with an entity
+type Action uint8
+
+const (
+	List Action = 1 << iota
+	Get
+	Create
+	Update
+	Delete
+
+	// Read lists and gets
+	Read = List | Get
+	// All is every action
+	All = Read | Create | Update | Delete
+)
+
+// actionNames names the actions in the order they're listed
+var actionNames = []struct {
+	action Action
+	name   string
+}{{List, "list"}, {Get, "get"}, {Create, "create"}, {Update, "update"}, {Delete, "delete"}}
+
+// actionVerbs describe each action in error messages
+var actionVerbs = map[Action]string{List: "listed", Get: "read", Create: "created", Update: "updated", Delete: "deleted"}
+
+// Names lists the actions in a
+func (a Action) Names() []string {
+	names := []string{}
+	for _, n := range actionNames {
+		if a&n.action != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return names
+}
+
+// Entity registers one Ent entity
+type Entity struct {
+	// Name is the entity's path segment, e.g. "artists"
+	Name string
+	// Schema is the entity's Ent schema, e.g. schema.Artist{}
+	Schema entgo.Interface
+	// Allow is what admins may do with the entity
+	Allow Action
+	// ReadOnly fields are shown but can't be written; the ID never can
+	ReadOnly []string
+}
+
+// column is a field of an entity that's exposed: neither sensitive nor
+// left out of its JSON
+type column struct {
+	desc *field.Descriptor
+	// typ is the Go type of the field's value, without the pointer of
+	// nillable fields
+	typ reflect.Type
+}
+
+// resource is a registered entity bound to its generated client
+type resource struct {
+	Entity
+	client  reflect.Value
+	columns map[string]column
+	names   []string
+	// filters are the columns lists can be filtered and sorted by
+	filters filtering.Fields
+}
+
+// API serves the registered entities
+type API struct {
+	resources map[string]*resource
+	order     []string
+}
+
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+// New registers entities served from client. It panics when an entity has
+// no generated client or isn't keyed by UUID, which is a programming error.
+func New(client *ent.Client, entities ...Entity) *API {
+	api := &API{resources: make(map[string]*resource, len(entities))}
+	for _, e := range entities {
+		typeName := reflect.TypeOf(e.Schema).Name()
+		tc := reflect.ValueOf(client).Elem().FieldByName(typeName)
+		if !tc.IsValid() {
+			panic(fmt.Sprintf("admindata: no client for %s", typeName))
+		}
+		get := tc.MethodByName("Get")
+		if get.Type().In(1) != uuidType {
+			panic(fmt.Sprintf("admindata: %s isn't keyed by UUID", typeName))
+		}
+		// Field types are read from the entity struct, which names each field
+		// in its JSON tag
+		entityType := get.Type().Out(0).Elem()
+		goTypes := map[string]reflect.Type{}
+		for i := 0; i < entityType.NumField(); i++ {
+			f := entityType.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "" || name == "-" || name == "edges" {
+				continue
+			}
+			t := f.Type
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			goTypes[name] = t
+		}
+
+		r := &resource{Entity: e, client: tc, columns: map[string]column{}, filters: filtering.Fields{}}
+		for _, f := range e.Schema.Fields() {
+			d := f.Descriptor()
+			t, ok := goTypes[d.Name]
+			if d.Sensitive || !ok {
+				continue
+			}
+			r.columns[d.Name] = column{desc: d, typ: t}
+			r.names = append(r.names, d.Name)
+			if f, ok := filter(d); ok {
+				r.filters[d.Name] = f
+			}
+		}
+		api.resources[e.Name] = r
+		api.order = append(api.order, e.Name)
+	}
+	return api
+}
+
+// filter returns the filtering field of a column, if its type has filters
+func filter(d *field.Descriptor) (filtering.Field, bool) {
+	f := filtering.Field{Column: d.Name}
+	switch t := d.Info.Type; {
+	case t == field.TypeString:
+		f.Kind = filtering.Text
+	case t == field.TypeEnum:
+		f.Kind = filtering.Enum
+		for _, e := range d.Enums {
+			f.Values = append(f.Values, e.V)
+		}
+	case t == field.TypeTime:
+		f.Kind = filtering.Time
+	case t.Integer():
+		f.Kind = filtering.Int
+	case t == field.TypeBool:
+		f.Kind = filtering.Bool
+	case t == field.TypeUUID:
+		f.Kind = filtering.ID
+	default:
+		return f, false
+	}
+	return f, true
+}
+
+// EntityInfo describes a registered entity for Entities
+type EntityInfo struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Actions  []string `json:"actions"`
+	Fields   []string `json:"fields"`
+	ReadOnly []string `json:"read_only"`
+}
+
+// Entities lists the registered entities, what admins may do with them and
+// their fields; /api/schema documents the fields (admin)
+func (api *API) Entities(c *gin.Context) {
+	infos := make([]EntityInfo, 0, len(api.order))
+	for _, name := range api.order {
+		r := api.resources[name]
+		readOnly := []string{"id"}
+		for _, f := range r.ReadOnly {
+			if f != "id" {
+				readOnly = append(readOnly, f)
+			}
+		}
+		infos = append(infos, EntityInfo{
+			Name:     r.Name,
+			Type:     reflect.TypeOf(r.Schema).Name(),
+			Actions:  r.Allow.Names(),
+			Fields:   r.names,
+			ReadOnly: readOnly,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"entities": infos})
+}
+
+// resource returns the entity named in the path if action is allowed on it,
+// or writes the error response
+func (api *API) resource(c *gin.Context, action Action) (*resource, bool) {
+	r, ok := api.resources[c.Param("entity")]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": fmt.Sprintf("unknown entity %q", c.Param("entity"))})
+		return nil, false
+	}
+	if r.Allow&action == 0 {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("%s can't be %s through the data API", r.Name, actionVerbs[action])})
+		return nil, false
+	}
+	return r, true
+}
+
+// id parses the ID in the path, or writes the error response
+func id(c *gin.Context) (uuid.UUID, bool) {
+	id, err := ids.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid ID"})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// call calls a method of v that returns a value and an error
+func call(v reflect.Value, method string, args ...any) (any, error) {
+	in := make([]reflect.Value, len(args))
+	for i, a := range args {
+		in[i] = reflect.ValueOf(a)
+	}
+	out := v.MethodByName(method).Call(in)
+	err, _ := out[len(out)-1].Interface().(error)
+	if len(out) == 1 {
+		return nil, err
+	}
+	return out[0].Interface(), err
+}
+
+// List lists an entity's rows, filtered and sorted as other lists are by
+// the filters of its non-JSON fields and ?sort= and ?order=, newest first by
+// default, and paged by ?limit= (100 unless given, at most 1000) and
+// ?offset= (admin)
+func (api *API) List(c *gin.Context) {
+	r, ok := api.resource(c, List)
+	if !ok {
+		return
+	}
+	query, ok := filtering.Parse(c, r.filters)
+	if !ok {
+		return
+	}
+	q := r.client.MethodByName("Query").Call(nil)[0]
+	where := q.MethodByName("Where")
+	predicate := where.Type().In(0).Elem()
+	for _, p := range filtering.Where[func(*sql.Selector)](query) {
+		where.Call([]reflect.Value{reflect.ValueOf(p).Convert(predicate)})
+	}
+
+	limit := 100
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 1000 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+			return
+		}
+		limit = n
+	}
+	offset := 0
+	if v := c.Query("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "offset must be a non-negative integer"})
+			return
+		}
+		offset = n
+	}
+
+	ctx := c.Request.Context()
+	total, err := call(q.MethodByName("Clone").Call(nil)[0], "Count", ctx)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	defaults := []func(*sql.Selector){sql.OrderByField("id").ToFunc()}
+	if _, ok := r.columns["created_at"]; ok {
+		// Ties keep their order from page to page
+		defaults = []func(*sql.Selector){
+			sql.OrderByField("created_at", sql.OrderDesc()).ToFunc(),
+			sql.OrderByField("id", sql.OrderDesc()).ToFunc(),
+		}
+	}
+	order := q.MethodByName("Order")
+	orderOption := order.Type().In(0).Elem()
+	var terms []reflect.Value
+	for _, o := range filtering.Order(query, defaults...) {
+		terms = append(terms, reflect.ValueOf(o).Convert(orderOption))
+	}
+	order.Call(terms)
+	q.MethodByName("Limit").Call([]reflect.Value{reflect.ValueOf(limit)})
+	q.MethodByName("Offset").Call([]reflect.Value{reflect.ValueOf(offset)})
+	rows, err := call(q, "All", ctx)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"items": rows, "total": total})
+}
+
+// Get returns one row of an entity (admin)
+func (api *API) Get(c *gin.Context) {
+	r, ok := api.resource(c, Get)
+	if !ok {
+		return
+	}
+	id, ok := id(c)
+	if !ok {
+		return
+	}
+	row, err := call(r.client, "Get", c.Request.Context(), id)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+// Create creates a row of an entity from a JSON object of its fields (admin)
+func (api *API) Create(c *gin.Context) {
+	r, ok := api.resource(c, Create)
+	if !ok {
+		return
+	}
+	builder := r.client.MethodByName("Create").Call(nil)[0]
+	if !r.bind(c, builder, false) {
+		return
+	}
+	row, err := call(builder, "Save", c.Request.Context())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, row)
+}
+
+// Update sets the fields of a row given in a JSON object, clearing optional
+// ones given as null (admin)
+func (api *API) Update(c *gin.Context) {
+	r, ok := api.resource(c, Update)
+	if !ok {
+		return
+	}
+	id, ok := id(c)
+	if !ok {
+		return
+	}
+	builder := r.client.MethodByName("UpdateOneID").Call([]reflect.Value{reflect.ValueOf(id)})[0]
+	if !r.bind(c, builder, true) {
+		return
+	}
+	row, err := call(builder, "Save", c.Request.Context())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, row)
+}
+
+// Delete deletes a row of an entity (admin)
+func (api *API) Delete(c *gin.Context) {
+	r, ok := api.resource(c, Delete)
+	if !ok {
+		return
+	}
+	id, ok := id(c)
+	if !ok {
+		return
+	}
+	builder := r.client.MethodByName("DeleteOneID").Call([]reflect.Value{reflect.ValueOf(id)})[0]
+	if _, err := call(builder, "Exec", c.Request.Context()); err != nil {
+		writeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// bind sets the fields in the request body on the mutation of a create or
+// update builder, or writes the error response
+func (r *resource) bind(c *gin.Context, builder reflect.Value, update bool) bool {
+	var body map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	m := builder.MethodByName("Mutation").Call(nil)[0].Interface().(entgo.Mutation)
+	for name, raw := range body {
+		col, ok := r.columns[name]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown field %q", name)})
+			return false
+		}
+		if name == "id" || slices.Contains(r.ReadOnly, name) || (update && col.desc.Immutable) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s can't be written", name)})
+			return false
+		}
+		if string(raw) == "null" {
+			if !update {
+				continue
+			}
+			if err := m.ClearField(name); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s can't be cleared", name)})
+				return false
+			}
+			continue
+		}
+		v := reflect.New(col.typ)
+		if err := json.Unmarshal(raw, v.Interface()); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s: %v", name, err)})
+			return false
+		}
+		if err := m.SetField(name, v.Elem().Interface()); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return false
+		}
+	}
+	return true
+}
+
+// writeError answers with the status matching an Ent error
+func writeError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	case ent.IsValidationError(err):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case ent.IsConstraintError(err):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, entprivacy.Deny):
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,201 @@
+// Package albumtotals keeps each album's track count and total duration, so
+// album listings can show them without reading the tracks. Hook adds the
+// difference each track mutation makes to its albums' totals; Reconcile
+// recounts the totals from the tracks, fixing any that drifted.
+package albumtotals
+
+import (
+	"context"
+	"slices"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/hook"
+	"streamify/ent/schema/rule"
+	"streamify/ent/track"
+	"streamify/logging"
+	"streamify/savepoint"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("albumtotals")
+
+// total is what tracks add to an album's totals
+type total struct {
+	tracks     int
+	durationMS int64
+}
+
+// diff is what to add to albums' totals
+type diff map[uuid.UUID]total
+
+// add adds n live tracks lasting durationMS to albumID's totals, or takes
+// them away when n is negative
+func (t diff) add(albumID uuid.UUID, n int, durationMS *int64) {
+	d := t[albumID]
+	d.tracks += n
+	if durationMS != nil {
+		d.durationMS += int64(n) * *durationMS
+	}
+	t[albumID] = d
+}
+
+// addLive adds n times the live tracks among ids to their albums' totals
+func (t diff) addLive(ctx context.Context, client *ent.Client, ids []uuid.UUID, n int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tracks, err := client.Track.Query().
+		Where(track.IDIn(ids...), track.DeletedAtIsNil()).
+		Select(track.FieldAlbumID, track.FieldDurationMs).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, tr := range tracks {
+		t.add(tr.AlbumID, n, tr.DurationMs)
+	}
+	return nil
+}
+
+// write adds the differences to the albums' totals, in album order so
+// concurrent writers lock albums in the same order
+func (t diff) write(ctx context.Context, client *ent.Client) error {
+	ids := make([]uuid.UUID, 0, len(t))
+	for id, n := range t {
+		if n != (total{}) {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	// Totals follow the tracks whoever changed them
+	ctx = rule.SystemContext(ctx)
+	for _, id := range ids {
+		n := t[id]
+		// Albums deleted along with their tracks are left alone
+		if _, err := client.Album.Update().
+			Where(album.IDEQ(id)).
+			AddTrackCount(n.tracks).
+			AddTotalDurationMs(n.durationMS).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// counted reports whether m may change what its tracks add to their albums
+func counted(m *ent.TrackMutation) bool {
+	if m.Op().Is(ent.OpCreate | ent.OpDelete | ent.OpDeleteOne) {
+		return true
+	}
+	for _, f := range slices.Concat(m.Fields(), m.AddedFields(), m.ClearedFields()) {
+		switch f {
+		case track.FieldAlbumID, track.FieldDeletedAt, track.FieldDurationMs:
+			return true
+		}
+	}
+	return false
+}
+
+// Hook keeps albums' totals as tracks are created, moved, deleted, restored
+// or given a duration. The totals are written through the track's own
+// connection, so inside a transaction they commit with the tracks. A failed
+// write is logged rather than failing the mutation, which is already made,
+// and fixed by the next Reconcile; inside a transaction it is rolled back to
+// a savepoint, so the caller's transaction carries on without it.
+func Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TrackFunc(func(ctx context.Context, m *ent.TrackMutation) (ent.Value, error) {
+			if !counted(m) {
+				return next.Mutate(ctx, m)
+			}
+			client := m.Client()
+			d := diff{}
+			var ids []uuid.UUID
+			if !m.Op().Is(ent.OpCreate) {
+				// What the tracks added before is taken away, then what they
+				// add now is added back
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+				if err := d.addLive(ctx, client, ids, -1); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if err := savepoint.Run(ctx, m, "album_totals", func() error {
+				switch {
+				case m.Op().Is(ent.OpCreate):
+					if t, ok := v.(*ent.Track); ok && t.DeletedAt == nil {
+						d.add(t.AlbumID, 1, t.DurationMs)
+					}
+				case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+					if err := d.addLive(ctx, client, ids, 1); err != nil {
+						return err
+					}
+				}
+				return d.write(ctx, client)
+			}); err != nil {
+				logger.Warn("updating album totals failed", "op", m.Op().String(), "error", err)
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// recount sets every album's totals from its live tracks where they differ,
+// first giving tracks uploaded before durations were kept the length their
+// audio was fingerprinted with
+var recount = []string{
+	`UPDATE tracks t SET duration_ms = f.duration * 1000
+FROM audio_fingerprints f
+WHERE f.track_id = t.id AND t.duration_ms IS NULL AND f.duration > 0`,
+	`UPDATE albums a SET track_count = s.tracks, total_duration_ms = s.duration_ms
+FROM (
+	SELECT al.id, count(t.id) AS tracks, coalesce(sum(t.duration_ms), 0) AS duration_ms
+	FROM albums al
+	LEFT JOIN tracks t ON t.album_id = al.id AND t.deleted_at IS NULL
+	GROUP BY al.id
+) s
+WHERE a.id = s.id AND (a.track_count <> s.tracks OR a.total_duration_ms <> s.duration_ms)`,
+}
+
+// Reconcile recounts albums' totals from their tracks, fixing totals that
+// drifted, such as after a failed write or tracks changed with raw SQL
+func Reconcile(client *ent.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) (err error) {
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+			}
+		}()
+		var fixed int64
+		for _, stmt := range recount {
+			res, err := tx.ExecContext(ctx, stmt)
+			if err != nil {
+				return err
+			}
+			if fixed, err = res.RowsAffected(); err != nil {
+				return err
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		if fixed > 0 {
+			logger.Info("album totals reconciled", "albums", fixed)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,146 @@
+// Package analytics mirrors play events to ClickHouse, where charts and
+// listening stats can be computed without scanning play history in the
+// primary database. It speaks ClickHouse's HTTP interface, so no driver is
+// needed.
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"streamify/logging"
+	"streamify/resilience"
+)
+
+var logger = logging.For("analytics")
+
+// schema creates the table plays are mirrored into. Rows sharing a play ID
+// and time, left by a retried insert, are collapsed by background merges.
+// Plays by users who opted out carry pseudonymous play IDs, which are as
+// unique and as stable across retries.
+const schema = `CREATE TABLE IF NOT EXISTS plays (
+	play_id UUID,
+	user_id UUID,
+	track_id UUID,
+	album_id UUID,
+	artist_id UUID,
+	territory LowCardinality(String),
+	played_at DateTime64(3, 'UTC')
+) ENGINE = ReplacingMergeTree
+PARTITION BY toYYYYMM(played_at)
+ORDER BY (toDate(played_at), track_id, play_id)`
+
+// ClickHouse is a client for a ClickHouse server's HTTP interface
+type ClickHouse struct {
+	endpoint string
+	database string
+	user     string
+	password string
+	http     *http.Client
+	dep      *resilience.Dependency
+}
+
+// NewClickHouse returns a client for the server at rawURL, written as
+// http(s)://user:password@host:8123/database. Calls go through dep.
+func NewClickHouse(rawURL string, dep *resilience.Dependency) (*ClickHouse, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme %q, want http or https", u.Scheme)
+	}
+	c := &ClickHouse{
+		endpoint: (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/"}).String(),
+		database: strings.Trim(u.Path, "/"),
+		http:     &http.Client{},
+		dep:      dep,
+	}
+	if c.database == "" {
+		c.database = "default"
+	}
+	if u.User != nil {
+		c.user = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+	return c, nil
+}
+
+// EnsureSchema creates the plays table if it doesn't exist
+func (c *ClickHouse) EnsureSchema(ctx context.Context) error {
+	_, err := c.do(ctx, schema, nil, nil)
+	return err
+}
+
+// Query runs a SELECT and decodes its rows into dst, a pointer to a slice of
+// structs whose JSON tags name the selected columns. Each params entry binds
+// a {name:Type} placeholder in query.
+func (c *ClickHouse) Query(ctx context.Context, query string, params map[string]string, dst any) error {
+	settings := url.Values{}
+	for name, v := range params {
+		settings.Set("param_"+name, v)
+	}
+	// Counts come back as JSON numbers rather than strings
+	settings.Set("output_format_json_quote_64bit_integers", "0")
+	body, err := c.do(ctx, query+"\nFORMAT JSON", settings, nil)
+	if err != nil {
+		return err
+	}
+	var res struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return fmt.Errorf("decoding clickhouse response: %w", err)
+	}
+	return json.Unmarshal(res.Data, dst)
+}
+
+// insert writes rows, encoded one JSON object per line, into table
+func (c *ClickHouse) insert(ctx context.Context, table string, rows []byte) error {
+	settings := url.Values{}
+	settings.Set("date_time_input_format", "best_effort")
+	_, err := c.do(ctx, "INSERT INTO "+table+" FORMAT JSONEachRow", settings, rows)
+	return err
+}
+
+// do runs one statement through the breaker, sending data as its input, and
+// returns the response body
+func (c *ClickHouse) do(ctx context.Context, query string, settings url.Values, data []byte) ([]byte, error) {
+	q := url.Values{}
+	for k, v := range settings {
+		q[k] = v
+	}
+	q.Set("database", c.database)
+	q.Set("query", query)
+	target := c.endpoint + "?" + q.Encode()
+
+	var out []byte
+	err := c.dep.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if c.user != "" {
+			req.Header.Set("X-ClickHouse-User", c.user)
+			req.Header.Set("X-ClickHouse-Key", c.password)
+		}
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+			return fmt.Errorf("clickhouse: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+		}
+		out, err = io.ReadAll(resp.Body)
+		return err
+	})
+	return out, err
+}
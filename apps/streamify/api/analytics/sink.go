@@ -0,0 +1,185 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/track"
+	"streamify/events"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// FlushInterval is how often mirrored plays are sent to ClickHouse
+	FlushInterval = 5 * time.Second
+	// maxBuffered bounds the plays held while ClickHouse is unreachable; the
+	// oldest are dropped beyond it
+	maxBuffered = 100000
+)
+
+// row is one play as stored in ClickHouse
+type row struct {
+	PlayID    uuid.UUID `json:"play_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	TrackID   uuid.UUID `json:"track_id"`
+	AlbumID   uuid.UUID `json:"album_id"`
+	ArtistID  uuid.UUID `json:"artist_id"`
+	Territory string    `json:"territory"`
+	PlayedAt  time.Time `json:"played_at"`
+}
+
+// Sink buffers play events and writes them to ClickHouse in batches
+type Sink struct {
+	ch     *ClickHouse
+	client *ent.Client
+
+	mu      sync.Mutex
+	pending []events.PlayRecorded
+	lastErr error
+
+	written atomic.Int64
+	dropped atomic.Int64
+}
+
+// NewSink returns a Sink writing to ch. Plays are attributed to their
+// track's album and artist, looked up through client when flushed.
+func NewSink(ch *ClickHouse, client *ent.Client) *Sink {
+	return &Sink{ch: ch, client: client}
+}
+
+// add queues plays, dropping the oldest beyond maxBuffered
+func (s *Sink) add(plays ...events.PlayRecorded) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, plays...)
+	if over := len(s.pending) - maxBuffered; over > 0 {
+		s.pending = s.pending[over:]
+		s.dropped.Add(int64(over))
+	}
+}
+
+// Flush writes the queued plays. On failure they are queued again, ahead of
+// plays recorded meanwhile, for the next flush.
+func (s *Sink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+
+	err := s.write(ctx, batch)
+	s.mu.Lock()
+	s.lastErr = err
+	if err != nil {
+		s.pending = append(batch, s.pending...)
+		if over := len(s.pending) - maxBuffered; over > 0 {
+			s.pending = s.pending[over:]
+			s.dropped.Add(int64(over))
+		}
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	s.written.Add(int64(len(batch)))
+	logger.Debug("plays mirrored to clickhouse", "plays", len(batch))
+	return nil
+}
+
+func (s *Sink) write(ctx context.Context, batch []events.PlayRecorded) error {
+	seen := map[uuid.UUID]bool{}
+	var trackIDs []uuid.UUID
+	for _, p := range batch {
+		if !seen[p.TrackID] {
+			seen[p.TrackID] = true
+			trackIDs = append(trackIDs, p.TrackID)
+		}
+	}
+	// Deleted tracks are included: their plays happened, and queries filter
+	// deleted entities when they load them
+	tracks, err := s.client.Track.Query().
+		Where(track.IDIn(trackIDs...)).
+		WithAlbum().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading tracks: %w", err)
+	}
+	byID := make(map[uuid.UUID]*ent.Track, len(tracks))
+	for _, t := range tracks {
+		byID[t.ID] = t
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, p := range batch {
+		r := row{PlayID: p.PlayID, UserID: p.UserID, TrackID: p.TrackID, Territory: p.Territory, PlayedAt: p.PlayedAt.UTC()}
+		if t := byID[p.TrackID]; t != nil {
+			r.AlbumID = t.AlbumID
+			if t.Edges.Album != nil {
+				r.ArtistID = t.Edges.Album.ArtistID
+			}
+		}
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return s.ch.insert(ctx, "plays", buf.Bytes())
+}
+
+// Health implements resilience.Component
+func (s *Sink) Health() (bool, any) {
+	s.mu.Lock()
+	pending, lastErr := len(s.pending), s.lastErr
+	s.mu.Unlock()
+	status := gin.H{"pending": pending, "written": s.written.Load(), "dropped": s.dropped.Load()}
+	if lastErr != nil {
+		status["error"] = lastErr.Error()
+	}
+	return lastErr == nil, status
+}
+
+// WriteMetrics implements resilience.Component
+func (s *Sink) WriteMetrics(w io.Writer) {
+	s.mu.Lock()
+	pending := len(s.pending)
+	s.mu.Unlock()
+	fmt.Fprintf(w, "# HELP analytics_plays_pending Plays waiting to be mirrored to the analytics store.\n# TYPE analytics_plays_pending gauge\nanalytics_plays_pending %d\n", pending)
+	fmt.Fprintf(w, "# HELP analytics_plays_written_total Plays mirrored to the analytics store.\n# TYPE analytics_plays_written_total counter\nanalytics_plays_written_total %d\n", s.written.Load())
+	fmt.Fprintf(w, "# HELP analytics_plays_dropped_total Plays dropped because the analytics store was unreachable for too long.\n# TYPE analytics_plays_dropped_total counter\nanalytics_plays_dropped_total %d\n", s.dropped.Load())
+}
+
+type publisher struct {
+	next events.Publisher
+	sink *Sink
+}
+
+// Publisher returns an events.Publisher that publishes through next and also
+// queues play events for s. Only next's errors are returned; mirroring is
+// best effort, and a republished play is collapsed by the table's merges.
+func Publisher(next events.Publisher, s *Sink) events.Publisher {
+	return &publisher{next: next, sink: s}
+}
+
+func (p *publisher) Publish(ctx context.Context, e events.Envelope) error {
+	err := p.next.Publish(ctx, e)
+	if e.Type == "play.recorded" {
+		var play events.PlayRecorded
+		if jerr := json.Unmarshal(e.Data, &play); jerr != nil {
+			logger.Warn("skipping malformed play event", "id", e.ID, "error", jerr)
+		} else {
+			p.sink.add(play)
+		}
+	}
+	return err
+}
@@ -0,0 +1,202 @@
+// Package anomaly watches account activity, such as sign-ins, token refreshes
+// and playlist deletions, for patterns that suggest an account was taken
+// over. Each activity is checked by a set of rules; a rule that flags it
+// raises a security alert for admins to review and, when configured to, signs
+// the user out everywhere so they must authenticate again.
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/securityalert"
+	"streamify/logging"
+	"streamify/quota"
+	"streamify/residency"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("anomaly")
+
+// Kind names a type of activity
+type Kind string
+
+const (
+	Login           Kind = "login"
+	TokenRefresh    Kind = "token_refresh"
+	PlaylistDeleted Kind = "playlist_deleted"
+)
+
+// Activity is one thing a user did
+type Activity struct {
+	Kind   Kind
+	UserID uuid.UUID
+	IP     string
+	// Country is the ISO code of where the request came from, or empty when
+	// it isn't known
+	Country string
+	At      time.Time
+}
+
+// Finding is what a rule flagged about an activity
+type Finding struct {
+	Severity securityalert.Severity
+	Details  map[string]string
+}
+
+// Rule checks activities of one kind
+type Rule interface {
+	// Name identifies the rule in alerts and configuration
+	Name() string
+	// Check returns a finding when a looks suspicious, or nil
+	Check(ctx context.Context, d *Detector, a Activity) (*Finding, error)
+}
+
+// Config says how activity is checked
+type Config struct {
+	Rules []Rule
+	// ForceReauth names the rules whose alerts also sign the user out
+	ForceReauth []string
+	// CountryHeader is the request header a proxy or CDN in front of the API
+	// sets to the client's country, e.g. CF-IPCountry. Without it sign-ins
+	// can't be placed and the new country rule never fires.
+	CountryHeader string
+}
+
+// DefaultRules are the rules checked unless configured otherwise
+func DefaultRules() []Rule {
+	return []Rule{
+		NewCountry{},
+		Burst{RuleName: "refresh_storm", Kind: TokenRefresh, Limit: 60, Window: 10 * time.Minute, Severity: securityalert.SeverityMedium},
+		Burst{RuleName: "mass_playlist_deletion", Kind: PlaylistDeleted, Limit: 20, Window: time.Hour, Severity: securityalert.SeverityHigh},
+	}
+}
+
+// FromEnv reads ANOMALY_FORCE_REAUTH, a comma-separated list of rules whose
+// alerts sign the user out, and GEOIP_COUNTRY_HEADER, the header holding the
+// client's country
+func FromEnv() (Config, error) {
+	cfg := Config{Rules: DefaultRules(), CountryHeader: os.Getenv("GEOIP_COUNTRY_HEADER")}
+	names := make([]string, len(cfg.Rules))
+	for i, r := range cfg.Rules {
+		names[i] = r.Name()
+	}
+	for _, name := range strings.Split(os.Getenv("ANOMALY_FORCE_REAUTH"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(names, name) {
+			return Config{}, fmt.Errorf("ANOMALY_FORCE_REAUTH: unknown rule %q (want %s)", name, strings.Join(names, ", "))
+		}
+		cfg.ForceReauth = append(cfg.ForceReauth, name)
+	}
+	return cfg, nil
+}
+
+// Detector checks activity against its rules and raises alerts
+type Detector struct {
+	client  *ent.Client
+	counter quota.Counter
+	cfg     Config
+}
+
+// New returns a detector storing alerts with client. Burst rules count
+// activity in counter, so that instances sharing it share the counts.
+func New(client *ent.Client, counter quota.Counter, cfg Config) *Detector {
+	return &Detector{client: client, counter: counter, cfg: cfg}
+}
+
+// detector checks the activity passed to Observe; nil until SetDetector
+var detector *Detector
+
+// SetDetector makes Observe check activity with d
+func SetDetector(d *Detector) {
+	detector = d
+}
+
+// countryPattern matches an ISO 3166 country code. Cloudflare's XX (unknown)
+// and T1 (Tor) don't match.
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Observe checks what the user did in the current request. Failures are
+// logged rather than failing a request that already succeeded.
+func Observe(c *gin.Context, kind Kind, userID uuid.UUID) {
+	d := detector
+	if d == nil {
+		return
+	}
+	a := Activity{Kind: kind, UserID: userID, IP: c.ClientIP(), At: time.Now()}
+	if d.cfg.CountryHeader != "" {
+		if cc := strings.ToUpper(strings.TrimSpace(c.GetHeader(d.cfg.CountryHeader))); countryPattern.MatchString(cc) && cc != "XX" {
+			a.Country = cc
+		}
+	}
+	// The request context may be cancelled as soon as the response is written.
+	// Alerts and sign-in state are kept in the home database.
+	d.Check(residency.Home(context.WithoutCancel(c.Request.Context())), a)
+}
+
+// Check runs a through every rule and raises an alert for each finding
+func (d *Detector) Check(ctx context.Context, a Activity) {
+	for _, r := range d.cfg.Rules {
+		f, err := r.Check(ctx, d, a)
+		if err != nil {
+			logger.Error("anomaly rule failed", "rule", r.Name(), "user_id", a.UserID, "error", err)
+			continue
+		}
+		if f == nil {
+			continue
+		}
+		if err := d.raise(ctx, r.Name(), a, f); err != nil {
+			logger.Error("failed raising security alert", "rule", r.Name(), "user_id", a.UserID, "error", err)
+		}
+	}
+}
+
+// raise stores an alert for f and, when the rule is configured to, ends the
+// user's sessions
+func (d *Detector) raise(ctx context.Context, rule string, a Activity, f *Finding) error {
+	force := slices.Contains(d.cfg.ForceReauth, rule)
+	tx, err := d.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	create := tx.SecurityAlert.Create().
+		SetUserID(a.UserID).
+		SetRule(rule).
+		SetSeverity(f.Severity).
+		SetReauthForced(force)
+	if len(f.Details) > 0 {
+		create.SetDetails(f.Details)
+	}
+	if a.IP != "" {
+		create.SetIP(a.IP)
+	}
+	if a.Country != "" {
+		create.SetCountry(a.Country)
+	}
+	alert, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	if force {
+		if err := tx.User.UpdateOneID(a.UserID).SetSessionsValidAfter(time.Now()).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	logger.Warn("security alert raised", "alert_id", alert.ID, "rule", rule, "severity", f.Severity, "user_id", a.UserID, "reauth_forced", force)
+	return nil
+}
@@ -0,0 +1,96 @@
+package anomaly
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/securityalert"
+	"streamify/ids"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListAlerts returns security alerts, newest first, optionally filtered by
+// ?status=open|resolved, ?user_id= and ?rule= (admin)
+func ListAlerts(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := client.SecurityAlert.Query().Order(ent.Desc(securityalert.FieldCreatedAt))
+		switch c.Query("status") {
+		case "":
+		case "open":
+			q.Where(securityalert.ResolvedAtIsNil())
+		case "resolved":
+			q.Where(securityalert.ResolvedAtNotNil())
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be open or resolved"})
+			return
+		}
+		if v := c.Query("user_id"); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+				return
+			}
+			q.Where(securityalert.UserIDEQ(id))
+		}
+		if v := c.Query("rule"); v != "" {
+			q.Where(securityalert.RuleEQ(v))
+		}
+		limit := 100
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 1000 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+				return
+			}
+			limit = n
+		}
+
+		alerts, err := q.Limit(limit).All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, alerts)
+	}
+}
+
+// ResolveAlert marks a security alert as reviewed by the calling admin (admin)
+func ResolveAlert(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert ID"})
+			return
+		}
+		adminID, _ := viewer.UserID(c.Request.Context())
+		ctx := c.Request.Context()
+		n, err := client.SecurityAlert.Update().
+			Where(securityalert.IDEQ(id), securityalert.ResolvedAtIsNil()).
+			SetResolvedAt(time.Now()).
+			SetResolvedBy(adminID).
+			Save(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		alert, err := client.SecurityAlert.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "security alert not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusConflict, gin.H{"error": "security alert already resolved"})
+			return
+		}
+		c.JSON(http.StatusOK, alert)
+	}
+}
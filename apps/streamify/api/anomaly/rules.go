@@ -0,0 +1,87 @@
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent/securityalert"
+	"streamify/ent/user"
+)
+
+// NewCountry flags a sign-in from a country the user hasn't signed in from
+// before. The first country seen is remembered without an alert.
+type NewCountry struct{}
+
+// Name implements Rule
+func (NewCountry) Name() string { return "new_country" }
+
+// Check implements Rule
+func (NewCountry) Check(ctx context.Context, d *Detector, a Activity) (*Finding, error) {
+	if a.Kind != Login || a.Country == "" {
+		return nil, nil
+	}
+	u, err := d.client.User.Query().
+		Where(user.IDEQ(a.UserID)).
+		Select(user.FieldLoginCountries).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(u.LoginCountries, a.Country) {
+		return nil, nil
+	}
+	if err := d.client.User.UpdateOneID(a.UserID).AppendLoginCountries([]string{a.Country}).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if len(u.LoginCountries) == 0 {
+		return nil, nil
+	}
+	return &Finding{
+		Severity: securityalert.SeverityMedium,
+		Details: map[string]string{
+			"country":         a.Country,
+			"known_countries": strings.Join(u.LoginCountries, ","),
+		},
+	}, nil
+}
+
+// Burst flags a user doing the same kind of thing Limit times within a
+// Window. Windows are fixed rather than sliding, and a burst is flagged once
+// per window, when the count reaches Limit.
+type Burst struct {
+	RuleName string
+	Kind     Kind
+	Limit    int64
+	Window   time.Duration
+	Severity securityalert.Severity
+}
+
+// Name implements Rule
+func (b Burst) Name() string { return b.RuleName }
+
+// Check implements Rule
+func (b Burst) Check(ctx context.Context, d *Detector, a Activity) (*Finding, error) {
+	if a.Kind != b.Kind {
+		return nil, nil
+	}
+	start := a.At.Truncate(b.Window)
+	key := fmt.Sprintf("anomaly:%s:%s:%d", b.RuleName, a.UserID, start.Unix())
+	n, err := d.counter.Incr(ctx, key, 1, start.Add(b.Window))
+	if err != nil {
+		return nil, err
+	}
+	if n != b.Limit {
+		return nil, nil
+	}
+	return &Finding{
+		Severity: b.Severity,
+		Details: map[string]string{
+			"count":  strconv.FormatInt(n, 10),
+			"window": b.Window.String(),
+		},
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Package apikeys lets users create API keys for their integrations and meters
+// each key's traffic into hourly usage buckets they can inspect.
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/apikey"
+	"streamify/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header carries an API key on requests made with one
+	Header = "X-API-Key"
+
+	// keyPrefix starts every key, so leaked keys are easy to recognize
+	keyPrefix = "sk_"
+
+	// shownPrefix is how many characters of a key are kept to tell keys apart
+	shownPrefix = 10
+
+	// MaxKeysPerUser caps how many active keys one user can hold
+	MaxKeysPerUser = 10
+)
+
+var (
+	// ErrInvalidKey is returned for unknown and revoked keys
+	ErrInvalidKey = errors.New("invalid API key")
+	// ErrTooManyKeys is returned when a user already holds MaxKeysPerUser active keys
+	ErrTooManyKeys = errors.New("API key limit reached")
+)
+
+// hashKey returns the stored form of key
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// newKey returns a random API key
+func newKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return keyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Create stores a new key for userID and returns it with the key itself,
+// which is not stored and can't be shown again
+func Create(ctx context.Context, client *ent.Client, userID uuid.UUID, name string) (*ent.APIKey, string, error) {
+	n, err := client.APIKey.Query().
+		Where(apikey.UserIDEQ(userID), apikey.RevokedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if n >= MaxKeysPerUser {
+		return nil, "", ErrTooManyKeys
+	}
+
+	key, err := newKey()
+	if err != nil {
+		return nil, "", err
+	}
+	k, err := client.APIKey.Create().
+		SetUserID(userID).
+		SetName(strings.TrimSpace(name)).
+		SetPrefix(key[:shownPrefix]).
+		SetKeyHash(hashKey(key)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return k, key, nil
+}
+
+// Lookup returns the active key matching key
+func Lookup(ctx context.Context, client *ent.Client, key string) (*ent.APIKey, error) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return nil, ErrInvalidKey
+	}
+	k, err := client.APIKey.Query().
+		Where(
+			apikey.KeyHashEQ(hashKey(key)),
+			apikey.RevokedAtIsNil(),
+			// Keys stop working with their deactivated owner
+			apikey.HasUserWith(user.DeactivatedAtIsNil()),
+		).
+		// The owner's region decides where the request's data is kept
+		WithUser(func(q *ent.UserQuery) { q.Select(user.FieldRegion) }).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalidKey
+	}
+	return k, err
+}
+
+// Revoke stops userID's key id from working. Revoking a revoked key is a no-op.
+func Revoke(ctx context.Context, client *ent.Client, userID, id uuid.UUID) (*ent.APIKey, error) {
+	k, err := client.APIKey.Query().
+		Where(apikey.IDEQ(id), apikey.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if k.RevokedAt != nil {
+		return k, nil
+	}
+	return k.Update().SetRevokedAt(time.Now()).Save(ctx)
+}
@@ -0,0 +1,243 @@
+package apikeys
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/apikey"
+	"streamify/ids"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxPoints caps the length of a usage series
+const maxPoints = 24 * 31
+
+// owner returns the user managing keys, writing an error response when the
+// request isn't allowed to. Keys can't be used to manage keys.
+func owner(c *gin.Context) (uuid.UUID, bool) {
+	v := viewer.FromContext(c.Request.Context())
+	userID, ok := viewer.UserID(c.Request.Context())
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return uuid.Nil, false
+	}
+	if v.APIKeyID != uuid.Nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "API keys cannot manage API keys"})
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// List returns the current user's API keys, newest first, revoked ones included
+func List(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := owner(c)
+		if !ok {
+			return
+		}
+		keys, err := client.APIKey.Query().
+			Where(apikey.UserIDEQ(userID)).
+			Order(ent.Desc(apikey.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, keys)
+	}
+}
+
+// CreateRequest is the request body for Create
+type CreateRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// CreateResponse is a new key with its secret, which is only ever shown here
+type CreateResponse struct {
+	*ent.APIKey
+	Key string `json:"key"`
+}
+
+// CreateKey creates an API key for the current user
+func CreateKey(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := owner(c)
+		if !ok {
+			return
+		}
+		var req CreateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		k, key, err := Create(c.Request.Context(), client, userID, req.Name)
+		if err != nil {
+			if errors.Is(err, ErrTooManyKeys) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "limit": MaxKeysPerUser})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, CreateResponse{APIKey: k, Key: key})
+	}
+}
+
+// RevokeKey revokes one of the current user's API keys
+func RevokeKey(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := owner(c)
+		if !ok {
+			return
+		}
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid API key ID"})
+			return
+		}
+
+		k, err := Revoke(c.Request.Context(), client, userID, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, k)
+	}
+}
+
+// Usage returns one of the current user's API keys' traffic over time:
+// requests, rate-limit hits and errors per ?interval=hour|day (default hour)
+// between ?from= and ?to= (RFC 3339; default the last 24 hours, or 30 days for
+// daily points). Counts are written about once a minute.
+func Usage(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := owner(c)
+		if !ok {
+			return
+		}
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid API key ID"})
+			return
+		}
+
+		interval := Interval(c.DefaultQuery("interval", string(Hourly)))
+		if interval != Hourly && interval != Daily {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "interval must be hour or day"})
+			return
+		}
+		to := time.Now()
+		if s := c.Query("to"); s != "" {
+			if to, err = time.Parse(time.RFC3339, s); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "to must be an RFC 3339 time"})
+				return
+			}
+		}
+		from := to.Add(-24 * time.Hour)
+		if interval == Daily {
+			from = to.AddDate(0, 0, -30)
+		}
+		if s := c.Query("from"); s != "" {
+			if from, err = time.Parse(time.RFC3339, s); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "from must be an RFC 3339 time"})
+				return
+			}
+		}
+		if !from.Before(to) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be before to"})
+			return
+		}
+		if to.Sub(from) > maxPoints*interval.duration() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "range is too long for this interval", "max_points": maxPoints})
+			return
+		}
+
+		ctx := c.Request.Context()
+		k, err := client.APIKey.Query().
+			Where(apikey.IDEQ(id), apikey.UserIDEQ(userID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		points, total, err := Series(ctx, client, k.ID, from, to, interval)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"key_id":   k.ID,
+			"interval": interval,
+			"from":     from.UTC(),
+			"to":       to.UTC(),
+			"total":    total,
+			"points":   points,
+		})
+	}
+}
+
+// UpdateLimitsRequest is the request body for UpdateLimits. Omitted fields are
+// left alone; reset drops the key's own quota and overage so its tier's apply.
+type UpdateLimitsRequest struct {
+	Tier         *string `json:"tier" binding:"omitempty,oneof=free pro enterprise"`
+	MonthlyQuota *int64  `json:"monthly_quota" binding:"omitempty,min=0"`
+	Overage      *string `json:"overage" binding:"omitempty,oneof=block allow"`
+	Reset        bool    `json:"reset"`
+}
+
+// UpdateLimits changes an API key's tier or overrides its monthly quota and
+// overage behavior (admin)
+func UpdateLimits(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid API key ID"})
+			return
+		}
+		var req UpdateLimitsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		update := client.APIKey.UpdateOneID(id)
+		if req.Reset {
+			update.ClearMonthlyQuota().ClearOverage()
+		}
+		if req.Tier != nil {
+			update.SetTier(apikey.Tier(*req.Tier))
+		}
+		if req.MonthlyQuota != nil {
+			update.SetMonthlyQuota(*req.MonthlyQuota)
+		}
+		if req.Overage != nil {
+			update.SetOverage(apikey.Overage(*req.Overage))
+		}
+		k, err := update.Save(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		limit, overage := Limits(k)
+		c.JSON(http.StatusOK, gin.H{"key": k, "monthly_quota": limit, "overage": overage})
+	}
+}
@@ -0,0 +1,48 @@
+package apikeys
+
+import (
+	"context"
+
+	"streamify/ent"
+	"streamify/ent/apikey"
+)
+
+// Tier is the default quota of a subscription tier
+type Tier struct {
+	// MonthlyQuota is the number of requests per calendar month (UTC); 0 means unlimited
+	MonthlyQuota int64          `json:"monthly_quota"`
+	Overage      apikey.Overage `json:"overage"`
+}
+
+// Tiers maps each tier to its defaults. A key's own monthly_quota and overage
+// override them.
+var Tiers = map[apikey.Tier]Tier{
+	apikey.TierFree:       {MonthlyQuota: 10_000, Overage: apikey.OverageBlock},
+	apikey.TierPro:        {MonthlyQuota: 1_000_000, Overage: apikey.OverageAllow},
+	apikey.TierEnterprise: {MonthlyQuota: 0, Overage: apikey.OverageAllow},
+}
+
+// Limits returns k's effective monthly quota and overage behavior
+func Limits(k *ent.APIKey) (int64, apikey.Overage) {
+	t := Tiers[k.Tier]
+	if k.MonthlyQuota != nil {
+		t.MonthlyQuota = *k.MonthlyQuota
+	}
+	if k.Overage != nil {
+		t.Overage = *k.Overage
+	}
+	return t.MonthlyQuota, t.Overage
+}
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying the key a request was made with
+func NewContext(ctx context.Context, k *ent.APIKey) context.Context {
+	return context.WithValue(ctx, ctxKey{}, k)
+}
+
+// FromContext returns the key the request was made with, or nil
+func FromContext(ctx context.Context) *ent.APIKey {
+	k, _ := ctx.Value(ctxKey{}).(*ent.APIKey)
+	return k
+}
@@ -0,0 +1,245 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/apikeyusage"
+	"streamify/logging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("apikeys")
+
+// UsageRetention is how long hourly usage buckets are kept
+const UsageRetention = 90 * 24 * time.Hour
+
+// Counts is an API key's traffic over some period
+type Counts struct {
+	Requests int64 `json:"requests"`
+	// RateLimited counts requests answered 429 Too Many Requests
+	RateLimited int64 `json:"rate_limited"`
+	// ClientErrors counts other 4xx answers
+	ClientErrors int64 `json:"client_errors"`
+	ServerErrors int64 `json:"server_errors"`
+	// ErrorRate is the share of requests answered with any 4xx or 5xx
+	ErrorRate float64 `json:"error_rate"`
+}
+
+func (c *Counts) add(o Counts) {
+	c.Requests += o.Requests
+	c.RateLimited += o.RateLimited
+	c.ClientErrors += o.ClientErrors
+	c.ServerErrors += o.ServerErrors
+}
+
+func (c *Counts) rate() {
+	c.ErrorRate = 0
+	if c.Requests > 0 {
+		c.ErrorRate = float64(c.RateLimited+c.ClientErrors+c.ServerErrors) / float64(c.Requests)
+	}
+}
+
+type bucketKey struct {
+	key  uuid.UUID
+	hour time.Time
+}
+
+// Meter counts requests made with API keys in memory and periodically flushes
+// the counts into hourly usage buckets
+type Meter struct {
+	client *ent.Client
+
+	mu       sync.Mutex
+	pending  map[bucketKey]*Counts
+	lastUsed map[uuid.UUID]time.Time
+}
+
+// NewMeter returns a Meter writing to client
+func NewMeter(client *ent.Client) *Meter {
+	return &Meter{
+		client:   client,
+		pending:  make(map[bucketKey]*Counts),
+		lastUsed: make(map[uuid.UUID]time.Time),
+	}
+}
+
+// Record counts one request made with keyID at t that was answered status
+func (m *Meter) Record(keyID uuid.UUID, t time.Time, status int) {
+	c := Counts{Requests: 1}
+	switch {
+	case status == http.StatusTooManyRequests:
+		c.RateLimited = 1
+	case status >= 500:
+		c.ServerErrors = 1
+	case status >= 400:
+		c.ClientErrors = 1
+	}
+
+	k := bucketKey{key: keyID, hour: t.UTC().Truncate(time.Hour)}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pending[k] == nil {
+		m.pending[k] = &Counts{}
+	}
+	m.pending[k].add(c)
+	if t.After(m.lastUsed[keyID]) {
+		m.lastUsed[keyID] = t
+	}
+}
+
+// Middleware records every request made with an API key once it's answered.
+// Register it right after auth.AuthMiddleware so rejections by later
+// middleware, rate limiting included, are counted too.
+func (m *Meter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if v := viewer.FromContext(c.Request.Context()); v != nil && v.APIKeyID != uuid.Nil {
+			m.Record(v.APIKeyID, time.Now(), c.Writer.Status())
+		}
+	}
+}
+
+// Flush writes the counts recorded since the last flush. Counts that fail to
+// be written are kept for the next flush.
+func (m *Meter) Flush(ctx context.Context) error {
+	m.mu.Lock()
+	pending, lastUsed := m.pending, m.lastUsed
+	m.pending = make(map[bucketKey]*Counts)
+	m.lastUsed = make(map[uuid.UUID]time.Time)
+	m.mu.Unlock()
+
+	var errs []error
+	for k, c := range pending {
+		if err := m.write(ctx, k, *c); err != nil {
+			errs = append(errs, err)
+			m.mu.Lock()
+			if m.pending[k] == nil {
+				m.pending[k] = &Counts{}
+			}
+			m.pending[k].add(*c)
+			m.mu.Unlock()
+		}
+	}
+	for id, t := range lastUsed {
+		if err := m.client.APIKey.UpdateOneID(id).SetLastUsedAt(t).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		logger.Warn("failed flushing API key usage", "error", err)
+		return err
+	}
+	return nil
+}
+
+// write adds c to k's bucket, creating it on the first flush of the hour
+func (m *Meter) write(ctx context.Context, k bucketKey, c Counts) error {
+	add := func() (int, error) {
+		return m.client.APIKeyUsage.Update().
+			Where(apikeyusage.KeyIDEQ(k.key), apikeyusage.BucketEQ(k.hour)).
+			AddRequests(c.Requests).
+			AddRateLimited(c.RateLimited).
+			AddClientErrors(c.ClientErrors).
+			AddServerErrors(c.ServerErrors).
+			Save(ctx)
+	}
+	n, err := add()
+	if err != nil || n > 0 {
+		return err
+	}
+	err = m.client.APIKeyUsage.Create().
+		SetKeyID(k.key).
+		SetBucket(k.hour).
+		SetRequests(c.Requests).
+		SetRateLimited(c.RateLimited).
+		SetClientErrors(c.ClientErrors).
+		SetServerErrors(c.ServerErrors).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Another instance created the bucket first
+		_, err = add()
+	}
+	return err
+}
+
+// Interval is the width of the points in a usage series
+type Interval string
+
+const (
+	Hourly Interval = "hour"
+	Daily  Interval = "day"
+)
+
+func (i Interval) duration() time.Duration {
+	if i == Daily {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// Point is a key's traffic during one interval
+type Point struct {
+	Start time.Time `json:"start"`
+	Counts
+}
+
+// Series returns keyID's traffic from from until to in points of the given
+// interval, oldest first. Intervals without traffic are included as zeros.
+func Series(ctx context.Context, client *ent.Client, keyID uuid.UUID, from, to time.Time, interval Interval) ([]Point, Counts, error) {
+	step := interval.duration()
+	from, to = from.UTC().Truncate(step), to.UTC()
+
+	buckets, err := client.APIKeyUsage.Query().
+		Where(
+			apikeyusage.KeyIDEQ(keyID),
+			apikeyusage.BucketGTE(from),
+			apikeyusage.BucketLT(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, Counts{}, err
+	}
+
+	points := []Point{}
+	index := map[time.Time]int{}
+	for t := from; t.Before(to); t = t.Add(step) {
+		index[t] = len(points)
+		points = append(points, Point{Start: t})
+	}
+	var total Counts
+	for _, b := range buckets {
+		c := Counts{
+			Requests:     b.Requests,
+			RateLimited:  b.RateLimited,
+			ClientErrors: b.ClientErrors,
+			ServerErrors: b.ServerErrors,
+		}
+		if i, ok := index[b.Bucket.UTC().Truncate(step)]; ok {
+			points[i].add(c)
+		}
+		total.add(c)
+	}
+	for i := range points {
+		points[i].rate()
+	}
+	total.rate()
+	return points, total, nil
+}
+
+// PurgeUsage deletes usage buckets older than retention
+func PurgeUsage(client *ent.Client, retention time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := client.APIKeyUsage.Delete().
+			Where(apikeyusage.BucketLT(time.Now().Add(-retention))).
+			Exec(ctx)
+		return err
+	}
+}
@@ -0,0 +1,83 @@
+// Package archive moves old rows out of Postgres into gzip-compressed CSV objects
+// in storage, where they stay queryable on demand without weighing on the database.
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"streamify/storage"
+)
+
+// Prefix is the storage prefix under which all archives are written
+const Prefix = "archives/"
+
+// RowFunc emits the rows of an archive one at a time through write
+type RowFunc func(write func(record []string) error) error
+
+// Write streams header and the rows produced by rows into store under key as
+// gzip-compressed CSV. Nothing is buffered beyond the compressor's window, so
+// archives of any size cost the same memory. On failure the partial object is
+// removed.
+func Write(ctx context.Context, store storage.Storage, key string, header []string, rows RowFunc) (int, error) {
+	pr, pw := io.Pipe()
+	count := 0
+	go func() {
+		zw := gzip.NewWriter(pw)
+		cw := csv.NewWriter(zw)
+		err := cw.Write(header)
+		if err == nil {
+			err = rows(func(record []string) error {
+				count++
+				return cw.Write(record)
+			})
+		}
+		cw.Flush()
+		if err == nil {
+			err = cw.Error()
+		}
+		if cerr := zw.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+
+	if err := store.Put(ctx, key, pr); err != nil {
+		// Unblock the writer if storage stopped reading
+		pr.CloseWithError(err)
+		store.Delete(ctx, key)
+		return 0, fmt.Errorf("writing archive %s: %w", key, err)
+	}
+	return count, nil
+}
+
+// Read streams the CSV records of the archive stored under key to fn, header first
+func Read(ctx context.Context, store storage.Storage, key string, fn func(record []string) error) error {
+	rc, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	zr, err := gzip.NewReader(rc)
+	if err != nil {
+		return fmt.Errorf("reading archive %s: %w", key, err)
+	}
+	defer zr.Close()
+	cr := csv.NewReader(zr)
+	cr.ReuseRecord = true
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive %s: %w", key, err)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
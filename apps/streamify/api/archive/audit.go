@@ -0,0 +1,226 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/auditlog"
+	"streamify/storage"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// auditHeader is the CSV header of audit log archives
+var auditHeader = []string{"id", "actor_id", "action", "target_id", "status", "ip", "metadata", "created_at"}
+
+// AuditKey returns the storage key of the archive holding audit entries from day
+func AuditKey(day time.Time) string {
+	return fmt.Sprintf("%saudit/%s.csv.gz", Prefix, day.Format(time.DateOnly))
+}
+
+// dayStart truncates t to midnight UTC
+func dayStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// AuditLogs returns a job that moves audit entries older than retainDays full days
+// into one archive per day, deleting them from the database once the archive is written
+func AuditLogs(client *ent.Client, store storage.Storage, retainDays int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		cutoff := dayStart(time.Now()).AddDate(0, 0, -retainDays)
+		for {
+			oldest, err := client.AuditLog.Query().
+				Where(auditlog.CreatedAtLT(cutoff)).
+				Order(ent.Asc(auditlog.FieldCreatedAt)).
+				First(ctx)
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := archiveAuditDay(ctx, client, store, dayStart(oldest.CreatedAt)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// archiveAuditDay writes the audit entries of one day to storage and deletes them
+func archiveAuditDay(ctx context.Context, client *ent.Client, store storage.Storage, day time.Time) error {
+	inDay := auditlog.And(auditlog.CreatedAtGTE(day), auditlog.CreatedAtLT(day.AddDate(0, 0, 1)))
+	logs, err := client.AuditLog.Query().
+		Where(inDay).
+		Order(ent.Asc(auditlog.FieldCreatedAt), ent.Asc(auditlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	key := AuditKey(day)
+	_, err = Write(ctx, store, key, auditHeader, func(write func([]string) error) error {
+		for _, l := range logs {
+			if err := write(auditRecord(l)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.AuditLog.Delete().Where(inDay).Exec(ctx); err != nil {
+		return err
+	}
+	log.Printf("archive: moved %d audit entries to %s", len(logs), key)
+	return nil
+}
+
+// auditRecord flattens an entry into the columns of auditHeader
+func auditRecord(l *ent.AuditLog) []string {
+	actor := ""
+	if l.ActorID != nil {
+		actor = l.ActorID.String()
+	}
+	metadata := ""
+	if len(l.Metadata) > 0 {
+		b, _ := json.Marshal(l.Metadata)
+		metadata = string(b)
+	}
+	return []string{
+		l.ID.String(), actor, l.Action, l.TargetID, strconv.Itoa(l.Status), l.IP,
+		metadata, l.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// parseAuditRecord is the inverse of auditRecord
+func parseAuditRecord(record []string) (*ent.AuditLog, error) {
+	if len(record) != len(auditHeader) {
+		return nil, fmt.Errorf("expected %d columns, got %d", len(auditHeader), len(record))
+	}
+	l := &ent.AuditLog{Action: record[2], TargetID: record[3], IP: record[5]}
+	var err error
+	if l.ID, err = uuid.Parse(record[0]); err != nil {
+		return nil, err
+	}
+	if record[1] != "" {
+		actor, err := uuid.Parse(record[1])
+		if err != nil {
+			return nil, err
+		}
+		l.ActorID = &actor
+	}
+	if l.Status, err = strconv.Atoi(record[4]); err != nil {
+		return nil, err
+	}
+	if record[6] != "" {
+		if err := json.Unmarshal([]byte(record[6]), &l.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	if l.CreatedAt, err = time.Parse(time.RFC3339Nano, record[7]); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// maxQueryDays bounds how many daily archives one query may open
+const maxQueryDays = 92
+
+// errLimitReached stops reading archives once a query has enough entries
+var errLimitReached = errors.New("limit reached")
+
+// QueryAuditLogs searches the archived audit entries between ?from= and ?to=
+// (inclusive dates), optionally filtered by ?actor_id= and ?action=. Entries come
+// back oldest first, up to ?limit=.
+func QueryAuditLogs(store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		from, err := time.Parse(time.DateOnly, c.Query("from"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be a date (YYYY-MM-DD)"})
+			return
+		}
+		to, err := time.Parse(time.DateOnly, c.Query("to"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "to must be a date (YYYY-MM-DD)"})
+			return
+		}
+		if to.Before(from) || to.Sub(from) >= maxQueryDays*24*time.Hour {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("range must cover 1 to %d days", maxQueryDays)})
+			return
+		}
+		var actor *uuid.UUID
+		if v := c.Query("actor_id"); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid actor ID"})
+				return
+			}
+			actor = &id
+		}
+		action := c.Query("action")
+		limit := 1000
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 10000 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 10000"})
+				return
+			}
+			limit = n
+		}
+
+		entries := []*ent.AuditLog{}
+		archivedDays := 0
+		truncated := false
+		for day := from; !day.After(to) && !truncated; day = day.AddDate(0, 0, 1) {
+			header := true
+			err := Read(c.Request.Context(), store, AuditKey(day), func(record []string) error {
+				if header {
+					header = false
+					return nil
+				}
+				l, err := parseAuditRecord(record)
+				if err != nil {
+					return fmt.Errorf("%s: %w", AuditKey(day), err)
+				}
+				if actor != nil && (l.ActorID == nil || *l.ActorID != *actor) {
+					return nil
+				}
+				if action != "" && l.Action != action {
+					return nil
+				}
+				if len(entries) == limit {
+					truncated = true
+					return errLimitReached
+				}
+				entries = append(entries, l)
+				return nil
+			})
+			if storage.IsNotFound(err) {
+				// Nothing was audited that day
+				continue
+			}
+			if err != nil && !errors.Is(err, errLimitReached) {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			archivedDays++
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"entries":       entries,
+			"archived_days": archivedDays,
+			"truncated":     truncated,
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"streamify/ent"
+	"streamify/migration"
+	"streamify/storage"
+)
+
+// playHeader is the CSV header of play archives
+var playHeader = []string{"id", "user_id", "track_id", "territory", "played_at"}
+
+// PlayKey returns the storage key of the archive holding plays from month
+func PlayKey(month time.Time) string {
+	return fmt.Sprintf("%splays/%s.csv.gz", Prefix, month.Format("2006-01"))
+}
+
+// Plays returns a job that archives every monthly play partition older than
+// retainMonths full months: the partition is detached, written to storage and
+// then dropped. A partition that fails to archive stays detached and is picked up
+// again by the next run. Archived plays also drop out of charts and stats once the
+// materialized views next refresh.
+func Plays(client *ent.Client, store storage.Storage, retainMonths int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		now := time.Now().UTC()
+		cutoff := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -retainMonths, 0)
+
+		partitions, err := migration.PlayPartitions(ctx, client)
+		if err != nil {
+			return err
+		}
+		for _, p := range partitions {
+			if !p.Month.Before(cutoff) {
+				continue
+			}
+			if err := archivePlayPartition(ctx, client, store, p); err != nil {
+				return fmt.Errorf("archiving %s: %w", p.Name, err)
+			}
+		}
+		return nil
+	}
+}
+
+// archivePlayPartition moves the rows of one partition into storage
+func archivePlayPartition(ctx context.Context, client *ent.Client, store storage.Storage, p migration.PlayPartition) error {
+	if err := migration.DetachPlayPartition(ctx, client, p); err != nil {
+		return err
+	}
+	p.Attached = false
+
+	key := PlayKey(p.Month)
+	n, err := Write(ctx, store, key, playHeader, func(write func([]string) error) error {
+		rows, err := client.QueryContext(ctx, fmt.Sprintf(
+			`SELECT id, user_id, track_id, COALESCE(territory, ''), played_at FROM %q ORDER BY played_at, id`, p.Name))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		record := make([]string, len(playHeader))
+		for rows.Next() {
+			var playedAt time.Time
+			if err := rows.Scan(&record[0], &record[1], &record[2], &record[3], &playedAt); err != nil {
+				return err
+			}
+			record[4] = playedAt.UTC().Format(time.RFC3339Nano)
+			if err := write(record); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := migration.DropPlayPartition(ctx, client, p); err != nil {
+		return err
+	}
+	log.Printf("archive: moved %d plays from %s to %s", n, p.Name, key)
+	return nil
+}
@@ -0,0 +1,122 @@
+// Package audio stores the audio files uploaded for tracks and fingerprints
+// them, so uploads that duplicate an existing track are rejected or queued for
+// an admin to review.
+package audio
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"os"
+	"os/exec"
+)
+
+// Print is an acoustic fingerprint of a recording
+type Print struct {
+	// Duration is the length of the recording in seconds
+	Duration int
+	// Raw holds one 32-bit subfingerprint per ~124ms of audio
+	Raw []uint32
+}
+
+// Fingerprinter computes the acoustic fingerprint of the audio file at path
+type Fingerprinter interface {
+	Fingerprint(ctx context.Context, path string) (*Print, error)
+}
+
+// fingerprintSeconds is how much of each recording is fingerprinted
+const fingerprintSeconds = 120
+
+// Chromaprint fingerprints files with Chromaprint's fpcalc tool
+type Chromaprint struct {
+	// Path is the fpcalc executable
+	Path string
+}
+
+// Fingerprint implements Fingerprinter
+func (c *Chromaprint) Fingerprint(ctx context.Context, path string) (*Print, error) {
+	out, err := exec.CommandContext(ctx, c.Path, "-raw", "-json", "-length", fmt.Sprint(fingerprintSeconds), path).Output()
+	if err != nil {
+		if exit, ok := err.(*exec.ExitError); ok && len(exit.Stderr) > 0 {
+			return nil, fmt.Errorf("fpcalc: %s", exit.Stderr)
+		}
+		return nil, fmt.Errorf("fpcalc: %w", err)
+	}
+	var res struct {
+		Duration    float64  `json:"duration"`
+		Fingerprint []uint32 `json:"fingerprint"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, fmt.Errorf("decoding fpcalc output: %w", err)
+	}
+	return &Print{Duration: int(res.Duration + 0.5), Raw: res.Fingerprint}, nil
+}
+
+// FromEnv returns a Chromaprint fingerprinter using FPCALC_PATH, or fpcalc from
+// PATH, or nil when fpcalc isn't installed; uploads are then only checked for
+// byte-identical duplicates
+func FromEnv() (Fingerprinter, error) {
+	if path := os.Getenv("FPCALC_PATH"); path != "" {
+		if _, err := exec.LookPath(path); err != nil {
+			return nil, fmt.Errorf("FPCALC_PATH: %w", err)
+		}
+		return &Chromaprint{Path: path}, nil
+	}
+	path, err := exec.LookPath("fpcalc")
+	if err != nil {
+		return nil, nil
+	}
+	return &Chromaprint{Path: path}, nil
+}
+
+// encode packs a raw fingerprint for storage
+func encode(raw []uint32) []byte {
+	b := make([]byte, 4*len(raw))
+	for i, v := range raw {
+		binary.LittleEndian.PutUint32(b[4*i:], v)
+	}
+	return b
+}
+
+// decode unpacks a stored fingerprint
+func decode(b []byte) []uint32 {
+	raw := make([]uint32, len(b)/4)
+	for i := range raw {
+		raw[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+	return raw
+}
+
+const (
+	// maxOffset is how far, in subfingerprints (~10s), recordings are shifted
+	// against each other to line them up
+	maxOffset = 80
+	// minOverlap is the fewest aligned subfingerprints compared (~6s)
+	minOverlap = 50
+)
+
+// Similarity returns the share of matching bits between two fingerprints at
+// their best alignment, from 0.5 (unrelated) to 1 (identical)
+func Similarity(a, b []uint32) float64 {
+	best := 0.0
+	for offset := -maxOffset; offset <= maxOffset; offset++ {
+		var matching, total int
+		for i := range a {
+			j := i + offset
+			if j < 0 || j >= len(b) {
+				continue
+			}
+			matching += 32 - bits.OnesCount32(a[i]^b[j])
+			total += 32
+		}
+		if total < 32*minOverlap {
+			continue
+		}
+		if s := float64(matching) / float64(total); s > best {
+			best = s
+		}
+	}
+	return best
+}
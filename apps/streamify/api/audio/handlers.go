@@ -0,0 +1,220 @@
+package audio
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/ids"
+	"streamify/storage"
+	"streamify/tombstones"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UploadAudio stores the request body as a track's audio file (admin). The
+// Content-Type is ignored: files are checked against the upload policy by
+// their contents. Uploads duplicating another track are rejected with 409
+// Conflict; near-matches are accepted and queued for review.
+func UploadAudio(u *Uploader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Checked before reading the body since files are stored before the catalog is written
+		if !viewer.FromContext(c.Request.Context()).IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			return
+		}
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track ID"})
+			return
+		}
+		if limit := u.Policy(c.Request.Context()).MaxSize; c.Request.ContentLength > limit {
+			writeUploadError(c, &RejectionError{Reason: ReasonTooLarge, Size: c.Request.ContentLength, Limit: limit})
+			return
+		}
+
+		res, err := u.Upload(c.Request.Context(), id, c.Request.Body)
+		if err != nil {
+			writeUploadError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+// writeUploadError responds to an upload the Uploader failed to store
+func writeUploadError(c *gin.Context, err error) {
+	var dup *DuplicateError
+	var rejected *RejectionError
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(http.StatusNotFound, gin.H{"error": "track not found"})
+	case errors.As(err, &dup):
+		c.JSON(http.StatusConflict, gin.H{"error": dup.Error(), "duplicate": dup})
+	case errors.As(err, &rejected):
+		c.JSON(rejected.Status(), gin.H{"error": rejected.Error(), "rejection": rejected})
+	case errors.Is(err, ErrTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrEmpty):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// ListReviews returns flagged uploads with the tracks they resemble, oldest
+// first (?status=pending|duplicate|dismissed, default pending)
+func ListReviews(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status := duplicatereview.Status(c.DefaultQuery("status", string(duplicatereview.StatusPending)))
+		if err := duplicatereview.StatusValidator(status); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, duplicate or dismissed"})
+			return
+		}
+		reviews, err := client.DuplicateReview.Query().
+			Where(duplicatereview.StatusEQ(status)).
+			WithTrack().
+			WithMatch().
+			Order(ent.Asc(duplicatereview.FieldCreatedAt)).
+			Limit(200).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, reviews)
+	}
+}
+
+// ResolveReviewRequest is the request body for ResolveReview
+type ResolveReviewRequest struct {
+	// Status duplicate removes the flagged track from the catalog; dismissed keeps it
+	Status string `json:"status" binding:"required,oneof=duplicate dismissed"`
+}
+
+// ResolveReview records an admin's judgement of a flagged upload. Confirmed
+// duplicates are soft-deleted.
+func ResolveReview(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+			return
+		}
+		var req ResolveReviewRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+
+		now := time.Now()
+		n, err := tx.DuplicateReview.Update().
+			Where(duplicatereview.IDEQ(id), duplicatereview.StatusEQ(duplicatereview.StatusPending)).
+			SetStatus(duplicatereview.Status(req.Status)).
+			SetReviewedBy(adminID).
+			SetReviewedAt(now).
+			Save(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		r, err := tx.DuplicateReview.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "review not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusConflict, gin.H{"error": "review already resolved", "review": r})
+			return
+		}
+		if r.Status == duplicatereview.StatusDuplicate {
+			if err := tx.Track.UpdateOneID(r.TrackID).SetDeletedAt(now).Exec(ctx); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			if err := tombstones.Record(ctx, tx.Client(), tombstone.EntityTypeTrack, nil, r.TrackID); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, r)
+	}
+}
+
+// DownloadAlbum streams a ZIP of an album's uploaded audio, tagged from the
+// catalog. Gate it with entitlements.Require.
+func DownloadAlbum(client *ent.Client, store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			return
+		}
+		ctx := c.Request.Context()
+		a, err := client.Album.Query().
+			Where(album.IDEQ(id), album.DeletedAtIsNil()).
+			WithArtist().
+			WithTracks(func(q *ent.TrackQuery) {
+				q.Where(track.DeletedAtIsNil(), track.AudioKeyNEQ("")).
+					Order(ent.Asc(track.FieldDiscNumber), ent.Asc(track.FieldTrackNumber))
+			}).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if len(a.Edges.Tracks) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "album has no downloadable audio"})
+			return
+		}
+
+		download := Album{Album: a, Tracks: a.Edges.Tracks}
+		if a.Edges.Artist != nil {
+			download.ArtistName = a.Edges.Artist.Name
+		}
+		name := fileName(a.Title)
+		if download.ArtistName != "" {
+			name = fileName(download.ArtistName + " - " + a.Title)
+		}
+		c.Header("Content-Type", "application/zip")
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + ".zip"}))
+		c.Status(http.StatusOK)
+		if err := WriteZip(ctx, c.Writer, store, download); err != nil {
+			// Headers are gone; the client sees a truncated archive
+			logger.Error("album download failed", "album_id", id, "error", err)
+			c.Abort()
+		}
+	}
+}
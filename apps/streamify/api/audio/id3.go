@@ -0,0 +1,119 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+)
+
+// Format is the container of an audio file, detected from its first bytes
+type Format string
+
+const (
+	FormatMP3     Format = "mp3"
+	FormatFLAC    Format = "flac"
+	FormatOgg     Format = "ogg"
+	FormatMP4     Format = "m4a"
+	FormatAAC     Format = "aac"
+	FormatUnknown Format = ""
+)
+
+// Ext returns the file extension for f
+func (f Format) Ext() string {
+	if f == FormatUnknown {
+		return ".bin"
+	}
+	return "." + string(f)
+}
+
+// sniff detects the format of the audio at the start of r without consuming it
+func sniff(r *bufio.Reader) Format {
+	head, _ := r.Peek(12)
+	switch {
+	case bytes.HasPrefix(head, []byte("ID3")):
+		return FormatMP3
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xF6 == 0xF0:
+		return FormatAAC // ADTS: MPEG sync with layer 0
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0:
+		return FormatMP3
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return FormatFLAC
+	case bytes.HasPrefix(head, []byte("OggS")):
+		return FormatOgg
+	case len(head) >= 8 && string(head[4:8]) == "ftyp":
+		return FormatMP4
+	}
+	return FormatUnknown
+}
+
+// Tags is the catalog metadata written into downloaded files
+type Tags struct {
+	Title  string
+	Artist string
+	Album  string
+	Year   int
+	Track  int
+	Tracks int
+	Disc   int
+	Discs  int
+	Label  string
+}
+
+// id3Tag returns an ID3v2.4 tag carrying tags
+func id3Tag(tags Tags) []byte {
+	var frames bytes.Buffer
+	text := func(id, value string) {
+		if value == "" {
+			return
+		}
+		frames.WriteString(id)
+		frames.Write(syncsafe(len(value) + 1))
+		frames.Write([]byte{0, 0}) // flags
+		frames.WriteByte(3)        // UTF-8
+		frames.WriteString(value)
+	}
+	count := func(n, total int) string {
+		if n <= 0 {
+			return ""
+		}
+		if total > 0 {
+			return strconv.Itoa(n) + "/" + strconv.Itoa(total)
+		}
+		return strconv.Itoa(n)
+	}
+	text("TIT2", tags.Title)
+	text("TPE1", tags.Artist)
+	text("TALB", tags.Album)
+	text("TRCK", count(tags.Track, tags.Tracks))
+	text("TPOS", count(tags.Disc, tags.Discs))
+	if tags.Year > 0 {
+		text("TDRC", strconv.Itoa(tags.Year))
+	}
+	text("TPUB", tags.Label)
+
+	tag := []byte{'I', 'D', '3', 4, 0, 0}
+	tag = append(tag, syncsafe(frames.Len())...)
+	return append(tag, frames.Bytes()...)
+}
+
+// skipID3 discards an ID3v2 tag at the start of r, so the file's own tag
+// doesn't shadow the one generated from the catalog
+func skipID3(r *bufio.Reader) error {
+	head, err := r.Peek(10)
+	if err != nil || !bytes.HasPrefix(head, []byte("ID3")) {
+		return nil
+	}
+	size := int64(head[6]&0x7F)<<21 | int64(head[7]&0x7F)<<14 | int64(head[8]&0x7F)<<7 | int64(head[9]&0x7F)
+	size += 10
+	if head[5]&0x10 != 0 {
+		size += 10 // footer
+	}
+	_, err = io.CopyN(io.Discard, r, size)
+	return err
+}
+
+// syncsafe encodes n as a 4-byte ID3 syncsafe integer
+func syncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7F), byte(n >> 14 & 0x7F), byte(n >> 7 & 0x7F), byte(n & 0x7F)}
+}
@@ -0,0 +1,185 @@
+package audio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"streamify/apikeys"
+	"streamify/ent/apikey"
+)
+
+// Reason says why an upload was rejected
+type Reason string
+
+const (
+	ReasonUnrecognizedFormat Reason = "unrecognized_format"
+	ReasonUnsupportedCodec   Reason = "unsupported_codec"
+	ReasonCorrupt            Reason = "corrupt_stream"
+	ReasonTooLarge           Reason = "file_too_large"
+	ReasonBitrateTooHigh     Reason = "bitrate_too_high"
+)
+
+// RejectionError is returned for uploads that aren't audio the uploader's
+// Policy accepts. It is sent to the client as is.
+type RejectionError struct {
+	Reason  Reason  `json:"reason"`
+	Format  Format  `json:"format,omitempty"`
+	Codec   Codec   `json:"codec,omitempty"`
+	Allowed []Codec `json:"allowed,omitempty"`
+	// Size is in bytes, Bitrate in kbit/s; Limit is in the same unit as the
+	// value that exceeded it
+	Size    int64 `json:"size,omitempty"`
+	Bitrate int   `json:"bitrate,omitempty"`
+	Limit   int64 `json:"limit,omitempty"`
+}
+
+func (e *RejectionError) Error() string {
+	switch e.Reason {
+	case ReasonUnrecognizedFormat:
+		return "file is not MP3, AAC, FLAC, Ogg or MP4 audio"
+	case ReasonUnsupportedCodec:
+		return fmt.Sprintf("%s audio is not accepted", e.Codec)
+	case ReasonCorrupt:
+		return fmt.Sprintf("%s file is damaged or truncated", e.Format)
+	case ReasonTooLarge:
+		return fmt.Sprintf("audio file exceeds %d MB", e.Limit>>20)
+	case ReasonBitrateTooHigh:
+		return fmt.Sprintf("bitrate %d kbit/s exceeds %d kbit/s", e.Bitrate, e.Limit)
+	}
+	return string(e.Reason)
+}
+
+// Status returns the HTTP status rejecting the upload
+func (e *RejectionError) Status() int {
+	switch e.Reason {
+	case ReasonUnrecognizedFormat, ReasonUnsupportedCodec:
+		return http.StatusUnsupportedMediaType
+	case ReasonTooLarge:
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusUnprocessableEntity
+}
+
+// Policy limits the audio an uploader may store
+type Policy struct {
+	Codecs []Codec `json:"codecs"`
+	// MaxSize is in bytes and can't exceed MaxUploadSize
+	MaxSize int64 `json:"max_size"`
+	// MaxBitrate is in kbit/s; 0 means unlimited
+	MaxBitrate int `json:"max_bitrate"`
+}
+
+// Check rejects a size-byte file described by info that p doesn't allow
+func (p Policy) Check(info *Info, size int64) error {
+	if size > p.MaxSize {
+		return &RejectionError{Reason: ReasonTooLarge, Size: size, Limit: p.MaxSize}
+	}
+	if !slices.Contains(p.Codecs, info.Codec) {
+		return &RejectionError{Reason: ReasonUnsupportedCodec, Format: info.Format, Codec: info.Codec, Allowed: p.Codecs}
+	}
+	if p.MaxBitrate > 0 && info.Bitrate > p.MaxBitrate {
+		return &RejectionError{Reason: ReasonBitrateTooHigh, Codec: info.Codec, Bitrate: info.Bitrate, Limit: int64(p.MaxBitrate)}
+	}
+	return nil
+}
+
+// Policies holds the upload policy of each API key tier
+type Policies struct {
+	// Default applies to uploads not made with an API key, and to tiers
+	// without a policy of their own
+	Default Policy
+	Tiers   map[apikey.Tier]Policy
+}
+
+// For returns the policy of the API key ctx's request was made with
+func (p Policies) For(ctx context.Context) Policy {
+	if k := apikeys.FromContext(ctx); k != nil {
+		if policy, ok := p.Tiers[k.Tier]; ok {
+			return policy
+		}
+	}
+	return p.Default
+}
+
+var allCodecs = []Codec{CodecMP3, CodecAAC, CodecFLAC, CodecVorbis, CodecOpus}
+
+// DefaultPolicies keeps lossless audio and large files to paid tiers
+func DefaultPolicies() Policies {
+	return Policies{
+		Default: Policy{Codecs: allCodecs, MaxSize: MaxUploadSize},
+		Tiers: map[apikey.Tier]Policy{
+			apikey.TierFree:       {Codecs: []Codec{CodecMP3, CodecAAC, CodecVorbis, CodecOpus}, MaxSize: 50 << 20, MaxBitrate: 320},
+			apikey.TierPro:        {Codecs: allCodecs, MaxSize: 200 << 20},
+			apikey.TierEnterprise: {Codecs: allCodecs, MaxSize: MaxUploadSize},
+		},
+	}
+}
+
+// ParsePolicies applies overrides of the form "tier.setting=value" to base,
+// separated by semicolons, where tier is default or an API key tier and
+// setting is codecs (comma-separated), max_size_mb or max_bitrate, e.g.
+// "free.codecs=mp3,aac;free.max_size_mb=20;pro.max_bitrate=320"
+func ParsePolicies(s string, base Policies) (Policies, error) {
+	p := Policies{Default: base.Default, Tiers: map[apikey.Tier]Policy{}}
+	for tier, policy := range base.Tiers {
+		p.Tiers[tier] = policy
+	}
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		tier, setting, dotted := strings.Cut(strings.TrimSpace(key), ".")
+		if !ok || !dotted {
+			return Policies{}, fmt.Errorf("audio: %q is not tier.setting=value", entry)
+		}
+		policy := p.Default
+		if tier != "default" {
+			if err := apikey.TierValidator(apikey.Tier(tier)); err != nil {
+				return Policies{}, fmt.Errorf("audio: %q: unknown tier %q", entry, tier)
+			}
+			if t, ok := p.Tiers[apikey.Tier(tier)]; ok {
+				policy = t
+			}
+		}
+
+		value = strings.TrimSpace(value)
+		switch setting {
+		case "codecs":
+			policy.Codecs = nil
+			for _, c := range strings.Split(value, ",") {
+				codec := Codec(strings.TrimSpace(c))
+				if !slices.Contains(allCodecs, codec) {
+					return Policies{}, fmt.Errorf("audio: %q: unknown codec %q", entry, codec)
+				}
+				policy.Codecs = append(policy.Codecs, codec)
+			}
+		case "max_size_mb":
+			mb, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || mb <= 0 || mb<<20 > MaxUploadSize {
+				return Policies{}, fmt.Errorf("audio: %q: max_size_mb must be between 1 and %d", entry, MaxUploadSize>>20)
+			}
+			policy.MaxSize = mb << 20
+		case "max_bitrate":
+			kbps, err := strconv.Atoi(value)
+			if err != nil || kbps < 0 {
+				return Policies{}, fmt.Errorf("audio: %q: max_bitrate must be a non-negative integer", entry)
+			}
+			policy.MaxBitrate = kbps
+		default:
+			return Policies{}, fmt.Errorf("audio: %q: unknown setting %q", entry, setting)
+		}
+
+		if tier == "default" {
+			p.Default = policy
+		} else {
+			p.Tiers[apikey.Tier(tier)] = policy
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,427 @@
+package audio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Codec is the encoding of the audio inside a file
+type Codec string
+
+const (
+	CodecMP3    Codec = "mp3"
+	CodecAAC    Codec = "aac"
+	CodecFLAC   Codec = "flac"
+	CodecVorbis Codec = "vorbis"
+	CodecOpus   Codec = "opus"
+)
+
+// Info describes an audio file as read from its headers
+type Info struct {
+	Format     Format `json:"format"`
+	Codec      Codec  `json:"codec"`
+	SampleRate int    `json:"sample_rate"`
+	Channels   int    `json:"channels"`
+	// Bitrate is the average bitrate in kbit/s, 0 when it can't be told
+	Bitrate int `json:"bitrate"`
+	// DurationMS is the length in milliseconds, 0 when it can't be told
+	DurationMS int64 `json:"duration_ms"`
+}
+
+// errCorrupt is returned by the parsers for files whose headers don't add up
+var errCorrupt = errors.New("corrupt stream")
+
+// Probe identifies the container and codec of the size-byte file in f from
+// its contents, ignoring whatever the client claimed. Files that aren't
+// recognized or whose headers are broken are rejected with a *RejectionError.
+func Probe(f io.ReaderAt, size int64) (*Info, error) {
+	br := bufio.NewReader(io.NewSectionReader(f, 0, size))
+	format := sniff(br)
+
+	var info *Info
+	var err error
+	switch format {
+	case FormatMP3:
+		info, err = probeMPEG(br, size)
+	case FormatAAC:
+		info, err = probeADTS(br, size)
+	case FormatFLAC:
+		info, err = probeFLAC(f, size)
+	case FormatOgg:
+		info, err = probeOgg(f, size)
+	case FormatMP4:
+		info, err = probeMP4(f, size)
+	default:
+		return nil, &RejectionError{Reason: ReasonUnrecognizedFormat}
+	}
+	if errors.Is(err, errCorrupt) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, &RejectionError{Reason: ReasonCorrupt, Format: format}
+	}
+	if err != nil {
+		return nil, err
+	}
+	info.Format = format
+	return info, nil
+}
+
+// averageKbps returns the bitrate of bytes of audio lasting samples/rate seconds
+func averageKbps(bytes, samples int64, rate int) int {
+	if samples <= 0 || rate <= 0 {
+		return 0
+	}
+	return int(bytes * 8 * int64(rate) / samples / 1000)
+}
+
+// durationMS returns the length of samples/rate seconds in milliseconds
+func durationMS(samples int64, rate int) int64 {
+	if samples <= 0 || rate <= 0 {
+		return 0
+	}
+	return samples * 1000 / int64(rate)
+}
+
+var (
+	mpeg1Layer3Kbps = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mpeg2Layer3Kbps = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	mpegSampleRates = [4][3]int{
+		{11025, 12000, 8000},  // MPEG 2.5
+		{},                    // reserved
+		{22050, 24000, 16000}, // MPEG 2
+		{44100, 48000, 32000}, // MPEG 1
+	}
+)
+
+// mpegFrame is a parsed MPEG audio frame header
+type mpegFrame struct {
+	version    byte
+	sampleRate int
+	channels   int
+	length     int
+	samples    int
+}
+
+// parseMPEGFrame parses the 4-byte header of an MPEG-1/2/2.5 Layer III frame
+func parseMPEGFrame(h []byte) (mpegFrame, bool) {
+	if len(h) < 4 || h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return mpegFrame{}, false
+	}
+	version := h[1] >> 3 & 3
+	layer := h[1] >> 1 & 3
+	rateIndex := h[2] >> 2 & 3
+	if version == 1 || layer != 1 || rateIndex == 3 {
+		return mpegFrame{}, false
+	}
+	f := mpegFrame{version: version, sampleRate: mpegSampleRates[version][rateIndex], channels: 2}
+	if h[3]>>6 == 3 {
+		f.channels = 1
+	}
+	padding := int(h[2] >> 1 & 1)
+	if version == 3 {
+		kbps := mpeg1Layer3Kbps[h[2]>>4]
+		f.length, f.samples = 144*kbps*1000/f.sampleRate+padding, 1152
+	} else {
+		kbps := mpeg2Layer3Kbps[h[2]>>4]
+		f.length, f.samples = 72*kbps*1000/f.sampleRate+padding, 576
+	}
+	if f.length < 4 { // free-format or invalid bitrate
+		return mpegFrame{}, false
+	}
+	return f, true
+}
+
+// probeMPEG walks the frames of an MP3 file. Trailing bytes that aren't
+// frames, such as an ID3v1 tag, end the walk.
+func probeMPEG(br *bufio.Reader, size int64) (*Info, error) {
+	if err := skipID3(br); err != nil {
+		return nil, err
+	}
+	var first mpegFrame
+	var frames, samples, audioBytes int64
+	for {
+		h, _ := br.Peek(4)
+		f, ok := parseMPEGFrame(h)
+		if !ok || (frames > 0 && (f.version != first.version || f.sampleRate != first.sampleRate)) {
+			break
+		}
+		if frames == 0 {
+			first = f
+		}
+		if _, err := br.Discard(f.length); err != nil {
+			break // a truncated last frame is common and harmless
+		}
+		frames++
+		samples += int64(f.samples)
+		audioBytes += int64(f.length)
+	}
+	if frames < 2 && audioBytes < size/2 {
+		return nil, errCorrupt
+	}
+	return &Info{
+		Codec:      CodecMP3,
+		SampleRate: first.sampleRate,
+		Channels:   first.channels,
+		Bitrate:    averageKbps(audioBytes, samples, first.sampleRate),
+		DurationMS: durationMS(samples, first.sampleRate),
+	}, nil
+}
+
+var adtsSampleRates = [16]int{96000, 88200, 64000, 48000, 44100, 32000, 24000, 22050, 16000, 12000, 11025, 8000, 7350}
+
+// probeADTS walks the frames of a raw AAC (ADTS) stream
+func probeADTS(br *bufio.Reader, size int64) (*Info, error) {
+	var rate, channels int
+	var frames, samples, audioBytes int64
+	for {
+		h, _ := br.Peek(7)
+		if len(h) < 7 || h[0] != 0xFF || h[1]&0xF6 != 0xF0 {
+			break
+		}
+		r := adtsSampleRates[h[2]>>2&0xF]
+		length := int(h[3]&3)<<11 | int(h[4])<<3 | int(h[5]>>5)
+		if r == 0 || length < 7 || (frames > 0 && r != rate) {
+			break
+		}
+		if frames == 0 {
+			rate, channels = r, int(h[2]&1)<<2|int(h[3]>>6)
+		}
+		if _, err := br.Discard(length); err != nil {
+			break
+		}
+		frames++
+		samples += 1024 * int64(h[6]&3+1)
+		audioBytes += int64(length)
+	}
+	if frames < 2 && audioBytes < size/2 {
+		return nil, errCorrupt
+	}
+	return &Info{
+		Codec:      CodecAAC,
+		SampleRate: rate,
+		Channels:   channels,
+		Bitrate:    averageKbps(audioBytes, samples, rate),
+		DurationMS: durationMS(samples, rate),
+	}, nil
+}
+
+// probeFLAC reads the STREAMINFO block that must follow the "fLaC" marker
+func probeFLAC(f io.ReaderAt, size int64) (*Info, error) {
+	b := make([]byte, 42)
+	if _, err := f.ReadAt(b, 0); err != nil {
+		return nil, err
+	}
+	// Metadata block header: last-block flag and type 0 (STREAMINFO), length 34
+	if b[4]&0x7F != 0 || b[5] != 0 || b[6] != 0 || b[7] != 34 {
+		return nil, errCorrupt
+	}
+	si := b[8:]
+	rate := int(si[10])<<12 | int(si[11])<<4 | int(si[12]>>4)
+	channels := int(si[12]>>1&7) + 1
+	total := int64(si[13]&0xF)<<32 | int64(binary.BigEndian.Uint32(si[14:18]))
+	if rate == 0 {
+		return nil, errCorrupt
+	}
+	return &Info{
+		Codec:      CodecFLAC,
+		SampleRate: rate,
+		Channels:   channels,
+		Bitrate:    averageKbps(size, total, rate),
+		DurationMS: durationMS(total, rate),
+	}, nil
+}
+
+// probeOgg reads the identification header in the first page and the
+// granule position of the last page, which gives the duration
+func probeOgg(f io.ReaderAt, size int64) (*Info, error) {
+	head := make([]byte, 27+255+19)
+	n, err := f.ReadAt(head, 0)
+	if n < 28 {
+		return nil, err
+	}
+	head = head[:n]
+	if string(head[:4]) != "OggS" || 27+int(head[26]) > n {
+		return nil, errCorrupt
+	}
+	packet := head[27+int(head[26]):]
+
+	info := &Info{}
+	var granuleRate int
+	switch {
+	case len(packet) >= 16 && string(packet[:7]) == "\x01vorbis":
+		info.Codec = CodecVorbis
+		info.Channels = int(packet[11])
+		info.SampleRate = int(binary.LittleEndian.Uint32(packet[12:16]))
+		granuleRate = info.SampleRate
+	case len(packet) >= 16 && string(packet[:8]) == "OpusHead":
+		info.Codec = CodecOpus
+		info.Channels = int(packet[9])
+		info.SampleRate = int(binary.LittleEndian.Uint32(packet[12:16]))
+		granuleRate = 48000 // Opus granules always count 48 kHz samples
+	case len(packet) >= 5 && string(packet[:5]) == "\x7fFLAC":
+		info.Codec = CodecFLAC
+	default:
+		return nil, errCorrupt
+	}
+	if info.Channels == 0 && info.Codec != CodecFLAC {
+		return nil, errCorrupt
+	}
+
+	// The last page starts within the final 64 KiB
+	tail := int64(64 << 10)
+	if tail > size {
+		tail = size
+	}
+	buf := make([]byte, tail)
+	if _, err := f.ReadAt(buf, size-tail); err != nil && err != io.EOF {
+		return nil, err
+	}
+	if i := bytes.LastIndex(buf, []byte("OggS")); i >= 0 && len(buf)-i >= 14 {
+		granule := int64(binary.LittleEndian.Uint64(buf[i+6 : i+14]))
+		info.Bitrate = averageKbps(size, granule, granuleRate)
+		info.DurationMS = durationMS(granule, granuleRate)
+	}
+	return info, nil
+}
+
+// mp4Codecs maps MP4 sample entry types to codecs
+var mp4Codecs = map[string]Codec{
+	"mp4a": CodecAAC,
+	"fLaC": CodecFLAC,
+	"Opus": CodecOpus,
+	".mp3": CodecMP3,
+}
+
+// box is an ISO base media box: its payload spans [start, end)
+type box struct {
+	typ        string
+	start, end int64
+}
+
+// children lists the boxes between start and end
+func children(f io.ReaderAt, start, end int64) ([]box, error) {
+	var boxes []box
+	h := make([]byte, 16)
+	for pos := start; pos+8 <= end; {
+		if _, err := f.ReadAt(h[:8], pos); err != nil {
+			return nil, err
+		}
+		size, header := int64(binary.BigEndian.Uint32(h[:4])), int64(8)
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			if _, err := f.ReadAt(h[8:16], pos+8); err != nil {
+				return nil, err
+			}
+			size, header = int64(binary.BigEndian.Uint64(h[8:16])), 16
+		}
+		if size < header || pos+size > end {
+			return nil, errCorrupt
+		}
+		boxes = append(boxes, box{typ: string(h[4:8]), start: pos + header, end: pos + size})
+		pos += size
+	}
+	return boxes, nil
+}
+
+// child returns the first box of type typ between start and end
+func child(f io.ReaderAt, start, end int64, typ string) (box, error) {
+	boxes, err := children(f, start, end)
+	if err != nil {
+		return box{}, err
+	}
+	for _, b := range boxes {
+		if b.typ == typ {
+			return b, nil
+		}
+	}
+	return box{}, errCorrupt
+}
+
+// path follows nested box types down from b
+func path(f io.ReaderAt, b box, types ...string) (box, error) {
+	var err error
+	for _, typ := range types {
+		if b, err = child(f, b.start, b.end, typ); err != nil {
+			return box{}, err
+		}
+	}
+	return b, nil
+}
+
+// probeMP4 finds the first sound track of an MP4 file and reads the codec
+// from its sample description and the duration from its media header
+func probeMP4(f io.ReaderAt, size int64) (*Info, error) {
+	moov, err := child(f, 0, size, "moov")
+	if err != nil {
+		return nil, err
+	}
+	traks, err := children(f, moov.start, moov.end)
+	if err != nil {
+		return nil, err
+	}
+	for _, trak := range traks {
+		if trak.typ != "trak" {
+			continue
+		}
+		hdlr, err := path(f, trak, "mdia", "hdlr")
+		if err != nil {
+			return nil, err
+		}
+		handler := make([]byte, 4)
+		if _, err := f.ReadAt(handler, hdlr.start+8); err != nil {
+			return nil, err
+		}
+		if string(handler) != "soun" {
+			continue
+		}
+
+		stsd, err := path(f, trak, "mdia", "minf", "stbl", "stsd")
+		if err != nil {
+			return nil, err
+		}
+		// Version and flags, entry count, then the first audio sample entry
+		entry := make([]byte, 8+8+28)
+		if stsd.end-stsd.start < int64(len(entry)) {
+			return nil, errCorrupt
+		}
+		if _, err := f.ReadAt(entry, stsd.start); err != nil {
+			return nil, err
+		}
+		typ := string(entry[12:16])
+		codec, ok := mp4Codecs[typ]
+		if !ok {
+			codec = Codec(bytes.TrimSpace(entry[12:16]))
+		}
+		info := &Info{
+			Codec:      codec,
+			Channels:   int(binary.BigEndian.Uint16(entry[32:34])),
+			SampleRate: int(binary.BigEndian.Uint16(entry[40:42])), // 16.16 fixed point
+		}
+
+		mdhd, err := path(f, trak, "mdia", "mdhd")
+		if err != nil {
+			return nil, err
+		}
+		m := make([]byte, min(32, mdhd.end-mdhd.start))
+		if _, err := f.ReadAt(m, mdhd.start); err != nil {
+			return nil, err
+		}
+		if len(m) < 20 || (m[0] == 1 && len(m) < 32) {
+			return nil, errCorrupt
+		}
+		var timescale int
+		var duration int64
+		if m[0] == 1 {
+			timescale, duration = int(binary.BigEndian.Uint32(m[20:24])), int64(binary.BigEndian.Uint64(m[24:32]))
+		} else {
+			timescale, duration = int(binary.BigEndian.Uint32(m[12:16])), int64(binary.BigEndian.Uint32(m[16:20]))
+		}
+		info.Bitrate = averageKbps(size, duration, timescale)
+		info.DurationMS = durationMS(duration, timescale)
+		return info, nil
+	}
+	return nil, errCorrupt
+}
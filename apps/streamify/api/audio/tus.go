@@ -0,0 +1,526 @@
+package audio
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/schema/rule"
+	"streamify/ent/track"
+	"streamify/ent/uploadsession"
+	"streamify/ids"
+	"streamify/storage"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// TusVersion is the version of the tus resumable upload protocol served
+	TusVersion = "1.0.0"
+	// tusExtensions lists the tus extensions supported
+	tusExtensions = "creation,expiration,termination"
+
+	// SessionTTL is how long an upload session lives without receiving data
+	SessionTTL = 24 * time.Hour
+
+	// partsPrefix is where the parts of resumable uploads are stored
+	partsPrefix = "uploads/"
+)
+
+// Sessions serves resumable track audio uploads over the tus protocol
+// (https://tus.io/protocols/resumable-upload). Each PATCH is stored as a
+// separate part; once the last byte arrives the parts are handed to the
+// Uploader as one file and removed.
+type Sessions struct {
+	client   *ent.Client
+	store    storage.Storage
+	uploader *Uploader
+}
+
+// NewSessions returns Sessions storing parts in store and finishing uploads with u
+func NewSessions(client *ent.Client, store storage.Storage, u *Uploader) *Sessions {
+	return &Sessions{client: client, store: store, uploader: u}
+}
+
+// partKey returns the storage key of a part starting at offset. The random
+// suffix keeps racing PATCHes for the same offset from overwriting each other.
+func partKey(id uuid.UUID, offset int64) string {
+	return fmt.Sprintf("%s%s/%020d-%s", partsPrefix, id, offset, uuid.NewString())
+}
+
+// partOffset parses the offset out of a part key
+func partOffset(key string) (int64, bool) {
+	name := key[strings.LastIndexByte(key, '/')+1:]
+	n, _, _ := strings.Cut(name, "-")
+	offset, err := strconv.ParseInt(n, 10, 64)
+	return offset, err == nil
+}
+
+// tus sets the headers every tus response carries and rejects requests for
+// another protocol version
+func tus(c *gin.Context) bool {
+	c.Header("Tus-Resumable", TusVersion)
+	if c.Request.Method == http.MethodOptions {
+		return true
+	}
+	if v := c.GetHeader("Tus-Resumable"); v != TusVersion {
+		c.Header("Tus-Version", TusVersion)
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": "unsupported tus version " + strconv.Quote(v)})
+		return false
+	}
+	return true
+}
+
+// Options describes the server's tus support
+func (s *Sessions) Options(c *gin.Context) {
+	tus(c)
+	c.Header("Tus-Version", TusVersion)
+	c.Header("Tus-Extension", tusExtensions)
+	c.Header("Tus-Max-Size", strconv.FormatInt(s.uploader.Policy(c.Request.Context()).MaxSize, 10))
+	c.Status(http.StatusNoContent)
+}
+
+// parseMetadata decodes an Upload-Metadata header: comma-separated pairs of a
+// key and an optional base64 value
+func parseMetadata(h string) (map[string]string, error) {
+	meta := map[string]string{}
+	for _, pair := range strings.Split(h, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, encoded, _ := strings.Cut(pair, " ")
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("metadata %q is not base64", key)
+		}
+		meta[key] = string(value)
+	}
+	return meta, nil
+}
+
+// Create opens an upload session (admin). The track is named by the track_id
+// key of Upload-Metadata; the new session's URL is returned in Location.
+func (s *Sessions) Create(c *gin.Context) {
+	if !tus(c) {
+		return
+	}
+	ctx := c.Request.Context()
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+	if !viewer.FromContext(ctx).IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+		return
+	}
+
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	switch {
+	case err != nil || length < 0:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Length must be a non-negative integer"})
+		return
+	case length == 0:
+		c.JSON(http.StatusBadRequest, gin.H{"error": ErrEmpty.Error()})
+		return
+	}
+	if limit := s.uploader.Policy(ctx).MaxSize; length > limit {
+		writeUploadError(c, &RejectionError{Reason: ReasonTooLarge, Size: length, Limit: limit})
+		return
+	}
+	meta, err := parseMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	trackID, err := uuid.Parse(meta["track_id"])
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Metadata must carry a valid track_id"})
+		return
+	}
+	exists, err := s.client.Track.Query().Where(track.IDEQ(trackID), track.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, gin.H{"error": "track not found"})
+		return
+	}
+
+	session, err := s.client.UploadSession.Create().
+		SetUserID(userID).
+		SetTrackID(trackID).
+		SetLength(length).
+		SetExpiresAt(time.Now().Add(SessionTTL)).
+		Save(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	logger.Info("upload session opened", "session_id", session.ID, "track_id", trackID, "length", length)
+
+	c.Header("Location", strings.TrimSuffix(c.Request.URL.Path, "/")+"/"+session.ID.String())
+	c.Header("Upload-Expires", session.ExpiresAt.UTC().Format(http.TimeFormat))
+	c.JSON(http.StatusCreated, session)
+}
+
+// load returns the caller's session named in the URL, writing the error
+// response and returning nil when there is none
+func (s *Sessions) load(c *gin.Context) *ent.UploadSession {
+	ctx := c.Request.Context()
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return nil
+	}
+	if !viewer.FromContext(ctx).IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+		return nil
+	}
+	id, err := ids.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid upload ID"})
+		return nil
+	}
+	session, err := s.client.UploadSession.Query().
+		Where(uploadsession.IDEQ(id), uploadsession.UserIDEQ(userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "upload not found"})
+		return nil
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil
+	}
+	return session
+}
+
+// gone reports whether session can no longer receive data
+func gone(session *ent.UploadSession) bool {
+	return session.Status == uploadsession.StatusFailed ||
+		(session.Status == uploadsession.StatusActive && time.Now().After(session.ExpiresAt))
+}
+
+// Head reports how much of an upload has been received
+func (s *Sessions) Head(c *gin.Context) {
+	if !tus(c) {
+		return
+	}
+	session := s.load(c)
+	if session == nil {
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	if gone(session) {
+		c.Status(http.StatusGone)
+		return
+	}
+	c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(session.Length, 10))
+	if session.Status == uploadsession.StatusActive {
+		c.Header("Upload-Expires", session.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	c.Status(http.StatusOK)
+}
+
+// Patch appends the request body to an upload at Upload-Offset. Whatever was
+// received is kept when the client disconnects mid-request, so it can resume
+// from the offset HEAD reports. The request carrying the last byte finishes
+// the upload, answering like UploadAudio does on failure; when that fails
+// for a transient reason an empty PATCH at the full length retries it.
+func (s *Sessions) Patch(c *gin.Context) {
+	if !tus(c) {
+		return
+	}
+	if ct, _, _ := strings.Cut(c.ContentType(), ";"); ct != "application/offset+octet-stream" {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Content-Type must be application/offset+octet-stream"})
+		return
+	}
+	session := s.load(c)
+	if session == nil {
+		return
+	}
+	switch {
+	case gone(session):
+		c.JSON(http.StatusGone, gin.H{"error": "upload expired or failed", "reason": session.Error})
+		return
+	case session.Status == uploadsession.StatusCompleted:
+		c.JSON(http.StatusConflict, gin.H{"error": "upload already completed"})
+		return
+	}
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Upload-Offset must be an integer"})
+		return
+	}
+	if offset != session.Offset {
+		c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+		c.JSON(http.StatusConflict, gin.H{"error": "Upload-Offset does not match the upload's offset"})
+		return
+	}
+	remaining := session.Length - offset
+	if c.Request.ContentLength > remaining {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body exceeds Upload-Length"})
+		return
+	}
+
+	// Not canceled with the request, so a part cut short by a disconnect is still saved
+	ctx := context.WithoutCancel(c.Request.Context())
+	n, readErr, err := s.appendPart(ctx, session, c.Request.Body, remaining)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if n > remaining {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body exceeds Upload-Length"})
+		return
+	}
+	if n > 0 {
+		expiresAt := time.Now().Add(SessionTTL)
+		updated, err := s.client.UploadSession.Update().
+			Where(
+				uploadsession.IDEQ(session.ID),
+				uploadsession.OffsetEQ(offset),
+				uploadsession.StatusEQ(uploadsession.StatusActive),
+			).
+			SetOffset(offset + n).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if updated == 0 {
+			// Another request for the same offset got there first; its part
+			// wins and this one is removed by the cleanup job
+			c.JSON(http.StatusConflict, gin.H{"error": "Upload-Offset does not match the upload's offset"})
+			return
+		}
+		session.Offset, session.ExpiresAt = offset+n, expiresAt
+	}
+	if readErr != nil {
+		logger.Warn("upload part interrupted", "session_id", session.ID, "offset", session.Offset, "error", readErr)
+		c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "reading request body: " + readErr.Error()})
+		return
+	}
+
+	if session.Offset == session.Length {
+		res, err := s.finish(ctx, session)
+		if err != nil {
+			writeUploadError(c, err)
+			return
+		}
+		c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+		c.JSON(http.StatusOK, res)
+		return
+	}
+	c.Header("Upload-Offset", strconv.FormatInt(session.Offset, 10))
+	c.Header("Upload-Expires", session.ExpiresAt.UTC().Format(http.TimeFormat))
+	c.Status(http.StatusNoContent)
+}
+
+// appendPart stores up to remaining bytes of r as a part of session and
+// returns how many it read, remaining+1 meaning r held more and nothing was
+// stored. Reading stops at the first error, returned as readErr so that the
+// bytes before it are still stored.
+func (s *Sessions) appendPart(ctx context.Context, session *ent.UploadSession, r io.Reader, remaining int64) (n int64, readErr, err error) {
+	tmp, err := os.CreateTemp("", "streamify-part-*")
+	if err != nil {
+		return 0, nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	n, readErr = io.Copy(tmp, io.LimitReader(r, remaining+1))
+	if n == 0 || n > remaining {
+		return n, readErr, nil
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, nil, err
+	}
+	if err := s.store.Put(ctx, partKey(session.ID, session.Offset), tmp); err != nil {
+		return 0, nil, fmt.Errorf("storing upload part: %w", err)
+	}
+	return n, readErr, nil
+}
+
+// finish hands the assembled parts to the Uploader. Uploads the Uploader
+// rejects fail the session; on other errors it stays open to be retried.
+func (s *Sessions) finish(ctx context.Context, session *ent.UploadSession) (*Result, error) {
+	keys, err := s.parts(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.uploader.Upload(ctx, session.TrackID, &partsReader{ctx: ctx, store: s.store, keys: keys})
+	if err != nil {
+		var dup *DuplicateError
+		var rejected *RejectionError
+		if errors.As(err, &dup) || errors.As(err, &rejected) || errors.Is(err, ErrEmpty) || errors.Is(err, ErrTooLarge) || ent.IsNotFound(err) {
+			if uerr := s.client.UploadSession.UpdateOneID(session.ID).
+				SetStatus(uploadsession.StatusFailed).
+				SetError(err.Error()).
+				Exec(ctx); uerr != nil {
+				logger.Error("failing upload session", "session_id", session.ID, "error", uerr)
+			}
+			s.removeParts(ctx, session.ID)
+		}
+		return nil, err
+	}
+
+	if err := s.client.UploadSession.UpdateOneID(session.ID).
+		SetStatus(uploadsession.StatusCompleted).
+		Exec(ctx); err != nil {
+		logger.Error("completing upload session", "session_id", session.ID, "error", err)
+	}
+	s.removeParts(ctx, session.ID)
+	logger.Info("resumable upload completed", "session_id", session.ID, "track_id", session.TrackID)
+	return res, nil
+}
+
+// parts returns the keys of the parts making up session's bytes in order.
+// Parts left over from lost races are skipped.
+func (s *Sessions) parts(ctx context.Context, session *ent.UploadSession) ([]string, error) {
+	objects, err := s.store.List(ctx, partsPrefix+session.ID.String()+"/")
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	var pos int64
+	for _, o := range objects {
+		if offset, ok := partOffset(o.Key); ok && offset == pos {
+			keys = append(keys, o.Key)
+			pos += o.Size
+		}
+	}
+	if pos != session.Length {
+		return nil, fmt.Errorf("upload %s: parts cover %d of %d bytes", session.ID, pos, session.Length)
+	}
+	return keys, nil
+}
+
+// removeParts deletes every stored part of an upload, logging failures
+func (s *Sessions) removeParts(ctx context.Context, id uuid.UUID) {
+	objects, err := s.store.List(ctx, partsPrefix+id.String()+"/")
+	if err != nil {
+		logger.Error("listing upload parts", "session_id", id, "error", err)
+		return
+	}
+	for _, o := range objects {
+		if err := s.store.Delete(ctx, o.Key); err != nil && !storage.IsNotFound(err) {
+			logger.Error("deleting upload part", "key", o.Key, "error", err)
+		}
+	}
+}
+
+// partsReader reads stored parts one after another
+type partsReader struct {
+	ctx   context.Context
+	store storage.Storage
+	keys  []string
+	cur   io.ReadCloser
+}
+
+func (r *partsReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			rc, err := r.store.Get(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, err
+			}
+			r.cur, r.keys = rc, r.keys[1:]
+		}
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Terminate abandons an upload and deletes what was received
+func (s *Sessions) Terminate(c *gin.Context) {
+	if !tus(c) {
+		return
+	}
+	session := s.load(c)
+	if session == nil {
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.client.UploadSession.DeleteOneID(session.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	s.removeParts(ctx, session.ID)
+	c.Status(http.StatusNoContent)
+}
+
+// Cleanup deletes expired sessions and any stored parts not belonging to a
+// live one, such as those of abandoned uploads
+func (s *Sessions) Cleanup(ctx context.Context) error {
+	ctx = rule.SystemContext(ctx)
+	expired, err := s.client.UploadSession.Delete().
+		Where(uploadsession.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	objects, err := s.store.List(ctx, partsPrefix)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		if expired > 0 {
+			logger.Info("expired upload sessions removed", "sessions", expired)
+		}
+		return nil
+	}
+	live, err := s.client.UploadSession.Query().
+		Where(uploadsession.StatusEQ(uploadsession.StatusActive)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	keep := map[string]bool{}
+	for _, id := range live {
+		keep[id.String()] = true
+	}
+	parts := 0
+	for _, o := range objects {
+		id, _, _ := strings.Cut(strings.TrimPrefix(o.Key, partsPrefix), "/")
+		if keep[id] {
+			continue
+		}
+		if err := s.store.Delete(ctx, o.Key); err != nil && !storage.IsNotFound(err) {
+			return err
+		}
+		parts++
+	}
+	if expired > 0 || parts > 0 {
+		logger.Info("abandoned uploads removed", "sessions", expired, "parts", parts)
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package audio
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"streamify/ent"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/track"
+	"streamify/logging"
+	"streamify/residency"
+	"streamify/storage"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("audio")
+
+const (
+	// MaxUploadSize caps the size of an uploaded audio file
+	MaxUploadSize = 512 << 20
+
+	// RejectSimilarity is the similarity from which an upload is taken to be
+	// another track's recording and rejected
+	RejectSimilarity = 0.9
+	// FlagSimilarity is the similarity from which an upload is queued for review
+	FlagSimilarity = 0.75
+
+	// durationSlack is how many seconds apart near-match candidates can be
+	durationSlack = 5
+	// maxCandidates caps how many near-match candidates are compared
+	maxCandidates = 500
+)
+
+var (
+	// ErrTooLarge is returned for uploads over MaxUploadSize
+	ErrTooLarge = fmt.Errorf("audio file exceeds %d MB", MaxUploadSize>>20)
+	// ErrEmpty is returned for empty uploads
+	ErrEmpty = errors.New("audio file is empty")
+)
+
+// DuplicateError is returned when an upload duplicates another track's audio
+type DuplicateError struct {
+	MatchTrackID uuid.UUID `json:"match_track_id"`
+	Similarity   float64   `json:"similarity"`
+	// Exact is set when the files are byte-identical
+	Exact bool `json:"exact"`
+}
+
+func (e *DuplicateError) Error() string {
+	if e.Exact {
+		return fmt.Sprintf("audio is identical to track %s", e.MatchTrackID)
+	}
+	return fmt.Sprintf("audio matches track %s (similarity %.2f)", e.MatchTrackID, e.Similarity)
+}
+
+// Key returns the storage key of a track's audio
+func Key(trackID uuid.UUID) string {
+	return "audio/tracks/" + trackID.String()
+}
+
+// Uploader stores track audio after checking it against the catalog
+type Uploader struct {
+	client *ent.Client
+	store  storage.Storage
+	// fp may be nil, in which case only byte-identical duplicates are caught
+	fp       Fingerprinter
+	policies Policies
+}
+
+// NewUploader returns an Uploader writing to store and accepting the audio
+// policies allow. fp may be nil.
+func NewUploader(client *ent.Client, store storage.Storage, fp Fingerprinter, policies Policies) *Uploader {
+	return &Uploader{client: client, store: store, fp: fp, policies: policies}
+}
+
+// Policy returns the policy uploads made with ctx are held to
+func (u *Uploader) Policy(ctx context.Context) Policy {
+	return u.policies.For(ctx)
+}
+
+// Result describes an accepted upload
+type Result struct {
+	Track *ent.Track `json:"track"`
+	// Audio describes the stored file
+	Audio *Info `json:"audio"`
+	// Fingerprinted is false when the audio couldn't be fingerprinted and only
+	// byte-identical duplicates were checked
+	Fingerprinted bool `json:"fingerprinted"`
+	// Flagged lists the reviews opened for tracks the upload resembles
+	Flagged []*ent.DuplicateReview `json:"flagged"`
+}
+
+// match is an existing track an upload resembles
+type match struct {
+	trackID    uuid.UUID
+	similarity float64
+}
+
+// Upload stores r as the audio of track trackID, replacing any earlier upload.
+// Files that aren't audio the uploader's Policy accepts are rejected with a
+// *RejectionError, going by their contents alone. Uploads matching another track's audio are rejected with a *DuplicateError,
+// unless the track is a version of the same recording (see catalog versions);
+// weaker matches and matching versions are accepted and queued for review.
+func (u *Uploader) Upload(ctx context.Context, trackID uuid.UUID, r io.Reader) (*Result, error) {
+	t, err := u.client.Track.Query().
+		Where(track.IDEQ(trackID), track.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "streamify-audio-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case n == 0:
+		return nil, ErrEmpty
+	case n > MaxUploadSize:
+		return nil, ErrTooLarge
+	}
+	info, err := Probe(tmp, n)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Policy(ctx).Check(info, n); err != nil {
+		return nil, err
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	if err := u.checkExact(ctx, trackID, sum); err != nil {
+		return nil, err
+	}
+
+	var print *Print
+	if u.fp != nil {
+		if print, err = u.fp.Fingerprint(ctx, tmp.Name()); err != nil {
+			logger.Warn("fingerprinting failed; checking exact duplicates only", "track_id", trackID, "error", err)
+			print = nil
+		}
+	}
+	var matches []match
+	if print != nil && len(print.Raw) > 0 {
+		if matches, err = u.nearMatches(ctx, t, print); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	key := Key(trackID)
+	if err := u.store.Put(ctx, key, tmp); err != nil {
+		return nil, fmt.Errorf("storing audio: %w", err)
+	}
+
+	res, err := u.save(ctx, trackID, key, sum, info.DurationMS, print, matches)
+	if err != nil {
+		return nil, err
+	}
+	res.Audio = info
+	logger.Info("track audio uploaded", "track_id", trackID, "size", n, "codec", info.Codec, "bitrate", info.Bitrate,
+		"fingerprinted", res.Fingerprinted, "flagged", len(res.Flagged))
+	return res, nil
+}
+
+// checkExact rejects audio byte-identical to another live track's
+func (u *Uploader) checkExact(ctx context.Context, trackID uuid.UUID, sum string) error {
+	fp, err := u.client.AudioFingerprint.Query().
+		Where(
+			audiofingerprint.Sha256EQ(sum),
+			audiofingerprint.TrackIDNEQ(trackID),
+			audiofingerprint.HasTrackWith(track.DeletedAtIsNil()),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &DuplicateError{MatchTrackID: fp.TrackID, Similarity: 1, Exact: true}
+}
+
+// nearMatches compares print with live tracks of about the same length. A
+// strong match with a track outside t's version group is returned as a
+// *DuplicateError; every other match above FlagSimilarity is returned.
+func (u *Uploader) nearMatches(ctx context.Context, t *ent.Track, print *Print) ([]match, error) {
+	candidates, err := u.client.AudioFingerprint.Query().
+		Where(
+			audiofingerprint.TrackIDNEQ(t.ID),
+			audiofingerprint.DurationGTE(print.Duration-durationSlack),
+			audiofingerprint.DurationLTE(print.Duration+durationSlack),
+			audiofingerprint.FingerprintNotNil(),
+			audiofingerprint.HasTrackWith(track.DeletedAtIsNil()),
+		).
+		WithTrack().
+		Limit(maxCandidates).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group := versionGroup(t)
+	var matches []match
+	var dup *DuplicateError
+	for _, c := range candidates {
+		s := Similarity(print.Raw, decode(c.Fingerprint))
+		if s < FlagSimilarity {
+			continue
+		}
+		sameRecording := c.Edges.Track != nil && versionGroup(c.Edges.Track) == group
+		if s >= RejectSimilarity && !sameRecording {
+			if dup == nil || s > dup.Similarity {
+				dup = &DuplicateError{MatchTrackID: c.TrackID, Similarity: s}
+			}
+			continue
+		}
+		matches = append(matches, match{trackID: c.TrackID, similarity: s})
+	}
+	if dup != nil {
+		return nil, dup
+	}
+	return matches, nil
+}
+
+// versionGroup identifies the original recording t is a version of
+func versionGroup(t *ent.Track) uuid.UUID {
+	if t.CanonicalTrackID != nil {
+		return *t.CanonicalTrackID
+	}
+	return t.ID
+}
+
+// save records the upload's fingerprint, points the track at key, sets its
+// length and opens reviews for matches, replacing what an earlier upload
+// recorded. Lengths the headers don't tell are taken from the fingerprint.
+func (u *Uploader) save(ctx context.Context, trackID uuid.UUID, key, sum string, durationMS int64, print *Print, matches []match) (*Result, error) {
+	// The catalog is kept in the home database
+	ctx = residency.Home(ctx)
+	tx, err := u.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.AudioFingerprint.Delete().Where(audiofingerprint.TrackIDEQ(trackID)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.DuplicateReview.Delete().
+		Where(duplicatereview.TrackIDEQ(trackID), duplicatereview.StatusEQ(duplicatereview.StatusPending)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	create := tx.AudioFingerprint.Create().
+		SetTrackID(trackID).
+		SetSha256(sum)
+	if print != nil {
+		create.SetDuration(max(print.Duration, 0)).SetFingerprint(encode(print.Raw))
+	}
+	if err := create.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if durationMS == 0 && print != nil {
+		durationMS = int64(max(print.Duration, 0)) * 1000
+	}
+	update := tx.Track.UpdateOneID(trackID).SetAudioKey(key)
+	if durationMS > 0 {
+		update.SetDurationMs(durationMS)
+	} else {
+		update.ClearDurationMs()
+	}
+	t, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pairs an admin already judged aren't queued again
+	reviewed, err := tx.DuplicateReview.Query().
+		Where(duplicatereview.TrackIDEQ(trackID)).
+		Select(duplicatereview.FieldMatchTrackID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	skip := map[uuid.UUID]bool{}
+	for _, r := range reviewed {
+		skip[r.MatchTrackID] = true
+	}
+	flagged := []*ent.DuplicateReview{}
+	for _, m := range matches {
+		if skip[m.trackID] {
+			continue
+		}
+		r, err := tx.DuplicateReview.Create().
+			SetTrackID(trackID).
+			SetMatchTrackID(m.trackID).
+			SetSimilarity(m.similarity).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		flagged = append(flagged, r)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &Result{Track: t, Fingerprinted: print != nil, Flagged: flagged}, nil
+}
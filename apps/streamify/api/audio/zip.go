@@ -0,0 +1,114 @@
+package audio
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/storage"
+)
+
+// Album is an album's metadata and the tracks with audio to download
+type Album struct {
+	*ent.Album
+	ArtistName string
+	// Tracks are in disc and track order, each with an AudioKey
+	Tracks []*ent.Track
+}
+
+// WriteZip streams a ZIP of a's audio files to w, one file at a time, without
+// buffering whole files. MP3s get an ID3 tag generated from the catalog in
+// place of their own; other formats are copied untouched. Files are stored
+// uncompressed since audio doesn't compress further.
+func WriteZip(ctx context.Context, w io.Writer, store storage.Storage, a Album) error {
+	discs := 1
+	perDisc := map[int]int{}
+	for _, t := range a.Tracks {
+		discs = max(discs, t.DiscNumber)
+		perDisc[t.DiscNumber]++
+	}
+	year := 0
+	if a.ReleaseDate != nil {
+		year = a.ReleaseDate.Year()
+	}
+
+	zw := zip.NewWriter(w)
+	seen := map[int]int{}
+	for _, t := range a.Tracks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		seen[t.DiscNumber]++
+		number := t.TrackNumber
+		if number == 0 {
+			number = seen[t.DiscNumber]
+		}
+		tags := Tags{
+			Title:  t.Title,
+			Artist: a.ArtistName,
+			Album:  a.Title,
+			Year:   year,
+			Track:  number,
+			Tracks: perDisc[t.DiscNumber],
+			Disc:   t.DiscNumber,
+			Discs:  discs,
+			Label:  a.Label,
+		}
+		if err := writeTrack(ctx, zw, store, t, tags, discs > 1); err != nil {
+			return fmt.Errorf("track %s: %w", t.ID, err)
+		}
+	}
+	return zw.Close()
+}
+
+func writeTrack(ctx context.Context, zw *zip.Writer, store storage.Storage, t *ent.Track, tags Tags, multiDisc bool) error {
+	rc, err := store.Get(ctx, t.AudioKey)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	r := bufio.NewReaderSize(rc, 64<<10)
+	format := sniff(r)
+
+	name := fmt.Sprintf("%02d %s%s", tags.Track, fileName(t.Title), format.Ext())
+	if multiDisc {
+		name = fmt.Sprintf("%d-%s", tags.Disc, name)
+	}
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	if format == FormatMP3 {
+		if err := skipID3(r); err != nil {
+			return err
+		}
+		if _, err := fw.Write(id3Tag(tags)); err != nil {
+			return err
+		}
+	}
+	_, err = io.Copy(fw, r)
+	return err
+}
+
+// fileName makes title safe to use as a file name on common filesystems
+func fileName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20, strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if len(name) > 120 {
+		name = strings.ToValidUTF8(name[:120], "")
+	}
+	if name == "" || name == "." || name == ".." {
+		name = "track"
+	}
+	return name
+}
@@ -0,0 +1,161 @@
+// Package audit records who did what through privileged endpoints. Entries are
+// kept in the audit_logs table for the retention window and then moved to
+// storage by the archive package.
+package audit
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+
+	"streamify/ent"
+	"streamify/ent/auditlog"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Entry describes one audited action
+type Entry struct {
+	ActorID  *uuid.UUID
+	Action   string
+	TargetID string
+	Status   int
+	IP       string
+	Metadata map[string]string
+}
+
+// Record stores e
+func Record(ctx context.Context, client *ent.Client, e Entry) error {
+	create := client.AuditLog.Create().
+		SetNillableActorID(e.ActorID).
+		SetAction(e.Action).
+		SetStatus(e.Status)
+	if e.TargetID != "" {
+		create.SetTargetID(e.TargetID)
+	}
+	if e.IP != "" {
+		create.SetIP(e.IP)
+	}
+	if len(e.Metadata) > 0 {
+		create.SetMetadata(e.Metadata)
+	}
+	return create.Exec(ctx)
+}
+
+// metadataKey is the gin context key holding annotations for the request's entry
+const metadataKey = "audit_metadata"
+
+// Annotate attaches a detail to the audit entry recorded for the current request
+func Annotate(c *gin.Context, key, value string) {
+	m, _ := c.Get(metadataKey)
+	meta, ok := m.(map[string]string)
+	if !ok {
+		meta = map[string]string{}
+		c.Set(metadataKey, meta)
+	}
+	meta[key] = value
+}
+
+// requestEntry describes the handled request, attributed to actor
+func requestEntry(c *gin.Context, actor string) Entry {
+	e := Entry{
+		Action:   c.Request.Method + " " + c.FullPath(),
+		TargetID: c.Param("id"),
+		Status:   c.Writer.Status(),
+		IP:       c.ClientIP(),
+		Metadata: map[string]string{},
+	}
+	if id, err := uuid.Parse(actor); err == nil {
+		e.ActorID = &id
+	}
+	if q := c.Request.URL.RawQuery; q != "" {
+		e.Metadata["query"] = q
+	}
+	if m, ok := c.Get(metadataKey); ok {
+		for k, v := range m.(map[string]string) {
+			e.Metadata[k] = v
+		}
+	}
+	return e
+}
+
+// record stores e, logging rather than failing a request that already completed
+func record(c *gin.Context, client *ent.Client, e Entry) {
+	// The request context may already be cancelled by a timeout
+	if err := Record(context.WithoutCancel(c.Request.Context()), client, e); err != nil {
+		log.Printf("audit: failed recording %s by %v: %v", e.Action, e.ActorID, err)
+	}
+}
+
+// Middleware records every request that may change state, after it has been
+// handled so the outcome is captured. Reads are not audited.
+func Middleware(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+		var actor string
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			actor = id.String()
+		}
+		record(c, client, requestEntry(c, actor))
+	}
+}
+
+// Impersonation records every request made with an impersonation token, reads
+// included, attributed to the impersonating admin.
+// Must be used after auth.AuthMiddleware
+func Impersonation(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		v := viewer.FromContext(c.Request.Context())
+		if !v.Impersonated() {
+			return
+		}
+		e := requestEntry(c, v.ImpersonatorID.String())
+		e.Metadata["impersonated_user_id"] = v.UserID.String()
+		record(c, client, e)
+	}
+}
+
+// ListLogs returns live audit entries, newest first, optionally filtered by
+// ?actor_id= and ?action=
+func ListLogs(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := client.AuditLog.Query().Order(ent.Desc(auditlog.FieldCreatedAt))
+		if v := c.Query("actor_id"); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid actor ID"})
+				return
+			}
+			q.Where(auditlog.ActorIDEQ(id))
+		}
+		if v := c.Query("action"); v != "" {
+			q.Where(auditlog.ActionEQ(v))
+		}
+		limit := 100
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 1000 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+				return
+			}
+			limit = n
+		}
+
+		logs, err := q.Limit(limit).All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, logs)
+	}
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"streamify/ent"
+	"streamify/ent/user"
+)
+
+// MinPasswordLength matches the registration requirement
+const MinPasswordLength = 8
+
+// ErrPasswordTooShort is returned when a password is under MinPasswordLength
+var ErrPasswordTooShort = errors.New("password must be at least 8 characters")
+
+// CreateAdmin creates an admin account for email, or promotes the existing
+// account with that email. A password is required for a new account; for an
+// existing one it replaces the current password when given. It reports whether
+// the account was created.
+func CreateAdmin(ctx context.Context, client *ent.Client, email, password string) (*ent.User, bool, error) {
+	if password != "" && len(password) < MinPasswordLength {
+		return nil, false, ErrPasswordTooShort
+	}
+	var hashed string
+	if password != "" {
+		h, err := hashPassword(password)
+		if err != nil {
+			return nil, false, err
+		}
+		hashed = h
+	}
+
+	existing, err := client.User.Query().Where(emailMatches(email)).Only(ctx)
+	switch {
+	case err == nil:
+		update := existing.Update().SetRole(user.RoleAdmin)
+		if hashed != "" {
+			update.SetPassword(hashed)
+		}
+		u, err := update.Save(ctx)
+		return u, false, err
+	case !ent.IsNotFound(err):
+		return nil, false, err
+	}
+
+	if hashed == "" {
+		return nil, false, ErrPasswordTooShort
+	}
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPassword(hashed).
+		SetRole(user.RoleAdmin).
+		Save(ctx)
+	return u, err == nil, err
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/gueststate"
+	"streamify/ent/track"
+	"streamify/viewer"
+)
+
+const (
+	claimTokenType = "claim"
+
+	// ClaimTokenTTL is how long synced guest state can be claimed by a new account
+	ClaimTokenTTL = 7 * 24 * time.Hour
+)
+
+var errInvalidClaimToken = errors.New("invalid or expired claim token")
+
+// GuestStateRequest is the locally captured state a guest syncs to the server
+type GuestStateRequest struct {
+	Queue []uuid.UUID `json:"queue" binding:"max=500"`
+	Likes []uuid.UUID `json:"likes" binding:"max=1000"`
+}
+
+// ClaimResult reports what was migrated from a guest session into a new account
+type ClaimResult struct {
+	Likes int `json:"likes"`
+	Queue int `json:"queue"`
+}
+
+// generateClaimToken generates a JWT that lets a later registration claim a guest's state
+func generateClaimToken(guestID string) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"guest_id": guestID,
+		"exp":      now.Add(ClaimTokenTTL).Unix(),
+		"iat":      now.Unix(),
+		"type":     claimTokenType,
+	}
+
+	return signToken(claims)
+}
+
+// parseClaimToken returns the guest ID carried by a valid claim token
+func parseClaimToken(tokenString string) (uuid.UUID, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return verificationKeys(token)
+	})
+	if err != nil || !token.Valid {
+		return uuid.Nil, errInvalidClaimToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["type"] != claimTokenType {
+		return uuid.Nil, errInvalidClaimToken
+	}
+	guestID, _ := claims["guest_id"].(string)
+	id, err := uuid.Parse(guestID)
+	if err != nil {
+		return uuid.Nil, errInvalidClaimToken
+	}
+	return id, nil
+}
+
+// SyncGuestState stores a guest's queue and likes and returns a claim token for registration.
+// Must be used after GuestMiddleware and a check for the state:sync scope; regular users are rejected.
+func SyncGuestState(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v := viewer.FromContext(c.Request.Context())
+		if !v.IsGuest() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Only guest sessions can sync guest state"})
+			return
+		}
+		guestID := v.GuestID
+
+		var req GuestStateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		expiresAt := time.Now().Add(ClaimTokenTTL)
+		err := client.GuestState.UpdateOneID(guestID).
+			SetQueue(req.Queue).
+			SetLikes(req.Likes).
+			SetExpiresAt(expiresAt).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			err = client.GuestState.Create().
+				SetID(guestID).
+				SetQueue(req.Queue).
+				SetLikes(req.Likes).
+				SetExpiresAt(expiresAt).
+				Exec(ctx)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		claimToken, err := generateClaimToken(guestID.String())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate claim token"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"claim_token": claimToken,
+			"expires_in":  int64(ClaimTokenTTL / time.Second),
+		})
+	}
+}
+
+// claimGuestState moves a guest's synced state onto userID within tx and deletes it.
+// Tracks that no longer exist are skipped; a missing state claims nothing.
+func claimGuestState(ctx context.Context, tx *ent.Tx, guestID, userID uuid.UUID) (*ClaimResult, error) {
+	// Login and registration run before any viewer is set; the likes are userID's own
+	ctx = viewer.NewContext(ctx, viewer.User(userID, viewer.RoleUser))
+	state, err := tx.GuestState.Query().
+		Where(gueststate.IDEQ(guestID), gueststate.ExpiresAtGT(time.Now())).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return &ClaimResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := append(append([]uuid.UUID{}, state.Queue...), state.Likes...)
+	live, err := tx.Track.Query().
+		Where(track.IDIn(ids...), track.DeletedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exists := make(map[uuid.UUID]bool, len(live))
+	for _, id := range live {
+		exists[id] = true
+	}
+
+	queue := make([]uuid.UUID, 0, len(state.Queue))
+	for _, id := range state.Queue {
+		if exists[id] {
+			queue = append(queue, id)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(state.Likes))
+	likes := make([]*ent.LikeCreate, 0, len(state.Likes))
+	for _, id := range state.Likes {
+		if !exists[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		likes = append(likes, tx.Like.Create().SetUserID(userID).SetTrackID(id))
+	}
+
+	if len(likes) > 0 {
+		if _, err := tx.Like.CreateBulk(likes...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(queue) > 0 {
+		if err := tx.User.UpdateOneID(userID).SetQueue(queue).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.GuestState.DeleteOneID(guestID).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return &ClaimResult{Likes: len(likes), Queue: len(queue)}, nil
+}
+
+// PurgeExpiredGuestState deletes guest state that was never claimed
+func PurgeExpiredGuestState(client *ent.Client) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := client.GuestState.Delete().
+			Where(gueststate.ExpiresAtLTE(time.Now())).
+			Exec(ctx)
+		return err
+	}
+}
@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/confirmation"
+	"streamify/ent/user"
+	"streamify/residency"
+	"streamify/viewer"
+)
+
+// ConfirmationTTL is how long a confirmation token can be used after the password was re-entered
+const ConfirmationTTL = 5 * time.Minute
+
+// ConfirmationHeader carries the confirmation token on the sensitive request
+const ConfirmationHeader = "X-Confirmation-Token"
+
+// errConfirmationInvalid covers unknown, expired, already used and other-session tokens alike
+var errConfirmationInvalid = errors.New("invalid confirmation token")
+
+// sessionFingerprint identifies the access token of the current request, so a
+// confirmation only works for the session that re-authenticated
+func sessionFingerprint(c *gin.Context) string {
+	raw := c.GetHeader("Authorization")
+	if token, ok := c.Get("token"); ok {
+		if t, ok := token.(*jwt.Token); ok && t.Raw != "" {
+			raw = t.Raw
+		}
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:16])
+}
+
+func hashConfirmationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ConfirmRequest is the request body for Confirm
+type ConfirmRequest struct {
+	Password string `json:"password" binding:"required"`
+	Action   string `json:"action" binding:"required,oneof=change_password change_email"`
+}
+
+// ConfirmResponse carries a single-use token for one sensitive action
+type ConfirmResponse struct {
+	ConfirmationToken string    `json:"confirmation_token"`
+	Action            string    `json:"action"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+// Confirm re-authenticates the current user with their password and returns a
+// token for one sensitive action. The token must be sent in X-Confirmation-Token
+// by the same session within ConfirmationTTL, and works once, so a captured
+// request can't be replayed.
+func Confirm(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+			return
+		}
+		if viewer.FromContext(c.Request.Context()).APIKeyID != uuid.Nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "API keys cannot change credentials"})
+			return
+		}
+		var req ConfirmRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		u, err := client.User.Get(ctx, userID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		if u.Password == "" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Set a password with the reset flow before making this change"})
+			return
+		}
+		if !comparePassword(u.Password, req.Password) {
+			logger.Debug("confirmation failed", "reason", "wrong password", "user_id", u.ID)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid password"})
+			return
+		}
+
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+		token := base64.RawURLEncoding.EncodeToString(b)
+		conf, err := client.Confirmation.Create().
+			SetUserID(u.ID).
+			SetAction(confirmation.Action(req.Action)).
+			SetTokenHash(hashConfirmationToken(token)).
+			SetSession(sessionFingerprint(c)).
+			SetExpiresAt(time.Now().Add(ConfirmationTTL)).
+			Save(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, ConfirmResponse{
+			ConfirmationToken: token,
+			Action:            req.Action,
+			ExpiresAt:         conf.ExpiresAt,
+		})
+	}
+}
+
+// consumeConfirmation marks the request's confirmation token used. It succeeds
+// only once, for the given user, action and session, before the token expires.
+func consumeConfirmation(ctx context.Context, client *ent.Client, c *gin.Context, userID uuid.UUID, action confirmation.Action) error {
+	token := c.GetHeader(ConfirmationHeader)
+	if token == "" {
+		return errConfirmationInvalid
+	}
+	now := time.Now()
+	n, err := client.Confirmation.Update().
+		Where(
+			confirmation.TokenHashEQ(hashConfirmationToken(token)),
+			confirmation.UserIDEQ(userID),
+			confirmation.ActionEQ(action),
+			confirmation.SessionEQ(sessionFingerprint(c)),
+			confirmation.UsedAtIsNil(),
+			confirmation.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errConfirmationInvalid
+	}
+	return nil
+}
+
+// confirmed runs fn in a transaction after consuming the request's confirmation
+// for action, answering 428 or 403 itself when the confirmation is missing or
+// invalid. fn's error aborts the change and leaves the token unused.
+func confirmed(c *gin.Context, client *ent.Client, userID uuid.UUID, action confirmation.Action, fn func(tx *ent.Tx) error) bool {
+	if c.GetHeader(ConfirmationHeader) == "" {
+		c.JSON(http.StatusPreconditionRequired, gin.H{
+			"error":  "Confirm your password first and send the token in " + ConfirmationHeader,
+			"action": action,
+		})
+		return false
+	}
+
+	ctx := c.Request.Context()
+	tx, err := client.Tx(residency.Home(ctx))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	defer tx.Rollback()
+
+	if err := consumeConfirmation(ctx, tx.Client(), c, userID, action); err != nil {
+		if errors.Is(err, errConfirmationInvalid) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Confirmation token is invalid, expired or already used", "action": action})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	if err := fn(tx); err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, gin.H{"error": "User with this email already exists"})
+			return false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	if err := tx.Commit(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+// ChangePasswordRequest is the request body for ChangePassword
+type ChangePasswordRequest struct {
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
+// ChangePassword sets a new password for the current user. It needs a
+// change_password confirmation; outstanding reset links stop working.
+func ChangePassword(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+			return
+		}
+		var req ChangePasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		hashed, err := hashPassword(req.NewPassword)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash password"})
+			return
+		}
+
+		changed := confirmed(c, client, userID, confirmation.ActionChangePassword, func(tx *ent.Tx) error {
+			return tx.User.UpdateOneID(userID).SetPassword(hashed).Exec(c.Request.Context())
+		})
+		if !changed {
+			return
+		}
+		logger.Info("password changed", "user_id", userID)
+		c.JSON(http.StatusOK, gin.H{"message": "Password changed"})
+	}
+}
+
+// ChangeEmailRequest is the request body for ChangeEmail
+type ChangeEmailRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+}
+
+// ChangeEmail sets a new email for the current user. It needs a change_email confirmation.
+func ChangeEmail(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+			return
+		}
+		var req ChangeEmailRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		taken, err := client.User.Query().
+			Where(emailMatches(req.Email), user.IDNEQ(userID)).
+			Exist(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if taken {
+			c.JSON(http.StatusConflict, gin.H{"error": "User with this email already exists"})
+			return
+		}
+
+		var u *ent.User
+		changed := confirmed(c, client, userID, confirmation.ActionChangeEmail, func(tx *ent.Tx) error {
+			var err error
+			u, err = tx.User.UpdateOneID(userID).SetEmail(req.Email).Save(ctx)
+			return err
+		})
+		if !changed {
+			return
+		}
+		logger.Info("email changed", "user_id", userID)
+		c.JSON(http.StatusOK, u.Unwrap())
+	}
+}
+
+// PurgeExpiredConfirmations deletes confirmations that can no longer be used
+func PurgeExpiredConfirmations(client *ent.Client) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := client.Confirmation.Delete().
+			Where(confirmation.ExpiresAtLTE(time.Now())).
+			Exec(ctx)
+		return err
+	}
+}
@@ -0,0 +1,285 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"streamify/anomaly"
+	"streamify/ent"
+	"streamify/ent/deviceauthorization"
+	"streamify/viewer"
+)
+
+// DeviceCodeTTL is how long a device has to be approved after asking for a code
+const DeviceCodeTTL = 10 * time.Minute
+
+// DevicePollInterval is how often a device may poll for its tokens
+const DevicePollInterval = 5 * time.Second
+
+// userCodeAlphabet has no vowels, so codes don't spell words, and no
+// characters that are easily confused on a TV screen
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+const userCodeLength = 8
+
+// newUserCode returns a random user code, as stored
+func newUserCode() (string, error) {
+	b := make([]byte, userCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		// The slight modulo bias doesn't matter for a code that lives minutes
+		b[i] = userCodeAlphabet[int(b[i])%len(userCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+// formatUserCode splits a stored code in two halves for display, as BCDF-GHJK
+func formatUserCode(code string) string {
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+// normalizeUserCode undoes formatting and case the user may have typed
+func normalizeUserCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+}
+
+// DeviceCodeResponse is returned to a device starting the device flow (RFC 8628)
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// DeviceCode starts a sign-in for a device without a keyboard. The device
+// shows the user code and verification URI, then polls DeviceToken with the
+// device code until the user approves it with ConfirmDevice.
+func DeviceCode(client *ent.Client, appURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate code"})
+			return
+		}
+		deviceCode := base64.RawURLEncoding.EncodeToString(b)
+
+		// User codes are short enough to collide now and then
+		var userCode string
+		for attempt := 0; ; attempt++ {
+			code, err := newUserCode()
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate code"})
+				return
+			}
+			err = client.DeviceAuthorization.Create().
+				SetDeviceCodeHash(hashConfirmationToken(deviceCode)).
+				SetUserCode(code).
+				SetExpiresAt(time.Now().Add(DeviceCodeTTL)).
+				Exec(c.Request.Context())
+			if err == nil {
+				userCode = code
+				break
+			}
+			if !ent.IsConstraintError(err) || attempt == 2 {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		verificationURI := appURL + "/device"
+		c.JSON(http.StatusOK, DeviceCodeResponse{
+			DeviceCode:              deviceCode,
+			UserCode:                formatUserCode(userCode),
+			VerificationURI:         verificationURI,
+			VerificationURIComplete: verificationURI + "?code=" + url.QueryEscape(formatUserCode(userCode)),
+			ExpiresIn:               int64(DeviceCodeTTL / time.Second),
+			Interval:                int64(DevicePollInterval / time.Second),
+		})
+	}
+}
+
+// DeviceTokenRequest is the request body for DeviceToken
+type DeviceTokenRequest struct {
+	DeviceCode string `json:"device_code" binding:"required"`
+}
+
+// DeviceToken is polled by a device with its device code. Until the user acts
+// it answers 400 with the RFC 8628 error codes authorization_pending,
+// slow_down, access_denied or expired_token; once approved it returns tokens,
+// a single time.
+func DeviceToken(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req DeviceTokenRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		hash := hashConfirmationToken(req.DeviceCode)
+		da, err := client.DeviceAuthorization.Query().
+			Where(deviceauthorization.DeviceCodeHashEQ(hash)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		now := time.Now()
+		if !now.Before(da.ExpiresAt) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "expired_token"})
+			return
+		}
+		switch da.Status {
+		case deviceauthorization.StatusDenied:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "access_denied"})
+			return
+		case deviceauthorization.StatusRedeemed:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+			return
+		case deviceauthorization.StatusPending:
+			// A second of slack keeps devices polling on schedule from being
+			// told to slow down by network jitter
+			tooSoon := da.PolledAt != nil && now.Sub(*da.PolledAt) < DevicePollInterval-time.Second
+			if err := client.DeviceAuthorization.UpdateOne(da).SetPolledAt(now).Exec(ctx); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			if tooSoon {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "slow_down"})
+				return
+			}
+			c.JSON(http.StatusBadRequest, gin.H{"error": "authorization_pending"})
+			return
+		}
+
+		// Only the poll that moves it from approved to redeemed gets tokens
+		n, err := client.DeviceAuthorization.Update().
+			Where(
+				deviceauthorization.ID(da.ID),
+				deviceauthorization.StatusEQ(deviceauthorization.StatusApproved),
+			).
+			SetStatus(deviceauthorization.StatusRedeemed).
+			Save(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 || da.UserID == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+			return
+		}
+
+		u, err := client.User.Get(ctx, *da.UserID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_grant"})
+			return
+		}
+		accessToken, err := generateToken(u.ID.String(), false)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+		refreshToken, err := generateToken(u.ID.String(), true)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate refresh token"})
+			return
+		}
+		logger.Info("device signed in", "user_id", u.ID)
+		anomaly.Observe(c, anomaly.Login, u.ID)
+		c.JSON(http.StatusOK, AuthResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			ExpiresIn:    int64(tokenExpirationHours * 3600),
+			User:         u,
+		})
+	}
+}
+
+// ConfirmDeviceRequest is the request body for ConfirmDevice
+type ConfirmDeviceRequest struct {
+	UserCode string `json:"user_code" binding:"required"`
+	// Deny refuses the sign-in instead, for a code the user doesn't recognize
+	Deny bool `json:"deny"`
+}
+
+// ConfirmDevice approves, or denies, the device showing a user code, signing
+// it in as the current user the next time it polls
+func ConfirmDevice(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+			return
+		}
+		if viewer.FromContext(c.Request.Context()).APIKeyID != uuid.Nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "API keys cannot sign in devices"})
+			return
+		}
+		var req ConfirmDeviceRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		status := deviceauthorization.StatusApproved
+		if req.Deny {
+			status = deviceauthorization.StatusDenied
+		}
+		n, err := client.DeviceAuthorization.Update().
+			Where(
+				deviceauthorization.UserCodeEQ(normalizeUserCode(req.UserCode)),
+				deviceauthorization.StatusEQ(deviceauthorization.StatusPending),
+				deviceauthorization.ExpiresAtGT(time.Now()),
+			).
+			SetStatus(status).
+			SetUserID(userID).
+			Save(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Invalid or expired code"})
+			return
+		}
+		if req.Deny {
+			logger.Info("device sign-in denied", "user_id", userID)
+			c.JSON(http.StatusOK, gin.H{"message": "Device sign-in denied"})
+			return
+		}
+		logger.Info("device sign-in approved", "user_id", userID)
+		c.JSON(http.StatusOK, gin.H{"message": "Device signed in"})
+	}
+}
+
+// PurgeExpiredDeviceAuthorizations deletes device authorizations that can no longer be used
+func PurgeExpiredDeviceAuthorizations(client *ent.Client) func(context.Context) error {
+	return func(ctx context.Context) error {
+		_, err := client.DeviceAuthorization.Delete().
+			Where(deviceauthorization.ExpiresAtLTE(time.Now())).
+			Exec(ctx)
+		return err
+	}
+}
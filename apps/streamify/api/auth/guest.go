@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/viewer"
+)
+
+const (
+	guestTokenType = "guest"
+
+	// GuestTokenTTL is how long a guest token stays valid
+	GuestTokenTTL = 30 * time.Minute
+
+	// GuestScope lists what a guest token may do: 30-second track previews, public playlists
+	// and syncing locally captured state for a later registration
+	GuestScope = "tracks:preview playlists:read state:sync"
+
+	// Guest tokens issued per client IP per window
+	guestIssueLimit  = 5
+	guestIssueWindow = time.Minute
+)
+
+// GuestResponse represents the guest token response
+type GuestResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+// ipLimiter is a fixed-window counter keyed by client IP
+type ipLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*ipWindow
+}
+
+type ipWindow struct {
+	start time.Time
+	count int
+}
+
+func newIPLimiter(limit int, window time.Duration) *ipLimiter {
+	return &ipLimiter{limit: limit, window: window, windows: make(map[string]*ipWindow)}
+}
+
+// allow records a request from ip and reports whether it is within the limit
+func (l *ipLimiter) allow(ip string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, ok := l.windows[ip]
+	if !ok || now.Sub(w.start) >= l.window {
+		// Drop expired windows so the map doesn't grow without bound
+		for k, v := range l.windows {
+			if now.Sub(v.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		w = &ipWindow{start: now}
+		l.windows[ip] = w
+	}
+	w.count++
+	return w.count <= l.limit
+}
+
+var guestLimiter = newIPLimiter(guestIssueLimit, guestIssueWindow)
+
+// generateGuestToken generates a short-lived JWT that carries no user identity
+func generateGuestToken() (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"guest_id": uuid.New().String(),
+		"scope":    GuestScope,
+		"exp":      now.Add(GuestTokenTTL).Unix(),
+		"iat":      now.Unix(),
+		"type":     guestTokenType,
+	}
+
+	return signToken(claims)
+}
+
+// Guest issues a guest token for logged-out browsing, rate limited per client IP
+func Guest() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !guestLimiter.allow(c.ClientIP(), time.Now()) {
+			c.Header("Retry-After", "60")
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many guest token requests"})
+			return
+		}
+
+		token, err := generateGuestToken()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+
+		c.JSON(http.StatusOK, GuestResponse{
+			AccessToken: token,
+			ExpiresIn:   int64(GuestTokenTTL / time.Second),
+			Scope:       GuestScope,
+		})
+	}
+}
+
+// GuestMiddleware accepts either a guest token or a regular access token.
+// Guests get a guest viewer limited to the token's scopes; users get the same viewer as with AuthMiddleware.
+func GuestMiddleware(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
+			c.Abort()
+			return
+		}
+
+		token, err := jwt.Parse(parts[1], func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, jwt.ErrSignatureInvalid
+			}
+			return verificationKeys(token)
+		})
+		if err != nil || !token.Valid {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			c.Abort()
+			return
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+			c.Abort()
+			return
+		}
+		if isRevoked(c.Request.Context(), claims) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
+			c.Abort()
+			return
+		}
+
+		var v *viewer.Viewer
+		switch claims["type"] {
+		case guestTokenType:
+			guestID, _ := claims["guest_id"].(string)
+			id, err := uuid.Parse(guestID)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid guest ID in token"})
+				c.Abort()
+				return
+			}
+			scope, _ := claims["scope"].(string)
+			v = &viewer.Viewer{GuestID: id, Roles: []viewer.Role{viewer.RoleGuest}, Scopes: strings.Fields(scope)}
+		case "access":
+			v, err = loadViewer(c.Request.Context(), client, claims)
+			if err != nil {
+				if ent.IsNotFound(err) || errors.Is(err, errInvalidUserID) {
+					c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
+				} else if errors.Is(err, errDeactivated) {
+					c.JSON(http.StatusUnauthorized, gin.H{"error": "Account deactivated"})
+				} else if errors.Is(err, errSessionExpired) {
+					c.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired; sign in again"})
+				} else {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				}
+				c.Abort()
+				return
+			}
+		default:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token type"})
+			c.Abort()
+			return
+		}
+		c.Set("token", token)
+		c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), v))
+
+		c.Next()
+	}
+}
@@ -1,17 +1,26 @@
 package auth
 
 import (
-	"context"
+	"errors"
 	"net/http"
+	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
+	"streamify/anomaly"
 	"streamify/ent"
+	"streamify/ent/invite"
+	"streamify/ent/predicate"
 	"streamify/ent/user"
+	"streamify/events"
+	"streamify/invites"
+	"streamify/referrals"
+	"streamify/viewer"
 )
 
 // LoginRequest represents the login request body
@@ -24,6 +33,10 @@ type LoginRequest struct {
 type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=8"`
+	// ClaimToken optionally migrates state synced by a guest session into the new account
+	ClaimToken string `json:"claim_token"`
+	// InviteCode is required while signups are invite only
+	InviteCode string `json:"invite_code"`
 }
 
 // RefreshRequest represents the refresh token request body
@@ -33,15 +46,17 @@ type RefreshRequest struct {
 
 // AuthResponse represents the authentication response
 type AuthResponse struct {
-	AccessToken  string      `json:"access_token"`
-	RefreshToken string      `json:"refresh_token"`
-	ExpiresIn    int64       `json:"expires_in"`
-	User         interface{} `json:"user"`
+	AccessToken  string       `json:"access_token"`
+	RefreshToken string       `json:"refresh_token"`
+	ExpiresIn    int64        `json:"expires_in"`
+	User         interface{}  `json:"user"`
+	Claimed      *ClaimResult `json:"claimed,omitempty"`
 }
 
 var (
 	tokenExpirationHours        = 24
 	refreshTokenExpirationHours = 168 // 7 days
+	inviteOnly                  = false
 )
 
 // InitAuthConfig initializes auth configuration
@@ -54,6 +69,11 @@ func InitAuthConfig(expirationHours, refreshExpirationHours int) {
 	}
 }
 
+// SetInviteOnly makes registration require an invitation code
+func SetInviteOnly(enabled bool) {
+	inviteOnly = enabled
+}
+
 // generateToken generates a JWT token for a user
 func generateToken(userID string, isRefresh bool) (string, error) {
 	expirationHours := tokenExpirationHours
@@ -72,8 +92,37 @@ func generateToken(userID string, isRefresh bool) (string, error) {
 		claims["type"] = "refresh"
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return signToken(claims)
+}
+
+// GenerateAccessToken issues an access token for userID, for tooling such as load-test generators
+func GenerateAccessToken(userID string) (string, error) {
+	return generateToken(userID, false)
+}
+
+// IssueTokens returns an access and a refresh token for userID, for sign-in
+// flows outside this package such as SSO
+func IssueTokens(userID string) (AuthResponse, error) {
+	accessToken, err := generateToken(userID, false)
+	if err != nil {
+		return AuthResponse{}, err
+	}
+	refreshToken, err := generateToken(userID, true)
+	if err != nil {
+		return AuthResponse{}, err
+	}
+	return AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(tokenExpirationHours * 3600),
+	}, nil
+}
+
+// emailMatches matches a user's email case-insensitively using the lower(email) index
+func emailMatches(email string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(sql.Lower(s.C(user.FieldEmail)), strings.ToLower(email)))
+	})
 }
 
 // hashPassword hashes a password using bcrypt
@@ -102,9 +151,10 @@ func Login(client *ent.Client) gin.HandlerFunc {
 
 		// Find user by email
 		u, err := client.User.Query().
-			Where(user.EmailEQ(req.Email)).
-			Only(context.Background())
+			Where(emailMatches(req.Email)).
+			Only(c.Request.Context())
 		if err != nil {
+			logger.Debug("login failed", "reason", "no such user", "error", err)
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
 			return
 		}
@@ -119,9 +169,14 @@ func Login(client *ent.Client) gin.HandlerFunc {
 
 		// Verify password
 		if !comparePassword(u.Password, req.Password) {
+			logger.Debug("login failed", "reason", "wrong password", "user_id", u.ID)
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
 			return
 		}
+		if u.DeactivatedAt != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Account deactivated"})
+			return
+		}
 
 		// Generate tokens
 		accessToken, err := generateToken(u.ID.String(), false)
@@ -136,6 +191,8 @@ func Login(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		anomaly.Observe(c, anomaly.Login, u.ID)
+
 		// Return response
 		c.JSON(http.StatusOK, AuthResponse{
 			AccessToken:  accessToken,
@@ -155,10 +212,25 @@ func Register(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
+		var guestID uuid.UUID
+		if req.ClaimToken != "" {
+			id, err := parseClaimToken(req.ClaimToken)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired claim token"})
+				return
+			}
+			guestID = id
+		}
+
+		if inviteOnly && req.InviteCode == "" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "An invitation code is required to register"})
+			return
+		}
+
 		// Check if user already exists
 		exists, err := client.User.Query().
-			Where(user.EmailEQ(req.Email)).
-			Exist(context.Background())
+			Where(emailMatches(req.Email)).
+			Exist(c.Request.Context())
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -175,18 +247,64 @@ func Register(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		// Create user
+		// Create the user and claim any guest state atomically
+		tx, err := client.Tx(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+
 		// Note: After regenerating Ent code with optional password, use SetNillablePassword
 		// For now, use SetPassword
-		u, err := client.User.Create().
+		u, err := tx.User.Create().
 			SetEmail(req.Email).
 			SetPassword(hashedPassword).
-			Save(context.Background())
+			Save(c.Request.Context())
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		if req.InviteCode != "" {
+			inv, err := invites.Redeem(c.Request.Context(), tx.Client(), req.InviteCode, req.Email)
+			if err != nil {
+				if errors.Is(err, invites.ErrInvalidCode) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired invitation code"})
+					return
+				}
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			if inv.Kind == invite.KindReferral {
+				if _, err := referrals.Attribute(c.Request.Context(), tx.Client(), inv, u, c.ClientIP()); err != nil {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+			}
+		}
+
+		var claimed *ClaimResult
+		if guestID != uuid.Nil {
+			claimed, err = claimGuestState(c.Request.Context(), tx, guestID, u.ID)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to migrate guest state"})
+				return
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		u = u.Unwrap()
+
+		events.Emit(c.Request.Context(), events.UserRegistered{
+			UserID:            u.ID,
+			InviteCode:        strings.ToUpper(strings.TrimSpace(req.InviteCode)),
+			ClaimedGuestState: claimed != nil,
+		})
+
 		// Generate tokens
 		accessToken, err := generateToken(u.ID.String(), false)
 		if err != nil {
@@ -206,6 +324,7 @@ func Register(client *ent.Client) gin.HandlerFunc {
 			RefreshToken: refreshToken,
 			ExpiresIn:    int64(tokenExpirationHours * 3600),
 			User:         u,
+			Claimed:      claimed,
 		})
 	}
 }
@@ -224,7 +343,7 @@ func Refresh(client *ent.Client) gin.HandlerFunc {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, jwt.ErrSignatureInvalid
 			}
-			return jwtSecret, nil
+			return verificationKeys(token)
 		})
 
 		if err != nil || !token.Valid {
@@ -243,12 +362,29 @@ func Refresh(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token type"})
 			return
 		}
+		if isRevoked(c.Request.Context(), claims) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token has been revoked"})
+			return
+		}
 
 		userID, ok := claims["user_id"].(string)
 		if !ok {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
 			return
 		}
+		v, err := loadViewer(c.Request.Context(), client, claims)
+		if err != nil {
+			if errors.Is(err, errDeactivated) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Account deactivated"})
+			} else if errors.Is(err, errSessionExpired) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired; sign in again"})
+			} else if ent.IsNotFound(err) || errors.Is(err, errInvalidUserID) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
 
 		// Generate new access token
 		accessToken, err := generateToken(userID, false)
@@ -256,6 +392,7 @@ func Refresh(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
 			return
 		}
+		anomaly.Observe(c, anomaly.TokenRefresh, v.UserID)
 
 		c.JSON(http.StatusOK, gin.H{
 			"access_token": accessToken,
@@ -267,28 +404,15 @@ func Refresh(client *ent.Client) gin.HandlerFunc {
 // Me returns the current authenticated user
 func Me(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userID, exists := c.Get("user_id")
-		if !exists {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
-			return
-		}
-
-		userIDStr, ok := userID.(string)
+		userUUID, ok := viewer.UserID(c.Request.Context())
 		if !ok {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID type"})
-			return
-		}
-
-		// Parse UUID
-		userUUID, err := uuid.Parse(userIDStr)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
 			return
 		}
 
 		u, err := client.User.Query().
 			Where(user.IDEQ(userUUID)).
-			Only(context.Background())
+			Only(c.Request.Context())
 		if err != nil {
 			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 			return
@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"streamify/audit"
+	"streamify/ent"
+	"streamify/ent/user"
+	"streamify/ids"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ImpersonationTTL is how long an impersonation token is valid; it cannot be refreshed
+const ImpersonationTTL = 15 * time.Minute
+
+const impersonationTokenType = "impersonation"
+
+// impersonationDenied lists routes an impersonated session may never call, on top of
+// every DELETE. Billing and credential routes belong here as they are added.
+var impersonationDenied = map[string]bool{
+	// Changing who can see a user's data is the user's decision alone
+	"PATCH /api/v1/me/privacy": true,
+	// Accepting terms and policies records the user's own agreement
+	"POST /api/v1/me/consent": true,
+	// Credentials only change after the user re-enters their own password
+	"POST /api/v1/me/confirm": true,
+	"PUT /api/v1/me/password": true,
+	"PUT /api/v1/me/email":    true,
+	// Keys outlive the impersonation session
+	"POST /api/v1/developer/keys":       true,
+	"DELETE /api/v1/developer/keys/:id": true,
+	// Devices signed in stay signed in after the impersonation ends
+	"POST /api/v1/device/confirm": true,
+	// Redeeming uses up the user's promo codes and changes their billing
+	"POST /api/v1/me/redemptions": true,
+}
+
+// impersonationAllowed reports whether an impersonated session may call method route
+func impersonationAllowed(method, route string) bool {
+	if method == http.MethodDelete {
+		return false
+	}
+	return !impersonationDenied[method+" "+route]
+}
+
+// ImpersonateRequest is the request body for Impersonate
+type ImpersonateRequest struct {
+	Reason string `json:"reason" binding:"required,min=10,max=500"`
+}
+
+// ImpersonationResponse is returned when an impersonation token is minted
+type ImpersonationResponse struct {
+	AccessToken    string    `json:"access_token"`
+	UserID         string    `json:"user_id"`
+	ImpersonatorID string    `json:"impersonator_id"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// generateImpersonationToken mints a token acting as userID on behalf of adminID. The
+// actor is recorded in the standard "act" claim (RFC 8693) so it survives every hop.
+func generateImpersonationToken(userID, adminID string, expiresAt time.Time) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"act":     map[string]string{"sub": adminID},
+		"exp":     expiresAt.Unix(),
+		"iat":     time.Now().Unix(),
+		"type":    impersonationTokenType,
+	}
+
+	return signToken(claims)
+}
+
+// impersonator returns the admin ID from the "act" claim of an impersonation token
+func impersonator(claims jwt.MapClaims) (string, bool) {
+	act, ok := claims["act"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	sub, ok := act["sub"].(string)
+	return sub, ok && sub != ""
+}
+
+// Impersonate mints a short-lived token that lets the authenticated admin act as the
+// user in the path for support debugging. Other admins cannot be impersonated, and the
+// reason given is kept in the audit log.
+// Must be used after AdminMiddleware
+func Impersonate(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminID := viewer.FromContext(c.Request.Context()).UserID.String()
+		targetID, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+			return
+		}
+		if targetID.String() == adminID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot impersonate yourself"})
+			return
+		}
+
+		var req ImpersonateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		target, err := client.User.Get(c.Request.Context(), targetID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if target.Role == user.RoleAdmin {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Admins cannot be impersonated"})
+			return
+		}
+
+		expiresAt := time.Now().Add(ImpersonationTTL)
+		token, err := generateImpersonationToken(target.ID.String(), adminID, expiresAt)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+
+		audit.Annotate(c, "reason", req.Reason)
+		audit.Annotate(c, "expires_at", expiresAt.UTC().Format(time.RFC3339))
+		c.JSON(http.StatusCreated, ImpersonationResponse{
+			AccessToken:    token,
+			UserID:         target.ID.String(),
+			ImpersonatorID: adminID,
+			ExpiresAt:      expiresAt,
+		})
+	}
+}
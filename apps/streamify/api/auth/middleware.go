@@ -1,15 +1,32 @@
 package auth
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"net/http"
 	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/apikeys"
+	"streamify/ent"
+	"streamify/ent/user"
+	"streamify/logging"
+	"streamify/viewer"
 )
 
-var jwtSecret []byte
+var logger = logging.For("auth")
+
+var (
+	jwtSecret []byte
+	// previousJWTSecrets still verify tokens issued before a key rotation
+	previousJWTSecrets [][]byte
+)
 
 // InitJWT initializes the JWT secret from environment variable or config
 func InitJWT(secret string) {
@@ -23,10 +40,67 @@ func InitJWT(secret string) {
 	jwtSecret = []byte(secret)
 }
 
-// AuthMiddleware validates JWT tokens and sets user context
-func AuthMiddleware() gin.HandlerFunc {
+// SetPreviousJWTSecrets keeps tokens signed with earlier secrets valid after
+// JWT_SECRET is rotated, so users aren't signed out; new tokens are always
+// signed with the current secret. Drop a secret once the refresh token lifetime
+// has passed since it was replaced.
+func SetPreviousJWTSecrets(secrets ...string) {
+	previousJWTSecrets = previousJWTSecrets[:0]
+	for _, s := range secrets {
+		if s != "" {
+			previousJWTSecrets = append(previousJWTSecrets, []byte(s))
+		}
+	}
+}
+
+// verificationKeys is what token keyfuncs return. With signing keys loaded,
+// a token gets the live key its kid names, and one issued before keys had
+// IDs gets every live key. Otherwise it's the current secret, followed by
+// any previous ones during a rotation.
+func verificationKeys(token *jwt.Token) (interface{}, error) {
+	if k := activeKeys.Load(); k != nil {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return jwt.VerificationKeySet{Keys: k.liveSecrets()}, nil
+		}
+		id, err := uuid.Parse(kid)
+		if err != nil {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		key, ok := k.lookup(id)
+		if !ok {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		return []byte(key.Secret), nil
+	}
+	if len(previousJWTSecrets) == 0 {
+		return jwtSecret, nil
+	}
+	set := jwt.VerificationKeySet{Keys: []jwt.VerificationKey{jwtSecret}}
+	for _, s := range previousJWTSecrets {
+		set.Keys = append(set.Keys, s)
+	}
+	return set, nil
+}
+
+// NewJWTSecret returns a random secret suitable for JWT_SECRET
+func NewJWTSecret() (string, error) {
+	b := make([]byte, 48)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthMiddleware validates JWT tokens and puts the viewer, with the user's
+// current role, in the request context
+func AuthMiddleware(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
+		if key := c.GetHeader(apikeys.Header); key != "" && authHeader == "" {
+			authenticateKey(c, client, key)
+			return
+		}
 		if authHeader == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header required"})
 			c.Abort()
@@ -49,10 +123,11 @@ func AuthMiddleware() gin.HandlerFunc {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, jwt.ErrSignatureInvalid
 			}
-			return jwtSecret, nil
+			return verificationKeys(token)
 		})
 
 		if err != nil || !token.Valid {
+			logger.Debug("token rejected", "path", c.FullPath(), "error", err)
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 			c.Abort()
 			return
@@ -65,25 +140,103 @@ func AuthMiddleware() gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+		if isRevoked(c.Request.Context(), claims) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token has been revoked"})
+			c.Abort()
+			return
+		}
+
+		// Guest tokens are only accepted by GuestMiddleware
+		if claims["type"] == guestTokenType {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Guest tokens cannot access this endpoint"})
+			c.Abort()
+			return
+		}
 
 		// Set user ID in context
-		userID, ok := claims["user_id"].(string)
-		if !ok {
+		if _, ok := claims["user_id"].(string); !ok {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
 			c.Abort()
 			return
 		}
 
-		c.Set("user_id", userID)
+		// Impersonated sessions are flagged on every response and kept away from
+		// destructive routes; audit.Impersonation records what they do
+		var impersonatorID uuid.UUID
+		if claims["type"] == impersonationTokenType {
+			adminID, ok := impersonator(claims)
+			if !ok {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+				c.Abort()
+				return
+			}
+			c.Header("X-Impersonated-By", adminID)
+			if !impersonationAllowed(c.Request.Method, c.FullPath()) {
+				logger.Debug("impersonation token denied", "admin_id", adminID, "route", c.Request.Method+" "+c.FullPath())
+				c.JSON(http.StatusForbidden, gin.H{"error": "Impersonation tokens cannot perform this action"})
+				c.Abort()
+				return
+			}
+			if impersonatorID, err = uuid.Parse(adminID); err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
+				c.Abort()
+				return
+			}
+		}
+
+		v, err := loadViewer(c.Request.Context(), client, claims)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			} else if errors.Is(err, errInvalidUserID) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID in token"})
+			} else if errors.Is(err, errDeactivated) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Account deactivated"})
+			} else if errors.Is(err, errSessionExpired) {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "Session expired; sign in again"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			c.Abort()
+			return
+		}
+		v.ImpersonatorID = impersonatorID
+
 		c.Set("token", token)
+		c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), v))
 
 		c.Next()
 	}
 }
 
+// authenticateKey authenticates a request made with an API key. Keys act as
+// their owner with the user role, even when the owner is an admin.
+func authenticateKey(c *gin.Context, client *ent.Client, key string) {
+	ctx := c.Request.Context()
+	k, err := apikeys.Lookup(ctx, client, key)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrInvalidKey) {
+			logger.Debug("API key rejected", "path", c.FullPath())
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid API key"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		c.Abort()
+		return
+	}
+	v := viewer.User(k.UserID, viewer.RoleUser)
+	v.APIKeyID = k.ID
+	if r := k.Edges.User.Region; r != nil {
+		v.Region = *r
+	}
+	ctx = apikeys.NewContext(viewer.NewContext(ctx, v), k)
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
 // OptionalAuthMiddleware allows requests with or without auth
-// Sets user_id in context if token is valid, but doesn't abort if missing
-func OptionalAuthMiddleware() gin.HandlerFunc {
+// Sets the viewer in context if token is valid, but doesn't abort if missing
+func OptionalAuthMiddleware(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -102,14 +255,14 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, jwt.ErrSignatureInvalid
 			}
-			return jwtSecret, nil
+			return verificationKeys(token)
 		})
 
 		if err == nil && token.Valid {
-			if claims, ok := token.Claims.(jwt.MapClaims); ok {
-				if userID, ok := claims["user_id"].(string); ok {
-					c.Set("user_id", userID)
+			if claims, ok := token.Claims.(jwt.MapClaims); ok && !isRevoked(c.Request.Context(), claims) {
+				if v, err := loadViewer(c.Request.Context(), client, claims); err == nil {
 					c.Set("token", token)
+					c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), v))
 				}
 			}
 		}
@@ -117,3 +270,66 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AdminMiddleware requires the authenticated user to have the admin role
+// Must be used after AuthMiddleware
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !viewer.FromContext(c.Request.Context()).IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Admin access required"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+var errInvalidUserID = errors.New("invalid user ID in token")
+
+// errDeactivated is returned for users deprovisioned by their identity provider
+var errDeactivated = errors.New("account deactivated")
+
+// errSessionExpired is returned for tokens issued before the user's sessions
+// were ended, e.g. by an anomaly rule
+var errSessionExpired = errors.New("session expired")
+
+// loadViewer builds the viewer for the user a valid token with claims was
+// issued to, with the role currently stored for them
+func loadViewer(ctx context.Context, client *ent.Client, claims jwt.MapClaims) (*viewer.Viewer, error) {
+	userID, _ := claims["user_id"].(string)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errInvalidUserID
+	}
+	u, err := client.User.Query().
+		Where(user.IDEQ(id)).
+		Select(user.FieldRole, user.FieldDeactivatedAt, user.FieldSessionsValidAfter, user.FieldRegion).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if u.DeactivatedAt != nil {
+		return nil, errDeactivated
+	}
+	if u.SessionsValidAfter != nil {
+		iat, err := claims.GetIssuedAt()
+		if err != nil || iat == nil || iat.Unix() < u.SessionsValidAfter.Unix() {
+			return nil, errSessionExpired
+		}
+	}
+	v := viewer.User(u.ID, viewer.Role(u.Role))
+	if u.Region != nil {
+		v.Region = *u.Region
+	}
+	return v, nil
+}
+
+// CurrentUser loads the authenticated user from the database
+// Must be used after AuthMiddleware
+func CurrentUser(c *gin.Context, client *ent.Client) (*ent.User, error) {
+	v := viewer.FromContext(c.Request.Context())
+	if v == nil || v.UserID == uuid.Nil {
+		return nil, errInvalidUserID
+	}
+	return client.User.Get(c.Request.Context(), v.UserID)
+}
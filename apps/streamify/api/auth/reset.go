@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/user"
+	"streamify/mail"
+)
+
+const (
+	passwordResetTokenType = "password_reset"
+
+	// PasswordResetTTL is how long a password reset link stays valid
+	PasswordResetTTL = time.Hour
+)
+
+var errInvalidResetToken = errors.New("invalid or expired reset token")
+
+// ForgotPasswordRequest represents the forgot password request body
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest represents the reset password request body
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// passwordFingerprint identifies the password a reset token was issued against, so the
+// token stops working once the password changes and every link is single use
+func passwordFingerprint(hash string) string {
+	sum := sha256.Sum256([]byte(hash))
+	return hex.EncodeToString(sum[:8])
+}
+
+// generateResetToken generates a JWT that lets the holder set a new password for u
+func generateResetToken(u *ent.User) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user_id": u.ID.String(),
+		"pwh":     passwordFingerprint(u.Password),
+		"exp":     now.Add(PasswordResetTTL).Unix(),
+		"iat":     now.Unix(),
+		"type":    passwordResetTokenType,
+	}
+
+	return signToken(claims)
+}
+
+// parseResetToken returns the user ID and password fingerprint carried by a valid reset token
+func parseResetToken(tokenString string) (uuid.UUID, string, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return verificationKeys(token)
+	})
+	if err != nil || !token.Valid {
+		return uuid.Nil, "", errInvalidResetToken
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["type"] != passwordResetTokenType {
+		return uuid.Nil, "", errInvalidResetToken
+	}
+	userID, _ := claims["user_id"].(string)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, "", errInvalidResetToken
+	}
+	pwh, _ := claims["pwh"].(string)
+	return id, pwh, nil
+}
+
+// ForgotPassword emails a password reset link pointing at appURL. It answers the same
+// way whether or not the email is registered, and sends in the background so response
+// times don't reveal it either.
+func ForgotPassword(client *ent.Client, mailer mail.Mailer, appURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		accepted := gin.H{"message": "If an account exists for this email, a reset link has been sent"}
+		u, err := client.User.Query().
+			Where(emailMatches(req.Email)).
+			Only(c.Request.Context())
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				logger.Error("forgot password lookup failed", "error", err)
+			}
+			c.JSON(http.StatusAccepted, accepted)
+			return
+		}
+
+		token, err := generateResetToken(u)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+		msg := mail.Message{
+			To:      []string{u.Email},
+			Subject: "Reset your Streamify password",
+			Text: "Someone asked to reset the password for your Streamify account.\n\n" +
+				"Open this link within an hour to choose a new one:\n" +
+				appURL + "/reset-password?token=" + url.QueryEscape(token) + "\n\n" +
+				"If it wasn't you, ignore this email and your password stays the same.\n",
+		}
+		go func() {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), time.Minute)
+			defer cancel()
+			if err := mailer.Send(ctx, msg); err != nil {
+				logger.Error("failed sending password reset", "user_id", u.ID, "error", err)
+			}
+		}()
+
+		c.JSON(http.StatusAccepted, accepted)
+	}
+}
+
+// ResetPassword sets a new password using a token from a reset link
+func ResetPassword(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ResetPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, pwh, err := parseResetToken(req.Token)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired reset token"})
+			return
+		}
+		u, err := client.User.Get(c.Request.Context(), userID)
+		if err != nil || passwordFingerprint(u.Password) != pwh {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired reset token"})
+			return
+		}
+
+		hashedPassword, err := hashPassword(req.Password)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash password"})
+			return
+		}
+		// Only replace the password the token was issued against, so concurrent uses fail
+		n, err := client.User.Update().
+			Where(user.IDEQ(u.ID), user.PasswordEQ(u.Password)).
+			SetPassword(hashedPassword).
+			Save(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired reset token"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"message": "Password has been reset"})
+	}
+}
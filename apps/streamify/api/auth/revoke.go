@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"streamify/revocation"
+	"streamify/viewer"
+)
+
+// revocations holds the IDs of tokens revoked before they expire
+var revocations revocation.Store = revocation.NewMemory()
+
+// SetRevocations sets where revoked token IDs are kept
+func SetRevocations(s revocation.Store) {
+	revocations = s
+}
+
+// errNotRevocable is returned for tokens issued without a jti, before tokens
+// had IDs. Only retiring their signing key invalidates them.
+var errNotRevocable = errors.New("token has no ID and can't be revoked on its own")
+
+// isRevoked reports whether the token with claims was revoked. A store that
+// can't be reached lets the token through, so an outage doesn't sign
+// everyone out.
+func isRevoked(ctx context.Context, claims jwt.MapClaims) bool {
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	revoked, err := revocations.Revoked(ctx, jti)
+	if err != nil {
+		logger.Warn("revocation store unavailable; accepting token", "error", err)
+		return false
+	}
+	return revoked
+}
+
+// revoke refuses the token with claims until it expires
+func revoke(ctx context.Context, claims jwt.MapClaims) error {
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return errNotRevocable
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return errNotRevocable
+	}
+	return revocations.Revoke(ctx, jti, exp.Time)
+}
+
+// parseToken verifies tokenString's signature and expiry
+func parseToken(tokenString string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return verificationKeys(token)
+	})
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+// LogoutRequest is the optional request body for Logout
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Logout revokes the access token the request was made with and, when one
+// of the caller's is given, their refresh token
+func Logout() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body LogoutRequest
+		if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		value, ok := c.Get("token")
+		token, _ := value.(*jwt.Token)
+		if !ok || token == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "logout needs a bearer token"})
+			return
+		}
+		claims, _ := token.Claims.(jwt.MapClaims)
+
+		var refreshClaims jwt.MapClaims
+		if body.RefreshToken != "" {
+			rc, err := parseToken(body.RefreshToken)
+			if err != nil || rc["type"] != "refresh" || rc["user_id"] != claims["user_id"] {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid refresh token"})
+				return
+			}
+			refreshClaims = rc
+		}
+
+		ctx := c.Request.Context()
+		for _, cl := range []jwt.MapClaims{claims, refreshClaims} {
+			if cl == nil {
+				continue
+			}
+			if err := revoke(ctx, cl); err != nil && !errors.Is(err, errNotRevocable) {
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed revoking token; try again"})
+				return
+			}
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// RevokeTokenRequest is the request body for RevokeToken
+type RevokeTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RevokeToken invalidates a compromised access or refresh token at once
+func RevokeToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body RevokeTokenRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		claims, err := parseToken(body.Token)
+		if err != nil {
+			// Expired tokens are already refused
+			c.JSON(http.StatusBadRequest, gin.H{"error": "token is invalid or expired"})
+			return
+		}
+		switch err := revoke(c.Request.Context(), claims); {
+		case errors.Is(err, errNotRevocable):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error() + "; retire its signing key instead"})
+			return
+		case err != nil:
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed revoking token; try again"})
+			return
+		}
+		logger.Info("token revoked", "jti", claims["jti"], "user_id", claims["user_id"], "by", viewer.FromContext(c.Request.Context()).UserID)
+		c.Status(http.StatusNoContent)
+	}
+}
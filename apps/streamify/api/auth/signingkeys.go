@@ -0,0 +1,373 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/signingkey"
+	"streamify/ids"
+	"streamify/viewer"
+)
+
+// missReloadInterval limits how often a token naming an unknown key makes
+// the key set reload, e.g. when another instance has just added a key
+const missReloadInterval = 5 * time.Second
+
+// ErrLastSigningKey is returned when retiring would leave nothing to sign with
+var ErrLastSigningKey = errors.New("add a new signing key before retiring the last one")
+
+// keyring is an immutable snapshot of the signing keys
+type keyring struct {
+	signing *ent.SigningKey
+	keys    map[uuid.UUID]*ent.SigningKey
+}
+
+// live reports whether k still verifies tokens at now
+func live(k *ent.SigningKey, now time.Time) bool {
+	return k.RetiresAt == nil || now.Before(*k.RetiresAt)
+}
+
+// SigningKeys holds the JWT signing keys stored in the database, so keys can
+// be added and retired while the server runs. The newest key without a
+// retirement date signs new tokens; every key verifies tokens until it
+// retires.
+type SigningKeys struct {
+	client *ent.Client
+	ring   atomic.Pointer[keyring]
+
+	missMu   sync.Mutex
+	lastMiss time.Time
+}
+
+// activeKeys is the key set tokens are signed and verified with. Until one
+// is loaded, JWT_SECRET and JWT_PREVIOUS_SECRETS are used directly.
+var activeKeys atomic.Pointer[SigningKeys]
+
+// LoadSigningKeys seeds the database with the secrets from InitJWT and
+// SetPreviousJWTSecrets, loads the key set and makes it the one tokens are
+// signed and verified with. Previous secrets are set to retire once the
+// refresh token lifetime has passed, so rotating JWT_SECRET still works.
+func LoadSigningKeys(ctx context.Context, client *ent.Client) (*SigningKeys, error) {
+	k := &SigningKeys{client: client}
+	if err := k.seed(ctx); err != nil {
+		return nil, fmt.Errorf("seeding signing keys: %w", err)
+	}
+	if err := k.Reload(ctx); err != nil {
+		return nil, err
+	}
+	activeKeys.Store(k)
+	return k, nil
+}
+
+// envKeyID derives a stable key ID from a secret, so every instance seeds
+// the same row for it
+func envKeyID(secret []byte) uuid.UUID {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("streamify signing key id"))
+	var id uuid.UUID
+	copy(id[:], mac.Sum(nil))
+	id[6] = id[6]&0x0f | 0x80
+	id[8] = id[8]&0x3f | 0x80
+	return id
+}
+
+func (k *SigningKeys) seed(ctx context.Context) error {
+	if len(jwtSecret) > 0 {
+		if err := k.seedOne(ctx, jwtSecret, nil); err != nil {
+			return err
+		}
+	}
+	retiresAt := time.Now().Add(time.Duration(refreshTokenExpirationHours) * time.Hour)
+	for _, s := range previousJWTSecrets {
+		if err := k.seedOne(ctx, s, &retiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seedOne adds secret unless it is already stored. A stored key that is
+// now listed as previous gets retiresAt, unless it is already retiring.
+func (k *SigningKeys) seedOne(ctx context.Context, secret []byte, retiresAt *time.Time) error {
+	id := envKeyID(secret)
+	existing, err := k.client.SigningKey.Get(ctx, id)
+	switch {
+	case ent.IsNotFound(err):
+		create := k.client.SigningKey.Create().SetID(id).SetSecret(string(secret)).SetFromEnv(true)
+		if retiresAt != nil {
+			create = create.SetRetiresAt(*retiresAt)
+		}
+		err = create.Exec(ctx)
+		if ent.IsConstraintError(err) {
+			// Another instance seeded it first
+			return nil
+		}
+		return err
+	case err != nil:
+		return err
+	case retiresAt != nil && existing.RetiresAt == nil:
+		return k.client.SigningKey.UpdateOneID(id).SetRetiresAt(*retiresAt).Exec(ctx)
+	}
+	return nil
+}
+
+// Reload reads the key set from the database
+func (k *SigningKeys) Reload(ctx context.Context) error {
+	rows, err := k.client.SigningKey.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading signing keys: %w", err)
+	}
+	ring := &keyring{keys: make(map[uuid.UUID]*ent.SigningKey, len(rows))}
+	for _, row := range rows {
+		ring.keys[row.ID] = row
+		if row.RetiresAt == nil && (ring.signing == nil || row.CreatedAt.After(ring.signing.CreatedAt)) {
+			ring.signing = row
+		}
+	}
+	k.ring.Store(ring)
+	return nil
+}
+
+// lookup returns the live key with id, reloading the key set once in a
+// while when it isn't known
+func (k *SigningKeys) lookup(id uuid.UUID) (*ent.SigningKey, bool) {
+	key, ok := k.ring.Load().keys[id]
+	if !ok {
+		k.missMu.Lock()
+		reload := time.Since(k.lastMiss) >= missReloadInterval
+		if reload {
+			k.lastMiss = time.Now()
+		}
+		k.missMu.Unlock()
+		if reload {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			err := k.Reload(ctx)
+			cancel()
+			if err != nil {
+				logger.Warn("reloading signing keys failed", "error", err)
+			}
+			key, ok = k.ring.Load().keys[id]
+		}
+	}
+	if !ok || !live(key, time.Now()) {
+		return nil, false
+	}
+	return key, true
+}
+
+// liveSecrets returns the secrets of every live key
+func (k *SigningKeys) liveSecrets() []jwt.VerificationKey {
+	now := time.Now()
+	var out []jwt.VerificationKey
+	for _, key := range k.ring.Load().keys {
+		if live(key, now) {
+			out = append(out, []byte(key.Secret))
+		}
+	}
+	return out
+}
+
+// Add generates a key and makes it the one new tokens are signed with
+func (k *SigningKeys) Add(ctx context.Context) (*ent.SigningKey, error) {
+	secret, err := NewJWTSecret()
+	if err != nil {
+		return nil, err
+	}
+	key, err := k.client.SigningKey.Create().SetSecret(secret).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return key, k.Reload(ctx)
+}
+
+// Retire stops key id signing tokens and has it verify tokens for grace
+// longer; zero revokes it at once. The key signing tokens can only be
+// retired when another key can take over.
+func (k *SigningKeys) Retire(ctx context.Context, id uuid.UUID, grace time.Duration) (*ent.SigningKey, error) {
+	tx, err := k.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key, err := k.retire(ctx, tx, id, grace)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return key, k.Reload(ctx)
+}
+
+func (k *SigningKeys) retire(ctx context.Context, tx *ent.Tx, id uuid.UUID, grace time.Duration) (*ent.SigningKey, error) {
+	key, err := tx.SigningKey.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	retiresAt := time.Now().Add(grace)
+	if key.RetiresAt != nil && key.RetiresAt.Before(retiresAt) {
+		// A grace period can be shortened, never extended
+		return key, nil
+	}
+	if key.RetiresAt == nil {
+		others, err := tx.SigningKey.Query().
+			Where(signingkey.IDNEQ(id), signingkey.RetiresAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !others {
+			return nil, ErrLastSigningKey
+		}
+	}
+	return tx.SigningKey.UpdateOneID(id).SetRetiresAt(retiresAt).Save(ctx)
+}
+
+// KeyStatus is how a key is currently used
+type KeyStatus string
+
+const (
+	KeySigning  KeyStatus = "signing"  // signs new tokens
+	KeyActive   KeyStatus = "active"   // verifies tokens; signs once newer keys retire
+	KeyRetiring KeyStatus = "retiring" // verifies tokens until retires_at
+	KeyRetired  KeyStatus = "retired"
+)
+
+// KeyInfo describes a signing key without its secret
+type KeyInfo struct {
+	ID        uuid.UUID  `json:"id"`
+	Status    KeyStatus  `json:"status"`
+	FromEnv   bool       `json:"from_env"`
+	CreatedAt time.Time  `json:"created_at"`
+	RetiresAt *time.Time `json:"retires_at,omitempty"`
+}
+
+// List describes every key, newest first
+func (k *SigningKeys) List() []KeyInfo {
+	ring := k.ring.Load()
+	now := time.Now()
+	out := make([]KeyInfo, 0, len(ring.keys))
+	for _, key := range ring.keys {
+		out = append(out, describeKey(ring, key, now))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out
+}
+
+func describeKey(ring *keyring, key *ent.SigningKey, now time.Time) KeyInfo {
+	info := KeyInfo{ID: key.ID, FromEnv: key.FromEnv, CreatedAt: key.CreatedAt, RetiresAt: key.RetiresAt}
+	switch {
+	case ring.signing != nil && ring.signing.ID == key.ID:
+		info.Status = KeySigning
+	case key.RetiresAt == nil:
+		info.Status = KeyActive
+	case live(key, now):
+		info.Status = KeyRetiring
+	default:
+		info.Status = KeyRetired
+	}
+	return info
+}
+
+// Describe returns how key is currently used
+func (k *SigningKeys) Describe(key *ent.SigningKey) KeyInfo {
+	return describeKey(k.ring.Load(), key, time.Now())
+}
+
+// signToken signs claims with the current signing key, naming it in the
+// token's kid header. Map claims get a jti so the token can be revoked.
+func signToken(claims jwt.Claims) (string, error) {
+	if m, ok := claims.(jwt.MapClaims); ok && m["jti"] == nil {
+		m["jti"] = uuid.NewString()
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if k := activeKeys.Load(); k != nil {
+		if key := k.ring.Load().signing; key != nil {
+			token.Header["kid"] = key.ID.String()
+			return token.SignedString([]byte(key.Secret))
+		}
+	}
+	return token.SignedString(jwtSecret)
+}
+
+// ListSigningKeys returns every signing key, newest first, without secrets
+func ListSigningKeys(k *SigningKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, k.List())
+	}
+}
+
+// AddSigningKey generates a key that signs every token issued from now on.
+// Tokens signed with older keys stay valid until those keys are retired.
+func AddSigningKey(k *SigningKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, err := k.Add(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("signing key added", "key", key.ID, "by", viewer.FromContext(c.Request.Context()).UserID)
+		c.JSON(http.StatusCreated, k.Describe(key))
+	}
+}
+
+// RetireKeyRequest is the request body for RetireSigningKey
+type RetireKeyRequest struct {
+	// GracePeriod is how long tokens signed with the key stay valid, e.g.
+	// "24h"; "0s" revokes them at once. Defaults to the refresh token lifetime.
+	GracePeriod string `json:"grace_period"`
+}
+
+// RetireSigningKey stops the key in the path signing tokens and revokes the
+// tokens it signed once the grace period has passed
+func RetireSigningKey(k *SigningKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid key ID"})
+			return
+		}
+		var body RetireKeyRequest
+		if err := c.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		grace := time.Duration(refreshTokenExpirationHours) * time.Hour
+		if body.GracePeriod != "" {
+			grace, err = time.ParseDuration(body.GracePeriod)
+			if err != nil || grace < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "grace_period must be a non-negative duration, e.g. 24h"})
+				return
+			}
+		}
+
+		key, err := k.Retire(c.Request.Context(), id, grace)
+		switch {
+		case ent.IsNotFound(err):
+			c.JSON(http.StatusNotFound, gin.H{"error": "signing key not found"})
+			return
+		case errors.Is(err, ErrLastSigningKey):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("signing key retired", "key", key.ID, "retires_at", key.RetiresAt, "by", viewer.FromContext(c.Request.Context()).UserID)
+		c.JSON(http.StatusOK, k.Describe(key))
+	}
+}
@@ -0,0 +1,124 @@
+package backups
+
+import (
+	"errors"
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/backup"
+	"streamify/ids"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListBackups returns all backups, newest first
+func ListBackups(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		backups, err := client.Backup.Query().
+			Order(ent.Desc(backup.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, backups)
+	}
+}
+
+// GetBackup returns a backup by ID
+func GetBackup(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid backup ID"})
+			return
+		}
+		b, err := client.Backup.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "backup not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, b)
+	}
+}
+
+// CreateBackup starts a manual backup in the background
+func CreateBackup(m *Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		b, err := m.Start(backup.TriggerManual)
+		if err != nil {
+			if errors.Is(err, ErrBusy) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusAccepted, b)
+	}
+}
+
+// VerifyBackup checks a backup archive against its checksum
+func VerifyBackup(m *Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid backup ID"})
+			return
+		}
+		b, err := m.Verify(c.Request.Context(), id)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, b)
+	}
+}
+
+// RestoreBackup restores the database from a backup.
+// The request body must repeat the backup ID as confirmation.
+func RestoreBackup(m *Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid backup ID"})
+			return
+		}
+
+		var body struct {
+			Confirm string `json:"confirm" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if body.Confirm != id.String() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "confirm must match the backup ID"})
+			return
+		}
+
+		if err := m.Restore(c.Request.Context(), id); err != nil {
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "backup restored"})
+	}
+}
+
+// respondError maps manager errors to HTTP responses
+func respondError(c *gin.Context, err error) {
+	switch {
+	case ent.IsNotFound(err):
+		c.JSON(http.StatusNotFound, gin.H{"error": "backup not found"})
+	case errors.Is(err, ErrBusy):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrNotCompleted):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
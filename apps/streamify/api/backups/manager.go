@@ -0,0 +1,274 @@
+package backups
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/config"
+	"streamify/ent"
+	"streamify/ent/backup"
+	"streamify/ids"
+	"streamify/storage"
+
+	"github.com/google/uuid"
+)
+
+// Prefix is the storage key prefix under which backups are written
+const Prefix = "backups/"
+
+// ErrBusy is returned when a backup or restore is already in progress
+var ErrBusy = errors.New("another backup or restore is in progress")
+
+// ErrNotCompleted is returned when verifying or restoring a backup that did not complete
+var ErrNotCompleted = errors.New("backup has not completed successfully")
+
+// Manager creates, verifies and restores logical database backups using pg_dump and pg_restore
+type Manager struct {
+	client *ent.Client
+	store  storage.Storage
+	dsn    string
+
+	mu      sync.Mutex
+	running bool
+}
+
+// NewManager creates a backup manager for the database at dsn
+func NewManager(client *ent.Client, store storage.Storage, dsn string) *Manager {
+	return &Manager{client: client, store: store, dsn: dsn}
+}
+
+// acquire marks the manager busy, failing if an operation is already running
+func (m *Manager) acquire() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		return ErrBusy
+	}
+	m.running = true
+	return nil
+}
+
+// release marks the manager idle
+func (m *Manager) release() {
+	m.mu.Lock()
+	m.running = false
+	m.mu.Unlock()
+}
+
+// Start records a new backup and runs the dump in the background
+func (m *Manager) Start(trigger backup.Trigger) (*ent.Backup, error) {
+	if err := m.acquire(); err != nil {
+		return nil, err
+	}
+
+	b, err := m.create(context.Background(), trigger)
+	if err != nil {
+		m.release()
+		return nil, err
+	}
+
+	go func() {
+		defer m.release()
+		m.dump(context.Background(), b)
+	}()
+	return b, nil
+}
+
+// Run records a new backup and performs the dump before returning
+func (m *Manager) Run(ctx context.Context, trigger backup.Trigger) (*ent.Backup, error) {
+	if err := m.acquire(); err != nil {
+		return nil, err
+	}
+	defer m.release()
+
+	b, err := m.create(ctx, trigger)
+	if err != nil {
+		return nil, err
+	}
+	return m.dump(ctx, b)
+}
+
+// Scheduled runs a backup as a scheduler job
+func (m *Manager) Scheduled(ctx context.Context) error {
+	_, err := m.Run(ctx, backup.TriggerScheduled)
+	return err
+}
+
+// create inserts the metadata row for a new backup
+func (m *Manager) create(ctx context.Context, trigger backup.Trigger) (*ent.Backup, error) {
+	id := ids.New()
+	// The end of the ID, since its start is the same for backups made in the same millisecond
+	s := id.String()
+	key := fmt.Sprintf("%s%s-%s.dump", Prefix, time.Now().UTC().Format("20060102T150405Z"), s[len(s)-8:])
+	return m.client.Backup.Create().
+		SetID(id).
+		SetKey(key).
+		SetTrigger(trigger).
+		Save(ctx)
+}
+
+// command runs a Postgres client tool against the database. Only the DSN
+// without its password goes on the command line, which anyone on the host
+// can read; the password is passed in the tool's environment.
+func (m *Manager) command(ctx context.Context, name string, args ...string) (*exec.Cmd, error) {
+	dsn, password, err := config.SplitPassword(m.dsn)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, name, append(args, "--dbname", dsn)...)
+	if password != "" {
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	}
+	return cmd, nil
+}
+
+// dump streams pg_dump output into storage and records the outcome on b
+func (m *Manager) dump(ctx context.Context, b *ent.Backup) (*ent.Backup, error) {
+	var stderr bytes.Buffer
+	cmd, err := m.command(ctx, "pg_dump", "--format=custom", "--no-owner")
+	if err != nil {
+		return m.fail(ctx, b, err)
+	}
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return m.fail(ctx, b, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return m.fail(ctx, b, fmt.Errorf("starting pg_dump: %w", err))
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	putErr := m.store.Put(ctx, b.Key, io.TeeReader(stdout, io.MultiWriter(hash, counter)))
+	if putErr != nil {
+		// Unblock pg_dump if storage stopped reading
+		cmd.Process.Kill()
+	}
+	waitErr := cmd.Wait()
+
+	if putErr != nil {
+		return m.fail(ctx, b, fmt.Errorf("writing backup: %w", putErr))
+	}
+	if waitErr != nil {
+		m.store.Delete(ctx, b.Key)
+		return m.fail(ctx, b, fmt.Errorf("pg_dump: %w: %s", waitErr, strings.TrimSpace(stderr.String())))
+	}
+
+	return b.Update().
+		SetStatus(backup.StatusCompleted).
+		SetSize(counter.n).
+		SetChecksum(hex.EncodeToString(hash.Sum(nil))).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// fail records err on b and returns it
+func (m *Manager) fail(ctx context.Context, b *ent.Backup, err error) (*ent.Backup, error) {
+	updated, uerr := b.Update().
+		SetStatus(backup.StatusFailed).
+		SetError(err.Error()).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if uerr != nil {
+		return b, fmt.Errorf("%w (recording failure: %v)", err, uerr)
+	}
+	return updated, err
+}
+
+// Verify checks the stored archive against its checksum and confirms pg_restore can read it
+func (m *Manager) Verify(ctx context.Context, id uuid.UUID) (*ent.Backup, error) {
+	b, err := m.completed(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := m.store.Get(ctx, b.Key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var stderr bytes.Buffer
+	hash := sha256.New()
+	cmd := exec.CommandContext(ctx, "pg_restore", "--list")
+	cmd.Stdin = io.TeeReader(r, hash)
+	cmd.Stdout = io.Discard
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pg_restore could not read archive: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	// pg_restore may stop before EOF; hash the remainder so the checksum covers the whole object
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != b.Checksum {
+		return nil, fmt.Errorf("checksum mismatch: expected %s, got %s", b.Checksum, sum)
+	}
+
+	return b.Update().SetVerifiedAt(time.Now()).Save(ctx)
+}
+
+// Restore replaces the contents of the database with the given backup
+func (m *Manager) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := m.acquire(); err != nil {
+		return err
+	}
+	defer m.release()
+
+	b, err := m.completed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	r, err := m.store.Get(ctx, b.Key)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var stderr bytes.Buffer
+	cmd, err := m.command(ctx, "pg_restore", "--clean", "--if-exists", "--no-owner", "--single-transaction")
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = r
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("pg_restore: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// completed loads a backup and ensures it finished successfully
+func (m *Manager) completed(ctx context.Context, id uuid.UUID) (*ent.Backup, error) {
+	b, err := m.client.Backup.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b.Status != backup.StatusCompleted {
+		return nil, ErrNotCompleted
+	}
+	return b, nil
+}
+
+// countingWriter counts the bytes written to it
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
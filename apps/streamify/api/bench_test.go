@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"streamify/ent/enttest"
+	"streamify/seed"
+	"streamify/viewer"
+	"streamify/wire"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// benchEnv is a seeded in-memory database behind a router serving the hot handlers
+type benchEnv struct {
+	router *gin.Engine
+	seeded *seed.Result
+}
+
+func newBenchEnv(tb testing.TB) *benchEnv {
+	tb.Helper()
+	client := enttest.Open(tb, "sqlite3", "file:"+tb.Name()+"?mode=memory&cache=shared&_fk=1")
+	tb.Cleanup(func() { client.Close() })
+
+	res, err := seed.Run(context.Background(), client, seed.Profiles["small"], 1)
+	if err != nil {
+		tb.Fatalf("seeding: %v", err)
+	}
+
+	gin.SetMode(gin.ReleaseMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		v := viewer.User(res.UserIDs[0], viewer.RoleUser)
+		c.Request = c.Request.WithContext(viewer.NewContext(c.Request.Context(), v))
+	})
+	r.GET("/api/v1/artists", getArtists(client))
+	r.GET("/api/v1/artists/:id/albums", getArtistAlbums(client))
+	r.GET("/api/v1/albums/:id/tracks", getAlbumTracks(client))
+	r.POST("/api/v1/plays", createPlay(client, nil))
+
+	return &benchEnv{router: r, seeded: res}
+}
+
+func (e *benchEnv) serve(tb testing.TB, method, path, body string, want int) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	e.router.ServeHTTP(w, req)
+	if w.Code != want {
+		tb.Fatalf("%s %s: got %d, want %d: %s", method, path, w.Code, want, w.Body.String())
+	}
+	return w.Body.Len()
+}
+
+// hotRoutes are the requests exercised by the benchmarks and the allocation budgets
+func (e *benchEnv) hotRoutes() []struct {
+	name, method, path, body string
+	want                     int
+} {
+	return []struct {
+		name, method, path, body string
+		want                     int
+	}{
+		{"ListArtists", "GET", "/api/v1/artists", "", http.StatusOK},
+		{"ArtistAlbums", "GET", "/api/v1/artists/" + e.seeded.ArtistIDs[0].String() + "/albums", "", http.StatusOK},
+		{"AlbumTracks", "GET", "/api/v1/albums/" + e.seeded.AlbumIDs[0].String() + "/tracks", "", http.StatusOK},
+		{"RecordPlay", "POST", "/api/v1/plays", `{"track_id":"` + e.seeded.TrackIDs[0].String() + `","territory":"US"}`, http.StatusCreated},
+	}
+}
+
+func BenchmarkHotHandlers(b *testing.B) {
+	env := newBenchEnv(b)
+	for _, encoder := range []string{"fast", "std"} {
+		wire.UseStdlib = encoder == "std"
+		for _, rt := range env.hotRoutes() {
+			b.Run(rt.name+"/"+encoder, func(b *testing.B) {
+				b.ReportAllocs()
+				var size int
+				for b.Loop() {
+					size = env.serve(b, rt.method, rt.path, rt.body, rt.want)
+				}
+				b.ReportMetric(float64(size), "resp-bytes/op")
+			})
+		}
+	}
+	wire.UseStdlib = false
+}
+
+// allocBudgets caps allocations per request on the hot paths, with headroom over
+// current measurements; a serialization or query regression shows up here first.
+// Lower a budget when an optimization lands so it stays locked in.
+var allocBudgets = map[string]float64{
+	"ListArtists":  2800,
+	"ArtistAlbums": 550,
+	"AlbumTracks":  800,
+	"RecordPlay":   350,
+}
+
+func TestHotHandlerAllocations(t *testing.T) {
+	if testing.Short() {
+		t.Skip("seeds a database")
+	}
+	env := newBenchEnv(t)
+	for _, rt := range env.hotRoutes() {
+		allocs := testing.AllocsPerRun(20, func() {
+			env.serve(t, rt.method, rt.path, rt.body, rt.want)
+		})
+		t.Logf("%s: %.0f allocs/request (budget %.0f)", rt.name, allocs, allocBudgets[rt.name])
+		if allocs > allocBudgets[rt.name] {
+			t.Errorf("%s: %.0f allocs/request exceeds budget of %.0f", rt.name, allocs, allocBudgets[rt.name])
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package caching sets the Cache-Control header of each route from a
+// declarative policy map, so CDNs can cache public catalog responses while
+// everything else stays private to the caller.
+package caching
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Policy says how a route's responses may be cached
+type Policy struct {
+	// Public lets shared caches such as CDNs store responses; otherwise only
+	// the caller's browser may
+	Public bool
+	// NoStore forbids storing responses at all; the other fields are ignored
+	NoStore bool
+	MaxAge  time.Duration
+	// SMaxAge overrides MaxAge for shared caches
+	SMaxAge time.Duration
+	// StaleWhileRevalidate is how long a stale response may still be served
+	// while it is refreshed in the background
+	StaleWhileRevalidate time.Duration
+	// StaleIfError is how long a stale response may be served when the
+	// origin answers with an error
+	StaleIfError time.Duration
+}
+
+// Private is the default policy: responses may depend on who is asking
+var Private = Policy{NoStore: true}
+
+// String returns the policy as a Cache-Control header value
+func (p Policy) String() string {
+	if p.NoStore {
+		if p.Public {
+			return "no-store"
+		}
+		return "private, no-store"
+	}
+	directives := []string{"private"}
+	if p.Public {
+		directives[0] = "public"
+	}
+	directives = append(directives, "max-age="+seconds(p.MaxAge))
+	if p.SMaxAge > 0 {
+		directives = append(directives, "s-maxage="+seconds(p.SMaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	if p.StaleIfError > 0 {
+		directives = append(directives, "stale-if-error="+seconds(p.StaleIfError))
+	}
+	return strings.Join(directives, ", ")
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
+
+// ParsePolicy parses a Cache-Control value made of the directives Policy
+// knows: public, private, no-store, max-age, s-maxage,
+// stale-while-revalidate and stale-if-error
+func ParsePolicy(s string) (Policy, error) {
+	var p Policy
+	for _, d := range strings.Split(s, ",") {
+		name, value, hasValue := strings.Cut(strings.ToLower(strings.TrimSpace(d)), "=")
+		var secs int
+		if hasValue {
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 0 {
+				return Policy{}, fmt.Errorf("caching: %q: %s must be a number of seconds", s, name)
+			}
+			secs = n
+		}
+		dur := time.Duration(secs) * time.Second
+		switch name {
+		case "public":
+			p.Public = true
+		case "private":
+			p.Public = false
+		case "no-store":
+			p.NoStore = true
+		case "max-age":
+			p.MaxAge = dur
+		case "s-maxage":
+			p.SMaxAge = dur
+		case "stale-while-revalidate":
+			p.StaleWhileRevalidate = dur
+		case "stale-if-error":
+			p.StaleIfError = dur
+		default:
+			return Policy{}, fmt.Errorf("caching: %q: unsupported directive %q", s, name)
+		}
+		switch name {
+		case "max-age", "s-maxage", "stale-while-revalidate", "stale-if-error":
+			if !hasValue {
+				return Policy{}, fmt.Errorf("caching: %q: %s needs a value", s, name)
+			}
+		default:
+			if hasValue {
+				return Policy{}, fmt.Errorf("caching: %q: %s takes no value", s, name)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Config sets the caching policy of each route. Routes are keyed by method and
+// gin route pattern, e.g. "GET /public/v1/albums/:id"; HEAD requests follow
+// the GET policy. Routes not listed get Default.
+type Config struct {
+	Default Policy
+	Routes  map[string]Policy
+}
+
+// For returns the policy for a method and route pattern
+func (cfg Config) For(method, route string) Policy {
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	if p, ok := cfg.Routes[method+" "+route]; ok {
+		return p
+	}
+	return cfg.Default
+}
+
+// ParseRoutes parses overrides of the form "METHOD /path=directives",
+// separated by semicolons, e.g.
+// "GET /public/v1/artists=public, max-age=60, s-maxage=300;GET /api/v1/artists=private, no-store"
+func ParseRoutes(s string) (map[string]Policy, error) {
+	routes := make(map[string]Policy)
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("caching: %q is not METHOD /path=directives", entry)
+		}
+		p, err := ParsePolicy(value)
+		if err != nil {
+			return nil, err
+		}
+		routes[strings.Join(strings.Fields(route), " ")] = p
+	}
+	return routes, nil
+}
+
+// errorPolicy keeps error responses out of caches whatever the route's policy
+const errorPolicy = "no-store"
+
+// policyWriter swaps the route's policy for errorPolicy when the handler
+// answers with an error and left the header alone
+type policyWriter struct {
+	gin.ResponseWriter
+	value string
+}
+
+func (w *policyWriter) WriteHeader(code int) {
+	h := w.Header()
+	if code >= http.StatusBadRequest && h.Get("Cache-Control") == w.value {
+		h.Set("Cache-Control", errorPolicy)
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Middleware sets each response's Cache-Control from its route's policy.
+// Handlers that set the header themselves, such as those serving immutable
+// artwork, keep theirs; error responses are never cached.
+func Middleware(cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value := cfg.For(c.Request.Method, c.FullPath()).String()
+		c.Header("Cache-Control", value)
+
+		inner := c.Writer
+		c.Writer = &policyWriter{ResponseWriter: inner, value: value}
+		c.Next()
+		c.Writer = inner
+	}
+}
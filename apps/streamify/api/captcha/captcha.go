@@ -0,0 +1,171 @@
+// Package captcha verifies challenge responses from hCaptcha or Cloudflare
+// Turnstile so public endpoints like registration aren't free for bots.
+// Clients pass the widget's response token in the X-Captcha-Token header.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"streamify/resilience"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Header carries the challenge response token from the client
+const Header = "X-Captcha-Token"
+
+// ErrRejected is returned when the provider says the token is invalid, expired or reused
+var ErrRejected = errors.New("captcha rejected")
+
+// Verifier checks a challenge response token. remoteIP is optional.
+type Verifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+const (
+	hcaptchaURL  = "https://api.hcaptcha.com/siteverify"
+	turnstileURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// SiteVerify checks tokens against a siteverify endpoint. hCaptcha and Turnstile
+// share the same form-encoded request and JSON response.
+type SiteVerify struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+// HCaptcha returns a verifier for hCaptcha with the given secret key
+func HCaptcha(secret string) *SiteVerify {
+	return &SiteVerify{URL: hcaptchaURL, Secret: secret}
+}
+
+// Turnstile returns a verifier for Cloudflare Turnstile with the given secret key
+func Turnstile(secret string) *SiteVerify {
+	return &SiteVerify{URL: turnstileURL, Secret: secret}
+}
+
+// Verify implements Verifier
+func (s *SiteVerify) Verify(ctx context.Context, token, remoteIP string) error {
+	form := url.Values{"secret": {s.Secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("siteverify returned %s", resp.Status)
+	}
+
+	var body struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("decoding siteverify response: %w", err)
+	}
+	if !body.Success {
+		// A misconfigured secret is our fault, not the client's
+		for _, code := range body.ErrorCodes {
+			if strings.HasPrefix(code, "invalid-input-secret") || strings.HasPrefix(code, "missing-input-secret") {
+				return fmt.Errorf("siteverify rejected the secret key: %s", code)
+			}
+		}
+		return fmt.Errorf("%w: %s", ErrRejected, strings.Join(body.ErrorCodes, ","))
+	}
+	return nil
+}
+
+// FromEnv returns the verifier selected by CAPTCHA_PROVIDER ("hcaptcha" or
+// "turnstile") with the secret in CAPTCHA_SECRET, or nil when verification is off
+func FromEnv() (Verifier, error) {
+	provider := os.Getenv("CAPTCHA_PROVIDER")
+	if provider == "" {
+		return nil, nil
+	}
+	secret := os.Getenv("CAPTCHA_SECRET")
+	if secret == "" {
+		return nil, errors.New("CAPTCHA_SECRET is required when CAPTCHA_PROVIDER is set")
+	}
+	switch provider {
+	case "hcaptcha":
+		return HCaptcha(secret), nil
+	case "turnstile":
+		return Turnstile(secret), nil
+	}
+	return nil, fmt.Errorf("unknown CAPTCHA_PROVIDER %q (want hcaptcha or turnstile)", provider)
+}
+
+type resilient struct {
+	v   Verifier
+	dep *resilience.Dependency
+}
+
+// Resilient wraps v so checks go through dep's breaker. Tokens are single use,
+// so checks are never retried; rejected tokens don't count as failures.
+func Resilient(v Verifier, dep *resilience.Dependency) Verifier {
+	return &resilient{v: v, dep: dep}
+}
+
+// Verify implements Verifier
+func (r *resilient) Verify(ctx context.Context, token, remoteIP string) error {
+	return r.dep.DoOnce(ctx, func(ctx context.Context) error {
+		return r.v.Verify(ctx, token, remoteIP)
+	})
+}
+
+// IsRejected reports whether err means the token itself was bad
+func IsRejected(err error) bool {
+	return errors.Is(err, ErrRejected)
+}
+
+// Require rejects requests without a valid challenge response token. A nil
+// verifier lets every request through, so routes can be wired unconditionally.
+// When the provider can't be reached requests fail closed.
+func Require(v Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if v == nil {
+			c.Next()
+			return
+		}
+		token := c.GetHeader(Header)
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "captcha token is required"})
+			return
+		}
+		ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+		defer cancel()
+		if err := v.Verify(ctx, token, c.ClientIP()); err != nil {
+			if IsRejected(err) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "captcha verification failed"})
+				return
+			}
+			log.Printf("captcha: verification unavailable: %v", err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "captcha verification is temporarily unavailable"})
+			return
+		}
+		c.Next()
+	}
+}
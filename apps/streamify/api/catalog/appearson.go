@@ -0,0 +1,179 @@
+package catalog
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"streamify/coalesce"
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+
+	"github.com/google/uuid"
+)
+
+// AppearsOnTTL bounds how long a credit added on another instance goes
+// unnoticed when its change notification is lost
+const AppearsOnTTL = 10 * time.Minute
+
+// maxCachedArtists caps how many artists' appearances are cached at once
+const maxCachedArtists = 10000
+
+// CreditedTrack is a track on another artist's release crediting the artist
+type CreditedTrack struct {
+	ID    uuid.UUID          `json:"id"`
+	Title string             `json:"title"`
+	Roles []trackcredit.Role `json:"roles"`
+}
+
+// Appearance is another artist's release, or a compilation, that an artist is
+// credited on
+type Appearance struct {
+	DiscographyAlbum
+	ArtistName string          `json:"artist_name"`
+	Tracks     []CreditedTrack `json:"tracks"`
+}
+
+// AppearsOn lists the releases an artist appears on, newest first, split into
+// compilations and other artists' releases
+type AppearsOn struct {
+	ArtistID     uuid.UUID    `json:"artist_id"`
+	Albums       []Appearance `json:"albums"`
+	Compilations []Appearance `json:"compilations"`
+}
+
+// appearances returns the live releases of other artists with live tracks
+// crediting artist id, with the credited tracks filled in
+func appearances(ctx context.Context, client *ent.Client, id uuid.UUID) ([]Appearance, error) {
+	credits, err := client.TrackCredit.Query().
+		Where(
+			trackcredit.ArtistIDEQ(id),
+			trackcredit.HasTrackWith(
+				track.DeletedAtIsNil(),
+				track.HasAlbumWith(album.DeletedAtIsNil(), album.ArtistIDNEQ(id)),
+			),
+		).
+		WithTrack(func(q *ent.TrackQuery) {
+			q.WithAlbum(func(q *ent.AlbumQuery) { q.WithArtist() })
+		}).
+		Order(ent.Asc(trackcredit.FieldRole)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byAlbum := map[uuid.UUID]*Appearance{}
+	var out []*Appearance
+	for _, c := range credits {
+		t := c.Edges.Track
+		a := t.Edges.Album
+		app := byAlbum[a.ID]
+		if app == nil {
+			app = &Appearance{DiscographyAlbum: discographyAlbum(a)}
+			if a.Edges.Artist != nil {
+				app.ArtistName = a.Edges.Artist.Name
+			}
+			byAlbum[a.ID] = app
+			out = append(out, app)
+		}
+		if !hasRole(app.Roles, c.Role) {
+			app.Roles = append(app.Roles, c.Role)
+		}
+		i := 0
+		for i < len(app.Tracks) && app.Tracks[i].ID != t.ID {
+			i++
+		}
+		if i == len(app.Tracks) {
+			app.Tracks = append(app.Tracks, CreditedTrack{ID: t.ID, Title: t.Title})
+		}
+		app.Tracks[i].Roles = append(app.Tracks[i].Roles, c.Role)
+	}
+
+	result := make([]Appearance, len(out))
+	for i, app := range out {
+		result[i] = *app
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return releasedBefore(result[j].DiscographyAlbum, result[i].DiscographyAlbum)
+	})
+	return result, nil
+}
+
+// AppearsOnCache caches the releases each artist appears on
+type AppearsOnCache struct {
+	client *ent.Client
+	// loads coalesces misses for the same artist
+	loads *coalesce.Group[*AppearsOn]
+
+	mu      sync.Mutex
+	entries map[uuid.UUID]appearsOnEntry
+}
+
+type appearsOnEntry struct {
+	appearsOn *AppearsOn
+	loadedAt  time.Time
+}
+
+// NewAppearsOnCache creates an AppearsOnCache backed by client
+func NewAppearsOnCache(client *ent.Client) *AppearsOnCache {
+	return &AppearsOnCache{client: client, loads: coalesce.New[*AppearsOn](), entries: map[uuid.UUID]appearsOnEntry{}}
+}
+
+// Get returns the releases artist id appears on. Returns a not-found error
+// when the artist doesn't exist.
+func (c *AppearsOnCache) Get(ctx context.Context, id uuid.UUID) (*AppearsOn, error) {
+	c.mu.Lock()
+	e, ok := c.entries[id]
+	c.mu.Unlock()
+	if ok && time.Since(e.loadedAt) < AppearsOnTTL {
+		return e.appearsOn, nil
+	}
+	return c.loads.Do(ctx, id.String(), func(ctx context.Context) (*AppearsOn, error) {
+		return c.load(ctx, id)
+	})
+}
+
+// load reads the releases artist id appears on and caches them
+func (c *AppearsOnCache) load(ctx context.Context, id uuid.UUID) (*AppearsOn, error) {
+	if _, err := c.client.Artist.Query().Where(artist.IDEQ(id), artist.DeletedAtIsNil()).OnlyID(ctx); err != nil {
+		return nil, err
+	}
+	apps, err := appearances(ctx, c.client, id)
+	if err != nil {
+		return nil, err
+	}
+	res := &AppearsOn{ArtistID: id, Albums: []Appearance{}, Compilations: []Appearance{}}
+	for _, app := range apps {
+		if app.AlbumType == album.AlbumTypeCompilation {
+			res.Compilations = append(res.Compilations, app)
+		} else {
+			res.Albums = append(res.Albums, app)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCachedArtists {
+		c.entries = map[uuid.UUID]appearsOnEntry{}
+	}
+	c.entries[id] = appearsOnEntry{appearsOn: res, loadedAt: time.Now()}
+	return res, nil
+}
+
+// Invalidate drops the cached appearances of the given artists, or of every
+// artist when called without IDs, so changed credits show up immediately
+func (c *AppearsOnCache) Invalidate(ids ...uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(ids) == 0 {
+		c.entries = map[uuid.UUID]appearsOnEntry{}
+		return
+	}
+	for _, id := range ids {
+		delete(c.entries, id)
+	}
+}
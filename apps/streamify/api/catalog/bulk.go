@@ -0,0 +1,229 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/play"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+
+	"github.com/google/uuid"
+)
+
+// MaxBulkAlbums is the most albums one bulk operation may select; larger
+// clean-ups are split by narrowing the filter
+const MaxBulkAlbums = 10000
+
+// ErrEmptyFilter is returned for a filter with no criteria, which would
+// select the whole catalog
+var ErrEmptyFilter = errors.New("filter needs at least one criterion")
+
+// ErrTooManyAlbums is returned when a selection exceeds MaxBulkAlbums
+var ErrTooManyAlbums = fmt.Errorf("selection matches more than %d albums", MaxBulkAlbums)
+
+// AlbumFilter selects albums by their attributes. Criteria are combined with AND.
+type AlbumFilter struct {
+	ArtistID       *uuid.UUID       `json:"artist_id"`
+	Label          *string          `json:"label"`
+	AlbumType      *album.AlbumType `json:"album_type" binding:"omitempty,oneof=album single ep compilation"`
+	ReleasedBefore *time.Time       `json:"released_before"`
+	CreatedBefore  *time.Time       `json:"created_before"`
+	// ArtistDeleted selects albums whose artist has been soft-deleted
+	ArtistDeleted bool `json:"artist_deleted"`
+}
+
+func (f AlbumFilter) predicates() []predicate.Album {
+	var ps []predicate.Album
+	if f.ArtistID != nil {
+		ps = append(ps, album.ArtistIDEQ(*f.ArtistID))
+	}
+	if f.Label != nil {
+		ps = append(ps, album.LabelEQ(*f.Label))
+	}
+	if f.AlbumType != nil {
+		ps = append(ps, album.AlbumTypeEQ(*f.AlbumType))
+	}
+	if f.ReleasedBefore != nil {
+		ps = append(ps, album.ReleaseDateLT(*f.ReleasedBefore))
+	}
+	if f.CreatedBefore != nil {
+		ps = append(ps, album.CreatedAtLT(*f.CreatedBefore))
+	}
+	if f.ArtistDeleted {
+		ps = append(ps, album.HasArtistWith(artist.DeletedAtNotNil()))
+	}
+	return ps
+}
+
+// BulkImpact describes the albums a bulk operation selected and the rows it
+// affects. Token must be sent back to carry the operation out.
+type BulkImpact struct {
+	Hard    bool        `json:"hard"`
+	Albums  []uuid.UUID `json:"albums"`
+	Missing []uuid.UUID `json:"missing,omitempty"`
+	Tracks  int         `json:"tracks"`
+	Plays   int         `json:"plays"`
+	Token   string      `json:"confirmation_token"`
+}
+
+// SelectAlbums resolves an explicit ID list or a filter to the albums a bulk
+// operation would affect, and counts their tracks and, for hard deletes,
+// plays. Archiving only considers live albums; hard deletes also take
+// albums that were already archived. Listed IDs that don't match are
+// reported as missing.
+func SelectAlbums(ctx context.Context, client *ent.Client, ids []uuid.UUID, filter *AlbumFilter, hard bool) (*BulkImpact, error) {
+	q := client.Album.Query()
+	if !hard {
+		q = q.Where(album.DeletedAtIsNil())
+	}
+	if len(ids) > 0 {
+		q = q.Where(album.IDIn(ids...))
+	} else {
+		if filter == nil {
+			return nil, ErrEmptyFilter
+		}
+		ps := filter.predicates()
+		if len(ps) == 0 {
+			return nil, ErrEmptyFilter
+		}
+		q = q.Where(ps...)
+	}
+	selected, err := q.Order(ent.Asc(album.FieldID)).Limit(MaxBulkAlbums + 1).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) > MaxBulkAlbums {
+		return nil, ErrTooManyAlbums
+	}
+
+	impact := &BulkImpact{Hard: hard, Albums: selected}
+	for _, id := range ids {
+		if !slices.Contains(selected, id) && !slices.Contains(impact.Missing, id) {
+			impact.Missing = append(impact.Missing, id)
+		}
+	}
+	if len(selected) > 0 {
+		tq := client.Track.Query().Where(track.AlbumIDIn(selected...))
+		if !hard {
+			tq = tq.Where(track.DeletedAtIsNil())
+		}
+		if impact.Tracks, err = tq.Count(ctx); err != nil {
+			return nil, err
+		}
+		if hard {
+			impact.Plays, err = client.Play.Query().
+				Where(play.HasTrackWith(track.AlbumIDIn(selected...))).
+				Count(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	impact.Token = bulkToken(selected, hard)
+	return impact, nil
+}
+
+// bulkToken fingerprints a selection, so a confirmed operation only goes
+// ahead if it would affect exactly the albums the dry run listed
+func bulkToken(ids []uuid.UUID, hard bool) string {
+	h := sha256.New()
+	if hard {
+		h.Write([]byte("hard"))
+	} else {
+		h.Write([]byte("archive"))
+	}
+	sorted := slices.Clone(ids)
+	slices.SortFunc(sorted, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	for _, id := range sorted {
+		h.Write(id[:])
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+// BulkResult reports what a bulk operation changed
+type BulkResult struct {
+	Hard    bool `json:"hard"`
+	Albums  int  `json:"albums"`
+	Tracks  int  `json:"tracks"`
+	Batches int  `json:"batches"`
+}
+
+// DeleteAlbums archives (soft-deletes) or, when hard, permanently deletes
+// albums with their tracks, batchSize albums per transaction so large
+// clean-ups never hold long locks. onBatch, if not nil, gets the running
+// totals after each committed batch. A failure or cancelled ctx stops the
+// run; batches already committed stay done and are reported with the error.
+func DeleteAlbums(ctx context.Context, client *ent.Client, ids []uuid.UUID, hard bool, batchSize int, onBatch func(BulkResult)) (BulkResult, error) {
+	result := BulkResult{Hard: hard}
+	if batchSize <= 0 {
+		return result, fmt.Errorf("batch size must be positive")
+	}
+	for batch := range slices.Chunk(ids, batchSize) {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		tracks, err := deleteAlbumBatch(ctx, client, batch, hard)
+		if err != nil {
+			return result, err
+		}
+		result.Albums += len(batch)
+		result.Tracks += tracks
+		result.Batches++
+		if onBatch != nil {
+			onBatch(result)
+		}
+		logger.Debug("album batch deleted", "hard", hard, "albums", len(batch), "tracks", tracks)
+	}
+	logger.Info("albums deleted in bulk", "hard", hard, "albums", result.Albums, "tracks", result.Tracks, "batches", result.Batches)
+	return result, nil
+}
+
+// deleteAlbumBatch deletes one batch in a transaction, returning how many
+// tracks went with it
+func deleteAlbumBatch(ctx context.Context, client *ent.Client, ids []uuid.UUID, hard bool) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	tq := tx.Track.Query().Where(track.AlbumIDIn(ids...))
+	if !hard {
+		tq = tq.Where(track.DeletedAtIsNil())
+	}
+	trackIDs, err := tq.IDs(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if hard {
+		err = purgeAlbums(ctx, tx, ids)
+	} else {
+		err = archiveAlbums(ctx, tx, ids, trackIDs)
+	}
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	return len(trackIDs), tx.Commit()
+}
+
+// archiveAlbums soft-deletes albums and their live tracks
+func archiveAlbums(ctx context.Context, tx *ent.Tx, ids, trackIDs []uuid.UUID) error {
+	now := time.Now()
+	if len(trackIDs) > 0 {
+		if _, err := tx.Track.Update().Where(track.IDIn(trackIDs...)).SetDeletedAt(now).Save(ctx); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Album.Update().Where(album.IDIn(ids...), album.DeletedAtIsNil()).SetDeletedAt(now).Save(ctx); err != nil {
+		return err
+	}
+	return recordDeletion(ctx, tx, trackIDs, ids, nil)
+}
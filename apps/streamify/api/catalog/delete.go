@@ -0,0 +1,234 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/play"
+	"streamify/ent/playcount"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+	"streamify/ent/uploadsession"
+	"streamify/logging"
+	"streamify/residency"
+	"streamify/tombstones"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("catalog")
+
+// DeletePolicy controls how deleting a parent entity treats its children
+type DeletePolicy string
+
+const (
+	// PolicyRestrict refuses to delete an entity that still has children
+	PolicyRestrict DeletePolicy = "restrict"
+	// PolicyCascade deletes the entity together with all of its children
+	PolicyCascade DeletePolicy = "cascade"
+)
+
+// ErrHasChildren is returned when a restricted delete finds dependent rows
+var ErrHasChildren = errors.New("entity has dependent children")
+
+// ParseDeletePolicy parses a policy name, defaulting to PolicyRestrict
+func ParseDeletePolicy(s string) (DeletePolicy, error) {
+	switch DeletePolicy(s) {
+	case "", PolicyRestrict:
+		return PolicyRestrict, nil
+	case PolicyCascade:
+		return PolicyCascade, nil
+	}
+	return "", fmt.Errorf("unknown delete policy %q", s)
+}
+
+// DeletionImpact describes the rows affected by deleting an artist
+type DeletionImpact struct {
+	ArtistID uuid.UUID    `json:"artist_id"`
+	Policy   DeletePolicy `json:"policy"`
+	Hard     bool         `json:"hard"`
+	Albums   []uuid.UUID  `json:"albums"`
+	Tracks   []uuid.UUID  `json:"tracks"`
+	Plays    int          `json:"plays"`
+	Blocked  bool         `json:"blocked"`
+}
+
+// ArtistDeletionImpact computes what deleting an artist would affect without changing anything.
+// Soft deletes only consider live children; hard deletes consider every row, including soft-deleted ones.
+func ArtistDeletionImpact(ctx context.Context, client *ent.Client, id uuid.UUID, policy DeletePolicy, hard bool) (*DeletionImpact, error) {
+	artistQuery := client.Artist.Query().Where(artist.IDEQ(id))
+	if !hard {
+		artistQuery = artistQuery.Where(artist.DeletedAtIsNil())
+	}
+	if _, err := artistQuery.OnlyID(ctx); err != nil {
+		return nil, err
+	}
+
+	albumQuery := client.Album.Query().Where(album.ArtistIDEQ(id))
+	if !hard {
+		albumQuery = albumQuery.Where(album.DeletedAtIsNil())
+	}
+	albumIDs, err := albumQuery.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trackIDs := []uuid.UUID{}
+	if len(albumIDs) > 0 {
+		trackQuery := client.Track.Query().Where(track.AlbumIDIn(albumIDs...))
+		if !hard {
+			trackQuery = trackQuery.Where(track.DeletedAtIsNil())
+		}
+		trackIDs, err = trackQuery.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plays := 0
+	if hard && len(trackIDs) > 0 {
+		plays, err = client.Play.Query().Where(play.TrackIDIn(trackIDs...)).Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &DeletionImpact{
+		ArtistID: id,
+		Policy:   policy,
+		Hard:     hard,
+		Albums:   albumIDs,
+		Tracks:   trackIDs,
+		Plays:    plays,
+		Blocked:  policy == PolicyRestrict && len(albumIDs) > 0,
+	}, nil
+}
+
+// DeleteArtist deletes an artist according to policy inside a single transaction.
+// A soft delete marks the artist, its albums and their tracks as deleted; a hard delete removes
+// the rows together with the plays that reference the deleted tracks.
+func DeleteArtist(ctx context.Context, client *ent.Client, id uuid.UUID, policy DeletePolicy, hard bool) (*DeletionImpact, error) {
+	// The catalog is kept in the home database
+	ctx = residency.Home(ctx)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	impact, err := ArtistDeletionImpact(ctx, tx.Client(), id, policy, hard)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if impact.Blocked {
+		return impact, rollback(tx, ErrHasChildren)
+	}
+
+	if hard {
+		err = hardDelete(ctx, tx, impact)
+	} else {
+		err = softDelete(ctx, tx, impact)
+	}
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := recordDeletion(ctx, tx, impact.Tracks, impact.Albums, []uuid.UUID{impact.ArtistID}); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	logger.Info("artist deleted", "artist_id", id, "hard", hard, "policy", policy,
+		"albums", len(impact.Albums), "tracks", len(impact.Tracks))
+	return impact, nil
+}
+
+// softDelete sets deleted_at on the artist and every affected album and track
+func softDelete(ctx context.Context, tx *ent.Tx, impact *DeletionImpact) error {
+	now := time.Now()
+	if len(impact.Tracks) > 0 {
+		if _, err := tx.Track.Update().Where(track.IDIn(impact.Tracks...)).SetDeletedAt(now).Save(ctx); err != nil {
+			return err
+		}
+	}
+	if len(impact.Albums) > 0 {
+		if _, err := tx.Album.Update().Where(album.IDIn(impact.Albums...)).SetDeletedAt(now).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return tx.Artist.UpdateOneID(impact.ArtistID).SetDeletedAt(now).Exec(ctx)
+}
+
+// hardDelete removes plays, credits, audio records, tracks, albums and the artist, children first to satisfy foreign keys
+func hardDelete(ctx context.Context, tx *ent.Tx, impact *DeletionImpact) error {
+	// The artist's credits on other artists' tracks go too
+	if _, err := tx.TrackCredit.Delete().Where(trackcredit.ArtistIDEQ(impact.ArtistID)).Exec(ctx); err != nil {
+		return err
+	}
+	if len(impact.Tracks) > 0 {
+		if _, err := tx.Play.Delete().Where(play.TrackIDIn(impact.Tracks...)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := deleteTrackRecords(ctx, tx, impact.Tracks); err != nil {
+			return err
+		}
+		if _, err := tx.Track.Delete().Where(track.IDIn(impact.Tracks...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if len(impact.Albums) > 0 {
+		if _, err := tx.Album.Delete().Where(album.IDIn(impact.Albums...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return tx.Artist.DeleteOneID(impact.ArtistID).Exec(ctx)
+}
+
+// recordDeletion writes tombstones for deleted tracks, albums and artists
+func recordDeletion(ctx context.Context, tx *ent.Tx, tracks, albums, artists []uuid.UUID) error {
+	if err := tombstones.Record(ctx, tx.Client(), tombstone.EntityTypeTrack, nil, tracks...); err != nil {
+		return err
+	}
+	if err := tombstones.Record(ctx, tx.Client(), tombstone.EntityTypeAlbum, nil, albums...); err != nil {
+		return err
+	}
+	return tombstones.Record(ctx, tx.Client(), tombstone.EntityTypeArtist, nil, artists...)
+}
+
+// deleteTrackRecords removes the credits, play counts, fingerprints, duplicate
+// reviews and upload sessions referencing tracks about to be deleted. Parts of
+// the sessions are left for the upload cleanup job.
+func deleteTrackRecords(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	if _, err := tx.TrackCredit.Delete().Where(trackcredit.TrackIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.PlayCount.Delete().Where(playcount.TrackIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.AudioFingerprint.Delete().Where(audiofingerprint.TrackIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.DuplicateReview.Delete().
+		Where(duplicatereview.Or(duplicatereview.TrackIDIn(ids...), duplicatereview.MatchTrackIDIn(ids...))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.UploadSession.Delete().Where(uploadsession.TrackIDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// rollback aborts tx and returns err, wrapping any rollback failure
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
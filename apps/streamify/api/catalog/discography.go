@@ -0,0 +1,158 @@
+package catalog
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/trackcredit"
+
+	"github.com/google/uuid"
+)
+
+// DiscographyAlbum is one release in an artist's discography
+type DiscographyAlbum struct {
+	ID          uuid.UUID       `json:"id"`
+	Title       string          `json:"title"`
+	ArtistID    uuid.UUID       `json:"artist_id"`
+	AlbumType   album.AlbumType `json:"album_type"`
+	ReleaseDate *time.Time      `json:"release_date,omitempty"`
+	ImageURL    string          `json:"image_url,omitempty"`
+	Palette     []string        `json:"palette,omitempty"`
+	// Roles lists how the artist is credited on appears-on releases
+	Roles []trackcredit.Role `json:"roles,omitempty"`
+}
+
+// DiscographyYear holds the releases of one year; Year is nil for releases
+// without a release date
+type DiscographyYear struct {
+	Year   *int               `json:"year"`
+	Albums []DiscographyAlbum `json:"albums"`
+}
+
+// Discography is an artist's releases grouped by type, newest year first.
+// AppearsOn lists other artists' releases with tracks crediting the artist.
+type Discography struct {
+	ArtistID     uuid.UUID         `json:"artist_id"`
+	Albums       []DiscographyYear `json:"albums"`
+	Singles      []DiscographyYear `json:"singles"`
+	EPs          []DiscographyYear `json:"eps"`
+	Compilations []DiscographyYear `json:"compilations"`
+	AppearsOn    []DiscographyYear `json:"appears_on"`
+}
+
+// ArtistDiscography returns the live releases of artist id and those it
+// appears on. Returns a not-found error when the artist doesn't exist.
+func ArtistDiscography(ctx context.Context, client *ent.Client, id uuid.UUID) (*Discography, error) {
+	if _, err := client.Artist.Query().Where(artist.IDEQ(id), artist.DeletedAtIsNil()).OnlyID(ctx); err != nil {
+		return nil, err
+	}
+
+	own, err := client.Album.Query().
+		Where(album.ArtistIDEQ(id), album.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appearsOn, err := appearances(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Discography{ArtistID: id}
+	byType := map[album.AlbumType][]*ent.Album{}
+	for _, a := range own {
+		byType[a.AlbumType] = append(byType[a.AlbumType], a)
+	}
+	d.Albums = groupByYear(byType[album.AlbumTypeAlbum])
+	d.Singles = groupByYear(byType[album.AlbumTypeSingle])
+	d.EPs = groupByYear(byType[album.AlbumTypeEp])
+	d.Compilations = groupByYear(byType[album.AlbumTypeCompilation])
+	d.AppearsOn = groupAppearancesByYear(appearsOn)
+	return d, nil
+}
+
+func hasRole(roles []trackcredit.Role, r trackcredit.Role) bool {
+	for _, have := range roles {
+		if have == r {
+			return true
+		}
+	}
+	return false
+}
+
+// discographyAlbum returns the discography entry for a
+func discographyAlbum(a *ent.Album) DiscographyAlbum {
+	return DiscographyAlbum{
+		ID:          a.ID,
+		Title:       a.Title,
+		ArtistID:    a.ArtistID,
+		AlbumType:   a.AlbumType,
+		ReleaseDate: a.ReleaseDate,
+		ImageURL:    a.ImageURL,
+		Palette:     a.Palette,
+	}
+}
+
+// releasedBefore orders releases by release date; undated releases sort first
+// and ties are broken by title, so sorting newest first puts them last
+func releasedBefore(a, b DiscographyAlbum) bool {
+	switch {
+	case a.ReleaseDate != nil && b.ReleaseDate != nil && !a.ReleaseDate.Equal(*b.ReleaseDate):
+		return a.ReleaseDate.Before(*b.ReleaseDate)
+	case (a.ReleaseDate == nil) != (b.ReleaseDate == nil):
+		return a.ReleaseDate == nil
+	}
+	return a.Title > b.Title
+}
+
+// groupByYear sorts albums newest first and groups them by release year.
+// Undated releases come last; releases within a year are ordered by title.
+func groupByYear(albums []*ent.Album) []DiscographyYear {
+	entries := make([]DiscographyAlbum, len(albums))
+	for i, a := range albums {
+		entries[i] = discographyAlbum(a)
+	}
+	sort.Slice(entries, func(i, j int) bool { return releasedBefore(entries[j], entries[i]) })
+	return groupSorted(entries)
+}
+
+// groupAppearancesByYear groups appearances, already sorted newest first, by
+// release year, keeping the artist's roles on each
+func groupAppearancesByYear(apps []Appearance) []DiscographyYear {
+	entries := make([]DiscographyAlbum, len(apps))
+	for i, app := range apps {
+		entries[i] = app.DiscographyAlbum
+	}
+	return groupSorted(entries)
+}
+
+// groupSorted groups consecutive entries released in the same year
+func groupSorted(entries []DiscographyAlbum) []DiscographyYear {
+	years := []DiscographyYear{}
+	for _, e := range entries {
+		var year *int
+		if e.ReleaseDate != nil {
+			y := e.ReleaseDate.Year()
+			year = &y
+		}
+		last := len(years) - 1
+		if last < 0 || !sameYear(years[last].Year, year) {
+			years = append(years, DiscographyYear{Year: year})
+			last++
+		}
+		years[last].Albums = append(years[last].Albums, e)
+	}
+	return years
+}
+
+func sameYear(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,284 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/play"
+	"streamify/ent/track"
+
+	"github.com/google/uuid"
+)
+
+// sampleSize is the number of example IDs included in an orphan report
+const sampleSize = 20
+
+// FixMode selects how orphaned rows are fixed
+type FixMode string
+
+const (
+	// FixRepair soft-deletes live rows whose parent has been soft-deleted
+	FixRepair FixMode = "repair"
+	// FixPurge permanently removes rows whose parent no longer exists
+	FixPurge FixMode = "purge"
+)
+
+// OrphanReport summarizes the result of a single integrity check
+type OrphanReport struct {
+	Check       string      `json:"check"`
+	Description string      `json:"description"`
+	Count       int         `json:"count"`
+	Sample      []uuid.UUID `json:"sample"`
+	Fix         FixMode     `json:"fix"`
+}
+
+// FixResult reports how many rows a fix run changed per check
+type FixResult struct {
+	Check   string  `json:"check"`
+	Mode    FixMode `json:"mode"`
+	Fixed   int     `json:"fixed"`
+	Batches int     `json:"batches"`
+}
+
+// orphanCheck finds one kind of orphaned row and knows how to fix it
+type orphanCheck struct {
+	name        string
+	description string
+	mode        FixMode
+	// ids returns up to limit matching row IDs
+	ids func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error)
+	// count returns the number of matching rows
+	count func(ctx context.Context, client *ent.Client) (int, error)
+	// fix repairs or purges the given rows inside tx
+	fix func(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error
+}
+
+// orphanChecks lists the integrity checks in the order they must be fixed (children first)
+var orphanChecks = []orphanCheck{
+	{
+		name:        "plays_missing_user",
+		description: "Plays referencing a user that no longer exists",
+		mode:        FixPurge,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Play.Query().Where(play.Not(play.HasUser())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Play.Query().Where(play.Not(play.HasUser())).Count(ctx)
+		},
+		fix: purgePlays,
+	},
+	{
+		name:        "plays_missing_track",
+		description: "Plays referencing a track that no longer exists",
+		mode:        FixPurge,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Play.Query().Where(play.Not(play.HasTrack())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Play.Query().Where(play.Not(play.HasTrack())).Count(ctx)
+		},
+		fix: purgePlays,
+	},
+	{
+		name:        "plays_of_deleted_tracks",
+		description: "Plays referencing a soft-deleted track",
+		mode:        FixPurge,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Play.Query().Where(play.HasTrackWith(track.DeletedAtNotNil())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Play.Query().Where(play.HasTrackWith(track.DeletedAtNotNil())).Count(ctx)
+		},
+		fix: purgePlays,
+	},
+	{
+		name:        "tracks_missing_album",
+		description: "Tracks referencing an album that no longer exists",
+		mode:        FixPurge,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Track.Query().Where(track.Not(track.HasAlbum())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Track.Query().Where(track.Not(track.HasAlbum())).Count(ctx)
+		},
+		fix: purgeTracks,
+	},
+	{
+		name:        "live_tracks_of_deleted_albums",
+		description: "Live tracks whose album has been soft-deleted",
+		mode:        FixRepair,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Track.Query().Where(track.DeletedAtIsNil(), track.HasAlbumWith(album.DeletedAtNotNil())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Track.Query().Where(track.DeletedAtIsNil(), track.HasAlbumWith(album.DeletedAtNotNil())).Count(ctx)
+		},
+		fix: func(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+			if err := tx.Track.Update().Where(track.IDIn(ids...)).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+				return err
+			}
+			return recordDeletion(ctx, tx, ids, nil, nil)
+		},
+	},
+	{
+		name:        "albums_missing_artist",
+		description: "Albums referencing an artist that no longer exists",
+		mode:        FixPurge,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Album.Query().Where(album.Not(album.HasArtist())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Album.Query().Where(album.Not(album.HasArtist())).Count(ctx)
+		},
+		fix: purgeAlbums,
+	},
+	{
+		name:        "live_albums_of_deleted_artists",
+		description: "Live albums whose artist has been soft-deleted",
+		mode:        FixRepair,
+		ids: func(ctx context.Context, client *ent.Client, limit int) ([]uuid.UUID, error) {
+			return client.Album.Query().Where(album.DeletedAtIsNil(), album.HasArtistWith(artist.DeletedAtNotNil())).Limit(limit).IDs(ctx)
+		},
+		count: func(ctx context.Context, client *ent.Client) (int, error) {
+			return client.Album.Query().Where(album.DeletedAtIsNil(), album.HasArtistWith(artist.DeletedAtNotNil())).Count(ctx)
+		},
+		fix: func(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+			now := time.Now()
+			trackIDs, err := tx.Track.Query().Where(track.AlbumIDIn(ids...), track.DeletedAtIsNil()).IDs(ctx)
+			if err != nil {
+				return err
+			}
+			if err := tx.Track.Update().Where(track.IDIn(trackIDs...)).SetDeletedAt(now).Exec(ctx); err != nil {
+				return err
+			}
+			if err := tx.Album.Update().Where(album.IDIn(ids...)).SetDeletedAt(now).Exec(ctx); err != nil {
+				return err
+			}
+			return recordDeletion(ctx, tx, trackIDs, ids, nil)
+		},
+	},
+}
+
+// ScanOrphans runs every integrity check and reports the rows it finds
+func ScanOrphans(ctx context.Context, client *ent.Client) ([]OrphanReport, error) {
+	reports := make([]OrphanReport, 0, len(orphanChecks))
+	for _, check := range orphanChecks {
+		count, err := check.count(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", check.name, err)
+		}
+		sample := []uuid.UUID{}
+		if count > 0 {
+			sample, err = check.ids(ctx, client, sampleSize)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", check.name, err)
+			}
+		}
+		reports = append(reports, OrphanReport{
+			Check:       check.name,
+			Description: check.description,
+			Count:       count,
+			Sample:      sample,
+			Fix:         check.mode,
+		})
+	}
+	return reports, nil
+}
+
+// FixOrphans applies every check matching mode in batches of batchSize rows.
+// Each batch runs in its own transaction so large repairs never hold long locks.
+// onBatch, if not nil, gets the results so far after each committed batch; a
+// cancelled ctx stops the run between batches.
+func FixOrphans(ctx context.Context, client *ent.Client, mode FixMode, batchSize int, onBatch func([]FixResult)) ([]FixResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("batch size must be positive")
+	}
+
+	results := []FixResult{}
+	for _, check := range orphanChecks {
+		if check.mode != mode {
+			continue
+		}
+		result := FixResult{Check: check.name, Mode: mode}
+		for {
+			if err := ctx.Err(); err != nil {
+				return append(results, result), err
+			}
+			ids, err := check.ids(ctx, client, batchSize)
+			if err != nil {
+				return results, fmt.Errorf("%s: %w", check.name, err)
+			}
+			if len(ids) == 0 {
+				break
+			}
+			if err := fixBatch(ctx, client, check, ids); err != nil {
+				return results, fmt.Errorf("%s: %w", check.name, err)
+			}
+			result.Fixed += len(ids)
+			result.Batches++
+			if onBatch != nil {
+				onBatch(append(slices.Clone(results), result))
+			}
+			logger.Debug("orphan batch fixed", "check", check.name, "mode", mode, "rows", len(ids))
+		}
+		if result.Fixed > 0 {
+			logger.Info("orphans fixed", "check", check.name, "mode", mode, "rows", result.Fixed, "batches", result.Batches)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// fixBatch fixes one batch of rows in a transaction
+func fixBatch(ctx context.Context, client *ent.Client, check orphanCheck, ids []uuid.UUID) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := check.fix(ctx, tx, ids); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// purgePlays permanently deletes plays
+func purgePlays(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	_, err := tx.Play.Delete().Where(play.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// purgeTracks permanently deletes tracks and the plays, credits and audio records referencing them
+func purgeTracks(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	if _, err := tx.Play.Delete().Where(play.TrackIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if err := deleteTrackRecords(ctx, tx, ids); err != nil {
+		return err
+	}
+	if _, err := tx.Track.Delete().Where(track.IDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	return recordDeletion(ctx, tx, ids, nil, nil)
+}
+
+// purgeAlbums permanently deletes albums together with their tracks and plays
+func purgeAlbums(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) error {
+	trackIDs, err := tx.Track.Query().Where(track.AlbumIDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(trackIDs) > 0 {
+		if err := purgeTracks(ctx, tx, trackIDs); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Album.Delete().Where(album.IDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	return recordDeletion(ctx, tx, nil, ids, nil)
+}
@@ -0,0 +1,116 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/track"
+	"streamify/residency"
+
+	"github.com/google/uuid"
+)
+
+// TracklistEntry places one track on an album
+type TracklistEntry struct {
+	TrackID uuid.UUID
+	Disc    int
+}
+
+// TracklistError explains why a tracklist doesn't match the album's tracks
+type TracklistError struct {
+	// Foreign lists tracks that aren't on the album
+	Foreign []uuid.UUID `json:"foreign,omitempty"`
+	// Duplicate lists tracks given more than once
+	Duplicate []uuid.UUID `json:"duplicate,omitempty"`
+	// Missing lists album tracks left out of the tracklist
+	Missing []uuid.UUID `json:"missing,omitempty"`
+}
+
+func (e *TracklistError) Error() string {
+	var parts []string
+	if len(e.Foreign) > 0 {
+		parts = append(parts, fmt.Sprintf("%d tracks not on the album", len(e.Foreign)))
+	}
+	if len(e.Duplicate) > 0 {
+		parts = append(parts, fmt.Sprintf("%d tracks listed more than once", len(e.Duplicate)))
+	}
+	if len(e.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("%d album tracks missing", len(e.Missing)))
+	}
+	return "invalid tracklist: " + strings.Join(parts, ", ")
+}
+
+// SetTracklist reorders an album's tracks inside a single transaction. entries
+// must list every live track on the album exactly once, in order; tracks are
+// numbered from 1 within each disc. Returns the tracks in their new order.
+func SetTracklist(ctx context.Context, client *ent.Client, albumID uuid.UUID, entries []TracklistEntry) ([]*ent.Track, error) {
+	// The catalog is kept in the home database
+	ctx = residency.Home(ctx)
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Album.Query().Where(album.IDEQ(albumID), album.DeletedAtIsNil()).OnlyID(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	ids, err := tx.Track.Query().
+		Where(track.AlbumIDEQ(albumID), track.DeletedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	onAlbum := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		onAlbum[id] = false
+	}
+	verr := &TracklistError{}
+	for _, e := range entries {
+		listed, ok := onAlbum[e.TrackID]
+		switch {
+		case !ok:
+			verr.Foreign = append(verr.Foreign, e.TrackID)
+		case listed:
+			verr.Duplicate = append(verr.Duplicate, e.TrackID)
+		default:
+			onAlbum[e.TrackID] = true
+		}
+	}
+	for _, id := range ids {
+		if !onAlbum[id] {
+			verr.Missing = append(verr.Missing, id)
+		}
+	}
+	if len(verr.Foreign) > 0 || len(verr.Duplicate) > 0 || len(verr.Missing) > 0 {
+		return nil, rollback(tx, verr)
+	}
+
+	next := map[int]int{}
+	for _, e := range entries {
+		next[e.Disc]++
+		err := tx.Track.UpdateOneID(e.TrackID).
+			SetDiscNumber(e.Disc).
+			SetTrackNumber(next[e.Disc]).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	tracks, err := tx.Track.Query().
+		Where(track.AlbumIDEQ(albumID), track.DeletedAtIsNil()).
+		Order(ent.Asc(track.FieldDiscNumber), ent.Asc(track.FieldTrackNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	logger.Info("album tracklist updated", "album_id", albumID, "tracks", len(entries))
+	return tracks, nil
+}
@@ -0,0 +1,61 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+
+	"streamify/ent"
+	"streamify/ent/track"
+
+	"github.com/google/uuid"
+)
+
+// ErrCanonicalNotFound is returned when a version names a canonical track that
+// doesn't exist
+var ErrCanonicalNotFound = errors.New("canonical track not found")
+
+// ResolveCanonical returns the original recording a new version of id should
+// link to. Versions of versions link to the original, so every version group
+// is one level deep.
+func ResolveCanonical(ctx context.Context, client *ent.Client, id uuid.UUID) (uuid.UUID, error) {
+	t, err := client.Track.Query().
+		Where(track.IDEQ(id), track.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return uuid.Nil, ErrCanonicalNotFound
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if t.CanonicalTrackID != nil {
+		return *t.CanonicalTrackID, nil
+	}
+	return t.ID, nil
+}
+
+// TrackVersions returns the original recording of track id and its live
+// versions, ordered by version type then age. A track whose original was
+// deleted is treated as the original.
+func TrackVersions(ctx context.Context, client *ent.Client, id uuid.UUID) (*ent.Track, []*ent.Track, error) {
+	t, err := client.Track.Query().
+		Where(track.IDEQ(id), track.DeletedAtIsNil()).
+		WithCanonical(func(q *ent.TrackQuery) { q.Where(track.DeletedAtIsNil()) }).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	canonical := t
+	if t.Edges.Canonical != nil {
+		canonical = t.Edges.Canonical
+	}
+	canonical.Edges.Canonical = nil
+
+	versions, err := client.Track.Query().
+		Where(track.CanonicalTrackIDEQ(canonical.ID), track.DeletedAtIsNil()).
+		Order(ent.Asc(track.FieldVersionType), ent.Asc(track.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return canonical, versions, nil
+}
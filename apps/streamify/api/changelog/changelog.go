@@ -0,0 +1,79 @@
+// Package changelog serves the what's new feed: release notes admins write
+// and publish, marked new until each user has caught up on them, so clients
+// can show what a release brought once.
+package changelog
+
+import (
+	"context"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/changelogentry"
+	"streamify/ent/predicate"
+	"streamify/paging"
+)
+
+// Entry is a changelog entry in a user's feed
+type Entry struct {
+	*ent.ChangelogEntry
+	// New is set on entries published since the user last caught up
+	New bool `json:"new"`
+}
+
+// published matches the entries in the feed at now, leaving out drafts and
+// entries scheduled for later
+func published(now time.Time) predicate.ChangelogEntry {
+	return changelogentry.PublishedAtLTE(now)
+}
+
+// newAfter returns the time entries must be published after to be new to a
+// user who last caught up at seenAt. A user who never has is only shown the
+// latest entry as new, rather than every release from before they signed up.
+func newAfter(ctx context.Context, client *ent.Client, seenAt *time.Time, now time.Time) (time.Time, error) {
+	if seenAt != nil {
+		return *seenAt, nil
+	}
+	previous, err := client.ChangelogEntry.Query().
+		Where(published(now)).
+		Order(ent.Desc(changelogentry.FieldPublishedAt)).
+		Offset(1).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *previous.PublishedAt, nil
+}
+
+// Feed returns a page of the published entries, newest first, to a user who
+// last caught up at seenAt, and how many entries in all are new to them
+func Feed(ctx context.Context, client *ent.Client, seenAt *time.Time, p paging.Params) (entries []Entry, total, unseen int, err error) {
+	now := time.Now()
+	after, err := newAfter(ctx, client, seenAt, now)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	q := client.ChangelogEntry.Query().Where(published(now))
+	all := q.Clone()
+	page, err := q.
+		Order(ent.Desc(changelogentry.FieldPublishedAt), ent.Desc(changelogentry.FieldID)).
+		Limit(p.Limit).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if total, err = paging.Total(ctx, p, len(page), all); err != nil {
+		return nil, 0, 0, err
+	}
+	if unseen, err = all.Clone().Where(changelogentry.PublishedAtGT(after)).Count(ctx); err != nil {
+		return nil, 0, 0, err
+	}
+	entries = make([]Entry, len(page))
+	for i, e := range page {
+		entries[i] = Entry{ChangelogEntry: e, New: e.PublishedAt.After(after)}
+	}
+	return entries, total, unseen, nil
+}
@@ -0,0 +1,232 @@
+package changelog
+
+import (
+	"net/http"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/changelogentry"
+	"streamify/ent/predicate"
+	"streamify/filtering"
+	"streamify/ids"
+	"streamify/paging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeedPage is a page of a user's feed with how many entries are new to them
+type FeedPage struct {
+	*paging.Page[Entry]
+	Unseen int `json:"unseen"`
+}
+
+// GetFeed returns the published changelog, newest first, a page at a time,
+// marking the entries published since the current user last caught up
+func GetFeed(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		ctx := c.Request.Context()
+		u, err := client.User.Get(ctx, userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		entries, total, unseen, err := Feed(ctx, client, u.ChangelogSeenAt, p)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, FeedPage{Page: paging.New(c, p, entries, total), Unseen: unseen})
+	}
+}
+
+// MarkSeen records that the current user caught up on the changelog, so no
+// entry published so far is new to them anymore. Impersonated sessions
+// can't catch up on a user's behalf.
+func MarkSeen(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if viewer.FromContext(c.Request.Context()).Impersonated() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "the changelog can only be marked seen by the user"})
+			return
+		}
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		if err := client.User.UpdateOneID(userID).SetChangelogSeenAt(time.Now()).Exec(c.Request.Context()); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// entryFields are what the admin list of entries may be filtered and sorted by
+var entryFields = filtering.Fields{
+	"version":   {Column: changelogentry.FieldVersion, Kind: filtering.Text},
+	"title":     {Column: changelogentry.FieldTitle, Kind: filtering.Text},
+	"published": {Column: changelogentry.FieldPublishedAt, Kind: filtering.Time},
+	"created":   {Column: changelogentry.FieldCreatedAt, Kind: filtering.Time},
+}
+
+// ListEntries lists every changelog entry, drafts and scheduled entries
+// included, newest first, a page at a time (admin)
+func ListEntries(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		f, ok := filtering.Parse(c, entryFields)
+		if !ok {
+			return
+		}
+		q := client.ChangelogEntry.Query().Where(filtering.Where[predicate.ChangelogEntry](f)...)
+		all := q.Clone()
+		entries, err := q.
+			Order(filtering.Order[changelogentry.OrderOption](f, ent.Desc(changelogentry.FieldCreatedAt), ent.Desc(changelogentry.FieldID))...).
+			Limit(p.Limit).
+			Offset(p.Offset).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		total, err := paging.Total(c.Request.Context(), p, len(entries), all)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, paging.New(c, p, entries, total))
+	}
+}
+
+// CreateRequest is the request body for CreateEntry
+type CreateRequest struct {
+	Version string `json:"version" binding:"required,max=64"`
+	Title   string `json:"title" binding:"required,max=200"`
+	Body    string `json:"body" binding:"max=20000"`
+	// PublishAt puts the entry in the feed at that time, now or later;
+	// drafts leave it out
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// CreateEntry writes a changelog entry, published or as a draft (admin)
+func CreateEntry(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreateRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		create := client.ChangelogEntry.Create().
+			SetVersion(body.Version).
+			SetTitle(body.Title).
+			SetBody(body.Body).
+			SetNillablePublishedAt(body.PublishAt)
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			create.SetCreatedBy(id)
+		}
+		e, err := create.Save(c.Request.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "the changelog already has an entry for this version"})
+				return
+			}
+			if ent.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, e)
+	}
+}
+
+// UpdateRequest is the request body for UpdateEntry. Omitted fields are left
+// alone.
+type UpdateRequest struct {
+	Version   *string    `json:"version" binding:"omitempty,max=64"`
+	Title     *string    `json:"title" binding:"omitempty,max=200"`
+	Body      *string    `json:"body" binding:"omitempty,max=20000"`
+	PublishAt *time.Time `json:"publish_at"`
+	// Draft takes the entry out of the feed again
+	Draft bool `json:"draft"`
+}
+
+// UpdateEntry edits a changelog entry, publishes it or takes it back to a
+// draft (admin). Entries edited after users saw them aren't new to them
+// again.
+func UpdateEntry(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid changelog entry ID"})
+			return
+		}
+		var body UpdateRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if body.Draft && body.PublishAt != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "an entry can't be published and a draft"})
+			return
+		}
+
+		update := client.ChangelogEntry.UpdateOneID(id).
+			SetNillableVersion(body.Version).
+			SetNillableTitle(body.Title).
+			SetNillableBody(body.Body).
+			SetNillablePublishedAt(body.PublishAt)
+		if body.Draft {
+			update.ClearPublishedAt()
+		}
+		e, err := update.Save(c.Request.Context())
+		if err != nil {
+			switch {
+			case ent.IsNotFound(err):
+				c.JSON(http.StatusNotFound, gin.H{"error": "changelog entry not found"})
+			case ent.IsConstraintError(err):
+				c.JSON(http.StatusConflict, gin.H{"error": "the changelog already has an entry for this version"})
+			case ent.IsValidationError(err):
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
+		c.JSON(http.StatusOK, e)
+	}
+}
+
+// DeleteEntry removes a changelog entry from the feed for good (admin)
+func DeleteEntry(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid changelog entry ID"})
+			return
+		}
+		if err := client.ChangelogEntry.DeleteOneID(id).Exec(c.Request.Context()); err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "changelog entry not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,228 @@
+// Package changes broadcasts entity changes to every API instance over
+// Postgres LISTEN/NOTIFY, so in-memory caches drop what another instance
+// changed instead of serving it until their TTL runs out. An Ent hook sends a
+// notification with each mutation of the watched types; since NOTIFY is
+// transactional, it is delivered when the change commits and never for a
+// rolled back one. Delivery is best effort: the TTLs still bound staleness
+// when a notification is lost.
+package changes
+
+import (
+	"context"
+	stdsql "database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"streamify/ent"
+	"streamify/logging"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var logger = logging.For("changes")
+
+// Channel is the notification channel changes are sent on
+const Channel = "streamify_changes"
+
+// maxIDs keeps payloads well under Postgres' 8000 byte limit; larger changes
+// are sent without IDs
+const maxIDs = 100
+
+// Change is the payload of a notification. IDs is empty when the change
+// touched more entities than it lists, e.g. a bulk update; subscribers then
+// treat every entity of the type as changed.
+type Change struct {
+	Type string      `json:"type"`
+	IDs  []uuid.UUID `json:"ids,omitempty"`
+}
+
+// Hook returns an Ent hook notifying other instances of mutations of the
+// given entity types. Single-entity mutations carry the entity's ID; bulk
+// updates and deletes don't.
+func Hook(types ...string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil || !slices.Contains(types, m.Type()) {
+				return v, err
+			}
+			change := Change{Type: m.Type()}
+			if m.Op().Is(ent.OpCreate | ent.OpUpdateOne | ent.OpDeleteOne) {
+				if im, ok := m.(interface{ ID() (uuid.UUID, bool) }); ok {
+					if id, ok := im.ID(); ok {
+						change.IDs = []uuid.UUID{id}
+					}
+				}
+			}
+			// The change is already written; a lost notification only delays
+			// other instances until their caches expire
+			if err := notify(ctx, m, change); err != nil {
+				logger.Warn("notifying change failed", "type", change.Type, "error", err)
+			}
+			return v, nil
+		})
+	}
+}
+
+// notify sends change through the mutation's connection, so inside a
+// transaction it goes out on commit
+func notify(ctx context.Context, m ent.Mutation, change Change) error {
+	ex, ok := m.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return fmt.Errorf("changes: %s mutation cannot execute statements", m.Type())
+	}
+	if len(change.IDs) > maxIDs {
+		change.IDs = nil
+	}
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	_, err = ex.ExecContext(ctx, "SELECT pg_notify($1, $2)", Channel, string(payload))
+	return err
+}
+
+// Listener receives changes made on any instance, including this one, and
+// hands them to the functions subscribed to their type
+type Listener struct {
+	mu       sync.Mutex
+	handlers map[string][]func(ids []uuid.UUID)
+	listener *pq.Listener
+
+	connected atomic.Bool
+	received  atomic.Int64
+	resyncs   atomic.Int64
+}
+
+// NewListener returns a Listener without subscribers; it receives nothing
+// until started
+func NewListener() *Listener {
+	return &Listener{handlers: map[string][]func([]uuid.UUID){}}
+}
+
+// On subscribes fn to changes of entity type typ. ids is nil when every
+// entity of the type may have changed.
+func (l *Listener) On(typ string, fn func(ids []uuid.UUID)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handlers[typ] = append(l.handlers[typ], fn)
+}
+
+// Start listens on dsn, which must reach Postgres directly rather than
+// through a transaction pooler, since LISTEN holds a session. The
+// connection is re-established when lost; as notifications sent meanwhile
+// are missed, every subscriber is then told everything changed.
+func (l *Listener) Start(dsn string) error {
+	l.listener = pq.NewListener(dsn, time.Second, time.Minute, l.event)
+	if err := l.listener.Listen(Channel); err != nil {
+		l.listener.Close()
+		return fmt.Errorf("changes: listening on %s: %w", Channel, err)
+	}
+	go l.receive()
+	return nil
+}
+
+// Close stops listening
+func (l *Listener) Close() error {
+	if l.listener == nil {
+		return nil
+	}
+	return l.listener.Close()
+}
+
+func (l *Listener) event(ev pq.ListenerEventType, err error) {
+	switch ev {
+	case pq.ListenerEventConnected, pq.ListenerEventReconnected:
+		l.connected.Store(true)
+	case pq.ListenerEventDisconnected:
+		l.connected.Store(false)
+		logger.Warn("change listener disconnected", "error", err)
+	case pq.ListenerEventConnectionAttemptFailed:
+		l.connected.Store(false)
+	}
+}
+
+func (l *Listener) receive() {
+	for {
+		select {
+		case n, ok := <-l.listener.NotificationChannel():
+			if !ok {
+				return
+			}
+			// A nil notification follows a reconnect
+			if n == nil {
+				l.resyncs.Add(1)
+				logger.Info("change listener reconnected; dropping cached entries")
+				l.dispatchAll()
+				continue
+			}
+			var change Change
+			if err := json.Unmarshal([]byte(n.Extra), &change); err != nil {
+				logger.Warn("ignoring malformed change notification", "payload", n.Extra, "error", err)
+				continue
+			}
+			l.received.Add(1)
+			l.dispatch(change)
+		case <-time.After(90 * time.Second):
+			// Detects a dead connection that would otherwise go unnoticed
+			go l.listener.Ping()
+		}
+	}
+}
+
+func (l *Listener) dispatch(change Change) {
+	l.mu.Lock()
+	fns := slices.Clone(l.handlers[change.Type])
+	l.mu.Unlock()
+	ids := change.IDs
+	if len(ids) == 0 {
+		ids = nil
+	}
+	for _, fn := range fns {
+		fn(ids)
+	}
+}
+
+func (l *Listener) dispatchAll() {
+	l.mu.Lock()
+	types := make([]string, 0, len(l.handlers))
+	for typ := range l.handlers {
+		types = append(types, typ)
+	}
+	l.mu.Unlock()
+	for _, typ := range types {
+		l.dispatch(Change{Type: typ})
+	}
+}
+
+// Status is the listener's state for /health
+type Status struct {
+	Connected bool  `json:"connected"`
+	Received  int64 `json:"received"`
+	Resyncs   int64 `json:"resyncs"`
+}
+
+// Health implements resilience.Component
+func (l *Listener) Health() (bool, any) {
+	s := Status{Connected: l.connected.Load(), Received: l.received.Load(), Resyncs: l.resyncs.Load()}
+	return s.Connected, s
+}
+
+// WriteMetrics implements resilience.Component
+func (l *Listener) WriteMetrics(w io.Writer) {
+	connected := 0
+	if l.connected.Load() {
+		connected = 1
+	}
+	fmt.Fprintf(w, "# HELP change_listener_up Whether the change notification listener is connected.\n# TYPE change_listener_up gauge\nchange_listener_up %d\n", connected)
+	fmt.Fprintf(w, "# HELP change_notifications_received_total Change notifications received from any instance.\n# TYPE change_notifications_received_total counter\nchange_notifications_received_total %d\n", l.received.Load())
+	fmt.Fprintf(w, "# HELP change_listener_resyncs_total Times every subscriber was reset after the listener reconnected.\n# TYPE change_listener_resyncs_total counter\nchange_listener_resyncs_total %d\n", l.resyncs.Load())
+}
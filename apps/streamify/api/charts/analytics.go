@@ -0,0 +1,99 @@
+package charts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"streamify/analytics"
+
+	"github.com/google/uuid"
+)
+
+// analyticsOverfetch is how many times the requested rows a ranking reads
+// from the analytics store, which doesn't know what has been deleted since;
+// deleted entities are dropped when the rows are loaded
+const analyticsOverfetch = 2
+
+// analyticsWindow builds the WHERE clause and parameters for a window
+func analyticsWindow(w Window, now time.Time) (string, map[string]string) {
+	where := "played_at >= toDate({since:String})"
+	params := map[string]string{"since": w.since(now)}
+	if w.Territory != "" {
+		where += " AND territory = {territory:String}"
+		params["territory"] = w.Territory
+	}
+	return where, params
+}
+
+// analyticsRanking ranks the values of column, track_id or artist_id, by
+// plays within the window
+func analyticsRanking(ctx context.Context, ch *analytics.ClickHouse, column string, w Window) ([]ranked, error) {
+	where, params := analyticsWindow(w, time.Now())
+	params["limit"] = strconv.Itoa(w.Limit * analyticsOverfetch)
+	var rows []struct {
+		ID    uuid.UUID `json:"id"`
+		Plays int64     `json:"plays"`
+	}
+	query := fmt.Sprintf(`SELECT %[1]s AS id, count() AS plays
+FROM plays
+WHERE %[2]s
+GROUP BY %[1]s
+ORDER BY plays DESC, %[1]s
+LIMIT {limit:UInt32}`, column, where)
+	if err := ch.Query(ctx, query, params, &rows); err != nil {
+		return nil, err
+	}
+	out := make([]ranked, len(rows))
+	for i, r := range rows {
+		out[i] = ranked{id: r.ID, plays: r.Plays}
+	}
+	return out, nil
+}
+
+// analyticsStats computes Stats over the plays whose column, track_id or
+// artist_id, is id
+func analyticsStats(ctx context.Context, ch *analytics.ClickHouse, column string, id uuid.UUID) (*Stats, error) {
+	now := time.Now()
+	params := map[string]string{
+		"id":      id.String(),
+		"since7":  Window{Days: 7}.since(now),
+		"since30": Window{Days: statsDays}.since(now),
+	}
+	scope := column + " = {id:UUID}"
+
+	s := &Stats{Daily: []DayCount{}, TopTerritories: []TerritoryCount{}}
+
+	var totals []struct {
+		Total  int64 `json:"total"`
+		Last7  int64 `json:"last_7"`
+		Last30 int64 `json:"last_30"`
+	}
+	err := ch.Query(ctx, fmt.Sprintf(`SELECT
+	count() AS total,
+	countIf(played_at >= toDate({since7:String})) AS last_7,
+	countIf(played_at >= toDate({since30:String})) AS last_30
+FROM plays WHERE %s`, scope), params, &totals)
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 1 {
+		s.TotalPlays, s.Last7Days, s.Last30Days = totals[0].Total, totals[0].Last7, totals[0].Last30
+	}
+
+	err = ch.Query(ctx, fmt.Sprintf(`SELECT toString(toDate(played_at)) AS day, count() AS plays
+FROM plays WHERE %s AND played_at >= toDate({since30:String})
+GROUP BY day ORDER BY day`, scope), params, &s.Daily)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.Query(ctx, fmt.Sprintf(`SELECT territory, count() AS plays
+FROM plays WHERE %s AND territory != ''
+GROUP BY territory ORDER BY plays DESC, territory LIMIT 10`, scope), params, &s.TopTerritories)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,307 @@
+// Package charts serves play rankings and per-entity listening stats. In the
+// database, every query reads the track_play_daily materialized view
+// maintained by the migration package, never the plays table, so latency
+// tracks catalog size rather than play history; figures lag live plays by up
+// to one refresh. When an analytics store is configured, queries go there
+// instead, falling back to the view while it is unavailable. Users' personal
+// top lists are read from the play counts kept by the playcounts package.
+package charts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"streamify/analytics"
+	"streamify/ent"
+	"streamify/loader"
+	"streamify/logging"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("charts")
+
+// Window selects the days and territory a chart covers
+type Window struct {
+	Days      int
+	Territory string // ISO country code; empty for worldwide
+	Limit     int
+}
+
+// key identifies the window among concurrent chart reads
+func (w Window) key() string {
+	return fmt.Sprintf("%d/%s/%d", w.Days, w.Territory, w.Limit)
+}
+
+// since returns the first UTC day inside the window, as a date literal
+func (w Window) since(now time.Time) string {
+	return now.UTC().AddDate(0, 0, -(w.Days - 1)).Format(time.DateOnly)
+}
+
+// TrackEntry is one row of a track chart
+type TrackEntry struct {
+	Rank  int        `json:"rank"`
+	Plays int64      `json:"plays"`
+	Track *ent.Track `json:"track"`
+}
+
+// ArtistEntry is one row of an artist chart
+type ArtistEntry struct {
+	Rank   int         `json:"rank"`
+	Plays  int64       `json:"plays"`
+	Artist *ent.Artist `json:"artist"`
+}
+
+// ranked is an ID with its play count, in chart order
+type ranked struct {
+	id    uuid.UUID
+	plays int64
+}
+
+// queryRanking runs a ranking query selecting (id, plays) rows
+func queryRanking(ctx context.Context, client *ent.Client, query string, args ...any) ([]ranked, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []ranked
+	for rows.Next() {
+		var r ranked
+		if err := rows.Scan(&r.id, &r.plays); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+// windowFilter builds the WHERE clause shared by the charts; args holds the
+// since date, then the territory when one is set
+func windowFilter(w Window, now time.Time) (string, []any) {
+	where := "v.day >= $1::date"
+	args := []any{w.since(now)}
+	if w.Territory != "" {
+		args = append(args, w.Territory)
+		where += fmt.Sprintf(" AND v.territory = $%d", len(args))
+	}
+	return where, args
+}
+
+// TopTracks ranks live tracks by plays within the window, reading ch when
+// it isn't nil
+func TopTracks(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, w Window) ([]TrackEntry, error) {
+	ranking, err := trackRanking(ctx, client, ch, w)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranking))
+	for i, r := range ranking {
+		ids[i] = r.id
+	}
+	tracks, err := loader.For(ctx, client).Tracks.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TrackEntry, 0, w.Limit)
+	for _, r := range ranking {
+		if t, ok := tracks[r.id]; ok && len(out) < w.Limit {
+			out = append(out, TrackEntry{Rank: len(out) + 1, Plays: r.plays, Track: t})
+		}
+	}
+	return out, nil
+}
+
+func trackRanking(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, w Window) ([]ranked, error) {
+	if ch != nil {
+		ranking, err := analyticsRanking(ctx, ch, "track_id", w)
+		if err == nil {
+			return ranking, nil
+		}
+		logger.Warn("analytics query failed, reading the database instead", "chart", "tracks", "error", err)
+	}
+	where, args := windowFilter(w, time.Now())
+	args = append(args, w.Limit)
+	query := fmt.Sprintf(`SELECT v.track_id, sum(v.plays)::bigint
+FROM track_play_daily v
+JOIN tracks t ON t.id = v.track_id AND t.deleted_at IS NULL
+WHERE %s
+GROUP BY v.track_id
+ORDER BY 2 DESC, v.track_id
+LIMIT $%d`, where, len(args))
+	return queryRanking(ctx, client, query, args...)
+}
+
+// TopArtists ranks live artists by plays of their live tracks within the
+// window, reading ch when it isn't nil. The analytics store credits a play
+// to the artist its album had when it was mirrored, including plays of
+// tracks deleted since.
+func TopArtists(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, w Window) ([]ArtistEntry, error) {
+	ranking, err := artistRanking(ctx, client, ch, w)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranking))
+	for i, r := range ranking {
+		ids[i] = r.id
+	}
+	artists, err := loader.For(ctx, client).Artists.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ArtistEntry, 0, w.Limit)
+	for _, r := range ranking {
+		if a, ok := artists[r.id]; ok && len(out) < w.Limit {
+			out = append(out, ArtistEntry{Rank: len(out) + 1, Plays: r.plays, Artist: a})
+		}
+	}
+	return out, nil
+}
+
+func artistRanking(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, w Window) ([]ranked, error) {
+	if ch != nil {
+		ranking, err := analyticsRanking(ctx, ch, "artist_id", w)
+		if err == nil {
+			return ranking, nil
+		}
+		logger.Warn("analytics query failed, reading the database instead", "chart", "artists", "error", err)
+	}
+	where, args := windowFilter(w, time.Now())
+	args = append(args, w.Limit)
+	query := fmt.Sprintf(`SELECT a.artist_id, sum(v.plays)::bigint
+FROM track_play_daily v
+JOIN tracks t ON t.id = v.track_id AND t.deleted_at IS NULL
+JOIN albums a ON a.id = t.album_id AND a.deleted_at IS NULL
+WHERE %s
+GROUP BY a.artist_id
+ORDER BY 2 DESC, a.artist_id
+LIMIT $%d`, where, len(args))
+	return queryRanking(ctx, client, query, args...)
+}
+
+// DayCount is the number of plays on one UTC day
+type DayCount struct {
+	Day   string `json:"day"`
+	Plays int64  `json:"plays"`
+}
+
+// TerritoryCount is the number of plays from one territory
+type TerritoryCount struct {
+	Territory string `json:"territory"`
+	Plays     int64  `json:"plays"`
+}
+
+// Stats summarizes the listening history of a track or an artist
+type Stats struct {
+	TotalPlays     int64            `json:"total_plays"`
+	Last7Days      int64            `json:"last_7_days"`
+	Last30Days     int64            `json:"last_30_days"`
+	Daily          []DayCount       `json:"daily"`       // the last 30 days, oldest first, days without plays omitted
+	TopTerritories []TerritoryCount `json:"territories"` // all time, at most 10
+}
+
+// statsDays is how far back the daily series reaches
+const statsDays = 30
+
+// TrackStats summarizes the plays of one track, reading ch when it isn't nil
+func TrackStats(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, trackID uuid.UUID) (*Stats, error) {
+	if ch != nil {
+		s, err := analyticsStats(ctx, ch, "track_id", trackID)
+		if err == nil {
+			return s, nil
+		}
+		logger.Warn("analytics query failed, reading the database instead", "stats", "track", "error", err)
+	}
+	return stats(ctx, client, "v.track_id = $1", trackID)
+}
+
+// ArtistStats summarizes the plays of every live track by one artist,
+// reading ch when it isn't nil. The analytics store counts plays credited to
+// the artist when they were mirrored, as TopArtists does.
+func ArtistStats(ctx context.Context, client *ent.Client, ch *analytics.ClickHouse, artistID uuid.UUID) (*Stats, error) {
+	if ch != nil {
+		s, err := analyticsStats(ctx, ch, "artist_id", artistID)
+		if err == nil {
+			return s, nil
+		}
+		logger.Warn("analytics query failed, reading the database instead", "stats", "artist", "error", err)
+	}
+	return stats(ctx, client, `v.track_id IN (
+	SELECT t.id FROM tracks t
+	JOIN albums a ON a.id = t.album_id
+	WHERE a.artist_id = $1 AND a.deleted_at IS NULL AND t.deleted_at IS NULL)`, artistID)
+}
+
+// stats computes Stats over the view rows matching scope, whose only argument is $1
+func stats(ctx context.Context, client *ent.Client, scope string, id uuid.UUID) (*Stats, error) {
+	now := time.Now()
+	since7 := Window{Days: 7}.since(now)
+	since30 := Window{Days: statsDays}.since(now)
+
+	s := &Stats{Daily: []DayCount{}, TopTerritories: []TerritoryCount{}}
+
+	totals := fmt.Sprintf(`SELECT
+	COALESCE(sum(v.plays), 0)::bigint,
+	COALESCE(sum(v.plays) FILTER (WHERE v.day >= $2::date), 0)::bigint,
+	COALESCE(sum(v.plays) FILTER (WHERE v.day >= $3::date), 0)::bigint
+FROM track_play_daily v WHERE %s`, scope)
+	rows, err := client.QueryContext(ctx, totals, id, since7, since30)
+	if err != nil {
+		return nil, err
+	}
+	if rows.Next() {
+		err = rows.Scan(&s.TotalPlays, &s.Last7Days, &s.Last30Days)
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	daily := fmt.Sprintf(`SELECT v.day, sum(v.plays)::bigint
+FROM track_play_daily v WHERE %s AND v.day >= $2::date
+GROUP BY v.day ORDER BY v.day`, scope)
+	rows, err = client.QueryContext(ctx, daily, id, since30)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			day time.Time
+			dc  DayCount
+		)
+		if err := rows.Scan(&day, &dc.Plays); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		dc.Day = day.Format(time.DateOnly)
+		s.Daily = append(s.Daily, dc)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	territories := fmt.Sprintf(`SELECT v.territory, sum(v.plays)::bigint
+FROM track_play_daily v WHERE %s AND v.territory <> ''
+GROUP BY v.territory ORDER BY 2 DESC, v.territory LIMIT 10`, scope)
+	rows, err = client.QueryContext(ctx, territories, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var tc TerritoryCount
+		if err := rows.Scan(&tc.Territory, &tc.Plays); err != nil {
+			return nil, err
+		}
+		s.TopTerritories = append(s.TopTerritories, tc)
+	}
+	return s, rows.Err()
+}
@@ -0,0 +1,140 @@
+package charts
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"streamify/analytics"
+	"streamify/coalesce"
+	"streamify/ent"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ids"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseWindow reads ?days=, ?territory= and ?limit=, writing a 400 response and
+// returning ok=false when one is out of range
+func parseWindow(c *gin.Context) (w Window, ok bool) {
+	w = Window{Days: 7, Limit: 50, Territory: strings.ToUpper(c.Query("territory"))}
+	if v := c.Query("days"); v != "" {
+		d, err := strconv.Atoi(v)
+		if err != nil || d < 1 || d > 365 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "days must be between 1 and 365"})
+			return w, false
+		}
+		w.Days = d
+	}
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 || l > 100 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+			return w, false
+		}
+		w.Limit = l
+	}
+	if len(w.Territory) != 0 && len(w.Territory) != 2 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "territory must be a two-letter country code"})
+		return w, false
+	}
+	return w, true
+}
+
+// TopTracksChart returns the most played tracks over the last ?days= days
+func TopTracksChart(client *ent.Client, ch *analytics.ClickHouse) gin.HandlerFunc {
+	// Requests for the same chart at once share one aggregation
+	reads := coalesce.New[[]TrackEntry]()
+	return func(c *gin.Context) {
+		w, ok := parseWindow(c)
+		if !ok {
+			return
+		}
+		entries, err := reads.Do(c.Request.Context(), w.key(), func(ctx context.Context) ([]TrackEntry, error) {
+			return TopTracks(ctx, client, ch, w)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, entries)
+	}
+}
+
+// TopArtistsChart returns the most played artists over the last ?days= days
+func TopArtistsChart(client *ent.Client, ch *analytics.ClickHouse) gin.HandlerFunc {
+	// Requests for the same chart at once share one aggregation
+	reads := coalesce.New[[]ArtistEntry]()
+	return func(c *gin.Context) {
+		w, ok := parseWindow(c)
+		if !ok {
+			return
+		}
+		entries, err := reads.Do(c.Request.Context(), w.key(), func(ctx context.Context) ([]ArtistEntry, error) {
+			return TopArtists(ctx, client, ch, w)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, entries)
+	}
+}
+
+// GetTrackStats returns listening stats for the track in the path
+func GetTrackStats(client *ent.Client, ch *analytics.ClickHouse) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track ID"})
+			return
+		}
+		exists, err := client.Track.Query().
+			Where(track.IDEQ(id), track.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "track not found"})
+			return
+		}
+		s, err := TrackStats(c.Request.Context(), client, ch, id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, s)
+	}
+}
+
+// GetArtistStats returns listening stats across the tracks of the artist in the path
+func GetArtistStats(client *ent.Client, ch *analytics.ClickHouse) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		exists, err := client.Artist.Query().
+			Where(artist.IDEQ(id), artist.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+			return
+		}
+		s, err := ArtistStats(c.Request.Context(), client, ch, id)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, s)
+	}
+}
@@ -0,0 +1,200 @@
+package charts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/loader"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Period is a window of a user's top lists, picked by name with ?period=
+type Period struct {
+	Name string
+	// Days is how many UTC days back the period reaches, today included; 0
+	// covers all time
+	Days int
+}
+
+// DefaultPeriods are the periods served unless TOP_PERIODS says otherwise;
+// the first is used when ?period= is left out
+var DefaultPeriods = []Period{{"month", 30}, {"week", 7}, {"year", 365}, {"all", 0}}
+
+// PeriodsFromEnv reads TOP_PERIODS, a comma-separated list of name=days
+// such as "month=30,week=7,all=0", the first being the default
+func PeriodsFromEnv() ([]Period, error) {
+	v := os.Getenv("TOP_PERIODS")
+	if v == "" {
+		return DefaultPeriods, nil
+	}
+	var periods []Period
+	for _, entry := range strings.Split(v, ",") {
+		name, days, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		n, err := strconv.Atoi(days)
+		if !ok || name == "" || err != nil || n < 0 {
+			return nil, fmt.Errorf("TOP_PERIODS must be name=days pairs, e.g. month=30,all=0, got %q", entry)
+		}
+		if slices.ContainsFunc(periods, func(p Period) bool { return p.Name == name }) {
+			return nil, fmt.Errorf("TOP_PERIODS names %q twice", name)
+		}
+		periods = append(periods, Period{Name: name, Days: n})
+	}
+	return periods, nil
+}
+
+// userFilter builds the WHERE clause of a user's top list; args holds the
+// user, then the since date unless p covers all time
+func userFilter(userID uuid.UUID, p Period, now time.Time) (string, []any) {
+	where := "c.user_id = $1"
+	args := []any{userID}
+	if p.Days > 0 {
+		args = append(args, Window{Days: p.Days}.since(now))
+		where += fmt.Sprintf(" AND c.day >= $%d::date", len(args))
+	}
+	return where, args
+}
+
+// UserTopTracks ranks the live tracks userID played most within p
+func UserTopTracks(ctx context.Context, client *ent.Client, userID uuid.UUID, p Period, limit int) ([]TrackEntry, error) {
+	where, args := userFilter(userID, p, time.Now())
+	args = append(args, limit)
+	ranking, err := queryRanking(ctx, client, fmt.Sprintf(`SELECT c.track_id, sum(c.plays)::bigint
+FROM play_counts c
+JOIN tracks t ON t.id = c.track_id AND t.deleted_at IS NULL
+WHERE %s
+GROUP BY c.track_id
+ORDER BY 2 DESC, c.track_id
+LIMIT $%d`, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranking))
+	for i, r := range ranking {
+		ids[i] = r.id
+	}
+	tracks, err := loader.For(ctx, client).Tracks.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]TrackEntry, 0, len(ranking))
+	for _, r := range ranking {
+		if t, ok := tracks[r.id]; ok {
+			out = append(out, TrackEntry{Rank: len(out) + 1, Plays: r.plays, Track: t})
+		}
+	}
+	return out, nil
+}
+
+// UserTopArtists ranks the live artists whose live tracks userID played most
+// within p, crediting each play to the artist the track's album has now
+func UserTopArtists(ctx context.Context, client *ent.Client, userID uuid.UUID, p Period, limit int) ([]ArtistEntry, error) {
+	where, args := userFilter(userID, p, time.Now())
+	args = append(args, limit)
+	ranking, err := queryRanking(ctx, client, fmt.Sprintf(`SELECT a.artist_id, sum(c.plays)::bigint
+FROM play_counts c
+JOIN tracks t ON t.id = c.track_id AND t.deleted_at IS NULL
+JOIN albums a ON a.id = t.album_id AND a.deleted_at IS NULL
+WHERE %s
+GROUP BY a.artist_id
+ORDER BY 2 DESC, a.artist_id
+LIMIT $%d`, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(ranking))
+	for i, r := range ranking {
+		ids[i] = r.id
+	}
+	artists, err := loader.For(ctx, client).Artists.LoadMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ArtistEntry, 0, len(ranking))
+	for _, r := range ranking {
+		if a, ok := artists[r.id]; ok {
+			out = append(out, ArtistEntry{Rank: len(out) + 1, Plays: r.plays, Artist: a})
+		}
+	}
+	return out, nil
+}
+
+// parsePeriod reads ?period= and ?limit=, writing a 400 response and
+// returning ok=false when one isn't valid
+func parsePeriod(c *gin.Context, periods []Period) (p Period, limit int, ok bool) {
+	p, limit = periods[0], 50
+	if v := c.Query("period"); v != "" {
+		i := slices.IndexFunc(periods, func(p Period) bool { return p.Name == v })
+		if i < 0 {
+			names := make([]string, len(periods))
+			for i, p := range periods {
+				names[i] = p.Name
+			}
+			c.JSON(http.StatusBadRequest, gin.H{"error": "period must be one of " + strings.Join(names, ", ")})
+			return p, limit, false
+		}
+		p = periods[i]
+	}
+	if v := c.Query("limit"); v != "" {
+		l, err := strconv.Atoi(v)
+		if err != nil || l < 1 || l > 100 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+			return p, limit, false
+		}
+		limit = l
+	}
+	return p, limit, true
+}
+
+// MyTopTracks returns the current user's most played tracks in ?period=
+func MyTopTracks(client *ent.Client, periods []Period) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		p, limit, ok := parsePeriod(c, periods)
+		if !ok {
+			return
+		}
+		entries, err := UserTopTracks(c.Request.Context(), client, userID, p, limit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, entries)
+	}
+}
+
+// MyTopArtists returns the current user's most played artists in ?period=
+func MyTopArtists(client *ent.Client, periods []Period) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		p, limit, ok := parsePeriod(c, periods)
+		if !ok {
+			return
+		}
+		entries, err := UserTopArtists(c.Request.Context(), client, userID, p, limit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, entries)
+	}
+}
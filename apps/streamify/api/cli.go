@@ -0,0 +1,493 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"streamify/albumtotals"
+	"streamify/auth"
+	"streamify/config"
+	"streamify/ent"
+	"streamify/ent/schema"
+	"streamify/failover"
+	"streamify/ids"
+	"streamify/logging"
+	"streamify/migration"
+	"streamify/playcounts"
+	"streamify/residency"
+	"streamify/seed"
+	"streamify/webtypes"
+	"streamify/wire"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/spf13/cobra"
+)
+
+// newRootCmd builds the command line. Running it without a subcommand serves the API.
+func newRootCmd() *cobra.Command {
+	goFlags := flag.NewFlagSet("streamify", flag.ContinueOnError)
+	cfg := config.Register(goFlags)
+
+	var selfTest bool
+	root := &cobra.Command{
+		Use:           "streamify",
+		Short:         "Streamify API server and operations",
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// LOG_LEVEL sets the default level; LOG_LEVELS overrides modules, e.g. "auth=debug,jobs=warn"
+			if err := logging.Configure(os.Getenv("LOG_LEVEL"), os.Getenv("LOG_LEVELS")); err != nil {
+				return fmt.Errorf("invalid log level config: %w", err)
+			}
+			// New entity IDs are time-ordered UUIDv7s unless ID_VERSION=4, and
+			// public IDs are keyed by PUBLIC_ID_SECRET
+			return ids.FromEnv()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if selfTest {
+				return runSelfTest(cfg)
+			}
+			return serve(cfg)
+		},
+	}
+	root.PersistentFlags().AddGoFlag(goFlags.Lookup("dsn"))
+	root.Flags().AddGoFlag(goFlags.Lookup("port"))
+	root.Flags().BoolVar(&selfTest, "selftest", false, "serve a scratch database on the --dsn server, run register, login, catalog and play requests against it and exit non-zero on failure")
+
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Apply safe migrations and serve the API",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve(cfg)
+		},
+	}
+	serveCmd.Flags().AddGoFlag(goFlags.Lookup("port"))
+
+	root.AddCommand(
+		serveCmd,
+		newMigrateCmd(cfg),
+		newSeedCmd(cfg),
+		newCreateAdminCmd(cfg),
+		newRotateJWTKeyCmd(),
+		newExportCmd(cfg),
+		newGenTypesCmd(),
+	)
+	return root
+}
+
+// openDB validates cfg and connects to its database, failing with a hint when it can't
+func openDB(cfg *config.Config) (*sql.Driver, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	drv, err := sql.Open(dialect.Postgres, cfg.DriverDSN(cfg.DSN))
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
+	}
+	// Fail now rather than on the first query when the database is unreachable
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := drv.DB().PingContext(ctx); err != nil {
+		drv.Close()
+		return nil, fmt.Errorf("cannot reach database %s: %w\ncheck that Postgres is running and --dsn or DATABASE_URL is correct", config.Redact(cfg.DSN), err)
+	}
+	checkPooler(ctx, cfg, drv.DB())
+	return drv, nil
+}
+
+// checkPooler warns when the database is reached through a transaction
+// pooler without pooler compatibility mode. Such a pooler gives each
+// statement outside a transaction whichever server connection is free, so
+// the backend PID seen over one client connection changes between
+// statements.
+func checkPooler(ctx context.Context, cfg *config.Config, db *stdsql.DB) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	pids := map[int]bool{}
+	for range 4 {
+		var pid int
+		if err := conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid); err != nil {
+			return
+		}
+		pids[pid] = true
+	}
+	if len(pids) > 1 && !cfg.PoolerCompat {
+		log.Println("the database connection appears to go through a transaction-pooling proxy such as PgBouncer " +
+			"(the server process changed between statements); set DATABASE_POOLER_COMPAT=true or pass --pooler-compat")
+	}
+}
+
+// openFailoverDB is openDB for the server: the pool follows the primary across
+// failovers, reopening DATABASE_URL and trying the standbys listed in
+// DATABASE_STANDBY_URLS (comma separated) until one is writable
+func openFailoverDB(cfg *config.Config) (*failover.Driver, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	dsns := []string{cfg.DriverDSN(cfg.DSN)}
+	for _, dsn := range strings.Split(os.Getenv("DATABASE_STANDBY_URLS"), ",") {
+		if dsn = strings.TrimSpace(dsn); dsn != "" {
+			dsns = append(dsns, cfg.DriverDSN(dsn))
+		}
+	}
+	drv, err := failover.Open(context.Background(), dsns...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot reach a primary database: %w\ncheck that Postgres is running and --dsn or DATABASE_URL is correct", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	checkPooler(ctx, cfg, drv.DB())
+	return drv, nil
+}
+
+// openRegionDBs opens the database of each region in REGION_DATABASES, keyed
+// by region name
+func openRegionDBs(cfg *config.Config) (map[string]*failover.Driver, error) {
+	dsns, err := residency.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	drivers := make(map[string]*failover.Driver, len(dsns))
+	for name, dsn := range dsns {
+		drv, err := failover.Open(context.Background(), cfg.DriverDSN(dsn))
+		if err != nil {
+			for _, opened := range drivers {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("cannot reach the database of region %s: %w", name, err)
+		}
+		drivers[name] = drv
+	}
+	return drivers, nil
+}
+
+// openClient is openDB for commands that don't record queries
+func openClient(cfg *config.Config) (*ent.Client, error) {
+	drv, err := openDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	useCountHooks(client)
+	return client, nil
+}
+
+// useCountHooks registers the hooks keeping counts derived from other rows,
+// which every client writing those rows needs, seeding included
+func useCountHooks(client *ent.Client) {
+	// Plays are added to their user's per-track daily counts for personal top lists
+	client.Play.Use(playcounts.Hook())
+	// Albums keep their track count and total duration as their tracks change
+	client.Track.Use(albumtotals.Hook())
+}
+
+// initJWT loads the signing secret from JWT_SECRET and, during a rotation, the
+// secrets it replaced from JWT_PREVIOUS_SECRETS (comma separated)
+func initJWT() error {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return errors.New("JWT_SECRET environment variable is required; generate one with `streamify rotate-jwt-key`")
+	}
+	auth.InitJWT(secret)
+	if v := os.Getenv("JWT_PREVIOUS_SECRETS"); v != "" {
+		auth.SetPreviousJWTSecrets(strings.Split(v, ",")...)
+	}
+	return nil
+}
+
+func newMigrateCmd(cfg *config.Config) *cobra.Command {
+	var dryRun, allowDestructive bool
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Check and apply schema migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			runMigrate(client, dryRun, allowDestructive)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the migration plan without applying it")
+	cmd.Flags().BoolVar(&allowDestructive, "allow-destructive", false, "apply changes that drop or narrow columns")
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "partition-plays",
+		Short: "Convert the plays table to monthly partitions, copying existing rows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			start := time.Now()
+			if err := migration.PartitionPlays(context.Background(), client, partitionsAhead); err != nil {
+				return fmt.Errorf("failed partitioning plays: %w", err)
+			}
+			log.Printf("plays partitioned by month in %s", time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "count-plays",
+		Short: "Rebuild users' per-track play counts, which top lists read, from the plays table",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			start := time.Now()
+			if err := playcounts.Rebuild(context.Background(), client); err != nil {
+				return fmt.Errorf("failed counting plays: %w", err)
+			}
+			log.Printf("play counts rebuilt in %s", time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	})
+	return cmd
+}
+
+func newSeedCmd(cfg *config.Config) *cobra.Command {
+	profiles := make([]string, 0, len(seed.Profiles))
+	for name := range seed.Profiles {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+
+	var scenarioDir, baseURL string
+	cmd := &cobra.Command{
+		Use:       fmt.Sprintf("seed [%s]", strings.Join(profiles, "|")),
+		Short:     "Seed the database with a data profile and optionally write load-test scenarios",
+		Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: profiles,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && scenarioDir == "" {
+				return fmt.Errorf("give a profile (%s), --scenario, or both", strings.Join(profiles, ", "))
+			}
+			if scenarioDir != "" {
+				// Scenarios carry access tokens for seeded users
+				if err := initJWT(); err != nil {
+					return err
+				}
+				auth.InitAuthConfig(24, 168)
+			}
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			if len(args) == 1 {
+				runSeed(client, args[0])
+			}
+			if scenarioDir != "" {
+				writeScenarios(client, scenarioDir, baseURL)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&scenarioDir, "scenario", "", "write k6 and vegeta load-test scenarios for the seeded data to this directory")
+	cmd.Flags().StringVar(&baseURL, "base-url", "http://localhost:8080", "with --scenario, the server URL the scenarios target")
+	return cmd
+}
+
+func newCreateAdminCmd(cfg *config.Config) *cobra.Command {
+	var email string
+	var passwordStdin bool
+	cmd := &cobra.Command{
+		Use:   "create-admin",
+		Short: "Create an admin account, or promote an existing account to admin",
+		Long: "Create an admin account, or promote an existing account to admin.\n" +
+			"The password is read from stdin so it stays out of shell history; it is\n" +
+			"required for a new account and replaces the password of an existing one.",
+		Example: "  printf '%s' \"$ADMIN_PASSWORD\" | streamify create-admin --email ops@example.com --password-stdin",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var password string
+			if passwordStdin {
+				line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+				if err != nil && !errors.Is(err, io.EOF) {
+					return fmt.Errorf("failed reading password: %w", err)
+				}
+				password = strings.TrimRight(line, "\r\n")
+			}
+
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			u, created, err := auth.CreateAdmin(context.Background(), client, email, password)
+			if err != nil {
+				if errors.Is(err, auth.ErrPasswordTooShort) && password == "" {
+					return errors.New("no account has this email; pass --password-stdin to create one")
+				}
+				return err
+			}
+			if created {
+				log.Printf("created admin %s (%s)", u.Email, u.ID)
+			} else {
+				log.Printf("promoted %s (%s) to admin", u.Email, u.ID)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&email, "email", "", "email of the admin account")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "read the password from the first line of stdin")
+	cmd.MarkFlagRequired("email")
+	return cmd
+}
+
+func newRotateJWTKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-jwt-key",
+		Short: "Generate a new JWT signing secret, keeping the current one valid for issued tokens",
+		Long: "Generate a new JWT signing secret and print the environment to deploy it with.\n" +
+			"The current JWT_SECRET moves to JWT_PREVIOUS_SECRETS so tokens already issued keep\n" +
+			"working; remove it from there once the refresh token lifetime (7 days) has passed.\n" +
+			"Running servers can also rotate keys without a redeploy through /api/v1/admin/signing-keys.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			secret, err := auth.NewJWTSecret()
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "JWT_SECRET=%s\n", secret)
+
+			var previous []string
+			if current := os.Getenv("JWT_SECRET"); current != "" {
+				previous = append(previous, current)
+			}
+			for _, s := range strings.Split(os.Getenv("JWT_PREVIOUS_SECRETS"), ",") {
+				if s != "" {
+					previous = append(previous, s)
+				}
+			}
+			if len(previous) > 0 {
+				fmt.Fprintf(out, "JWT_PREVIOUS_SECRETS=%s\n", strings.Join(previous, ","))
+			}
+			return nil
+		},
+	}
+}
+
+func newExportCmd(cfg *config.Config) *cobra.Command {
+	var output, since string
+	cmd := &cobra.Command{
+		Use:       "export tracks|plays",
+		Short:     "Write every live track or the play history as a JSON array",
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: []string{"tracks", "plays"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var from time.Time
+			if since != "" {
+				if args[0] != "plays" {
+					return errors.New("--since only applies to plays")
+				}
+				t, err := time.Parse(time.RFC3339, since)
+				if err != nil {
+					return errors.New("--since must be an RFC 3339 timestamp")
+				}
+				from = t
+			}
+
+			client, err := openClient(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			w := bufio.NewWriter(cmd.OutOrStdout())
+			if output != "" && output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = bufio.NewWriter(f)
+			}
+
+			ctx := context.Background()
+			var n int
+			switch args[0] {
+			case "tracks":
+				n, err = wire.WriteArray(ctx, w, trackPages(client))
+			case "plays":
+				n, err = wire.WriteArray(ctx, w, playPages(client, from))
+			}
+			if ferr := w.Flush(); err == nil {
+				err = ferr
+			}
+			if err != nil {
+				return fmt.Errorf("export failed after %d %s: %w", n, args[0], err)
+			}
+			log.Printf("exported %d %s", n, args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write (default stdout)")
+	cmd.Flags().StringVar(&since, "since", "", "with plays, only export plays at or after this RFC 3339 timestamp")
+	return cmd
+}
+
+// webEntities are the entities the web app reads, see webtypes
+var webEntities = []webtypes.Entity{
+	{Name: "Artist", Fields: schema.Artist{}.Fields(), Edges: schema.Artist{}.Edges(), PublicID: true},
+	{Name: "Album", Fields: schema.Album{}.Fields(), Edges: schema.Album{}.Edges(), PublicID: true},
+	{Name: "Track", Fields: schema.Track{}.Fields(), Edges: schema.Track{}.Edges(), PublicID: true},
+}
+
+// webTypesPath is where the web app keeps its generated entity types,
+// relative to the API's directory
+const webTypesPath = "../src/lib/entities.ts"
+
+func newGenTypesCmd() *cobra.Command {
+	var output string
+	var check bool
+	cmd := &cobra.Command{
+		Use:   "gen-types",
+		Short: "Write the web app's TypeScript entity types from the Ent schemas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			generated := webtypes.Generate(webEntities)
+			if !check {
+				return os.WriteFile(output, generated, 0o644)
+			}
+			current, err := os.ReadFile(output)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(current, generated) {
+				return fmt.Errorf("%s is out of date with the Ent schemas; run streamify gen-types", output)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", webTypesPath, "file to write")
+	cmd.Flags().BoolVar(&check, "check", false, "fail if the file differs from what would be written instead of writing it")
+	return cmd
+}
@@ -0,0 +1,49 @@
+// Package coalesce collapses identical reads made at the same time into one,
+// so a popular album or chart whose cache entry expired costs one query
+// rather than one per waiting request.
+package coalesce
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// MaxDuration bounds a shared read, which no longer stops when the request
+// that started it gives up
+const MaxDuration = 30 * time.Second
+
+// Group coalesces reads returning T by key. Callers share the result, so
+// they must not modify it.
+type Group[T any] struct {
+	g singleflight.Group
+}
+
+// New returns an empty group
+func New[T any]() *Group[T] {
+	return &Group[T]{}
+}
+
+// Do returns the result of fn for key, running fn once for all the callers
+// that ask for key while it runs. fn runs detached from ctx's cancellation,
+// so one caller going away doesn't fail the others, while each caller still
+// stops waiting when its own ctx is done.
+func (g *Group[T]) Do(ctx context.Context, key string, fn func(ctx context.Context) (T, error)) (T, error) {
+	ch := g.g.DoChan(key, func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), MaxDuration)
+		defer cancel()
+		return fn(ctx)
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			var zero T
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,142 @@
+// Package concurrency limits how many requests each principal (API key,
+// user or guest) may have in flight at once, so a single client can't tie
+// up the workers with expensive requests such as exports. Limits are held
+// per instance.
+package concurrency
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Config sets how many requests each principal may have in flight. Default
+// caps all of a principal's requests together; Routes caps a route on its
+// own, keyed by method and gin route pattern, e.g.
+// "GET /api/v1/admin/exports/plays". A zero limit means unlimited. Routes
+// in Exempt don't count toward Default, so long-lived streams don't use up a
+// principal's other requests; only their own Routes entry caps them.
+type Config struct {
+	Default int
+	Routes  map[string]int
+	Exempt  []string
+}
+
+// ParseRoutes parses overrides of the form "METHOD /path=limit", separated
+// by semicolons, e.g. "GET /api/v1/admin/exports/plays=1;GET /api/v1/albums/:id/download=2"
+func ParseRoutes(s string) (map[string]int, error) {
+	routes := make(map[string]int)
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("concurrency: %q is not METHOD /path=limit", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("concurrency: %q: limit must be a non-negative integer", entry)
+		}
+		routes[strings.Join(strings.Fields(route), " ")] = n
+	}
+	return routes, nil
+}
+
+// Limiter counts in-flight requests per key
+type Limiter struct {
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+// NewLimiter returns a Limiter with nothing in flight
+func NewLimiter() *Limiter {
+	return &Limiter{inFlight: make(map[string]int)}
+}
+
+// acquire takes a slot under key unless limit are already taken
+func (l *Limiter) acquire(key string, limit int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inFlight[key] >= limit {
+		return false
+	}
+	l.inFlight[key]++
+	return true
+}
+
+func (l *Limiter) release(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.inFlight[key]--; l.inFlight[key] <= 0 {
+		delete(l.inFlight, key)
+	}
+}
+
+// principal identifies who is calling: the API key if one was used, since
+// a user's keys and sessions are separate clients, else the user or guest
+func principal(v *viewer.Viewer) string {
+	switch {
+	case v.APIKeyID != uuid.Nil:
+		return "key:" + v.APIKeyID.String()
+	case v.UserID != uuid.Nil:
+		return "user:" + v.UserID.String()
+	case v.GuestID != uuid.Nil:
+		return "guest:" + v.GuestID.String()
+	}
+	return ""
+}
+
+// Middleware rejects requests with 429 while the caller already has the
+// route's or its overall limit of requests in flight. It must run after
+// authentication; requests without a principal aren't limited.
+func Middleware(l *Limiter, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		who := principal(viewer.FromContext(c.Request.Context()))
+		if who == "" {
+			c.Next()
+			return
+		}
+
+		route := c.Request.Method + " " + c.FullPath()
+		var held []string
+		defer func() {
+			for _, key := range held {
+				l.release(key)
+			}
+		}()
+		if limit := cfg.Routes[route]; limit > 0 {
+			key := who + " " + route
+			if !l.acquire(key, limit) {
+				reject(c, limit)
+				return
+			}
+			held = append(held, key)
+		}
+		if cfg.Default > 0 && !slices.Contains(cfg.Exempt, route) {
+			if !l.acquire(who, cfg.Default) {
+				reject(c, cfg.Default)
+				return
+			}
+			held = append(held, who)
+		}
+		c.Next()
+	}
+}
+
+func reject(c *gin.Context, limit int) {
+	c.Header("Retry-After", "1")
+	c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+		"error": "too many concurrent requests; wait for earlier ones to finish",
+		"limit": limit,
+	})
+}
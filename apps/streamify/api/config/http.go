@@ -0,0 +1,135 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// HTTP tunes the protocols and connections the server accepts. Mobile
+// clients make many small catalog requests, which HTTP/2 multiplexes over
+// one long-lived connection instead of opening one per request.
+type HTTP struct {
+	// CertFile and KeyFile serve HTTPS, which offers HTTP/2 to clients that
+	// support it (TLS_CERT_FILE, TLS_KEY_FILE)
+	CertFile string
+	KeyFile  string
+	// H2C accepts HTTP/2 without TLS from clients that send it straight away
+	// (HTTP2_CLEARTEXT), when not serving HTTPS. It is for a reverse proxy
+	// that terminates TLS and talks HTTP/2 to the server; the server itself
+	// can't tell a proxy from anyone else, so the port must only be reachable
+	// by the proxy.
+	H2C bool
+	// MaxStreams is how many requests one HTTP/2 connection may have in
+	// flight (HTTP2_MAX_STREAMS, default 250)
+	MaxStreams int
+	// IdleTimeout closes keep-alive connections idle this long
+	// (KEEPALIVE_IDLE_TIMEOUT, default 2m)
+	IdleTimeout time.Duration
+	// PingInterval pings HTTP/2 connections that were silent this long, to
+	// drop those whose client went away without closing them
+	// (HTTP2_PING_INTERVAL, default 30s; 0 turns pings off)
+	PingInterval time.Duration
+}
+
+// Default HTTP settings
+const (
+	DefaultMaxStreams   = 250
+	DefaultIdleTimeout  = 2 * time.Minute
+	DefaultPingInterval = 30 * time.Second
+)
+
+// HTTPFromEnv reads the HTTP settings from the environment
+func HTTPFromEnv() (HTTP, error) {
+	c := HTTP{
+		CertFile:     os.Getenv("TLS_CERT_FILE"),
+		KeyFile:      os.Getenv("TLS_KEY_FILE"),
+		MaxStreams:   DefaultMaxStreams,
+		IdleTimeout:  DefaultIdleTimeout,
+		PingInterval: DefaultPingInterval,
+	}
+	var errs []error
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		errs = append(errs, errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together"))
+	}
+	if v := os.Getenv("HTTP2_CLEARTEXT"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("HTTP2_CLEARTEXT must be true or false, got %q", v))
+		}
+		c.H2C = b
+	}
+	if c.H2C && c.TLS() {
+		errs = append(errs, errors.New("HTTP2_CLEARTEXT is for serving without TLS; unset it or TLS_CERT_FILE"))
+	}
+	if v := os.Getenv("HTTP2_MAX_STREAMS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			errs = append(errs, fmt.Errorf("HTTP2_MAX_STREAMS must be a positive integer, got %q", v))
+		}
+		c.MaxStreams = n
+	}
+	if v := os.Getenv("KEEPALIVE_IDLE_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("KEEPALIVE_IDLE_TIMEOUT must be a positive duration, got %q", v))
+		}
+		c.IdleTimeout = d
+	}
+	if v := os.Getenv("HTTP2_PING_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			errs = append(errs, fmt.Errorf("HTTP2_PING_INTERVAL must be a non-negative duration, got %q", v))
+		}
+		c.PingInterval = d
+	}
+	return c, errors.Join(errs...)
+}
+
+// TLS reports whether the server serves HTTPS
+func (c HTTP) TLS() bool {
+	return c.CertFile != ""
+}
+
+// Server returns a server for h with c's protocols and limits
+func (c HTTP) Server(h http.Handler) *http.Server {
+	srv := &http.Server{
+		Handler: h,
+		// Slow clients can't hold a connection open without sending a request
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       c.IdleTimeout,
+		Protocols:         new(http.Protocols),
+		HTTP2: &http.HTTP2Config{
+			MaxConcurrentStreams: c.MaxStreams,
+			SendPingTimeout:      c.PingInterval,
+			PingTimeout:          15 * time.Second,
+		},
+	}
+	srv.Protocols.SetHTTP1(true)
+	srv.Protocols.SetHTTP2(c.TLS())
+	srv.Protocols.SetUnencryptedHTTP2(c.H2C)
+	return srv
+}
+
+// Serve accepts connections on ln until the server fails
+func (c HTTP) Serve(srv *http.Server, ln net.Listener) error {
+	if c.TLS() {
+		return srv.ServeTLS(ln, c.CertFile, c.KeyFile)
+	}
+	return srv.Serve(ln)
+}
+
+// Protocols describes what the server accepts, for logging
+func (c HTTP) Protocols() string {
+	switch {
+	case c.TLS():
+		return "HTTPS with HTTP/2"
+	case c.H2C:
+		return "HTTP/1.1 and h2c"
+	}
+	return "HTTP/1.1"
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// listenFDsStart is the first file descriptor systemd passes (SD_LISTEN_FDS_START)
+const listenFDsStart = 3
+
+// SocketMode is the permission of the Unix socket: the owner and its group,
+// which the reverse proxy is expected to be in, may connect
+const SocketMode = 0o660
+
+// activation returns how many listeners systemd passed this process, or 0
+// when it wasn't socket activated. LISTEN_PID guards against variables
+// inherited from a parent that was activated instead.
+func activation() (int, error) {
+	fds := os.Getenv("LISTEN_FDS")
+	if fds == "" {
+		return 0, nil
+	}
+	if pid := os.Getenv("LISTEN_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(fds)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("LISTEN_FDS must be a non-negative number, got %q", fds)
+	}
+	return n, nil
+}
+
+func checkActivation() error {
+	n, err := activation()
+	if err != nil {
+		return err
+	}
+	if n > 1 {
+		return fmt.Errorf("systemd passed %d sockets but the server listens on one; give the .socket unit a single ListenStream=", n)
+	}
+	return nil
+}
+
+// Listen opens the listener the server accepts connections on: the socket
+// passed by systemd when socket activated, else the Unix socket when Socket
+// is set, else the TCP port
+func (c *Config) Listen() (net.Listener, error) {
+	n, err := activation()
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		// Not passed on to anything the server starts
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+		f := os.NewFile(listenFDsStart, "systemd-socket")
+		defer f.Close()
+		ln, err := net.FileListener(f)
+		if err != nil {
+			return nil, fmt.Errorf("using the socket passed by systemd: %w", err)
+		}
+		return ln, nil
+	}
+	if c.Socket != "" {
+		// A socket left behind by an earlier run refuses the bind
+		if err := os.Remove(c.Socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("removing stale socket: %w", err)
+		}
+		ln, err := net.Listen("unix", c.Socket)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(c.Socket, SocketMode); err != nil {
+			ln.Close()
+			return nil, err
+		}
+		return ln, nil
+	}
+	return net.Listen("tcp", c.Addr())
+}
+
+// UnixPeer lets h see requests arriving over a Unix socket as coming from
+// the loopback address. Such requests carry no remote address, so the client
+// IP couldn't be told even from the reverse proxy's X-Forwarded-For.
+func UnixPeer(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RemoteAddr == "" || strings.HasPrefix(r.RemoteAddr, "@") {
+			r.RemoteAddr = "127.0.0.1:0"
+		}
+		h.ServeHTTP(w, r)
+	})
+}
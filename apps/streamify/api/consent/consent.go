@@ -0,0 +1,212 @@
+// Package consent tracks which terms of service and privacy policy versions
+// each user has accepted, and holds users at a 451 response until they accept
+// the latest ones.
+package consent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+
+	"github.com/google/uuid"
+)
+
+// Kinds lists the policies users must accept, in display order
+var Kinds = []policyversion.Kind{policyversion.KindTerms, policyversion.KindPrivacy}
+
+// cacheTTL bounds how long another instance's publish goes unnoticed when its
+// change notification is lost
+const cacheTTL = time.Minute
+
+// Checker answers whether users have accepted the current policy versions. The
+// current versions and the users known to have accepted them are cached, so
+// most requests don't touch the database.
+type Checker struct {
+	client *ent.Client
+
+	mu       sync.Mutex
+	current  []*ent.PolicyVersion
+	loadedAt time.Time
+	// accepted holds users known to have accepted every current version
+	accepted map[uuid.UUID]bool
+}
+
+// NewChecker creates a Checker backed by client
+func NewChecker(client *ent.Client) *Checker {
+	return &Checker{client: client, accepted: map[uuid.UUID]bool{}}
+}
+
+// Current returns the latest published version of each kind; kinds that were
+// never published are left out
+func (ch *Checker) Current(ctx context.Context) ([]*ent.PolicyVersion, error) {
+	ch.mu.Lock()
+	if ch.current != nil && time.Since(ch.loadedAt) < cacheTTL {
+		current := ch.current
+		ch.mu.Unlock()
+		return current, nil
+	}
+	ch.mu.Unlock()
+
+	current := make([]*ent.PolicyVersion, 0, len(Kinds))
+	for _, kind := range Kinds {
+		v, err := ch.client.PolicyVersion.Query().
+			Where(policyversion.KindEQ(kind)).
+			Order(ent.Desc(policyversion.FieldPublishedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, v)
+	}
+
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	if !sameVersions(ch.current, current) {
+		ch.accepted = map[uuid.UUID]bool{}
+	}
+	ch.current = current
+	ch.loadedAt = time.Now()
+	return current, nil
+}
+
+func sameVersions(a, b []*ent.PolicyVersion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// Invalidate drops the cache so a newly published version applies immediately
+func (ch *Checker) Invalidate() {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.current = nil
+	ch.accepted = map[uuid.UUID]bool{}
+}
+
+// Pending returns the current versions userID has not accepted yet
+func (ch *Checker) Pending(ctx context.Context, userID uuid.UUID) ([]*ent.PolicyVersion, error) {
+	current, err := ch.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := []*ent.PolicyVersion{}
+	if len(current) == 0 {
+		return pending, nil
+	}
+	ch.mu.Lock()
+	known := ch.accepted[userID]
+	ch.mu.Unlock()
+	if known {
+		return pending, nil
+	}
+
+	ids := make([]uuid.UUID, len(current))
+	for i, v := range current {
+		ids[i] = v.ID
+	}
+	accepted, err := ch.client.PolicyAcceptance.Query().
+		Where(policyacceptance.UserIDEQ(userID), policyacceptance.PolicyVersionIDIn(ids...)).
+		Select(policyacceptance.FieldPolicyVersionID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[string]bool, len(accepted))
+	for _, id := range accepted {
+		done[id] = true
+	}
+	for _, v := range current {
+		if !done[v.ID.String()] {
+			pending = append(pending, v)
+		}
+	}
+	if len(pending) == 0 {
+		ch.markAccepted(current, userID)
+	}
+	return pending, nil
+}
+
+// markAccepted caches that userID accepted current, unless the versions changed meanwhile
+func (ch *Checker) markAccepted(current []*ent.PolicyVersion, userID uuid.UUID) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	if sameVersions(ch.current, current) {
+		ch.accepted[userID] = true
+	}
+}
+
+// Accept records that userID accepted the given versions. Versions already
+// accepted are skipped.
+func (ch *Checker) Accept(ctx context.Context, userID uuid.UUID, versions []*ent.PolicyVersion, ip string) error {
+	ids := make([]uuid.UUID, len(versions))
+	for i, v := range versions {
+		ids[i] = v.ID
+	}
+	existing, err := ch.client.PolicyAcceptance.Query().
+		Where(policyacceptance.UserIDEQ(userID), policyacceptance.PolicyVersionIDIn(ids...)).
+		Select(policyacceptance.FieldPolicyVersionID).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		done[id] = true
+	}
+
+	var creates []*ent.PolicyAcceptanceCreate
+	for _, v := range versions {
+		if done[v.ID.String()] {
+			continue
+		}
+		creates = append(creates, ch.client.PolicyAcceptance.Create().
+			SetUserID(userID).
+			SetPolicyVersionID(v.ID).
+			SetIP(ip))
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+	if err := ch.client.PolicyAcceptance.CreateBulk(creates...).Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+		return err
+	}
+	return nil
+}
+
+// PublishOptions describes a new policy version
+type PublishOptions struct {
+	Kind        policyversion.Kind
+	Version     string
+	URL         string
+	Summary     string
+	PublishedBy *uuid.UUID
+}
+
+// Publish stores a new version, which every user must accept before continuing
+func (ch *Checker) Publish(ctx context.Context, opts PublishOptions) (*ent.PolicyVersion, error) {
+	v, err := ch.client.PolicyVersion.Create().
+		SetKind(opts.Kind).
+		SetVersion(opts.Version).
+		SetURL(opts.URL).
+		SetSummary(opts.Summary).
+		SetNillablePublishedBy(opts.PublishedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ch.Invalidate()
+	return v, nil
+}
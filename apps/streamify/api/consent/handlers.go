@@ -0,0 +1,236 @@
+package consent
+
+import (
+	"log"
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+	"streamify/events"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Middleware answers 451 with the pending versions until the user has accepted
+// every current policy. Routes in exempt ("METHOD /full/path") stay reachable so
+// clients can show and accept the new versions.
+// Must be used after auth.AuthMiddleware
+func Middleware(ch *Checker, exempt ...string) gin.HandlerFunc {
+	skip := make(map[string]bool, len(exempt))
+	for _, route := range exempt {
+		skip[route] = true
+	}
+	return func(c *gin.Context) {
+		if skip[c.Request.Method+" "+c.FullPath()] {
+			c.Next()
+			return
+		}
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		pending, err := ch.Pending(c.Request.Context(), userID)
+		if err != nil {
+			// An outage of this check shouldn't take the whole API down with it
+			log.Printf("consent: failed checking user %s: %v", userID, err)
+			c.Next()
+			return
+		}
+		if len(pending) > 0 {
+			c.AbortWithStatusJSON(http.StatusUnavailableForLegalReasons, gin.H{
+				"error":   "updated policies must be accepted to continue",
+				"pending": pending,
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// CurrentPolicies returns the latest version of each policy, for signup pages
+func CurrentPolicies(ch *Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		current, err := ch.Current(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"policies": current})
+	}
+}
+
+// GetConsent returns the current user's acceptance history and any pending versions
+func GetConsent(ch *Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		pending, err := ch.Pending(ctx, userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		accepted, err := ch.client.PolicyAcceptance.Query().
+			Where(policyacceptance.UserIDEQ(userID)).
+			WithPolicyVersion().
+			Order(ent.Desc(policyacceptance.FieldAcceptedAt)).
+			All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"pending": pending, "accepted": accepted})
+	}
+}
+
+// AcceptRequest is the request body for AcceptPolicies
+type AcceptRequest struct {
+	VersionIDs []uuid.UUID `json:"version_ids" binding:"required,min=1,max=10"`
+}
+
+// AcceptPolicies records that the current user accepted the given versions. Only
+// current versions can be accepted, so a client showing stale text gets 409.
+// Impersonated sessions can't accept on a user's behalf.
+func AcceptPolicies(ch *Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if viewer.FromContext(c.Request.Context()).Impersonated() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "policies can only be accepted by the user"})
+			return
+		}
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		var body AcceptRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		current, err := ch.Current(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		byID := make(map[uuid.UUID]*ent.PolicyVersion, len(current))
+		for _, v := range current {
+			byID[v.ID] = v
+		}
+		versions := make([]*ent.PolicyVersion, 0, len(body.VersionIDs))
+		for _, id := range body.VersionIDs {
+			v, ok := byID[id]
+			if !ok {
+				c.JSON(http.StatusConflict, gin.H{"error": "version is not current", "id": id, "current": current})
+				return
+			}
+			versions = append(versions, v)
+		}
+
+		if err := ch.Accept(ctx, userID, versions, c.ClientIP()); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		pending, err := ch.Pending(ctx, userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"pending": pending})
+	}
+}
+
+// PublishRequest is the request body for PublishPolicy
+type PublishRequest struct {
+	Kind    string `json:"kind" binding:"required,oneof=terms privacy"`
+	Version string `json:"version" binding:"required,max=64"`
+	URL     string `json:"url" binding:"omitempty,url"`
+	Summary string `json:"summary" binding:"max=5000"`
+}
+
+// PublishPolicy publishes a new policy version; every user must accept it on their next request
+func PublishPolicy(ch *Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body PublishRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		opts := PublishOptions{
+			Kind:    policyversion.Kind(body.Kind),
+			Version: body.Version,
+			URL:     body.URL,
+			Summary: body.Summary,
+		}
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			opts.PublishedBy = &id
+		}
+
+		v, err := ch.Publish(c.Request.Context(), opts)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "version already published"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		events.Emit(c.Request.Context(), events.PolicyPublished{VersionID: v.ID, Kind: string(v.Kind), Version: v.Version})
+		c.JSON(http.StatusCreated, v)
+	}
+}
+
+// versionStats is a published version with how many users accepted it
+type versionStats struct {
+	*ent.PolicyVersion
+	Acceptances int `json:"acceptances"`
+}
+
+// ListPolicies lists every published version, newest first, with acceptance counts
+func ListPolicies(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		versions, err := client.PolicyVersion.Query().
+			Order(ent.Desc(policyversion.FieldPublishedAt)).
+			All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		var counts []struct {
+			PolicyVersionID uuid.UUID `json:"policy_version_id"`
+			Count           int       `json:"count"`
+		}
+		err = client.PolicyAcceptance.Query().
+			GroupBy(policyacceptance.FieldPolicyVersionID).
+			Aggregate(ent.Count()).
+			Scan(ctx, &counts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		byID := make(map[uuid.UUID]int, len(counts))
+		for _, n := range counts {
+			byID[n.PolicyVersionID] = n.Count
+		}
+
+		out := make([]versionStats, len(versions))
+		for i, v := range versions {
+			out[i] = versionStats{PolicyVersion: v, Acceptances: byID[v.ID]}
+		}
+		c.JSON(http.StatusOK, gin.H{"policies": out})
+	}
+}
@@ -0,0 +1,200 @@
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"slices"
+	"time"
+
+	"streamify/operations"
+	"streamify/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Prefix is the storage key prefix under which captured profiles are written
+const Prefix = "diagnostics/"
+
+const (
+	// defaultDuration is how long CPU profiles and traces run unless asked
+	defaultDuration = 30 * time.Second
+	// maxDuration bounds CPU profiles and traces
+	maxDuration = 5 * time.Minute
+)
+
+// Kinds are the profiles that can be captured. cpu and trace record for a
+// duration; the rest are snapshots.
+var Kinds = []string{"cpu", "trace", "heap", "allocs", "goroutine", "threadcreate"}
+
+// ErrBusy is returned when a CPU profile, or a trace, is already being
+// recorded, by a capture or through the pprof endpoints
+var ErrBusy = errors.New("already recording a profile of this kind")
+
+// Capture records a profile of kind from this instance, for d when it's cpu
+// or trace, and writes it to store
+func Capture(ctx context.Context, store storage.Storage, kind string, d time.Duration) (storage.Object, error) {
+	var buf bytes.Buffer
+	switch kind {
+	case "cpu":
+		if err := pprof.StartCPUProfile(&buf); err != nil {
+			return storage.Object{}, ErrBusy
+		}
+		err := wait(ctx, d)
+		pprof.StopCPUProfile()
+		if err != nil {
+			return storage.Object{}, err
+		}
+	case "trace":
+		if err := trace.Start(&buf); err != nil {
+			return storage.Object{}, ErrBusy
+		}
+		err := wait(ctx, d)
+		trace.Stop()
+		if err != nil {
+			return storage.Object{}, err
+		}
+	default:
+		p := pprof.Lookup(kind)
+		if p == nil || !slices.Contains(Kinds, kind) {
+			return storage.Object{}, fmt.Errorf("unknown profile %q", kind)
+		}
+		if kind == "heap" {
+			// Profiles show the heap as of the last collection
+			runtime.GC()
+		}
+		if err := p.WriteTo(&buf, 0); err != nil {
+			return storage.Object{}, err
+		}
+	}
+
+	now := time.Now().UTC()
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "unknown"
+	}
+	ext := ".pprof"
+	if kind == "trace" {
+		ext = ".trace"
+	}
+	key := Prefix + now.Format(time.DateOnly) + "/" + now.Format("150405") + "-" + host + "-" + kind + ext
+	obj := storage.Object{Key: key, Size: int64(buf.Len()), ModifiedAt: now}
+	if err := store.Put(ctx, key, &buf); err != nil {
+		return storage.Object{}, fmt.Errorf("writing %s: %w", key, err)
+	}
+	logger.Info("profile captured", "kind", kind, "key", key, "size", obj.Size)
+	return obj, nil
+}
+
+// wait sleeps for d or until ctx is cancelled
+func wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// CaptureRequest is the request body for StartCapture
+type CaptureRequest struct {
+	// Kind is one of Kinds
+	Kind string `json:"kind" binding:"required"`
+	// Seconds is how long cpu and trace record, 30 unless given
+	Seconds int `json:"seconds"`
+}
+
+// StartCapture records a profile of the instance serving the request in the
+// background and writes it to store (admin)
+func StartCapture(store storage.Storage, ops *operations.Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CaptureRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if !slices.Contains(Kinds, req.Kind) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown profile %q", req.Kind)})
+			return
+		}
+		d := defaultDuration
+		if req.Seconds != 0 {
+			d = time.Duration(req.Seconds) * time.Second
+		}
+		if d < time.Second || d > maxDuration {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("seconds must be between 1 and %d", int(maxDuration.Seconds()))})
+			return
+		}
+		op, err := ops.Start(c.Request.Context(), "diagnostics."+req.Kind, func(ctx context.Context, p *operations.Progress) (any, error) {
+			return Capture(ctx, store, req.Kind, d)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		operations.Accepted(c, op)
+	}
+}
+
+var (
+	dayPattern  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	filePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+)
+
+// ListCaptures lists captured profiles, optionally of one day (admin)
+func ListCaptures(store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		prefix := Prefix
+		if day := c.Query("day"); day != "" {
+			if !dayPattern.MatchString(day) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "day must be formatted as YYYY-MM-DD"})
+				return
+			}
+			prefix += day + "/"
+		}
+		objects, err := store.List(c.Request.Context(), prefix)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"captures": objects})
+	}
+}
+
+// DownloadCapture streams a captured profile, for go tool pprof or go tool
+// trace (admin)
+func DownloadCapture(store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		day := c.Param("day")
+		file := c.Param("file")
+		if !dayPattern.MatchString(day) || !filePattern.MatchString(file) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid capture path"})
+			return
+		}
+		r, err := store.Get(c.Request.Context(), Prefix+day+"/"+file)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "capture not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer r.Close()
+
+		c.Header("Content-Type", "application/octet-stream")
+		c.Header("Content-Disposition", `attachment; filename="`+day+"-"+file+`"`)
+		c.Status(http.StatusOK)
+		io.Copy(c.Writer, r)
+	}
+}
@@ -0,0 +1,143 @@
+// Package diagnostics exposes the Go runtime for debugging a live instance:
+// the net/http/pprof profiles, goroutine dumps and runtime/metrics. They are
+// served to admins under /api/v1/admin/debug and, when DIAGNOSTICS_ADDR is
+// set, without authentication on a separate port that should only be
+// reachable from inside the deployment. Profiles can also be captured on
+// demand and kept in object storage to be looked at later.
+package diagnostics
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/metrics"
+	"strings"
+
+	"streamify/logging"
+
+	"github.com/gin-gonic/gin"
+)
+
+var logger = logging.For("diagnostics")
+
+// Handler serves the pprof index and profiles under /debug/pprof/ and the
+// runtime metrics under /debug/runtime. Goroutine dumps are
+// /debug/pprof/goroutine?debug=2.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/runtime", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(Runtime()); err != nil {
+			logger.Error("failed writing runtime metrics", "error", err)
+		}
+	})
+	return mux
+}
+
+// Serve serves Handler from a route group, taking the rest of the path from
+// the *path parameter, e.g. /api/v1/admin/debug/pprof/heap
+func Serve() gin.HandlerFunc {
+	h := Handler()
+	return func(c *gin.Context) {
+		// pprof finds the profile by its path under /debug/pprof/
+		r := c.Request.Clone(c.Request.Context())
+		r.URL.Path = "/debug/" + strings.TrimPrefix(c.Param("path"), "/")
+		r.URL.RawPath = ""
+		h.ServeHTTP(c.Writer, r)
+	}
+}
+
+// Histogram summarizes a runtime/metrics distribution
+type Histogram struct {
+	Count uint64  `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+// RuntimeStats is a snapshot of the runtime
+type RuntimeStats struct {
+	GoVersion  string `json:"go_version"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	Goroutines int    `json:"goroutines"`
+	// Metrics holds every runtime/metrics sample by name, such as
+	// /gc/heap/live:bytes; distributions are summarized as a Histogram
+	Metrics map[string]any `json:"metrics"`
+}
+
+// Runtime reads every metric the runtime supports
+func Runtime() RuntimeStats {
+	descs := metrics.All()
+	samples := make([]metrics.Sample, len(descs))
+	for i, d := range descs {
+		samples[i].Name = d.Name
+	}
+	metrics.Read(samples)
+
+	values := make(map[string]any, len(samples))
+	for _, s := range samples {
+		switch s.Value.Kind() {
+		case metrics.KindUint64:
+			values[s.Name] = s.Value.Uint64()
+		case metrics.KindFloat64:
+			if f := s.Value.Float64(); !math.IsNaN(f) && !math.IsInf(f, 0) {
+				values[s.Name] = f
+			}
+		case metrics.KindFloat64Histogram:
+			values[s.Name] = summarize(s.Value.Float64Histogram())
+		}
+	}
+	return RuntimeStats{
+		GoVersion:  runtime.Version(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		Metrics:    values,
+	}
+}
+
+// summarize returns the count and approximate quantiles of h, taking each
+// bucket's upper bound, or its lower bound when unbounded above
+func summarize(h *metrics.Float64Histogram) Histogram {
+	var out Histogram
+	for _, n := range h.Counts {
+		out.Count += n
+	}
+	if out.Count == 0 {
+		return out
+	}
+	bound := func(i int) float64 {
+		if b := h.Buckets[i+1]; !math.IsInf(b, 1) {
+			return b
+		}
+		if b := h.Buckets[i]; !math.IsInf(b, -1) {
+			return b
+		}
+		return 0
+	}
+	quantiles := []struct {
+		q   float64
+		dst *float64
+	}{{0.5, &out.P50}, {0.9, &out.P90}, {0.99, &out.P99}}
+	var seen uint64
+	next := 0
+	for i, n := range h.Counts {
+		if n == 0 {
+			continue
+		}
+		seen += n
+		for next < len(quantiles) && float64(seen) >= quantiles[next].q*float64(out.Count) {
+			*quantiles[next].dst = bound(i)
+			next++
+		}
+		out.Max = bound(i)
+	}
+	return out
+}
@@ -0,0 +1,208 @@
+// Package diagram draws the entity relationship diagram of the database from
+// the generated Ent migration schema, so it always matches the code: one box
+// per table listing its columns and keys, and one line per foreign key. It is
+// rendered as Mermaid, as Graphviz DOT or, when Graphviz is installed, as SVG.
+package diagram
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/ent/migrate"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrNoGraphviz is returned when rendering SVG without Graphviz's dot on PATH
+var ErrNoGraphviz = errors.New("rendering SVG needs Graphviz's dot on PATH")
+
+// typeNames are the column types shown, one word each as Mermaid requires
+var typeNames = map[field.Type]string{
+	field.TypeBool:    "bool",
+	field.TypeBytes:   "bytes",
+	field.TypeEnum:    "enum",
+	field.TypeFloat32: "float",
+	field.TypeFloat64: "float",
+	field.TypeInt:     "int",
+	field.TypeInt8:    "int",
+	field.TypeInt16:   "int",
+	field.TypeInt32:   "int",
+	field.TypeInt64:   "int",
+	field.TypeJSON:    "json",
+	field.TypeString:  "string",
+	field.TypeTime:    "time",
+	field.TypeUUID:    "uuid",
+}
+
+func typeName(c *schema.Column) string {
+	if name, ok := typeNames[c.Type]; ok {
+		return name
+	}
+	return "other"
+}
+
+// keys lists what c is in t: PK, FK and UK for a unique column
+func keys(t *schema.Table, c *schema.Column) []string {
+	var out []string
+	if slices.Contains(t.PrimaryKey, c) {
+		out = append(out, "PK")
+	}
+	for _, fk := range t.ForeignKeys {
+		if slices.Contains(fk.Columns, c) {
+			out = append(out, "FK")
+			break
+		}
+	}
+	if c.Unique && !slices.Contains(t.PrimaryKey, c) {
+		out = append(out, "UK")
+	}
+	return out
+}
+
+// columnNames joins the names of cols
+func columnNames(cols []*schema.Column) string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// cardinality reports whether a row may reference no row through fk,
+// and whether each referenced row is referenced at most once
+func cardinality(fk *schema.ForeignKey) (optional, unique bool) {
+	for _, c := range fk.Columns {
+		optional = optional || c.Nullable
+	}
+	unique = len(fk.Columns) == 1 && fk.Columns[0].Unique
+	return optional, unique
+}
+
+// Mermaid renders tables as a Mermaid erDiagram
+func Mermaid(tables []*schema.Table) string {
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, t := range tables {
+		fmt.Fprintf(&b, "    %s {\n", t.Name)
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "        %s %s", typeName(c), c.Name)
+			if k := keys(t, c); len(k) > 0 {
+				fmt.Fprintf(&b, " %s", strings.Join(k, ", "))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("    }\n")
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			optional, unique := cardinality(fk)
+			left, right := "}o", "||"
+			if unique {
+				left = "|o"
+			}
+			if optional {
+				right = "o|"
+			}
+			fmt.Fprintf(&b, "    %s %s--%s %s : %q\n", t.Name, left, right, fk.RefTable.Name, columnNames(fk.Columns))
+		}
+	}
+	return b.String()
+}
+
+// DOT renders tables as a Graphviz digraph, each table an HTML-like label
+func DOT(tables []*schema.Table) string {
+	var b strings.Builder
+	b.WriteString("digraph schema {\n")
+	b.WriteString("    graph [rankdir=LR];\n")
+	b.WriteString("    node [shape=plaintext, fontname=\"Helvetica\", fontsize=10];\n")
+	b.WriteString("    edge [fontname=\"Helvetica\", fontsize=9];\n")
+	for _, t := range tables {
+		fmt.Fprintf(&b, "    %q [label=<<table border=\"0\" cellborder=\"1\" cellspacing=\"0\">", t.Name)
+		fmt.Fprintf(&b, "<tr><td colspan=\"3\" bgcolor=\"lightgrey\"><b>%s</b></td></tr>", html.EscapeString(t.Name))
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "<tr><td align=\"left\" port=%q>%s</td><td align=\"left\">%s</td><td>%s</td></tr>",
+				c.Name, html.EscapeString(c.Name), typeName(c), strings.Join(keys(t, c), " "))
+		}
+		b.WriteString("</table>>];\n")
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			optional, _ := cardinality(fk)
+			style := "solid"
+			if optional {
+				style = "dashed"
+			}
+			fmt.Fprintf(&b, "    %q:%q -> %q [label=%q, style=%s];\n",
+				t.Name, fk.Columns[0].Name, fk.RefTable.Name, columnNames(fk.Columns), style)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// SVG renders dot with Graphviz
+func SVG(ctx context.Context, dot string) ([]byte, error) {
+	path, err := exec.LookPath("dot")
+	if err != nil {
+		return nil, ErrNoGraphviz
+	}
+	cmd := exec.CommandContext(ctx, path, "-Tsvg")
+	cmd.Stdin = strings.NewReader(dot)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("dot: %w: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return out, nil
+}
+
+// svgCache holds the rendered SVG; the schema only changes with a deploy
+var svgCache struct {
+	sync.Mutex
+	svg []byte
+}
+
+// Serve renders the diagram of every table in the format given by ?format:
+// mermaid (the default), dot or svg
+func Serve() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch format := c.DefaultQuery("format", "mermaid"); format {
+		case "mermaid":
+			c.Data(http.StatusOK, "text/vnd.mermaid; charset=utf-8", []byte(Mermaid(migrate.Tables)))
+		case "dot":
+			c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(DOT(migrate.Tables)))
+		case "svg":
+			svgCache.Lock()
+			defer svgCache.Unlock()
+			if svgCache.svg == nil {
+				ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+				defer cancel()
+				svg, err := SVG(ctx, DOT(migrate.Tables))
+				if err != nil {
+					if errors.Is(err, ErrNoGraphviz) {
+						c.JSON(http.StatusNotImplemented, gin.H{"error": err.Error()})
+						return
+					}
+					c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+					return
+				}
+				svgCache.svg = svg
+			}
+			c.Data(http.StatusOK, "image/svg+xml", svgCache.svg)
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown format %q (want mermaid, dot or svg)", format)})
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Package directory looks up users by email or ID, for clients that match a
+// user's contacts against their accounts, and tells the signup form whether
+// an email is taken. Both answer questions that could be used to find out
+// who has an account, so both are rate limited: lookups per caller by the
+// number of emails and IDs they ask about, and the email check per client IP.
+package directory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/predicate"
+	"streamify/ent/user"
+	"streamify/ids"
+	"streamify/logging"
+	"streamify/quota"
+	"streamify/social"
+	"streamify/viewer"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("directory")
+
+// MaxBatch is how many emails and IDs one lookup may ask about
+const MaxBatch = 100
+
+// Rate is a number of events allowed per window
+type Rate struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Config sets the rate limits
+type Config struct {
+	// Lookup is how many emails and IDs each user may look up
+	// (USER_LOOKUP_RATE_LIMIT, default 300/1h)
+	Lookup Rate
+	// Exists is how many email checks each client IP may make
+	// (EMAIL_EXISTS_RATE_LIMIT, default 10/1m)
+	Exists Rate
+}
+
+// FromEnv reads the rate limits, written as "300/1h"
+func FromEnv() (Config, error) {
+	cfg := Config{
+		Lookup: Rate{Limit: 300, Window: time.Hour},
+		Exists: Rate{Limit: 10, Window: time.Minute},
+	}
+	for name, r := range map[string]*Rate{"USER_LOOKUP_RATE_LIMIT": &cfg.Lookup, "EMAIL_EXISTS_RATE_LIMIT": &cfg.Exists} {
+		v := os.Getenv(name)
+		if v == "" {
+			continue
+		}
+		n, window, ok := strings.Cut(v, "/")
+		limit, err := strconv.Atoi(strings.TrimSpace(n))
+		d, derr := time.ParseDuration(strings.TrimSpace(window))
+		if !ok || err != nil || derr != nil || limit <= 0 || d < time.Second {
+			return Config{}, fmt.Errorf("%s must be count/window, e.g. 10/1m, got %q", name, v)
+		}
+		*r = Rate{Limit: limit, Window: d}
+	}
+	return cfg, nil
+}
+
+// allow adds n to the count at key in the current window of r and reports
+// whether it is still within the limit, setting Retry-After when it isn't.
+// When the counter can't be reached the request is let through.
+func allow(c *gin.Context, counter quota.Counter, r Rate, key string, n int) bool {
+	now := time.Now()
+	start := now.Truncate(r.Window)
+	reset := start.Add(r.Window)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second)
+	used, err := counter.Incr(ctx, key+":"+strconv.FormatInt(start.Unix(), 10), int64(n), reset)
+	cancel()
+	if err != nil {
+		logger.Warn("rate limit counter unavailable; letting request through", "error", err)
+		return true
+	}
+	if used > int64(r.Limit) {
+		c.Header("Retry-After", strconv.Itoa(int(reset.Sub(now).Seconds())+1))
+		return false
+	}
+	return true
+}
+
+// emailMatches matches a user's email case-insensitively using the
+// lower(email) index
+func emailMatches(emails ...string) predicate.User {
+	lower := make([]any, len(emails))
+	for i, e := range emails {
+		lower[i] = strings.ToLower(e)
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.In(sql.Lower(s.C(user.FieldEmail)), lower...))
+	})
+}
+
+// LookupRequest names the users to look up
+type LookupRequest struct {
+	Emails []string `json:"emails" binding:"omitempty,dive,email"`
+	// IDs are UUIDs or public IDs
+	IDs []string `json:"ids"`
+}
+
+// Record is what a lookup returns about a user: enough to show who they are
+// and follow them. Email is only set on users found by email, and is the
+// address the caller asked about.
+type Record struct {
+	ID        uuid.UUID `json:"id"`
+	FirstName string    `json:"first_name,omitempty"`
+	LastName  string    `json:"last_name,omitempty"`
+	Email     string    `json:"email,omitempty"`
+}
+
+// Lookup returns the users with the given emails or IDs. Emails and IDs
+// without an account are left out, as are deactivated users and users
+// blocked by or blocking the caller.
+func Lookup(client *ent.Client, counter quota.Counter, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body LookupRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		n := len(body.Emails) + len(body.IDs)
+		if n == 0 || n > MaxBatch {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("look up between 1 and %d emails and IDs", MaxBatch)})
+			return
+		}
+		userIDs := make([]uuid.UUID, len(body.IDs))
+		for i, s := range body.IDs {
+			id, err := ids.Parse(s)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid user ID %q", s)})
+				return
+			}
+			userIDs[i] = id
+		}
+
+		ctx := c.Request.Context()
+		callerID, ok := viewer.UserID(ctx)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		if !allow(c, counter, cfg.Lookup, "lookup:"+callerID.String(), n) {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many lookups"})
+			return
+		}
+
+		blocked, err := social.BlockSet(ctx, client, callerID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		var match []predicate.User
+		if len(userIDs) > 0 {
+			match = append(match, user.IDIn(userIDs...))
+		}
+		if len(body.Emails) > 0 {
+			match = append(match, emailMatches(body.Emails...))
+		}
+		users, err := client.User.Query().
+			Where(user.Or(match...), user.DeactivatedAtIsNil(), user.IDNotIn(blocked...)).
+			All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		records := make([]Record, 0, len(users))
+		for _, u := range users {
+			r := Record{ID: u.ID, FirstName: u.FirstName, LastName: u.LastName}
+			// Only echo the address back when the caller asked about it
+			if i := slices.IndexFunc(body.Emails, func(e string) bool { return strings.EqualFold(e, u.Email) }); i >= 0 {
+				r.Email = body.Emails[i]
+			} else if !slices.Contains(userIDs, u.ID) {
+				continue
+			}
+			records = append(records, r)
+		}
+		c.JSON(http.StatusOK, gin.H{"users": records})
+	}
+}
+
+// Exists answers HEAD ?email= with 200 when the email has an account and 404
+// when it hasn't, and nothing else: no body, and nothing cached along the
+// way. It tells a signup form early what registering would, without the
+// CAPTCHA, so it is limited per client IP.
+func Exists(client *ent.Client, counter quota.Counter, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "no-store")
+		email := strings.TrimSpace(c.Query("email"))
+		if _, err := mail.ParseAddress(email); err != nil || strings.ContainsAny(email, "<> ") {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		if !allow(c, counter, cfg.Exists, "exists:"+c.ClientIP(), 1) {
+			c.Status(http.StatusTooManyRequests)
+			return
+		}
+		// Deactivated accounts still hold their email, so they count
+		found, err := client.User.Query().Where(emailMatches(email)).Exist(c.Request.Context())
+		switch {
+		case err != nil:
+			c.Status(http.StatusInternalServerError)
+		case found:
+			c.Status(http.StatusOK)
+		default:
+			c.Status(http.StatusNotFound)
+		}
+	}
+}
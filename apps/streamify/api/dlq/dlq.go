@@ -0,0 +1,197 @@
+// Package dlq keeps background work that failed (scheduled job runs, event
+// publishes and emails) so admins can see why, replay it once the cause is
+// fixed, or purge it.
+package dlq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/deadletter"
+	"streamify/events"
+	"streamify/jobs"
+	"streamify/mail"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoReplayer is returned when replaying an item whose kind has no registered handler
+var ErrNoReplayer = errors.New("no replay handler for this kind")
+
+// Replayer performs the work of a dead item again from its stored payload
+type Replayer func(ctx context.Context, name string, payload json.RawMessage) error
+
+// Item is failed work to keep
+type Item struct {
+	Kind    deadletter.Kind
+	Name    string
+	Summary string
+	Payload any
+	Err     error
+}
+
+// Queue stores dead items and replays them through the handler for their kind
+type Queue struct {
+	client *ent.Client
+
+	mu        sync.RWMutex
+	replayers map[deadletter.Kind]Replayer
+}
+
+// New creates a queue backed by client
+func New(client *ent.Client) *Queue {
+	return &Queue{client: client, replayers: map[deadletter.Kind]Replayer{}}
+}
+
+// Handle registers how items of kind are replayed
+func (q *Queue) Handle(kind deadletter.Kind, fn Replayer) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.replayers[kind] = fn
+}
+
+// Add stores a failed item. A job that keeps failing is folded into its existing
+// item, bumping the attempt count, so a broken schedule doesn't flood the queue.
+func (q *Queue) Add(ctx context.Context, item Item) error {
+	payload, err := json.Marshal(item.Payload)
+	if err != nil {
+		return err
+	}
+	msg := item.Err.Error()
+
+	if item.Kind == deadletter.KindJob {
+		n, err := q.client.DeadLetter.Update().
+			Where(deadletter.KindEQ(item.Kind), deadletter.NameEQ(item.Name)).
+			SetError(msg).
+			AddAttempts(1).
+			SetLastFailedAt(time.Now()).
+			Save(ctx)
+		if err != nil || n > 0 {
+			return err
+		}
+	}
+	return q.client.DeadLetter.Create().
+		SetKind(item.Kind).
+		SetName(truncate(item.Name, 255)).
+		SetSummary(item.Summary).
+		SetPayload(payload).
+		SetError(msg).
+		Exec(ctx)
+}
+
+// add stores item, logging rather than failing since callers are already handling an error
+func (q *Queue) add(ctx context.Context, item Item) {
+	if err := q.Add(ctx, item); err != nil {
+		log.Printf("dlq: failed storing %s %q: %v", item.Kind, item.Name, err)
+	}
+}
+
+func truncate(s string, n int) string {
+	if len([]rune(s)) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// Replay runs the item again. On success it is removed from the queue; on
+// failure its error and attempt count are updated and the error returned.
+func (q *Queue) Replay(ctx context.Context, id uuid.UUID) error {
+	item, err := q.client.DeadLetter.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	q.mu.RLock()
+	replay, ok := q.replayers[item.Kind]
+	q.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoReplayer, item.Kind)
+	}
+
+	if err := replay(ctx, item.Name, item.Payload); err != nil {
+		if uerr := q.client.DeadLetter.UpdateOne(item).
+			SetError(err.Error()).
+			AddAttempts(1).
+			SetLastFailedAt(time.Now()).
+			Exec(context.WithoutCancel(ctx)); uerr != nil {
+			log.Printf("dlq: failed updating %s after replay: %v", id, uerr)
+		}
+		return err
+	}
+	return q.client.DeadLetter.DeleteOneID(id).Exec(ctx)
+}
+
+// Jobs keeps failed runs of s's jobs. Replaying one runs the job again now.
+func (q *Queue) Jobs(s *jobs.Scheduler) {
+	q.Handle(deadletter.KindJob, func(ctx context.Context, name string, payload json.RawMessage) error {
+		return s.Run(ctx, name)
+	})
+	s.OnFailure(func(ctx context.Context, name string, err error) {
+		q.add(context.WithoutCancel(ctx), Item{
+			Kind:    deadletter.KindJob,
+			Name:    name,
+			Summary: "scheduled run of " + name,
+			Err:     err,
+		})
+	})
+}
+
+// Events keeps events that failed to publish. Replaying one republishes it with
+// its original ID.
+func (q *Queue) Events() {
+	q.Handle(deadletter.KindEvent, func(ctx context.Context, name string, payload json.RawMessage) error {
+		var e events.Envelope
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return err
+		}
+		return events.Republish(ctx, e)
+	})
+	events.OnFailure(func(ctx context.Context, e events.Envelope, err error) {
+		q.add(ctx, Item{
+			Kind:    deadletter.KindEvent,
+			Name:    e.Type,
+			Summary: fmt.Sprintf("v%d %s", e.Version, e.ID),
+			Payload: e,
+			Err:     err,
+		})
+	})
+}
+
+type mailer struct {
+	q    *Queue
+	next mail.Mailer
+}
+
+// Mailer wraps m so messages it fails to send are kept for replay. Replays go
+// through m again.
+func (q *Queue) Mailer(m mail.Mailer) mail.Mailer {
+	q.Handle(deadletter.KindMail, func(ctx context.Context, name string, payload json.RawMessage) error {
+		var msg mail.Message
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return err
+		}
+		return m.Send(ctx, msg)
+	})
+	return &mailer{q: q, next: m}
+}
+
+// Send implements mail.Mailer
+func (m *mailer) Send(ctx context.Context, msg mail.Message) error {
+	err := m.next.Send(ctx, msg)
+	if err != nil {
+		m.q.add(context.WithoutCancel(ctx), Item{
+			Kind:    deadletter.KindMail,
+			Name:    msg.Subject,
+			Summary: "to " + strings.Join(msg.To, ", "),
+			Payload: msg,
+			Err:     err,
+		})
+	}
+	return err
+}
@@ -0,0 +1,170 @@
+package dlq
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/deadletter"
+	"streamify/ent/predicate"
+	"streamify/ids"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// parseKind validates an optional ?kind= filter
+func parseKind(c *gin.Context) (deadletter.Kind, bool) {
+	kind := deadletter.Kind(c.Query("kind"))
+	if kind == "" {
+		return "", true
+	}
+	if err := deadletter.KindValidator(kind); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be job, event or mail"})
+		return "", false
+	}
+	return kind, true
+}
+
+// List returns dead items, most recently failed first, optionally filtered by ?kind=
+func List(q *Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		kind, ok := parseKind(c)
+		if !ok {
+			return
+		}
+		limit := 100
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 1000 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+				return
+			}
+			limit = n
+		}
+
+		query := q.client.DeadLetter.Query()
+		if kind != "" {
+			query = query.Where(deadletter.KindEQ(kind))
+		}
+		total, err := query.Clone().Count(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		items, err := query.
+			Order(ent.Desc(deadletter.FieldLastFailedAt)).
+			Limit(limit).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"items": items, "total": total})
+	}
+}
+
+// Get returns one dead item
+func Get(q *Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id format"})
+			return
+		}
+		item, err := q.client.DeadLetter.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "dead letter not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, item)
+	}
+}
+
+// ReplayRequest is the request body for Replay
+type ReplayRequest struct {
+	IDs []uuid.UUID `json:"ids" binding:"required,min=1,max=100"`
+}
+
+// replayResult reports what happened to one replayed item
+type replayResult struct {
+	ID       uuid.UUID `json:"id"`
+	Replayed bool      `json:"replayed"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Replay runs the selected items again, one at a time. Items that succeed are
+// removed; the rest stay with their new error.
+func Replay(q *Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body ReplayRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		results := make([]replayResult, 0, len(body.IDs))
+		replayed := 0
+		for _, id := range body.IDs {
+			r := replayResult{ID: id}
+			err := q.Replay(c.Request.Context(), id)
+			switch {
+			case err == nil:
+				r.Replayed = true
+				replayed++
+			case ent.IsNotFound(err):
+				r.Error = "dead letter not found"
+			default:
+				r.Error = err.Error()
+			}
+			results = append(results, r)
+		}
+		c.JSON(http.StatusOK, gin.H{"replayed": replayed, "results": results})
+	}
+}
+
+// PurgeRequest is the request body for Purge. At least one filter is required
+// so a bare request can't empty the queue by accident.
+type PurgeRequest struct {
+	IDs    []uuid.UUID `json:"ids" binding:"max=1000"`
+	Kind   string      `json:"kind" binding:"omitempty,oneof=job event mail"`
+	Before *time.Time  `json:"before"`
+}
+
+// Purge deletes dead items matching every given filter
+func Purge(q *Queue) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body PurgeRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var where []predicate.DeadLetter
+		if len(body.IDs) > 0 {
+			where = append(where, deadletter.IDIn(body.IDs...))
+		}
+		if body.Kind != "" {
+			where = append(where, deadletter.KindEQ(deadletter.Kind(body.Kind)))
+		}
+		if body.Before != nil {
+			where = append(where, deadletter.LastFailedAtLT(*body.Before))
+		}
+		if len(where) == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "give ids, kind or before"})
+			return
+		}
+
+		n, err := q.client.DeadLetter.Delete().Where(where...).Exec(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"purged": n})
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"streamify/ent/album"
 	"streamify/ent/artist"
@@ -19,14 +20,28 @@ type Album struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Title holds the value of the "title" field.
+	// Title as printed on the release
 	Title string `json:"title,omitempty"`
-	// ArtistID holds the value of the "artist_id" field.
+	// The album's main artist
 	ArtistID uuid.UUID `json:"artist_id,omitempty"`
-	// ImageURL holds the value of the "image_url" field.
+	// Cover art
 	ImageURL string `json:"image_url,omitempty"`
+	// Dominant colors of the artwork as #rrggbb, most dominant first, for clients to tint the UI with
+	Palette []string `json:"palette,omitempty"`
+	// Record label that released the album
+	Label string `json:"label,omitempty"`
+	// Kind of release
+	AlbumType album.AlbumType `json:"album_type,omitempty"`
+	// When the album was first released
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	// Live tracks on the album, kept up to date as tracks change
+	TrackCount int `json:"track_count,omitempty"`
+	// Summed length of the album's live tracks in milliseconds; tracks of unknown length add nothing
+	TotalDurationMs int64 `json:"total_duration_ms,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AlbumQuery when eager-loading is set.
 	Edges        AlbumEdges `json:"edges"`
@@ -69,9 +84,13 @@ func (*Album) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case album.FieldTitle, album.FieldImageURL:
+		case album.FieldPalette:
+			values[i] = new([]byte)
+		case album.FieldTrackCount, album.FieldTotalDurationMs:
+			values[i] = new(sql.NullInt64)
+		case album.FieldTitle, album.FieldImageURL, album.FieldLabel, album.FieldAlbumType:
 			values[i] = new(sql.NullString)
-		case album.FieldCreatedAt:
+		case album.FieldReleaseDate, album.FieldCreatedAt, album.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case album.FieldID, album.FieldArtistID:
 			values[i] = new(uuid.UUID)
@@ -114,12 +133,58 @@ func (_m *Album) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ImageURL = value.String
 			}
+		case album.FieldPalette:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field palette", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Palette); err != nil {
+					return fmt.Errorf("unmarshal field palette: %w", err)
+				}
+			}
+		case album.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case album.FieldAlbumType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field album_type", values[i])
+			} else if value.Valid {
+				_m.AlbumType = album.AlbumType(value.String)
+			}
+		case album.FieldReleaseDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field release_date", values[i])
+			} else if value.Valid {
+				_m.ReleaseDate = new(time.Time)
+				*_m.ReleaseDate = value.Time
+			}
+		case album.FieldTrackCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field track_count", values[i])
+			} else if value.Valid {
+				_m.TrackCount = int(value.Int64)
+			}
+		case album.FieldTotalDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_duration_ms", values[i])
+			} else if value.Valid {
+				_m.TotalDurationMs = value.Int64
+			}
 		case album.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case album.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -175,8 +240,33 @@ func (_m *Album) String() string {
 	builder.WriteString("image_url=")
 	builder.WriteString(_m.ImageURL)
 	builder.WriteString(", ")
+	builder.WriteString("palette=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Palette))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("album_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlbumType))
+	builder.WriteString(", ")
+	if v := _m.ReleaseDate; v != nil {
+		builder.WriteString("release_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("track_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackCount))
+	builder.WriteString(", ")
+	builder.WriteString("total_duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalDurationMs))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,10 @@
 package album
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -21,8 +23,22 @@ const (
 	FieldArtistID = "artist_id"
 	// FieldImageURL holds the string denoting the image_url field in the database.
 	FieldImageURL = "image_url"
+	// FieldPalette holds the string denoting the palette field in the database.
+	FieldPalette = "palette"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldAlbumType holds the string denoting the album_type field in the database.
+	FieldAlbumType = "album_type"
+	// FieldReleaseDate holds the string denoting the release_date field in the database.
+	FieldReleaseDate = "release_date"
+	// FieldTrackCount holds the string denoting the track_count field in the database.
+	FieldTrackCount = "track_count"
+	// FieldTotalDurationMs holds the string denoting the total_duration_ms field in the database.
+	FieldTotalDurationMs = "total_duration_ms"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeArtist holds the string denoting the artist edge name in mutations.
 	EdgeArtist = "artist"
 	// EdgeTracks holds the string denoting the tracks edge name in mutations.
@@ -51,7 +67,14 @@ var Columns = []string{
 	FieldTitle,
 	FieldArtistID,
 	FieldImageURL,
+	FieldPalette,
+	FieldLabel,
+	FieldAlbumType,
+	FieldReleaseDate,
+	FieldTrackCount,
+	FieldTotalDurationMs,
 	FieldCreatedAt,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -64,15 +87,60 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// DefaultTrackCount holds the default value on creation for the "track_count" field.
+	DefaultTrackCount int
+	// TrackCountValidator is a validator for the "track_count" field. It is called by the builders before save.
+	TrackCountValidator func(int) error
+	// DefaultTotalDurationMs holds the default value on creation for the "total_duration_ms" field.
+	DefaultTotalDurationMs int64
+	// TotalDurationMsValidator is a validator for the "total_duration_ms" field. It is called by the builders before save.
+	TotalDurationMsValidator func(int64) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// AlbumType defines the type for the "album_type" enum field.
+type AlbumType string
+
+// AlbumTypeAlbum is the default value of the AlbumType enum.
+const DefaultAlbumType = AlbumTypeAlbum
+
+// AlbumType values.
+const (
+	AlbumTypeAlbum       AlbumType = "album"
+	AlbumTypeSingle      AlbumType = "single"
+	AlbumTypeEp          AlbumType = "ep"
+	AlbumTypeCompilation AlbumType = "compilation"
+)
+
+func (at AlbumType) String() string {
+	return string(at)
+}
+
+// AlbumTypeValidator is a validator for the "album_type" field enum values. It is called by the builders before save.
+func AlbumTypeValidator(at AlbumType) error {
+	switch at {
+	case AlbumTypeAlbum, AlbumTypeSingle, AlbumTypeEp, AlbumTypeCompilation:
+		return nil
+	default:
+		return fmt.Errorf("album: invalid enum value for album_type field: %q", at)
+	}
+}
+
 // OrderOption defines the ordering options for the Album queries.
 type OrderOption func(*sql.Selector)
 
@@ -96,11 +164,41 @@ func ByImageURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImageURL, opts...).ToFunc()
 }
 
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByAlbumType orders the results by the album_type field.
+func ByAlbumType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlbumType, opts...).ToFunc()
+}
+
+// ByReleaseDate orders the results by the release_date field.
+func ByReleaseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleaseDate, opts...).ToFunc()
+}
+
+// ByTrackCount orders the results by the track_count field.
+func ByTrackCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackCount, opts...).ToFunc()
+}
+
+// ByTotalDurationMs orders the results by the total_duration_ms field.
+func ByTotalDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDurationMs, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByArtistField orders the results by artist field.
 func ByArtistField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -71,11 +71,31 @@ func ImageURL(v string) predicate.Album {
 	return predicate.Album(sql.FieldEQ(FieldImageURL, v))
 }
 
+// ReleaseDate applies equality check predicate on the "release_date" field. It's identical to ReleaseDateEQ.
+func ReleaseDate(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// TrackCount applies equality check predicate on the "track_count" field. It's identical to TrackCountEQ.
+func TrackCount(v int) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTrackCount, v))
+}
+
+// TotalDurationMs applies equality check predicate on the "total_duration_ms" field. It's identical to TotalDurationMsEQ.
+func TotalDurationMs(v int64) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTotalDurationMs, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Album {
 	return predicate.Album(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Album {
 	return predicate.Album(sql.FieldEQ(FieldTitle, v))
@@ -236,6 +256,241 @@ func ImageURLContainsFold(v string) predicate.Album {
 	return predicate.Album(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// PaletteIsNil applies the IsNil predicate on the "palette" field.
+func PaletteIsNil() predicate.Album {
+	return predicate.Album(sql.FieldIsNull(FieldPalette))
+}
+
+// PaletteNotNil applies the NotNil predicate on the "palette" field.
+func PaletteNotNil() predicate.Album {
+	return predicate.Album(sql.FieldNotNull(FieldPalette))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.Album {
+	return predicate.Album(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.Album {
+	return predicate.Album(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.Album {
+	return predicate.Album(sql.FieldIsNull(FieldLabel))
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.Album {
+	return predicate.Album(sql.FieldNotNull(FieldLabel))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.Album {
+	return predicate.Album(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// AlbumTypeEQ applies the EQ predicate on the "album_type" field.
+func AlbumTypeEQ(v AlbumType) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldAlbumType, v))
+}
+
+// AlbumTypeNEQ applies the NEQ predicate on the "album_type" field.
+func AlbumTypeNEQ(v AlbumType) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldAlbumType, v))
+}
+
+// AlbumTypeIn applies the In predicate on the "album_type" field.
+func AlbumTypeIn(vs ...AlbumType) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldAlbumType, vs...))
+}
+
+// AlbumTypeNotIn applies the NotIn predicate on the "album_type" field.
+func AlbumTypeNotIn(vs ...AlbumType) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldAlbumType, vs...))
+}
+
+// ReleaseDateEQ applies the EQ predicate on the "release_date" field.
+func ReleaseDateEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateNEQ applies the NEQ predicate on the "release_date" field.
+func ReleaseDateNEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldReleaseDate, v))
+}
+
+// ReleaseDateIn applies the In predicate on the "release_date" field.
+func ReleaseDateIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateNotIn applies the NotIn predicate on the "release_date" field.
+func ReleaseDateNotIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldReleaseDate, vs...))
+}
+
+// ReleaseDateGT applies the GT predicate on the "release_date" field.
+func ReleaseDateGT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldReleaseDate, v))
+}
+
+// ReleaseDateGTE applies the GTE predicate on the "release_date" field.
+func ReleaseDateGTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateLT applies the LT predicate on the "release_date" field.
+func ReleaseDateLT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldReleaseDate, v))
+}
+
+// ReleaseDateLTE applies the LTE predicate on the "release_date" field.
+func ReleaseDateLTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldReleaseDate, v))
+}
+
+// ReleaseDateIsNil applies the IsNil predicate on the "release_date" field.
+func ReleaseDateIsNil() predicate.Album {
+	return predicate.Album(sql.FieldIsNull(FieldReleaseDate))
+}
+
+// ReleaseDateNotNil applies the NotNil predicate on the "release_date" field.
+func ReleaseDateNotNil() predicate.Album {
+	return predicate.Album(sql.FieldNotNull(FieldReleaseDate))
+}
+
+// TrackCountEQ applies the EQ predicate on the "track_count" field.
+func TrackCountEQ(v int) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTrackCount, v))
+}
+
+// TrackCountNEQ applies the NEQ predicate on the "track_count" field.
+func TrackCountNEQ(v int) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldTrackCount, v))
+}
+
+// TrackCountIn applies the In predicate on the "track_count" field.
+func TrackCountIn(vs ...int) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldTrackCount, vs...))
+}
+
+// TrackCountNotIn applies the NotIn predicate on the "track_count" field.
+func TrackCountNotIn(vs ...int) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldTrackCount, vs...))
+}
+
+// TrackCountGT applies the GT predicate on the "track_count" field.
+func TrackCountGT(v int) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldTrackCount, v))
+}
+
+// TrackCountGTE applies the GTE predicate on the "track_count" field.
+func TrackCountGTE(v int) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldTrackCount, v))
+}
+
+// TrackCountLT applies the LT predicate on the "track_count" field.
+func TrackCountLT(v int) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldTrackCount, v))
+}
+
+// TrackCountLTE applies the LTE predicate on the "track_count" field.
+func TrackCountLTE(v int) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldTrackCount, v))
+}
+
+// TotalDurationMsEQ applies the EQ predicate on the "total_duration_ms" field.
+func TotalDurationMsEQ(v int64) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldTotalDurationMs, v))
+}
+
+// TotalDurationMsNEQ applies the NEQ predicate on the "total_duration_ms" field.
+func TotalDurationMsNEQ(v int64) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldTotalDurationMs, v))
+}
+
+// TotalDurationMsIn applies the In predicate on the "total_duration_ms" field.
+func TotalDurationMsIn(vs ...int64) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldTotalDurationMs, vs...))
+}
+
+// TotalDurationMsNotIn applies the NotIn predicate on the "total_duration_ms" field.
+func TotalDurationMsNotIn(vs ...int64) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldTotalDurationMs, vs...))
+}
+
+// TotalDurationMsGT applies the GT predicate on the "total_duration_ms" field.
+func TotalDurationMsGT(v int64) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldTotalDurationMs, v))
+}
+
+// TotalDurationMsGTE applies the GTE predicate on the "total_duration_ms" field.
+func TotalDurationMsGTE(v int64) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldTotalDurationMs, v))
+}
+
+// TotalDurationMsLT applies the LT predicate on the "total_duration_ms" field.
+func TotalDurationMsLT(v int64) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldTotalDurationMs, v))
+}
+
+// TotalDurationMsLTE applies the LTE predicate on the "total_duration_ms" field.
+func TotalDurationMsLTE(v int64) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldTotalDurationMs, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Album {
 	return predicate.Album(sql.FieldEQ(FieldCreatedAt, v))
@@ -276,6 +531,56 @@ func CreatedAtLTE(v time.Time) predicate.Album {
 	return predicate.Album(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Album {
+	return predicate.Album(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Album {
+	return predicate.Album(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Album {
+	return predicate.Album(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Album {
+	return predicate.Album(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasArtist applies the HasEdge predicate on the "artist" edge.
 func HasArtist() predicate.Album {
 	return predicate.Album(func(s *sql.Selector) {
@@ -49,6 +49,82 @@ func (_c *AlbumCreate) SetNillableImageURL(v *string) *AlbumCreate {
 	return _c
 }
 
+// SetPalette sets the "palette" field.
+func (_c *AlbumCreate) SetPalette(v []string) *AlbumCreate {
+	_c.mutation.SetPalette(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *AlbumCreate) SetLabel(v string) *AlbumCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableLabel(v *string) *AlbumCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetAlbumType sets the "album_type" field.
+func (_c *AlbumCreate) SetAlbumType(v album.AlbumType) *AlbumCreate {
+	_c.mutation.SetAlbumType(v)
+	return _c
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableAlbumType(v *album.AlbumType) *AlbumCreate {
+	if v != nil {
+		_c.SetAlbumType(*v)
+	}
+	return _c
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_c *AlbumCreate) SetReleaseDate(v time.Time) *AlbumCreate {
+	_c.mutation.SetReleaseDate(v)
+	return _c
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableReleaseDate(v *time.Time) *AlbumCreate {
+	if v != nil {
+		_c.SetReleaseDate(*v)
+	}
+	return _c
+}
+
+// SetTrackCount sets the "track_count" field.
+func (_c *AlbumCreate) SetTrackCount(v int) *AlbumCreate {
+	_c.mutation.SetTrackCount(v)
+	return _c
+}
+
+// SetNillableTrackCount sets the "track_count" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableTrackCount(v *int) *AlbumCreate {
+	if v != nil {
+		_c.SetTrackCount(*v)
+	}
+	return _c
+}
+
+// SetTotalDurationMs sets the "total_duration_ms" field.
+func (_c *AlbumCreate) SetTotalDurationMs(v int64) *AlbumCreate {
+	_c.mutation.SetTotalDurationMs(v)
+	return _c
+}
+
+// SetNillableTotalDurationMs sets the "total_duration_ms" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableTotalDurationMs(v *int64) *AlbumCreate {
+	if v != nil {
+		_c.SetTotalDurationMs(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AlbumCreate) SetCreatedAt(v time.Time) *AlbumCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -63,6 +139,20 @@ func (_c *AlbumCreate) SetNillableCreatedAt(v *time.Time) *AlbumCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AlbumCreate) SetDeletedAt(v time.Time) *AlbumCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AlbumCreate) SetNillableDeletedAt(v *time.Time) *AlbumCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AlbumCreate) SetID(v uuid.UUID) *AlbumCreate {
 	_c.mutation.SetID(v)
@@ -104,7 +194,9 @@ func (_c *AlbumCreate) Mutation() *AlbumMutation {
 
 // Save creates the Album in the database.
 func (_c *AlbumCreate) Save(ctx context.Context) (*Album, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -131,15 +223,34 @@ func (_c *AlbumCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AlbumCreate) defaults() {
+func (_c *AlbumCreate) defaults() error {
+	if _, ok := _c.mutation.AlbumType(); !ok {
+		v := album.DefaultAlbumType
+		_c.mutation.SetAlbumType(v)
+	}
+	if _, ok := _c.mutation.TrackCount(); !ok {
+		v := album.DefaultTrackCount
+		_c.mutation.SetTrackCount(v)
+	}
+	if _, ok := _c.mutation.TotalDurationMs(); !ok {
+		v := album.DefaultTotalDurationMs
+		_c.mutation.SetTotalDurationMs(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if album.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized album.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := album.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if album.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized album.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := album.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -155,6 +266,35 @@ func (_c *AlbumCreate) check() error {
 	if _, ok := _c.mutation.ArtistID(); !ok {
 		return &ValidationError{Name: "artist_id", err: errors.New(`ent: missing required field "Album.artist_id"`)}
 	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := album.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Album.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AlbumType(); !ok {
+		return &ValidationError{Name: "album_type", err: errors.New(`ent: missing required field "Album.album_type"`)}
+	}
+	if v, ok := _c.mutation.AlbumType(); ok {
+		if err := album.AlbumTypeValidator(v); err != nil {
+			return &ValidationError{Name: "album_type", err: fmt.Errorf(`ent: validator failed for field "Album.album_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TrackCount(); !ok {
+		return &ValidationError{Name: "track_count", err: errors.New(`ent: missing required field "Album.track_count"`)}
+	}
+	if v, ok := _c.mutation.TrackCount(); ok {
+		if err := album.TrackCountValidator(v); err != nil {
+			return &ValidationError{Name: "track_count", err: fmt.Errorf(`ent: validator failed for field "Album.track_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalDurationMs(); !ok {
+		return &ValidationError{Name: "total_duration_ms", err: errors.New(`ent: missing required field "Album.total_duration_ms"`)}
+	}
+	if v, ok := _c.mutation.TotalDurationMs(); ok {
+		if err := album.TotalDurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_duration_ms", err: fmt.Errorf(`ent: validator failed for field "Album.total_duration_ms": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Album.created_at"`)}
 	}
@@ -204,10 +344,38 @@ func (_c *AlbumCreate) createSpec() (*Album, *sqlgraph.CreateSpec) {
 		_spec.SetField(album.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := _c.mutation.Palette(); ok {
+		_spec.SetField(album.FieldPalette, field.TypeJSON, value)
+		_node.Palette = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeEnum, value)
+		_node.AlbumType = value
+	}
+	if value, ok := _c.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeTime, value)
+		_node.ReleaseDate = &value
+	}
+	if value, ok := _c.mutation.TrackCount(); ok {
+		_spec.SetField(album.FieldTrackCount, field.TypeInt, value)
+		_node.TrackCount = value
+	}
+	if value, ok := _c.mutation.TotalDurationMs(); ok {
+		_spec.SetField(album.FieldTotalDurationMs, field.TypeInt64, value)
+		_node.TotalDurationMs = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(album.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.ArtistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 	"streamify/ent/album"
@@ -401,6 +402,12 @@ func (_q *AlbumQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if album.Policy == nil {
+		return errors.New("ent: uninitialized album.Policy (forgotten import ent/runtime?)")
+	}
+	if err := album.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
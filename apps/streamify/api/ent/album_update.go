@@ -14,6 +14,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -79,6 +80,120 @@ func (_u *AlbumUpdate) ClearImageURL() *AlbumUpdate {
 	return _u
 }
 
+// SetPalette sets the "palette" field.
+func (_u *AlbumUpdate) SetPalette(v []string) *AlbumUpdate {
+	_u.mutation.SetPalette(v)
+	return _u
+}
+
+// AppendPalette appends value to the "palette" field.
+func (_u *AlbumUpdate) AppendPalette(v []string) *AlbumUpdate {
+	_u.mutation.AppendPalette(v)
+	return _u
+}
+
+// ClearPalette clears the value of the "palette" field.
+func (_u *AlbumUpdate) ClearPalette() *AlbumUpdate {
+	_u.mutation.ClearPalette()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *AlbumUpdate) SetLabel(v string) *AlbumUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableLabel(v *string) *AlbumUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *AlbumUpdate) ClearLabel() *AlbumUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetAlbumType sets the "album_type" field.
+func (_u *AlbumUpdate) SetAlbumType(v album.AlbumType) *AlbumUpdate {
+	_u.mutation.SetAlbumType(v)
+	return _u
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableAlbumType(v *album.AlbumType) *AlbumUpdate {
+	if v != nil {
+		_u.SetAlbumType(*v)
+	}
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *AlbumUpdate) SetReleaseDate(v time.Time) *AlbumUpdate {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableReleaseDate(v *time.Time) *AlbumUpdate {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *AlbumUpdate) ClearReleaseDate() *AlbumUpdate {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// SetTrackCount sets the "track_count" field.
+func (_u *AlbumUpdate) SetTrackCount(v int) *AlbumUpdate {
+	_u.mutation.ResetTrackCount()
+	_u.mutation.SetTrackCount(v)
+	return _u
+}
+
+// SetNillableTrackCount sets the "track_count" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableTrackCount(v *int) *AlbumUpdate {
+	if v != nil {
+		_u.SetTrackCount(*v)
+	}
+	return _u
+}
+
+// AddTrackCount adds value to the "track_count" field.
+func (_u *AlbumUpdate) AddTrackCount(v int) *AlbumUpdate {
+	_u.mutation.AddTrackCount(v)
+	return _u
+}
+
+// SetTotalDurationMs sets the "total_duration_ms" field.
+func (_u *AlbumUpdate) SetTotalDurationMs(v int64) *AlbumUpdate {
+	_u.mutation.ResetTotalDurationMs()
+	_u.mutation.SetTotalDurationMs(v)
+	return _u
+}
+
+// SetNillableTotalDurationMs sets the "total_duration_ms" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableTotalDurationMs(v *int64) *AlbumUpdate {
+	if v != nil {
+		_u.SetTotalDurationMs(*v)
+	}
+	return _u
+}
+
+// AddTotalDurationMs adds value to the "total_duration_ms" field.
+func (_u *AlbumUpdate) AddTotalDurationMs(v int64) *AlbumUpdate {
+	_u.mutation.AddTotalDurationMs(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AlbumUpdate) SetCreatedAt(v time.Time) *AlbumUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -93,6 +208,26 @@ func (_u *AlbumUpdate) SetNillableCreatedAt(v *time.Time) *AlbumUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AlbumUpdate) SetDeletedAt(v time.Time) *AlbumUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AlbumUpdate) SetNillableDeletedAt(v *time.Time) *AlbumUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AlbumUpdate) ClearDeletedAt() *AlbumUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetArtist sets the "artist" edge to the Artist entity.
 func (_u *AlbumUpdate) SetArtist(v *Artist) *AlbumUpdate {
 	return _u.SetArtistID(v.ID)
@@ -179,6 +314,26 @@ func (_u *AlbumUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Album.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := album.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Album.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlbumType(); ok {
+		if err := album.AlbumTypeValidator(v); err != nil {
+			return &ValidationError{Name: "album_type", err: fmt.Errorf(`ent: validator failed for field "Album.album_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrackCount(); ok {
+		if err := album.TrackCountValidator(v); err != nil {
+			return &ValidationError{Name: "track_count", err: fmt.Errorf(`ent: validator failed for field "Album.track_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalDurationMs(); ok {
+		if err := album.TotalDurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_duration_ms", err: fmt.Errorf(`ent: validator failed for field "Album.total_duration_ms": %w`, err)}
+		}
+	}
 	if _u.mutation.ArtistCleared() && len(_u.mutation.ArtistIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Album.artist"`)
 	}
@@ -206,9 +361,53 @@ func (_u *AlbumUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(album.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Palette(); ok {
+		_spec.SetField(album.FieldPalette, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPalette(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, album.FieldPalette, value)
+		})
+	}
+	if _u.mutation.PaletteCleared() {
+		_spec.ClearField(album.FieldPalette, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(album.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(album.FieldReleaseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrackCount(); ok {
+		_spec.SetField(album.FieldTrackCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrackCount(); ok {
+		_spec.AddField(album.FieldTrackCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalDurationMs(); ok {
+		_spec.SetField(album.FieldTotalDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDurationMs(); ok {
+		_spec.AddField(album.FieldTotalDurationMs, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(album.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(album.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.ArtistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -351,6 +550,120 @@ func (_u *AlbumUpdateOne) ClearImageURL() *AlbumUpdateOne {
 	return _u
 }
 
+// SetPalette sets the "palette" field.
+func (_u *AlbumUpdateOne) SetPalette(v []string) *AlbumUpdateOne {
+	_u.mutation.SetPalette(v)
+	return _u
+}
+
+// AppendPalette appends value to the "palette" field.
+func (_u *AlbumUpdateOne) AppendPalette(v []string) *AlbumUpdateOne {
+	_u.mutation.AppendPalette(v)
+	return _u
+}
+
+// ClearPalette clears the value of the "palette" field.
+func (_u *AlbumUpdateOne) ClearPalette() *AlbumUpdateOne {
+	_u.mutation.ClearPalette()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *AlbumUpdateOne) SetLabel(v string) *AlbumUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableLabel(v *string) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *AlbumUpdateOne) ClearLabel() *AlbumUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetAlbumType sets the "album_type" field.
+func (_u *AlbumUpdateOne) SetAlbumType(v album.AlbumType) *AlbumUpdateOne {
+	_u.mutation.SetAlbumType(v)
+	return _u
+}
+
+// SetNillableAlbumType sets the "album_type" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableAlbumType(v *album.AlbumType) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetAlbumType(*v)
+	}
+	return _u
+}
+
+// SetReleaseDate sets the "release_date" field.
+func (_u *AlbumUpdateOne) SetReleaseDate(v time.Time) *AlbumUpdateOne {
+	_u.mutation.SetReleaseDate(v)
+	return _u
+}
+
+// SetNillableReleaseDate sets the "release_date" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableReleaseDate(v *time.Time) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetReleaseDate(*v)
+	}
+	return _u
+}
+
+// ClearReleaseDate clears the value of the "release_date" field.
+func (_u *AlbumUpdateOne) ClearReleaseDate() *AlbumUpdateOne {
+	_u.mutation.ClearReleaseDate()
+	return _u
+}
+
+// SetTrackCount sets the "track_count" field.
+func (_u *AlbumUpdateOne) SetTrackCount(v int) *AlbumUpdateOne {
+	_u.mutation.ResetTrackCount()
+	_u.mutation.SetTrackCount(v)
+	return _u
+}
+
+// SetNillableTrackCount sets the "track_count" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableTrackCount(v *int) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetTrackCount(*v)
+	}
+	return _u
+}
+
+// AddTrackCount adds value to the "track_count" field.
+func (_u *AlbumUpdateOne) AddTrackCount(v int) *AlbumUpdateOne {
+	_u.mutation.AddTrackCount(v)
+	return _u
+}
+
+// SetTotalDurationMs sets the "total_duration_ms" field.
+func (_u *AlbumUpdateOne) SetTotalDurationMs(v int64) *AlbumUpdateOne {
+	_u.mutation.ResetTotalDurationMs()
+	_u.mutation.SetTotalDurationMs(v)
+	return _u
+}
+
+// SetNillableTotalDurationMs sets the "total_duration_ms" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableTotalDurationMs(v *int64) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetTotalDurationMs(*v)
+	}
+	return _u
+}
+
+// AddTotalDurationMs adds value to the "total_duration_ms" field.
+func (_u *AlbumUpdateOne) AddTotalDurationMs(v int64) *AlbumUpdateOne {
+	_u.mutation.AddTotalDurationMs(v)
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AlbumUpdateOne) SetCreatedAt(v time.Time) *AlbumUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -365,6 +678,26 @@ func (_u *AlbumUpdateOne) SetNillableCreatedAt(v *time.Time) *AlbumUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AlbumUpdateOne) SetDeletedAt(v time.Time) *AlbumUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AlbumUpdateOne) SetNillableDeletedAt(v *time.Time) *AlbumUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AlbumUpdateOne) ClearDeletedAt() *AlbumUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetArtist sets the "artist" edge to the Artist entity.
 func (_u *AlbumUpdateOne) SetArtist(v *Artist) *AlbumUpdateOne {
 	return _u.SetArtistID(v.ID)
@@ -464,6 +797,26 @@ func (_u *AlbumUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Album.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := album.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Album.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlbumType(); ok {
+		if err := album.AlbumTypeValidator(v); err != nil {
+			return &ValidationError{Name: "album_type", err: fmt.Errorf(`ent: validator failed for field "Album.album_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TrackCount(); ok {
+		if err := album.TrackCountValidator(v); err != nil {
+			return &ValidationError{Name: "track_count", err: fmt.Errorf(`ent: validator failed for field "Album.track_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalDurationMs(); ok {
+		if err := album.TotalDurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "total_duration_ms", err: fmt.Errorf(`ent: validator failed for field "Album.total_duration_ms": %w`, err)}
+		}
+	}
 	if _u.mutation.ArtistCleared() && len(_u.mutation.ArtistIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Album.artist"`)
 	}
@@ -508,9 +861,53 @@ func (_u *AlbumUpdateOne) sqlSave(ctx context.Context) (_node *Album, err error)
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(album.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Palette(); ok {
+		_spec.SetField(album.FieldPalette, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPalette(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, album.FieldPalette, value)
+		})
+	}
+	if _u.mutation.PaletteCleared() {
+		_spec.ClearField(album.FieldPalette, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(album.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(album.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlbumType(); ok {
+		_spec.SetField(album.FieldAlbumType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReleaseDate(); ok {
+		_spec.SetField(album.FieldReleaseDate, field.TypeTime, value)
+	}
+	if _u.mutation.ReleaseDateCleared() {
+		_spec.ClearField(album.FieldReleaseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TrackCount(); ok {
+		_spec.SetField(album.FieldTrackCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrackCount(); ok {
+		_spec.AddField(album.FieldTrackCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalDurationMs(); ok {
+		_spec.SetField(album.FieldTotalDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalDurationMs(); ok {
+		_spec.AddField(album.FieldTotalDurationMs, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(album.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(album.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(album.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.ArtistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/apikey"
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (_u *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *APIKeyUpdate) SetName(v string) *APIKeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableName(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *APIKeyUpdate) SetTier(v apikey.Tier) *APIKeyUpdate {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableTier(v *apikey.Tier) *APIKeyUpdate {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetMonthlyQuota sets the "monthly_quota" field.
+func (_u *APIKeyUpdate) SetMonthlyQuota(v int64) *APIKeyUpdate {
+	_u.mutation.ResetMonthlyQuota()
+	_u.mutation.SetMonthlyQuota(v)
+	return _u
+}
+
+// SetNillableMonthlyQuota sets the "monthly_quota" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableMonthlyQuota(v *int64) *APIKeyUpdate {
+	if v != nil {
+		_u.SetMonthlyQuota(*v)
+	}
+	return _u
+}
+
+// AddMonthlyQuota adds value to the "monthly_quota" field.
+func (_u *APIKeyUpdate) AddMonthlyQuota(v int64) *APIKeyUpdate {
+	_u.mutation.AddMonthlyQuota(v)
+	return _u
+}
+
+// ClearMonthlyQuota clears the value of the "monthly_quota" field.
+func (_u *APIKeyUpdate) ClearMonthlyQuota() *APIKeyUpdate {
+	_u.mutation.ClearMonthlyQuota()
+	return _u
+}
+
+// SetOverage sets the "overage" field.
+func (_u *APIKeyUpdate) SetOverage(v apikey.Overage) *APIKeyUpdate {
+	_u.mutation.SetOverage(v)
+	return _u
+}
+
+// SetNillableOverage sets the "overage" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableOverage(v *apikey.Overage) *APIKeyUpdate {
+	if v != nil {
+		_u.SetOverage(*v)
+	}
+	return _u
+}
+
+// ClearOverage clears the value of the "overage" field.
+func (_u *APIKeyUpdate) ClearOverage() *APIKeyUpdate {
+	_u.mutation.ClearOverage()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *APIKeyUpdate) SetLastUsedAt(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableLastUsedAt(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *APIKeyUpdate) ClearLastUsedAt() *APIKeyUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *APIKeyUpdate) SetRevokedAt(v time.Time) *APIKeyUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableRevokedAt(v *time.Time) *APIKeyUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *APIKeyUpdate) ClearRevokedAt() *APIKeyUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (_u *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := apikey.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "APIKey.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyQuota(); ok {
+		if err := apikey.MonthlyQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_quota", err: fmt.Errorf(`ent: validator failed for field "APIKey.monthly_quota": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Overage(); ok {
+		if err := apikey.OverageValidator(v); err != nil {
+			return &ValidationError{Name: "overage", err: fmt.Errorf(`ent: validator failed for field "APIKey.overage": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
+	}
+	return nil
+}
+
+func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(apikey.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MonthlyQuota(); ok {
+		_spec.SetField(apikey.FieldMonthlyQuota, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyQuota(); ok {
+		_spec.AddField(apikey.FieldMonthlyQuota, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyQuotaCleared() {
+		_spec.ClearField(apikey.FieldMonthlyQuota, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Overage(); ok {
+		_spec.SetField(apikey.FieldOverage, field.TypeEnum, value)
+	}
+	if _u.mutation.OverageCleared() {
+		_spec.ClearField(apikey.FieldOverage, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyMutation
+}
+
+// SetName sets the "name" field.
+func (_u *APIKeyUpdateOne) SetName(v string) *APIKeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableName(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetTier sets the "tier" field.
+func (_u *APIKeyUpdateOne) SetTier(v apikey.Tier) *APIKeyUpdateOne {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableTier(v *apikey.Tier) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// SetMonthlyQuota sets the "monthly_quota" field.
+func (_u *APIKeyUpdateOne) SetMonthlyQuota(v int64) *APIKeyUpdateOne {
+	_u.mutation.ResetMonthlyQuota()
+	_u.mutation.SetMonthlyQuota(v)
+	return _u
+}
+
+// SetNillableMonthlyQuota sets the "monthly_quota" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableMonthlyQuota(v *int64) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetMonthlyQuota(*v)
+	}
+	return _u
+}
+
+// AddMonthlyQuota adds value to the "monthly_quota" field.
+func (_u *APIKeyUpdateOne) AddMonthlyQuota(v int64) *APIKeyUpdateOne {
+	_u.mutation.AddMonthlyQuota(v)
+	return _u
+}
+
+// ClearMonthlyQuota clears the value of the "monthly_quota" field.
+func (_u *APIKeyUpdateOne) ClearMonthlyQuota() *APIKeyUpdateOne {
+	_u.mutation.ClearMonthlyQuota()
+	return _u
+}
+
+// SetOverage sets the "overage" field.
+func (_u *APIKeyUpdateOne) SetOverage(v apikey.Overage) *APIKeyUpdateOne {
+	_u.mutation.SetOverage(v)
+	return _u
+}
+
+// SetNillableOverage sets the "overage" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableOverage(v *apikey.Overage) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetOverage(*v)
+	}
+	return _u
+}
+
+// ClearOverage clears the value of the "overage" field.
+func (_u *APIKeyUpdateOne) ClearOverage() *APIKeyUpdateOne {
+	_u.mutation.ClearOverage()
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *APIKeyUpdateOne) SetLastUsedAt(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableLastUsedAt(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *APIKeyUpdateOne) ClearLastUsedAt() *APIKeyUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *APIKeyUpdateOne) SetRevokedAt(v time.Time) *APIKeyUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableRevokedAt(v *time.Time) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *APIKeyUpdateOne) ClearRevokedAt() *APIKeyUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (_u *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (_u *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (_u *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKey.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tier(); ok {
+		if err := apikey.TierValidator(v); err != nil {
+			return &ValidationError{Name: "tier", err: fmt.Errorf(`ent: validator failed for field "APIKey.tier": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MonthlyQuota(); ok {
+		if err := apikey.MonthlyQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "monthly_quota", err: fmt.Errorf(`ent: validator failed for field "APIKey.monthly_quota": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Overage(); ok {
+		if err := apikey.OverageValidator(v); err != nil {
+			return &ValidationError{Name: "overage", err: fmt.Errorf(`ent: validator failed for field "APIKey.overage": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
+	}
+	return nil
+}
+
+func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(apikey.FieldTier, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MonthlyQuota(); ok {
+		_spec.SetField(apikey.FieldMonthlyQuota, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyQuota(); ok {
+		_spec.AddField(apikey.FieldMonthlyQuota, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyQuotaCleared() {
+		_spec.ClearField(apikey.FieldMonthlyQuota, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Overage(); ok {
+		_spec.SetField(apikey.FieldOverage, field.TypeEnum, value)
+	}
+	if _u.mutation.OverageCleared() {
+		_spec.ClearField(apikey.FieldOverage, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &APIKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
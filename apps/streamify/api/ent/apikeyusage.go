@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/apikey"
+	"streamify/ent/apikeyusage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// APIKeyUsage is the model entity for the APIKeyUsage schema.
+type APIKeyUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The API key
+	KeyID uuid.UUID `json:"key_id,omitempty"`
+	// Start of the hour, in UTC
+	Bucket time.Time `json:"bucket,omitempty"`
+	// Requests made with the key in the hour
+	Requests int64 `json:"requests,omitempty"`
+	// Requests answered 429 Too Many Requests
+	RateLimited int64 `json:"rate_limited,omitempty"`
+	// Other 4xx answers
+	ClientErrors int64 `json:"client_errors,omitempty"`
+	// 5xx answers
+	ServerErrors int64 `json:"server_errors,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the APIKeyUsageQuery when eager-loading is set.
+	Edges        APIKeyUsageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// APIKeyUsageEdges holds the relations/edges for other nodes in the graph.
+type APIKeyUsageEdges struct {
+	// Key holds the value of the key edge.
+	Key *APIKey `json:"key,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// KeyOrErr returns the Key value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIKeyUsageEdges) KeyOrErr() (*APIKey, error) {
+	if e.Key != nil {
+		return e.Key, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: apikey.Label}
+	}
+	return nil, &NotLoadedError{edge: "key"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*APIKeyUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case apikeyusage.FieldRequests, apikeyusage.FieldRateLimited, apikeyusage.FieldClientErrors, apikeyusage.FieldServerErrors:
+			values[i] = new(sql.NullInt64)
+		case apikeyusage.FieldBucket:
+			values[i] = new(sql.NullTime)
+		case apikeyusage.FieldID, apikeyusage.FieldKeyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the APIKeyUsage fields.
+func (_m *APIKeyUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case apikeyusage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case apikeyusage.FieldKeyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value != nil {
+				_m.KeyID = *value
+			}
+		case apikeyusage.FieldBucket:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				_m.Bucket = value.Time
+			}
+		case apikeyusage.FieldRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requests", values[i])
+			} else if value.Valid {
+				_m.Requests = value.Int64
+			}
+		case apikeyusage.FieldRateLimited:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limited", values[i])
+			} else if value.Valid {
+				_m.RateLimited = value.Int64
+			}
+		case apikeyusage.FieldClientErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_errors", values[i])
+			} else if value.Valid {
+				_m.ClientErrors = value.Int64
+			}
+		case apikeyusage.FieldServerErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field server_errors", values[i])
+			} else if value.Valid {
+				_m.ServerErrors = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the APIKeyUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *APIKeyUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryKey queries the "key" edge of the APIKeyUsage entity.
+func (_m *APIKeyUsage) QueryKey() *APIKeyQuery {
+	return NewAPIKeyUsageClient(_m.config).QueryKey(_m)
+}
+
+// Update returns a builder for updating this APIKeyUsage.
+// Note that you need to call APIKeyUsage.Unwrap() before calling this method if this APIKeyUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *APIKeyUsage) Update() *APIKeyUsageUpdateOne {
+	return NewAPIKeyUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the APIKeyUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *APIKeyUsage) Unwrap() *APIKeyUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: APIKeyUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *APIKeyUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("APIKeyUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(_m.Bucket.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Requests))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limited=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RateLimited))
+	builder.WriteString(", ")
+	builder.WriteString("client_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClientErrors))
+	builder.WriteString(", ")
+	builder.WriteString("server_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ServerErrors))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// APIKeyUsages is a parsable slice of APIKeyUsage.
+type APIKeyUsages []*APIKeyUsage
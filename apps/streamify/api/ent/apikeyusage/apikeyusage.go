@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikeyusage
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the apikeyusage type in the database.
+	Label = "api_key_usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldBucket holds the string denoting the bucket field in the database.
+	FieldBucket = "bucket"
+	// FieldRequests holds the string denoting the requests field in the database.
+	FieldRequests = "requests"
+	// FieldRateLimited holds the string denoting the rate_limited field in the database.
+	FieldRateLimited = "rate_limited"
+	// FieldClientErrors holds the string denoting the client_errors field in the database.
+	FieldClientErrors = "client_errors"
+	// FieldServerErrors holds the string denoting the server_errors field in the database.
+	FieldServerErrors = "server_errors"
+	// EdgeKey holds the string denoting the key edge name in mutations.
+	EdgeKey = "key"
+	// Table holds the table name of the apikeyusage in the database.
+	Table = "api_key_usages"
+	// KeyTable is the table that holds the key relation/edge.
+	KeyTable = "api_key_usages"
+	// KeyInverseTable is the table name for the APIKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	KeyInverseTable = "api_keys"
+	// KeyColumn is the table column denoting the key relation/edge.
+	KeyColumn = "key_id"
+)
+
+// Columns holds all SQL columns for apikeyusage fields.
+var Columns = []string{
+	FieldID,
+	FieldKeyID,
+	FieldBucket,
+	FieldRequests,
+	FieldRateLimited,
+	FieldClientErrors,
+	FieldServerErrors,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRequests holds the default value on creation for the "requests" field.
+	DefaultRequests int64
+	// RequestsValidator is a validator for the "requests" field. It is called by the builders before save.
+	RequestsValidator func(int64) error
+	// DefaultRateLimited holds the default value on creation for the "rate_limited" field.
+	DefaultRateLimited int64
+	// RateLimitedValidator is a validator for the "rate_limited" field. It is called by the builders before save.
+	RateLimitedValidator func(int64) error
+	// DefaultClientErrors holds the default value on creation for the "client_errors" field.
+	DefaultClientErrors int64
+	// ClientErrorsValidator is a validator for the "client_errors" field. It is called by the builders before save.
+	ClientErrorsValidator func(int64) error
+	// DefaultServerErrors holds the default value on creation for the "server_errors" field.
+	DefaultServerErrors int64
+	// ServerErrorsValidator is a validator for the "server_errors" field. It is called by the builders before save.
+	ServerErrorsValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the APIKeyUsage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// ByBucket orders the results by the bucket field.
+func ByBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucket, opts...).ToFunc()
+}
+
+// ByRequests orders the results by the requests field.
+func ByRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequests, opts...).ToFunc()
+}
+
+// ByRateLimited orders the results by the rate_limited field.
+func ByRateLimited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimited, opts...).ToFunc()
+}
+
+// ByClientErrors orders the results by the client_errors field.
+func ByClientErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientErrors, opts...).ToFunc()
+}
+
+// ByServerErrors orders the results by the server_errors field.
+func ByServerErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerErrors, opts...).ToFunc()
+}
+
+// ByKeyField orders the results by key field.
+func ByKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newKeyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, KeyTable, KeyColumn),
+	)
+}
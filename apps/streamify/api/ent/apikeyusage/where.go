@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikeyusage
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldID, id))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldKeyID, v))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldBucket, v))
+}
+
+// Requests applies equality check predicate on the "requests" field. It's identical to RequestsEQ.
+func Requests(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// RateLimited applies equality check predicate on the "rate_limited" field. It's identical to RateLimitedEQ.
+func RateLimited(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldRateLimited, v))
+}
+
+// ClientErrors applies equality check predicate on the "client_errors" field. It's identical to ClientErrorsEQ.
+func ClientErrors(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldClientErrors, v))
+}
+
+// ServerErrors applies equality check predicate on the "server_errors" field. It's identical to ServerErrorsEQ.
+func ServerErrors(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldServerErrors, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...uuid.UUID) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v time.Time) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldBucket, v))
+}
+
+// RequestsEQ applies the EQ predicate on the "requests" field.
+func RequestsEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// RequestsNEQ applies the NEQ predicate on the "requests" field.
+func RequestsNEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldRequests, v))
+}
+
+// RequestsIn applies the In predicate on the "requests" field.
+func RequestsIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldRequests, vs...))
+}
+
+// RequestsNotIn applies the NotIn predicate on the "requests" field.
+func RequestsNotIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldRequests, vs...))
+}
+
+// RequestsGT applies the GT predicate on the "requests" field.
+func RequestsGT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldRequests, v))
+}
+
+// RequestsGTE applies the GTE predicate on the "requests" field.
+func RequestsGTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldRequests, v))
+}
+
+// RequestsLT applies the LT predicate on the "requests" field.
+func RequestsLT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldRequests, v))
+}
+
+// RequestsLTE applies the LTE predicate on the "requests" field.
+func RequestsLTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldRequests, v))
+}
+
+// RateLimitedEQ applies the EQ predicate on the "rate_limited" field.
+func RateLimitedEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldRateLimited, v))
+}
+
+// RateLimitedNEQ applies the NEQ predicate on the "rate_limited" field.
+func RateLimitedNEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldRateLimited, v))
+}
+
+// RateLimitedIn applies the In predicate on the "rate_limited" field.
+func RateLimitedIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldRateLimited, vs...))
+}
+
+// RateLimitedNotIn applies the NotIn predicate on the "rate_limited" field.
+func RateLimitedNotIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldRateLimited, vs...))
+}
+
+// RateLimitedGT applies the GT predicate on the "rate_limited" field.
+func RateLimitedGT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldRateLimited, v))
+}
+
+// RateLimitedGTE applies the GTE predicate on the "rate_limited" field.
+func RateLimitedGTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldRateLimited, v))
+}
+
+// RateLimitedLT applies the LT predicate on the "rate_limited" field.
+func RateLimitedLT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldRateLimited, v))
+}
+
+// RateLimitedLTE applies the LTE predicate on the "rate_limited" field.
+func RateLimitedLTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldRateLimited, v))
+}
+
+// ClientErrorsEQ applies the EQ predicate on the "client_errors" field.
+func ClientErrorsEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldClientErrors, v))
+}
+
+// ClientErrorsNEQ applies the NEQ predicate on the "client_errors" field.
+func ClientErrorsNEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldClientErrors, v))
+}
+
+// ClientErrorsIn applies the In predicate on the "client_errors" field.
+func ClientErrorsIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldClientErrors, vs...))
+}
+
+// ClientErrorsNotIn applies the NotIn predicate on the "client_errors" field.
+func ClientErrorsNotIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldClientErrors, vs...))
+}
+
+// ClientErrorsGT applies the GT predicate on the "client_errors" field.
+func ClientErrorsGT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldClientErrors, v))
+}
+
+// ClientErrorsGTE applies the GTE predicate on the "client_errors" field.
+func ClientErrorsGTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldClientErrors, v))
+}
+
+// ClientErrorsLT applies the LT predicate on the "client_errors" field.
+func ClientErrorsLT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldClientErrors, v))
+}
+
+// ClientErrorsLTE applies the LTE predicate on the "client_errors" field.
+func ClientErrorsLTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldClientErrors, v))
+}
+
+// ServerErrorsEQ applies the EQ predicate on the "server_errors" field.
+func ServerErrorsEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldEQ(FieldServerErrors, v))
+}
+
+// ServerErrorsNEQ applies the NEQ predicate on the "server_errors" field.
+func ServerErrorsNEQ(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNEQ(FieldServerErrors, v))
+}
+
+// ServerErrorsIn applies the In predicate on the "server_errors" field.
+func ServerErrorsIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldIn(FieldServerErrors, vs...))
+}
+
+// ServerErrorsNotIn applies the NotIn predicate on the "server_errors" field.
+func ServerErrorsNotIn(vs ...int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldNotIn(FieldServerErrors, vs...))
+}
+
+// ServerErrorsGT applies the GT predicate on the "server_errors" field.
+func ServerErrorsGT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGT(FieldServerErrors, v))
+}
+
+// ServerErrorsGTE applies the GTE predicate on the "server_errors" field.
+func ServerErrorsGTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldGTE(FieldServerErrors, v))
+}
+
+// ServerErrorsLT applies the LT predicate on the "server_errors" field.
+func ServerErrorsLT(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLT(FieldServerErrors, v))
+}
+
+// ServerErrorsLTE applies the LTE predicate on the "server_errors" field.
+func ServerErrorsLTE(v int64) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.FieldLTE(FieldServerErrors, v))
+}
+
+// HasKey applies the HasEdge predicate on the "key" edge.
+func HasKey() predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, KeyTable, KeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeyWith applies the HasEdge predicate on the "key" edge with a given conditions (other predicates).
+func HasKeyWith(preds ...predicate.APIKey) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(func(s *sql.Selector) {
+		step := newKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.APIKeyUsage) predicate.APIKeyUsage {
+	return predicate.APIKeyUsage(sql.NotPredicates(p))
+}
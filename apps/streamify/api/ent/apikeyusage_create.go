@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/apikey"
+	"streamify/ent/apikeyusage"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// APIKeyUsageCreate is the builder for creating a APIKeyUsage entity.
+type APIKeyUsageCreate struct {
+	config
+	mutation *APIKeyUsageMutation
+	hooks    []Hook
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *APIKeyUsageCreate) SetKeyID(v uuid.UUID) *APIKeyUsageCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *APIKeyUsageCreate) SetBucket(v time.Time) *APIKeyUsageCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetRequests sets the "requests" field.
+func (_c *APIKeyUsageCreate) SetRequests(v int64) *APIKeyUsageCreate {
+	_c.mutation.SetRequests(v)
+	return _c
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableRequests(v *int64) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetRequests(*v)
+	}
+	return _c
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_c *APIKeyUsageCreate) SetRateLimited(v int64) *APIKeyUsageCreate {
+	_c.mutation.SetRateLimited(v)
+	return _c
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableRateLimited(v *int64) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetRateLimited(*v)
+	}
+	return _c
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_c *APIKeyUsageCreate) SetClientErrors(v int64) *APIKeyUsageCreate {
+	_c.mutation.SetClientErrors(v)
+	return _c
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableClientErrors(v *int64) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetClientErrors(*v)
+	}
+	return _c
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_c *APIKeyUsageCreate) SetServerErrors(v int64) *APIKeyUsageCreate {
+	_c.mutation.SetServerErrors(v)
+	return _c
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableServerErrors(v *int64) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetServerErrors(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *APIKeyUsageCreate) SetID(v uuid.UUID) *APIKeyUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *APIKeyUsageCreate) SetNillableID(v *uuid.UUID) *APIKeyUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetKey sets the "key" edge to the APIKey entity.
+func (_c *APIKeyUsageCreate) SetKey(v *APIKey) *APIKeyUsageCreate {
+	return _c.SetKeyID(v.ID)
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_c *APIKeyUsageCreate) Mutation() *APIKeyUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the APIKeyUsage in the database.
+func (_c *APIKeyUsageCreate) Save(ctx context.Context) (*APIKeyUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *APIKeyUsageCreate) SaveX(ctx context.Context) *APIKeyUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *APIKeyUsageCreate) defaults() {
+	if _, ok := _c.mutation.Requests(); !ok {
+		v := apikeyusage.DefaultRequests
+		_c.mutation.SetRequests(v)
+	}
+	if _, ok := _c.mutation.RateLimited(); !ok {
+		v := apikeyusage.DefaultRateLimited
+		_c.mutation.SetRateLimited(v)
+	}
+	if _, ok := _c.mutation.ClientErrors(); !ok {
+		v := apikeyusage.DefaultClientErrors
+		_c.mutation.SetClientErrors(v)
+	}
+	if _, ok := _c.mutation.ServerErrors(); !ok {
+		v := apikeyusage.DefaultServerErrors
+		_c.mutation.SetServerErrors(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := apikeyusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *APIKeyUsageCreate) check() error {
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "APIKeyUsage.key_id"`)}
+	}
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "APIKeyUsage.bucket"`)}
+	}
+	if _, ok := _c.mutation.Requests(); !ok {
+		return &ValidationError{Name: "requests", err: errors.New(`ent: missing required field "APIKeyUsage.requests"`)}
+	}
+	if v, ok := _c.mutation.Requests(); ok {
+		if err := apikeyusage.RequestsValidator(v); err != nil {
+			return &ValidationError{Name: "requests", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.requests": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RateLimited(); !ok {
+		return &ValidationError{Name: "rate_limited", err: errors.New(`ent: missing required field "APIKeyUsage.rate_limited"`)}
+	}
+	if v, ok := _c.mutation.RateLimited(); ok {
+		if err := apikeyusage.RateLimitedValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limited", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.rate_limited": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientErrors(); !ok {
+		return &ValidationError{Name: "client_errors", err: errors.New(`ent: missing required field "APIKeyUsage.client_errors"`)}
+	}
+	if v, ok := _c.mutation.ClientErrors(); ok {
+		if err := apikeyusage.ClientErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "client_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.client_errors": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ServerErrors(); !ok {
+		return &ValidationError{Name: "server_errors", err: errors.New(`ent: missing required field "APIKeyUsage.server_errors"`)}
+	}
+	if v, ok := _c.mutation.ServerErrors(); ok {
+		if err := apikeyusage.ServerErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "server_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.server_errors": %w`, err)}
+		}
+	}
+	if len(_c.mutation.KeyIDs()) == 0 {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required edge "APIKeyUsage.key"`)}
+	}
+	return nil
+}
+
+func (_c *APIKeyUsageCreate) sqlSave(ctx context.Context) (*APIKeyUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *APIKeyUsageCreate) createSpec() (*APIKeyUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIKeyUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(apikeyusage.Table, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(apikeyusage.FieldBucket, field.TypeTime, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.Requests(); ok {
+		_spec.SetField(apikeyusage.FieldRequests, field.TypeInt64, value)
+		_node.Requests = value
+	}
+	if value, ok := _c.mutation.RateLimited(); ok {
+		_spec.SetField(apikeyusage.FieldRateLimited, field.TypeInt64, value)
+		_node.RateLimited = value
+	}
+	if value, ok := _c.mutation.ClientErrors(); ok {
+		_spec.SetField(apikeyusage.FieldClientErrors, field.TypeInt64, value)
+		_node.ClientErrors = value
+	}
+	if value, ok := _c.mutation.ServerErrors(); ok {
+		_spec.SetField(apikeyusage.FieldServerErrors, field.TypeInt64, value)
+		_node.ServerErrors = value
+	}
+	if nodes := _c.mutation.KeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   apikeyusage.KeyTable,
+			Columns: []string{apikeyusage.KeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.KeyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// APIKeyUsageCreateBulk is the builder for creating many APIKeyUsage entities in bulk.
+type APIKeyUsageCreateBulk struct {
+	config
+	err      error
+	builders []*APIKeyUsageCreate
+}
+
+// Save creates the APIKeyUsage entities in the database.
+func (_c *APIKeyUsageCreateBulk) Save(ctx context.Context) ([]*APIKeyUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*APIKeyUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIKeyUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *APIKeyUsageCreateBulk) SaveX(ctx context.Context) []*APIKeyUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *APIKeyUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *APIKeyUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
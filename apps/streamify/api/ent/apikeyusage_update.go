@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/apikeyusage"
+	"streamify/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUsageUpdate is the builder for updating APIKeyUsage entities.
+type APIKeyUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeyUsageMutation
+}
+
+// Where appends a list predicates to the APIKeyUsageUpdate builder.
+func (_u *APIKeyUsageUpdate) Where(ps ...predicate.APIKeyUsage) *APIKeyUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *APIKeyUsageUpdate) SetRequests(v int64) *APIKeyUsageUpdate {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableRequests(v *int64) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *APIKeyUsageUpdate) AddRequests(v int64) *APIKeyUsageUpdate {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_u *APIKeyUsageUpdate) SetRateLimited(v int64) *APIKeyUsageUpdate {
+	_u.mutation.ResetRateLimited()
+	_u.mutation.SetRateLimited(v)
+	return _u
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableRateLimited(v *int64) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetRateLimited(*v)
+	}
+	return _u
+}
+
+// AddRateLimited adds value to the "rate_limited" field.
+func (_u *APIKeyUsageUpdate) AddRateLimited(v int64) *APIKeyUsageUpdate {
+	_u.mutation.AddRateLimited(v)
+	return _u
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_u *APIKeyUsageUpdate) SetClientErrors(v int64) *APIKeyUsageUpdate {
+	_u.mutation.ResetClientErrors()
+	_u.mutation.SetClientErrors(v)
+	return _u
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableClientErrors(v *int64) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetClientErrors(*v)
+	}
+	return _u
+}
+
+// AddClientErrors adds value to the "client_errors" field.
+func (_u *APIKeyUsageUpdate) AddClientErrors(v int64) *APIKeyUsageUpdate {
+	_u.mutation.AddClientErrors(v)
+	return _u
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_u *APIKeyUsageUpdate) SetServerErrors(v int64) *APIKeyUsageUpdate {
+	_u.mutation.ResetServerErrors()
+	_u.mutation.SetServerErrors(v)
+	return _u
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_u *APIKeyUsageUpdate) SetNillableServerErrors(v *int64) *APIKeyUsageUpdate {
+	if v != nil {
+		_u.SetServerErrors(*v)
+	}
+	return _u
+}
+
+// AddServerErrors adds value to the "server_errors" field.
+func (_u *APIKeyUsageUpdate) AddServerErrors(v int64) *APIKeyUsageUpdate {
+	_u.mutation.AddServerErrors(v)
+	return _u
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_u *APIKeyUsageUpdate) Mutation() *APIKeyUsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *APIKeyUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *APIKeyUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUsageUpdate) check() error {
+	if v, ok := _u.mutation.Requests(); ok {
+		if err := apikeyusage.RequestsValidator(v); err != nil {
+			return &ValidationError{Name: "requests", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.requests": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RateLimited(); ok {
+		if err := apikeyusage.RateLimitedValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limited", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.rate_limited": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientErrors(); ok {
+		if err := apikeyusage.ClientErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "client_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.client_errors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ServerErrors(); ok {
+		if err := apikeyusage.ServerErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "server_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.server_errors": %w`, err)}
+		}
+	}
+	if _u.mutation.KeyCleared() && len(_u.mutation.KeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIKeyUsage.key"`)
+	}
+	return nil
+}
+
+func (_u *APIKeyUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikeyusage.Table, apikeyusage.Columns, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(apikeyusage.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(apikeyusage.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RateLimited(); ok {
+		_spec.SetField(apikeyusage.FieldRateLimited, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimited(); ok {
+		_spec.AddField(apikeyusage.FieldRateLimited, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClientErrors(); ok {
+		_spec.SetField(apikeyusage.FieldClientErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClientErrors(); ok {
+		_spec.AddField(apikeyusage.FieldClientErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ServerErrors(); ok {
+		_spec.SetField(apikeyusage.FieldServerErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedServerErrors(); ok {
+		_spec.AddField(apikeyusage.FieldServerErrors, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeyusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// APIKeyUsageUpdateOne is the builder for updating a single APIKeyUsage entity.
+type APIKeyUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeyUsageMutation
+}
+
+// SetRequests sets the "requests" field.
+func (_u *APIKeyUsageUpdateOne) SetRequests(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableRequests(v *int64) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *APIKeyUsageUpdateOne) AddRequests(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetRateLimited sets the "rate_limited" field.
+func (_u *APIKeyUsageUpdateOne) SetRateLimited(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetRateLimited()
+	_u.mutation.SetRateLimited(v)
+	return _u
+}
+
+// SetNillableRateLimited sets the "rate_limited" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableRateLimited(v *int64) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetRateLimited(*v)
+	}
+	return _u
+}
+
+// AddRateLimited adds value to the "rate_limited" field.
+func (_u *APIKeyUsageUpdateOne) AddRateLimited(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.AddRateLimited(v)
+	return _u
+}
+
+// SetClientErrors sets the "client_errors" field.
+func (_u *APIKeyUsageUpdateOne) SetClientErrors(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetClientErrors()
+	_u.mutation.SetClientErrors(v)
+	return _u
+}
+
+// SetNillableClientErrors sets the "client_errors" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableClientErrors(v *int64) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetClientErrors(*v)
+	}
+	return _u
+}
+
+// AddClientErrors adds value to the "client_errors" field.
+func (_u *APIKeyUsageUpdateOne) AddClientErrors(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.AddClientErrors(v)
+	return _u
+}
+
+// SetServerErrors sets the "server_errors" field.
+func (_u *APIKeyUsageUpdateOne) SetServerErrors(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.ResetServerErrors()
+	_u.mutation.SetServerErrors(v)
+	return _u
+}
+
+// SetNillableServerErrors sets the "server_errors" field if the given value is not nil.
+func (_u *APIKeyUsageUpdateOne) SetNillableServerErrors(v *int64) *APIKeyUsageUpdateOne {
+	if v != nil {
+		_u.SetServerErrors(*v)
+	}
+	return _u
+}
+
+// AddServerErrors adds value to the "server_errors" field.
+func (_u *APIKeyUsageUpdateOne) AddServerErrors(v int64) *APIKeyUsageUpdateOne {
+	_u.mutation.AddServerErrors(v)
+	return _u
+}
+
+// Mutation returns the APIKeyUsageMutation object of the builder.
+func (_u *APIKeyUsageUpdateOne) Mutation() *APIKeyUsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the APIKeyUsageUpdate builder.
+func (_u *APIKeyUsageUpdateOne) Where(ps ...predicate.APIKeyUsage) *APIKeyUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *APIKeyUsageUpdateOne) Select(field string, fields ...string) *APIKeyUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated APIKeyUsage entity.
+func (_u *APIKeyUsageUpdateOne) Save(ctx context.Context) (*APIKeyUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *APIKeyUsageUpdateOne) SaveX(ctx context.Context) *APIKeyUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *APIKeyUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *APIKeyUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *APIKeyUsageUpdateOne) check() error {
+	if v, ok := _u.mutation.Requests(); ok {
+		if err := apikeyusage.RequestsValidator(v); err != nil {
+			return &ValidationError{Name: "requests", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.requests": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RateLimited(); ok {
+		if err := apikeyusage.RateLimitedValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limited", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.rate_limited": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientErrors(); ok {
+		if err := apikeyusage.ClientErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "client_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.client_errors": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ServerErrors(); ok {
+		if err := apikeyusage.ServerErrorsValidator(v); err != nil {
+			return &ValidationError{Name: "server_errors", err: fmt.Errorf(`ent: validator failed for field "APIKeyUsage.server_errors": %w`, err)}
+		}
+	}
+	if _u.mutation.KeyCleared() && len(_u.mutation.KeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "APIKeyUsage.key"`)
+	}
+	return nil
+}
+
+func (_u *APIKeyUsageUpdateOne) sqlSave(ctx context.Context) (_node *APIKeyUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikeyusage.Table, apikeyusage.Columns, sqlgraph.NewFieldSpec(apikeyusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKeyUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikeyusage.FieldID)
+		for _, f := range fields {
+			if !apikeyusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikeyusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(apikeyusage.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(apikeyusage.FieldRequests, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RateLimited(); ok {
+		_spec.SetField(apikeyusage.FieldRateLimited, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimited(); ok {
+		_spec.AddField(apikeyusage.FieldRateLimited, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClientErrors(); ok {
+		_spec.SetField(apikeyusage.FieldClientErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClientErrors(); ok {
+		_spec.AddField(apikeyusage.FieldClientErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ServerErrors(); ok {
+		_spec.SetField(apikeyusage.FieldServerErrors, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedServerErrors(); ok {
+		_spec.AddField(apikeyusage.FieldServerErrors, field.TypeInt64, value)
+	}
+	_node = &APIKeyUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeyusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"streamify/ent/artist"
 	"strings"
@@ -18,12 +19,16 @@ type Artist struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Name holds the value of the "name" field.
+	// Name the artist performs under
 	Name string `json:"name,omitempty"`
-	// ImageURL holds the value of the "image_url" field.
+	// Artist photo
 	ImageURL string `json:"image_url,omitempty"`
+	// Dominant colors of the artwork as #rrggbb, most dominant first, for clients to tint the UI with
+	Palette []string `json:"palette,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArtistQuery when eager-loading is set.
 	Edges        ArtistEdges `json:"edges"`
@@ -53,9 +58,11 @@ func (*Artist) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case artist.FieldPalette:
+			values[i] = new([]byte)
 		case artist.FieldName, artist.FieldImageURL:
 			values[i] = new(sql.NullString)
-		case artist.FieldCreatedAt:
+		case artist.FieldCreatedAt, artist.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case artist.FieldID:
 			values[i] = new(uuid.UUID)
@@ -92,12 +99,27 @@ func (_m *Artist) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ImageURL = value.String
 			}
+		case artist.FieldPalette:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field palette", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Palette); err != nil {
+					return fmt.Errorf("unmarshal field palette: %w", err)
+				}
+			}
 		case artist.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case artist.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -145,8 +167,16 @@ func (_m *Artist) String() string {
 	builder.WriteString("image_url=")
 	builder.WriteString(_m.ImageURL)
 	builder.WriteString(", ")
+	builder.WriteString("palette=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Palette))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -71,6 +71,11 @@ func CreatedAt(v time.Time) predicate.Artist {
 	return predicate.Artist(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Artist {
 	return predicate.Artist(sql.FieldEQ(FieldName, v))
@@ -211,6 +216,16 @@ func ImageURLContainsFold(v string) predicate.Artist {
 	return predicate.Artist(sql.FieldContainsFold(FieldImageURL, v))
 }
 
+// PaletteIsNil applies the IsNil predicate on the "palette" field.
+func PaletteIsNil() predicate.Artist {
+	return predicate.Artist(sql.FieldIsNull(FieldPalette))
+}
+
+// PaletteNotNil applies the NotNil predicate on the "palette" field.
+func PaletteNotNil() predicate.Artist {
+	return predicate.Artist(sql.FieldNotNull(FieldPalette))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Artist {
 	return predicate.Artist(sql.FieldEQ(FieldCreatedAt, v))
@@ -251,6 +266,56 @@ func CreatedAtLTE(v time.Time) predicate.Artist {
 	return predicate.Artist(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Artist {
+	return predicate.Artist(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Artist {
+	return predicate.Artist(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Artist {
+	return predicate.Artist(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasAlbums applies the HasEdge predicate on the "albums" edge.
 func HasAlbums() predicate.Artist {
 	return predicate.Artist(func(s *sql.Selector) {
@@ -42,6 +42,12 @@ func (_c *ArtistCreate) SetNillableImageURL(v *string) *ArtistCreate {
 	return _c
 }
 
+// SetPalette sets the "palette" field.
+func (_c *ArtistCreate) SetPalette(v []string) *ArtistCreate {
+	_c.mutation.SetPalette(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ArtistCreate) SetCreatedAt(v time.Time) *ArtistCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -56,6 +62,20 @@ func (_c *ArtistCreate) SetNillableCreatedAt(v *time.Time) *ArtistCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ArtistCreate) SetDeletedAt(v time.Time) *ArtistCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ArtistCreate) SetNillableDeletedAt(v *time.Time) *ArtistCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ArtistCreate) SetID(v uuid.UUID) *ArtistCreate {
 	_c.mutation.SetID(v)
@@ -92,7 +112,9 @@ func (_c *ArtistCreate) Mutation() *ArtistMutation {
 
 // Save creates the Artist in the database.
 func (_c *ArtistCreate) Save(ctx context.Context) (*Artist, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -119,15 +141,22 @@ func (_c *ArtistCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ArtistCreate) defaults() {
+func (_c *ArtistCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if artist.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized artist.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := artist.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if artist.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized artist.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := artist.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -186,10 +215,18 @@ func (_c *ArtistCreate) createSpec() (*Artist, *sqlgraph.CreateSpec) {
 		_spec.SetField(artist.FieldImageURL, field.TypeString, value)
 		_node.ImageURL = value
 	}
+	if value, ok := _c.mutation.Palette(); ok {
+		_spec.SetField(artist.FieldPalette, field.TypeJSON, value)
+		_node.Palette = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(artist.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(artist.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.AlbumsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
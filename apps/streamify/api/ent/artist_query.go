@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 	"streamify/ent/album"
@@ -365,6 +366,12 @@ func (_q *ArtistQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if artist.Policy == nil {
+		return errors.New("ent: uninitialized artist.Policy (forgotten import ent/runtime?)")
+	}
+	if err := artist.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
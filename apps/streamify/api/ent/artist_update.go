@@ -13,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -64,6 +65,24 @@ func (_u *ArtistUpdate) ClearImageURL() *ArtistUpdate {
 	return _u
 }
 
+// SetPalette sets the "palette" field.
+func (_u *ArtistUpdate) SetPalette(v []string) *ArtistUpdate {
+	_u.mutation.SetPalette(v)
+	return _u
+}
+
+// AppendPalette appends value to the "palette" field.
+func (_u *ArtistUpdate) AppendPalette(v []string) *ArtistUpdate {
+	_u.mutation.AppendPalette(v)
+	return _u
+}
+
+// ClearPalette clears the value of the "palette" field.
+func (_u *ArtistUpdate) ClearPalette() *ArtistUpdate {
+	_u.mutation.ClearPalette()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ArtistUpdate) SetCreatedAt(v time.Time) *ArtistUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -78,6 +97,26 @@ func (_u *ArtistUpdate) SetNillableCreatedAt(v *time.Time) *ArtistUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ArtistUpdate) SetDeletedAt(v time.Time) *ArtistUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ArtistUpdate) SetNillableDeletedAt(v *time.Time) *ArtistUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ArtistUpdate) ClearDeletedAt() *ArtistUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
 func (_u *ArtistUpdate) AddAlbumIDs(ids ...uuid.UUID) *ArtistUpdate {
 	_u.mutation.AddAlbumIDs(ids...)
@@ -177,9 +216,26 @@ func (_u *ArtistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(artist.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Palette(); ok {
+		_spec.SetField(artist.FieldPalette, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPalette(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, artist.FieldPalette, value)
+		})
+	}
+	if _u.mutation.PaletteCleared() {
+		_spec.ClearField(artist.FieldPalette, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(artist.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(artist.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(artist.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.AlbumsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -279,6 +335,24 @@ func (_u *ArtistUpdateOne) ClearImageURL() *ArtistUpdateOne {
 	return _u
 }
 
+// SetPalette sets the "palette" field.
+func (_u *ArtistUpdateOne) SetPalette(v []string) *ArtistUpdateOne {
+	_u.mutation.SetPalette(v)
+	return _u
+}
+
+// AppendPalette appends value to the "palette" field.
+func (_u *ArtistUpdateOne) AppendPalette(v []string) *ArtistUpdateOne {
+	_u.mutation.AppendPalette(v)
+	return _u
+}
+
+// ClearPalette clears the value of the "palette" field.
+func (_u *ArtistUpdateOne) ClearPalette() *ArtistUpdateOne {
+	_u.mutation.ClearPalette()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *ArtistUpdateOne) SetCreatedAt(v time.Time) *ArtistUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -293,6 +367,26 @@ func (_u *ArtistUpdateOne) SetNillableCreatedAt(v *time.Time) *ArtistUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ArtistUpdateOne) SetDeletedAt(v time.Time) *ArtistUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ArtistUpdateOne) SetNillableDeletedAt(v *time.Time) *ArtistUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ArtistUpdateOne) ClearDeletedAt() *ArtistUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // AddAlbumIDs adds the "albums" edge to the Album entity by IDs.
 func (_u *ArtistUpdateOne) AddAlbumIDs(ids ...uuid.UUID) *ArtistUpdateOne {
 	_u.mutation.AddAlbumIDs(ids...)
@@ -422,9 +516,26 @@ func (_u *ArtistUpdateOne) sqlSave(ctx context.Context) (_node *Artist, err erro
 	if _u.mutation.ImageURLCleared() {
 		_spec.ClearField(artist.FieldImageURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Palette(); ok {
+		_spec.SetField(artist.FieldPalette, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPalette(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, artist.FieldPalette, value)
+		})
+	}
+	if _u.mutation.PaletteCleared() {
+		_spec.ClearField(artist.FieldPalette, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(artist.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(artist.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(artist.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.AlbumsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
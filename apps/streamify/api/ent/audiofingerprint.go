@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/track"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AudioFingerprint is the model entity for the AudioFingerprint schema.
+type AudioFingerprint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The fingerprinted track
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// SHA-256 of the uploaded file, hex encoded
+	Sha256 string `json:"sha256,omitempty"`
+	// Duration in seconds; 0 when the audio couldn't be fingerprinted
+	Duration int `json:"duration,omitempty"`
+	// Raw chromaprint fingerprint, little-endian uint32s; empty when unavailable
+	Fingerprint []byte `json:"fingerprint,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AudioFingerprintQuery when eager-loading is set.
+	Edges        AudioFingerprintEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AudioFingerprintEdges holds the relations/edges for other nodes in the graph.
+type AudioFingerprintEdges struct {
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AudioFingerprintEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AudioFingerprint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audiofingerprint.FieldFingerprint:
+			values[i] = new([]byte)
+		case audiofingerprint.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case audiofingerprint.FieldSha256:
+			values[i] = new(sql.NullString)
+		case audiofingerprint.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case audiofingerprint.FieldID, audiofingerprint.FieldTrackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AudioFingerprint fields.
+func (_m *AudioFingerprint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audiofingerprint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case audiofingerprint.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case audiofingerprint.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case audiofingerprint.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = int(value.Int64)
+			}
+		case audiofingerprint.FieldFingerprint:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value != nil {
+				_m.Fingerprint = *value
+			}
+		case audiofingerprint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AudioFingerprint.
+// This includes values selected through modifiers, order, etc.
+func (_m *AudioFingerprint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTrack queries the "track" edge of the AudioFingerprint entity.
+func (_m *AudioFingerprint) QueryTrack() *TrackQuery {
+	return NewAudioFingerprintClient(_m.config).QueryTrack(_m)
+}
+
+// Update returns a builder for updating this AudioFingerprint.
+// Note that you need to call AudioFingerprint.Unwrap() before calling this method if this AudioFingerprint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AudioFingerprint) Update() *AudioFingerprintUpdateOne {
+	return NewAudioFingerprintClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AudioFingerprint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AudioFingerprint) Unwrap() *AudioFingerprint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AudioFingerprint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AudioFingerprint) String() string {
+	var builder strings.Builder
+	builder.WriteString("AudioFingerprint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fingerprint))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AudioFingerprints is a parsable slice of AudioFingerprint.
+type AudioFingerprints []*AudioFingerprint
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audiofingerprint
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLTE(FieldID, id))
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldTrackID, v))
+}
+
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldSha256, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldDuration, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldTrackID, v))
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldTrackID, v))
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldTrackID, vs...))
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...uuid.UUID) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldTrackID, vs...))
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldSha256, v))
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldSha256, v))
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldSha256, vs...))
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldSha256, vs...))
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGT(FieldSha256, v))
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGTE(FieldSha256, v))
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLT(FieldSha256, v))
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLTE(FieldSha256, v))
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldContains(FieldSha256, v))
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldHasPrefix(FieldSha256, v))
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldHasSuffix(FieldSha256, v))
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEqualFold(FieldSha256, v))
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldContainsFold(FieldSha256, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLTE(FieldDuration, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...[]byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...[]byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v []byte) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotNull(FieldFingerprint))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AudioFingerprint) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AudioFingerprint) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AudioFingerprint) predicate.AudioFingerprint {
+	return predicate.AudioFingerprint(sql.NotPredicates(p))
+}
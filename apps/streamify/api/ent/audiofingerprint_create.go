@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/track"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AudioFingerprintCreate is the builder for creating a AudioFingerprint entity.
+type AudioFingerprintCreate struct {
+	config
+	mutation *AudioFingerprintMutation
+	hooks    []Hook
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *AudioFingerprintCreate) SetTrackID(v uuid.UUID) *AudioFingerprintCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *AudioFingerprintCreate) SetSha256(v string) *AudioFingerprintCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetDuration sets the "duration" field.
+func (_c *AudioFingerprintCreate) SetDuration(v int) *AudioFingerprintCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_c *AudioFingerprintCreate) SetNillableDuration(v *int) *AudioFingerprintCreate {
+	if v != nil {
+		_c.SetDuration(*v)
+	}
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *AudioFingerprintCreate) SetFingerprint(v []byte) *AudioFingerprintCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AudioFingerprintCreate) SetCreatedAt(v time.Time) *AudioFingerprintCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AudioFingerprintCreate) SetNillableCreatedAt(v *time.Time) *AudioFingerprintCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AudioFingerprintCreate) SetID(v uuid.UUID) *AudioFingerprintCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AudioFingerprintCreate) SetNillableID(v *uuid.UUID) *AudioFingerprintCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *AudioFingerprintCreate) SetTrack(v *Track) *AudioFingerprintCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// Mutation returns the AudioFingerprintMutation object of the builder.
+func (_c *AudioFingerprintCreate) Mutation() *AudioFingerprintMutation {
+	return _c.mutation
+}
+
+// Save creates the AudioFingerprint in the database.
+func (_c *AudioFingerprintCreate) Save(ctx context.Context) (*AudioFingerprint, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AudioFingerprintCreate) SaveX(ctx context.Context) *AudioFingerprint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioFingerprintCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioFingerprintCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AudioFingerprintCreate) defaults() error {
+	if _, ok := _c.mutation.Duration(); !ok {
+		v := audiofingerprint.DefaultDuration
+		_c.mutation.SetDuration(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if audiofingerprint.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized audiofingerprint.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := audiofingerprint.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if audiofingerprint.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized audiofingerprint.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := audiofingerprint.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AudioFingerprintCreate) check() error {
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "AudioFingerprint.track_id"`)}
+	}
+	if _, ok := _c.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "AudioFingerprint.sha256"`)}
+	}
+	if _, ok := _c.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "AudioFingerprint.duration"`)}
+	}
+	if v, ok := _c.mutation.Duration(); ok {
+		if err := audiofingerprint.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "AudioFingerprint.duration": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AudioFingerprint.created_at"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "AudioFingerprint.track"`)}
+	}
+	return nil
+}
+
+func (_c *AudioFingerprintCreate) sqlSave(ctx context.Context) (*AudioFingerprint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AudioFingerprintCreate) createSpec() (*AudioFingerprint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AudioFingerprint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(audiofingerprint.Table, sqlgraph.NewFieldSpec(audiofingerprint.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(audiofingerprint.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(audiofingerprint.FieldDuration, field.TypeInt, value)
+		_node.Duration = value
+	}
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(audiofingerprint.FieldFingerprint, field.TypeBytes, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(audiofingerprint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   audiofingerprint.TrackTable,
+			Columns: []string{audiofingerprint.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AudioFingerprintCreateBulk is the builder for creating many AudioFingerprint entities in bulk.
+type AudioFingerprintCreateBulk struct {
+	config
+	err      error
+	builders []*AudioFingerprintCreate
+}
+
+// Save creates the AudioFingerprint entities in the database.
+func (_c *AudioFingerprintCreateBulk) Save(ctx context.Context) ([]*AudioFingerprint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AudioFingerprint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudioFingerprintMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AudioFingerprintCreateBulk) SaveX(ctx context.Context) []*AudioFingerprint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AudioFingerprintCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AudioFingerprintCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
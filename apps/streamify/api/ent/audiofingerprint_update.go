@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AudioFingerprintUpdate is the builder for updating AudioFingerprint entities.
+type AudioFingerprintUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudioFingerprintMutation
+}
+
+// Where appends a list predicates to the AudioFingerprintUpdate builder.
+func (_u *AudioFingerprintUpdate) Where(ps ...predicate.AudioFingerprint) *AudioFingerprintUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *AudioFingerprintUpdate) SetTrackID(v uuid.UUID) *AudioFingerprintUpdate {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *AudioFingerprintUpdate) SetNillableTrackID(v *uuid.UUID) *AudioFingerprintUpdate {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *AudioFingerprintUpdate) SetSha256(v string) *AudioFingerprintUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *AudioFingerprintUpdate) SetNillableSha256(v *string) *AudioFingerprintUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *AudioFingerprintUpdate) SetDuration(v int) *AudioFingerprintUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *AudioFingerprintUpdate) SetNillableDuration(v *int) *AudioFingerprintUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *AudioFingerprintUpdate) AddDuration(v int) *AudioFingerprintUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *AudioFingerprintUpdate) SetFingerprint(v []byte) *AudioFingerprintUpdate {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (_u *AudioFingerprintUpdate) ClearFingerprint() *AudioFingerprintUpdate {
+	_u.mutation.ClearFingerprint()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AudioFingerprintUpdate) SetCreatedAt(v time.Time) *AudioFingerprintUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AudioFingerprintUpdate) SetNillableCreatedAt(v *time.Time) *AudioFingerprintUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *AudioFingerprintUpdate) SetTrack(v *Track) *AudioFingerprintUpdate {
+	return _u.SetTrackID(v.ID)
+}
+
+// Mutation returns the AudioFingerprintMutation object of the builder.
+func (_u *AudioFingerprintUpdate) Mutation() *AudioFingerprintMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *AudioFingerprintUpdate) ClearTrack() *AudioFingerprintUpdate {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AudioFingerprintUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioFingerprintUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AudioFingerprintUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioFingerprintUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AudioFingerprintUpdate) check() error {
+	if v, ok := _u.mutation.Duration(); ok {
+		if err := audiofingerprint.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "AudioFingerprint.duration": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AudioFingerprint.track"`)
+	}
+	return nil
+}
+
+func (_u *AudioFingerprintUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiofingerprint.Table, audiofingerprint.Columns, sqlgraph.NewFieldSpec(audiofingerprint.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(audiofingerprint.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(audiofingerprint.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(audiofingerprint.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(audiofingerprint.FieldFingerprint, field.TypeBytes, value)
+	}
+	if _u.mutation.FingerprintCleared() {
+		_spec.ClearField(audiofingerprint.FieldFingerprint, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(audiofingerprint.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   audiofingerprint.TrackTable,
+			Columns: []string{audiofingerprint.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   audiofingerprint.TrackTable,
+			Columns: []string{audiofingerprint.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiofingerprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AudioFingerprintUpdateOne is the builder for updating a single AudioFingerprint entity.
+type AudioFingerprintUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudioFingerprintMutation
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *AudioFingerprintUpdateOne) SetTrackID(v uuid.UUID) *AudioFingerprintUpdateOne {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *AudioFingerprintUpdateOne) SetNillableTrackID(v *uuid.UUID) *AudioFingerprintUpdateOne {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *AudioFingerprintUpdateOne) SetSha256(v string) *AudioFingerprintUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *AudioFingerprintUpdateOne) SetNillableSha256(v *string) *AudioFingerprintUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *AudioFingerprintUpdateOne) SetDuration(v int) *AudioFingerprintUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *AudioFingerprintUpdateOne) SetNillableDuration(v *int) *AudioFingerprintUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *AudioFingerprintUpdateOne) AddDuration(v int) *AudioFingerprintUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *AudioFingerprintUpdateOne) SetFingerprint(v []byte) *AudioFingerprintUpdateOne {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (_u *AudioFingerprintUpdateOne) ClearFingerprint() *AudioFingerprintUpdateOne {
+	_u.mutation.ClearFingerprint()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AudioFingerprintUpdateOne) SetCreatedAt(v time.Time) *AudioFingerprintUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AudioFingerprintUpdateOne) SetNillableCreatedAt(v *time.Time) *AudioFingerprintUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *AudioFingerprintUpdateOne) SetTrack(v *Track) *AudioFingerprintUpdateOne {
+	return _u.SetTrackID(v.ID)
+}
+
+// Mutation returns the AudioFingerprintMutation object of the builder.
+func (_u *AudioFingerprintUpdateOne) Mutation() *AudioFingerprintMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *AudioFingerprintUpdateOne) ClearTrack() *AudioFingerprintUpdateOne {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// Where appends a list predicates to the AudioFingerprintUpdate builder.
+func (_u *AudioFingerprintUpdateOne) Where(ps ...predicate.AudioFingerprint) *AudioFingerprintUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AudioFingerprintUpdateOne) Select(field string, fields ...string) *AudioFingerprintUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AudioFingerprint entity.
+func (_u *AudioFingerprintUpdateOne) Save(ctx context.Context) (*AudioFingerprint, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AudioFingerprintUpdateOne) SaveX(ctx context.Context) *AudioFingerprint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AudioFingerprintUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AudioFingerprintUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AudioFingerprintUpdateOne) check() error {
+	if v, ok := _u.mutation.Duration(); ok {
+		if err := audiofingerprint.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf(`ent: validator failed for field "AudioFingerprint.duration": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AudioFingerprint.track"`)
+	}
+	return nil
+}
+
+func (_u *AudioFingerprintUpdateOne) sqlSave(ctx context.Context) (_node *AudioFingerprint, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(audiofingerprint.Table, audiofingerprint.Columns, sqlgraph.NewFieldSpec(audiofingerprint.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AudioFingerprint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audiofingerprint.FieldID)
+		for _, f := range fields {
+			if !audiofingerprint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != audiofingerprint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(audiofingerprint.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(audiofingerprint.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(audiofingerprint.FieldDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(audiofingerprint.FieldFingerprint, field.TypeBytes, value)
+	}
+	if _u.mutation.FingerprintCleared() {
+		_spec.ClearField(audiofingerprint.FieldFingerprint, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(audiofingerprint.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   audiofingerprint.TrackTable,
+			Columns: []string{audiofingerprint.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   audiofingerprint.TrackTable,
+			Columns: []string{audiofingerprint.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AudioFingerprint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiofingerprint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/backup"
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BackupUpdate is the builder for updating Backup entities.
+type BackupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BackupMutation
+}
+
+// Where appends a list predicates to the BackupUpdate builder.
+func (_u *BackupUpdate) Where(ps ...predicate.Backup) *BackupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *BackupUpdate) SetKey(v string) *BackupUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableKey(v *string) *BackupUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupUpdate) SetStatus(v backup.Status) *BackupUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableStatus(v *backup.Status) *BackupUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupUpdate) SetTrigger(v backup.Trigger) *BackupUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableTrigger(v *backup.Trigger) *BackupUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *BackupUpdate) SetSize(v int64) *BackupUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableSize(v *int64) *BackupUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *BackupUpdate) AddSize(v int64) *BackupUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *BackupUpdate) SetChecksum(v string) *BackupUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableChecksum(v *string) *BackupUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (_u *BackupUpdate) ClearChecksum() *BackupUpdate {
+	_u.mutation.ClearChecksum()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupUpdate) SetError(v string) *BackupUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableError(v *string) *BackupUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupUpdate) ClearError() *BackupUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BackupUpdate) SetCreatedAt(v time.Time) *BackupUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableCreatedAt(v *time.Time) *BackupUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *BackupUpdate) SetCompletedAt(v time.Time) *BackupUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableCompletedAt(v *time.Time) *BackupUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *BackupUpdate) ClearCompletedAt() *BackupUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *BackupUpdate) SetVerifiedAt(v time.Time) *BackupUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *BackupUpdate) SetNillableVerifiedAt(v *time.Time) *BackupUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *BackupUpdate) ClearVerifiedAt() *BackupUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// Mutation returns the BackupMutation object of the builder.
+func (_u *BackupUpdate) Mutation() *BackupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupUpdate) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := backup.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Backup.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := backup.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Backup.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := backup.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Backup.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BackupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backup.Table, backup.Columns, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(backup.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backup.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backup.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(backup.FieldChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumCleared() {
+		_spec.ClearField(backup.FieldChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backup.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backup.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(backup.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(backup.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(backup.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(backup.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(backup.FieldVerifiedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackupUpdateOne is the builder for updating a single Backup entity.
+type BackupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BackupMutation
+}
+
+// SetKey sets the "key" field.
+func (_u *BackupUpdateOne) SetKey(v string) *BackupUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableKey(v *string) *BackupUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BackupUpdateOne) SetStatus(v backup.Status) *BackupUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableStatus(v *backup.Status) *BackupUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *BackupUpdateOne) SetTrigger(v backup.Trigger) *BackupUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableTrigger(v *backup.Trigger) *BackupUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *BackupUpdateOne) SetSize(v int64) *BackupUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableSize(v *int64) *BackupUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *BackupUpdateOne) AddSize(v int64) *BackupUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *BackupUpdateOne) SetChecksum(v string) *BackupUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableChecksum(v *string) *BackupUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (_u *BackupUpdateOne) ClearChecksum() *BackupUpdateOne {
+	_u.mutation.ClearChecksum()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *BackupUpdateOne) SetError(v string) *BackupUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableError(v *string) *BackupUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *BackupUpdateOne) ClearError() *BackupUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BackupUpdateOne) SetCreatedAt(v time.Time) *BackupUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableCreatedAt(v *time.Time) *BackupUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *BackupUpdateOne) SetCompletedAt(v time.Time) *BackupUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableCompletedAt(v *time.Time) *BackupUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *BackupUpdateOne) ClearCompletedAt() *BackupUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *BackupUpdateOne) SetVerifiedAt(v time.Time) *BackupUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *BackupUpdateOne) SetNillableVerifiedAt(v *time.Time) *BackupUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *BackupUpdateOne) ClearVerifiedAt() *BackupUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// Mutation returns the BackupMutation object of the builder.
+func (_u *BackupUpdateOne) Mutation() *BackupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BackupUpdate builder.
+func (_u *BackupUpdateOne) Where(ps ...predicate.Backup) *BackupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackupUpdateOne) Select(field string, fields ...string) *BackupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Backup entity.
+func (_u *BackupUpdateOne) Save(ctx context.Context) (*Backup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupUpdateOne) SaveX(ctx context.Context) *Backup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupUpdateOne) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := backup.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Backup.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := backup.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Backup.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := backup.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "Backup.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BackupUpdateOne) sqlSave(ctx context.Context) (_node *Backup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backup.Table, backup.Columns, sqlgraph.NewFieldSpec(backup.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Backup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backup.FieldID)
+		for _, f := range fields {
+			if !backup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(backup.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(backup.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(backup.FieldTrigger, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(backup.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(backup.FieldChecksum, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumCleared() {
+		_spec.ClearField(backup.FieldChecksum, field.TypeString)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(backup.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(backup.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(backup.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(backup.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(backup.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(backup.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(backup.FieldVerifiedAt, field.TypeTime)
+	}
+	_node = &Backup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
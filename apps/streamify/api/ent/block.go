@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/block"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Block is the model entity for the Block schema.
+type Block struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user blocking
+	BlockerID uuid.UUID `json:"blocker_id,omitempty"`
+	// The user blocked
+	BlockedID uuid.UUID `json:"blocked_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockQuery when eager-loading is set.
+	Edges        BlockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlockEdges holds the relations/edges for other nodes in the graph.
+type BlockEdges struct {
+	// Blocker holds the value of the blocker edge.
+	Blocker *User `json:"blocker,omitempty"`
+	// Blocked holds the value of the blocked edge.
+	Blocked *User `json:"blocked,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BlockerOrErr returns the Blocker value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) BlockerOrErr() (*User, error) {
+	if e.Blocker != nil {
+		return e.Blocker, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "blocker"}
+}
+
+// BlockedOrErr returns the Blocked value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) BlockedOrErr() (*User, error) {
+	if e.Blocked != nil {
+		return e.Blocked, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "blocked"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Block) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case block.FieldID, block.FieldBlockerID, block.FieldBlockedID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Block fields.
+func (_m *Block) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case block.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case block.FieldBlockerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field blocker_id", values[i])
+			} else if value != nil {
+				_m.BlockerID = *value
+			}
+		case block.FieldBlockedID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field blocked_id", values[i])
+			} else if value != nil {
+				_m.BlockedID = *value
+			}
+		case block.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Block.
+// This includes values selected through modifiers, order, etc.
+func (_m *Block) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBlocker queries the "blocker" edge of the Block entity.
+func (_m *Block) QueryBlocker() *UserQuery {
+	return NewBlockClient(_m.config).QueryBlocker(_m)
+}
+
+// QueryBlocked queries the "blocked" edge of the Block entity.
+func (_m *Block) QueryBlocked() *UserQuery {
+	return NewBlockClient(_m.config).QueryBlocked(_m)
+}
+
+// Update returns a builder for updating this Block.
+// Note that you need to call Block.Unwrap() before calling this method if this Block
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Block) Update() *BlockUpdateOne {
+	return NewBlockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Block entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Block) Unwrap() *Block {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Block is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Block) String() string {
+	var builder strings.Builder
+	builder.WriteString("Block(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("blocker_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockerID))
+	builder.WriteString(", ")
+	builder.WriteString("blocked_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockedID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Blocks is a parsable slice of Block.
+type Blocks []*Block
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package block
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldID, id))
+}
+
+// BlockerID applies equality check predicate on the "blocker_id" field. It's identical to BlockerIDEQ.
+func BlockerID(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockedID applies equality check predicate on the "blocked_id" field. It's identical to BlockedIDEQ.
+func BlockedID(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BlockerIDEQ applies the EQ predicate on the "blocker_id" field.
+func BlockerIDEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockerID, v))
+}
+
+// BlockerIDNEQ applies the NEQ predicate on the "blocker_id" field.
+func BlockerIDNEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldBlockerID, v))
+}
+
+// BlockerIDIn applies the In predicate on the "blocker_id" field.
+func BlockerIDIn(vs ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldBlockerID, vs...))
+}
+
+// BlockerIDNotIn applies the NotIn predicate on the "blocker_id" field.
+func BlockerIDNotIn(vs ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldBlockerID, vs...))
+}
+
+// BlockedIDEQ applies the EQ predicate on the "blocked_id" field.
+func BlockedIDEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldBlockedID, v))
+}
+
+// BlockedIDNEQ applies the NEQ predicate on the "blocked_id" field.
+func BlockedIDNEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldBlockedID, v))
+}
+
+// BlockedIDIn applies the In predicate on the "blocked_id" field.
+func BlockedIDIn(vs ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldBlockedID, vs...))
+}
+
+// BlockedIDNotIn applies the NotIn predicate on the "blocked_id" field.
+func BlockedIDNotIn(vs ...uuid.UUID) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldBlockedID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Block {
+	return predicate.Block(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Block {
+	return predicate.Block(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasBlocker applies the HasEdge predicate on the "blocker" edge.
+func HasBlocker() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BlockerTable, BlockerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockerWith applies the HasEdge predicate on the "blocker" edge with a given conditions (other predicates).
+func HasBlockerWith(preds ...predicate.User) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := newBlockerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocked applies the HasEdge predicate on the "blocked" edge.
+func HasBlocked() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BlockedTable, BlockedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlockedWith applies the HasEdge predicate on the "blocked" edge with a given conditions (other predicates).
+func HasBlockedWith(preds ...predicate.User) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := newBlockedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Block) predicate.Block {
+	return predicate.Block(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Block) predicate.Block {
+	return predicate.Block(sql.NotPredicates(p))
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/block"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BlockCreate is the builder for creating a Block entity.
+type BlockCreate struct {
+	config
+	mutation *BlockMutation
+	hooks    []Hook
+}
+
+// SetBlockerID sets the "blocker_id" field.
+func (_c *BlockCreate) SetBlockerID(v uuid.UUID) *BlockCreate {
+	_c.mutation.SetBlockerID(v)
+	return _c
+}
+
+// SetBlockedID sets the "blocked_id" field.
+func (_c *BlockCreate) SetBlockedID(v uuid.UUID) *BlockCreate {
+	_c.mutation.SetBlockedID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BlockCreate) SetCreatedAt(v time.Time) *BlockCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BlockCreate) SetNillableCreatedAt(v *time.Time) *BlockCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BlockCreate) SetID(v uuid.UUID) *BlockCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BlockCreate) SetNillableID(v *uuid.UUID) *BlockCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBlocker sets the "blocker" edge to the User entity.
+func (_c *BlockCreate) SetBlocker(v *User) *BlockCreate {
+	return _c.SetBlockerID(v.ID)
+}
+
+// SetBlocked sets the "blocked" edge to the User entity.
+func (_c *BlockCreate) SetBlocked(v *User) *BlockCreate {
+	return _c.SetBlockedID(v.ID)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (_c *BlockCreate) Mutation() *BlockMutation {
+	return _c.mutation
+}
+
+// Save creates the Block in the database.
+func (_c *BlockCreate) Save(ctx context.Context) (*Block, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlockCreate) SaveX(ctx context.Context) *Block {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BlockCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := block.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := block.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlockCreate) check() error {
+	if _, ok := _c.mutation.BlockerID(); !ok {
+		return &ValidationError{Name: "blocker_id", err: errors.New(`ent: missing required field "Block.blocker_id"`)}
+	}
+	if _, ok := _c.mutation.BlockedID(); !ok {
+		return &ValidationError{Name: "blocked_id", err: errors.New(`ent: missing required field "Block.blocked_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Block.created_at"`)}
+	}
+	if len(_c.mutation.BlockerIDs()) == 0 {
+		return &ValidationError{Name: "blocker", err: errors.New(`ent: missing required edge "Block.blocker"`)}
+	}
+	if len(_c.mutation.BlockedIDs()) == 0 {
+		return &ValidationError{Name: "blocked", err: errors.New(`ent: missing required edge "Block.blocked"`)}
+	}
+	return nil
+}
+
+func (_c *BlockCreate) sqlSave(ctx context.Context) (*Block, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlockCreate) createSpec() (*Block, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Block{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(block.Table, sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(block.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.BlockerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockerTable,
+			Columns: []string{block.BlockerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlockerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BlockedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockedTable,
+			Columns: []string{block.BlockedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlockedID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlockCreateBulk is the builder for creating many Block entities in bulk.
+type BlockCreateBulk struct {
+	config
+	err      error
+	builders []*BlockCreate
+}
+
+// Save creates the Block entities in the database.
+func (_c *BlockCreateBulk) Save(ctx context.Context) ([]*Block, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Block, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlockCreateBulk) SaveX(ctx context.Context) []*Block {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
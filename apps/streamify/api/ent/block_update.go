@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/block"
+	"streamify/ent/predicate"
+	"streamify/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BlockUpdate is the builder for updating Block entities.
+type BlockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlockMutation
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (_u *BlockUpdate) Where(ps ...predicate.Block) *BlockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlockerID sets the "blocker_id" field.
+func (_u *BlockUpdate) SetBlockerID(v uuid.UUID) *BlockUpdate {
+	_u.mutation.SetBlockerID(v)
+	return _u
+}
+
+// SetNillableBlockerID sets the "blocker_id" field if the given value is not nil.
+func (_u *BlockUpdate) SetNillableBlockerID(v *uuid.UUID) *BlockUpdate {
+	if v != nil {
+		_u.SetBlockerID(*v)
+	}
+	return _u
+}
+
+// SetBlockedID sets the "blocked_id" field.
+func (_u *BlockUpdate) SetBlockedID(v uuid.UUID) *BlockUpdate {
+	_u.mutation.SetBlockedID(v)
+	return _u
+}
+
+// SetNillableBlockedID sets the "blocked_id" field if the given value is not nil.
+func (_u *BlockUpdate) SetNillableBlockedID(v *uuid.UUID) *BlockUpdate {
+	if v != nil {
+		_u.SetBlockedID(*v)
+	}
+	return _u
+}
+
+// SetBlocker sets the "blocker" edge to the User entity.
+func (_u *BlockUpdate) SetBlocker(v *User) *BlockUpdate {
+	return _u.SetBlockerID(v.ID)
+}
+
+// SetBlocked sets the "blocked" edge to the User entity.
+func (_u *BlockUpdate) SetBlocked(v *User) *BlockUpdate {
+	return _u.SetBlockedID(v.ID)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (_u *BlockUpdate) Mutation() *BlockMutation {
+	return _u.mutation
+}
+
+// ClearBlocker clears the "blocker" edge to the User entity.
+func (_u *BlockUpdate) ClearBlocker() *BlockUpdate {
+	_u.mutation.ClearBlocker()
+	return _u
+}
+
+// ClearBlocked clears the "blocked" edge to the User entity.
+func (_u *BlockUpdate) ClearBlocked() *BlockUpdate {
+	_u.mutation.ClearBlocked()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlockUpdate) check() error {
+	if _u.mutation.BlockerCleared() && len(_u.mutation.BlockerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocker"`)
+	}
+	if _u.mutation.BlockedCleared() && len(_u.mutation.BlockedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocked"`)
+	}
+	return nil
+}
+
+func (_u *BlockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.BlockerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockerTable,
+			Columns: []string{block.BlockerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockerTable,
+			Columns: []string{block.BlockerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockedTable,
+			Columns: []string{block.BlockedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockedTable,
+			Columns: []string{block.BlockedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlockUpdateOne is the builder for updating a single Block entity.
+type BlockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlockMutation
+}
+
+// SetBlockerID sets the "blocker_id" field.
+func (_u *BlockUpdateOne) SetBlockerID(v uuid.UUID) *BlockUpdateOne {
+	_u.mutation.SetBlockerID(v)
+	return _u
+}
+
+// SetNillableBlockerID sets the "blocker_id" field if the given value is not nil.
+func (_u *BlockUpdateOne) SetNillableBlockerID(v *uuid.UUID) *BlockUpdateOne {
+	if v != nil {
+		_u.SetBlockerID(*v)
+	}
+	return _u
+}
+
+// SetBlockedID sets the "blocked_id" field.
+func (_u *BlockUpdateOne) SetBlockedID(v uuid.UUID) *BlockUpdateOne {
+	_u.mutation.SetBlockedID(v)
+	return _u
+}
+
+// SetNillableBlockedID sets the "blocked_id" field if the given value is not nil.
+func (_u *BlockUpdateOne) SetNillableBlockedID(v *uuid.UUID) *BlockUpdateOne {
+	if v != nil {
+		_u.SetBlockedID(*v)
+	}
+	return _u
+}
+
+// SetBlocker sets the "blocker" edge to the User entity.
+func (_u *BlockUpdateOne) SetBlocker(v *User) *BlockUpdateOne {
+	return _u.SetBlockerID(v.ID)
+}
+
+// SetBlocked sets the "blocked" edge to the User entity.
+func (_u *BlockUpdateOne) SetBlocked(v *User) *BlockUpdateOne {
+	return _u.SetBlockedID(v.ID)
+}
+
+// Mutation returns the BlockMutation object of the builder.
+func (_u *BlockUpdateOne) Mutation() *BlockMutation {
+	return _u.mutation
+}
+
+// ClearBlocker clears the "blocker" edge to the User entity.
+func (_u *BlockUpdateOne) ClearBlocker() *BlockUpdateOne {
+	_u.mutation.ClearBlocker()
+	return _u
+}
+
+// ClearBlocked clears the "blocked" edge to the User entity.
+func (_u *BlockUpdateOne) ClearBlocked() *BlockUpdateOne {
+	_u.mutation.ClearBlocked()
+	return _u
+}
+
+// Where appends a list predicates to the BlockUpdate builder.
+func (_u *BlockUpdateOne) Where(ps ...predicate.Block) *BlockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlockUpdateOne) Select(field string, fields ...string) *BlockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Block entity.
+func (_u *BlockUpdateOne) Save(ctx context.Context) (*Block, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlockUpdateOne) SaveX(ctx context.Context) *Block {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlockUpdateOne) check() error {
+	if _u.mutation.BlockerCleared() && len(_u.mutation.BlockerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocker"`)
+	}
+	if _u.mutation.BlockedCleared() && len(_u.mutation.BlockedIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Block.blocked"`)
+	}
+	return nil
+}
+
+func (_u *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(block.Table, block.Columns, sqlgraph.NewFieldSpec(block.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Block.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, block.FieldID)
+		for _, f := range fields {
+			if !block.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != block.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.BlockerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockerTable,
+			Columns: []string{block.BlockerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockerTable,
+			Columns: []string{block.BlockerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BlockedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockedTable,
+			Columns: []string{block.BlockedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlockedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   block.BlockedTable,
+			Columns: []string{block.BlockedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Block{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{block.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
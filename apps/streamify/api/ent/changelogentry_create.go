@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/changelogentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChangelogEntryCreate is the builder for creating a ChangelogEntry entity.
+type ChangelogEntryCreate struct {
+	config
+	mutation *ChangelogEntryMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (_c *ChangelogEntryCreate) SetVersion(v string) *ChangelogEntryCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ChangelogEntryCreate) SetTitle(v string) *ChangelogEntryCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *ChangelogEntryCreate) SetBody(v string) *ChangelogEntryCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_c *ChangelogEntryCreate) SetPublishedAt(v time.Time) *ChangelogEntryCreate {
+	_c.mutation.SetPublishedAt(v)
+	return _c
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_c *ChangelogEntryCreate) SetNillablePublishedAt(v *time.Time) *ChangelogEntryCreate {
+	if v != nil {
+		_c.SetPublishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ChangelogEntryCreate) SetCreatedBy(v uuid.UUID) *ChangelogEntryCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ChangelogEntryCreate) SetNillableCreatedBy(v *uuid.UUID) *ChangelogEntryCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChangelogEntryCreate) SetCreatedAt(v time.Time) *ChangelogEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChangelogEntryCreate) SetNillableCreatedAt(v *time.Time) *ChangelogEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChangelogEntryCreate) SetUpdatedAt(v time.Time) *ChangelogEntryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChangelogEntryCreate) SetNillableUpdatedAt(v *time.Time) *ChangelogEntryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChangelogEntryCreate) SetID(v uuid.UUID) *ChangelogEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChangelogEntryCreate) SetNillableID(v *uuid.UUID) *ChangelogEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ChangelogEntryMutation object of the builder.
+func (_c *ChangelogEntryCreate) Mutation() *ChangelogEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the ChangelogEntry in the database.
+func (_c *ChangelogEntryCreate) Save(ctx context.Context) (*ChangelogEntry, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChangelogEntryCreate) SaveX(ctx context.Context) *ChangelogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChangelogEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChangelogEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChangelogEntryCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if changelogentry.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized changelogentry.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := changelogentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if changelogentry.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized changelogentry.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := changelogentry.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if changelogentry.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized changelogentry.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := changelogentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChangelogEntryCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ChangelogEntry.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := changelogentry.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ChangelogEntry.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ChangelogEntry.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := changelogentry.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ChangelogEntry.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "ChangelogEntry.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := changelogentry.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "ChangelogEntry.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChangelogEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChangelogEntry.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ChangelogEntryCreate) sqlSave(ctx context.Context) (*ChangelogEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChangelogEntryCreate) createSpec() (*ChangelogEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChangelogEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(changelogentry.Table, sqlgraph.NewFieldSpec(changelogentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(changelogentry.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(changelogentry.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(changelogentry.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.PublishedAt(); ok {
+		_spec.SetField(changelogentry.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(changelogentry.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(changelogentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(changelogentry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ChangelogEntryCreateBulk is the builder for creating many ChangelogEntry entities in bulk.
+type ChangelogEntryCreateBulk struct {
+	config
+	err      error
+	builders []*ChangelogEntryCreate
+}
+
+// Save creates the ChangelogEntry entities in the database.
+func (_c *ChangelogEntryCreateBulk) Save(ctx context.Context) ([]*ChangelogEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChangelogEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChangelogEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChangelogEntryCreateBulk) SaveX(ctx context.Context) []*ChangelogEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChangelogEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChangelogEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
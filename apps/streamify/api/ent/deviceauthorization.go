@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/deviceauthorization"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DeviceAuthorization is the model entity for the DeviceAuthorization schema.
+type DeviceAuthorization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SHA-256 of the device code the device polls with; the code itself is never stored
+	DeviceCodeHash string `json:"-"`
+	// The code the user types in, without its separator
+	UserCode string `json:"user_code,omitempty"`
+	// Pending until the user approves or denies the code, redeemed once the device got its tokens
+	Status deviceauthorization.Status `json:"status,omitempty"`
+	// The user who approved or denied the code
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// Polls closer together than the interval are answered with slow_down
+	PolledAt *time.Time `json:"polled_at,omitempty"`
+	// The code can't be approved or redeemed after this
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceAuthorizationQuery when eager-loading is set.
+	Edges        DeviceAuthorizationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeviceAuthorizationEdges holds the relations/edges for other nodes in the graph.
+type DeviceAuthorizationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceAuthorizationEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceAuthorization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deviceauthorization.FieldUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case deviceauthorization.FieldDeviceCodeHash, deviceauthorization.FieldUserCode, deviceauthorization.FieldStatus:
+			values[i] = new(sql.NullString)
+		case deviceauthorization.FieldPolledAt, deviceauthorization.FieldExpiresAt, deviceauthorization.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case deviceauthorization.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceAuthorization fields.
+func (_m *DeviceAuthorization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deviceauthorization.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deviceauthorization.FieldDeviceCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_code_hash", values[i])
+			} else if value.Valid {
+				_m.DeviceCodeHash = value.String
+			}
+		case deviceauthorization.FieldUserCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_code", values[i])
+			} else if value.Valid {
+				_m.UserCode = value.String
+			}
+		case deviceauthorization.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = deviceauthorization.Status(value.String)
+			}
+		case deviceauthorization.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case deviceauthorization.FieldPolledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field polled_at", values[i])
+			} else if value.Valid {
+				_m.PolledAt = new(time.Time)
+				*_m.PolledAt = value.Time
+			}
+		case deviceauthorization.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case deviceauthorization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceAuthorization.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeviceAuthorization) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DeviceAuthorization entity.
+func (_m *DeviceAuthorization) QueryUser() *UserQuery {
+	return NewDeviceAuthorizationClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this DeviceAuthorization.
+// Note that you need to call DeviceAuthorization.Unwrap() before calling this method if this DeviceAuthorization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeviceAuthorization) Update() *DeviceAuthorizationUpdateOne {
+	return NewDeviceAuthorizationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeviceAuthorization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeviceAuthorization) Unwrap() *DeviceAuthorization {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceAuthorization is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeviceAuthorization) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceAuthorization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("device_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("user_code=")
+	builder.WriteString(_m.UserCode)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PolledAt; v != nil {
+		builder.WriteString("polled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceAuthorizations is a parsable slice of DeviceAuthorization.
+type DeviceAuthorizations []*DeviceAuthorization
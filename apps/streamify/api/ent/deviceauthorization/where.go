@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceauthorization
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldID, id))
+}
+
+// DeviceCodeHash applies equality check predicate on the "device_code_hash" field. It's identical to DeviceCodeHashEQ.
+func DeviceCodeHash(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDeviceCodeHash, v))
+}
+
+// UserCode applies equality check predicate on the "user_code" field. It's identical to UserCodeEQ.
+func UserCode(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserID, v))
+}
+
+// PolledAt applies equality check predicate on the "polled_at" field. It's identical to PolledAtEQ.
+func PolledAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldPolledAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeviceCodeHashEQ applies the EQ predicate on the "device_code_hash" field.
+func DeviceCodeHashEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashNEQ applies the NEQ predicate on the "device_code_hash" field.
+func DeviceCodeHashNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashIn applies the In predicate on the "device_code_hash" field.
+func DeviceCodeHashIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldDeviceCodeHash, vs...))
+}
+
+// DeviceCodeHashNotIn applies the NotIn predicate on the "device_code_hash" field.
+func DeviceCodeHashNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldDeviceCodeHash, vs...))
+}
+
+// DeviceCodeHashGT applies the GT predicate on the "device_code_hash" field.
+func DeviceCodeHashGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashGTE applies the GTE predicate on the "device_code_hash" field.
+func DeviceCodeHashGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashLT applies the LT predicate on the "device_code_hash" field.
+func DeviceCodeHashLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashLTE applies the LTE predicate on the "device_code_hash" field.
+func DeviceCodeHashLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashContains applies the Contains predicate on the "device_code_hash" field.
+func DeviceCodeHashContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashHasPrefix applies the HasPrefix predicate on the "device_code_hash" field.
+func DeviceCodeHashHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashHasSuffix applies the HasSuffix predicate on the "device_code_hash" field.
+func DeviceCodeHashHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashEqualFold applies the EqualFold predicate on the "device_code_hash" field.
+func DeviceCodeHashEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldDeviceCodeHash, v))
+}
+
+// DeviceCodeHashContainsFold applies the ContainsFold predicate on the "device_code_hash" field.
+func DeviceCodeHashContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldDeviceCodeHash, v))
+}
+
+// UserCodeEQ applies the EQ predicate on the "user_code" field.
+func UserCodeEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserCode, v))
+}
+
+// UserCodeNEQ applies the NEQ predicate on the "user_code" field.
+func UserCodeNEQ(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUserCode, v))
+}
+
+// UserCodeIn applies the In predicate on the "user_code" field.
+func UserCodeIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUserCode, vs...))
+}
+
+// UserCodeNotIn applies the NotIn predicate on the "user_code" field.
+func UserCodeNotIn(vs ...string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUserCode, vs...))
+}
+
+// UserCodeGT applies the GT predicate on the "user_code" field.
+func UserCodeGT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldUserCode, v))
+}
+
+// UserCodeGTE applies the GTE predicate on the "user_code" field.
+func UserCodeGTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldUserCode, v))
+}
+
+// UserCodeLT applies the LT predicate on the "user_code" field.
+func UserCodeLT(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldUserCode, v))
+}
+
+// UserCodeLTE applies the LTE predicate on the "user_code" field.
+func UserCodeLTE(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldUserCode, v))
+}
+
+// UserCodeContains applies the Contains predicate on the "user_code" field.
+func UserCodeContains(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContains(FieldUserCode, v))
+}
+
+// UserCodeHasPrefix applies the HasPrefix predicate on the "user_code" field.
+func UserCodeHasPrefix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasPrefix(FieldUserCode, v))
+}
+
+// UserCodeHasSuffix applies the HasSuffix predicate on the "user_code" field.
+func UserCodeHasSuffix(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldHasSuffix(FieldUserCode, v))
+}
+
+// UserCodeEqualFold applies the EqualFold predicate on the "user_code" field.
+func UserCodeEqualFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEqualFold(FieldUserCode, v))
+}
+
+// UserCodeContainsFold applies the ContainsFold predicate on the "user_code" field.
+func UserCodeContainsFold(v string) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldContainsFold(FieldUserCode, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldUserID))
+}
+
+// PolledAtEQ applies the EQ predicate on the "polled_at" field.
+func PolledAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldPolledAt, v))
+}
+
+// PolledAtNEQ applies the NEQ predicate on the "polled_at" field.
+func PolledAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldPolledAt, v))
+}
+
+// PolledAtIn applies the In predicate on the "polled_at" field.
+func PolledAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldPolledAt, vs...))
+}
+
+// PolledAtNotIn applies the NotIn predicate on the "polled_at" field.
+func PolledAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldPolledAt, vs...))
+}
+
+// PolledAtGT applies the GT predicate on the "polled_at" field.
+func PolledAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldPolledAt, v))
+}
+
+// PolledAtGTE applies the GTE predicate on the "polled_at" field.
+func PolledAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldPolledAt, v))
+}
+
+// PolledAtLT applies the LT predicate on the "polled_at" field.
+func PolledAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldPolledAt, v))
+}
+
+// PolledAtLTE applies the LTE predicate on the "polled_at" field.
+func PolledAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldPolledAt, v))
+}
+
+// PolledAtIsNil applies the IsNil predicate on the "polled_at" field.
+func PolledAtIsNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIsNull(FieldPolledAt))
+}
+
+// PolledAtNotNil applies the NotNil predicate on the "polled_at" field.
+func PolledAtNotNil() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotNull(FieldPolledAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceAuthorization) predicate.DeviceAuthorization {
+	return predicate.DeviceAuthorization(sql.NotPredicates(p))
+}
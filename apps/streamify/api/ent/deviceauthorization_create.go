@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/deviceauthorization"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceAuthorizationCreate is the builder for creating a DeviceAuthorization entity.
+type DeviceAuthorizationCreate struct {
+	config
+	mutation *DeviceAuthorizationMutation
+	hooks    []Hook
+}
+
+// SetDeviceCodeHash sets the "device_code_hash" field.
+func (_c *DeviceAuthorizationCreate) SetDeviceCodeHash(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetDeviceCodeHash(v)
+	return _c
+}
+
+// SetUserCode sets the "user_code" field.
+func (_c *DeviceAuthorizationCreate) SetUserCode(v string) *DeviceAuthorizationCreate {
+	_c.mutation.SetUserCode(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DeviceAuthorizationCreate) SetStatus(v deviceauthorization.Status) *DeviceAuthorizationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableStatus(v *deviceauthorization.Status) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DeviceAuthorizationCreate) SetUserID(v uuid.UUID) *DeviceAuthorizationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableUserID(v *uuid.UUID) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetPolledAt sets the "polled_at" field.
+func (_c *DeviceAuthorizationCreate) SetPolledAt(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetPolledAt(v)
+	return _c
+}
+
+// SetNillablePolledAt sets the "polled_at" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillablePolledAt(v *time.Time) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetPolledAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *DeviceAuthorizationCreate) SetExpiresAt(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeviceAuthorizationCreate) SetCreatedAt(v time.Time) *DeviceAuthorizationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableCreatedAt(v *time.Time) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceAuthorizationCreate) SetID(v uuid.UUID) *DeviceAuthorizationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeviceAuthorizationCreate) SetNillableID(v *uuid.UUID) *DeviceAuthorizationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *DeviceAuthorizationCreate) SetUser(v *User) *DeviceAuthorizationCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_c *DeviceAuthorizationCreate) Mutation() *DeviceAuthorizationMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceAuthorization in the database.
+func (_c *DeviceAuthorizationCreate) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceAuthorizationCreate) SaveX(ctx context.Context) *DeviceAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAuthorizationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceAuthorizationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := deviceauthorization.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deviceauthorization.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := deviceauthorization.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceAuthorizationCreate) check() error {
+	if _, ok := _c.mutation.DeviceCodeHash(); !ok {
+		return &ValidationError{Name: "device_code_hash", err: errors.New(`ent: missing required field "DeviceAuthorization.device_code_hash"`)}
+	}
+	if v, ok := _c.mutation.DeviceCodeHash(); ok {
+		if err := deviceauthorization.DeviceCodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_code_hash", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.device_code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserCode(); !ok {
+		return &ValidationError{Name: "user_code", err: errors.New(`ent: missing required field "DeviceAuthorization.user_code"`)}
+	}
+	if v, ok := _c.mutation.UserCode(); ok {
+		if err := deviceauthorization.UserCodeValidator(v); err != nil {
+			return &ValidationError{Name: "user_code", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.user_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeviceAuthorization.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "DeviceAuthorization.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeviceAuthorization.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DeviceAuthorizationCreate) sqlSave(ctx context.Context) (*DeviceAuthorization, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceAuthorizationCreate) createSpec() (*DeviceAuthorization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceAuthorization{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceauthorization.Table, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DeviceCodeHash(); ok {
+		_spec.SetField(deviceauthorization.FieldDeviceCodeHash, field.TypeString, value)
+		_node.DeviceCodeHash = value
+	}
+	if value, ok := _c.mutation.UserCode(); ok {
+		_spec.SetField(deviceauthorization.FieldUserCode, field.TypeString, value)
+		_node.UserCode = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldPolledAt, field.TypeTime, value)
+		_node.PolledAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(deviceauthorization.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deviceauthorization.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeviceAuthorizationCreateBulk is the builder for creating many DeviceAuthorization entities in bulk.
+type DeviceAuthorizationCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceAuthorizationCreate
+}
+
+// Save creates the DeviceAuthorization entities in the database.
+func (_c *DeviceAuthorizationCreateBulk) Save(ctx context.Context) ([]*DeviceAuthorization, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceAuthorization, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceAuthorizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreateBulk) SaveX(ctx context.Context) []*DeviceAuthorization {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAuthorizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAuthorizationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
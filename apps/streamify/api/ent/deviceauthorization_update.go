@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/deviceauthorization"
+	"streamify/ent/predicate"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceAuthorizationUpdate is the builder for updating DeviceAuthorization entities.
+type DeviceAuthorizationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceAuthorizationMutation
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (_u *DeviceAuthorizationUpdate) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeviceAuthorizationUpdate) SetStatus(v deviceauthorization.Status) *DeviceAuthorizationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableStatus(v *deviceauthorization.Status) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DeviceAuthorizationUpdate) SetUserID(v uuid.UUID) *DeviceAuthorizationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillableUserID(v *uuid.UUID) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *DeviceAuthorizationUpdate) ClearUserID() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetPolledAt sets the "polled_at" field.
+func (_u *DeviceAuthorizationUpdate) SetPolledAt(v time.Time) *DeviceAuthorizationUpdate {
+	_u.mutation.SetPolledAt(v)
+	return _u
+}
+
+// SetNillablePolledAt sets the "polled_at" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdate) SetNillablePolledAt(v *time.Time) *DeviceAuthorizationUpdate {
+	if v != nil {
+		_u.SetPolledAt(*v)
+	}
+	return _u
+}
+
+// ClearPolledAt clears the value of the "polled_at" field.
+func (_u *DeviceAuthorizationUpdate) ClearPolledAt() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearPolledAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *DeviceAuthorizationUpdate) SetUser(v *User) *DeviceAuthorizationUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_u *DeviceAuthorizationUpdate) Mutation() *DeviceAuthorizationMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *DeviceAuthorizationUpdate) ClearUser() *DeviceAuthorizationUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceAuthorizationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceAuthorizationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceAuthorizationUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceAuthorizationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldPolledAt, field.TypeTime, value)
+	}
+	if _u.mutation.PolledAtCleared() {
+		_spec.ClearField(deviceauthorization.FieldPolledAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceAuthorizationUpdateOne is the builder for updating a single DeviceAuthorization entity.
+type DeviceAuthorizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceAuthorizationMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeviceAuthorizationUpdateOne) SetStatus(v deviceauthorization.Status) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableStatus(v *deviceauthorization.Status) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DeviceAuthorizationUpdateOne) SetUserID(v uuid.UUID) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillableUserID(v *uuid.UUID) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *DeviceAuthorizationUpdateOne) ClearUserID() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetPolledAt sets the "polled_at" field.
+func (_u *DeviceAuthorizationUpdateOne) SetPolledAt(v time.Time) *DeviceAuthorizationUpdateOne {
+	_u.mutation.SetPolledAt(v)
+	return _u
+}
+
+// SetNillablePolledAt sets the "polled_at" field if the given value is not nil.
+func (_u *DeviceAuthorizationUpdateOne) SetNillablePolledAt(v *time.Time) *DeviceAuthorizationUpdateOne {
+	if v != nil {
+		_u.SetPolledAt(*v)
+	}
+	return _u
+}
+
+// ClearPolledAt clears the value of the "polled_at" field.
+func (_u *DeviceAuthorizationUpdateOne) ClearPolledAt() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearPolledAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *DeviceAuthorizationUpdateOne) SetUser(v *User) *DeviceAuthorizationUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the DeviceAuthorizationMutation object of the builder.
+func (_u *DeviceAuthorizationUpdateOne) Mutation() *DeviceAuthorizationMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *DeviceAuthorizationUpdateOne) ClearUser() *DeviceAuthorizationUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the DeviceAuthorizationUpdate builder.
+func (_u *DeviceAuthorizationUpdateOne) Where(ps ...predicate.DeviceAuthorization) *DeviceAuthorizationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceAuthorizationUpdateOne) Select(field string, fields ...string) *DeviceAuthorizationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceAuthorization entity.
+func (_u *DeviceAuthorizationUpdateOne) Save(ctx context.Context) (*DeviceAuthorization, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdateOne) SaveX(ctx context.Context) *DeviceAuthorization {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceAuthorizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAuthorizationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceAuthorizationUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := deviceauthorization.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeviceAuthorization.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DeviceAuthorizationUpdateOne) sqlSave(ctx context.Context) (_node *DeviceAuthorization, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deviceauthorization.Table, deviceauthorization.Columns, sqlgraph.NewFieldSpec(deviceauthorization.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceAuthorization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceauthorization.FieldID)
+		for _, f := range fields {
+			if !deviceauthorization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceauthorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(deviceauthorization.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PolledAt(); ok {
+		_spec.SetField(deviceauthorization.FieldPolledAt, field.TypeTime, value)
+	}
+	if _u.mutation.PolledAtCleared() {
+		_spec.ClearField(deviceauthorization.FieldPolledAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   deviceauthorization.UserTable,
+			Columns: []string{deviceauthorization.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DeviceAuthorization{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceauthorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
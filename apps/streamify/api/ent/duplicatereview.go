@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/track"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DuplicateReview is the model entity for the DuplicateReview schema.
+type DuplicateReview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The track whose upload was flagged
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// The existing track it resembles
+	MatchTrackID uuid.UUID `json:"match_track_id,omitempty"`
+	// How alike the fingerprints are, from 0 to 1
+	Similarity float64 `json:"similarity,omitempty"`
+	// Pending until an admin marks the upload a duplicate or dismisses the flag
+	Status duplicatereview.Status `json:"status,omitempty"`
+	// The admin who reviewed the flag
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// When the flag was reviewed
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DuplicateReviewQuery when eager-loading is set.
+	Edges        DuplicateReviewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DuplicateReviewEdges holds the relations/edges for other nodes in the graph.
+type DuplicateReviewEdges struct {
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// Match holds the value of the match edge.
+	Match *Track `json:"match,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DuplicateReviewEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// MatchOrErr returns the Match value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DuplicateReviewEdges) MatchOrErr() (*Track, error) {
+	if e.Match != nil {
+		return e.Match, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "match"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DuplicateReview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case duplicatereview.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case duplicatereview.FieldSimilarity:
+			values[i] = new(sql.NullFloat64)
+		case duplicatereview.FieldStatus:
+			values[i] = new(sql.NullString)
+		case duplicatereview.FieldReviewedAt, duplicatereview.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case duplicatereview.FieldID, duplicatereview.FieldTrackID, duplicatereview.FieldMatchTrackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DuplicateReview fields.
+func (_m *DuplicateReview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case duplicatereview.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case duplicatereview.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case duplicatereview.FieldMatchTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field match_track_id", values[i])
+			} else if value != nil {
+				_m.MatchTrackID = *value
+			}
+		case duplicatereview.FieldSimilarity:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field similarity", values[i])
+			} else if value.Valid {
+				_m.Similarity = value.Float64
+			}
+		case duplicatereview.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = duplicatereview.Status(value.String)
+			}
+		case duplicatereview.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(uuid.UUID)
+				*_m.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case duplicatereview.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case duplicatereview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DuplicateReview.
+// This includes values selected through modifiers, order, etc.
+func (_m *DuplicateReview) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTrack queries the "track" edge of the DuplicateReview entity.
+func (_m *DuplicateReview) QueryTrack() *TrackQuery {
+	return NewDuplicateReviewClient(_m.config).QueryTrack(_m)
+}
+
+// QueryMatch queries the "match" edge of the DuplicateReview entity.
+func (_m *DuplicateReview) QueryMatch() *TrackQuery {
+	return NewDuplicateReviewClient(_m.config).QueryMatch(_m)
+}
+
+// Update returns a builder for updating this DuplicateReview.
+// Note that you need to call DuplicateReview.Unwrap() before calling this method if this DuplicateReview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DuplicateReview) Update() *DuplicateReviewUpdateOne {
+	return NewDuplicateReviewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DuplicateReview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DuplicateReview) Unwrap() *DuplicateReview {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DuplicateReview is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DuplicateReview) String() string {
+	var builder strings.Builder
+	builder.WriteString("DuplicateReview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("match_track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchTrackID))
+	builder.WriteString(", ")
+	builder.WriteString("similarity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Similarity))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DuplicateReviews is a parsable slice of DuplicateReview.
+type DuplicateReviews []*DuplicateReview
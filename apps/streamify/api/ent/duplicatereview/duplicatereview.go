@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package duplicatereview
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the duplicatereview type in the database.
+	Label = "duplicate_review"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTrackID holds the string denoting the track_id field in the database.
+	FieldTrackID = "track_id"
+	// FieldMatchTrackID holds the string denoting the match_track_id field in the database.
+	FieldMatchTrackID = "match_track_id"
+	// FieldSimilarity holds the string denoting the similarity field in the database.
+	FieldSimilarity = "similarity"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTrack holds the string denoting the track edge name in mutations.
+	EdgeTrack = "track"
+	// EdgeMatch holds the string denoting the match edge name in mutations.
+	EdgeMatch = "match"
+	// Table holds the table name of the duplicatereview in the database.
+	Table = "duplicate_reviews"
+	// TrackTable is the table that holds the track relation/edge.
+	TrackTable = "duplicate_reviews"
+	// TrackInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TrackInverseTable = "tracks"
+	// TrackColumn is the table column denoting the track relation/edge.
+	TrackColumn = "track_id"
+	// MatchTable is the table that holds the match relation/edge.
+	MatchTable = "duplicate_reviews"
+	// MatchInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	MatchInverseTable = "tracks"
+	// MatchColumn is the table column denoting the match relation/edge.
+	MatchColumn = "match_track_id"
+)
+
+// Columns holds all SQL columns for duplicatereview fields.
+var Columns = []string{
+	FieldID,
+	FieldTrackID,
+	FieldMatchTrackID,
+	FieldSimilarity,
+	FieldStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// SimilarityValidator is a validator for the "similarity" field. It is called by the builders before save.
+	SimilarityValidator func(float64) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusDuplicate Status = "duplicate"
+	StatusDismissed Status = "dismissed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusDuplicate, StatusDismissed:
+		return nil
+	default:
+		return fmt.Errorf("duplicatereview: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DuplicateReview queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTrackID orders the results by the track_id field.
+func ByTrackID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackID, opts...).ToFunc()
+}
+
+// ByMatchTrackID orders the results by the match_track_id field.
+func ByMatchTrackID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchTrackID, opts...).ToFunc()
+}
+
+// BySimilarity orders the results by the similarity field.
+func BySimilarity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSimilarity, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTrackField orders the results by track field.
+func ByTrackField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMatchField orders the results by match field.
+func ByMatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTrackStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+	)
+}
+func newMatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MatchTable, MatchColumn),
+	)
+}
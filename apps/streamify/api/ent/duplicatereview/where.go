@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package duplicatereview
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLTE(FieldID, id))
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldTrackID, v))
+}
+
+// MatchTrackID applies equality check predicate on the "match_track_id" field. It's identical to MatchTrackIDEQ.
+func MatchTrackID(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldMatchTrackID, v))
+}
+
+// Similarity applies equality check predicate on the "similarity" field. It's identical to SimilarityEQ.
+func Similarity(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldSimilarity, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldTrackID, v))
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldTrackID, v))
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldTrackID, vs...))
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldTrackID, vs...))
+}
+
+// MatchTrackIDEQ applies the EQ predicate on the "match_track_id" field.
+func MatchTrackIDEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldMatchTrackID, v))
+}
+
+// MatchTrackIDNEQ applies the NEQ predicate on the "match_track_id" field.
+func MatchTrackIDNEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldMatchTrackID, v))
+}
+
+// MatchTrackIDIn applies the In predicate on the "match_track_id" field.
+func MatchTrackIDIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldMatchTrackID, vs...))
+}
+
+// MatchTrackIDNotIn applies the NotIn predicate on the "match_track_id" field.
+func MatchTrackIDNotIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldMatchTrackID, vs...))
+}
+
+// SimilarityEQ applies the EQ predicate on the "similarity" field.
+func SimilarityEQ(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldSimilarity, v))
+}
+
+// SimilarityNEQ applies the NEQ predicate on the "similarity" field.
+func SimilarityNEQ(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldSimilarity, v))
+}
+
+// SimilarityIn applies the In predicate on the "similarity" field.
+func SimilarityIn(vs ...float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldSimilarity, vs...))
+}
+
+// SimilarityNotIn applies the NotIn predicate on the "similarity" field.
+func SimilarityNotIn(vs ...float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldSimilarity, vs...))
+}
+
+// SimilarityGT applies the GT predicate on the "similarity" field.
+func SimilarityGT(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGT(FieldSimilarity, v))
+}
+
+// SimilarityGTE applies the GTE predicate on the "similarity" field.
+func SimilarityGTE(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGTE(FieldSimilarity, v))
+}
+
+// SimilarityLT applies the LT predicate on the "similarity" field.
+func SimilarityLT(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLT(FieldSimilarity, v))
+}
+
+// SimilarityLTE applies the LTE predicate on the "similarity" field.
+func SimilarityLTE(v float64) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLTE(FieldSimilarity, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.DuplicateReview {
+	return predicate.DuplicateReview(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.DuplicateReview {
+	return predicate.DuplicateReview(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatch applies the HasEdge predicate on the "match" edge.
+func HasMatch() predicate.DuplicateReview {
+	return predicate.DuplicateReview(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MatchTable, MatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchWith applies the HasEdge predicate on the "match" edge with a given conditions (other predicates).
+func HasMatchWith(preds ...predicate.Track) predicate.DuplicateReview {
+	return predicate.DuplicateReview(func(s *sql.Selector) {
+		step := newMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DuplicateReview) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DuplicateReview) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DuplicateReview) predicate.DuplicateReview {
+	return predicate.DuplicateReview(sql.NotPredicates(p))
+}
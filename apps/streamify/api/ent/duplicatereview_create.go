@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/track"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DuplicateReviewCreate is the builder for creating a DuplicateReview entity.
+type DuplicateReviewCreate struct {
+	config
+	mutation *DuplicateReviewMutation
+	hooks    []Hook
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *DuplicateReviewCreate) SetTrackID(v uuid.UUID) *DuplicateReviewCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetMatchTrackID sets the "match_track_id" field.
+func (_c *DuplicateReviewCreate) SetMatchTrackID(v uuid.UUID) *DuplicateReviewCreate {
+	_c.mutation.SetMatchTrackID(v)
+	return _c
+}
+
+// SetSimilarity sets the "similarity" field.
+func (_c *DuplicateReviewCreate) SetSimilarity(v float64) *DuplicateReviewCreate {
+	_c.mutation.SetSimilarity(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DuplicateReviewCreate) SetStatus(v duplicatereview.Status) *DuplicateReviewCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DuplicateReviewCreate) SetNillableStatus(v *duplicatereview.Status) *DuplicateReviewCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *DuplicateReviewCreate) SetReviewedBy(v uuid.UUID) *DuplicateReviewCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *DuplicateReviewCreate) SetNillableReviewedBy(v *uuid.UUID) *DuplicateReviewCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *DuplicateReviewCreate) SetReviewedAt(v time.Time) *DuplicateReviewCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *DuplicateReviewCreate) SetNillableReviewedAt(v *time.Time) *DuplicateReviewCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DuplicateReviewCreate) SetCreatedAt(v time.Time) *DuplicateReviewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DuplicateReviewCreate) SetNillableCreatedAt(v *time.Time) *DuplicateReviewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DuplicateReviewCreate) SetID(v uuid.UUID) *DuplicateReviewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DuplicateReviewCreate) SetNillableID(v *uuid.UUID) *DuplicateReviewCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *DuplicateReviewCreate) SetTrack(v *Track) *DuplicateReviewCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// SetMatchID sets the "match" edge to the Track entity by ID.
+func (_c *DuplicateReviewCreate) SetMatchID(id uuid.UUID) *DuplicateReviewCreate {
+	_c.mutation.SetMatchID(id)
+	return _c
+}
+
+// SetMatch sets the "match" edge to the Track entity.
+func (_c *DuplicateReviewCreate) SetMatch(v *Track) *DuplicateReviewCreate {
+	return _c.SetMatchID(v.ID)
+}
+
+// Mutation returns the DuplicateReviewMutation object of the builder.
+func (_c *DuplicateReviewCreate) Mutation() *DuplicateReviewMutation {
+	return _c.mutation
+}
+
+// Save creates the DuplicateReview in the database.
+func (_c *DuplicateReviewCreate) Save(ctx context.Context) (*DuplicateReview, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DuplicateReviewCreate) SaveX(ctx context.Context) *DuplicateReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DuplicateReviewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DuplicateReviewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DuplicateReviewCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := duplicatereview.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if duplicatereview.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized duplicatereview.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := duplicatereview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if duplicatereview.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized duplicatereview.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := duplicatereview.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DuplicateReviewCreate) check() error {
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "DuplicateReview.track_id"`)}
+	}
+	if _, ok := _c.mutation.MatchTrackID(); !ok {
+		return &ValidationError{Name: "match_track_id", err: errors.New(`ent: missing required field "DuplicateReview.match_track_id"`)}
+	}
+	if _, ok := _c.mutation.Similarity(); !ok {
+		return &ValidationError{Name: "similarity", err: errors.New(`ent: missing required field "DuplicateReview.similarity"`)}
+	}
+	if v, ok := _c.mutation.Similarity(); ok {
+		if err := duplicatereview.SimilarityValidator(v); err != nil {
+			return &ValidationError{Name: "similarity", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.similarity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DuplicateReview.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := duplicatereview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DuplicateReview.created_at"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "DuplicateReview.track"`)}
+	}
+	if len(_c.mutation.MatchIDs()) == 0 {
+		return &ValidationError{Name: "match", err: errors.New(`ent: missing required edge "DuplicateReview.match"`)}
+	}
+	return nil
+}
+
+func (_c *DuplicateReviewCreate) sqlSave(ctx context.Context) (*DuplicateReview, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DuplicateReviewCreate) createSpec() (*DuplicateReview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DuplicateReview{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(duplicatereview.Table, sqlgraph.NewFieldSpec(duplicatereview.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Similarity(); ok {
+		_spec.SetField(duplicatereview.FieldSimilarity, field.TypeFloat64, value)
+		_node.Similarity = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(duplicatereview.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(duplicatereview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.TrackTable,
+			Columns: []string{duplicatereview.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.MatchTable,
+			Columns: []string{duplicatereview.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MatchTrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DuplicateReviewCreateBulk is the builder for creating many DuplicateReview entities in bulk.
+type DuplicateReviewCreateBulk struct {
+	config
+	err      error
+	builders []*DuplicateReviewCreate
+}
+
+// Save creates the DuplicateReview entities in the database.
+func (_c *DuplicateReviewCreateBulk) Save(ctx context.Context) ([]*DuplicateReview, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DuplicateReview, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DuplicateReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DuplicateReviewCreateBulk) SaveX(ctx context.Context) []*DuplicateReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DuplicateReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DuplicateReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
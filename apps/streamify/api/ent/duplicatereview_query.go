@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DuplicateReviewQuery is the builder for querying DuplicateReview entities.
+type DuplicateReviewQuery struct {
+	config
+	ctx        *QueryContext
+	order      []duplicatereview.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DuplicateReview
+	withTrack  *TrackQuery
+	withMatch  *TrackQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DuplicateReviewQuery builder.
+func (_q *DuplicateReviewQuery) Where(ps ...predicate.DuplicateReview) *DuplicateReviewQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DuplicateReviewQuery) Limit(limit int) *DuplicateReviewQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DuplicateReviewQuery) Offset(offset int) *DuplicateReviewQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DuplicateReviewQuery) Unique(unique bool) *DuplicateReviewQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DuplicateReviewQuery) Order(o ...duplicatereview.OrderOption) *DuplicateReviewQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryTrack chains the current query on the "track" edge.
+func (_q *DuplicateReviewQuery) QueryTrack() *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicatereview.Table, duplicatereview.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, duplicatereview.TrackTable, duplicatereview.TrackColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMatch chains the current query on the "match" edge.
+func (_q *DuplicateReviewQuery) QueryMatch() *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(duplicatereview.Table, duplicatereview.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, duplicatereview.MatchTable, duplicatereview.MatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DuplicateReview entity from the query.
+// Returns a *NotFoundError when no DuplicateReview was found.
+func (_q *DuplicateReviewQuery) First(ctx context.Context) (*DuplicateReview, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{duplicatereview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) FirstX(ctx context.Context) *DuplicateReview {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DuplicateReview ID from the query.
+// Returns a *NotFoundError when no DuplicateReview ID was found.
+func (_q *DuplicateReviewQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{duplicatereview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DuplicateReview entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DuplicateReview entity is found.
+// Returns a *NotFoundError when no DuplicateReview entities are found.
+func (_q *DuplicateReviewQuery) Only(ctx context.Context) (*DuplicateReview, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{duplicatereview.Label}
+	default:
+		return nil, &NotSingularError{duplicatereview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) OnlyX(ctx context.Context) *DuplicateReview {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DuplicateReview ID in the query.
+// Returns a *NotSingularError when more than one DuplicateReview ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DuplicateReviewQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{duplicatereview.Label}
+	default:
+		err = &NotSingularError{duplicatereview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DuplicateReviews.
+func (_q *DuplicateReviewQuery) All(ctx context.Context) ([]*DuplicateReview, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DuplicateReview, *DuplicateReviewQuery]()
+	return withInterceptors[[]*DuplicateReview](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) AllX(ctx context.Context) []*DuplicateReview {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DuplicateReview IDs.
+func (_q *DuplicateReviewQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(duplicatereview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DuplicateReviewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DuplicateReviewQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DuplicateReviewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DuplicateReviewQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DuplicateReviewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DuplicateReviewQuery) Clone() *DuplicateReviewQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DuplicateReviewQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]duplicatereview.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.DuplicateReview{}, _q.predicates...),
+		withTrack:  _q.withTrack.Clone(),
+		withMatch:  _q.withMatch.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithTrack tells the query-builder to eager-load the nodes that are connected to
+// the "track" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DuplicateReviewQuery) WithTrack(opts ...func(*TrackQuery)) *DuplicateReviewQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTrack = query
+	return _q
+}
+
+// WithMatch tells the query-builder to eager-load the nodes that are connected to
+// the "match" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DuplicateReviewQuery) WithMatch(opts ...func(*TrackQuery)) *DuplicateReviewQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMatch = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TrackID uuid.UUID `json:"track_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DuplicateReview.Query().
+//		GroupBy(duplicatereview.FieldTrackID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *DuplicateReviewQuery) GroupBy(field string, fields ...string) *DuplicateReviewGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DuplicateReviewGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = duplicatereview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TrackID uuid.UUID `json:"track_id,omitempty"`
+//	}
+//
+//	client.DuplicateReview.Query().
+//		Select(duplicatereview.FieldTrackID).
+//		Scan(ctx, &v)
+func (_q *DuplicateReviewQuery) Select(fields ...string) *DuplicateReviewSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DuplicateReviewSelect{DuplicateReviewQuery: _q}
+	sbuild.label = duplicatereview.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DuplicateReviewSelect configured with the given aggregations.
+func (_q *DuplicateReviewQuery) Aggregate(fns ...AggregateFunc) *DuplicateReviewSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DuplicateReviewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !duplicatereview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if duplicatereview.Policy == nil {
+		return errors.New("ent: uninitialized duplicatereview.Policy (forgotten import ent/runtime?)")
+	}
+	if err := duplicatereview.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *DuplicateReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DuplicateReview, error) {
+	var (
+		nodes       = []*DuplicateReview{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withTrack != nil,
+			_q.withMatch != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DuplicateReview).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DuplicateReview{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withTrack; query != nil {
+		if err := _q.loadTrack(ctx, query, nodes, nil,
+			func(n *DuplicateReview, e *Track) { n.Edges.Track = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMatch; query != nil {
+		if err := _q.loadMatch(ctx, query, nodes, nil,
+			func(n *DuplicateReview, e *Track) { n.Edges.Match = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *DuplicateReviewQuery) loadTrack(ctx context.Context, query *TrackQuery, nodes []*DuplicateReview, init func(*DuplicateReview), assign func(*DuplicateReview, *Track)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DuplicateReview)
+	for i := range nodes {
+		fk := nodes[i].TrackID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(track.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "track_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *DuplicateReviewQuery) loadMatch(ctx context.Context, query *TrackQuery, nodes []*DuplicateReview, init func(*DuplicateReview), assign func(*DuplicateReview, *Track)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DuplicateReview)
+	for i := range nodes {
+		fk := nodes[i].MatchTrackID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(track.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "match_track_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *DuplicateReviewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DuplicateReviewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(duplicatereview.Table, duplicatereview.Columns, sqlgraph.NewFieldSpec(duplicatereview.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, duplicatereview.FieldID)
+		for i := range fields {
+			if fields[i] != duplicatereview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withTrack != nil {
+			_spec.Node.AddColumnOnce(duplicatereview.FieldTrackID)
+		}
+		if _q.withMatch != nil {
+			_spec.Node.AddColumnOnce(duplicatereview.FieldMatchTrackID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DuplicateReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(duplicatereview.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = duplicatereview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DuplicateReviewGroupBy is the group-by builder for DuplicateReview entities.
+type DuplicateReviewGroupBy struct {
+	selector
+	build *DuplicateReviewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DuplicateReviewGroupBy) Aggregate(fns ...AggregateFunc) *DuplicateReviewGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DuplicateReviewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DuplicateReviewQuery, *DuplicateReviewGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DuplicateReviewGroupBy) sqlScan(ctx context.Context, root *DuplicateReviewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DuplicateReviewSelect is the builder for selecting fields of DuplicateReview entities.
+type DuplicateReviewSelect struct {
+	*DuplicateReviewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DuplicateReviewSelect) Aggregate(fns ...AggregateFunc) *DuplicateReviewSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DuplicateReviewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DuplicateReviewQuery, *DuplicateReviewSelect](ctx, _s.DuplicateReviewQuery, _s, _s.inters, v)
+}
+
+func (_s *DuplicateReviewSelect) sqlScan(ctx context.Context, root *DuplicateReviewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,680 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DuplicateReviewUpdate is the builder for updating DuplicateReview entities.
+type DuplicateReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DuplicateReviewMutation
+}
+
+// Where appends a list predicates to the DuplicateReviewUpdate builder.
+func (_u *DuplicateReviewUpdate) Where(ps ...predicate.DuplicateReview) *DuplicateReviewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *DuplicateReviewUpdate) SetTrackID(v uuid.UUID) *DuplicateReviewUpdate {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableTrackID(v *uuid.UUID) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetMatchTrackID sets the "match_track_id" field.
+func (_u *DuplicateReviewUpdate) SetMatchTrackID(v uuid.UUID) *DuplicateReviewUpdate {
+	_u.mutation.SetMatchTrackID(v)
+	return _u
+}
+
+// SetNillableMatchTrackID sets the "match_track_id" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableMatchTrackID(v *uuid.UUID) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetMatchTrackID(*v)
+	}
+	return _u
+}
+
+// SetSimilarity sets the "similarity" field.
+func (_u *DuplicateReviewUpdate) SetSimilarity(v float64) *DuplicateReviewUpdate {
+	_u.mutation.ResetSimilarity()
+	_u.mutation.SetSimilarity(v)
+	return _u
+}
+
+// SetNillableSimilarity sets the "similarity" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableSimilarity(v *float64) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetSimilarity(*v)
+	}
+	return _u
+}
+
+// AddSimilarity adds value to the "similarity" field.
+func (_u *DuplicateReviewUpdate) AddSimilarity(v float64) *DuplicateReviewUpdate {
+	_u.mutation.AddSimilarity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DuplicateReviewUpdate) SetStatus(v duplicatereview.Status) *DuplicateReviewUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableStatus(v *duplicatereview.Status) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *DuplicateReviewUpdate) SetReviewedBy(v uuid.UUID) *DuplicateReviewUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableReviewedBy(v *uuid.UUID) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *DuplicateReviewUpdate) ClearReviewedBy() *DuplicateReviewUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *DuplicateReviewUpdate) SetReviewedAt(v time.Time) *DuplicateReviewUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableReviewedAt(v *time.Time) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *DuplicateReviewUpdate) ClearReviewedAt() *DuplicateReviewUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DuplicateReviewUpdate) SetCreatedAt(v time.Time) *DuplicateReviewUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DuplicateReviewUpdate) SetNillableCreatedAt(v *time.Time) *DuplicateReviewUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *DuplicateReviewUpdate) SetTrack(v *Track) *DuplicateReviewUpdate {
+	return _u.SetTrackID(v.ID)
+}
+
+// SetMatchID sets the "match" edge to the Track entity by ID.
+func (_u *DuplicateReviewUpdate) SetMatchID(id uuid.UUID) *DuplicateReviewUpdate {
+	_u.mutation.SetMatchID(id)
+	return _u
+}
+
+// SetMatch sets the "match" edge to the Track entity.
+func (_u *DuplicateReviewUpdate) SetMatch(v *Track) *DuplicateReviewUpdate {
+	return _u.SetMatchID(v.ID)
+}
+
+// Mutation returns the DuplicateReviewMutation object of the builder.
+func (_u *DuplicateReviewUpdate) Mutation() *DuplicateReviewMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *DuplicateReviewUpdate) ClearTrack() *DuplicateReviewUpdate {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// ClearMatch clears the "match" edge to the Track entity.
+func (_u *DuplicateReviewUpdate) ClearMatch() *DuplicateReviewUpdate {
+	_u.mutation.ClearMatch()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DuplicateReviewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DuplicateReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DuplicateReviewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DuplicateReviewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DuplicateReviewUpdate) check() error {
+	if v, ok := _u.mutation.Similarity(); ok {
+		if err := duplicatereview.SimilarityValidator(v); err != nil {
+			return &ValidationError{Name: "similarity", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.similarity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := duplicatereview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DuplicateReview.track"`)
+	}
+	if _u.mutation.MatchCleared() && len(_u.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DuplicateReview.match"`)
+	}
+	return nil
+}
+
+func (_u *DuplicateReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(duplicatereview.Table, duplicatereview.Columns, sqlgraph.NewFieldSpec(duplicatereview.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Similarity(); ok {
+		_spec.SetField(duplicatereview.FieldSimilarity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSimilarity(); ok {
+		_spec.AddField(duplicatereview.FieldSimilarity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(duplicatereview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(duplicatereview.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(duplicatereview.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(duplicatereview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.TrackTable,
+			Columns: []string{duplicatereview.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.TrackTable,
+			Columns: []string{duplicatereview.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.MatchTable,
+			Columns: []string{duplicatereview.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.MatchTable,
+			Columns: []string{duplicatereview.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{duplicatereview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DuplicateReviewUpdateOne is the builder for updating a single DuplicateReview entity.
+type DuplicateReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DuplicateReviewMutation
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *DuplicateReviewUpdateOne) SetTrackID(v uuid.UUID) *DuplicateReviewUpdateOne {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableTrackID(v *uuid.UUID) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetMatchTrackID sets the "match_track_id" field.
+func (_u *DuplicateReviewUpdateOne) SetMatchTrackID(v uuid.UUID) *DuplicateReviewUpdateOne {
+	_u.mutation.SetMatchTrackID(v)
+	return _u
+}
+
+// SetNillableMatchTrackID sets the "match_track_id" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableMatchTrackID(v *uuid.UUID) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetMatchTrackID(*v)
+	}
+	return _u
+}
+
+// SetSimilarity sets the "similarity" field.
+func (_u *DuplicateReviewUpdateOne) SetSimilarity(v float64) *DuplicateReviewUpdateOne {
+	_u.mutation.ResetSimilarity()
+	_u.mutation.SetSimilarity(v)
+	return _u
+}
+
+// SetNillableSimilarity sets the "similarity" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableSimilarity(v *float64) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetSimilarity(*v)
+	}
+	return _u
+}
+
+// AddSimilarity adds value to the "similarity" field.
+func (_u *DuplicateReviewUpdateOne) AddSimilarity(v float64) *DuplicateReviewUpdateOne {
+	_u.mutation.AddSimilarity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DuplicateReviewUpdateOne) SetStatus(v duplicatereview.Status) *DuplicateReviewUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableStatus(v *duplicatereview.Status) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *DuplicateReviewUpdateOne) SetReviewedBy(v uuid.UUID) *DuplicateReviewUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableReviewedBy(v *uuid.UUID) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *DuplicateReviewUpdateOne) ClearReviewedBy() *DuplicateReviewUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *DuplicateReviewUpdateOne) SetReviewedAt(v time.Time) *DuplicateReviewUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableReviewedAt(v *time.Time) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *DuplicateReviewUpdateOne) ClearReviewedAt() *DuplicateReviewUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DuplicateReviewUpdateOne) SetCreatedAt(v time.Time) *DuplicateReviewUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DuplicateReviewUpdateOne) SetNillableCreatedAt(v *time.Time) *DuplicateReviewUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *DuplicateReviewUpdateOne) SetTrack(v *Track) *DuplicateReviewUpdateOne {
+	return _u.SetTrackID(v.ID)
+}
+
+// SetMatchID sets the "match" edge to the Track entity by ID.
+func (_u *DuplicateReviewUpdateOne) SetMatchID(id uuid.UUID) *DuplicateReviewUpdateOne {
+	_u.mutation.SetMatchID(id)
+	return _u
+}
+
+// SetMatch sets the "match" edge to the Track entity.
+func (_u *DuplicateReviewUpdateOne) SetMatch(v *Track) *DuplicateReviewUpdateOne {
+	return _u.SetMatchID(v.ID)
+}
+
+// Mutation returns the DuplicateReviewMutation object of the builder.
+func (_u *DuplicateReviewUpdateOne) Mutation() *DuplicateReviewMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *DuplicateReviewUpdateOne) ClearTrack() *DuplicateReviewUpdateOne {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// ClearMatch clears the "match" edge to the Track entity.
+func (_u *DuplicateReviewUpdateOne) ClearMatch() *DuplicateReviewUpdateOne {
+	_u.mutation.ClearMatch()
+	return _u
+}
+
+// Where appends a list predicates to the DuplicateReviewUpdate builder.
+func (_u *DuplicateReviewUpdateOne) Where(ps ...predicate.DuplicateReview) *DuplicateReviewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DuplicateReviewUpdateOne) Select(field string, fields ...string) *DuplicateReviewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DuplicateReview entity.
+func (_u *DuplicateReviewUpdateOne) Save(ctx context.Context) (*DuplicateReview, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DuplicateReviewUpdateOne) SaveX(ctx context.Context) *DuplicateReview {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DuplicateReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DuplicateReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DuplicateReviewUpdateOne) check() error {
+	if v, ok := _u.mutation.Similarity(); ok {
+		if err := duplicatereview.SimilarityValidator(v); err != nil {
+			return &ValidationError{Name: "similarity", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.similarity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := duplicatereview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DuplicateReview.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DuplicateReview.track"`)
+	}
+	if _u.mutation.MatchCleared() && len(_u.mutation.MatchIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DuplicateReview.match"`)
+	}
+	return nil
+}
+
+func (_u *DuplicateReviewUpdateOne) sqlSave(ctx context.Context) (_node *DuplicateReview, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(duplicatereview.Table, duplicatereview.Columns, sqlgraph.NewFieldSpec(duplicatereview.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DuplicateReview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, duplicatereview.FieldID)
+		for _, f := range fields {
+			if !duplicatereview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != duplicatereview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Similarity(); ok {
+		_spec.SetField(duplicatereview.FieldSimilarity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSimilarity(); ok {
+		_spec.AddField(duplicatereview.FieldSimilarity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(duplicatereview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(duplicatereview.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(duplicatereview.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(duplicatereview.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(duplicatereview.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.TrackTable,
+			Columns: []string{duplicatereview.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.TrackTable,
+			Columns: []string{duplicatereview.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.MatchTable,
+			Columns: []string{duplicatereview.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   duplicatereview.MatchTable,
+			Columns: []string{duplicatereview.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DuplicateReview{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{duplicatereview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,9 +8,47 @@ import (
 	"fmt"
 	"reflect"
 	"streamify/ent/album"
+	"streamify/ent/apikey"
+	"streamify/ent/apikeyusage"
 	"streamify/ent/artist"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/auditlog"
+	"streamify/ent/backup"
+	"streamify/ent/block"
+	"streamify/ent/changelogentry"
+	"streamify/ent/confirmation"
+	"streamify/ent/deadletter"
+	"streamify/ent/deviceauthorization"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/entitlement"
+	"streamify/ent/externalidentity"
+	"streamify/ent/follow"
+	"streamify/ent/gueststate"
+	"streamify/ent/invite"
+	"streamify/ent/like"
+	"streamify/ent/operation"
+	"streamify/ent/play"
+	"streamify/ent/playcount"
+	"streamify/ent/playlist"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/referral"
+	"streamify/ent/scimgroup"
+	"streamify/ent/securityalert"
+	"streamify/ent/sharelink"
+	"streamify/ent/signingkey"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/ent/tombstone"
 	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+	"streamify/ent/uploadsession"
 	"streamify/ent/user"
+	"streamify/ent/waitlistentry"
 	"sync"
 
 	"entgo.io/ent"
@@ -76,10 +114,48 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			album.Table:  album.ValidColumn,
-			artist.Table: artist.ValidColumn,
-			track.Table:  track.ValidColumn,
-			user.Table:   user.ValidColumn,
+			apikey.Table:              apikey.ValidColumn,
+			apikeyusage.Table:         apikeyusage.ValidColumn,
+			album.Table:               album.ValidColumn,
+			artist.Table:              artist.ValidColumn,
+			audiofingerprint.Table:    audiofingerprint.ValidColumn,
+			auditlog.Table:            auditlog.ValidColumn,
+			backup.Table:              backup.ValidColumn,
+			block.Table:               block.ValidColumn,
+			changelogentry.Table:      changelogentry.ValidColumn,
+			confirmation.Table:        confirmation.ValidColumn,
+			deadletter.Table:          deadletter.ValidColumn,
+			deviceauthorization.Table: deviceauthorization.ValidColumn,
+			duplicatereview.Table:     duplicatereview.ValidColumn,
+			entitlement.Table:         entitlement.ValidColumn,
+			externalidentity.Table:    externalidentity.ValidColumn,
+			follow.Table:              follow.ValidColumn,
+			gueststate.Table:          gueststate.ValidColumn,
+			invite.Table:              invite.ValidColumn,
+			like.Table:                like.ValidColumn,
+			operation.Table:           operation.ValidColumn,
+			play.Table:                play.ValidColumn,
+			playcount.Table:           playcount.ValidColumn,
+			playlist.Table:            playlist.ValidColumn,
+			policyacceptance.Table:    policyacceptance.ValidColumn,
+			policyversion.Table:       policyversion.ValidColumn,
+			promocode.Table:           promocode.ValidColumn,
+			promoredemption.Table:     promoredemption.ValidColumn,
+			referral.Table:            referral.ValidColumn,
+			scimgroup.Table:           scimgroup.ValidColumn,
+			ssoprovider.Table:         ssoprovider.ValidColumn,
+			securityalert.Table:       securityalert.ValidColumn,
+			sharelink.Table:           sharelink.ValidColumn,
+			signingkey.Table:          signingkey.ValidColumn,
+			supportattachment.Table:   supportattachment.ValidColumn,
+			supportreply.Table:        supportreply.ValidColumn,
+			supportticket.Table:       supportticket.ValidColumn,
+			tombstone.Table:           tombstone.ValidColumn,
+			track.Table:               track.ValidColumn,
+			trackcredit.Table:         trackcredit.ValidColumn,
+			uploadsession.Table:       uploadsession.ValidColumn,
+			user.Table:                user.ValidColumn,
+			waitlistentry.Table:       waitlistentry.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entitlement
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the entitlement type in the database.
+	Label = "entitlement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldFeature holds the string denoting the feature field in the database.
+	FieldFeature = "feature"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the entitlement in the database.
+	Table = "entitlements"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "entitlements"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for entitlement fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldFeature,
+	FieldSource,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Feature defines the type for the "feature" enum field.
+type Feature string
+
+// Feature values.
+const (
+	FeaturePremium   Feature = "premium"
+	FeatureDownloads Feature = "downloads"
+)
+
+func (f Feature) String() string {
+	return string(f)
+}
+
+// FeatureValidator is a validator for the "feature" field enum values. It is called by the builders before save.
+func FeatureValidator(f Feature) error {
+	switch f {
+	case FeaturePremium, FeatureDownloads:
+		return nil
+	default:
+		return fmt.Errorf("entitlement: invalid enum value for feature field: %q", f)
+	}
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// SourceAdmin is the default value of the Source enum.
+const DefaultSource = SourceAdmin
+
+// Source values.
+const (
+	SourceAdmin    Source = "admin"
+	SourcePromo    Source = "promo"
+	SourceReferral Source = "referral"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceAdmin, SourcePromo, SourceReferral:
+		return nil
+	default:
+		return fmt.Errorf("entitlement: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Entitlement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByFeature orders the results by the feature field.
+func ByFeature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeature, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
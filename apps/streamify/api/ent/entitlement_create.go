@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/entitlement"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EntitlementCreate is the builder for creating a Entitlement entity.
+type EntitlementCreate struct {
+	config
+	mutation *EntitlementMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EntitlementCreate) SetUserID(v uuid.UUID) *EntitlementCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFeature sets the "feature" field.
+func (_c *EntitlementCreate) SetFeature(v entitlement.Feature) *EntitlementCreate {
+	_c.mutation.SetFeature(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *EntitlementCreate) SetSource(v entitlement.Source) *EntitlementCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableSource(v *entitlement.Source) *EntitlementCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *EntitlementCreate) SetExpiresAt(v time.Time) *EntitlementCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableExpiresAt(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntitlementCreate) SetCreatedAt(v time.Time) *EntitlementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableCreatedAt(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EntitlementCreate) SetID(v uuid.UUID) *EntitlementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableID(v *uuid.UUID) *EntitlementCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *EntitlementCreate) SetUser(v *User) *EntitlementCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_c *EntitlementCreate) Mutation() *EntitlementMutation {
+	return _c.mutation
+}
+
+// Save creates the Entitlement in the database.
+func (_c *EntitlementCreate) Save(ctx context.Context) (*Entitlement, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntitlementCreate) SaveX(ctx context.Context) *Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntitlementCreate) defaults() error {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := entitlement.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if entitlement.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized entitlement.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := entitlement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if entitlement.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized entitlement.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := entitlement.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntitlementCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Entitlement.user_id"`)}
+	}
+	if _, ok := _c.mutation.Feature(); !ok {
+		return &ValidationError{Name: "feature", err: errors.New(`ent: missing required field "Entitlement.feature"`)}
+	}
+	if v, ok := _c.mutation.Feature(); ok {
+		if err := entitlement.FeatureValidator(v); err != nil {
+			return &ValidationError{Name: "feature", err: fmt.Errorf(`ent: validator failed for field "Entitlement.feature": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Entitlement.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := entitlement.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Entitlement.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Entitlement.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Entitlement.user"`)}
+	}
+	return nil
+}
+
+func (_c *EntitlementCreate) sqlSave(ctx context.Context) (*Entitlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntitlementCreate) createSpec() (*Entitlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Entitlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entitlement.Table, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Feature(); ok {
+		_spec.SetField(entitlement.FieldFeature, field.TypeEnum, value)
+		_node.Feature = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(entitlement.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(entitlement.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entitlement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   entitlement.UserTable,
+			Columns: []string{entitlement.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EntitlementCreateBulk is the builder for creating many Entitlement entities in bulk.
+type EntitlementCreateBulk struct {
+	config
+	err      error
+	builders []*EntitlementCreate
+}
+
+// Save creates the Entitlement entities in the database.
+func (_c *EntitlementCreateBulk) Save(ctx context.Context) ([]*Entitlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Entitlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntitlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) SaveX(ctx context.Context) []*Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/externalidentity"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ExternalIdentity is the model entity for the ExternalIdentity schema.
+type ExternalIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The SSO provider
+	ProviderID uuid.UUID `json:"provider_id,omitempty"`
+	// The provider's stable ID for the account, the ID token's sub claim. Unset until an account provisioned through SCIM first signs in.
+	Subject *string `json:"subject,omitempty"`
+	// The provider's ID for an account provisioned through SCIM
+	ExternalID *string `json:"external_id,omitempty"`
+	// Managed by the provider through SCIM
+	Provisioned bool `json:"provisioned,omitempty"`
+	// The local account
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the user last signed in through the provider
+	LastLoginAt time.Time `json:"last_login_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExternalIdentityQuery when eager-loading is set.
+	Edges        ExternalIdentityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExternalIdentityEdges holds the relations/edges for other nodes in the graph.
+type ExternalIdentityEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *SSOProvider `json:"provider,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExternalIdentityEdges) ProviderOrErr() (*SSOProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ssoprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExternalIdentityEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExternalIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case externalidentity.FieldProvisioned:
+			values[i] = new(sql.NullBool)
+		case externalidentity.FieldSubject, externalidentity.FieldExternalID:
+			values[i] = new(sql.NullString)
+		case externalidentity.FieldCreatedAt, externalidentity.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		case externalidentity.FieldID, externalidentity.FieldProviderID, externalidentity.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExternalIdentity fields.
+func (_m *ExternalIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case externalidentity.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case externalidentity.FieldProviderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value != nil {
+				_m.ProviderID = *value
+			}
+		case externalidentity.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = new(string)
+				*_m.Subject = value.String
+			}
+		case externalidentity.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = new(string)
+				*_m.ExternalID = value.String
+			}
+		case externalidentity.FieldProvisioned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field provisioned", values[i])
+			} else if value.Valid {
+				_m.Provisioned = value.Bool
+			}
+		case externalidentity.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case externalidentity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case externalidentity.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExternalIdentity.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExternalIdentity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ExternalIdentity entity.
+func (_m *ExternalIdentity) QueryProvider() *SSOProviderQuery {
+	return NewExternalIdentityClient(_m.config).QueryProvider(_m)
+}
+
+// QueryUser queries the "user" edge of the ExternalIdentity entity.
+func (_m *ExternalIdentity) QueryUser() *UserQuery {
+	return NewExternalIdentityClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ExternalIdentity.
+// Note that you need to call ExternalIdentity.Unwrap() before calling this method if this ExternalIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExternalIdentity) Update() *ExternalIdentityUpdateOne {
+	return NewExternalIdentityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExternalIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExternalIdentity) Unwrap() *ExternalIdentity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExternalIdentity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExternalIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExternalIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	if v := _m.Subject; v != nil {
+		builder.WriteString("subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provisioned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Provisioned))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_login_at=")
+	builder.WriteString(_m.LastLoginAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExternalIdentities is a parsable slice of ExternalIdentity.
+type ExternalIdentities []*ExternalIdentity
@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/externalidentity"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ExternalIdentityCreate is the builder for creating a ExternalIdentity entity.
+type ExternalIdentityCreate struct {
+	config
+	mutation *ExternalIdentityMutation
+	hooks    []Hook
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *ExternalIdentityCreate) SetProviderID(v uuid.UUID) *ExternalIdentityCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ExternalIdentityCreate) SetSubject(v string) *ExternalIdentityCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableSubject(v *string) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *ExternalIdentityCreate) SetExternalID(v string) *ExternalIdentityCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableExternalID(v *string) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetProvisioned sets the "provisioned" field.
+func (_c *ExternalIdentityCreate) SetProvisioned(v bool) *ExternalIdentityCreate {
+	_c.mutation.SetProvisioned(v)
+	return _c
+}
+
+// SetNillableProvisioned sets the "provisioned" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableProvisioned(v *bool) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetProvisioned(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ExternalIdentityCreate) SetUserID(v uuid.UUID) *ExternalIdentityCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExternalIdentityCreate) SetCreatedAt(v time.Time) *ExternalIdentityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableCreatedAt(v *time.Time) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_c *ExternalIdentityCreate) SetLastLoginAt(v time.Time) *ExternalIdentityCreate {
+	_c.mutation.SetLastLoginAt(v)
+	return _c
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableLastLoginAt(v *time.Time) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetLastLoginAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExternalIdentityCreate) SetID(v uuid.UUID) *ExternalIdentityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExternalIdentityCreate) SetNillableID(v *uuid.UUID) *ExternalIdentityCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" edge to the SSOProvider entity.
+func (_c *ExternalIdentityCreate) SetProvider(v *SSOProvider) *ExternalIdentityCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ExternalIdentityCreate) SetUser(v *User) *ExternalIdentityCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (_c *ExternalIdentityCreate) Mutation() *ExternalIdentityMutation {
+	return _c.mutation
+}
+
+// Save creates the ExternalIdentity in the database.
+func (_c *ExternalIdentityCreate) Save(ctx context.Context) (*ExternalIdentity, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExternalIdentityCreate) SaveX(ctx context.Context) *ExternalIdentity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExternalIdentityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExternalIdentityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExternalIdentityCreate) defaults() {
+	if _, ok := _c.mutation.Provisioned(); !ok {
+		v := externalidentity.DefaultProvisioned
+		_c.mutation.SetProvisioned(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := externalidentity.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.LastLoginAt(); !ok {
+		v := externalidentity.DefaultLastLoginAt()
+		_c.mutation.SetLastLoginAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := externalidentity.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExternalIdentityCreate) check() error {
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "ExternalIdentity.provider_id"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := externalidentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ExternalIdentity.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provisioned(); !ok {
+		return &ValidationError{Name: "provisioned", err: errors.New(`ent: missing required field "ExternalIdentity.provisioned"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ExternalIdentity.user_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExternalIdentity.created_at"`)}
+	}
+	if _, ok := _c.mutation.LastLoginAt(); !ok {
+		return &ValidationError{Name: "last_login_at", err: errors.New(`ent: missing required field "ExternalIdentity.last_login_at"`)}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ExternalIdentity.provider"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ExternalIdentity.user"`)}
+	}
+	return nil
+}
+
+func (_c *ExternalIdentityCreate) sqlSave(ctx context.Context) (*ExternalIdentity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExternalIdentityCreate) createSpec() (*ExternalIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExternalIdentity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(externalidentity.Table, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+		_node.Subject = &value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(externalidentity.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
+	if value, ok := _c.mutation.Provisioned(); ok {
+		_spec.SetField(externalidentity.FieldProvisioned, field.TypeBool, value)
+		_node.Provisioned = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(externalidentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.LastLoginAt(); ok {
+		_spec.SetField(externalidentity.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = value
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   externalidentity.ProviderTable,
+			Columns: []string{externalidentity.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoprovider.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   externalidentity.UserTable,
+			Columns: []string{externalidentity.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExternalIdentityCreateBulk is the builder for creating many ExternalIdentity entities in bulk.
+type ExternalIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*ExternalIdentityCreate
+}
+
+// Save creates the ExternalIdentity entities in the database.
+func (_c *ExternalIdentityCreateBulk) Save(ctx context.Context) ([]*ExternalIdentity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExternalIdentity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExternalIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExternalIdentityCreateBulk) SaveX(ctx context.Context) []*ExternalIdentity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExternalIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExternalIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/externalidentity"
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExternalIdentityUpdate is the builder for updating ExternalIdentity entities.
+type ExternalIdentityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (_u *ExternalIdentityUpdate) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ExternalIdentityUpdate) SetSubject(v string) *ExternalIdentityUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ExternalIdentityUpdate) SetNillableSubject(v *string) *ExternalIdentityUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *ExternalIdentityUpdate) ClearSubject() *ExternalIdentityUpdate {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ExternalIdentityUpdate) SetExternalID(v string) *ExternalIdentityUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ExternalIdentityUpdate) SetNillableExternalID(v *string) *ExternalIdentityUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *ExternalIdentityUpdate) ClearExternalID() *ExternalIdentityUpdate {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetProvisioned sets the "provisioned" field.
+func (_u *ExternalIdentityUpdate) SetProvisioned(v bool) *ExternalIdentityUpdate {
+	_u.mutation.SetProvisioned(v)
+	return _u
+}
+
+// SetNillableProvisioned sets the "provisioned" field if the given value is not nil.
+func (_u *ExternalIdentityUpdate) SetNillableProvisioned(v *bool) *ExternalIdentityUpdate {
+	if v != nil {
+		_u.SetProvisioned(*v)
+	}
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *ExternalIdentityUpdate) SetLastLoginAt(v time.Time) *ExternalIdentityUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *ExternalIdentityUpdate) SetNillableLastLoginAt(v *time.Time) *ExternalIdentityUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (_u *ExternalIdentityUpdate) Mutation() *ExternalIdentityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExternalIdentityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExternalIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExternalIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExternalIdentityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExternalIdentityUpdate) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := externalidentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ExternalIdentity.subject": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.provider"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (_u *ExternalIdentityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(externalidentity.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(externalidentity.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(externalidentity.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provisioned(); ok {
+		_spec.SetField(externalidentity.FieldProvisioned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(externalidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExternalIdentityUpdateOne is the builder for updating a single ExternalIdentity entity.
+type ExternalIdentityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExternalIdentityMutation
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ExternalIdentityUpdateOne) SetSubject(v string) *ExternalIdentityUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ExternalIdentityUpdateOne) SetNillableSubject(v *string) *ExternalIdentityUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *ExternalIdentityUpdateOne) ClearSubject() *ExternalIdentityUpdateOne {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ExternalIdentityUpdateOne) SetExternalID(v string) *ExternalIdentityUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ExternalIdentityUpdateOne) SetNillableExternalID(v *string) *ExternalIdentityUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *ExternalIdentityUpdateOne) ClearExternalID() *ExternalIdentityUpdateOne {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetProvisioned sets the "provisioned" field.
+func (_u *ExternalIdentityUpdateOne) SetProvisioned(v bool) *ExternalIdentityUpdateOne {
+	_u.mutation.SetProvisioned(v)
+	return _u
+}
+
+// SetNillableProvisioned sets the "provisioned" field if the given value is not nil.
+func (_u *ExternalIdentityUpdateOne) SetNillableProvisioned(v *bool) *ExternalIdentityUpdateOne {
+	if v != nil {
+		_u.SetProvisioned(*v)
+	}
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *ExternalIdentityUpdateOne) SetLastLoginAt(v time.Time) *ExternalIdentityUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *ExternalIdentityUpdateOne) SetNillableLastLoginAt(v *time.Time) *ExternalIdentityUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExternalIdentityMutation object of the builder.
+func (_u *ExternalIdentityUpdateOne) Mutation() *ExternalIdentityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExternalIdentityUpdate builder.
+func (_u *ExternalIdentityUpdateOne) Where(ps ...predicate.ExternalIdentity) *ExternalIdentityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExternalIdentityUpdateOne) Select(field string, fields ...string) *ExternalIdentityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExternalIdentity entity.
+func (_u *ExternalIdentityUpdateOne) Save(ctx context.Context) (*ExternalIdentity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExternalIdentityUpdateOne) SaveX(ctx context.Context) *ExternalIdentity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExternalIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExternalIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExternalIdentityUpdateOne) check() error {
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := externalidentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ExternalIdentity.subject": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.provider"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExternalIdentity.user"`)
+	}
+	return nil
+}
+
+func (_u *ExternalIdentityUpdateOne) sqlSave(ctx context.Context) (_node *ExternalIdentity, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externalidentity.Table, externalidentity.Columns, sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExternalIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externalidentity.FieldID)
+		for _, f := range fields {
+			if !externalidentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != externalidentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(externalidentity.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(externalidentity.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(externalidentity.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(externalidentity.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provisioned(); ok {
+		_spec.SetField(externalidentity.FieldProvisioned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(externalidentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	_node = &ExternalIdentity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externalidentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
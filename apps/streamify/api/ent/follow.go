@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/follow"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Follow is the model entity for the Follow schema.
+type Follow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user following
+	FollowerID uuid.UUID `json:"follower_id,omitempty"`
+	// The user followed
+	FolloweeID uuid.UUID `json:"followee_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FollowQuery when eager-loading is set.
+	Edges        FollowEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FollowEdges holds the relations/edges for other nodes in the graph.
+type FollowEdges struct {
+	// Follower holds the value of the follower edge.
+	Follower *User `json:"follower,omitempty"`
+	// Followee holds the value of the followee edge.
+	Followee *User `json:"followee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FollowerOrErr returns the Follower value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FollowerOrErr() (*User, error) {
+	if e.Follower != nil {
+		return e.Follower, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "follower"}
+}
+
+// FolloweeOrErr returns the Followee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FollowEdges) FolloweeOrErr() (*User, error) {
+	if e.Followee != nil {
+		return e.Followee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "followee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Follow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case follow.FieldID, follow.FieldFollowerID, follow.FieldFolloweeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Follow fields.
+func (_m *Follow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case follow.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case follow.FieldFollowerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field follower_id", values[i])
+			} else if value != nil {
+				_m.FollowerID = *value
+			}
+		case follow.FieldFolloweeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field followee_id", values[i])
+			} else if value != nil {
+				_m.FolloweeID = *value
+			}
+		case follow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Follow.
+// This includes values selected through modifiers, order, etc.
+func (_m *Follow) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFollower queries the "follower" edge of the Follow entity.
+func (_m *Follow) QueryFollower() *UserQuery {
+	return NewFollowClient(_m.config).QueryFollower(_m)
+}
+
+// QueryFollowee queries the "followee" edge of the Follow entity.
+func (_m *Follow) QueryFollowee() *UserQuery {
+	return NewFollowClient(_m.config).QueryFollowee(_m)
+}
+
+// Update returns a builder for updating this Follow.
+// Note that you need to call Follow.Unwrap() before calling this method if this Follow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Follow) Update() *FollowUpdateOne {
+	return NewFollowClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Follow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Follow) Unwrap() *Follow {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Follow is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Follow) String() string {
+	var builder strings.Builder
+	builder.WriteString("Follow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("follower_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FollowerID))
+	builder.WriteString(", ")
+	builder.WriteString("followee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FolloweeID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Follows is a parsable slice of Follow.
+type Follows []*Follow
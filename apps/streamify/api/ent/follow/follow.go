@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package follow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the follow type in the database.
+	Label = "follow"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFollowerID holds the string denoting the follower_id field in the database.
+	FieldFollowerID = "follower_id"
+	// FieldFolloweeID holds the string denoting the followee_id field in the database.
+	FieldFolloweeID = "followee_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFollower holds the string denoting the follower edge name in mutations.
+	EdgeFollower = "follower"
+	// EdgeFollowee holds the string denoting the followee edge name in mutations.
+	EdgeFollowee = "followee"
+	// Table holds the table name of the follow in the database.
+	Table = "follows"
+	// FollowerTable is the table that holds the follower relation/edge.
+	FollowerTable = "follows"
+	// FollowerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FollowerInverseTable = "users"
+	// FollowerColumn is the table column denoting the follower relation/edge.
+	FollowerColumn = "follower_id"
+	// FolloweeTable is the table that holds the followee relation/edge.
+	FolloweeTable = "follows"
+	// FolloweeInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	FolloweeInverseTable = "users"
+	// FolloweeColumn is the table column denoting the followee relation/edge.
+	FolloweeColumn = "followee_id"
+)
+
+// Columns holds all SQL columns for follow fields.
+var Columns = []string{
+	FieldID,
+	FieldFollowerID,
+	FieldFolloweeID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Follow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFollowerID orders the results by the follower_id field.
+func ByFollowerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowerID, opts...).ToFunc()
+}
+
+// ByFolloweeID orders the results by the followee_id field.
+func ByFolloweeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolloweeID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFollowerField orders the results by follower field.
+func ByFollowerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFolloweeField orders the results by followee field.
+func ByFolloweeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFolloweeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFollowerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, FollowerTable, FollowerColumn),
+	)
+}
+func newFolloweeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FolloweeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, FolloweeTable, FolloweeColumn),
+	)
+}
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/follow"
+	"streamify/ent/predicate"
+	"streamify/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FollowUpdate is the builder for updating Follow entities.
+type FollowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FollowMutation
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (_u *FollowUpdate) Where(ps ...predicate.Follow) *FollowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (_u *FollowUpdate) SetFollowerID(v uuid.UUID) *FollowUpdate {
+	_u.mutation.SetFollowerID(v)
+	return _u
+}
+
+// SetNillableFollowerID sets the "follower_id" field if the given value is not nil.
+func (_u *FollowUpdate) SetNillableFollowerID(v *uuid.UUID) *FollowUpdate {
+	if v != nil {
+		_u.SetFollowerID(*v)
+	}
+	return _u
+}
+
+// SetFolloweeID sets the "followee_id" field.
+func (_u *FollowUpdate) SetFolloweeID(v uuid.UUID) *FollowUpdate {
+	_u.mutation.SetFolloweeID(v)
+	return _u
+}
+
+// SetNillableFolloweeID sets the "followee_id" field if the given value is not nil.
+func (_u *FollowUpdate) SetNillableFolloweeID(v *uuid.UUID) *FollowUpdate {
+	if v != nil {
+		_u.SetFolloweeID(*v)
+	}
+	return _u
+}
+
+// SetFollower sets the "follower" edge to the User entity.
+func (_u *FollowUpdate) SetFollower(v *User) *FollowUpdate {
+	return _u.SetFollowerID(v.ID)
+}
+
+// SetFollowee sets the "followee" edge to the User entity.
+func (_u *FollowUpdate) SetFollowee(v *User) *FollowUpdate {
+	return _u.SetFolloweeID(v.ID)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (_u *FollowUpdate) Mutation() *FollowMutation {
+	return _u.mutation
+}
+
+// ClearFollower clears the "follower" edge to the User entity.
+func (_u *FollowUpdate) ClearFollower() *FollowUpdate {
+	_u.mutation.ClearFollower()
+	return _u
+}
+
+// ClearFollowee clears the "followee" edge to the User entity.
+func (_u *FollowUpdate) ClearFollowee() *FollowUpdate {
+	_u.mutation.ClearFollowee()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FollowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FollowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FollowUpdate) check() error {
+	if _u.mutation.FollowerCleared() && len(_u.mutation.FollowerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _u.mutation.FolloweeCleared() && len(_u.mutation.FolloweeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+func (_u *FollowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FollowerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FolloweeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolloweeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FollowUpdateOne is the builder for updating a single Follow entity.
+type FollowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FollowMutation
+}
+
+// SetFollowerID sets the "follower_id" field.
+func (_u *FollowUpdateOne) SetFollowerID(v uuid.UUID) *FollowUpdateOne {
+	_u.mutation.SetFollowerID(v)
+	return _u
+}
+
+// SetNillableFollowerID sets the "follower_id" field if the given value is not nil.
+func (_u *FollowUpdateOne) SetNillableFollowerID(v *uuid.UUID) *FollowUpdateOne {
+	if v != nil {
+		_u.SetFollowerID(*v)
+	}
+	return _u
+}
+
+// SetFolloweeID sets the "followee_id" field.
+func (_u *FollowUpdateOne) SetFolloweeID(v uuid.UUID) *FollowUpdateOne {
+	_u.mutation.SetFolloweeID(v)
+	return _u
+}
+
+// SetNillableFolloweeID sets the "followee_id" field if the given value is not nil.
+func (_u *FollowUpdateOne) SetNillableFolloweeID(v *uuid.UUID) *FollowUpdateOne {
+	if v != nil {
+		_u.SetFolloweeID(*v)
+	}
+	return _u
+}
+
+// SetFollower sets the "follower" edge to the User entity.
+func (_u *FollowUpdateOne) SetFollower(v *User) *FollowUpdateOne {
+	return _u.SetFollowerID(v.ID)
+}
+
+// SetFollowee sets the "followee" edge to the User entity.
+func (_u *FollowUpdateOne) SetFollowee(v *User) *FollowUpdateOne {
+	return _u.SetFolloweeID(v.ID)
+}
+
+// Mutation returns the FollowMutation object of the builder.
+func (_u *FollowUpdateOne) Mutation() *FollowMutation {
+	return _u.mutation
+}
+
+// ClearFollower clears the "follower" edge to the User entity.
+func (_u *FollowUpdateOne) ClearFollower() *FollowUpdateOne {
+	_u.mutation.ClearFollower()
+	return _u
+}
+
+// ClearFollowee clears the "followee" edge to the User entity.
+func (_u *FollowUpdateOne) ClearFollowee() *FollowUpdateOne {
+	_u.mutation.ClearFollowee()
+	return _u
+}
+
+// Where appends a list predicates to the FollowUpdate builder.
+func (_u *FollowUpdateOne) Where(ps ...predicate.Follow) *FollowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FollowUpdateOne) Select(field string, fields ...string) *FollowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Follow entity.
+func (_u *FollowUpdateOne) Save(ctx context.Context) (*Follow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FollowUpdateOne) SaveX(ctx context.Context) *Follow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FollowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FollowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FollowUpdateOne) check() error {
+	if _u.mutation.FollowerCleared() && len(_u.mutation.FollowerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.follower"`)
+	}
+	if _u.mutation.FolloweeCleared() && len(_u.mutation.FolloweeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Follow.followee"`)
+	}
+	return nil
+}
+
+func (_u *FollowUpdateOne) sqlSave(ctx context.Context) (_node *Follow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(follow.Table, follow.Columns, sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Follow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, follow.FieldID)
+		for _, f := range fields {
+			if !follow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != follow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FollowerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FollowerTable,
+			Columns: []string{follow.FollowerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FolloweeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolloweeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   follow.FolloweeTable,
+			Columns: []string{follow.FolloweeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Follow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{follow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
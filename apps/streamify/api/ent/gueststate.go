@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"streamify/ent/gueststate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GuestState is the model entity for the GuestState schema.
+type GuestState struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The guest_id from the guest token
+	ID uuid.UUID `json:"id,omitempty"`
+	// Ordered track IDs of the guest's play queue
+	Queue []uuid.UUID `json:"queue,omitempty"`
+	// IDs of the tracks the guest liked, moved to the account on sign-up
+	Likes []uuid.UUID `json:"likes,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// When the guest token expires and the state can be deleted
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuestState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gueststate.FieldQueue, gueststate.FieldLikes:
+			values[i] = new([]byte)
+		case gueststate.FieldUpdatedAt, gueststate.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case gueststate.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuestState fields.
+func (_m *GuestState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gueststate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case gueststate.FieldQueue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Queue); err != nil {
+					return fmt.Errorf("unmarshal field queue: %w", err)
+				}
+			}
+		case gueststate.FieldLikes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field likes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Likes); err != nil {
+					return fmt.Errorf("unmarshal field likes: %w", err)
+				}
+			}
+		case gueststate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case gueststate.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuestState.
+// This includes values selected through modifiers, order, etc.
+func (_m *GuestState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GuestState.
+// Note that you need to call GuestState.Unwrap() before calling this method if this GuestState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GuestState) Update() *GuestStateUpdateOne {
+	return NewGuestStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GuestState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GuestState) Unwrap() *GuestState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuestState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GuestState) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuestState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("queue=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Queue))
+	builder.WriteString(", ")
+	builder.WriteString("likes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Likes))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuestStates is a parsable slice of GuestState.
+type GuestStates []*GuestState
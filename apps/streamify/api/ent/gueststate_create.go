@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/gueststate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GuestStateCreate is the builder for creating a GuestState entity.
+type GuestStateCreate struct {
+	config
+	mutation *GuestStateMutation
+	hooks    []Hook
+}
+
+// SetQueue sets the "queue" field.
+func (_c *GuestStateCreate) SetQueue(v []uuid.UUID) *GuestStateCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetLikes sets the "likes" field.
+func (_c *GuestStateCreate) SetLikes(v []uuid.UUID) *GuestStateCreate {
+	_c.mutation.SetLikes(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GuestStateCreate) SetUpdatedAt(v time.Time) *GuestStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GuestStateCreate) SetNillableUpdatedAt(v *time.Time) *GuestStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *GuestStateCreate) SetExpiresAt(v time.Time) *GuestStateCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GuestStateCreate) SetID(v uuid.UUID) *GuestStateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GuestStateMutation object of the builder.
+func (_c *GuestStateCreate) Mutation() *GuestStateMutation {
+	return _c.mutation
+}
+
+// Save creates the GuestState in the database.
+func (_c *GuestStateCreate) Save(ctx context.Context) (*GuestState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GuestStateCreate) SaveX(ctx context.Context) *GuestState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuestStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuestStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GuestStateCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := gueststate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GuestStateCreate) check() error {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GuestState.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "GuestState.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *GuestStateCreate) sqlSave(ctx context.Context) (*GuestState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GuestStateCreate) createSpec() (*GuestState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuestState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gueststate.Table, sqlgraph.NewFieldSpec(gueststate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(gueststate.FieldQueue, field.TypeJSON, value)
+		_node.Queue = value
+	}
+	if value, ok := _c.mutation.Likes(); ok {
+		_spec.SetField(gueststate.FieldLikes, field.TypeJSON, value)
+		_node.Likes = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(gueststate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(gueststate.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// GuestStateCreateBulk is the builder for creating many GuestState entities in bulk.
+type GuestStateCreateBulk struct {
+	config
+	err      error
+	builders []*GuestStateCreate
+}
+
+// Save creates the GuestState entities in the database.
+func (_c *GuestStateCreateBulk) Save(ctx context.Context) ([]*GuestState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GuestState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuestStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GuestStateCreateBulk) SaveX(ctx context.Context) []*GuestState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuestStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuestStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
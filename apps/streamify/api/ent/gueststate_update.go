@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/gueststate"
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GuestStateUpdate is the builder for updating GuestState entities.
+type GuestStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuestStateMutation
+}
+
+// Where appends a list predicates to the GuestStateUpdate builder.
+func (_u *GuestStateUpdate) Where(ps ...predicate.GuestState) *GuestStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *GuestStateUpdate) SetQueue(v []uuid.UUID) *GuestStateUpdate {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// AppendQueue appends value to the "queue" field.
+func (_u *GuestStateUpdate) AppendQueue(v []uuid.UUID) *GuestStateUpdate {
+	_u.mutation.AppendQueue(v)
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *GuestStateUpdate) ClearQueue() *GuestStateUpdate {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *GuestStateUpdate) SetLikes(v []uuid.UUID) *GuestStateUpdate {
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// AppendLikes appends value to the "likes" field.
+func (_u *GuestStateUpdate) AppendLikes(v []uuid.UUID) *GuestStateUpdate {
+	_u.mutation.AppendLikes(v)
+	return _u
+}
+
+// ClearLikes clears the value of the "likes" field.
+func (_u *GuestStateUpdate) ClearLikes() *GuestStateUpdate {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuestStateUpdate) SetUpdatedAt(v time.Time) *GuestStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *GuestStateUpdate) SetExpiresAt(v time.Time) *GuestStateUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *GuestStateUpdate) SetNillableExpiresAt(v *time.Time) *GuestStateUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GuestStateMutation object of the builder.
+func (_u *GuestStateUpdate) Mutation() *GuestStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GuestStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuestStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GuestStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuestStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuestStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := gueststate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *GuestStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gueststate.Table, gueststate.Columns, sqlgraph.NewFieldSpec(gueststate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(gueststate.FieldQueue, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gueststate.FieldQueue, value)
+		})
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(gueststate.FieldQueue, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(gueststate.FieldLikes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLikes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gueststate.FieldLikes, value)
+		})
+	}
+	if _u.mutation.LikesCleared() {
+		_spec.ClearField(gueststate.FieldLikes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(gueststate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(gueststate.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gueststate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GuestStateUpdateOne is the builder for updating a single GuestState entity.
+type GuestStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuestStateMutation
+}
+
+// SetQueue sets the "queue" field.
+func (_u *GuestStateUpdateOne) SetQueue(v []uuid.UUID) *GuestStateUpdateOne {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// AppendQueue appends value to the "queue" field.
+func (_u *GuestStateUpdateOne) AppendQueue(v []uuid.UUID) *GuestStateUpdateOne {
+	_u.mutation.AppendQueue(v)
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *GuestStateUpdateOne) ClearQueue() *GuestStateUpdateOne {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetLikes sets the "likes" field.
+func (_u *GuestStateUpdateOne) SetLikes(v []uuid.UUID) *GuestStateUpdateOne {
+	_u.mutation.SetLikes(v)
+	return _u
+}
+
+// AppendLikes appends value to the "likes" field.
+func (_u *GuestStateUpdateOne) AppendLikes(v []uuid.UUID) *GuestStateUpdateOne {
+	_u.mutation.AppendLikes(v)
+	return _u
+}
+
+// ClearLikes clears the value of the "likes" field.
+func (_u *GuestStateUpdateOne) ClearLikes() *GuestStateUpdateOne {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuestStateUpdateOne) SetUpdatedAt(v time.Time) *GuestStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *GuestStateUpdateOne) SetExpiresAt(v time.Time) *GuestStateUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *GuestStateUpdateOne) SetNillableExpiresAt(v *time.Time) *GuestStateUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GuestStateMutation object of the builder.
+func (_u *GuestStateUpdateOne) Mutation() *GuestStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GuestStateUpdate builder.
+func (_u *GuestStateUpdateOne) Where(ps ...predicate.GuestState) *GuestStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GuestStateUpdateOne) Select(field string, fields ...string) *GuestStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GuestState entity.
+func (_u *GuestStateUpdateOne) Save(ctx context.Context) (*GuestState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuestStateUpdateOne) SaveX(ctx context.Context) *GuestState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GuestStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuestStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuestStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := gueststate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *GuestStateUpdateOne) sqlSave(ctx context.Context) (_node *GuestState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gueststate.Table, gueststate.Columns, sqlgraph.NewFieldSpec(gueststate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuestState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gueststate.FieldID)
+		for _, f := range fields {
+			if !gueststate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gueststate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(gueststate.FieldQueue, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gueststate.FieldQueue, value)
+		})
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(gueststate.FieldQueue, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Likes(); ok {
+		_spec.SetField(gueststate.FieldLikes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLikes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gueststate.FieldLikes, value)
+		})
+	}
+	if _u.mutation.LikesCleared() {
+		_spec.ClearField(gueststate.FieldLikes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(gueststate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(gueststate.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &GuestState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gueststate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
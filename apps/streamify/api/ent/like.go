@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/like"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Like is the model entity for the Like schema.
+type Like struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user who liked the track
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The liked track
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LikeQuery when eager-loading is set.
+	Edges        LikeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LikeEdges holds the relations/edges for other nodes in the graph.
+type LikeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LikeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LikeEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Like) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case like.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case like.FieldID, like.FieldUserID, like.FieldTrackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Like fields.
+func (_m *Like) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case like.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case like.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case like.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case like.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Like.
+// This includes values selected through modifiers, order, etc.
+func (_m *Like) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Like entity.
+func (_m *Like) QueryUser() *UserQuery {
+	return NewLikeClient(_m.config).QueryUser(_m)
+}
+
+// QueryTrack queries the "track" edge of the Like entity.
+func (_m *Like) QueryTrack() *TrackQuery {
+	return NewLikeClient(_m.config).QueryTrack(_m)
+}
+
+// Update returns a builder for updating this Like.
+// Note that you need to call Like.Unwrap() before calling this method if this Like
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Like) Update() *LikeUpdateOne {
+	return NewLikeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Like entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Like) Unwrap() *Like {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Like is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Like) String() string {
+	var builder strings.Builder
+	builder.WriteString("Like(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Likes is a parsable slice of Like.
+type Likes []*Like
@@ -8,12 +8,90 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "prefix", Type: field.TypeString, Size: 16},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Size: 64, SchemaType: map[string]string{"mysql": "char(64)", "postgres": "char(64)", "sqlite3": "char(64)"}},
+		{Name: "tier", Type: field.TypeEnum, Enums: []string{"free", "pro", "enterprise"}, Default: "free"},
+		{Name: "monthly_quota", Type: field.TypeInt64, Nullable: true},
+		{Name: "overage", Type: field.TypeEnum, Nullable: true, Enums: []string{"block", "allow"}},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_user",
+				Columns:    []*schema.Column{APIKeysColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[10], APIKeysColumns[9]},
+			},
+		},
+	}
+	// APIKeyUsagesColumns holds the columns for the "api_key_usages" table.
+	APIKeyUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "bucket", Type: field.TypeTime},
+		{Name: "requests", Type: field.TypeInt64, Default: 0},
+		{Name: "rate_limited", Type: field.TypeInt64, Default: 0},
+		{Name: "client_errors", Type: field.TypeInt64, Default: 0},
+		{Name: "server_errors", Type: field.TypeInt64, Default: 0},
+		{Name: "key_id", Type: field.TypeUUID},
+	}
+	// APIKeyUsagesTable holds the schema information for the "api_key_usages" table.
+	APIKeyUsagesTable = &schema.Table{
+		Name:       "api_key_usages",
+		Columns:    APIKeyUsagesColumns,
+		PrimaryKey: []*schema.Column{APIKeyUsagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_key_usages_api_keys_key",
+				Columns:    []*schema.Column{APIKeyUsagesColumns[6]},
+				RefColumns: []*schema.Column{APIKeysColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikeyusage_key_id_bucket",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeyUsagesColumns[6], APIKeyUsagesColumns[1]},
+			},
+			{
+				Name:    "apikeyusage_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeyUsagesColumns[1]},
+			},
+		},
+	}
 	// AlbumsColumns holds the columns for the "albums" table.
 	AlbumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "title", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "palette", Type: field.TypeJSON, Nullable: true},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "album_type", Type: field.TypeEnum, Enums: []string{"album", "single", "ep", "compilation"}, Default: "album"},
+		{Name: "release_date", Type: field.TypeTime, Nullable: true},
+		{Name: "track_count", Type: field.TypeInt, Default: 0},
+		{Name: "total_duration_ms", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "artist_id", Type: field.TypeUUID},
 	}
 	// AlbumsTable holds the schema information for the "albums" table.
@@ -24,18 +102,27 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "albums_artists_artist",
-				Columns:    []*schema.Column{AlbumsColumns[4]},
+				Columns:    []*schema.Column{AlbumsColumns[11]},
 				RefColumns: []*schema.Column{ArtistsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "album_artist_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlbumsColumns[11], AlbumsColumns[9]},
+			},
+		},
 	}
 	// ArtistsColumns holds the columns for the "artists" table.
 	ArtistsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "name", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "palette", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ArtistsTable holds the schema information for the "artists" table.
 	ArtistsTable = &schema.Table{
@@ -43,13 +130,1110 @@ var (
 		Columns:    ArtistsColumns,
 		PrimaryKey: []*schema.Column{ArtistsColumns[0]},
 	}
+	// AudioFingerprintsColumns holds the columns for the "audio_fingerprints" table.
+	AudioFingerprintsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "sha256", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(64)", "postgres": "char(64)", "sqlite3": "char(64)"}},
+		{Name: "duration", Type: field.TypeInt, Default: 0},
+		{Name: "fingerprint", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// AudioFingerprintsTable holds the schema information for the "audio_fingerprints" table.
+	AudioFingerprintsTable = &schema.Table{
+		Name:       "audio_fingerprints",
+		Columns:    AudioFingerprintsColumns,
+		PrimaryKey: []*schema.Column{AudioFingerprintsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audio_fingerprints_tracks_track",
+				Columns:    []*schema.Column{AudioFingerprintsColumns[5]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "audiofingerprint_sha256",
+				Unique:  false,
+				Columns: []*schema.Column{AudioFingerprintsColumns[1]},
+			},
+			{
+				Name:    "audiofingerprint_duration",
+				Unique:  false,
+				Columns: []*schema.Column{AudioFingerprintsColumns[2]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7]},
+			},
+			{
+				Name:    "auditlog_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[7]},
+			},
+		},
+	}
+	// BackupsColumns holds the columns for the "backups" table.
+	BackupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString, Unique: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "completed", "failed"}, Default: "running"},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"manual", "scheduled"}, Default: "manual"},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BackupsTable holds the schema information for the "backups" table.
+	BackupsTable = &schema.Table{
+		Name:       "backups",
+		Columns:    BackupsColumns,
+		PrimaryKey: []*schema.Column{BackupsColumns[0]},
+	}
+	// BlocksColumns holds the columns for the "blocks" table.
+	BlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blocker_id", Type: field.TypeUUID},
+		{Name: "blocked_id", Type: field.TypeUUID},
+	}
+	// BlocksTable holds the schema information for the "blocks" table.
+	BlocksTable = &schema.Table{
+		Name:       "blocks",
+		Columns:    BlocksColumns,
+		PrimaryKey: []*schema.Column{BlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blocks_users_blocker",
+				Columns:    []*schema.Column{BlocksColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blocks_users_blocked",
+				Columns:    []*schema.Column{BlocksColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_blocker_id_blocked_id",
+				Unique:  true,
+				Columns: []*schema.Column{BlocksColumns[2], BlocksColumns[3]},
+			},
+			{
+				Name:    "block_blocked_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlocksColumns[3]},
+			},
+		},
+	}
+	// ChangelogEntriesColumns holds the columns for the "changelog_entries" table.
+	ChangelogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "version", Type: field.TypeString, Unique: true, Size: 64, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "body", Type: field.TypeString, Size: 20000},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ChangelogEntriesTable holds the schema information for the "changelog_entries" table.
+	ChangelogEntriesTable = &schema.Table{
+		Name:       "changelog_entries",
+		Columns:    ChangelogEntriesColumns,
+		PrimaryKey: []*schema.Column{ChangelogEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "changelogentry_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChangelogEntriesColumns[4]},
+			},
+		},
+	}
+	// ConfirmationsColumns holds the columns for the "confirmations" table.
+	ConfirmationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"change_password", "change_email"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64, SchemaType: map[string]string{"mysql": "char(64)", "postgres": "char(64)", "sqlite3": "char(64)"}},
+		{Name: "session", Type: field.TypeString, Size: 32},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ConfirmationsTable holds the schema information for the "confirmations" table.
+	ConfirmationsTable = &schema.Table{
+		Name:       "confirmations",
+		Columns:    ConfirmationsColumns,
+		PrimaryKey: []*schema.Column{ConfirmationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "confirmations_users_user",
+				Columns:    []*schema.Column{ConfirmationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "confirmation_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConfirmationsColumns[4]},
+			},
+		},
+	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"job", "event", "mail"}},
+		{Name: "name", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "summary", Type: field.TypeString, Nullable: true},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_failed_at", Type: field.TypeTime},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_kind_last_failed_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[1], DeadLettersColumns[8]},
+			},
+			{
+				Name:    "deadletter_kind_name",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[1], DeadLettersColumns[2]},
+			},
+		},
+	}
+	// DeviceAuthorizationsColumns holds the columns for the "device_authorizations" table.
+	DeviceAuthorizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "device_code_hash", Type: field.TypeString, Unique: true, Size: 64, SchemaType: map[string]string{"mysql": "char(64)", "postgres": "char(64)", "sqlite3": "char(64)"}},
+		{Name: "user_code", Type: field.TypeString, Unique: true, Size: 8},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "denied", "redeemed"}, Default: "pending"},
+		{Name: "polled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// DeviceAuthorizationsTable holds the schema information for the "device_authorizations" table.
+	DeviceAuthorizationsTable = &schema.Table{
+		Name:       "device_authorizations",
+		Columns:    DeviceAuthorizationsColumns,
+		PrimaryKey: []*schema.Column{DeviceAuthorizationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_authorizations_users_user",
+				Columns:    []*schema.Column{DeviceAuthorizationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceauthorization_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceAuthorizationsColumns[5]},
+			},
+		},
+	}
+	// DuplicateReviewsColumns holds the columns for the "duplicate_reviews" table.
+	DuplicateReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "similarity", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "duplicate", "dismissed"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "track_id", Type: field.TypeUUID},
+		{Name: "match_track_id", Type: field.TypeUUID},
+	}
+	// DuplicateReviewsTable holds the schema information for the "duplicate_reviews" table.
+	DuplicateReviewsTable = &schema.Table{
+		Name:       "duplicate_reviews",
+		Columns:    DuplicateReviewsColumns,
+		PrimaryKey: []*schema.Column{DuplicateReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "duplicate_reviews_tracks_track",
+				Columns:    []*schema.Column{DuplicateReviewsColumns[6]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "duplicate_reviews_tracks_match",
+				Columns:    []*schema.Column{DuplicateReviewsColumns[7]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "duplicatereview_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DuplicateReviewsColumns[2], DuplicateReviewsColumns[5]},
+			},
+			{
+				Name:    "duplicatereview_track_id_match_track_id",
+				Unique:  true,
+				Columns: []*schema.Column{DuplicateReviewsColumns[6], DuplicateReviewsColumns[7]},
+			},
+		},
+	}
+	// EntitlementsColumns holds the columns for the "entitlements" table.
+	EntitlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "feature", Type: field.TypeEnum, Enums: []string{"premium", "downloads"}},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"admin", "promo", "referral"}, Default: "admin"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// EntitlementsTable holds the schema information for the "entitlements" table.
+	EntitlementsTable = &schema.Table{
+		Name:       "entitlements",
+		Columns:    EntitlementsColumns,
+		PrimaryKey: []*schema.Column{EntitlementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "entitlements_users_user",
+				Columns:    []*schema.Column{EntitlementsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entitlement_user_id_feature",
+				Unique:  false,
+				Columns: []*schema.Column{EntitlementsColumns[5], EntitlementsColumns[1]},
+			},
+		},
+	}
+	// ExternalIdentitiesColumns holds the columns for the "external_identities" table.
+	ExternalIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "provisioned", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime},
+		{Name: "provider_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ExternalIdentitiesTable holds the schema information for the "external_identities" table.
+	ExternalIdentitiesTable = &schema.Table{
+		Name:       "external_identities",
+		Columns:    ExternalIdentitiesColumns,
+		PrimaryKey: []*schema.Column{ExternalIdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "external_identities_sso_providers_provider",
+				Columns:    []*schema.Column{ExternalIdentitiesColumns[6]},
+				RefColumns: []*schema.Column{SSOProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "external_identities_users_user",
+				Columns:    []*schema.Column{ExternalIdentitiesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "externalidentity_provider_id_subject",
+				Unique:  true,
+				Columns: []*schema.Column{ExternalIdentitiesColumns[6], ExternalIdentitiesColumns[1]},
+			},
+			{
+				Name:    "externalidentity_provider_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalIdentitiesColumns[6], ExternalIdentitiesColumns[7]},
+			},
+		},
+	}
+	// FollowsColumns holds the columns for the "follows" table.
+	FollowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "follower_id", Type: field.TypeUUID},
+		{Name: "followee_id", Type: field.TypeUUID},
+	}
+	// FollowsTable holds the schema information for the "follows" table.
+	FollowsTable = &schema.Table{
+		Name:       "follows",
+		Columns:    FollowsColumns,
+		PrimaryKey: []*schema.Column{FollowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "follows_users_follower",
+				Columns:    []*schema.Column{FollowsColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "follows_users_followee",
+				Columns:    []*schema.Column{FollowsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "follow_follower_id_followee_id",
+				Unique:  true,
+				Columns: []*schema.Column{FollowsColumns[2], FollowsColumns[3]},
+			},
+			{
+				Name:    "follow_followee_id",
+				Unique:  false,
+				Columns: []*schema.Column{FollowsColumns[3]},
+			},
+		},
+	}
+	// GuestStatesColumns holds the columns for the "guest_states" table.
+	GuestStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "queue", Type: field.TypeJSON, Nullable: true},
+		{Name: "likes", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// GuestStatesTable holds the schema information for the "guest_states" table.
+	GuestStatesTable = &schema.Table{
+		Name:       "guest_states",
+		Columns:    GuestStatesColumns,
+		PrimaryKey: []*schema.Column{GuestStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gueststate_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{GuestStatesColumns[4]},
+			},
+		},
+	}
+	// InvitesColumns holds the columns for the "invites" table.
+	InvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 16, SchemaType: map[string]string{"mysql": "varchar(16)", "postgres": "varchar(16)", "sqlite3": "varchar(16)"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"admin", "referral", "waitlist"}},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "max_uses", Type: field.TypeInt, Default: 1},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+	}
+	// InvitesTable holds the schema information for the "invites" table.
+	InvitesTable = &schema.Table{
+		Name:       "invites",
+		Columns:    InvitesColumns,
+		PrimaryKey: []*schema.Column{InvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invites_users_creator",
+				Columns:    []*schema.Column{InvitesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invite_created_by_kind",
+				Unique:  false,
+				Columns: []*schema.Column{InvitesColumns[8], InvitesColumns[2]},
+			},
+		},
+	}
+	// LikesColumns holds the columns for the "likes" table.
+	LikesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// LikesTable holds the schema information for the "likes" table.
+	LikesTable = &schema.Table{
+		Name:       "likes",
+		Columns:    LikesColumns,
+		PrimaryKey: []*schema.Column{LikesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "likes_users_user",
+				Columns:    []*schema.Column{LikesColumns[2]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "likes_tracks_track",
+				Columns:    []*schema.Column{LikesColumns[3]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "like_user_id_track_id",
+				Unique:  true,
+				Columns: []*schema.Column{LikesColumns[2], LikesColumns[3]},
+			},
+		},
+	}
+	// OperationsColumns holds the columns for the "operations" table.
+	OperationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed", "cancelled"}, Default: "running"},
+		{Name: "owner_id", Type: field.TypeUUID},
+		{Name: "done", Type: field.TypeInt, Default: 0},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cancel_requested", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OperationsTable holds the schema information for the "operations" table.
+	OperationsTable = &schema.Table{
+		Name:       "operations",
+		Columns:    OperationsColumns,
+		PrimaryKey: []*schema.Column{OperationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "operation_owner_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OperationsColumns[3], OperationsColumns[9]},
+			},
+			{
+				Name:    "operation_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{OperationsColumns[2], OperationsColumns[10]},
+			},
+		},
+	}
+	// PlaysColumns holds the columns for the "plays" table.
+	PlaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "territory", Type: field.TypeString, Nullable: true, Size: 2, SchemaType: map[string]string{"mysql": "varchar(2)", "postgres": "varchar(2)", "sqlite3": "varchar(2)"}},
+		{Name: "played_at", Type: field.TypeTime},
+		{Name: "progress_ms", Type: field.TypeInt, Default: 0},
+		{Name: "progress_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// PlaysTable holds the schema information for the "plays" table.
+	PlaysTable = &schema.Table{
+		Name:       "plays",
+		Columns:    PlaysColumns,
+		PrimaryKey: []*schema.Column{PlaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "plays_users_user",
+				Columns:    []*schema.Column{PlaysColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "plays_tracks_track",
+				Columns:    []*schema.Column{PlaysColumns[6]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "play_user_id_played_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaysColumns[5], PlaysColumns[2]},
+			},
+		},
+	}
+	// PlayCountsColumns holds the columns for the "play_counts" table.
+	PlayCountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "day", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "date"}},
+		{Name: "plays", Type: field.TypeInt64, Default: 0},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// PlayCountsTable holds the schema information for the "play_counts" table.
+	PlayCountsTable = &schema.Table{
+		Name:       "play_counts",
+		Columns:    PlayCountsColumns,
+		PrimaryKey: []*schema.Column{PlayCountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "play_counts_users_user",
+				Columns:    []*schema.Column{PlayCountsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "play_counts_tracks_track",
+				Columns:    []*schema.Column{PlayCountsColumns[4]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playcount_user_id_day_track_id",
+				Unique:  true,
+				Columns: []*schema.Column{PlayCountsColumns[3], PlayCountsColumns[1], PlayCountsColumns[4]},
+			},
+		},
+	}
+	// PlaylistsColumns holds the columns for the "playlists" table.
+	PlaylistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "public", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "track_order", Type: field.TypeJSON, Nullable: true},
+		{Name: "clock", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeUUID},
+	}
+	// PlaylistsTable holds the schema information for the "playlists" table.
+	PlaylistsTable = &schema.Table{
+		Name:       "playlists",
+		Columns:    PlaylistsColumns,
+		PrimaryKey: []*schema.Column{PlaylistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlists_users_owner",
+				Columns:    []*schema.Column{PlaylistsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playlist_owner_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaylistsColumns[7], PlaylistsColumns[3]},
+			},
+		},
+	}
+	// PolicyAcceptancesColumns holds the columns for the "policy_acceptances" table.
+	PolicyAcceptancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "policy_version_id", Type: field.TypeUUID},
+	}
+	// PolicyAcceptancesTable holds the schema information for the "policy_acceptances" table.
+	PolicyAcceptancesTable = &schema.Table{
+		Name:       "policy_acceptances",
+		Columns:    PolicyAcceptancesColumns,
+		PrimaryKey: []*schema.Column{PolicyAcceptancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_acceptances_users_user",
+				Columns:    []*schema.Column{PolicyAcceptancesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "policy_acceptances_policy_versions_policy_version",
+				Columns:    []*schema.Column{PolicyAcceptancesColumns[4]},
+				RefColumns: []*schema.Column{PolicyVersionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyacceptance_user_id_policy_version_id",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyAcceptancesColumns[3], PolicyAcceptancesColumns[4]},
+			},
+			{
+				Name:    "policyacceptance_policy_version_id",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyAcceptancesColumns[4]},
+			},
+		},
+	}
+	// PolicyVersionsColumns holds the columns for the "policy_versions" table.
+	PolicyVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"terms", "privacy"}},
+		{Name: "version", Type: field.TypeString, Size: 64, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "published_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime},
+	}
+	// PolicyVersionsTable holds the schema information for the "policy_versions" table.
+	PolicyVersionsTable = &schema.Table{
+		Name:       "policy_versions",
+		Columns:    PolicyVersionsColumns,
+		PrimaryKey: []*schema.Column{PolicyVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyversion_kind_version",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyVersionsColumns[1], PolicyVersionsColumns[2]},
+			},
+			{
+				Name:    "policyversion_kind_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyVersionsColumns[1], PolicyVersionsColumns[6]},
+			},
+		},
+	}
+	// PromoCodesColumns holds the columns for the "promo_codes" table.
+	PromoCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 32, SchemaType: map[string]string{"mysql": "varchar(32)", "postgres": "varchar(32)", "sqlite3": "varchar(32)"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"percent_off", "free_months", "feature_unlock"}},
+		{Name: "percent_off", Type: field.TypeInt, Nullable: true},
+		{Name: "months", Type: field.TypeInt, Nullable: true},
+		{Name: "feature", Type: field.TypeEnum, Nullable: true, Enums: []string{"premium", "downloads"}},
+		{Name: "max_redemptions", Type: field.TypeInt, Nullable: true},
+		{Name: "per_user_limit", Type: field.TypeInt, Default: 1},
+		{Name: "redemptions", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+	}
+	// PromoCodesTable holds the schema information for the "promo_codes" table.
+	PromoCodesTable = &schema.Table{
+		Name:       "promo_codes",
+		Columns:    PromoCodesColumns,
+		PrimaryKey: []*schema.Column{PromoCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_codes_users_creator",
+				Columns:    []*schema.Column{PromoCodesColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PromoRedemptionsColumns holds the columns for the "promo_redemptions" table.
+	PromoRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "percent_off", Type: field.TypeInt, Nullable: true},
+		{Name: "discount_ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "code_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "entitlement_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PromoRedemptionsTable holds the schema information for the "promo_redemptions" table.
+	PromoRedemptionsTable = &schema.Table{
+		Name:       "promo_redemptions",
+		Columns:    PromoRedemptionsColumns,
+		PrimaryKey: []*schema.Column{PromoRedemptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_redemptions_promo_codes_code",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[4]},
+				RefColumns: []*schema.Column{PromoCodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "promo_redemptions_users_user",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "promo_redemptions_entitlements_entitlement",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[6]},
+				RefColumns: []*schema.Column{EntitlementsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promoredemption_code_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[4], PromoRedemptionsColumns[5]},
+			},
+			{
+				Name:    "promoredemption_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[5], PromoRedemptionsColumns[3]},
+			},
+		},
+	}
+	// ReferralsColumns holds the columns for the "referrals" table.
+	ReferralsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"rewarded", "rejected"}},
+		{Name: "reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"same_email", "shared_ip"}},
+		{Name: "reward_days", Type: field.TypeInt, Default: 0},
+		{Name: "signup_ip", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "referrer_id", Type: field.TypeUUID},
+		{Name: "referred_id", Type: field.TypeUUID},
+		{Name: "invite_id", Type: field.TypeUUID},
+		{Name: "entitlement_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ReferralsTable holds the schema information for the "referrals" table.
+	ReferralsTable = &schema.Table{
+		Name:       "referrals",
+		Columns:    ReferralsColumns,
+		PrimaryKey: []*schema.Column{ReferralsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "referrals_users_referrer",
+				Columns:    []*schema.Column{ReferralsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "referrals_users_referred",
+				Columns:    []*schema.Column{ReferralsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "referrals_invites_invite",
+				Columns:    []*schema.Column{ReferralsColumns[8]},
+				RefColumns: []*schema.Column{InvitesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "referrals_entitlements_entitlement",
+				Columns:    []*schema.Column{ReferralsColumns[9]},
+				RefColumns: []*schema.Column{EntitlementsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referral_referrer_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[6], ReferralsColumns[5]},
+			},
+			{
+				Name:    "referral_referrer_id_signup_ip",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralsColumns[6], ReferralsColumns[4]},
+			},
+		},
+	}
+	// ScimGroupsColumns holds the columns for the "scim_groups" table.
+	ScimGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "display_name", Type: field.TypeString, Size: 255},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "members", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "provider_id", Type: field.TypeUUID},
+	}
+	// ScimGroupsTable holds the schema information for the "scim_groups" table.
+	ScimGroupsTable = &schema.Table{
+		Name:       "scim_groups",
+		Columns:    ScimGroupsColumns,
+		PrimaryKey: []*schema.Column{ScimGroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scim_groups_sso_providers_provider",
+				Columns:    []*schema.Column{ScimGroupsColumns[6]},
+				RefColumns: []*schema.Column{SSOProvidersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scimgroup_provider_id_display_name",
+				Unique:  true,
+				Columns: []*schema.Column{ScimGroupsColumns[6], ScimGroupsColumns[1]},
+			},
+		},
+	}
+	// SSOProvidersColumns holds the columns for the "sso_providers" table.
+	SSOProvidersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString},
+		{Name: "client_secret", Type: field.TypeString},
+		{Name: "groups_claim", Type: field.TypeString, Default: "groups"},
+		{Name: "group_roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "email_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "scim_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "region", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "provisioning", Type: field.TypeBool, Default: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SSOProvidersTable holds the schema information for the "sso_providers" table.
+	SSOProvidersTable = &schema.Table{
+		Name:       "sso_providers",
+		Columns:    SSOProvidersColumns,
+		PrimaryKey: []*schema.Column{SSOProvidersColumns[0]},
+	}
+	// SecurityAlertsColumns holds the columns for the "security_alerts" table.
+	SecurityAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "rule", Type: field.TypeString, Size: 64},
+		{Name: "severity", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true, Size: 2},
+		{Name: "reauth_forced", Type: field.TypeBool, Default: false},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SecurityAlertsTable holds the schema information for the "security_alerts" table.
+	SecurityAlertsTable = &schema.Table{
+		Name:       "security_alerts",
+		Columns:    SecurityAlertsColumns,
+		PrimaryKey: []*schema.Column{SecurityAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "security_alerts_users_user",
+				Columns:    []*schema.Column{SecurityAlertsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityalert_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityAlertsColumns[9]},
+			},
+			{
+				Name:    "securityalert_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityAlertsColumns[10], SecurityAlertsColumns[9]},
+			},
+		},
+	}
+	// ShareLinksColumns holds the columns for the "share_links" table.
+	ShareLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "token", Type: field.TypeString, Unique: true, Size: 16, SchemaType: map[string]string{"mysql": "varchar(16)", "postgres": "varchar(16)", "sqlite3": "varchar(16)"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"track", "album", "playlist"}},
+		{Name: "target_id", Type: field.TypeUUID},
+		{Name: "visits", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID},
+	}
+	// ShareLinksTable holds the schema information for the "share_links" table.
+	ShareLinksTable = &schema.Table{
+		Name:       "share_links",
+		Columns:    ShareLinksColumns,
+		PrimaryKey: []*schema.Column{ShareLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "share_links_users_creator",
+				Columns:    []*schema.Column{ShareLinksColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sharelink_created_by_kind_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{ShareLinksColumns[6], ShareLinksColumns[2], ShareLinksColumns[3]},
+			},
+		},
+	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "from_env", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "retires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+	}
+	// SupportAttachmentsColumns holds the columns for the "support_attachments" table.
+	SupportAttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeUUID},
+		{Name: "uploaded_by", Type: field.TypeUUID},
+	}
+	// SupportAttachmentsTable holds the schema information for the "support_attachments" table.
+	SupportAttachmentsTable = &schema.Table{
+		Name:       "support_attachments",
+		Columns:    SupportAttachmentsColumns,
+		PrimaryKey: []*schema.Column{SupportAttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "support_attachments_support_tickets_ticket",
+				Columns:    []*schema.Column{SupportAttachmentsColumns[5]},
+				RefColumns: []*schema.Column{SupportTicketsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "support_attachments_users_uploader",
+				Columns:    []*schema.Column{SupportAttachmentsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SupportRepliesColumns holds the columns for the "support_replies" table.
+	SupportRepliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "staff", Type: field.TypeBool, Default: false},
+		{Name: "body", Type: field.TypeString, Size: 10000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID},
+	}
+	// SupportRepliesTable holds the schema information for the "support_replies" table.
+	SupportRepliesTable = &schema.Table{
+		Name:       "support_replies",
+		Columns:    SupportRepliesColumns,
+		PrimaryKey: []*schema.Column{SupportRepliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "support_replies_support_tickets_ticket",
+				Columns:    []*schema.Column{SupportRepliesColumns[4]},
+				RefColumns: []*schema.Column{SupportTicketsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "support_replies_users_author",
+				Columns:    []*schema.Column{SupportRepliesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "supportreply_ticket_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SupportRepliesColumns[4], SupportRepliesColumns[3]},
+			},
+		},
+	}
+	// SupportTicketsColumns holds the columns for the "support_tickets" table.
+	SupportTicketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "subject", Type: field.TypeString, Size: 200},
+		{Name: "body", Type: field.TypeString, Size: 10000},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"account", "billing", "playback", "catalog", "bug", "other"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "pending", "resolved", "closed"}, Default: "open"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// SupportTicketsTable holds the schema information for the "support_tickets" table.
+	SupportTicketsTable = &schema.Table{
+		Name:       "support_tickets",
+		Columns:    SupportTicketsColumns,
+		PrimaryKey: []*schema.Column{SupportTicketsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "support_tickets_users_user",
+				Columns:    []*schema.Column{SupportTicketsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "supportticket_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SupportTicketsColumns[7], SupportTicketsColumns[5]},
+			},
+			{
+				Name:    "supportticket_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{SupportTicketsColumns[4], SupportTicketsColumns[6]},
+			},
+		},
+	}
+	// TombstonesColumns holds the columns for the "tombstones" table.
+	TombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "entity_type", Type: field.TypeEnum, Enums: []string{"artist", "album", "track", "like", "user"}},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime},
+	}
+	// TombstonesTable holds the schema information for the "tombstones" table.
+	TombstonesTable = &schema.Table{
+		Name:       "tombstones",
+		Columns:    TombstonesColumns,
+		PrimaryKey: []*schema.Column{TombstonesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tombstone_deleted_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{TombstonesColumns[4], TombstonesColumns[0]},
+			},
+			{
+				Name:    "tombstone_owner_id_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TombstonesColumns[3], TombstonesColumns[4]},
+			},
+		},
+	}
 	// TracksColumns holds the columns for the "tracks" table.
 	TracksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "title", Type: field.TypeString, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "track_number", Type: field.TypeInt, Nullable: true},
+		{Name: "disc_number", Type: field.TypeInt, Default: 1},
 		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "audio_key", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "version_type", Type: field.TypeEnum, Enums: []string{"original", "remaster", "live", "remix", "acoustic", "edit"}, Default: "original"},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "album_id", Type: field.TypeUUID},
+		{Name: "canonical_track_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TracksTable holds the schema information for the "tracks" table.
 	TracksTable = &schema.Table{
@@ -59,10 +1243,107 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tracks_albums_album",
-				Columns:    []*schema.Column{TracksColumns[4]},
+				Columns:    []*schema.Column{TracksColumns[10]},
 				RefColumns: []*schema.Column{AlbumsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "tracks_tracks_versions",
+				Columns:    []*schema.Column{TracksColumns[11]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "track_album_id_disc_number_track_number",
+				Unique:  false,
+				Columns: []*schema.Column{TracksColumns[10], TracksColumns[3], TracksColumns[2]},
+			},
+			{
+				Name:    "track_canonical_track_id",
+				Unique:  false,
+				Columns: []*schema.Column{TracksColumns[11]},
+			},
+		},
+	}
+	// TrackCreditsColumns holds the columns for the "track_credits" table.
+	TrackCreditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"featured", "remixer", "producer", "composer"}, Default: "featured"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "track_id", Type: field.TypeUUID},
+		{Name: "artist_id", Type: field.TypeUUID},
+	}
+	// TrackCreditsTable holds the schema information for the "track_credits" table.
+	TrackCreditsTable = &schema.Table{
+		Name:       "track_credits",
+		Columns:    TrackCreditsColumns,
+		PrimaryKey: []*schema.Column{TrackCreditsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "track_credits_tracks_track",
+				Columns:    []*schema.Column{TrackCreditsColumns[3]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "track_credits_artists_artist",
+				Columns:    []*schema.Column{TrackCreditsColumns[4]},
+				RefColumns: []*schema.Column{ArtistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trackcredit_track_id_artist_id_role",
+				Unique:  true,
+				Columns: []*schema.Column{TrackCreditsColumns[3], TrackCreditsColumns[4], TrackCreditsColumns[1]},
+			},
+			{
+				Name:    "trackcredit_artist_id",
+				Unique:  false,
+				Columns: []*schema.Column{TrackCreditsColumns[4]},
+			},
+		},
+	}
+	// UploadSessionsColumns holds the columns for the "upload_sessions" table.
+	UploadSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "length", Type: field.TypeInt64},
+		{Name: "offset", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "completed", "failed"}, Default: "active"},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// UploadSessionsTable holds the schema information for the "upload_sessions" table.
+	UploadSessionsTable = &schema.Table{
+		Name:       "upload_sessions",
+		Columns:    UploadSessionsColumns,
+		PrimaryKey: []*schema.Column{UploadSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "upload_sessions_users_user",
+				Columns:    []*schema.Column{UploadSessionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "upload_sessions_tracks_track",
+				Columns:    []*schema.Column{UploadSessionsColumns[8]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uploadsession_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UploadSessionsColumns[3], UploadSessionsColumns[5]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -72,6 +1353,18 @@ var (
 		{Name: "first_name", Type: field.TypeString, Nullable: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
 		{Name: "last_name", Type: field.TypeString, Nullable: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
 		{Name: "password", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
+		{Name: "preferences", Type: field.TypeJSON, Nullable: true},
+		{Name: "playlists_visibility", Type: field.TypeEnum, Enums: []string{"public", "private"}, Default: "public"},
+		{Name: "activity_visibility", Type: field.TypeEnum, Enums: []string{"public", "private"}, Default: "public"},
+		{Name: "followers_visibility", Type: field.TypeEnum, Enums: []string{"public", "private"}, Default: "public"},
+		{Name: "analytics_opt_out", Type: field.TypeBool, Default: false},
+		{Name: "queue", Type: field.TypeJSON, Nullable: true},
+		{Name: "deactivated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "login_countries", Type: field.TypeJSON, Nullable: true},
+		{Name: "sessions_valid_after", Type: field.TypeTime, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "changelog_seen_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -79,16 +1372,157 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString, Unique: true, Size: 255, SchemaType: map[string]string{"mysql": "varchar(255)", "postgres": "varchar(255)", "sqlite3": "varchar(255)"}},
+		{Name: "invited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "invite_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_invites_invite",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[4]},
+				RefColumns: []*schema.Column{InvitesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_invited_at_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[2], WaitlistEntriesColumns[3]},
+			},
+		},
+	}
+	// PlaylistTracksColumns holds the columns for the "playlist_tracks" table.
+	PlaylistTracksColumns = []*schema.Column{
+		{Name: "playlist_id", Type: field.TypeUUID},
+		{Name: "track_id", Type: field.TypeUUID},
+	}
+	// PlaylistTracksTable holds the schema information for the "playlist_tracks" table.
+	PlaylistTracksTable = &schema.Table{
+		Name:       "playlist_tracks",
+		Columns:    PlaylistTracksColumns,
+		PrimaryKey: []*schema.Column{PlaylistTracksColumns[0], PlaylistTracksColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "playlist_tracks_playlist_id",
+				Columns:    []*schema.Column{PlaylistTracksColumns[0]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "playlist_tracks_track_id",
+				Columns:    []*schema.Column{PlaylistTracksColumns[1]},
+				RefColumns: []*schema.Column{TracksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		APIKeyUsagesTable,
 		AlbumsTable,
 		ArtistsTable,
+		AudioFingerprintsTable,
+		AuditLogsTable,
+		BackupsTable,
+		BlocksTable,
+		ChangelogEntriesTable,
+		ConfirmationsTable,
+		DeadLettersTable,
+		DeviceAuthorizationsTable,
+		DuplicateReviewsTable,
+		EntitlementsTable,
+		ExternalIdentitiesTable,
+		FollowsTable,
+		GuestStatesTable,
+		InvitesTable,
+		LikesTable,
+		OperationsTable,
+		PlaysTable,
+		PlayCountsTable,
+		PlaylistsTable,
+		PolicyAcceptancesTable,
+		PolicyVersionsTable,
+		PromoCodesTable,
+		PromoRedemptionsTable,
+		ReferralsTable,
+		ScimGroupsTable,
+		SSOProvidersTable,
+		SecurityAlertsTable,
+		ShareLinksTable,
+		SigningKeysTable,
+		SupportAttachmentsTable,
+		SupportRepliesTable,
+		SupportTicketsTable,
+		TombstonesTable,
 		TracksTable,
+		TrackCreditsTable,
+		UploadSessionsTable,
 		UsersTable,
+		WaitlistEntriesTable,
+		PlaylistTracksTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	APIKeyUsagesTable.ForeignKeys[0].RefTable = APIKeysTable
 	AlbumsTable.ForeignKeys[0].RefTable = ArtistsTable
+	AudioFingerprintsTable.ForeignKeys[0].RefTable = TracksTable
+	BlocksTable.ForeignKeys[0].RefTable = UsersTable
+	BlocksTable.ForeignKeys[1].RefTable = UsersTable
+	ConfirmationsTable.ForeignKeys[0].RefTable = UsersTable
+	DeviceAuthorizationsTable.ForeignKeys[0].RefTable = UsersTable
+	DuplicateReviewsTable.ForeignKeys[0].RefTable = TracksTable
+	DuplicateReviewsTable.ForeignKeys[1].RefTable = TracksTable
+	EntitlementsTable.ForeignKeys[0].RefTable = UsersTable
+	ExternalIdentitiesTable.ForeignKeys[0].RefTable = SSOProvidersTable
+	ExternalIdentitiesTable.ForeignKeys[1].RefTable = UsersTable
+	FollowsTable.ForeignKeys[0].RefTable = UsersTable
+	FollowsTable.ForeignKeys[1].RefTable = UsersTable
+	InvitesTable.ForeignKeys[0].RefTable = UsersTable
+	LikesTable.ForeignKeys[0].RefTable = UsersTable
+	LikesTable.ForeignKeys[1].RefTable = TracksTable
+	PlaysTable.ForeignKeys[0].RefTable = UsersTable
+	PlaysTable.ForeignKeys[1].RefTable = TracksTable
+	PlayCountsTable.ForeignKeys[0].RefTable = UsersTable
+	PlayCountsTable.ForeignKeys[1].RefTable = TracksTable
+	PlaylistsTable.ForeignKeys[0].RefTable = UsersTable
+	PolicyAcceptancesTable.ForeignKeys[0].RefTable = UsersTable
+	PolicyAcceptancesTable.ForeignKeys[1].RefTable = PolicyVersionsTable
+	PromoCodesTable.ForeignKeys[0].RefTable = UsersTable
+	PromoRedemptionsTable.ForeignKeys[0].RefTable = PromoCodesTable
+	PromoRedemptionsTable.ForeignKeys[1].RefTable = UsersTable
+	PromoRedemptionsTable.ForeignKeys[2].RefTable = EntitlementsTable
+	ReferralsTable.ForeignKeys[0].RefTable = UsersTable
+	ReferralsTable.ForeignKeys[1].RefTable = UsersTable
+	ReferralsTable.ForeignKeys[2].RefTable = InvitesTable
+	ReferralsTable.ForeignKeys[3].RefTable = EntitlementsTable
+	ScimGroupsTable.ForeignKeys[0].RefTable = SSOProvidersTable
+	SecurityAlertsTable.ForeignKeys[0].RefTable = UsersTable
+	ShareLinksTable.ForeignKeys[0].RefTable = UsersTable
+	SupportAttachmentsTable.ForeignKeys[0].RefTable = SupportTicketsTable
+	SupportAttachmentsTable.ForeignKeys[1].RefTable = UsersTable
+	SupportRepliesTable.ForeignKeys[0].RefTable = SupportTicketsTable
+	SupportRepliesTable.ForeignKeys[1].RefTable = UsersTable
+	SupportTicketsTable.ForeignKeys[0].RefTable = UsersTable
 	TracksTable.ForeignKeys[0].RefTable = AlbumsTable
+	TracksTable.ForeignKeys[1].RefTable = TracksTable
+	TrackCreditsTable.ForeignKeys[0].RefTable = TracksTable
+	TrackCreditsTable.ForeignKeys[1].RefTable = ArtistsTable
+	UploadSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	UploadSessionsTable.ForeignKeys[1].RefTable = TracksTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = InvitesTable
+	PlaylistTracksTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistTracksTable.ForeignKeys[1].RefTable = TracksTable
 }
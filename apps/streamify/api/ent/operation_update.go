@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"streamify/ent/operation"
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OperationUpdate is the builder for updating Operation entities.
+type OperationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OperationMutation
+}
+
+// Where appends a list predicates to the OperationUpdate builder.
+func (_u *OperationUpdate) Where(ps ...predicate.Operation) *OperationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OperationUpdate) SetStatus(v operation.Status) *OperationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableStatus(v *operation.Status) *OperationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *OperationUpdate) SetDone(v int) *OperationUpdate {
+	_u.mutation.ResetDone()
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableDone(v *int) *OperationUpdate {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// AddDone adds value to the "done" field.
+func (_u *OperationUpdate) AddDone(v int) *OperationUpdate {
+	_u.mutation.AddDone(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *OperationUpdate) SetTotal(v int) *OperationUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableTotal(v *int) *OperationUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *OperationUpdate) AddTotal(v int) *OperationUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *OperationUpdate) SetResult(v jsontext.Value) *OperationUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// AppendResult appends value to the "result" field.
+func (_u *OperationUpdate) AppendResult(v jsontext.Value) *OperationUpdate {
+	_u.mutation.AppendResult(v)
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *OperationUpdate) ClearResult() *OperationUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *OperationUpdate) SetError(v string) *OperationUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableError(v *string) *OperationUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *OperationUpdate) ClearError() *OperationUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (_u *OperationUpdate) SetCancelRequested(v bool) *OperationUpdate {
+	_u.mutation.SetCancelRequested(v)
+	return _u
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableCancelRequested(v *bool) *OperationUpdate {
+	if v != nil {
+		_u.SetCancelRequested(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OperationUpdate) SetUpdatedAt(v time.Time) *OperationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *OperationUpdate) SetFinishedAt(v time.Time) *OperationUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *OperationUpdate) SetNillableFinishedAt(v *time.Time) *OperationUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *OperationUpdate) ClearFinishedAt() *OperationUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the OperationMutation object of the builder.
+func (_u *OperationUpdate) Mutation() *OperationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OperationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OperationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := operation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := operation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Operation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OperationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operation.Table, operation.Columns, sqlgraph.NewFieldSpec(operation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(operation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(operation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDone(); ok {
+		_spec.AddField(operation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(operation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(operation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(operation.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, operation.FieldResult, value)
+		})
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(operation.FieldResult, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(operation.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(operation.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelRequested(); ok {
+		_spec.SetField(operation.FieldCancelRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(operation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(operation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(operation.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OperationUpdateOne is the builder for updating a single Operation entity.
+type OperationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OperationMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *OperationUpdateOne) SetStatus(v operation.Status) *OperationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableStatus(v *operation.Status) *OperationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDone sets the "done" field.
+func (_u *OperationUpdateOne) SetDone(v int) *OperationUpdateOne {
+	_u.mutation.ResetDone()
+	_u.mutation.SetDone(v)
+	return _u
+}
+
+// SetNillableDone sets the "done" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableDone(v *int) *OperationUpdateOne {
+	if v != nil {
+		_u.SetDone(*v)
+	}
+	return _u
+}
+
+// AddDone adds value to the "done" field.
+func (_u *OperationUpdateOne) AddDone(v int) *OperationUpdateOne {
+	_u.mutation.AddDone(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *OperationUpdateOne) SetTotal(v int) *OperationUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableTotal(v *int) *OperationUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *OperationUpdateOne) AddTotal(v int) *OperationUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *OperationUpdateOne) SetResult(v jsontext.Value) *OperationUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// AppendResult appends value to the "result" field.
+func (_u *OperationUpdateOne) AppendResult(v jsontext.Value) *OperationUpdateOne {
+	_u.mutation.AppendResult(v)
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *OperationUpdateOne) ClearResult() *OperationUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *OperationUpdateOne) SetError(v string) *OperationUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableError(v *string) *OperationUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *OperationUpdateOne) ClearError() *OperationUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (_u *OperationUpdateOne) SetCancelRequested(v bool) *OperationUpdateOne {
+	_u.mutation.SetCancelRequested(v)
+	return _u
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableCancelRequested(v *bool) *OperationUpdateOne {
+	if v != nil {
+		_u.SetCancelRequested(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OperationUpdateOne) SetUpdatedAt(v time.Time) *OperationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *OperationUpdateOne) SetFinishedAt(v time.Time) *OperationUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *OperationUpdateOne) SetNillableFinishedAt(v *time.Time) *OperationUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *OperationUpdateOne) ClearFinishedAt() *OperationUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the OperationMutation object of the builder.
+func (_u *OperationUpdateOne) Mutation() *OperationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OperationUpdate builder.
+func (_u *OperationUpdateOne) Where(ps ...predicate.Operation) *OperationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OperationUpdateOne) Select(field string, fields ...string) *OperationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Operation entity.
+func (_u *OperationUpdateOne) Save(ctx context.Context) (*Operation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationUpdateOne) SaveX(ctx context.Context) *Operation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OperationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := operation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := operation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Operation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OperationUpdateOne) sqlSave(ctx context.Context) (_node *Operation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operation.Table, operation.Columns, sqlgraph.NewFieldSpec(operation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Operation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operation.FieldID)
+		for _, f := range fields {
+			if !operation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != operation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(operation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Done(); ok {
+		_spec.SetField(operation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDone(); ok {
+		_spec.AddField(operation.FieldDone, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(operation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(operation.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(operation.FieldResult, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedResult(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, operation.FieldResult, value)
+		})
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(operation.FieldResult, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(operation.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(operation.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelRequested(); ok {
+		_spec.SetField(operation.FieldCancelRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(operation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(operation.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(operation.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &Operation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
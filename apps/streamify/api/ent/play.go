@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/play"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Play is the model entity for the Play schema.
+type Play struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The listener
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The track played
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// Country the play happened in, as an ISO 3166-1 alpha-2 code
+	Territory string `json:"territory,omitempty"`
+	// When playback started
+	PlayedAt time.Time `json:"played_at,omitempty"`
+	// How far into the track playback got, from the client's heartbeats
+	ProgressMs int `json:"progress_ms,omitempty"`
+	// When the last heartbeat was received
+	ProgressAt *time.Time `json:"progress_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlayQuery when eager-loading is set.
+	Edges        PlayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlayEdges holds the relations/edges for other nodes in the graph.
+type PlayEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Play) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case play.FieldProgressMs:
+			values[i] = new(sql.NullInt64)
+		case play.FieldTerritory:
+			values[i] = new(sql.NullString)
+		case play.FieldPlayedAt, play.FieldProgressAt:
+			values[i] = new(sql.NullTime)
+		case play.FieldID, play.FieldUserID, play.FieldTrackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Play fields.
+func (_m *Play) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case play.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case play.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case play.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case play.FieldTerritory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field territory", values[i])
+			} else if value.Valid {
+				_m.Territory = value.String
+			}
+		case play.FieldPlayedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field played_at", values[i])
+			} else if value.Valid {
+				_m.PlayedAt = value.Time
+			}
+		case play.FieldProgressMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress_ms", values[i])
+			} else if value.Valid {
+				_m.ProgressMs = int(value.Int64)
+			}
+		case play.FieldProgressAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field progress_at", values[i])
+			} else if value.Valid {
+				_m.ProgressAt = new(time.Time)
+				*_m.ProgressAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Play.
+// This includes values selected through modifiers, order, etc.
+func (_m *Play) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Play entity.
+func (_m *Play) QueryUser() *UserQuery {
+	return NewPlayClient(_m.config).QueryUser(_m)
+}
+
+// QueryTrack queries the "track" edge of the Play entity.
+func (_m *Play) QueryTrack() *TrackQuery {
+	return NewPlayClient(_m.config).QueryTrack(_m)
+}
+
+// Update returns a builder for updating this Play.
+// Note that you need to call Play.Unwrap() before calling this method if this Play
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Play) Update() *PlayUpdateOne {
+	return NewPlayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Play entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Play) Unwrap() *Play {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Play is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Play) String() string {
+	var builder strings.Builder
+	builder.WriteString("Play(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("territory=")
+	builder.WriteString(_m.Territory)
+	builder.WriteString(", ")
+	builder.WriteString("played_at=")
+	builder.WriteString(_m.PlayedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("progress_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProgressMs))
+	builder.WriteString(", ")
+	if v := _m.ProgressAt; v != nil {
+		builder.WriteString("progress_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Plays is a parsable slice of Play.
+type Plays []*Play
@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package play
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the play type in the database.
+	Label = "play"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTrackID holds the string denoting the track_id field in the database.
+	FieldTrackID = "track_id"
+	// FieldTerritory holds the string denoting the territory field in the database.
+	FieldTerritory = "territory"
+	// FieldPlayedAt holds the string denoting the played_at field in the database.
+	FieldPlayedAt = "played_at"
+	// FieldProgressMs holds the string denoting the progress_ms field in the database.
+	FieldProgressMs = "progress_ms"
+	// FieldProgressAt holds the string denoting the progress_at field in the database.
+	FieldProgressAt = "progress_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeTrack holds the string denoting the track edge name in mutations.
+	EdgeTrack = "track"
+	// Table holds the table name of the play in the database.
+	Table = "plays"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "plays"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// TrackTable is the table that holds the track relation/edge.
+	TrackTable = "plays"
+	// TrackInverseTable is the table name for the Track entity.
+	// It exists in this package in order to avoid circular dependency with the "track" package.
+	TrackInverseTable = "tracks"
+	// TrackColumn is the table column denoting the track relation/edge.
+	TrackColumn = "track_id"
+)
+
+// Columns holds all SQL columns for play fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldTrackID,
+	FieldTerritory,
+	FieldPlayedAt,
+	FieldProgressMs,
+	FieldProgressAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TerritoryValidator is a validator for the "territory" field. It is called by the builders before save.
+	TerritoryValidator func(string) error
+	// DefaultPlayedAt holds the default value on creation for the "played_at" field.
+	DefaultPlayedAt func() time.Time
+	// DefaultProgressMs holds the default value on creation for the "progress_ms" field.
+	DefaultProgressMs int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Play queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTrackID orders the results by the track_id field.
+func ByTrackID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackID, opts...).ToFunc()
+}
+
+// ByTerritory orders the results by the territory field.
+func ByTerritory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerritory, opts...).ToFunc()
+}
+
+// ByPlayedAt orders the results by the played_at field.
+func ByPlayedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayedAt, opts...).ToFunc()
+}
+
+// ByProgressMs orders the results by the progress_ms field.
+func ByProgressMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgressMs, opts...).ToFunc()
+}
+
+// ByProgressAt orders the results by the progress_at field.
+func ByProgressAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgressAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTrackField orders the results by track field.
+func ByTrackField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newTrackStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+	)
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package play
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldUserID, v))
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldTrackID, v))
+}
+
+// Territory applies equality check predicate on the "territory" field. It's identical to TerritoryEQ.
+func Territory(v string) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldTerritory, v))
+}
+
+// PlayedAt applies equality check predicate on the "played_at" field. It's identical to PlayedAtEQ.
+func PlayedAt(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// ProgressMs applies equality check predicate on the "progress_ms" field. It's identical to ProgressMsEQ.
+func ProgressMs(v int) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldProgressMs, v))
+}
+
+// ProgressAt applies equality check predicate on the "progress_at" field. It's identical to ProgressAtEQ.
+func ProgressAt(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldProgressAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldTrackID, v))
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldTrackID, v))
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldTrackID, vs...))
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...uuid.UUID) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldTrackID, vs...))
+}
+
+// TerritoryEQ applies the EQ predicate on the "territory" field.
+func TerritoryEQ(v string) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldTerritory, v))
+}
+
+// TerritoryNEQ applies the NEQ predicate on the "territory" field.
+func TerritoryNEQ(v string) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldTerritory, v))
+}
+
+// TerritoryIn applies the In predicate on the "territory" field.
+func TerritoryIn(vs ...string) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldTerritory, vs...))
+}
+
+// TerritoryNotIn applies the NotIn predicate on the "territory" field.
+func TerritoryNotIn(vs ...string) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldTerritory, vs...))
+}
+
+// TerritoryGT applies the GT predicate on the "territory" field.
+func TerritoryGT(v string) predicate.Play {
+	return predicate.Play(sql.FieldGT(FieldTerritory, v))
+}
+
+// TerritoryGTE applies the GTE predicate on the "territory" field.
+func TerritoryGTE(v string) predicate.Play {
+	return predicate.Play(sql.FieldGTE(FieldTerritory, v))
+}
+
+// TerritoryLT applies the LT predicate on the "territory" field.
+func TerritoryLT(v string) predicate.Play {
+	return predicate.Play(sql.FieldLT(FieldTerritory, v))
+}
+
+// TerritoryLTE applies the LTE predicate on the "territory" field.
+func TerritoryLTE(v string) predicate.Play {
+	return predicate.Play(sql.FieldLTE(FieldTerritory, v))
+}
+
+// TerritoryContains applies the Contains predicate on the "territory" field.
+func TerritoryContains(v string) predicate.Play {
+	return predicate.Play(sql.FieldContains(FieldTerritory, v))
+}
+
+// TerritoryHasPrefix applies the HasPrefix predicate on the "territory" field.
+func TerritoryHasPrefix(v string) predicate.Play {
+	return predicate.Play(sql.FieldHasPrefix(FieldTerritory, v))
+}
+
+// TerritoryHasSuffix applies the HasSuffix predicate on the "territory" field.
+func TerritoryHasSuffix(v string) predicate.Play {
+	return predicate.Play(sql.FieldHasSuffix(FieldTerritory, v))
+}
+
+// TerritoryIsNil applies the IsNil predicate on the "territory" field.
+func TerritoryIsNil() predicate.Play {
+	return predicate.Play(sql.FieldIsNull(FieldTerritory))
+}
+
+// TerritoryNotNil applies the NotNil predicate on the "territory" field.
+func TerritoryNotNil() predicate.Play {
+	return predicate.Play(sql.FieldNotNull(FieldTerritory))
+}
+
+// TerritoryEqualFold applies the EqualFold predicate on the "territory" field.
+func TerritoryEqualFold(v string) predicate.Play {
+	return predicate.Play(sql.FieldEqualFold(FieldTerritory, v))
+}
+
+// TerritoryContainsFold applies the ContainsFold predicate on the "territory" field.
+func TerritoryContainsFold(v string) predicate.Play {
+	return predicate.Play(sql.FieldContainsFold(FieldTerritory, v))
+}
+
+// PlayedAtEQ applies the EQ predicate on the "played_at" field.
+func PlayedAtEQ(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtNEQ applies the NEQ predicate on the "played_at" field.
+func PlayedAtNEQ(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldPlayedAt, v))
+}
+
+// PlayedAtIn applies the In predicate on the "played_at" field.
+func PlayedAtIn(vs ...time.Time) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtNotIn applies the NotIn predicate on the "played_at" field.
+func PlayedAtNotIn(vs ...time.Time) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldPlayedAt, vs...))
+}
+
+// PlayedAtGT applies the GT predicate on the "played_at" field.
+func PlayedAtGT(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldGT(FieldPlayedAt, v))
+}
+
+// PlayedAtGTE applies the GTE predicate on the "played_at" field.
+func PlayedAtGTE(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldGTE(FieldPlayedAt, v))
+}
+
+// PlayedAtLT applies the LT predicate on the "played_at" field.
+func PlayedAtLT(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldLT(FieldPlayedAt, v))
+}
+
+// PlayedAtLTE applies the LTE predicate on the "played_at" field.
+func PlayedAtLTE(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldLTE(FieldPlayedAt, v))
+}
+
+// ProgressMsEQ applies the EQ predicate on the "progress_ms" field.
+func ProgressMsEQ(v int) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldProgressMs, v))
+}
+
+// ProgressMsNEQ applies the NEQ predicate on the "progress_ms" field.
+func ProgressMsNEQ(v int) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldProgressMs, v))
+}
+
+// ProgressMsIn applies the In predicate on the "progress_ms" field.
+func ProgressMsIn(vs ...int) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldProgressMs, vs...))
+}
+
+// ProgressMsNotIn applies the NotIn predicate on the "progress_ms" field.
+func ProgressMsNotIn(vs ...int) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldProgressMs, vs...))
+}
+
+// ProgressMsGT applies the GT predicate on the "progress_ms" field.
+func ProgressMsGT(v int) predicate.Play {
+	return predicate.Play(sql.FieldGT(FieldProgressMs, v))
+}
+
+// ProgressMsGTE applies the GTE predicate on the "progress_ms" field.
+func ProgressMsGTE(v int) predicate.Play {
+	return predicate.Play(sql.FieldGTE(FieldProgressMs, v))
+}
+
+// ProgressMsLT applies the LT predicate on the "progress_ms" field.
+func ProgressMsLT(v int) predicate.Play {
+	return predicate.Play(sql.FieldLT(FieldProgressMs, v))
+}
+
+// ProgressMsLTE applies the LTE predicate on the "progress_ms" field.
+func ProgressMsLTE(v int) predicate.Play {
+	return predicate.Play(sql.FieldLTE(FieldProgressMs, v))
+}
+
+// ProgressAtEQ applies the EQ predicate on the "progress_at" field.
+func ProgressAtEQ(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldEQ(FieldProgressAt, v))
+}
+
+// ProgressAtNEQ applies the NEQ predicate on the "progress_at" field.
+func ProgressAtNEQ(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldNEQ(FieldProgressAt, v))
+}
+
+// ProgressAtIn applies the In predicate on the "progress_at" field.
+func ProgressAtIn(vs ...time.Time) predicate.Play {
+	return predicate.Play(sql.FieldIn(FieldProgressAt, vs...))
+}
+
+// ProgressAtNotIn applies the NotIn predicate on the "progress_at" field.
+func ProgressAtNotIn(vs ...time.Time) predicate.Play {
+	return predicate.Play(sql.FieldNotIn(FieldProgressAt, vs...))
+}
+
+// ProgressAtGT applies the GT predicate on the "progress_at" field.
+func ProgressAtGT(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldGT(FieldProgressAt, v))
+}
+
+// ProgressAtGTE applies the GTE predicate on the "progress_at" field.
+func ProgressAtGTE(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldGTE(FieldProgressAt, v))
+}
+
+// ProgressAtLT applies the LT predicate on the "progress_at" field.
+func ProgressAtLT(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldLT(FieldProgressAt, v))
+}
+
+// ProgressAtLTE applies the LTE predicate on the "progress_at" field.
+func ProgressAtLTE(v time.Time) predicate.Play {
+	return predicate.Play(sql.FieldLTE(FieldProgressAt, v))
+}
+
+// ProgressAtIsNil applies the IsNil predicate on the "progress_at" field.
+func ProgressAtIsNil() predicate.Play {
+	return predicate.Play(sql.FieldIsNull(FieldProgressAt))
+}
+
+// ProgressAtNotNil applies the NotNil predicate on the "progress_at" field.
+func ProgressAtNotNil() predicate.Play {
+	return predicate.Play(sql.FieldNotNull(FieldProgressAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Play {
+	return predicate.Play(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Play {
+	return predicate.Play(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.Play {
+	return predicate.Play(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.Play {
+	return predicate.Play(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Play) predicate.Play {
+	return predicate.Play(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Play) predicate.Play {
+	return predicate.Play(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Play) predicate.Play {
+	return predicate.Play(sql.NotPredicates(p))
+}
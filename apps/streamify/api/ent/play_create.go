@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/play"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlayCreate is the builder for creating a Play entity.
+type PlayCreate struct {
+	config
+	mutation *PlayMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PlayCreate) SetUserID(v uuid.UUID) *PlayCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *PlayCreate) SetTrackID(v uuid.UUID) *PlayCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetTerritory sets the "territory" field.
+func (_c *PlayCreate) SetTerritory(v string) *PlayCreate {
+	_c.mutation.SetTerritory(v)
+	return _c
+}
+
+// SetNillableTerritory sets the "territory" field if the given value is not nil.
+func (_c *PlayCreate) SetNillableTerritory(v *string) *PlayCreate {
+	if v != nil {
+		_c.SetTerritory(*v)
+	}
+	return _c
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (_c *PlayCreate) SetPlayedAt(v time.Time) *PlayCreate {
+	_c.mutation.SetPlayedAt(v)
+	return _c
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (_c *PlayCreate) SetNillablePlayedAt(v *time.Time) *PlayCreate {
+	if v != nil {
+		_c.SetPlayedAt(*v)
+	}
+	return _c
+}
+
+// SetProgressMs sets the "progress_ms" field.
+func (_c *PlayCreate) SetProgressMs(v int) *PlayCreate {
+	_c.mutation.SetProgressMs(v)
+	return _c
+}
+
+// SetNillableProgressMs sets the "progress_ms" field if the given value is not nil.
+func (_c *PlayCreate) SetNillableProgressMs(v *int) *PlayCreate {
+	if v != nil {
+		_c.SetProgressMs(*v)
+	}
+	return _c
+}
+
+// SetProgressAt sets the "progress_at" field.
+func (_c *PlayCreate) SetProgressAt(v time.Time) *PlayCreate {
+	_c.mutation.SetProgressAt(v)
+	return _c
+}
+
+// SetNillableProgressAt sets the "progress_at" field if the given value is not nil.
+func (_c *PlayCreate) SetNillableProgressAt(v *time.Time) *PlayCreate {
+	if v != nil {
+		_c.SetProgressAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlayCreate) SetID(v uuid.UUID) *PlayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlayCreate) SetNillableID(v *uuid.UUID) *PlayCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PlayCreate) SetUser(v *User) *PlayCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *PlayCreate) SetTrack(v *Track) *PlayCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// Mutation returns the PlayMutation object of the builder.
+func (_c *PlayCreate) Mutation() *PlayMutation {
+	return _c.mutation
+}
+
+// Save creates the Play in the database.
+func (_c *PlayCreate) Save(ctx context.Context) (*Play, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlayCreate) SaveX(ctx context.Context) *Play {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlayCreate) defaults() {
+	if _, ok := _c.mutation.PlayedAt(); !ok {
+		v := play.DefaultPlayedAt()
+		_c.mutation.SetPlayedAt(v)
+	}
+	if _, ok := _c.mutation.ProgressMs(); !ok {
+		v := play.DefaultProgressMs
+		_c.mutation.SetProgressMs(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := play.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlayCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Play.user_id"`)}
+	}
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "Play.track_id"`)}
+	}
+	if v, ok := _c.mutation.Territory(); ok {
+		if err := play.TerritoryValidator(v); err != nil {
+			return &ValidationError{Name: "territory", err: fmt.Errorf(`ent: validator failed for field "Play.territory": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlayedAt(); !ok {
+		return &ValidationError{Name: "played_at", err: errors.New(`ent: missing required field "Play.played_at"`)}
+	}
+	if _, ok := _c.mutation.ProgressMs(); !ok {
+		return &ValidationError{Name: "progress_ms", err: errors.New(`ent: missing required field "Play.progress_ms"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Play.user"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "Play.track"`)}
+	}
+	return nil
+}
+
+func (_c *PlayCreate) sqlSave(ctx context.Context) (*Play, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlayCreate) createSpec() (*Play, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Play{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(play.Table, sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Territory(); ok {
+		_spec.SetField(play.FieldTerritory, field.TypeString, value)
+		_node.Territory = value
+	}
+	if value, ok := _c.mutation.PlayedAt(); ok {
+		_spec.SetField(play.FieldPlayedAt, field.TypeTime, value)
+		_node.PlayedAt = value
+	}
+	if value, ok := _c.mutation.ProgressMs(); ok {
+		_spec.SetField(play.FieldProgressMs, field.TypeInt, value)
+		_node.ProgressMs = value
+	}
+	if value, ok := _c.mutation.ProgressAt(); ok {
+		_spec.SetField(play.FieldProgressAt, field.TypeTime, value)
+		_node.ProgressAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.UserTable,
+			Columns: []string{play.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.TrackTable,
+			Columns: []string{play.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlayCreateBulk is the builder for creating many Play entities in bulk.
+type PlayCreateBulk struct {
+	config
+	err      error
+	builders []*PlayCreate
+}
+
+// Save creates the Play entities in the database.
+func (_c *PlayCreateBulk) Save(ctx context.Context) ([]*Play, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Play, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlayCreateBulk) SaveX(ctx context.Context) []*Play {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
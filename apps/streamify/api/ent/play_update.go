@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/play"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlayUpdate is the builder for updating Play entities.
+type PlayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlayMutation
+}
+
+// Where appends a list predicates to the PlayUpdate builder.
+func (_u *PlayUpdate) Where(ps ...predicate.Play) *PlayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PlayUpdate) SetUserID(v uuid.UUID) *PlayUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillableUserID(v *uuid.UUID) *PlayUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *PlayUpdate) SetTrackID(v uuid.UUID) *PlayUpdate {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillableTrackID(v *uuid.UUID) *PlayUpdate {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetTerritory sets the "territory" field.
+func (_u *PlayUpdate) SetTerritory(v string) *PlayUpdate {
+	_u.mutation.SetTerritory(v)
+	return _u
+}
+
+// SetNillableTerritory sets the "territory" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillableTerritory(v *string) *PlayUpdate {
+	if v != nil {
+		_u.SetTerritory(*v)
+	}
+	return _u
+}
+
+// ClearTerritory clears the value of the "territory" field.
+func (_u *PlayUpdate) ClearTerritory() *PlayUpdate {
+	_u.mutation.ClearTerritory()
+	return _u
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (_u *PlayUpdate) SetPlayedAt(v time.Time) *PlayUpdate {
+	_u.mutation.SetPlayedAt(v)
+	return _u
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillablePlayedAt(v *time.Time) *PlayUpdate {
+	if v != nil {
+		_u.SetPlayedAt(*v)
+	}
+	return _u
+}
+
+// SetProgressMs sets the "progress_ms" field.
+func (_u *PlayUpdate) SetProgressMs(v int) *PlayUpdate {
+	_u.mutation.ResetProgressMs()
+	_u.mutation.SetProgressMs(v)
+	return _u
+}
+
+// SetNillableProgressMs sets the "progress_ms" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillableProgressMs(v *int) *PlayUpdate {
+	if v != nil {
+		_u.SetProgressMs(*v)
+	}
+	return _u
+}
+
+// AddProgressMs adds value to the "progress_ms" field.
+func (_u *PlayUpdate) AddProgressMs(v int) *PlayUpdate {
+	_u.mutation.AddProgressMs(v)
+	return _u
+}
+
+// SetProgressAt sets the "progress_at" field.
+func (_u *PlayUpdate) SetProgressAt(v time.Time) *PlayUpdate {
+	_u.mutation.SetProgressAt(v)
+	return _u
+}
+
+// SetNillableProgressAt sets the "progress_at" field if the given value is not nil.
+func (_u *PlayUpdate) SetNillableProgressAt(v *time.Time) *PlayUpdate {
+	if v != nil {
+		_u.SetProgressAt(*v)
+	}
+	return _u
+}
+
+// ClearProgressAt clears the value of the "progress_at" field.
+func (_u *PlayUpdate) ClearProgressAt() *PlayUpdate {
+	_u.mutation.ClearProgressAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PlayUpdate) SetUser(v *User) *PlayUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *PlayUpdate) SetTrack(v *Track) *PlayUpdate {
+	return _u.SetTrackID(v.ID)
+}
+
+// Mutation returns the PlayMutation object of the builder.
+func (_u *PlayUpdate) Mutation() *PlayMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PlayUpdate) ClearUser() *PlayUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *PlayUpdate) ClearTrack() *PlayUpdate {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlayUpdate) check() error {
+	if v, ok := _u.mutation.Territory(); ok {
+		if err := play.TerritoryValidator(v); err != nil {
+			return &ValidationError{Name: "territory", err: fmt.Errorf(`ent: validator failed for field "Play.territory": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Play.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Play.track"`)
+	}
+	return nil
+}
+
+func (_u *PlayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(play.Table, play.Columns, sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Territory(); ok {
+		_spec.SetField(play.FieldTerritory, field.TypeString, value)
+	}
+	if _u.mutation.TerritoryCleared() {
+		_spec.ClearField(play.FieldTerritory, field.TypeString)
+	}
+	if value, ok := _u.mutation.PlayedAt(); ok {
+		_spec.SetField(play.FieldPlayedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProgressMs(); ok {
+		_spec.SetField(play.FieldProgressMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressMs(); ok {
+		_spec.AddField(play.FieldProgressMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressAt(); ok {
+		_spec.SetField(play.FieldProgressAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProgressAtCleared() {
+		_spec.ClearField(play.FieldProgressAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.UserTable,
+			Columns: []string{play.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.UserTable,
+			Columns: []string{play.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.TrackTable,
+			Columns: []string{play.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.TrackTable,
+			Columns: []string{play.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{play.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlayUpdateOne is the builder for updating a single Play entity.
+type PlayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlayMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PlayUpdateOne) SetUserID(v uuid.UUID) *PlayUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillableUserID(v *uuid.UUID) *PlayUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *PlayUpdateOne) SetTrackID(v uuid.UUID) *PlayUpdateOne {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillableTrackID(v *uuid.UUID) *PlayUpdateOne {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetTerritory sets the "territory" field.
+func (_u *PlayUpdateOne) SetTerritory(v string) *PlayUpdateOne {
+	_u.mutation.SetTerritory(v)
+	return _u
+}
+
+// SetNillableTerritory sets the "territory" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillableTerritory(v *string) *PlayUpdateOne {
+	if v != nil {
+		_u.SetTerritory(*v)
+	}
+	return _u
+}
+
+// ClearTerritory clears the value of the "territory" field.
+func (_u *PlayUpdateOne) ClearTerritory() *PlayUpdateOne {
+	_u.mutation.ClearTerritory()
+	return _u
+}
+
+// SetPlayedAt sets the "played_at" field.
+func (_u *PlayUpdateOne) SetPlayedAt(v time.Time) *PlayUpdateOne {
+	_u.mutation.SetPlayedAt(v)
+	return _u
+}
+
+// SetNillablePlayedAt sets the "played_at" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillablePlayedAt(v *time.Time) *PlayUpdateOne {
+	if v != nil {
+		_u.SetPlayedAt(*v)
+	}
+	return _u
+}
+
+// SetProgressMs sets the "progress_ms" field.
+func (_u *PlayUpdateOne) SetProgressMs(v int) *PlayUpdateOne {
+	_u.mutation.ResetProgressMs()
+	_u.mutation.SetProgressMs(v)
+	return _u
+}
+
+// SetNillableProgressMs sets the "progress_ms" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillableProgressMs(v *int) *PlayUpdateOne {
+	if v != nil {
+		_u.SetProgressMs(*v)
+	}
+	return _u
+}
+
+// AddProgressMs adds value to the "progress_ms" field.
+func (_u *PlayUpdateOne) AddProgressMs(v int) *PlayUpdateOne {
+	_u.mutation.AddProgressMs(v)
+	return _u
+}
+
+// SetProgressAt sets the "progress_at" field.
+func (_u *PlayUpdateOne) SetProgressAt(v time.Time) *PlayUpdateOne {
+	_u.mutation.SetProgressAt(v)
+	return _u
+}
+
+// SetNillableProgressAt sets the "progress_at" field if the given value is not nil.
+func (_u *PlayUpdateOne) SetNillableProgressAt(v *time.Time) *PlayUpdateOne {
+	if v != nil {
+		_u.SetProgressAt(*v)
+	}
+	return _u
+}
+
+// ClearProgressAt clears the value of the "progress_at" field.
+func (_u *PlayUpdateOne) ClearProgressAt() *PlayUpdateOne {
+	_u.mutation.ClearProgressAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PlayUpdateOne) SetUser(v *User) *PlayUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *PlayUpdateOne) SetTrack(v *Track) *PlayUpdateOne {
+	return _u.SetTrackID(v.ID)
+}
+
+// Mutation returns the PlayMutation object of the builder.
+func (_u *PlayUpdateOne) Mutation() *PlayMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PlayUpdateOne) ClearUser() *PlayUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *PlayUpdateOne) ClearTrack() *PlayUpdateOne {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// Where appends a list predicates to the PlayUpdate builder.
+func (_u *PlayUpdateOne) Where(ps ...predicate.Play) *PlayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlayUpdateOne) Select(field string, fields ...string) *PlayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Play entity.
+func (_u *PlayUpdateOne) Save(ctx context.Context) (*Play, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlayUpdateOne) SaveX(ctx context.Context) *Play {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlayUpdateOne) check() error {
+	if v, ok := _u.mutation.Territory(); ok {
+		if err := play.TerritoryValidator(v); err != nil {
+			return &ValidationError{Name: "territory", err: fmt.Errorf(`ent: validator failed for field "Play.territory": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Play.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Play.track"`)
+	}
+	return nil
+}
+
+func (_u *PlayUpdateOne) sqlSave(ctx context.Context) (_node *Play, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(play.Table, play.Columns, sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Play.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, play.FieldID)
+		for _, f := range fields {
+			if !play.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != play.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Territory(); ok {
+		_spec.SetField(play.FieldTerritory, field.TypeString, value)
+	}
+	if _u.mutation.TerritoryCleared() {
+		_spec.ClearField(play.FieldTerritory, field.TypeString)
+	}
+	if value, ok := _u.mutation.PlayedAt(); ok {
+		_spec.SetField(play.FieldPlayedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ProgressMs(); ok {
+		_spec.SetField(play.FieldProgressMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgressMs(); ok {
+		_spec.AddField(play.FieldProgressMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProgressAt(); ok {
+		_spec.SetField(play.FieldProgressAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProgressAtCleared() {
+		_spec.ClearField(play.FieldProgressAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.UserTable,
+			Columns: []string{play.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.UserTable,
+			Columns: []string{play.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.TrackTable,
+			Columns: []string{play.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   play.TrackTable,
+			Columns: []string{play.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Play{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{play.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/playcount"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PlayCount is the model entity for the PlayCount schema.
+type PlayCount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The listener
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The track played
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// The UTC day the plays started on
+	Day time.Time `json:"day,omitempty"`
+	// Plays of the track by the user that day
+	Plays int64 `json:"plays,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlayCountQuery when eager-loading is set.
+	Edges        PlayCountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlayCountEdges holds the relations/edges for other nodes in the graph.
+type PlayCountEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayCountEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayCountEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlayCount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playcount.FieldPlays:
+			values[i] = new(sql.NullInt64)
+		case playcount.FieldDay:
+			values[i] = new(sql.NullTime)
+		case playcount.FieldID, playcount.FieldUserID, playcount.FieldTrackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlayCount fields.
+func (_m *PlayCount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playcount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playcount.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case playcount.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case playcount.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.Time
+			}
+		case playcount.FieldPlays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plays", values[i])
+			} else if value.Valid {
+				_m.Plays = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlayCount.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlayCount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PlayCount entity.
+func (_m *PlayCount) QueryUser() *UserQuery {
+	return NewPlayCountClient(_m.config).QueryUser(_m)
+}
+
+// QueryTrack queries the "track" edge of the PlayCount entity.
+func (_m *PlayCount) QueryTrack() *TrackQuery {
+	return NewPlayCountClient(_m.config).QueryTrack(_m)
+}
+
+// Update returns a builder for updating this PlayCount.
+// Note that you need to call PlayCount.Unwrap() before calling this method if this PlayCount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlayCount) Update() *PlayCountUpdateOne {
+	return NewPlayCountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlayCount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlayCount) Unwrap() *PlayCount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlayCount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlayCount) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlayCount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("plays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Plays))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlayCounts is a parsable slice of PlayCount.
+type PlayCounts []*PlayCount
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playcount
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldUserID, v))
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldTrackID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldDay, v))
+}
+
+// Plays applies equality check predicate on the "plays" field. It's identical to PlaysEQ.
+func Plays(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldPlays, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldTrackID, v))
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNEQ(FieldTrackID, v))
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldIn(FieldTrackID, vs...))
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...uuid.UUID) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNotIn(FieldTrackID, vs...))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLTE(FieldDay, v))
+}
+
+// PlaysEQ applies the EQ predicate on the "plays" field.
+func PlaysEQ(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldEQ(FieldPlays, v))
+}
+
+// PlaysNEQ applies the NEQ predicate on the "plays" field.
+func PlaysNEQ(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNEQ(FieldPlays, v))
+}
+
+// PlaysIn applies the In predicate on the "plays" field.
+func PlaysIn(vs ...int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldIn(FieldPlays, vs...))
+}
+
+// PlaysNotIn applies the NotIn predicate on the "plays" field.
+func PlaysNotIn(vs ...int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldNotIn(FieldPlays, vs...))
+}
+
+// PlaysGT applies the GT predicate on the "plays" field.
+func PlaysGT(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGT(FieldPlays, v))
+}
+
+// PlaysGTE applies the GTE predicate on the "plays" field.
+func PlaysGTE(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldGTE(FieldPlays, v))
+}
+
+// PlaysLT applies the LT predicate on the "plays" field.
+func PlaysLT(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLT(FieldPlays, v))
+}
+
+// PlaysLTE applies the LTE predicate on the "plays" field.
+func PlaysLTE(v int64) predicate.PlayCount {
+	return predicate.PlayCount(sql.FieldLTE(FieldPlays, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PlayCount {
+	return predicate.PlayCount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PlayCount {
+	return predicate.PlayCount(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.PlayCount {
+	return predicate.PlayCount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.PlayCount {
+	return predicate.PlayCount(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlayCount) predicate.PlayCount {
+	return predicate.PlayCount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlayCount) predicate.PlayCount {
+	return predicate.PlayCount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlayCount) predicate.PlayCount {
+	return predicate.PlayCount(sql.NotPredicates(p))
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/playcount"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlayCountCreate is the builder for creating a PlayCount entity.
+type PlayCountCreate struct {
+	config
+	mutation *PlayCountMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PlayCountCreate) SetUserID(v uuid.UUID) *PlayCountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *PlayCountCreate) SetTrackID(v uuid.UUID) *PlayCountCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *PlayCountCreate) SetDay(v time.Time) *PlayCountCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetPlays sets the "plays" field.
+func (_c *PlayCountCreate) SetPlays(v int64) *PlayCountCreate {
+	_c.mutation.SetPlays(v)
+	return _c
+}
+
+// SetNillablePlays sets the "plays" field if the given value is not nil.
+func (_c *PlayCountCreate) SetNillablePlays(v *int64) *PlayCountCreate {
+	if v != nil {
+		_c.SetPlays(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlayCountCreate) SetID(v uuid.UUID) *PlayCountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlayCountCreate) SetNillableID(v *uuid.UUID) *PlayCountCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PlayCountCreate) SetUser(v *User) *PlayCountCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *PlayCountCreate) SetTrack(v *Track) *PlayCountCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// Mutation returns the PlayCountMutation object of the builder.
+func (_c *PlayCountCreate) Mutation() *PlayCountMutation {
+	return _c.mutation
+}
+
+// Save creates the PlayCount in the database.
+func (_c *PlayCountCreate) Save(ctx context.Context) (*PlayCount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlayCountCreate) SaveX(ctx context.Context) *PlayCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayCountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayCountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlayCountCreate) defaults() {
+	if _, ok := _c.mutation.Plays(); !ok {
+		v := playcount.DefaultPlays
+		_c.mutation.SetPlays(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := playcount.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlayCountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PlayCount.user_id"`)}
+	}
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "PlayCount.track_id"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "PlayCount.day"`)}
+	}
+	if _, ok := _c.mutation.Plays(); !ok {
+		return &ValidationError{Name: "plays", err: errors.New(`ent: missing required field "PlayCount.plays"`)}
+	}
+	if v, ok := _c.mutation.Plays(); ok {
+		if err := playcount.PlaysValidator(v); err != nil {
+			return &ValidationError{Name: "plays", err: fmt.Errorf(`ent: validator failed for field "PlayCount.plays": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PlayCount.user"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "PlayCount.track"`)}
+	}
+	return nil
+}
+
+func (_c *PlayCountCreate) sqlSave(ctx context.Context) (*PlayCount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlayCountCreate) createSpec() (*PlayCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlayCount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playcount.Table, sqlgraph.NewFieldSpec(playcount.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(playcount.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.Plays(); ok {
+		_spec.SetField(playcount.FieldPlays, field.TypeInt64, value)
+		_node.Plays = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playcount.UserTable,
+			Columns: []string{playcount.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playcount.TrackTable,
+			Columns: []string{playcount.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlayCountCreateBulk is the builder for creating many PlayCount entities in bulk.
+type PlayCountCreateBulk struct {
+	config
+	err      error
+	builders []*PlayCountCreate
+}
+
+// Save creates the PlayCount entities in the database.
+func (_c *PlayCountCreateBulk) Save(ctx context.Context) ([]*PlayCount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlayCount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlayCountCreateBulk) SaveX(ctx context.Context) []*PlayCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlayCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlayCountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/playcount"
+	"streamify/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlayCountUpdate is the builder for updating PlayCount entities.
+type PlayCountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlayCountMutation
+}
+
+// Where appends a list predicates to the PlayCountUpdate builder.
+func (_u *PlayCountUpdate) Where(ps ...predicate.PlayCount) *PlayCountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlays sets the "plays" field.
+func (_u *PlayCountUpdate) SetPlays(v int64) *PlayCountUpdate {
+	_u.mutation.ResetPlays()
+	_u.mutation.SetPlays(v)
+	return _u
+}
+
+// SetNillablePlays sets the "plays" field if the given value is not nil.
+func (_u *PlayCountUpdate) SetNillablePlays(v *int64) *PlayCountUpdate {
+	if v != nil {
+		_u.SetPlays(*v)
+	}
+	return _u
+}
+
+// AddPlays adds value to the "plays" field.
+func (_u *PlayCountUpdate) AddPlays(v int64) *PlayCountUpdate {
+	_u.mutation.AddPlays(v)
+	return _u
+}
+
+// Mutation returns the PlayCountMutation object of the builder.
+func (_u *PlayCountUpdate) Mutation() *PlayCountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlayCountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlayCountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlayCountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlayCountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlayCountUpdate) check() error {
+	if v, ok := _u.mutation.Plays(); ok {
+		if err := playcount.PlaysValidator(v); err != nil {
+			return &ValidationError{Name: "plays", err: fmt.Errorf(`ent: validator failed for field "PlayCount.plays": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlayCount.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlayCount.track"`)
+	}
+	return nil
+}
+
+func (_u *PlayCountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playcount.Table, playcount.Columns, sqlgraph.NewFieldSpec(playcount.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Plays(); ok {
+		_spec.SetField(playcount.FieldPlays, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlays(); ok {
+		_spec.AddField(playcount.FieldPlays, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playcount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlayCountUpdateOne is the builder for updating a single PlayCount entity.
+type PlayCountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlayCountMutation
+}
+
+// SetPlays sets the "plays" field.
+func (_u *PlayCountUpdateOne) SetPlays(v int64) *PlayCountUpdateOne {
+	_u.mutation.ResetPlays()
+	_u.mutation.SetPlays(v)
+	return _u
+}
+
+// SetNillablePlays sets the "plays" field if the given value is not nil.
+func (_u *PlayCountUpdateOne) SetNillablePlays(v *int64) *PlayCountUpdateOne {
+	if v != nil {
+		_u.SetPlays(*v)
+	}
+	return _u
+}
+
+// AddPlays adds value to the "plays" field.
+func (_u *PlayCountUpdateOne) AddPlays(v int64) *PlayCountUpdateOne {
+	_u.mutation.AddPlays(v)
+	return _u
+}
+
+// Mutation returns the PlayCountMutation object of the builder.
+func (_u *PlayCountUpdateOne) Mutation() *PlayCountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlayCountUpdate builder.
+func (_u *PlayCountUpdateOne) Where(ps ...predicate.PlayCount) *PlayCountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlayCountUpdateOne) Select(field string, fields ...string) *PlayCountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlayCount entity.
+func (_u *PlayCountUpdateOne) Save(ctx context.Context) (*PlayCount, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlayCountUpdateOne) SaveX(ctx context.Context) *PlayCount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlayCountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlayCountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlayCountUpdateOne) check() error {
+	if v, ok := _u.mutation.Plays(); ok {
+		if err := playcount.PlaysValidator(v); err != nil {
+			return &ValidationError{Name: "plays", err: fmt.Errorf(`ent: validator failed for field "PlayCount.plays": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlayCount.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PlayCount.track"`)
+	}
+	return nil
+}
+
+func (_u *PlayCountUpdateOne) sqlSave(ctx context.Context) (_node *PlayCount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playcount.Table, playcount.Columns, sqlgraph.NewFieldSpec(playcount.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PlayCount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playcount.FieldID)
+		for _, f := range fields {
+			if !playcount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playcount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Plays(); ok {
+		_spec.SetField(playcount.FieldPlays, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlays(); ok {
+		_spec.AddField(playcount.FieldPlays, field.TypeInt64, value)
+	}
+	_node = &PlayCount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playcount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"streamify/ent/playlist"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Playlist is the model entity for the Playlist schema.
+type Playlist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user who owns the playlist
+	OwnerID uuid.UUID `json:"owner_id,omitempty"`
+	// Name shown to listeners
+	Name string `json:"name,omitempty"`
+	// Public playlists can be seen by anyone the owner's privacy settings allow
+	Public bool `json:"public,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Ordered IDs of the playlist's tracks; tracks missing from it sort last
+	TrackOrder []uuid.UUID `json:"track_order,omitempty"`
+	// When the name, the public flag and each track's membership last changed, for last-writer-wins sync merges
+	Clock map[string]time.Time `json:"clock,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistQuery when eager-loading is set.
+	Edges        PlaylistEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaylistEdges holds the relations/edges for other nodes in the graph.
+type PlaylistEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Tracks holds the value of the tracks edge.
+	Tracks []*Track `json:"tracks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// TracksOrErr returns the Tracks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlaylistEdges) TracksOrErr() ([]*Track, error) {
+	if e.loadedTypes[1] {
+		return e.Tracks, nil
+	}
+	return nil, &NotLoadedError{edge: "tracks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Playlist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldTrackOrder, playlist.FieldClock:
+			values[i] = new([]byte)
+		case playlist.FieldPublic:
+			values[i] = new(sql.NullBool)
+		case playlist.FieldName:
+			values[i] = new(sql.NullString)
+		case playlist.FieldCreatedAt, playlist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case playlist.FieldID, playlist.FieldOwnerID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Playlist fields.
+func (_m *Playlist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlist.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playlist.FieldOwnerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				_m.OwnerID = *value
+			}
+		case playlist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case playlist.FieldPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field public", values[i])
+			} else if value.Valid {
+				_m.Public = value.Bool
+			}
+		case playlist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case playlist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case playlist.FieldTrackOrder:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field track_order", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TrackOrder); err != nil {
+					return fmt.Errorf("unmarshal field track_order: %w", err)
+				}
+			}
+		case playlist.FieldClock:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field clock", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Clock); err != nil {
+					return fmt.Errorf("unmarshal field clock: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Playlist.
+// This includes values selected through modifiers, order, etc.
+func (_m *Playlist) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Playlist entity.
+func (_m *Playlist) QueryOwner() *UserQuery {
+	return NewPlaylistClient(_m.config).QueryOwner(_m)
+}
+
+// QueryTracks queries the "tracks" edge of the Playlist entity.
+func (_m *Playlist) QueryTracks() *TrackQuery {
+	return NewPlaylistClient(_m.config).QueryTracks(_m)
+}
+
+// Update returns a builder for updating this Playlist.
+// Note that you need to call Playlist.Unwrap() before calling this method if this Playlist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Playlist) Update() *PlaylistUpdateOne {
+	return NewPlaylistClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Playlist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Playlist) Unwrap() *Playlist {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Playlist is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Playlist) String() string {
+	var builder strings.Builder
+	builder.WriteString("Playlist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("public=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Public))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("track_order=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackOrder))
+	builder.WriteString(", ")
+	builder.WriteString("clock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Clock))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Playlists is a parsable slice of Playlist.
+type Playlists []*Playlist
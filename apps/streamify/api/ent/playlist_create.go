@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/playlist"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlaylistCreate is the builder for creating a Playlist entity.
+type PlaylistCreate struct {
+	config
+	mutation *PlaylistMutation
+	hooks    []Hook
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *PlaylistCreate) SetOwnerID(v uuid.UUID) *PlaylistCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PlaylistCreate) SetName(v string) *PlaylistCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPublic sets the "public" field.
+func (_c *PlaylistCreate) SetPublic(v bool) *PlaylistCreate {
+	_c.mutation.SetPublic(v)
+	return _c
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillablePublic(v *bool) *PlaylistCreate {
+	if v != nil {
+		_c.SetPublic(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlaylistCreate) SetCreatedAt(v time.Time) *PlaylistCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableCreatedAt(v *time.Time) *PlaylistCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlaylistCreate) SetUpdatedAt(v time.Time) *PlaylistCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableUpdatedAt(v *time.Time) *PlaylistCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTrackOrder sets the "track_order" field.
+func (_c *PlaylistCreate) SetTrackOrder(v []uuid.UUID) *PlaylistCreate {
+	_c.mutation.SetTrackOrder(v)
+	return _c
+}
+
+// SetClock sets the "clock" field.
+func (_c *PlaylistCreate) SetClock(v map[string]time.Time) *PlaylistCreate {
+	_c.mutation.SetClock(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaylistCreate) SetID(v uuid.UUID) *PlaylistCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaylistCreate) SetNillableID(v *uuid.UUID) *PlaylistCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *PlaylistCreate) SetOwner(v *User) *PlaylistCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (_c *PlaylistCreate) AddTrackIDs(ids ...uuid.UUID) *PlaylistCreate {
+	_c.mutation.AddTrackIDs(ids...)
+	return _c
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (_c *PlaylistCreate) AddTracks(v ...*Track) *PlaylistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTrackIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (_c *PlaylistCreate) Mutation() *PlaylistMutation {
+	return _c.mutation
+}
+
+// Save creates the Playlist in the database.
+func (_c *PlaylistCreate) Save(ctx context.Context) (*Playlist, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaylistCreate) SaveX(ctx context.Context) *Playlist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaylistCreate) defaults() error {
+	if _, ok := _c.mutation.Public(); !ok {
+		v := playlist.DefaultPublic
+		_c.mutation.SetPublic(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if playlist.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if playlist.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if playlist.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized playlist.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := playlist.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaylistCreate) check() error {
+	if _, ok := _c.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Playlist.owner_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Playlist.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := playlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Playlist.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Public(); !ok {
+		return &ValidationError{Name: "public", err: errors.New(`ent: missing required field "Playlist.public"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Playlist.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Playlist.updated_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Playlist.owner"`)}
+	}
+	return nil
+}
+
+func (_c *PlaylistCreate) sqlSave(ctx context.Context) (*Playlist, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaylistCreate) createSpec() (*Playlist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Playlist{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playlist.Table, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Public(); ok {
+		_spec.SetField(playlist.FieldPublic, field.TypeBool, value)
+		_node.Public = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(playlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TrackOrder(); ok {
+		_spec.SetField(playlist.FieldTrackOrder, field.TypeJSON, value)
+		_node.TrackOrder = value
+	}
+	if value, ok := _c.mutation.Clock(); ok {
+		_spec.SetField(playlist.FieldClock, field.TypeJSON, value)
+		_node.Clock = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlaylistCreateBulk is the builder for creating many Playlist entities in bulk.
+type PlaylistCreateBulk struct {
+	config
+	err      error
+	builders []*PlaylistCreate
+}
+
+// Save creates the Playlist entities in the database.
+func (_c *PlaylistCreateBulk) Save(ctx context.Context) ([]*Playlist, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Playlist, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaylistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaylistCreateBulk) SaveX(ctx context.Context) []*Playlist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/playlist"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PlaylistUpdate is the builder for updating Playlist entities.
+type PlaylistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (_u *PlaylistUpdate) Where(ps ...predicate.Playlist) *PlaylistUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *PlaylistUpdate) SetOwnerID(v uuid.UUID) *PlaylistUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillableOwnerID(v *uuid.UUID) *PlaylistUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlaylistUpdate) SetName(v string) *PlaylistUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillableName(v *string) *PlaylistUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *PlaylistUpdate) SetPublic(v bool) *PlaylistUpdate {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *PlaylistUpdate) SetNillablePublic(v *bool) *PlaylistUpdate {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaylistUpdate) SetUpdatedAt(v time.Time) *PlaylistUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTrackOrder sets the "track_order" field.
+func (_u *PlaylistUpdate) SetTrackOrder(v []uuid.UUID) *PlaylistUpdate {
+	_u.mutation.SetTrackOrder(v)
+	return _u
+}
+
+// AppendTrackOrder appends value to the "track_order" field.
+func (_u *PlaylistUpdate) AppendTrackOrder(v []uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AppendTrackOrder(v)
+	return _u
+}
+
+// ClearTrackOrder clears the value of the "track_order" field.
+func (_u *PlaylistUpdate) ClearTrackOrder() *PlaylistUpdate {
+	_u.mutation.ClearTrackOrder()
+	return _u
+}
+
+// SetClock sets the "clock" field.
+func (_u *PlaylistUpdate) SetClock(v map[string]time.Time) *PlaylistUpdate {
+	_u.mutation.SetClock(v)
+	return _u
+}
+
+// ClearClock clears the value of the "clock" field.
+func (_u *PlaylistUpdate) ClearClock() *PlaylistUpdate {
+	_u.mutation.ClearClock()
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *PlaylistUpdate) SetOwner(v *User) *PlaylistUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (_u *PlaylistUpdate) AddTrackIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.AddTrackIDs(ids...)
+	return _u
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (_u *PlaylistUpdate) AddTracks(v ...*Track) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTrackIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (_u *PlaylistUpdate) Mutation() *PlaylistMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *PlaylistUpdate) ClearOwner() *PlaylistUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearTracks clears all "tracks" edges to the Track entity.
+func (_u *PlaylistUpdate) ClearTracks() *PlaylistUpdate {
+	_u.mutation.ClearTracks()
+	return _u
+}
+
+// RemoveTrackIDs removes the "tracks" edge to Track entities by IDs.
+func (_u *PlaylistUpdate) RemoveTrackIDs(ids ...uuid.UUID) *PlaylistUpdate {
+	_u.mutation.RemoveTrackIDs(ids...)
+	return _u
+}
+
+// RemoveTracks removes "tracks" edges to Track entities.
+func (_u *PlaylistUpdate) RemoveTracks(v ...*Track) *PlaylistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTrackIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaylistUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaylistUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaylistUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if playlist.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := playlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Playlist.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Playlist.owner"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(playlist.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TrackOrder(); ok {
+		_spec.SetField(playlist.FieldTrackOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrackOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldTrackOrder, value)
+		})
+	}
+	if _u.mutation.TrackOrderCleared() {
+		_spec.ClearField(playlist.FieldTrackOrder, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Clock(); ok {
+		_spec.SetField(playlist.FieldClock, field.TypeJSON, value)
+	}
+	if _u.mutation.ClockCleared() {
+		_spec.ClearField(playlist.FieldClock, field.TypeJSON)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTracksIDs(); len(nodes) > 0 && !_u.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaylistUpdateOne is the builder for updating a single Playlist entity.
+type PlaylistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistMutation
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *PlaylistUpdateOne) SetOwnerID(v uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillableOwnerID(v *uuid.UUID) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlaylistUpdateOne) SetName(v string) *PlaylistUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillableName(v *string) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPublic sets the "public" field.
+func (_u *PlaylistUpdateOne) SetPublic(v bool) *PlaylistUpdateOne {
+	_u.mutation.SetPublic(v)
+	return _u
+}
+
+// SetNillablePublic sets the "public" field if the given value is not nil.
+func (_u *PlaylistUpdateOne) SetNillablePublic(v *bool) *PlaylistUpdateOne {
+	if v != nil {
+		_u.SetPublic(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlaylistUpdateOne) SetUpdatedAt(v time.Time) *PlaylistUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTrackOrder sets the "track_order" field.
+func (_u *PlaylistUpdateOne) SetTrackOrder(v []uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.SetTrackOrder(v)
+	return _u
+}
+
+// AppendTrackOrder appends value to the "track_order" field.
+func (_u *PlaylistUpdateOne) AppendTrackOrder(v []uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AppendTrackOrder(v)
+	return _u
+}
+
+// ClearTrackOrder clears the value of the "track_order" field.
+func (_u *PlaylistUpdateOne) ClearTrackOrder() *PlaylistUpdateOne {
+	_u.mutation.ClearTrackOrder()
+	return _u
+}
+
+// SetClock sets the "clock" field.
+func (_u *PlaylistUpdateOne) SetClock(v map[string]time.Time) *PlaylistUpdateOne {
+	_u.mutation.SetClock(v)
+	return _u
+}
+
+// ClearClock clears the value of the "clock" field.
+func (_u *PlaylistUpdateOne) ClearClock() *PlaylistUpdateOne {
+	_u.mutation.ClearClock()
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *PlaylistUpdateOne) SetOwner(v *User) *PlaylistUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddTrackIDs adds the "tracks" edge to the Track entity by IDs.
+func (_u *PlaylistUpdateOne) AddTrackIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.AddTrackIDs(ids...)
+	return _u
+}
+
+// AddTracks adds the "tracks" edges to the Track entity.
+func (_u *PlaylistUpdateOne) AddTracks(v ...*Track) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTrackIDs(ids...)
+}
+
+// Mutation returns the PlaylistMutation object of the builder.
+func (_u *PlaylistUpdateOne) Mutation() *PlaylistMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *PlaylistUpdateOne) ClearOwner() *PlaylistUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearTracks clears all "tracks" edges to the Track entity.
+func (_u *PlaylistUpdateOne) ClearTracks() *PlaylistUpdateOne {
+	_u.mutation.ClearTracks()
+	return _u
+}
+
+// RemoveTrackIDs removes the "tracks" edge to Track entities by IDs.
+func (_u *PlaylistUpdateOne) RemoveTrackIDs(ids ...uuid.UUID) *PlaylistUpdateOne {
+	_u.mutation.RemoveTrackIDs(ids...)
+	return _u
+}
+
+// RemoveTracks removes "tracks" edges to Track entities.
+func (_u *PlaylistUpdateOne) RemoveTracks(v ...*Track) *PlaylistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTrackIDs(ids...)
+}
+
+// Where appends a list predicates to the PlaylistUpdate builder.
+func (_u *PlaylistUpdateOne) Where(ps ...predicate.Playlist) *PlaylistUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaylistUpdateOne) Select(field string, fields ...string) *PlaylistUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Playlist entity.
+func (_u *PlaylistUpdateOne) Save(ctx context.Context) (*Playlist, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistUpdateOne) SaveX(ctx context.Context) *Playlist {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaylistUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PlaylistUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if playlist.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized playlist.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := playlist.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := playlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Playlist.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Playlist.owner"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistUpdateOne) sqlSave(ctx context.Context) (_node *Playlist, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlist.Table, playlist.Columns, sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Playlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlist.FieldID)
+		for _, f := range fields {
+			if !playlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != playlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(playlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Public(); ok {
+		_spec.SetField(playlist.FieldPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(playlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TrackOrder(); ok {
+		_spec.SetField(playlist.FieldTrackOrder, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrackOrder(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, playlist.FieldTrackOrder, value)
+		})
+	}
+	if _u.mutation.TrackOrderCleared() {
+		_spec.ClearField(playlist.FieldTrackOrder, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Clock(); ok {
+		_spec.SetField(playlist.FieldClock, field.TypeJSON, value)
+	}
+	if _u.mutation.ClockCleared() {
+		_spec.ClearField(playlist.FieldClock, field.TypeJSON)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlist.OwnerTable,
+			Columns: []string{playlist.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTracksIDs(); len(nodes) > 0 && !_u.mutation.TracksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TracksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   playlist.TracksTable,
+			Columns: playlist.TracksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Playlist{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PolicyAcceptance is the model entity for the PolicyAcceptance schema.
+type PolicyAcceptance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user who accepted
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The version accepted
+	PolicyVersionID uuid.UUID `json:"policy_version_id,omitempty"`
+	// Client address the acceptance came from
+	IP string `json:"ip,omitempty"`
+	// When the version was accepted
+	AcceptedAt time.Time `json:"accepted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyAcceptanceQuery when eager-loading is set.
+	Edges        PolicyAcceptanceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyAcceptanceEdges holds the relations/edges for other nodes in the graph.
+type PolicyAcceptanceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// PolicyVersion holds the value of the policy_version edge.
+	PolicyVersion *PolicyVersion `json:"policy_version,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyAcceptanceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// PolicyVersionOrErr returns the PolicyVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyAcceptanceEdges) PolicyVersionOrErr() (*PolicyVersion, error) {
+	if e.PolicyVersion != nil {
+		return e.PolicyVersion, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: policyversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "policy_version"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyAcceptance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyacceptance.FieldIP:
+			values[i] = new(sql.NullString)
+		case policyacceptance.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		case policyacceptance.FieldID, policyacceptance.FieldUserID, policyacceptance.FieldPolicyVersionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyAcceptance fields.
+func (_m *PolicyAcceptance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyacceptance.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyacceptance.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case policyacceptance.FieldPolicyVersionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_version_id", values[i])
+			} else if value != nil {
+				_m.PolicyVersionID = *value
+			}
+		case policyacceptance.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case policyacceptance.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				_m.AcceptedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyAcceptance.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyAcceptance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PolicyAcceptance entity.
+func (_m *PolicyAcceptance) QueryUser() *UserQuery {
+	return NewPolicyAcceptanceClient(_m.config).QueryUser(_m)
+}
+
+// QueryPolicyVersion queries the "policy_version" edge of the PolicyAcceptance entity.
+func (_m *PolicyAcceptance) QueryPolicyVersion() *PolicyVersionQuery {
+	return NewPolicyAcceptanceClient(_m.config).QueryPolicyVersion(_m)
+}
+
+// Update returns a builder for updating this PolicyAcceptance.
+// Note that you need to call PolicyAcceptance.Unwrap() before calling this method if this PolicyAcceptance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyAcceptance) Update() *PolicyAcceptanceUpdateOne {
+	return NewPolicyAcceptanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyAcceptance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyAcceptance) Unwrap() *PolicyAcceptance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyAcceptance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyAcceptance) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyAcceptance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("policy_version_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyVersionID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("accepted_at=")
+	builder.WriteString(_m.AcceptedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyAcceptances is a parsable slice of PolicyAcceptance.
+type PolicyAcceptances []*PolicyAcceptance
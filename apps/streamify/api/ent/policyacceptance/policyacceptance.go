@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyacceptance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the policyacceptance type in the database.
+	Label = "policy_acceptance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldPolicyVersionID holds the string denoting the policy_version_id field in the database.
+	FieldPolicyVersionID = "policy_version_id"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgePolicyVersion holds the string denoting the policy_version edge name in mutations.
+	EdgePolicyVersion = "policy_version"
+	// Table holds the table name of the policyacceptance in the database.
+	Table = "policy_acceptances"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "policy_acceptances"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// PolicyVersionTable is the table that holds the policy_version relation/edge.
+	PolicyVersionTable = "policy_acceptances"
+	// PolicyVersionInverseTable is the table name for the PolicyVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "policyversion" package.
+	PolicyVersionInverseTable = "policy_versions"
+	// PolicyVersionColumn is the table column denoting the policy_version relation/edge.
+	PolicyVersionColumn = "policy_version_id"
+)
+
+// Columns holds all SQL columns for policyacceptance fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldPolicyVersionID,
+	FieldIP,
+	FieldAcceptedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAcceptedAt holds the default value on creation for the "accepted_at" field.
+	DefaultAcceptedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PolicyAcceptance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByPolicyVersionID orders the results by the policy_version_id field.
+func ByPolicyVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyVersionID, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByAcceptedAt orders the results by the accepted_at field.
+func ByAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPolicyVersionField orders the results by policy_version field.
+func ByPolicyVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPolicyVersionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newPolicyVersionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PolicyVersionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PolicyVersionTable, PolicyVersionColumn),
+	)
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyacceptance
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldUserID, v))
+}
+
+// PolicyVersionID applies equality check predicate on the "policy_version_id" field. It's identical to PolicyVersionIDEQ.
+func PolicyVersionID(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldPolicyVersionID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldIP, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// PolicyVersionIDEQ applies the EQ predicate on the "policy_version_id" field.
+func PolicyVersionIDEQ(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldPolicyVersionID, v))
+}
+
+// PolicyVersionIDNEQ applies the NEQ predicate on the "policy_version_id" field.
+func PolicyVersionIDNEQ(v uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldPolicyVersionID, v))
+}
+
+// PolicyVersionIDIn applies the In predicate on the "policy_version_id" field.
+func PolicyVersionIDIn(vs ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldPolicyVersionID, vs...))
+}
+
+// PolicyVersionIDNotIn applies the NotIn predicate on the "policy_version_id" field.
+func PolicyVersionIDNotIn(vs ...uuid.UUID) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldPolicyVersionID, vs...))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPIsNil applies the IsNil predicate on the "ip" field.
+func IPIsNil() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIsNull(FieldIP))
+}
+
+// IPNotNil applies the NotNil predicate on the "ip" field.
+func IPNotNil() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotNull(FieldIP))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldContainsFold(FieldIP, v))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPolicyVersion applies the HasEdge predicate on the "policy_version" edge.
+func HasPolicyVersion() predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, PolicyVersionTable, PolicyVersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyVersionWith applies the HasEdge predicate on the "policy_version" edge with a given conditions (other predicates).
+func HasPolicyVersionWith(preds ...predicate.PolicyVersion) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(func(s *sql.Selector) {
+		step := newPolicyVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyAcceptance) predicate.PolicyAcceptance {
+	return predicate.PolicyAcceptance(sql.NotPredicates(p))
+}
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PolicyAcceptanceCreate is the builder for creating a PolicyAcceptance entity.
+type PolicyAcceptanceCreate struct {
+	config
+	mutation *PolicyAcceptanceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PolicyAcceptanceCreate) SetUserID(v uuid.UUID) *PolicyAcceptanceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPolicyVersionID sets the "policy_version_id" field.
+func (_c *PolicyAcceptanceCreate) SetPolicyVersionID(v uuid.UUID) *PolicyAcceptanceCreate {
+	_c.mutation.SetPolicyVersionID(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *PolicyAcceptanceCreate) SetIP(v string) *PolicyAcceptanceCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *PolicyAcceptanceCreate) SetNillableIP(v *string) *PolicyAcceptanceCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_c *PolicyAcceptanceCreate) SetAcceptedAt(v time.Time) *PolicyAcceptanceCreate {
+	_c.mutation.SetAcceptedAt(v)
+	return _c
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_c *PolicyAcceptanceCreate) SetNillableAcceptedAt(v *time.Time) *PolicyAcceptanceCreate {
+	if v != nil {
+		_c.SetAcceptedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyAcceptanceCreate) SetID(v uuid.UUID) *PolicyAcceptanceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyAcceptanceCreate) SetNillableID(v *uuid.UUID) *PolicyAcceptanceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PolicyAcceptanceCreate) SetUser(v *User) *PolicyAcceptanceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetPolicyVersion sets the "policy_version" edge to the PolicyVersion entity.
+func (_c *PolicyAcceptanceCreate) SetPolicyVersion(v *PolicyVersion) *PolicyAcceptanceCreate {
+	return _c.SetPolicyVersionID(v.ID)
+}
+
+// Mutation returns the PolicyAcceptanceMutation object of the builder.
+func (_c *PolicyAcceptanceCreate) Mutation() *PolicyAcceptanceMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyAcceptance in the database.
+func (_c *PolicyAcceptanceCreate) Save(ctx context.Context) (*PolicyAcceptance, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyAcceptanceCreate) SaveX(ctx context.Context) *PolicyAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyAcceptanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyAcceptanceCreate) defaults() {
+	if _, ok := _c.mutation.AcceptedAt(); !ok {
+		v := policyacceptance.DefaultAcceptedAt()
+		_c.mutation.SetAcceptedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyacceptance.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyAcceptanceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PolicyAcceptance.user_id"`)}
+	}
+	if _, ok := _c.mutation.PolicyVersionID(); !ok {
+		return &ValidationError{Name: "policy_version_id", err: errors.New(`ent: missing required field "PolicyAcceptance.policy_version_id"`)}
+	}
+	if _, ok := _c.mutation.AcceptedAt(); !ok {
+		return &ValidationError{Name: "accepted_at", err: errors.New(`ent: missing required field "PolicyAcceptance.accepted_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PolicyAcceptance.user"`)}
+	}
+	if len(_c.mutation.PolicyVersionIDs()) == 0 {
+		return &ValidationError{Name: "policy_version", err: errors.New(`ent: missing required edge "PolicyAcceptance.policy_version"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyAcceptanceCreate) sqlSave(ctx context.Context) (*PolicyAcceptance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyAcceptanceCreate) createSpec() (*PolicyAcceptance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyAcceptance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyacceptance.Table, sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(policyacceptance.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.AcceptedAt(); ok {
+		_spec.SetField(policyacceptance.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyacceptance.UserTable,
+			Columns: []string{policyacceptance.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PolicyVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyacceptance.PolicyVersionTable,
+			Columns: []string{policyacceptance.PolicyVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PolicyVersionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PolicyAcceptanceCreateBulk is the builder for creating many PolicyAcceptance entities in bulk.
+type PolicyAcceptanceCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyAcceptanceCreate
+}
+
+// Save creates the PolicyAcceptance entities in the database.
+func (_c *PolicyAcceptanceCreateBulk) Save(ctx context.Context) ([]*PolicyAcceptance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyAcceptance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyAcceptanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreateBulk) SaveX(ctx context.Context) []*PolicyAcceptance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyAcceptanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyAcceptanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
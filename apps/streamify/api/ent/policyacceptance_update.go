@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PolicyAcceptanceUpdate is the builder for updating PolicyAcceptance entities.
+type PolicyAcceptanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PolicyAcceptanceMutation
+}
+
+// Where appends a list predicates to the PolicyAcceptanceUpdate builder.
+func (_u *PolicyAcceptanceUpdate) Where(ps ...predicate.PolicyAcceptance) *PolicyAcceptanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PolicyAcceptanceMutation object of the builder.
+func (_u *PolicyAcceptanceUpdate) Mutation() *PolicyAcceptanceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyAcceptanceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyAcceptanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyAcceptanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyAcceptanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyAcceptanceUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyAcceptance.user"`)
+	}
+	if _u.mutation.PolicyVersionCleared() && len(_u.mutation.PolicyVersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyAcceptance.policy_version"`)
+	}
+	return nil
+}
+
+func (_u *PolicyAcceptanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyacceptance.Table, policyacceptance.Columns, sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(policyacceptance.FieldIP, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyacceptance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyAcceptanceUpdateOne is the builder for updating a single PolicyAcceptance entity.
+type PolicyAcceptanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PolicyAcceptanceMutation
+}
+
+// Mutation returns the PolicyAcceptanceMutation object of the builder.
+func (_u *PolicyAcceptanceUpdateOne) Mutation() *PolicyAcceptanceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PolicyAcceptanceUpdate builder.
+func (_u *PolicyAcceptanceUpdateOne) Where(ps ...predicate.PolicyAcceptance) *PolicyAcceptanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyAcceptanceUpdateOne) Select(field string, fields ...string) *PolicyAcceptanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyAcceptance entity.
+func (_u *PolicyAcceptanceUpdateOne) Save(ctx context.Context) (*PolicyAcceptance, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyAcceptanceUpdateOne) SaveX(ctx context.Context) *PolicyAcceptance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyAcceptanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyAcceptanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyAcceptanceUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyAcceptance.user"`)
+	}
+	if _u.mutation.PolicyVersionCleared() && len(_u.mutation.PolicyVersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyAcceptance.policy_version"`)
+	}
+	return nil
+}
+
+func (_u *PolicyAcceptanceUpdateOne) sqlSave(ctx context.Context) (_node *PolicyAcceptance, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyacceptance.Table, policyacceptance.Columns, sqlgraph.NewFieldSpec(policyacceptance.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyAcceptance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyacceptance.FieldID)
+		for _, f := range fields {
+			if !policyacceptance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyacceptance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(policyacceptance.FieldIP, field.TypeString)
+	}
+	_node = &PolicyAcceptance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyacceptance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
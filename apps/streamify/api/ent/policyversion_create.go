@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/policyversion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PolicyVersionCreate is the builder for creating a PolicyVersion entity.
+type PolicyVersionCreate struct {
+	config
+	mutation *PolicyVersionMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *PolicyVersionCreate) SetKind(v policyversion.Kind) *PolicyVersionCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *PolicyVersionCreate) SetVersion(v string) *PolicyVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *PolicyVersionCreate) SetURL(v string) *PolicyVersionCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableURL(v *string) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetURL(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *PolicyVersionCreate) SetSummary(v string) *PolicyVersionCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableSummary(v *string) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (_c *PolicyVersionCreate) SetPublishedBy(v uuid.UUID) *PolicyVersionCreate {
+	_c.mutation.SetPublishedBy(v)
+	return _c
+}
+
+// SetNillablePublishedBy sets the "published_by" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillablePublishedBy(v *uuid.UUID) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetPublishedBy(*v)
+	}
+	return _c
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_c *PolicyVersionCreate) SetPublishedAt(v time.Time) *PolicyVersionCreate {
+	_c.mutation.SetPublishedAt(v)
+	return _c
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillablePublishedAt(v *time.Time) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetPublishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyVersionCreate) SetID(v uuid.UUID) *PolicyVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyVersionCreate) SetNillableID(v *uuid.UUID) *PolicyVersionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PolicyVersionMutation object of the builder.
+func (_c *PolicyVersionCreate) Mutation() *PolicyVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyVersion in the database.
+func (_c *PolicyVersionCreate) Save(ctx context.Context) (*PolicyVersion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyVersionCreate) SaveX(ctx context.Context) *PolicyVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyVersionCreate) defaults() {
+	if _, ok := _c.mutation.PublishedAt(); !ok {
+		v := policyversion.DefaultPublishedAt()
+		_c.mutation.SetPublishedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyversion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyVersionCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "PolicyVersion.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := policyversion.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PolicyVersion.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PolicyVersion.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := policyversion.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "PolicyVersion.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublishedAt(); !ok {
+		return &ValidationError{Name: "published_at", err: errors.New(`ent: missing required field "PolicyVersion.published_at"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyVersionCreate) sqlSave(ctx context.Context) (*PolicyVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyVersionCreate) createSpec() (*PolicyVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyversion.Table, sqlgraph.NewFieldSpec(policyversion.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(policyversion.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(policyversion.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(policyversion.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(policyversion.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.PublishedBy(); ok {
+		_spec.SetField(policyversion.FieldPublishedBy, field.TypeUUID, value)
+		_node.PublishedBy = &value
+	}
+	if value, ok := _c.mutation.PublishedAt(); ok {
+		_spec.SetField(policyversion.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = value
+	}
+	return _node, _spec
+}
+
+// PolicyVersionCreateBulk is the builder for creating many PolicyVersion entities in bulk.
+type PolicyVersionCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyVersionCreate
+}
+
+// Save creates the PolicyVersion entities in the database.
+func (_c *PolicyVersionCreateBulk) Save(ctx context.Context) ([]*PolicyVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyVersionCreateBulk) SaveX(ctx context.Context) []*PolicyVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,14 +6,128 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// APIKeyUsage is the predicate function for apikeyusage builders.
+type APIKeyUsage func(*sql.Selector)
+
 // Album is the predicate function for album builders.
 type Album func(*sql.Selector)
 
 // Artist is the predicate function for artist builders.
 type Artist func(*sql.Selector)
 
+// AudioFingerprint is the predicate function for audiofingerprint builders.
+type AudioFingerprint func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Backup is the predicate function for backup builders.
+type Backup func(*sql.Selector)
+
+// Block is the predicate function for block builders.
+type Block func(*sql.Selector)
+
+// ChangelogEntry is the predicate function for changelogentry builders.
+type ChangelogEntry func(*sql.Selector)
+
+// Confirmation is the predicate function for confirmation builders.
+type Confirmation func(*sql.Selector)
+
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
+// DeviceAuthorization is the predicate function for deviceauthorization builders.
+type DeviceAuthorization func(*sql.Selector)
+
+// DuplicateReview is the predicate function for duplicatereview builders.
+type DuplicateReview func(*sql.Selector)
+
+// Entitlement is the predicate function for entitlement builders.
+type Entitlement func(*sql.Selector)
+
+// ExternalIdentity is the predicate function for externalidentity builders.
+type ExternalIdentity func(*sql.Selector)
+
+// Follow is the predicate function for follow builders.
+type Follow func(*sql.Selector)
+
+// GuestState is the predicate function for gueststate builders.
+type GuestState func(*sql.Selector)
+
+// Invite is the predicate function for invite builders.
+type Invite func(*sql.Selector)
+
+// Like is the predicate function for like builders.
+type Like func(*sql.Selector)
+
+// Operation is the predicate function for operation builders.
+type Operation func(*sql.Selector)
+
+// Play is the predicate function for play builders.
+type Play func(*sql.Selector)
+
+// PlayCount is the predicate function for playcount builders.
+type PlayCount func(*sql.Selector)
+
+// Playlist is the predicate function for playlist builders.
+type Playlist func(*sql.Selector)
+
+// PolicyAcceptance is the predicate function for policyacceptance builders.
+type PolicyAcceptance func(*sql.Selector)
+
+// PolicyVersion is the predicate function for policyversion builders.
+type PolicyVersion func(*sql.Selector)
+
+// PromoCode is the predicate function for promocode builders.
+type PromoCode func(*sql.Selector)
+
+// PromoRedemption is the predicate function for promoredemption builders.
+type PromoRedemption func(*sql.Selector)
+
+// Referral is the predicate function for referral builders.
+type Referral func(*sql.Selector)
+
+// SCIMGroup is the predicate function for scimgroup builders.
+type SCIMGroup func(*sql.Selector)
+
+// SSOProvider is the predicate function for ssoprovider builders.
+type SSOProvider func(*sql.Selector)
+
+// SecurityAlert is the predicate function for securityalert builders.
+type SecurityAlert func(*sql.Selector)
+
+// ShareLink is the predicate function for sharelink builders.
+type ShareLink func(*sql.Selector)
+
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
+// SupportAttachment is the predicate function for supportattachment builders.
+type SupportAttachment func(*sql.Selector)
+
+// SupportReply is the predicate function for supportreply builders.
+type SupportReply func(*sql.Selector)
+
+// SupportTicket is the predicate function for supportticket builders.
+type SupportTicket func(*sql.Selector)
+
+// Tombstone is the predicate function for tombstone builders.
+type Tombstone func(*sql.Selector)
+
 // Track is the predicate function for track builders.
 type Track func(*sql.Selector)
 
+// TrackCredit is the predicate function for trackcredit builders.
+type TrackCredit func(*sql.Selector)
+
+// UploadSession is the predicate function for uploadsession builders.
+type UploadSession func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
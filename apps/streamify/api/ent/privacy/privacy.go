@@ -0,0 +1,1119 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacy
+
+import (
+	"context"
+
+	"streamify/ent"
+
+	"entgo.io/ent/privacy"
+)
+
+var (
+	// Allow may be returned by rules to indicate that the policy
+	// evaluation should terminate with allow decision.
+	Allow = privacy.Allow
+
+	// Deny may be returned by rules to indicate that the policy
+	// evaluation should terminate with deny decision.
+	Deny = privacy.Deny
+
+	// Skip may be returned by rules to indicate that the policy
+	// evaluation should continue to the next rule.
+	Skip = privacy.Skip
+)
+
+// Allowf returns a formatted wrapped Allow decision.
+func Allowf(format string, a ...any) error {
+	return privacy.Allowf(format, a...)
+}
+
+// Denyf returns a formatted wrapped Deny decision.
+func Denyf(format string, a ...any) error {
+	return privacy.Denyf(format, a...)
+}
+
+// Skipf returns a formatted wrapped Skip decision.
+func Skipf(format string, a ...any) error {
+	return privacy.Skipf(format, a...)
+}
+
+// DecisionContext creates a new context from the given parent context with
+// a policy decision attach to it.
+func DecisionContext(parent context.Context, decision error) context.Context {
+	return privacy.DecisionContext(parent, decision)
+}
+
+// DecisionFromContext retrieves the policy decision from the context.
+func DecisionFromContext(ctx context.Context) (error, bool) {
+	return privacy.DecisionFromContext(ctx)
+}
+
+type (
+	// Policy groups query and mutation policies.
+	Policy = privacy.Policy
+
+	// QueryRule defines the interface deciding whether a
+	// query is allowed and optionally modify it.
+	QueryRule = privacy.QueryRule
+	// QueryPolicy combines multiple query rules into a single policy.
+	QueryPolicy = privacy.QueryPolicy
+
+	// MutationRule defines the interface which decides whether a
+	// mutation is allowed and optionally modifies it.
+	MutationRule = privacy.MutationRule
+	// MutationPolicy combines multiple mutation rules into a single policy.
+	MutationPolicy = privacy.MutationPolicy
+	// MutationRuleFunc type is an adapter which allows the use of
+	// ordinary functions as mutation rules.
+	MutationRuleFunc = privacy.MutationRuleFunc
+
+	// QueryMutationRule is an interface which groups query and mutation rules.
+	QueryMutationRule = privacy.QueryMutationRule
+)
+
+// QueryRuleFunc type is an adapter to allow the use of
+// ordinary functions as query rules.
+type QueryRuleFunc func(context.Context, ent.Query) error
+
+// Eval returns f(ctx, q).
+func (f QueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	return f(ctx, q)
+}
+
+// AlwaysAllowRule returns a rule that returns an allow decision.
+func AlwaysAllowRule() QueryMutationRule {
+	return privacy.AlwaysAllowRule()
+}
+
+// AlwaysDenyRule returns a rule that returns a deny decision.
+func AlwaysDenyRule() QueryMutationRule {
+	return privacy.AlwaysDenyRule()
+}
+
+// ContextQueryMutationRule creates a query/mutation rule from a context eval func.
+func ContextQueryMutationRule(eval func(context.Context) error) QueryMutationRule {
+	return privacy.ContextQueryMutationRule(eval)
+}
+
+// OnMutationOperation evaluates the given rule only on a given mutation operation.
+func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
+	return privacy.OnMutationOperation(rule, op)
+}
+
+// DenyMutationOperationRule returns a rule denying specified mutation operation.
+func DenyMutationOperationRule(op ent.Op) MutationRule {
+	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
+		return Denyf("ent/privacy: operation %s is not allowed", m.Op())
+	})
+	return OnMutationOperation(rule, op)
+}
+
+// The APIKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type APIKeyQueryRuleFunc func(context.Context, *ent.APIKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f APIKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.APIKeyQuery", q)
+}
+
+// The APIKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type APIKeyMutationRuleFunc func(context.Context, *ent.APIKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f APIKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.APIKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.APIKeyMutation", m)
+}
+
+// The APIKeyUsageQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type APIKeyUsageQueryRuleFunc func(context.Context, *ent.APIKeyUsageQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f APIKeyUsageQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.APIKeyUsageQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.APIKeyUsageQuery", q)
+}
+
+// The APIKeyUsageMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type APIKeyUsageMutationRuleFunc func(context.Context, *ent.APIKeyUsageMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f APIKeyUsageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.APIKeyUsageMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.APIKeyUsageMutation", m)
+}
+
+// The AlbumQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AlbumQueryRuleFunc func(context.Context, *ent.AlbumQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AlbumQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AlbumQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AlbumQuery", q)
+}
+
+// The AlbumMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AlbumMutationRuleFunc func(context.Context, *ent.AlbumMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AlbumMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AlbumMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AlbumMutation", m)
+}
+
+// The ArtistQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ArtistQueryRuleFunc func(context.Context, *ent.ArtistQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ArtistQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArtistQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ArtistQuery", q)
+}
+
+// The ArtistMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ArtistMutationRuleFunc func(context.Context, *ent.ArtistMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ArtistMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ArtistMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArtistMutation", m)
+}
+
+// The AudioFingerprintQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AudioFingerprintQueryRuleFunc func(context.Context, *ent.AudioFingerprintQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AudioFingerprintQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AudioFingerprintQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AudioFingerprintQuery", q)
+}
+
+// The AudioFingerprintMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AudioFingerprintMutationRuleFunc func(context.Context, *ent.AudioFingerprintMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AudioFingerprintMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AudioFingerprintMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AudioFingerprintMutation", m)
+}
+
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The BackupQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BackupQueryRuleFunc func(context.Context, *ent.BackupQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BackupQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BackupQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BackupQuery", q)
+}
+
+// The BackupMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BackupMutationRuleFunc func(context.Context, *ent.BackupMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BackupMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BackupMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BackupMutation", m)
+}
+
+// The BlockQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BlockQueryRuleFunc func(context.Context, *ent.BlockQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BlockQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BlockQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BlockQuery", q)
+}
+
+// The BlockMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BlockMutationRuleFunc func(context.Context, *ent.BlockMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BlockMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BlockMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BlockMutation", m)
+}
+
+// The ChangelogEntryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ChangelogEntryQueryRuleFunc func(context.Context, *ent.ChangelogEntryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ChangelogEntryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChangelogEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ChangelogEntryQuery", q)
+}
+
+// The ChangelogEntryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ChangelogEntryMutationRuleFunc func(context.Context, *ent.ChangelogEntryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ChangelogEntryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ChangelogEntryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ChangelogEntryMutation", m)
+}
+
+// The ConfirmationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ConfirmationQueryRuleFunc func(context.Context, *ent.ConfirmationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ConfirmationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConfirmationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ConfirmationQuery", q)
+}
+
+// The ConfirmationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ConfirmationMutationRuleFunc func(context.Context, *ent.ConfirmationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ConfirmationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ConfirmationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ConfirmationMutation", m)
+}
+
+// The DeadLetterQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeadLetterQueryRuleFunc func(context.Context, *ent.DeadLetterQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeadLetterQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeadLetterQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeadLetterQuery", q)
+}
+
+// The DeadLetterMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeadLetterMutationRuleFunc func(context.Context, *ent.DeadLetterMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeadLetterMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeadLetterMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeadLetterMutation", m)
+}
+
+// The DeviceAuthorizationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeviceAuthorizationQueryRuleFunc func(context.Context, *ent.DeviceAuthorizationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeviceAuthorizationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeviceAuthorizationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeviceAuthorizationQuery", q)
+}
+
+// The DeviceAuthorizationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeviceAuthorizationMutationRuleFunc func(context.Context, *ent.DeviceAuthorizationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeviceAuthorizationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeviceAuthorizationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceAuthorizationMutation", m)
+}
+
+// The DuplicateReviewQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DuplicateReviewQueryRuleFunc func(context.Context, *ent.DuplicateReviewQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DuplicateReviewQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DuplicateReviewQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DuplicateReviewQuery", q)
+}
+
+// The DuplicateReviewMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DuplicateReviewMutationRuleFunc func(context.Context, *ent.DuplicateReviewMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DuplicateReviewMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DuplicateReviewMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DuplicateReviewMutation", m)
+}
+
+// The EntitlementQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type EntitlementQueryRuleFunc func(context.Context, *ent.EntitlementQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f EntitlementQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EntitlementQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.EntitlementQuery", q)
+}
+
+// The EntitlementMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type EntitlementMutationRuleFunc func(context.Context, *ent.EntitlementMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f EntitlementMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.EntitlementMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.EntitlementMutation", m)
+}
+
+// The ExternalIdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ExternalIdentityQueryRuleFunc func(context.Context, *ent.ExternalIdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ExternalIdentityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ExternalIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ExternalIdentityQuery", q)
+}
+
+// The ExternalIdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ExternalIdentityMutationRuleFunc func(context.Context, *ent.ExternalIdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ExternalIdentityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ExternalIdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ExternalIdentityMutation", m)
+}
+
+// The FollowQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FollowQueryRuleFunc func(context.Context, *ent.FollowQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FollowQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FollowQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FollowQuery", q)
+}
+
+// The FollowMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FollowMutationRuleFunc func(context.Context, *ent.FollowMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FollowMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FollowMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FollowMutation", m)
+}
+
+// The GuestStateQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type GuestStateQueryRuleFunc func(context.Context, *ent.GuestStateQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f GuestStateQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GuestStateQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.GuestStateQuery", q)
+}
+
+// The GuestStateMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type GuestStateMutationRuleFunc func(context.Context, *ent.GuestStateMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f GuestStateMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.GuestStateMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.GuestStateMutation", m)
+}
+
+// The InviteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InviteQueryRuleFunc func(context.Context, *ent.InviteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InviteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InviteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InviteQuery", q)
+}
+
+// The InviteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InviteMutationRuleFunc func(context.Context, *ent.InviteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InviteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InviteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InviteMutation", m)
+}
+
+// The LikeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LikeQueryRuleFunc func(context.Context, *ent.LikeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LikeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LikeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LikeQuery", q)
+}
+
+// The LikeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LikeMutationRuleFunc func(context.Context, *ent.LikeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LikeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LikeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LikeMutation", m)
+}
+
+// The OperationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OperationQueryRuleFunc func(context.Context, *ent.OperationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OperationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OperationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OperationQuery", q)
+}
+
+// The OperationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OperationMutationRuleFunc func(context.Context, *ent.OperationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OperationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OperationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OperationMutation", m)
+}
+
+// The PlayQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PlayQueryRuleFunc func(context.Context, *ent.PlayQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PlayQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlayQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PlayQuery", q)
+}
+
+// The PlayMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PlayMutationRuleFunc func(context.Context, *ent.PlayMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PlayMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PlayMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PlayMutation", m)
+}
+
+// The PlayCountQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PlayCountQueryRuleFunc func(context.Context, *ent.PlayCountQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PlayCountQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlayCountQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PlayCountQuery", q)
+}
+
+// The PlayCountMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PlayCountMutationRuleFunc func(context.Context, *ent.PlayCountMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PlayCountMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PlayCountMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PlayCountMutation", m)
+}
+
+// The PlaylistQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PlaylistQueryRuleFunc func(context.Context, *ent.PlaylistQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PlaylistQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PlaylistQuery", q)
+}
+
+// The PlaylistMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PlaylistMutationRuleFunc func(context.Context, *ent.PlaylistMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PlaylistMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PlaylistMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PlaylistMutation", m)
+}
+
+// The PolicyAcceptanceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PolicyAcceptanceQueryRuleFunc func(context.Context, *ent.PolicyAcceptanceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PolicyAcceptanceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PolicyAcceptanceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PolicyAcceptanceQuery", q)
+}
+
+// The PolicyAcceptanceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PolicyAcceptanceMutationRuleFunc func(context.Context, *ent.PolicyAcceptanceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PolicyAcceptanceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PolicyAcceptanceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PolicyAcceptanceMutation", m)
+}
+
+// The PolicyVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PolicyVersionQueryRuleFunc func(context.Context, *ent.PolicyVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PolicyVersionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PolicyVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PolicyVersionQuery", q)
+}
+
+// The PolicyVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PolicyVersionMutationRuleFunc func(context.Context, *ent.PolicyVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PolicyVersionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PolicyVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PolicyVersionMutation", m)
+}
+
+// The PromoCodeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PromoCodeQueryRuleFunc func(context.Context, *ent.PromoCodeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PromoCodeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PromoCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PromoCodeQuery", q)
+}
+
+// The PromoCodeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PromoCodeMutationRuleFunc func(context.Context, *ent.PromoCodeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PromoCodeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PromoCodeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PromoCodeMutation", m)
+}
+
+// The PromoRedemptionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PromoRedemptionQueryRuleFunc func(context.Context, *ent.PromoRedemptionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PromoRedemptionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PromoRedemptionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PromoRedemptionQuery", q)
+}
+
+// The PromoRedemptionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PromoRedemptionMutationRuleFunc func(context.Context, *ent.PromoRedemptionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PromoRedemptionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PromoRedemptionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PromoRedemptionMutation", m)
+}
+
+// The ReferralQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ReferralQueryRuleFunc func(context.Context, *ent.ReferralQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ReferralQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReferralQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ReferralQuery", q)
+}
+
+// The ReferralMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ReferralMutationRuleFunc func(context.Context, *ent.ReferralMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ReferralMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ReferralMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ReferralMutation", m)
+}
+
+// The SCIMGroupQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SCIMGroupQueryRuleFunc func(context.Context, *ent.SCIMGroupQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SCIMGroupQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SCIMGroupQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SCIMGroupQuery", q)
+}
+
+// The SCIMGroupMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SCIMGroupMutationRuleFunc func(context.Context, *ent.SCIMGroupMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SCIMGroupMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SCIMGroupMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SCIMGroupMutation", m)
+}
+
+// The SSOProviderQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SSOProviderQueryRuleFunc func(context.Context, *ent.SSOProviderQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SSOProviderQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SSOProviderQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SSOProviderQuery", q)
+}
+
+// The SSOProviderMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SSOProviderMutationRuleFunc func(context.Context, *ent.SSOProviderMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SSOProviderMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SSOProviderMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SSOProviderMutation", m)
+}
+
+// The SecurityAlertQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SecurityAlertQueryRuleFunc func(context.Context, *ent.SecurityAlertQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SecurityAlertQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SecurityAlertQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SecurityAlertQuery", q)
+}
+
+// The SecurityAlertMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SecurityAlertMutationRuleFunc func(context.Context, *ent.SecurityAlertMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SecurityAlertMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SecurityAlertMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SecurityAlertMutation", m)
+}
+
+// The ShareLinkQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareLinkQueryRuleFunc func(context.Context, *ent.ShareLinkQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareLinkQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareLinkQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareLinkQuery", q)
+}
+
+// The ShareLinkMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareLinkMutationRuleFunc func(context.Context, *ent.ShareLinkMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareLinkMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareLinkMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareLinkMutation", m)
+}
+
+// The SigningKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SigningKeyQueryRuleFunc func(context.Context, *ent.SigningKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SigningKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SigningKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SigningKeyQuery", q)
+}
+
+// The SigningKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SigningKeyMutationRuleFunc func(context.Context, *ent.SigningKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SigningKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SigningKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SigningKeyMutation", m)
+}
+
+// The SupportAttachmentQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SupportAttachmentQueryRuleFunc func(context.Context, *ent.SupportAttachmentQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SupportAttachmentQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SupportAttachmentQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SupportAttachmentQuery", q)
+}
+
+// The SupportAttachmentMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SupportAttachmentMutationRuleFunc func(context.Context, *ent.SupportAttachmentMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SupportAttachmentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SupportAttachmentMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SupportAttachmentMutation", m)
+}
+
+// The SupportReplyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SupportReplyQueryRuleFunc func(context.Context, *ent.SupportReplyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SupportReplyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SupportReplyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SupportReplyQuery", q)
+}
+
+// The SupportReplyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SupportReplyMutationRuleFunc func(context.Context, *ent.SupportReplyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SupportReplyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SupportReplyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SupportReplyMutation", m)
+}
+
+// The SupportTicketQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SupportTicketQueryRuleFunc func(context.Context, *ent.SupportTicketQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SupportTicketQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SupportTicketQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SupportTicketQuery", q)
+}
+
+// The SupportTicketMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SupportTicketMutationRuleFunc func(context.Context, *ent.SupportTicketMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SupportTicketMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SupportTicketMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SupportTicketMutation", m)
+}
+
+// The TombstoneQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TombstoneQueryRuleFunc func(context.Context, *ent.TombstoneQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TombstoneQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TombstoneQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TombstoneQuery", q)
+}
+
+// The TombstoneMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TombstoneMutationRuleFunc func(context.Context, *ent.TombstoneMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TombstoneMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TombstoneMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TombstoneMutation", m)
+}
+
+// The TrackQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TrackQueryRuleFunc func(context.Context, *ent.TrackQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TrackQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TrackQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TrackQuery", q)
+}
+
+// The TrackMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TrackMutationRuleFunc func(context.Context, *ent.TrackMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TrackMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TrackMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TrackMutation", m)
+}
+
+// The TrackCreditQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TrackCreditQueryRuleFunc func(context.Context, *ent.TrackCreditQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TrackCreditQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TrackCreditQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TrackCreditQuery", q)
+}
+
+// The TrackCreditMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TrackCreditMutationRuleFunc func(context.Context, *ent.TrackCreditMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TrackCreditMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TrackCreditMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TrackCreditMutation", m)
+}
+
+// The UploadSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UploadSessionQueryRuleFunc func(context.Context, *ent.UploadSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UploadSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UploadSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UploadSessionQuery", q)
+}
+
+// The UploadSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UploadSessionMutationRuleFunc func(context.Context, *ent.UploadSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UploadSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UploadSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UploadSessionMutation", m)
+}
+
+// The UserQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserQuery", q)
+}
+
+// The UserMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserMutationRuleFunc func(context.Context, *ent.UserMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
+}
+
+// The WaitlistEntryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WaitlistEntryQueryRuleFunc func(context.Context, *ent.WaitlistEntryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WaitlistEntryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WaitlistEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WaitlistEntryQuery", q)
+}
+
+// The WaitlistEntryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WaitlistEntryMutationRuleFunc func(context.Context, *ent.WaitlistEntryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WaitlistEntryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WaitlistEntryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WaitlistEntryMutation", m)
+}
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promocode
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the promocode type in the database.
+	Label = "promo_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldPercentOff holds the string denoting the percent_off field in the database.
+	FieldPercentOff = "percent_off"
+	// FieldMonths holds the string denoting the months field in the database.
+	FieldMonths = "months"
+	// FieldFeature holds the string denoting the feature field in the database.
+	FieldFeature = "feature"
+	// FieldMaxRedemptions holds the string denoting the max_redemptions field in the database.
+	FieldMaxRedemptions = "max_redemptions"
+	// FieldPerUserLimit holds the string denoting the per_user_limit field in the database.
+	FieldPerUserLimit = "per_user_limit"
+	// FieldRedemptions holds the string denoting the redemptions field in the database.
+	FieldRedemptions = "redemptions"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCreator holds the string denoting the creator edge name in mutations.
+	EdgeCreator = "creator"
+	// EdgeRedeemed holds the string denoting the redeemed edge name in mutations.
+	EdgeRedeemed = "redeemed"
+	// Table holds the table name of the promocode in the database.
+	Table = "promo_codes"
+	// CreatorTable is the table that holds the creator relation/edge.
+	CreatorTable = "promo_codes"
+	// CreatorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	CreatorInverseTable = "users"
+	// CreatorColumn is the table column denoting the creator relation/edge.
+	CreatorColumn = "created_by"
+	// RedeemedTable is the table that holds the redeemed relation/edge.
+	RedeemedTable = "promo_redemptions"
+	// RedeemedInverseTable is the table name for the PromoRedemption entity.
+	// It exists in this package in order to avoid circular dependency with the "promoredemption" package.
+	RedeemedInverseTable = "promo_redemptions"
+	// RedeemedColumn is the table column denoting the redeemed relation/edge.
+	RedeemedColumn = "code_id"
+)
+
+// Columns holds all SQL columns for promocode fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldKind,
+	FieldPercentOff,
+	FieldMonths,
+	FieldFeature,
+	FieldMaxRedemptions,
+	FieldPerUserLimit,
+	FieldRedemptions,
+	FieldCreatedBy,
+	FieldExpiresAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// PercentOffValidator is a validator for the "percent_off" field. It is called by the builders before save.
+	PercentOffValidator func(int) error
+	// MonthsValidator is a validator for the "months" field. It is called by the builders before save.
+	MonthsValidator func(int) error
+	// MaxRedemptionsValidator is a validator for the "max_redemptions" field. It is called by the builders before save.
+	MaxRedemptionsValidator func(int) error
+	// DefaultPerUserLimit holds the default value on creation for the "per_user_limit" field.
+	DefaultPerUserLimit int
+	// PerUserLimitValidator is a validator for the "per_user_limit" field. It is called by the builders before save.
+	PerUserLimitValidator func(int) error
+	// DefaultRedemptions holds the default value on creation for the "redemptions" field.
+	DefaultRedemptions int
+	// RedemptionsValidator is a validator for the "redemptions" field. It is called by the builders before save.
+	RedemptionsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindPercentOff    Kind = "percent_off"
+	KindFreeMonths    Kind = "free_months"
+	KindFeatureUnlock Kind = "feature_unlock"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindPercentOff, KindFreeMonths, KindFeatureUnlock:
+		return nil
+	default:
+		return fmt.Errorf("promocode: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Feature defines the type for the "feature" enum field.
+type Feature string
+
+// Feature values.
+const (
+	FeaturePremium   Feature = "premium"
+	FeatureDownloads Feature = "downloads"
+)
+
+func (f Feature) String() string {
+	return string(f)
+}
+
+// FeatureValidator is a validator for the "feature" field enum values. It is called by the builders before save.
+func FeatureValidator(f Feature) error {
+	switch f {
+	case FeaturePremium, FeatureDownloads:
+		return nil
+	default:
+		return fmt.Errorf("promocode: invalid enum value for feature field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the PromoCode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByPercentOff orders the results by the percent_off field.
+func ByPercentOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentOff, opts...).ToFunc()
+}
+
+// ByMonths orders the results by the months field.
+func ByMonths(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonths, opts...).ToFunc()
+}
+
+// ByFeature orders the results by the feature field.
+func ByFeature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeature, opts...).ToFunc()
+}
+
+// ByMaxRedemptions orders the results by the max_redemptions field.
+func ByMaxRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRedemptions, opts...).ToFunc()
+}
+
+// ByPerUserLimit orders the results by the per_user_limit field.
+func ByPerUserLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPerUserLimit, opts...).ToFunc()
+}
+
+// ByRedemptions orders the results by the redemptions field.
+func ByRedemptions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedemptions, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCreatorField orders the results by creator field.
+func ByCreatorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreatorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRedeemedCount orders the results by redeemed count.
+func ByRedeemedCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRedeemedStep(), opts...)
+	}
+}
+
+// ByRedeemed orders the results by redeemed terms.
+func ByRedeemed(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRedeemedStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCreatorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreatorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CreatorTable, CreatorColumn),
+	)
+}
+func newRedeemedStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RedeemedInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RedeemedTable, RedeemedColumn),
+	)
+}
@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promocode
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCode, v))
+}
+
+// PercentOff applies equality check predicate on the "percent_off" field. It's identical to PercentOffEQ.
+func PercentOff(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// Months applies equality check predicate on the "months" field. It's identical to MonthsEQ.
+func Months(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMonths, v))
+}
+
+// MaxRedemptions applies equality check predicate on the "max_redemptions" field. It's identical to MaxRedemptionsEQ.
+func MaxRedemptions(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// PerUserLimit applies equality check predicate on the "per_user_limit" field. It's identical to PerUserLimitEQ.
+func PerUserLimit(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldPerUserLimit, v))
+}
+
+// Redemptions applies equality check predicate on the "redemptions" field. It's identical to RedemptionsEQ.
+func Redemptions(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldRedemptions, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// PercentOffEQ applies the EQ predicate on the "percent_off" field.
+func PercentOffEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// PercentOffNEQ applies the NEQ predicate on the "percent_off" field.
+func PercentOffNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldPercentOff, v))
+}
+
+// PercentOffIn applies the In predicate on the "percent_off" field.
+func PercentOffIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldPercentOff, vs...))
+}
+
+// PercentOffNotIn applies the NotIn predicate on the "percent_off" field.
+func PercentOffNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldPercentOff, vs...))
+}
+
+// PercentOffGT applies the GT predicate on the "percent_off" field.
+func PercentOffGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldPercentOff, v))
+}
+
+// PercentOffGTE applies the GTE predicate on the "percent_off" field.
+func PercentOffGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldPercentOff, v))
+}
+
+// PercentOffLT applies the LT predicate on the "percent_off" field.
+func PercentOffLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldPercentOff, v))
+}
+
+// PercentOffLTE applies the LTE predicate on the "percent_off" field.
+func PercentOffLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldPercentOff, v))
+}
+
+// PercentOffIsNil applies the IsNil predicate on the "percent_off" field.
+func PercentOffIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldPercentOff))
+}
+
+// PercentOffNotNil applies the NotNil predicate on the "percent_off" field.
+func PercentOffNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldPercentOff))
+}
+
+// MonthsEQ applies the EQ predicate on the "months" field.
+func MonthsEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMonths, v))
+}
+
+// MonthsNEQ applies the NEQ predicate on the "months" field.
+func MonthsNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMonths, v))
+}
+
+// MonthsIn applies the In predicate on the "months" field.
+func MonthsIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMonths, vs...))
+}
+
+// MonthsNotIn applies the NotIn predicate on the "months" field.
+func MonthsNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMonths, vs...))
+}
+
+// MonthsGT applies the GT predicate on the "months" field.
+func MonthsGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMonths, v))
+}
+
+// MonthsGTE applies the GTE predicate on the "months" field.
+func MonthsGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMonths, v))
+}
+
+// MonthsLT applies the LT predicate on the "months" field.
+func MonthsLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMonths, v))
+}
+
+// MonthsLTE applies the LTE predicate on the "months" field.
+func MonthsLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMonths, v))
+}
+
+// MonthsIsNil applies the IsNil predicate on the "months" field.
+func MonthsIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMonths))
+}
+
+// MonthsNotNil applies the NotNil predicate on the "months" field.
+func MonthsNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMonths))
+}
+
+// FeatureEQ applies the EQ predicate on the "feature" field.
+func FeatureEQ(v Feature) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldFeature, v))
+}
+
+// FeatureNEQ applies the NEQ predicate on the "feature" field.
+func FeatureNEQ(v Feature) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldFeature, v))
+}
+
+// FeatureIn applies the In predicate on the "feature" field.
+func FeatureIn(vs ...Feature) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldFeature, vs...))
+}
+
+// FeatureNotIn applies the NotIn predicate on the "feature" field.
+func FeatureNotIn(vs ...Feature) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldFeature, vs...))
+}
+
+// FeatureIsNil applies the IsNil predicate on the "feature" field.
+func FeatureIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldFeature))
+}
+
+// FeatureNotNil applies the NotNil predicate on the "feature" field.
+func FeatureNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldFeature))
+}
+
+// MaxRedemptionsEQ applies the EQ predicate on the "max_redemptions" field.
+func MaxRedemptionsEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsNEQ applies the NEQ predicate on the "max_redemptions" field.
+func MaxRedemptionsNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIn applies the In predicate on the "max_redemptions" field.
+func MaxRedemptionsIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsNotIn applies the NotIn predicate on the "max_redemptions" field.
+func MaxRedemptionsNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxRedemptions, vs...))
+}
+
+// MaxRedemptionsGT applies the GT predicate on the "max_redemptions" field.
+func MaxRedemptionsGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsGTE applies the GTE predicate on the "max_redemptions" field.
+func MaxRedemptionsGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLT applies the LT predicate on the "max_redemptions" field.
+func MaxRedemptionsLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsLTE applies the LTE predicate on the "max_redemptions" field.
+func MaxRedemptionsLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxRedemptions, v))
+}
+
+// MaxRedemptionsIsNil applies the IsNil predicate on the "max_redemptions" field.
+func MaxRedemptionsIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMaxRedemptions))
+}
+
+// MaxRedemptionsNotNil applies the NotNil predicate on the "max_redemptions" field.
+func MaxRedemptionsNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMaxRedemptions))
+}
+
+// PerUserLimitEQ applies the EQ predicate on the "per_user_limit" field.
+func PerUserLimitEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldPerUserLimit, v))
+}
+
+// PerUserLimitNEQ applies the NEQ predicate on the "per_user_limit" field.
+func PerUserLimitNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldPerUserLimit, v))
+}
+
+// PerUserLimitIn applies the In predicate on the "per_user_limit" field.
+func PerUserLimitIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldPerUserLimit, vs...))
+}
+
+// PerUserLimitNotIn applies the NotIn predicate on the "per_user_limit" field.
+func PerUserLimitNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldPerUserLimit, vs...))
+}
+
+// PerUserLimitGT applies the GT predicate on the "per_user_limit" field.
+func PerUserLimitGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldPerUserLimit, v))
+}
+
+// PerUserLimitGTE applies the GTE predicate on the "per_user_limit" field.
+func PerUserLimitGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldPerUserLimit, v))
+}
+
+// PerUserLimitLT applies the LT predicate on the "per_user_limit" field.
+func PerUserLimitLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldPerUserLimit, v))
+}
+
+// PerUserLimitLTE applies the LTE predicate on the "per_user_limit" field.
+func PerUserLimitLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldPerUserLimit, v))
+}
+
+// RedemptionsEQ applies the EQ predicate on the "redemptions" field.
+func RedemptionsEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldRedemptions, v))
+}
+
+// RedemptionsNEQ applies the NEQ predicate on the "redemptions" field.
+func RedemptionsNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldRedemptions, v))
+}
+
+// RedemptionsIn applies the In predicate on the "redemptions" field.
+func RedemptionsIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldRedemptions, vs...))
+}
+
+// RedemptionsNotIn applies the NotIn predicate on the "redemptions" field.
+func RedemptionsNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldRedemptions, vs...))
+}
+
+// RedemptionsGT applies the GT predicate on the "redemptions" field.
+func RedemptionsGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldRedemptions, v))
+}
+
+// RedemptionsGTE applies the GTE predicate on the "redemptions" field.
+func RedemptionsGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldRedemptions, v))
+}
+
+// RedemptionsLT applies the LT predicate on the "redemptions" field.
+func RedemptionsLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldRedemptions, v))
+}
+
+// RedemptionsLTE applies the LTE predicate on the "redemptions" field.
+func RedemptionsLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldRedemptions, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCreator applies the HasEdge predicate on the "creator" edge.
+func HasCreator() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CreatorTable, CreatorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatorWith applies the HasEdge predicate on the "creator" edge with a given conditions (other predicates).
+func HasCreatorWith(preds ...predicate.User) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := newCreatorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRedeemed applies the HasEdge predicate on the "redeemed" edge.
+func HasRedeemed() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RedeemedTable, RedeemedColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRedeemedWith applies the HasEdge predicate on the "redeemed" edge with a given conditions (other predicates).
+func HasRedeemedWith(preds ...predicate.PromoRedemption) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := newRedeemedStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.NotPredicates(p))
+}
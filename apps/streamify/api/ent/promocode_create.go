@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PromoCodeCreate is the builder for creating a PromoCode entity.
+type PromoCodeCreate struct {
+	config
+	mutation *PromoCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *PromoCodeCreate) SetCode(v string) *PromoCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *PromoCodeCreate) SetKind(v promocode.Kind) *PromoCodeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (_c *PromoCodeCreate) SetPercentOff(v int) *PromoCodeCreate {
+	_c.mutation.SetPercentOff(v)
+	return _c
+}
+
+// SetNillablePercentOff sets the "percent_off" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillablePercentOff(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetPercentOff(*v)
+	}
+	return _c
+}
+
+// SetMonths sets the "months" field.
+func (_c *PromoCodeCreate) SetMonths(v int) *PromoCodeCreate {
+	_c.mutation.SetMonths(v)
+	return _c
+}
+
+// SetNillableMonths sets the "months" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMonths(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMonths(*v)
+	}
+	return _c
+}
+
+// SetFeature sets the "feature" field.
+func (_c *PromoCodeCreate) SetFeature(v promocode.Feature) *PromoCodeCreate {
+	_c.mutation.SetFeature(v)
+	return _c
+}
+
+// SetNillableFeature sets the "feature" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableFeature(v *promocode.Feature) *PromoCodeCreate {
+	if v != nil {
+		_c.SetFeature(*v)
+	}
+	return _c
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_c *PromoCodeCreate) SetMaxRedemptions(v int) *PromoCodeCreate {
+	_c.mutation.SetMaxRedemptions(v)
+	return _c
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxRedemptions(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxRedemptions(*v)
+	}
+	return _c
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_c *PromoCodeCreate) SetPerUserLimit(v int) *PromoCodeCreate {
+	_c.mutation.SetPerUserLimit(v)
+	return _c
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillablePerUserLimit(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetPerUserLimit(*v)
+	}
+	return _c
+}
+
+// SetRedemptions sets the "redemptions" field.
+func (_c *PromoCodeCreate) SetRedemptions(v int) *PromoCodeCreate {
+	_c.mutation.SetRedemptions(v)
+	return _c
+}
+
+// SetNillableRedemptions sets the "redemptions" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableRedemptions(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetRedemptions(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PromoCodeCreate) SetCreatedBy(v uuid.UUID) *PromoCodeCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreatedBy(v *uuid.UUID) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PromoCodeCreate) SetExpiresAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableExpiresAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoCodeCreate) SetCreatedAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreatedAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoCodeCreate) SetID(v uuid.UUID) *PromoCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableID(v *uuid.UUID) *PromoCodeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_c *PromoCodeCreate) SetCreatorID(id uuid.UUID) *PromoCodeCreate {
+	_c.mutation.SetCreatorID(id)
+	return _c
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreatorID(id *uuid.UUID) *PromoCodeCreate {
+	if id != nil {
+		_c = _c.SetCreatorID(*id)
+	}
+	return _c
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_c *PromoCodeCreate) SetCreator(v *User) *PromoCodeCreate {
+	return _c.SetCreatorID(v.ID)
+}
+
+// AddRedeemedIDs adds the "redeemed" edge to the PromoRedemption entity by IDs.
+func (_c *PromoCodeCreate) AddRedeemedIDs(ids ...uuid.UUID) *PromoCodeCreate {
+	_c.mutation.AddRedeemedIDs(ids...)
+	return _c
+}
+
+// AddRedeemed adds the "redeemed" edges to the PromoRedemption entity.
+func (_c *PromoCodeCreate) AddRedeemed(v ...*PromoRedemption) *PromoCodeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRedeemedIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_c *PromoCodeCreate) Mutation() *PromoCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoCode in the database.
+func (_c *PromoCodeCreate) Save(ctx context.Context) (*PromoCode, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoCodeCreate) SaveX(ctx context.Context) *PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoCodeCreate) defaults() error {
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		v := promocode.DefaultPerUserLimit
+		_c.mutation.SetPerUserLimit(v)
+	}
+	if _, ok := _c.mutation.Redemptions(); !ok {
+		v := promocode.DefaultRedemptions
+		_c.mutation.SetRedemptions(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if promocode.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promocode.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := promocode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if promocode.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized promocode.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := promocode.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PromoCode.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := promocode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PromoCode.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "PromoCode.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := promocode.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PromoCode.kind": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PercentOff(); ok {
+		if err := promocode.PercentOffValidator(v); err != nil {
+			return &ValidationError{Name: "percent_off", err: fmt.Errorf(`ent: validator failed for field "PromoCode.percent_off": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Months(); ok {
+		if err := promocode.MonthsValidator(v); err != nil {
+			return &ValidationError{Name: "months", err: fmt.Errorf(`ent: validator failed for field "PromoCode.months": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Feature(); ok {
+		if err := promocode.FeatureValidator(v); err != nil {
+			return &ValidationError{Name: "feature", err: fmt.Errorf(`ent: validator failed for field "PromoCode.feature": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		return &ValidationError{Name: "per_user_limit", err: errors.New(`ent: missing required field "PromoCode.per_user_limit"`)}
+	}
+	if v, ok := _c.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`ent: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Redemptions(); !ok {
+		return &ValidationError{Name: "redemptions", err: errors.New(`ent: missing required field "PromoCode.redemptions"`)}
+	}
+	if v, ok := _c.mutation.Redemptions(); ok {
+		if err := promocode.RedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.redemptions": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromoCode.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PromoCodeCreate) sqlSave(ctx context.Context) (*PromoCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promocode.Table, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(promocode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(promocode.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.PercentOff(); ok {
+		_spec.SetField(promocode.FieldPercentOff, field.TypeInt, value)
+		_node.PercentOff = &value
+	}
+	if value, ok := _c.mutation.Months(); ok {
+		_spec.SetField(promocode.FieldMonths, field.TypeInt, value)
+		_node.Months = &value
+	}
+	if value, ok := _c.mutation.Feature(); ok {
+		_spec.SetField(promocode.FieldFeature, field.TypeEnum, value)
+		_node.Feature = &value
+	}
+	if value, ok := _c.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+		_node.MaxRedemptions = &value
+	}
+	if value, ok := _c.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+		_node.PerUserLimit = value
+	}
+	if value, ok := _c.mutation.Redemptions(); ok {
+		_spec.SetField(promocode.FieldRedemptions, field.TypeInt, value)
+		_node.Redemptions = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promocode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   promocode.CreatorTable,
+			Columns: []string{promocode.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedBy = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RedeemedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromoCodeCreateBulk is the builder for creating many PromoCode entities in bulk.
+type PromoCodeCreateBulk struct {
+	config
+	err      error
+	builders []*PromoCodeCreate
+}
+
+// Save creates the PromoCode entities in the database.
+func (_c *PromoCodeCreateBulk) Save(ctx context.Context) ([]*PromoCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) SaveX(ctx context.Context) []*PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
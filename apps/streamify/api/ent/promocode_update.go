@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PromoCodeUpdate is the builder for updating PromoCode entities.
+type PromoCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdate) Where(ps ...predicate.PromoCode) *PromoCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *PromoCodeUpdate) SetMaxRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxRedemptions(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *PromoCodeUpdate) AddMaxRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *PromoCodeUpdate) ClearMaxRedemptions() *PromoCodeUpdate {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PromoCodeUpdate) SetPerUserLimit(v int) *PromoCodeUpdate {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillablePerUserLimit(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PromoCodeUpdate) AddPerUserLimit(v int) *PromoCodeUpdate {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetRedemptions sets the "redemptions" field.
+func (_u *PromoCodeUpdate) SetRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.ResetRedemptions()
+	_u.mutation.SetRedemptions(v)
+	return _u
+}
+
+// SetNillableRedemptions sets the "redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableRedemptions(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetRedemptions(*v)
+	}
+	return _u
+}
+
+// AddRedemptions adds value to the "redemptions" field.
+func (_u *PromoCodeUpdate) AddRedemptions(v int) *PromoCodeUpdate {
+	_u.mutation.AddRedemptions(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoCodeUpdate) SetExpiresAt(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableExpiresAt(v *time.Time) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoCodeUpdate) ClearExpiresAt() *PromoCodeUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// AddRedeemedIDs adds the "redeemed" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdate) AddRedeemedIDs(ids ...uuid.UUID) *PromoCodeUpdate {
+	_u.mutation.AddRedeemedIDs(ids...)
+	return _u
+}
+
+// AddRedeemed adds the "redeemed" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) AddRedeemed(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedeemedIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdate) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedeemed clears all "redeemed" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) ClearRedeemed() *PromoCodeUpdate {
+	_u.mutation.ClearRedeemed()
+	return _u
+}
+
+// RemoveRedeemedIDs removes the "redeemed" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdate) RemoveRedeemedIDs(ids ...uuid.UUID) *PromoCodeUpdate {
+	_u.mutation.RemoveRedeemedIDs(ids...)
+	return _u
+}
+
+// RemoveRedeemed removes "redeemed" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdate) RemoveRedeemed(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedeemedIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdate) check() error {
+	if v, ok := _u.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`ent: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Redemptions(); ok {
+		if err := promocode.RedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.redemptions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PercentOffCleared() {
+		_spec.ClearField(promocode.FieldPercentOff, field.TypeInt)
+	}
+	if _u.mutation.MonthsCleared() {
+		_spec.ClearField(promocode.FieldMonths, field.TypeInt)
+	}
+	if _u.mutation.FeatureCleared() {
+		_spec.ClearField(promocode.FieldFeature, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promocode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Redemptions(); ok {
+		_spec.SetField(promocode.FieldRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedemptions(); ok {
+		_spec.AddField(promocode.FieldRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promocode.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.RedeemedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedeemedIDs(); len(nodes) > 0 && !_u.mutation.RedeemedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedeemedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoCodeUpdateOne is the builder for updating a single PromoCode entity.
+type PromoCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// SetMaxRedemptions sets the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) SetMaxRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxRedemptions()
+	_u.mutation.SetMaxRedemptions(v)
+	return _u
+}
+
+// SetNillableMaxRedemptions sets the "max_redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxRedemptions(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxRedemptions(*v)
+	}
+	return _u
+}
+
+// AddMaxRedemptions adds value to the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) AddMaxRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxRedemptions(v)
+	return _u
+}
+
+// ClearMaxRedemptions clears the value of the "max_redemptions" field.
+func (_u *PromoCodeUpdateOne) ClearMaxRedemptions() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxRedemptions()
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PromoCodeUpdateOne) SetPerUserLimit(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillablePerUserLimit(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PromoCodeUpdateOne) AddPerUserLimit(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetRedemptions sets the "redemptions" field.
+func (_u *PromoCodeUpdateOne) SetRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetRedemptions()
+	_u.mutation.SetRedemptions(v)
+	return _u
+}
+
+// SetNillableRedemptions sets the "redemptions" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableRedemptions(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetRedemptions(*v)
+	}
+	return _u
+}
+
+// AddRedemptions adds value to the "redemptions" field.
+func (_u *PromoCodeUpdateOne) AddRedemptions(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddRedemptions(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PromoCodeUpdateOne) SetExpiresAt(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PromoCodeUpdateOne) ClearExpiresAt() *PromoCodeUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// AddRedeemedIDs adds the "redeemed" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdateOne) AddRedeemedIDs(ids ...uuid.UUID) *PromoCodeUpdateOne {
+	_u.mutation.AddRedeemedIDs(ids...)
+	return _u
+}
+
+// AddRedeemed adds the "redeemed" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) AddRedeemed(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedeemedIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdateOne) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedeemed clears all "redeemed" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) ClearRedeemed() *PromoCodeUpdateOne {
+	_u.mutation.ClearRedeemed()
+	return _u
+}
+
+// RemoveRedeemedIDs removes the "redeemed" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdateOne) RemoveRedeemedIDs(ids ...uuid.UUID) *PromoCodeUpdateOne {
+	_u.mutation.RemoveRedeemedIDs(ids...)
+	return _u
+}
+
+// RemoveRedeemed removes "redeemed" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdateOne) RemoveRedeemed(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedeemedIDs(ids...)
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdateOne) Where(ps ...predicate.PromoCode) *PromoCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoCodeUpdateOne) Select(field string, fields ...string) *PromoCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoCode entity.
+func (_u *PromoCodeUpdateOne) Save(ctx context.Context) (*PromoCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) SaveX(ctx context.Context) *PromoCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxRedemptions(); ok {
+		if err := promocode.MaxRedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_redemptions": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PerUserLimit(); ok {
+		if err := promocode.PerUserLimitValidator(v); err != nil {
+			return &ValidationError{Name: "per_user_limit", err: fmt.Errorf(`ent: validator failed for field "PromoCode.per_user_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Redemptions(); ok {
+		if err := promocode.RedemptionsValidator(v); err != nil {
+			return &ValidationError{Name: "redemptions", err: fmt.Errorf(`ent: validator failed for field "PromoCode.redemptions": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromoCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promocode.FieldID)
+		for _, f := range fields {
+			if !promocode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promocode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PercentOffCleared() {
+		_spec.ClearField(promocode.FieldPercentOff, field.TypeInt)
+	}
+	if _u.mutation.MonthsCleared() {
+		_spec.ClearField(promocode.FieldMonths, field.TypeInt)
+	}
+	if _u.mutation.FeatureCleared() {
+		_spec.ClearField(promocode.FieldFeature, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxRedemptions(); ok {
+		_spec.SetField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRedemptions(); ok {
+		_spec.AddField(promocode.FieldMaxRedemptions, field.TypeInt, value)
+	}
+	if _u.mutation.MaxRedemptionsCleared() {
+		_spec.ClearField(promocode.FieldMaxRedemptions, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(promocode.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Redemptions(); ok {
+		_spec.SetField(promocode.FieldRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRedemptions(); ok {
+		_spec.AddField(promocode.FieldRedemptions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(promocode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(promocode.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.RedeemedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedeemedIDs(); len(nodes) > 0 && !_u.mutation.RedeemedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedeemedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   promocode.RedeemedTable,
+			Columns: []string{promocode.RedeemedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
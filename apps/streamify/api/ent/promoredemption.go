@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/entitlement"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PromoRedemption is the model entity for the PromoRedemption schema.
+type PromoRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The promo code redeemed
+	CodeID uuid.UUID `json:"code_id,omitempty"`
+	// The user who redeemed it
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The entitlement granted, for free_months and feature_unlock codes
+	EntitlementID *uuid.UUID `json:"entitlement_id,omitempty"`
+	// The discount owed, for percent_off codes
+	PercentOff *int `json:"percent_off,omitempty"`
+	// When the discount stops applying; unset for a discount that doesn't end
+	DiscountEndsAt *time.Time `json:"discount_ends_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromoRedemptionQuery when eager-loading is set.
+	Edges        PromoRedemptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromoRedemptionEdges holds the relations/edges for other nodes in the graph.
+type PromoRedemptionEdges struct {
+	// Code holds the value of the code edge.
+	Code *PromoCode `json:"code,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Entitlement holds the value of the entitlement edge.
+	Entitlement *Entitlement `json:"entitlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CodeOrErr returns the Code value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) CodeOrErr() (*PromoCode, error) {
+	if e.Code != nil {
+		return e.Code, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: promocode.Label}
+	}
+	return nil, &NotLoadedError{edge: "code"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// EntitlementOrErr returns the Entitlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromoRedemptionEdges) EntitlementOrErr() (*Entitlement, error) {
+	if e.Entitlement != nil {
+		return e.Entitlement, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: entitlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "entitlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromoRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldEntitlementID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case promoredemption.FieldPercentOff:
+			values[i] = new(sql.NullInt64)
+		case promoredemption.FieldDiscountEndsAt, promoredemption.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case promoredemption.FieldID, promoredemption.FieldCodeID, promoredemption.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromoRedemption fields.
+func (_m *PromoRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promoredemption.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case promoredemption.FieldCodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field code_id", values[i])
+			} else if value != nil {
+				_m.CodeID = *value
+			}
+		case promoredemption.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case promoredemption.FieldEntitlementID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field entitlement_id", values[i])
+			} else if value.Valid {
+				_m.EntitlementID = new(uuid.UUID)
+				*_m.EntitlementID = *value.S.(*uuid.UUID)
+			}
+		case promoredemption.FieldPercentOff:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field percent_off", values[i])
+			} else if value.Valid {
+				_m.PercentOff = new(int)
+				*_m.PercentOff = int(value.Int64)
+			}
+		case promoredemption.FieldDiscountEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_ends_at", values[i])
+			} else if value.Valid {
+				_m.DiscountEndsAt = new(time.Time)
+				*_m.DiscountEndsAt = value.Time
+			}
+		case promoredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromoRedemption.
+// This includes values selected through modifiers, order, etc.
+func (_m *PromoRedemption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCode queries the "code" edge of the PromoRedemption entity.
+func (_m *PromoRedemption) QueryCode() *PromoCodeQuery {
+	return NewPromoRedemptionClient(_m.config).QueryCode(_m)
+}
+
+// QueryUser queries the "user" edge of the PromoRedemption entity.
+func (_m *PromoRedemption) QueryUser() *UserQuery {
+	return NewPromoRedemptionClient(_m.config).QueryUser(_m)
+}
+
+// QueryEntitlement queries the "entitlement" edge of the PromoRedemption entity.
+func (_m *PromoRedemption) QueryEntitlement() *EntitlementQuery {
+	return NewPromoRedemptionClient(_m.config).QueryEntitlement(_m)
+}
+
+// Update returns a builder for updating this PromoRedemption.
+// Note that you need to call PromoRedemption.Unwrap() before calling this method if this PromoRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PromoRedemption) Update() *PromoRedemptionUpdateOne {
+	return NewPromoRedemptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PromoRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PromoRedemption) Unwrap() *PromoRedemption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromoRedemption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PromoRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromoRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CodeID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.EntitlementID; v != nil {
+		builder.WriteString("entitlement_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PercentOff; v != nil {
+		builder.WriteString("percent_off=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DiscountEndsAt; v != nil {
+		builder.WriteString("discount_ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromoRedemptions is a parsable slice of PromoRedemption.
+type PromoRedemptions []*PromoRedemption
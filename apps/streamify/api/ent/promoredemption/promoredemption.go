@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the promoredemption type in the database.
+	Label = "promo_redemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCodeID holds the string denoting the code_id field in the database.
+	FieldCodeID = "code_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEntitlementID holds the string denoting the entitlement_id field in the database.
+	FieldEntitlementID = "entitlement_id"
+	// FieldPercentOff holds the string denoting the percent_off field in the database.
+	FieldPercentOff = "percent_off"
+	// FieldDiscountEndsAt holds the string denoting the discount_ends_at field in the database.
+	FieldDiscountEndsAt = "discount_ends_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeCode holds the string denoting the code edge name in mutations.
+	EdgeCode = "code"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeEntitlement holds the string denoting the entitlement edge name in mutations.
+	EdgeEntitlement = "entitlement"
+	// Table holds the table name of the promoredemption in the database.
+	Table = "promo_redemptions"
+	// CodeTable is the table that holds the code relation/edge.
+	CodeTable = "promo_redemptions"
+	// CodeInverseTable is the table name for the PromoCode entity.
+	// It exists in this package in order to avoid circular dependency with the "promocode" package.
+	CodeInverseTable = "promo_codes"
+	// CodeColumn is the table column denoting the code relation/edge.
+	CodeColumn = "code_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "promo_redemptions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// EntitlementTable is the table that holds the entitlement relation/edge.
+	EntitlementTable = "promo_redemptions"
+	// EntitlementInverseTable is the table name for the Entitlement entity.
+	// It exists in this package in order to avoid circular dependency with the "entitlement" package.
+	EntitlementInverseTable = "entitlements"
+	// EntitlementColumn is the table column denoting the entitlement relation/edge.
+	EntitlementColumn = "entitlement_id"
+)
+
+// Columns holds all SQL columns for promoredemption fields.
+var Columns = []string{
+	FieldID,
+	FieldCodeID,
+	FieldUserID,
+	FieldEntitlementID,
+	FieldPercentOff,
+	FieldDiscountEndsAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PromoRedemption queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCodeID orders the results by the code_id field.
+func ByCodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEntitlementID orders the results by the entitlement_id field.
+func ByEntitlementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntitlementID, opts...).ToFunc()
+}
+
+// ByPercentOff orders the results by the percent_off field.
+func ByPercentOff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercentOff, opts...).ToFunc()
+}
+
+// ByDiscountEndsAt orders the results by the discount_ends_at field.
+func ByDiscountEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountEndsAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCodeField orders the results by code field.
+func ByCodeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCodeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEntitlementField orders the results by entitlement field.
+func ByEntitlementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntitlementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCodeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CodeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CodeTable, CodeColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
+func newEntitlementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntitlementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EntitlementTable, EntitlementColumn),
+	)
+}
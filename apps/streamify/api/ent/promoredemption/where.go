@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// CodeID applies equality check predicate on the "code_id" field. It's identical to CodeIDEQ.
+func CodeID(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCodeID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// EntitlementID applies equality check predicate on the "entitlement_id" field. It's identical to EntitlementIDEQ.
+func EntitlementID(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldEntitlementID, v))
+}
+
+// PercentOff applies equality check predicate on the "percent_off" field. It's identical to PercentOffEQ.
+func PercentOff(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// DiscountEndsAt applies equality check predicate on the "discount_ends_at" field. It's identical to DiscountEndsAtEQ.
+func DiscountEndsAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountEndsAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeIDEQ applies the EQ predicate on the "code_id" field.
+func CodeIDEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCodeID, v))
+}
+
+// CodeIDNEQ applies the NEQ predicate on the "code_id" field.
+func CodeIDNEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCodeID, v))
+}
+
+// CodeIDIn applies the In predicate on the "code_id" field.
+func CodeIDIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCodeID, vs...))
+}
+
+// CodeIDNotIn applies the NotIn predicate on the "code_id" field.
+func CodeIDNotIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCodeID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// EntitlementIDEQ applies the EQ predicate on the "entitlement_id" field.
+func EntitlementIDEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldEntitlementID, v))
+}
+
+// EntitlementIDNEQ applies the NEQ predicate on the "entitlement_id" field.
+func EntitlementIDNEQ(v uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldEntitlementID, v))
+}
+
+// EntitlementIDIn applies the In predicate on the "entitlement_id" field.
+func EntitlementIDIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldEntitlementID, vs...))
+}
+
+// EntitlementIDNotIn applies the NotIn predicate on the "entitlement_id" field.
+func EntitlementIDNotIn(vs ...uuid.UUID) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldEntitlementID, vs...))
+}
+
+// EntitlementIDIsNil applies the IsNil predicate on the "entitlement_id" field.
+func EntitlementIDIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldEntitlementID))
+}
+
+// EntitlementIDNotNil applies the NotNil predicate on the "entitlement_id" field.
+func EntitlementIDNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldEntitlementID))
+}
+
+// PercentOffEQ applies the EQ predicate on the "percent_off" field.
+func PercentOffEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPercentOff, v))
+}
+
+// PercentOffNEQ applies the NEQ predicate on the "percent_off" field.
+func PercentOffNEQ(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldPercentOff, v))
+}
+
+// PercentOffIn applies the In predicate on the "percent_off" field.
+func PercentOffIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldPercentOff, vs...))
+}
+
+// PercentOffNotIn applies the NotIn predicate on the "percent_off" field.
+func PercentOffNotIn(vs ...int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldPercentOff, vs...))
+}
+
+// PercentOffGT applies the GT predicate on the "percent_off" field.
+func PercentOffGT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldPercentOff, v))
+}
+
+// PercentOffGTE applies the GTE predicate on the "percent_off" field.
+func PercentOffGTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldPercentOff, v))
+}
+
+// PercentOffLT applies the LT predicate on the "percent_off" field.
+func PercentOffLT(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldPercentOff, v))
+}
+
+// PercentOffLTE applies the LTE predicate on the "percent_off" field.
+func PercentOffLTE(v int) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldPercentOff, v))
+}
+
+// PercentOffIsNil applies the IsNil predicate on the "percent_off" field.
+func PercentOffIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldPercentOff))
+}
+
+// PercentOffNotNil applies the NotNil predicate on the "percent_off" field.
+func PercentOffNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldPercentOff))
+}
+
+// DiscountEndsAtEQ applies the EQ predicate on the "discount_ends_at" field.
+func DiscountEndsAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtNEQ applies the NEQ predicate on the "discount_ends_at" field.
+func DiscountEndsAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtIn applies the In predicate on the "discount_ends_at" field.
+func DiscountEndsAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldDiscountEndsAt, vs...))
+}
+
+// DiscountEndsAtNotIn applies the NotIn predicate on the "discount_ends_at" field.
+func DiscountEndsAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldDiscountEndsAt, vs...))
+}
+
+// DiscountEndsAtGT applies the GT predicate on the "discount_ends_at" field.
+func DiscountEndsAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtGTE applies the GTE predicate on the "discount_ends_at" field.
+func DiscountEndsAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtLT applies the LT predicate on the "discount_ends_at" field.
+func DiscountEndsAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtLTE applies the LTE predicate on the "discount_ends_at" field.
+func DiscountEndsAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldDiscountEndsAt, v))
+}
+
+// DiscountEndsAtIsNil applies the IsNil predicate on the "discount_ends_at" field.
+func DiscountEndsAtIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldDiscountEndsAt))
+}
+
+// DiscountEndsAtNotNil applies the NotNil predicate on the "discount_ends_at" field.
+func DiscountEndsAtNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldDiscountEndsAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasCode applies the HasEdge predicate on the "code" edge.
+func HasCode() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CodeTable, CodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCodeWith applies the HasEdge predicate on the "code" edge with a given conditions (other predicates).
+func HasCodeWith(preds ...predicate.PromoCode) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := newCodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntitlement applies the HasEdge predicate on the "entitlement" edge.
+func HasEntitlement() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EntitlementTable, EntitlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntitlementWith applies the HasEdge predicate on the "entitlement" edge with a given conditions (other predicates).
+func HasEntitlementWith(preds ...predicate.Entitlement) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := newEntitlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.NotPredicates(p))
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/entitlement"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PromoRedemptionCreate is the builder for creating a PromoRedemption entity.
+type PromoRedemptionCreate struct {
+	config
+	mutation *PromoRedemptionMutation
+	hooks    []Hook
+}
+
+// SetCodeID sets the "code_id" field.
+func (_c *PromoRedemptionCreate) SetCodeID(v uuid.UUID) *PromoRedemptionCreate {
+	_c.mutation.SetCodeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PromoRedemptionCreate) SetUserID(v uuid.UUID) *PromoRedemptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEntitlementID sets the "entitlement_id" field.
+func (_c *PromoRedemptionCreate) SetEntitlementID(v uuid.UUID) *PromoRedemptionCreate {
+	_c.mutation.SetEntitlementID(v)
+	return _c
+}
+
+// SetNillableEntitlementID sets the "entitlement_id" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableEntitlementID(v *uuid.UUID) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetEntitlementID(*v)
+	}
+	return _c
+}
+
+// SetPercentOff sets the "percent_off" field.
+func (_c *PromoRedemptionCreate) SetPercentOff(v int) *PromoRedemptionCreate {
+	_c.mutation.SetPercentOff(v)
+	return _c
+}
+
+// SetNillablePercentOff sets the "percent_off" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillablePercentOff(v *int) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetPercentOff(*v)
+	}
+	return _c
+}
+
+// SetDiscountEndsAt sets the "discount_ends_at" field.
+func (_c *PromoRedemptionCreate) SetDiscountEndsAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetDiscountEndsAt(v)
+	return _c
+}
+
+// SetNillableDiscountEndsAt sets the "discount_ends_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableDiscountEndsAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetDiscountEndsAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoRedemptionCreate) SetCreatedAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableCreatedAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoRedemptionCreate) SetID(v uuid.UUID) *PromoRedemptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableID(v *uuid.UUID) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" edge to the PromoCode entity.
+func (_c *PromoRedemptionCreate) SetCode(v *PromoCode) *PromoRedemptionCreate {
+	return _c.SetCodeID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PromoRedemptionCreate) SetUser(v *User) *PromoRedemptionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetEntitlement sets the "entitlement" edge to the Entitlement entity.
+func (_c *PromoRedemptionCreate) SetEntitlement(v *Entitlement) *PromoRedemptionCreate {
+	return _c.SetEntitlementID(v.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_c *PromoRedemptionCreate) Mutation() *PromoRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoRedemption in the database.
+func (_c *PromoRedemptionCreate) Save(ctx context.Context) (*PromoRedemption, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoRedemptionCreate) SaveX(ctx context.Context) *PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoRedemptionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if promoredemption.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if promoredemption.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized promoredemption.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := promoredemption.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoRedemptionCreate) check() error {
+	if _, ok := _c.mutation.CodeID(); !ok {
+		return &ValidationError{Name: "code_id", err: errors.New(`ent: missing required field "PromoRedemption.code_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PromoRedemption.user_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromoRedemption.created_at"`)}
+	}
+	if len(_c.mutation.CodeIDs()) == 0 {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required edge "PromoRedemption.code"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PromoRedemption.user"`)}
+	}
+	return nil
+}
+
+func (_c *PromoRedemptionCreate) sqlSave(ctx context.Context) (*PromoRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoRedemptionCreate) createSpec() (*PromoRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promoredemption.Table, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PercentOff(); ok {
+		_spec.SetField(promoredemption.FieldPercentOff, field.TypeInt, value)
+		_node.PercentOff = &value
+	}
+	if value, ok := _c.mutation.DiscountEndsAt(); ok {
+		_spec.SetField(promoredemption.FieldDiscountEndsAt, field.TypeTime, value)
+		_node.DiscountEndsAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promoredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   promoredemption.CodeTable,
+			Columns: []string{promoredemption.CodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   promoredemption.UserTable,
+			Columns: []string{promoredemption.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntitlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   promoredemption.EntitlementTable,
+			Columns: []string{promoredemption.EntitlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EntitlementID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromoRedemptionCreateBulk is the builder for creating many PromoRedemption entities in bulk.
+type PromoRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*PromoRedemptionCreate
+}
+
+// Save creates the PromoRedemption entities in the database.
+func (_c *PromoRedemptionCreateBulk) Save(ctx context.Context) ([]*PromoRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) SaveX(ctx context.Context) []*PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
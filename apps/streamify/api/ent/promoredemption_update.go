@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/promoredemption"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PromoRedemptionUpdate is the builder for updating PromoRedemption entities.
+type PromoRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdate) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdate) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdate) check() error {
+	if _u.mutation.CodeCleared() && len(_u.mutation.CodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.code"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.user"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PercentOffCleared() {
+		_spec.ClearField(promoredemption.FieldPercentOff, field.TypeInt)
+	}
+	if _u.mutation.DiscountEndsAtCleared() {
+		_spec.ClearField(promoredemption.FieldDiscountEndsAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoRedemptionUpdateOne is the builder for updating a single PromoRedemption entity.
+type PromoRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdateOne) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdateOne) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoRedemptionUpdateOne) Select(field string, fields ...string) *PromoRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoRedemption entity.
+func (_u *PromoRedemptionUpdateOne) Save(ctx context.Context) (*PromoRedemption, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) SaveX(ctx context.Context) *PromoRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdateOne) check() error {
+	if _u.mutation.CodeCleared() && len(_u.mutation.CodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.code"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.user"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *PromoRedemption, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromoRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promoredemption.FieldID)
+		for _, f := range fields {
+			if !promoredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promoredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PercentOffCleared() {
+		_spec.ClearField(promoredemption.FieldPercentOff, field.TypeInt)
+	}
+	if _u.mutation.DiscountEndsAtCleared() {
+		_spec.ClearField(promoredemption.FieldDiscountEndsAt, field.TypeTime)
+	}
+	_node = &PromoRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
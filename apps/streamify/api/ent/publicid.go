@@ -0,0 +1,49 @@
+package ent
+
+// This file is not generated. It adds each shareable entity's public ID to
+// its JSON, for clients to build shared links with.
+
+import (
+	"encoding/json"
+
+	"streamify/ids"
+)
+
+type (
+	artistJSON   Artist
+	albumJSON    Album
+	trackJSON    Track
+	playlistJSON Playlist
+)
+
+// MarshalJSON adds public_id to the artist's fields
+func (a *Artist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		*artistJSON
+		PublicID string `json:"public_id"`
+	}{(*artistJSON)(a), ids.Public(a.ID)})
+}
+
+// MarshalJSON adds public_id to the album's fields
+func (a *Album) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		*albumJSON
+		PublicID string `json:"public_id"`
+	}{(*albumJSON)(a), ids.Public(a.ID)})
+}
+
+// MarshalJSON adds public_id to the track's fields
+func (t *Track) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		*trackJSON
+		PublicID string `json:"public_id"`
+	}{(*trackJSON)(t), ids.Public(t.ID)})
+}
+
+// MarshalJSON adds public_id to the playlist's fields
+func (p *Playlist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		*playlistJSON
+		PublicID string `json:"public_id"`
+	}{(*playlistJSON)(p), ids.Public(p.ID)})
+}
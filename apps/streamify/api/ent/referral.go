@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/entitlement"
+	"streamify/ent/invite"
+	"streamify/ent/referral"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Referral is the model entity for the Referral schema.
+type Referral struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user whose code was used
+	ReferrerID uuid.UUID `json:"referrer_id,omitempty"`
+	// The user who signed up
+	ReferredID uuid.UUID `json:"referred_id,omitempty"`
+	// The referral code signed up with
+	InviteID uuid.UUID `json:"invite_id,omitempty"`
+	// rewarded once the referrer got their reward, rejected when the signup looked like the referrer referring themselves
+	Status referral.Status `json:"status,omitempty"`
+	// Why a rejected referral was rejected: the referred email is an alias of the referrer's, or the signup came from an address the referrer's other signups did
+	Reason *referral.Reason `json:"reason,omitempty"`
+	// Free premium days the referrer got; 0 when rejected or the referrer has premium for good
+	RewardDays int `json:"reward_days,omitempty"`
+	// The referrer's reward
+	EntitlementID *uuid.UUID `json:"entitlement_id,omitempty"`
+	// The client IP the referred user signed up from, compared with the referrer's other referrals
+	SignupIP string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReferralQuery when eager-loading is set.
+	Edges        ReferralEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReferralEdges holds the relations/edges for other nodes in the graph.
+type ReferralEdges struct {
+	// Referrer holds the value of the referrer edge.
+	Referrer *User `json:"referrer,omitempty"`
+	// Referred holds the value of the referred edge.
+	Referred *User `json:"referred,omitempty"`
+	// Invite holds the value of the invite edge.
+	Invite *Invite `json:"invite,omitempty"`
+	// Entitlement holds the value of the entitlement edge.
+	Entitlement *Entitlement `json:"entitlement,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ReferrerOrErr returns the Referrer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReferralEdges) ReferrerOrErr() (*User, error) {
+	if e.Referrer != nil {
+		return e.Referrer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "referrer"}
+}
+
+// ReferredOrErr returns the Referred value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReferralEdges) ReferredOrErr() (*User, error) {
+	if e.Referred != nil {
+		return e.Referred, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "referred"}
+}
+
+// InviteOrErr returns the Invite value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReferralEdges) InviteOrErr() (*Invite, error) {
+	if e.Invite != nil {
+		return e.Invite, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invite.Label}
+	}
+	return nil, &NotLoadedError{edge: "invite"}
+}
+
+// EntitlementOrErr returns the Entitlement value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReferralEdges) EntitlementOrErr() (*Entitlement, error) {
+	if e.Entitlement != nil {
+		return e.Entitlement, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: entitlement.Label}
+	}
+	return nil, &NotLoadedError{edge: "entitlement"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Referral) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldEntitlementID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case referral.FieldRewardDays:
+			values[i] = new(sql.NullInt64)
+		case referral.FieldStatus, referral.FieldReason, referral.FieldSignupIP:
+			values[i] = new(sql.NullString)
+		case referral.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case referral.FieldID, referral.FieldReferrerID, referral.FieldReferredID, referral.FieldInviteID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Referral fields.
+func (_m *Referral) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referral.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case referral.FieldReferrerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_id", values[i])
+			} else if value != nil {
+				_m.ReferrerID = *value
+			}
+		case referral.FieldReferredID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_id", values[i])
+			} else if value != nil {
+				_m.ReferredID = *value
+			}
+		case referral.FieldInviteID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_id", values[i])
+			} else if value != nil {
+				_m.InviteID = *value
+			}
+		case referral.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = referral.Status(value.String)
+			}
+		case referral.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(referral.Reason)
+				*_m.Reason = referral.Reason(value.String)
+			}
+		case referral.FieldRewardDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reward_days", values[i])
+			} else if value.Valid {
+				_m.RewardDays = int(value.Int64)
+			}
+		case referral.FieldEntitlementID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field entitlement_id", values[i])
+			} else if value.Valid {
+				_m.EntitlementID = new(uuid.UUID)
+				*_m.EntitlementID = *value.S.(*uuid.UUID)
+			}
+		case referral.FieldSignupIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signup_ip", values[i])
+			} else if value.Valid {
+				_m.SignupIP = value.String
+			}
+		case referral.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Referral.
+// This includes values selected through modifiers, order, etc.
+func (_m *Referral) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryReferrer queries the "referrer" edge of the Referral entity.
+func (_m *Referral) QueryReferrer() *UserQuery {
+	return NewReferralClient(_m.config).QueryReferrer(_m)
+}
+
+// QueryReferred queries the "referred" edge of the Referral entity.
+func (_m *Referral) QueryReferred() *UserQuery {
+	return NewReferralClient(_m.config).QueryReferred(_m)
+}
+
+// QueryInvite queries the "invite" edge of the Referral entity.
+func (_m *Referral) QueryInvite() *InviteQuery {
+	return NewReferralClient(_m.config).QueryInvite(_m)
+}
+
+// QueryEntitlement queries the "entitlement" edge of the Referral entity.
+func (_m *Referral) QueryEntitlement() *EntitlementQuery {
+	return NewReferralClient(_m.config).QueryEntitlement(_m)
+}
+
+// Update returns a builder for updating this Referral.
+// Note that you need to call Referral.Unwrap() before calling this method if this Referral
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Referral) Update() *ReferralUpdateOne {
+	return NewReferralClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Referral entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Referral) Unwrap() *Referral {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Referral is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Referral) String() string {
+	var builder strings.Builder
+	builder.WriteString("Referral(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("referrer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferrerID))
+	builder.WriteString(", ")
+	builder.WriteString("referred_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferredID))
+	builder.WriteString(", ")
+	builder.WriteString("invite_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InviteID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reward_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RewardDays))
+	builder.WriteString(", ")
+	if v := _m.EntitlementID; v != nil {
+		builder.WriteString("entitlement_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("signup_ip=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Referrals is a parsable slice of Referral.
+type Referrals []*Referral
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the referral type in the database.
+	Label = "referral"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReferrerID holds the string denoting the referrer_id field in the database.
+	FieldReferrerID = "referrer_id"
+	// FieldReferredID holds the string denoting the referred_id field in the database.
+	FieldReferredID = "referred_id"
+	// FieldInviteID holds the string denoting the invite_id field in the database.
+	FieldInviteID = "invite_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldRewardDays holds the string denoting the reward_days field in the database.
+	FieldRewardDays = "reward_days"
+	// FieldEntitlementID holds the string denoting the entitlement_id field in the database.
+	FieldEntitlementID = "entitlement_id"
+	// FieldSignupIP holds the string denoting the signup_ip field in the database.
+	FieldSignupIP = "signup_ip"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeReferrer holds the string denoting the referrer edge name in mutations.
+	EdgeReferrer = "referrer"
+	// EdgeReferred holds the string denoting the referred edge name in mutations.
+	EdgeReferred = "referred"
+	// EdgeInvite holds the string denoting the invite edge name in mutations.
+	EdgeInvite = "invite"
+	// EdgeEntitlement holds the string denoting the entitlement edge name in mutations.
+	EdgeEntitlement = "entitlement"
+	// Table holds the table name of the referral in the database.
+	Table = "referrals"
+	// ReferrerTable is the table that holds the referrer relation/edge.
+	ReferrerTable = "referrals"
+	// ReferrerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReferrerInverseTable = "users"
+	// ReferrerColumn is the table column denoting the referrer relation/edge.
+	ReferrerColumn = "referrer_id"
+	// ReferredTable is the table that holds the referred relation/edge.
+	ReferredTable = "referrals"
+	// ReferredInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ReferredInverseTable = "users"
+	// ReferredColumn is the table column denoting the referred relation/edge.
+	ReferredColumn = "referred_id"
+	// InviteTable is the table that holds the invite relation/edge.
+	InviteTable = "referrals"
+	// InviteInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InviteInverseTable = "invites"
+	// InviteColumn is the table column denoting the invite relation/edge.
+	InviteColumn = "invite_id"
+	// EntitlementTable is the table that holds the entitlement relation/edge.
+	EntitlementTable = "referrals"
+	// EntitlementInverseTable is the table name for the Entitlement entity.
+	// It exists in this package in order to avoid circular dependency with the "entitlement" package.
+	EntitlementInverseTable = "entitlements"
+	// EntitlementColumn is the table column denoting the entitlement relation/edge.
+	EntitlementColumn = "entitlement_id"
+)
+
+// Columns holds all SQL columns for referral fields.
+var Columns = []string{
+	FieldID,
+	FieldReferrerID,
+	FieldReferredID,
+	FieldInviteID,
+	FieldStatus,
+	FieldReason,
+	FieldRewardDays,
+	FieldEntitlementID,
+	FieldSignupIP,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultRewardDays holds the default value on creation for the "reward_days" field.
+	DefaultRewardDays int
+	// RewardDaysValidator is a validator for the "reward_days" field. It is called by the builders before save.
+	RewardDaysValidator func(int) error
+	// SignupIPValidator is a validator for the "signup_ip" field. It is called by the builders before save.
+	SignupIPValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusRewarded Status = "rewarded"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRewarded, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("referral: invalid enum value for status field: %q", s)
+	}
+}
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonSameEmail Reason = "same_email"
+	ReasonSharedIP  Reason = "shared_ip"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonSameEmail, ReasonSharedIP:
+		return nil
+	default:
+		return fmt.Errorf("referral: invalid enum value for reason field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the Referral queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReferrerID orders the results by the referrer_id field.
+func ByReferrerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerID, opts...).ToFunc()
+}
+
+// ByReferredID orders the results by the referred_id field.
+func ByReferredID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredID, opts...).ToFunc()
+}
+
+// ByInviteID orders the results by the invite_id field.
+func ByInviteID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviteID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByRewardDays orders the results by the reward_days field.
+func ByRewardDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewardDays, opts...).ToFunc()
+}
+
+// ByEntitlementID orders the results by the entitlement_id field.
+func ByEntitlementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntitlementID, opts...).ToFunc()
+}
+
+// BySignupIP orders the results by the signup_ip field.
+func BySignupIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSignupIP, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReferrerField orders the results by referrer field.
+func ByReferrerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReferrerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReferredField orders the results by referred field.
+func ByReferredField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReferredStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInviteField orders the results by invite field.
+func ByInviteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInviteStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEntitlementField orders the results by entitlement field.
+func ByEntitlementField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntitlementStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReferrerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReferrerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReferrerTable, ReferrerColumn),
+	)
+}
+func newReferredStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReferredInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ReferredTable, ReferredColumn),
+	)
+}
+func newInviteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InviteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, InviteTable, InviteColumn),
+	)
+}
+func newEntitlementStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntitlementInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, EntitlementTable, EntitlementColumn),
+	)
+}
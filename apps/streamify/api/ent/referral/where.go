@@ -0,0 +1,494 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referral
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldID, id))
+}
+
+// ReferrerID applies equality check predicate on the "referrer_id" field. It's identical to ReferrerIDEQ.
+func ReferrerID(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferredID applies equality check predicate on the "referred_id" field. It's identical to ReferredIDEQ.
+func ReferredID(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredID, v))
+}
+
+// InviteID applies equality check predicate on the "invite_id" field. It's identical to InviteIDEQ.
+func InviteID(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviteID, v))
+}
+
+// RewardDays applies equality check predicate on the "reward_days" field. It's identical to RewardDaysEQ.
+func RewardDays(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardDays, v))
+}
+
+// EntitlementID applies equality check predicate on the "entitlement_id" field. It's identical to EntitlementIDEQ.
+func EntitlementID(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldEntitlementID, v))
+}
+
+// SignupIP applies equality check predicate on the "signup_ip" field. It's identical to SignupIPEQ.
+func SignupIP(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldSignupIP, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReferrerIDEQ applies the EQ predicate on the "referrer_id" field.
+func ReferrerIDEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDNEQ applies the NEQ predicate on the "referrer_id" field.
+func ReferrerIDNEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDIn applies the In predicate on the "referrer_id" field.
+func ReferrerIDIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDNotIn applies the NotIn predicate on the "referrer_id" field.
+func ReferrerIDNotIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferrerID, vs...))
+}
+
+// ReferredIDEQ applies the EQ predicate on the "referred_id" field.
+func ReferredIDEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReferredID, v))
+}
+
+// ReferredIDNEQ applies the NEQ predicate on the "referred_id" field.
+func ReferredIDNEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReferredID, v))
+}
+
+// ReferredIDIn applies the In predicate on the "referred_id" field.
+func ReferredIDIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReferredID, vs...))
+}
+
+// ReferredIDNotIn applies the NotIn predicate on the "referred_id" field.
+func ReferredIDNotIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReferredID, vs...))
+}
+
+// InviteIDEQ applies the EQ predicate on the "invite_id" field.
+func InviteIDEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldInviteID, v))
+}
+
+// InviteIDNEQ applies the NEQ predicate on the "invite_id" field.
+func InviteIDNEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldInviteID, v))
+}
+
+// InviteIDIn applies the In predicate on the "invite_id" field.
+func InviteIDIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldInviteID, vs...))
+}
+
+// InviteIDNotIn applies the NotIn predicate on the "invite_id" field.
+func InviteIDNotIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldInviteID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldReason))
+}
+
+// RewardDaysEQ applies the EQ predicate on the "reward_days" field.
+func RewardDaysEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldRewardDays, v))
+}
+
+// RewardDaysNEQ applies the NEQ predicate on the "reward_days" field.
+func RewardDaysNEQ(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldRewardDays, v))
+}
+
+// RewardDaysIn applies the In predicate on the "reward_days" field.
+func RewardDaysIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldRewardDays, vs...))
+}
+
+// RewardDaysNotIn applies the NotIn predicate on the "reward_days" field.
+func RewardDaysNotIn(vs ...int) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldRewardDays, vs...))
+}
+
+// RewardDaysGT applies the GT predicate on the "reward_days" field.
+func RewardDaysGT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldRewardDays, v))
+}
+
+// RewardDaysGTE applies the GTE predicate on the "reward_days" field.
+func RewardDaysGTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldRewardDays, v))
+}
+
+// RewardDaysLT applies the LT predicate on the "reward_days" field.
+func RewardDaysLT(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldRewardDays, v))
+}
+
+// RewardDaysLTE applies the LTE predicate on the "reward_days" field.
+func RewardDaysLTE(v int) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldRewardDays, v))
+}
+
+// EntitlementIDEQ applies the EQ predicate on the "entitlement_id" field.
+func EntitlementIDEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldEntitlementID, v))
+}
+
+// EntitlementIDNEQ applies the NEQ predicate on the "entitlement_id" field.
+func EntitlementIDNEQ(v uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldEntitlementID, v))
+}
+
+// EntitlementIDIn applies the In predicate on the "entitlement_id" field.
+func EntitlementIDIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldEntitlementID, vs...))
+}
+
+// EntitlementIDNotIn applies the NotIn predicate on the "entitlement_id" field.
+func EntitlementIDNotIn(vs ...uuid.UUID) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldEntitlementID, vs...))
+}
+
+// EntitlementIDIsNil applies the IsNil predicate on the "entitlement_id" field.
+func EntitlementIDIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldEntitlementID))
+}
+
+// EntitlementIDNotNil applies the NotNil predicate on the "entitlement_id" field.
+func EntitlementIDNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldEntitlementID))
+}
+
+// SignupIPEQ applies the EQ predicate on the "signup_ip" field.
+func SignupIPEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldSignupIP, v))
+}
+
+// SignupIPNEQ applies the NEQ predicate on the "signup_ip" field.
+func SignupIPNEQ(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldSignupIP, v))
+}
+
+// SignupIPIn applies the In predicate on the "signup_ip" field.
+func SignupIPIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldSignupIP, vs...))
+}
+
+// SignupIPNotIn applies the NotIn predicate on the "signup_ip" field.
+func SignupIPNotIn(vs ...string) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldSignupIP, vs...))
+}
+
+// SignupIPGT applies the GT predicate on the "signup_ip" field.
+func SignupIPGT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldSignupIP, v))
+}
+
+// SignupIPGTE applies the GTE predicate on the "signup_ip" field.
+func SignupIPGTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldSignupIP, v))
+}
+
+// SignupIPLT applies the LT predicate on the "signup_ip" field.
+func SignupIPLT(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldSignupIP, v))
+}
+
+// SignupIPLTE applies the LTE predicate on the "signup_ip" field.
+func SignupIPLTE(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldSignupIP, v))
+}
+
+// SignupIPContains applies the Contains predicate on the "signup_ip" field.
+func SignupIPContains(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContains(FieldSignupIP, v))
+}
+
+// SignupIPHasPrefix applies the HasPrefix predicate on the "signup_ip" field.
+func SignupIPHasPrefix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasPrefix(FieldSignupIP, v))
+}
+
+// SignupIPHasSuffix applies the HasSuffix predicate on the "signup_ip" field.
+func SignupIPHasSuffix(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldHasSuffix(FieldSignupIP, v))
+}
+
+// SignupIPIsNil applies the IsNil predicate on the "signup_ip" field.
+func SignupIPIsNil() predicate.Referral {
+	return predicate.Referral(sql.FieldIsNull(FieldSignupIP))
+}
+
+// SignupIPNotNil applies the NotNil predicate on the "signup_ip" field.
+func SignupIPNotNil() predicate.Referral {
+	return predicate.Referral(sql.FieldNotNull(FieldSignupIP))
+}
+
+// SignupIPEqualFold applies the EqualFold predicate on the "signup_ip" field.
+func SignupIPEqualFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldEqualFold(FieldSignupIP, v))
+}
+
+// SignupIPContainsFold applies the ContainsFold predicate on the "signup_ip" field.
+func SignupIPContainsFold(v string) predicate.Referral {
+	return predicate.Referral(sql.FieldContainsFold(FieldSignupIP, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Referral {
+	return predicate.Referral(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasReferrer applies the HasEdge predicate on the "referrer" edge.
+func HasReferrer() predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReferrerTable, ReferrerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReferrerWith applies the HasEdge predicate on the "referrer" edge with a given conditions (other predicates).
+func HasReferrerWith(preds ...predicate.User) predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := newReferrerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReferred applies the HasEdge predicate on the "referred" edge.
+func HasReferred() predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReferredTable, ReferredColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReferredWith applies the HasEdge predicate on the "referred" edge with a given conditions (other predicates).
+func HasReferredWith(preds ...predicate.User) predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := newReferredStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvite applies the HasEdge predicate on the "invite" edge.
+func HasInvite() predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, InviteTable, InviteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInviteWith applies the HasEdge predicate on the "invite" edge with a given conditions (other predicates).
+func HasInviteWith(preds ...predicate.Invite) predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := newInviteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntitlement applies the HasEdge predicate on the "entitlement" edge.
+func HasEntitlement() predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, EntitlementTable, EntitlementColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntitlementWith applies the HasEdge predicate on the "entitlement" edge with a given conditions (other predicates).
+func HasEntitlementWith(preds ...predicate.Entitlement) predicate.Referral {
+	return predicate.Referral(func(s *sql.Selector) {
+		step := newEntitlementStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Referral) predicate.Referral {
+	return predicate.Referral(sql.NotPredicates(p))
+}
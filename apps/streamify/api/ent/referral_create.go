@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/entitlement"
+	"streamify/ent/invite"
+	"streamify/ent/referral"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReferralCreate is the builder for creating a Referral entity.
+type ReferralCreate struct {
+	config
+	mutation *ReferralMutation
+	hooks    []Hook
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (_c *ReferralCreate) SetReferrerID(v uuid.UUID) *ReferralCreate {
+	_c.mutation.SetReferrerID(v)
+	return _c
+}
+
+// SetReferredID sets the "referred_id" field.
+func (_c *ReferralCreate) SetReferredID(v uuid.UUID) *ReferralCreate {
+	_c.mutation.SetReferredID(v)
+	return _c
+}
+
+// SetInviteID sets the "invite_id" field.
+func (_c *ReferralCreate) SetInviteID(v uuid.UUID) *ReferralCreate {
+	_c.mutation.SetInviteID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReferralCreate) SetStatus(v referral.Status) *ReferralCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ReferralCreate) SetReason(v referral.Reason) *ReferralCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableReason(v *referral.Reason) *ReferralCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetRewardDays sets the "reward_days" field.
+func (_c *ReferralCreate) SetRewardDays(v int) *ReferralCreate {
+	_c.mutation.SetRewardDays(v)
+	return _c
+}
+
+// SetNillableRewardDays sets the "reward_days" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableRewardDays(v *int) *ReferralCreate {
+	if v != nil {
+		_c.SetRewardDays(*v)
+	}
+	return _c
+}
+
+// SetEntitlementID sets the "entitlement_id" field.
+func (_c *ReferralCreate) SetEntitlementID(v uuid.UUID) *ReferralCreate {
+	_c.mutation.SetEntitlementID(v)
+	return _c
+}
+
+// SetNillableEntitlementID sets the "entitlement_id" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableEntitlementID(v *uuid.UUID) *ReferralCreate {
+	if v != nil {
+		_c.SetEntitlementID(*v)
+	}
+	return _c
+}
+
+// SetSignupIP sets the "signup_ip" field.
+func (_c *ReferralCreate) SetSignupIP(v string) *ReferralCreate {
+	_c.mutation.SetSignupIP(v)
+	return _c
+}
+
+// SetNillableSignupIP sets the "signup_ip" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableSignupIP(v *string) *ReferralCreate {
+	if v != nil {
+		_c.SetSignupIP(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReferralCreate) SetCreatedAt(v time.Time) *ReferralCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableCreatedAt(v *time.Time) *ReferralCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReferralCreate) SetID(v uuid.UUID) *ReferralCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReferralCreate) SetNillableID(v *uuid.UUID) *ReferralCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetReferrer sets the "referrer" edge to the User entity.
+func (_c *ReferralCreate) SetReferrer(v *User) *ReferralCreate {
+	return _c.SetReferrerID(v.ID)
+}
+
+// SetReferred sets the "referred" edge to the User entity.
+func (_c *ReferralCreate) SetReferred(v *User) *ReferralCreate {
+	return _c.SetReferredID(v.ID)
+}
+
+// SetInvite sets the "invite" edge to the Invite entity.
+func (_c *ReferralCreate) SetInvite(v *Invite) *ReferralCreate {
+	return _c.SetInviteID(v.ID)
+}
+
+// SetEntitlement sets the "entitlement" edge to the Entitlement entity.
+func (_c *ReferralCreate) SetEntitlement(v *Entitlement) *ReferralCreate {
+	return _c.SetEntitlementID(v.ID)
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_c *ReferralCreate) Mutation() *ReferralMutation {
+	return _c.mutation
+}
+
+// Save creates the Referral in the database.
+func (_c *ReferralCreate) Save(ctx context.Context) (*Referral, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReferralCreate) SaveX(ctx context.Context) *Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReferralCreate) defaults() error {
+	if _, ok := _c.mutation.RewardDays(); !ok {
+		v := referral.DefaultRewardDays
+		_c.mutation.SetRewardDays(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if referral.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized referral.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := referral.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if referral.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized referral.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := referral.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReferralCreate) check() error {
+	if _, ok := _c.mutation.ReferrerID(); !ok {
+		return &ValidationError{Name: "referrer_id", err: errors.New(`ent: missing required field "Referral.referrer_id"`)}
+	}
+	if _, ok := _c.mutation.ReferredID(); !ok {
+		return &ValidationError{Name: "referred_id", err: errors.New(`ent: missing required field "Referral.referred_id"`)}
+	}
+	if _, ok := _c.mutation.InviteID(); !ok {
+		return &ValidationError{Name: "invite_id", err: errors.New(`ent: missing required field "Referral.invite_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Referral.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := referral.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Referral.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := referral.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Referral.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RewardDays(); !ok {
+		return &ValidationError{Name: "reward_days", err: errors.New(`ent: missing required field "Referral.reward_days"`)}
+	}
+	if v, ok := _c.mutation.RewardDays(); ok {
+		if err := referral.RewardDaysValidator(v); err != nil {
+			return &ValidationError{Name: "reward_days", err: fmt.Errorf(`ent: validator failed for field "Referral.reward_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SignupIP(); ok {
+		if err := referral.SignupIPValidator(v); err != nil {
+			return &ValidationError{Name: "signup_ip", err: fmt.Errorf(`ent: validator failed for field "Referral.signup_ip": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Referral.created_at"`)}
+	}
+	if len(_c.mutation.ReferrerIDs()) == 0 {
+		return &ValidationError{Name: "referrer", err: errors.New(`ent: missing required edge "Referral.referrer"`)}
+	}
+	if len(_c.mutation.ReferredIDs()) == 0 {
+		return &ValidationError{Name: "referred", err: errors.New(`ent: missing required edge "Referral.referred"`)}
+	}
+	if len(_c.mutation.InviteIDs()) == 0 {
+		return &ValidationError{Name: "invite", err: errors.New(`ent: missing required edge "Referral.invite"`)}
+	}
+	return nil
+}
+
+func (_c *ReferralCreate) sqlSave(ctx context.Context) (*Referral, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReferralCreate) createSpec() (*Referral, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Referral{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(referral.Table, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(referral.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(referral.FieldReason, field.TypeEnum, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.RewardDays(); ok {
+		_spec.SetField(referral.FieldRewardDays, field.TypeInt, value)
+		_node.RewardDays = value
+	}
+	if value, ok := _c.mutation.SignupIP(); ok {
+		_spec.SetField(referral.FieldSignupIP, field.TypeString, value)
+		_node.SignupIP = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(referral.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ReferrerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   referral.ReferrerTable,
+			Columns: []string{referral.ReferrerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReferrerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReferredIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   referral.ReferredTable,
+			Columns: []string{referral.ReferredColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReferredID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   referral.InviteTable,
+			Columns: []string{referral.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InviteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntitlementIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   referral.EntitlementTable,
+			Columns: []string{referral.EntitlementColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EntitlementID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReferralCreateBulk is the builder for creating many Referral entities in bulk.
+type ReferralCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCreate
+}
+
+// Save creates the Referral entities in the database.
+func (_c *ReferralCreateBulk) Save(ctx context.Context) ([]*Referral, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Referral, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReferralCreateBulk) SaveX(ctx context.Context) []*Referral {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
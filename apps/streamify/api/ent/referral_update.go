@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/referral"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReferralUpdate is the builder for updating Referral entities.
+type ReferralUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdate) Where(ps ...predicate.Referral) *ReferralUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdate) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReferralUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReferralUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdate) check() error {
+	if _u.mutation.ReferrerCleared() && len(_u.mutation.ReferrerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.referrer"`)
+	}
+	if _u.mutation.ReferredCleared() && len(_u.mutation.ReferredIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.referred"`)
+	}
+	if _u.mutation.InviteCleared() && len(_u.mutation.InviteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.invite"`)
+	}
+	return nil
+}
+
+func (_u *ReferralUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(referral.FieldReason, field.TypeEnum)
+	}
+	if _u.mutation.SignupIPCleared() {
+		_spec.ClearField(referral.FieldSignupIP, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReferralUpdateOne is the builder for updating a single Referral entity.
+type ReferralUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralMutation
+}
+
+// Mutation returns the ReferralMutation object of the builder.
+func (_u *ReferralUpdateOne) Mutation() *ReferralMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReferralUpdate builder.
+func (_u *ReferralUpdateOne) Where(ps ...predicate.Referral) *ReferralUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReferralUpdateOne) Select(field string, fields ...string) *ReferralUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Referral entity.
+func (_u *ReferralUpdateOne) Save(ctx context.Context) (*Referral, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralUpdateOne) SaveX(ctx context.Context) *Referral {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReferralUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralUpdateOne) check() error {
+	if _u.mutation.ReferrerCleared() && len(_u.mutation.ReferrerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.referrer"`)
+	}
+	if _u.mutation.ReferredCleared() && len(_u.mutation.ReferredIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.referred"`)
+	}
+	if _u.mutation.InviteCleared() && len(_u.mutation.InviteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Referral.invite"`)
+	}
+	return nil
+}
+
+func (_u *ReferralUpdateOne) sqlSave(ctx context.Context) (_node *Referral, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referral.Table, referral.Columns, sqlgraph.NewFieldSpec(referral.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Referral.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referral.FieldID)
+		for _, f := range fields {
+			if !referral.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referral.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(referral.FieldReason, field.TypeEnum)
+	}
+	if _u.mutation.SignupIPCleared() {
+		_spec.ClearField(referral.FieldSignupIP, field.TypeString)
+	}
+	_node = &Referral{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referral.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
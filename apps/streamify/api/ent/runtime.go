@@ -2,79 +2,4 @@
 
 package ent
 
-import (
-	"streamify/ent/album"
-	"streamify/ent/artist"
-	"streamify/ent/schema"
-	"streamify/ent/track"
-	"streamify/ent/user"
-	"time"
-
-	"github.com/google/uuid"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	albumFields := schema.Album{}.Fields()
-	_ = albumFields
-	// albumDescTitle is the schema descriptor for title field.
-	albumDescTitle := albumFields[1].Descriptor()
-	// album.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	album.TitleValidator = albumDescTitle.Validators[0].(func(string) error)
-	// albumDescCreatedAt is the schema descriptor for created_at field.
-	albumDescCreatedAt := albumFields[4].Descriptor()
-	// album.DefaultCreatedAt holds the default value on creation for the created_at field.
-	album.DefaultCreatedAt = albumDescCreatedAt.Default.(func() time.Time)
-	// albumDescID is the schema descriptor for id field.
-	albumDescID := albumFields[0].Descriptor()
-	// album.DefaultID holds the default value on creation for the id field.
-	album.DefaultID = albumDescID.Default.(func() uuid.UUID)
-	artistFields := schema.Artist{}.Fields()
-	_ = artistFields
-	// artistDescName is the schema descriptor for name field.
-	artistDescName := artistFields[1].Descriptor()
-	// artist.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	artist.NameValidator = artistDescName.Validators[0].(func(string) error)
-	// artistDescCreatedAt is the schema descriptor for created_at field.
-	artistDescCreatedAt := artistFields[3].Descriptor()
-	// artist.DefaultCreatedAt holds the default value on creation for the created_at field.
-	artist.DefaultCreatedAt = artistDescCreatedAt.Default.(func() time.Time)
-	// artistDescID is the schema descriptor for id field.
-	artistDescID := artistFields[0].Descriptor()
-	// artist.DefaultID holds the default value on creation for the id field.
-	artist.DefaultID = artistDescID.Default.(func() uuid.UUID)
-	trackFields := schema.Track{}.Fields()
-	_ = trackFields
-	// trackDescTitle is the schema descriptor for title field.
-	trackDescTitle := trackFields[1].Descriptor()
-	// track.TitleValidator is a validator for the "title" field. It is called by the builders before save.
-	track.TitleValidator = trackDescTitle.Validators[0].(func(string) error)
-	// trackDescCreatedAt is the schema descriptor for created_at field.
-	trackDescCreatedAt := trackFields[4].Descriptor()
-	// track.DefaultCreatedAt holds the default value on creation for the created_at field.
-	track.DefaultCreatedAt = trackDescCreatedAt.Default.(func() time.Time)
-	// trackDescID is the schema descriptor for id field.
-	trackDescID := trackFields[0].Descriptor()
-	// track.DefaultID holds the default value on creation for the id field.
-	track.DefaultID = trackDescID.Default.(func() uuid.UUID)
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[1].Descriptor()
-	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
-	// userDescFirstName is the schema descriptor for first_name field.
-	userDescFirstName := userFields[2].Descriptor()
-	// user.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
-	user.FirstNameValidator = userDescFirstName.Validators[0].(func(string) error)
-	// userDescLastName is the schema descriptor for last_name field.
-	userDescLastName := userFields[3].Descriptor()
-	// user.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
-	user.LastNameValidator = userDescLastName.Validators[0].(func(string) error)
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in streamify/ent/runtime/runtime.go
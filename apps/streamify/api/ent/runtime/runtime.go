@@ -2,7 +2,1201 @@
 
 package runtime
 
-// The schema-stitching logic is generated in streamify/ent/runtime.go
+import (
+	"context"
+	"streamify/ent/album"
+	"streamify/ent/apikey"
+	"streamify/ent/apikeyusage"
+	"streamify/ent/artist"
+	"streamify/ent/audiofingerprint"
+	"streamify/ent/auditlog"
+	"streamify/ent/backup"
+	"streamify/ent/block"
+	"streamify/ent/changelogentry"
+	"streamify/ent/confirmation"
+	"streamify/ent/deadletter"
+	"streamify/ent/deviceauthorization"
+	"streamify/ent/duplicatereview"
+	"streamify/ent/entitlement"
+	"streamify/ent/externalidentity"
+	"streamify/ent/follow"
+	"streamify/ent/gueststate"
+	"streamify/ent/invite"
+	"streamify/ent/like"
+	"streamify/ent/operation"
+	"streamify/ent/play"
+	"streamify/ent/playcount"
+	"streamify/ent/playlist"
+	"streamify/ent/policyacceptance"
+	"streamify/ent/policyversion"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/referral"
+	"streamify/ent/schema"
+	"streamify/ent/scimgroup"
+	"streamify/ent/securityalert"
+	"streamify/ent/sharelink"
+	"streamify/ent/signingkey"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+	"streamify/ent/uploadsession"
+	"streamify/ent/user"
+	"streamify/ent/waitlistentry"
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[4].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescMonthlyQuota is the schema descriptor for monthly_quota field.
+	apikeyDescMonthlyQuota := apikeyFields[6].Descriptor()
+	// apikey.MonthlyQuotaValidator is a validator for the "monthly_quota" field. It is called by the builders before save.
+	apikey.MonthlyQuotaValidator = apikeyDescMonthlyQuota.Validators[0].(func(int64) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[10].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	apikeyusageFields := schema.APIKeyUsage{}.Fields()
+	_ = apikeyusageFields
+	// apikeyusageDescRequests is the schema descriptor for requests field.
+	apikeyusageDescRequests := apikeyusageFields[3].Descriptor()
+	// apikeyusage.DefaultRequests holds the default value on creation for the requests field.
+	apikeyusage.DefaultRequests = apikeyusageDescRequests.Default.(int64)
+	// apikeyusage.RequestsValidator is a validator for the "requests" field. It is called by the builders before save.
+	apikeyusage.RequestsValidator = apikeyusageDescRequests.Validators[0].(func(int64) error)
+	// apikeyusageDescRateLimited is the schema descriptor for rate_limited field.
+	apikeyusageDescRateLimited := apikeyusageFields[4].Descriptor()
+	// apikeyusage.DefaultRateLimited holds the default value on creation for the rate_limited field.
+	apikeyusage.DefaultRateLimited = apikeyusageDescRateLimited.Default.(int64)
+	// apikeyusage.RateLimitedValidator is a validator for the "rate_limited" field. It is called by the builders before save.
+	apikeyusage.RateLimitedValidator = apikeyusageDescRateLimited.Validators[0].(func(int64) error)
+	// apikeyusageDescClientErrors is the schema descriptor for client_errors field.
+	apikeyusageDescClientErrors := apikeyusageFields[5].Descriptor()
+	// apikeyusage.DefaultClientErrors holds the default value on creation for the client_errors field.
+	apikeyusage.DefaultClientErrors = apikeyusageDescClientErrors.Default.(int64)
+	// apikeyusage.ClientErrorsValidator is a validator for the "client_errors" field. It is called by the builders before save.
+	apikeyusage.ClientErrorsValidator = apikeyusageDescClientErrors.Validators[0].(func(int64) error)
+	// apikeyusageDescServerErrors is the schema descriptor for server_errors field.
+	apikeyusageDescServerErrors := apikeyusageFields[6].Descriptor()
+	// apikeyusage.DefaultServerErrors holds the default value on creation for the server_errors field.
+	apikeyusage.DefaultServerErrors = apikeyusageDescServerErrors.Default.(int64)
+	// apikeyusage.ServerErrorsValidator is a validator for the "server_errors" field. It is called by the builders before save.
+	apikeyusage.ServerErrorsValidator = apikeyusageDescServerErrors.Validators[0].(func(int64) error)
+	// apikeyusageDescID is the schema descriptor for id field.
+	apikeyusageDescID := apikeyusageFields[0].Descriptor()
+	// apikeyusage.DefaultID holds the default value on creation for the id field.
+	apikeyusage.DefaultID = apikeyusageDescID.Default.(func() uuid.UUID)
+	album.Policy = privacy.NewPolicies(schema.Album{})
+	album.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := album.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	albumFields := schema.Album{}.Fields()
+	_ = albumFields
+	// albumDescTitle is the schema descriptor for title field.
+	albumDescTitle := albumFields[1].Descriptor()
+	// album.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	album.TitleValidator = albumDescTitle.Validators[0].(func(string) error)
+	// albumDescLabel is the schema descriptor for label field.
+	albumDescLabel := albumFields[5].Descriptor()
+	// album.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	album.LabelValidator = albumDescLabel.Validators[0].(func(string) error)
+	// albumDescTrackCount is the schema descriptor for track_count field.
+	albumDescTrackCount := albumFields[8].Descriptor()
+	// album.DefaultTrackCount holds the default value on creation for the track_count field.
+	album.DefaultTrackCount = albumDescTrackCount.Default.(int)
+	// album.TrackCountValidator is a validator for the "track_count" field. It is called by the builders before save.
+	album.TrackCountValidator = albumDescTrackCount.Validators[0].(func(int) error)
+	// albumDescTotalDurationMs is the schema descriptor for total_duration_ms field.
+	albumDescTotalDurationMs := albumFields[9].Descriptor()
+	// album.DefaultTotalDurationMs holds the default value on creation for the total_duration_ms field.
+	album.DefaultTotalDurationMs = albumDescTotalDurationMs.Default.(int64)
+	// album.TotalDurationMsValidator is a validator for the "total_duration_ms" field. It is called by the builders before save.
+	album.TotalDurationMsValidator = albumDescTotalDurationMs.Validators[0].(func(int64) error)
+	// albumDescCreatedAt is the schema descriptor for created_at field.
+	albumDescCreatedAt := albumFields[10].Descriptor()
+	// album.DefaultCreatedAt holds the default value on creation for the created_at field.
+	album.DefaultCreatedAt = albumDescCreatedAt.Default.(func() time.Time)
+	// albumDescID is the schema descriptor for id field.
+	albumDescID := albumFields[0].Descriptor()
+	// album.DefaultID holds the default value on creation for the id field.
+	album.DefaultID = albumDescID.Default.(func() uuid.UUID)
+	artist.Policy = privacy.NewPolicies(schema.Artist{})
+	artist.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := artist.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	artistFields := schema.Artist{}.Fields()
+	_ = artistFields
+	// artistDescName is the schema descriptor for name field.
+	artistDescName := artistFields[1].Descriptor()
+	// artist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	artist.NameValidator = artistDescName.Validators[0].(func(string) error)
+	// artistDescCreatedAt is the schema descriptor for created_at field.
+	artistDescCreatedAt := artistFields[4].Descriptor()
+	// artist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	artist.DefaultCreatedAt = artistDescCreatedAt.Default.(func() time.Time)
+	// artistDescID is the schema descriptor for id field.
+	artistDescID := artistFields[0].Descriptor()
+	// artist.DefaultID holds the default value on creation for the id field.
+	artist.DefaultID = artistDescID.Default.(func() uuid.UUID)
+	audiofingerprint.Policy = privacy.NewPolicies(schema.AudioFingerprint{})
+	audiofingerprint.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := audiofingerprint.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	audiofingerprintFields := schema.AudioFingerprint{}.Fields()
+	_ = audiofingerprintFields
+	// audiofingerprintDescDuration is the schema descriptor for duration field.
+	audiofingerprintDescDuration := audiofingerprintFields[3].Descriptor()
+	// audiofingerprint.DefaultDuration holds the default value on creation for the duration field.
+	audiofingerprint.DefaultDuration = audiofingerprintDescDuration.Default.(int)
+	// audiofingerprint.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	audiofingerprint.DurationValidator = audiofingerprintDescDuration.Validators[0].(func(int) error)
+	// audiofingerprintDescCreatedAt is the schema descriptor for created_at field.
+	audiofingerprintDescCreatedAt := audiofingerprintFields[5].Descriptor()
+	// audiofingerprint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audiofingerprint.DefaultCreatedAt = audiofingerprintDescCreatedAt.Default.(func() time.Time)
+	// audiofingerprintDescID is the schema descriptor for id field.
+	audiofingerprintDescID := audiofingerprintFields[0].Descriptor()
+	// audiofingerprint.DefaultID holds the default value on creation for the id field.
+	audiofingerprint.DefaultID = audiofingerprintDescID.Default.(func() uuid.UUID)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[2].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescStatus is the schema descriptor for status field.
+	auditlogDescStatus := auditlogFields[4].Descriptor()
+	// auditlog.DefaultStatus holds the default value on creation for the status field.
+	auditlog.DefaultStatus = auditlogDescStatus.Default.(int)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[7].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	backupFields := schema.Backup{}.Fields()
+	_ = backupFields
+	// backupDescKey is the schema descriptor for key field.
+	backupDescKey := backupFields[1].Descriptor()
+	// backup.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	backup.KeyValidator = backupDescKey.Validators[0].(func(string) error)
+	// backupDescSize is the schema descriptor for size field.
+	backupDescSize := backupFields[4].Descriptor()
+	// backup.DefaultSize holds the default value on creation for the size field.
+	backup.DefaultSize = backupDescSize.Default.(int64)
+	// backupDescCreatedAt is the schema descriptor for created_at field.
+	backupDescCreatedAt := backupFields[7].Descriptor()
+	// backup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backup.DefaultCreatedAt = backupDescCreatedAt.Default.(func() time.Time)
+	// backupDescID is the schema descriptor for id field.
+	backupDescID := backupFields[0].Descriptor()
+	// backup.DefaultID holds the default value on creation for the id field.
+	backup.DefaultID = backupDescID.Default.(func() uuid.UUID)
+	blockFields := schema.Block{}.Fields()
+	_ = blockFields
+	// blockDescCreatedAt is the schema descriptor for created_at field.
+	blockDescCreatedAt := blockFields[3].Descriptor()
+	// block.DefaultCreatedAt holds the default value on creation for the created_at field.
+	block.DefaultCreatedAt = blockDescCreatedAt.Default.(func() time.Time)
+	// blockDescID is the schema descriptor for id field.
+	blockDescID := blockFields[0].Descriptor()
+	// block.DefaultID holds the default value on creation for the id field.
+	block.DefaultID = blockDescID.Default.(func() uuid.UUID)
+	changelogentry.Policy = privacy.NewPolicies(schema.ChangelogEntry{})
+	changelogentry.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := changelogentry.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	changelogentryFields := schema.ChangelogEntry{}.Fields()
+	_ = changelogentryFields
+	// changelogentryDescVersion is the schema descriptor for version field.
+	changelogentryDescVersion := changelogentryFields[1].Descriptor()
+	// changelogentry.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	changelogentry.VersionValidator = changelogentryDescVersion.Validators[0].(func(string) error)
+	// changelogentryDescTitle is the schema descriptor for title field.
+	changelogentryDescTitle := changelogentryFields[2].Descriptor()
+	// changelogentry.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	changelogentry.TitleValidator = func() func(string) error {
+		validators := changelogentryDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// changelogentryDescBody is the schema descriptor for body field.
+	changelogentryDescBody := changelogentryFields[3].Descriptor()
+	// changelogentry.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	changelogentry.BodyValidator = changelogentryDescBody.Validators[0].(func(string) error)
+	// changelogentryDescCreatedAt is the schema descriptor for created_at field.
+	changelogentryDescCreatedAt := changelogentryFields[6].Descriptor()
+	// changelogentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	changelogentry.DefaultCreatedAt = changelogentryDescCreatedAt.Default.(func() time.Time)
+	// changelogentryDescUpdatedAt is the schema descriptor for updated_at field.
+	changelogentryDescUpdatedAt := changelogentryFields[7].Descriptor()
+	// changelogentry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	changelogentry.DefaultUpdatedAt = changelogentryDescUpdatedAt.Default.(func() time.Time)
+	// changelogentry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	changelogentry.UpdateDefaultUpdatedAt = changelogentryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// changelogentryDescID is the schema descriptor for id field.
+	changelogentryDescID := changelogentryFields[0].Descriptor()
+	// changelogentry.DefaultID holds the default value on creation for the id field.
+	changelogentry.DefaultID = changelogentryDescID.Default.(func() uuid.UUID)
+	confirmationFields := schema.Confirmation{}.Fields()
+	_ = confirmationFields
+	// confirmationDescTokenHash is the schema descriptor for token_hash field.
+	confirmationDescTokenHash := confirmationFields[3].Descriptor()
+	// confirmation.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	confirmation.TokenHashValidator = confirmationDescTokenHash.Validators[0].(func(string) error)
+	// confirmationDescSession is the schema descriptor for session field.
+	confirmationDescSession := confirmationFields[4].Descriptor()
+	// confirmation.SessionValidator is a validator for the "session" field. It is called by the builders before save.
+	confirmation.SessionValidator = confirmationDescSession.Validators[0].(func(string) error)
+	// confirmationDescCreatedAt is the schema descriptor for created_at field.
+	confirmationDescCreatedAt := confirmationFields[7].Descriptor()
+	// confirmation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	confirmation.DefaultCreatedAt = confirmationDescCreatedAt.Default.(func() time.Time)
+	// confirmationDescID is the schema descriptor for id field.
+	confirmationDescID := confirmationFields[0].Descriptor()
+	// confirmation.DefaultID holds the default value on creation for the id field.
+	confirmation.DefaultID = confirmationDescID.Default.(func() uuid.UUID)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescName is the schema descriptor for name field.
+	deadletterDescName := deadletterFields[2].Descriptor()
+	// deadletter.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deadletter.NameValidator = deadletterDescName.Validators[0].(func(string) error)
+	// deadletterDescAttempts is the schema descriptor for attempts field.
+	deadletterDescAttempts := deadletterFields[6].Descriptor()
+	// deadletter.DefaultAttempts holds the default value on creation for the attempts field.
+	deadletter.DefaultAttempts = deadletterDescAttempts.Default.(int)
+	// deadletter.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	deadletter.AttemptsValidator = deadletterDescAttempts.Validators[0].(func(int) error)
+	// deadletterDescCreatedAt is the schema descriptor for created_at field.
+	deadletterDescCreatedAt := deadletterFields[7].Descriptor()
+	// deadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletter.DefaultCreatedAt = deadletterDescCreatedAt.Default.(func() time.Time)
+	// deadletterDescLastFailedAt is the schema descriptor for last_failed_at field.
+	deadletterDescLastFailedAt := deadletterFields[8].Descriptor()
+	// deadletter.DefaultLastFailedAt holds the default value on creation for the last_failed_at field.
+	deadletter.DefaultLastFailedAt = deadletterDescLastFailedAt.Default.(func() time.Time)
+	// deadletterDescID is the schema descriptor for id field.
+	deadletterDescID := deadletterFields[0].Descriptor()
+	// deadletter.DefaultID holds the default value on creation for the id field.
+	deadletter.DefaultID = deadletterDescID.Default.(func() uuid.UUID)
+	deviceauthorizationFields := schema.DeviceAuthorization{}.Fields()
+	_ = deviceauthorizationFields
+	// deviceauthorizationDescDeviceCodeHash is the schema descriptor for device_code_hash field.
+	deviceauthorizationDescDeviceCodeHash := deviceauthorizationFields[1].Descriptor()
+	// deviceauthorization.DeviceCodeHashValidator is a validator for the "device_code_hash" field. It is called by the builders before save.
+	deviceauthorization.DeviceCodeHashValidator = deviceauthorizationDescDeviceCodeHash.Validators[0].(func(string) error)
+	// deviceauthorizationDescUserCode is the schema descriptor for user_code field.
+	deviceauthorizationDescUserCode := deviceauthorizationFields[2].Descriptor()
+	// deviceauthorization.UserCodeValidator is a validator for the "user_code" field. It is called by the builders before save.
+	deviceauthorization.UserCodeValidator = deviceauthorizationDescUserCode.Validators[0].(func(string) error)
+	// deviceauthorizationDescCreatedAt is the schema descriptor for created_at field.
+	deviceauthorizationDescCreatedAt := deviceauthorizationFields[7].Descriptor()
+	// deviceauthorization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deviceauthorization.DefaultCreatedAt = deviceauthorizationDescCreatedAt.Default.(func() time.Time)
+	// deviceauthorizationDescID is the schema descriptor for id field.
+	deviceauthorizationDescID := deviceauthorizationFields[0].Descriptor()
+	// deviceauthorization.DefaultID holds the default value on creation for the id field.
+	deviceauthorization.DefaultID = deviceauthorizationDescID.Default.(func() uuid.UUID)
+	duplicatereview.Policy = privacy.NewPolicies(schema.DuplicateReview{})
+	duplicatereview.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := duplicatereview.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	duplicatereviewFields := schema.DuplicateReview{}.Fields()
+	_ = duplicatereviewFields
+	// duplicatereviewDescSimilarity is the schema descriptor for similarity field.
+	duplicatereviewDescSimilarity := duplicatereviewFields[3].Descriptor()
+	// duplicatereview.SimilarityValidator is a validator for the "similarity" field. It is called by the builders before save.
+	duplicatereview.SimilarityValidator = func() func(float64) error {
+		validators := duplicatereviewDescSimilarity.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(similarity float64) error {
+			for _, fn := range fns {
+				if err := fn(similarity); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// duplicatereviewDescCreatedAt is the schema descriptor for created_at field.
+	duplicatereviewDescCreatedAt := duplicatereviewFields[7].Descriptor()
+	// duplicatereview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	duplicatereview.DefaultCreatedAt = duplicatereviewDescCreatedAt.Default.(func() time.Time)
+	// duplicatereviewDescID is the schema descriptor for id field.
+	duplicatereviewDescID := duplicatereviewFields[0].Descriptor()
+	// duplicatereview.DefaultID holds the default value on creation for the id field.
+	duplicatereview.DefaultID = duplicatereviewDescID.Default.(func() uuid.UUID)
+	entitlement.Policy = privacy.NewPolicies(schema.Entitlement{})
+	entitlement.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := entitlement.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	entitlementFields := schema.Entitlement{}.Fields()
+	_ = entitlementFields
+	// entitlementDescCreatedAt is the schema descriptor for created_at field.
+	entitlementDescCreatedAt := entitlementFields[5].Descriptor()
+	// entitlement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	entitlement.DefaultCreatedAt = entitlementDescCreatedAt.Default.(func() time.Time)
+	// entitlementDescID is the schema descriptor for id field.
+	entitlementDescID := entitlementFields[0].Descriptor()
+	// entitlement.DefaultID holds the default value on creation for the id field.
+	entitlement.DefaultID = entitlementDescID.Default.(func() uuid.UUID)
+	externalidentityFields := schema.ExternalIdentity{}.Fields()
+	_ = externalidentityFields
+	// externalidentityDescSubject is the schema descriptor for subject field.
+	externalidentityDescSubject := externalidentityFields[2].Descriptor()
+	// externalidentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	externalidentity.SubjectValidator = externalidentityDescSubject.Validators[0].(func(string) error)
+	// externalidentityDescProvisioned is the schema descriptor for provisioned field.
+	externalidentityDescProvisioned := externalidentityFields[4].Descriptor()
+	// externalidentity.DefaultProvisioned holds the default value on creation for the provisioned field.
+	externalidentity.DefaultProvisioned = externalidentityDescProvisioned.Default.(bool)
+	// externalidentityDescCreatedAt is the schema descriptor for created_at field.
+	externalidentityDescCreatedAt := externalidentityFields[6].Descriptor()
+	// externalidentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	externalidentity.DefaultCreatedAt = externalidentityDescCreatedAt.Default.(func() time.Time)
+	// externalidentityDescLastLoginAt is the schema descriptor for last_login_at field.
+	externalidentityDescLastLoginAt := externalidentityFields[7].Descriptor()
+	// externalidentity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	externalidentity.DefaultLastLoginAt = externalidentityDescLastLoginAt.Default.(func() time.Time)
+	// externalidentityDescID is the schema descriptor for id field.
+	externalidentityDescID := externalidentityFields[0].Descriptor()
+	// externalidentity.DefaultID holds the default value on creation for the id field.
+	externalidentity.DefaultID = externalidentityDescID.Default.(func() uuid.UUID)
+	followFields := schema.Follow{}.Fields()
+	_ = followFields
+	// followDescCreatedAt is the schema descriptor for created_at field.
+	followDescCreatedAt := followFields[3].Descriptor()
+	// follow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	follow.DefaultCreatedAt = followDescCreatedAt.Default.(func() time.Time)
+	// followDescID is the schema descriptor for id field.
+	followDescID := followFields[0].Descriptor()
+	// follow.DefaultID holds the default value on creation for the id field.
+	follow.DefaultID = followDescID.Default.(func() uuid.UUID)
+	gueststateFields := schema.GuestState{}.Fields()
+	_ = gueststateFields
+	// gueststateDescUpdatedAt is the schema descriptor for updated_at field.
+	gueststateDescUpdatedAt := gueststateFields[3].Descriptor()
+	// gueststate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	gueststate.DefaultUpdatedAt = gueststateDescUpdatedAt.Default.(func() time.Time)
+	// gueststate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	gueststate.UpdateDefaultUpdatedAt = gueststateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescCode is the schema descriptor for code field.
+	inviteDescCode := inviteFields[1].Descriptor()
+	// invite.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invite.CodeValidator = inviteDescCode.Validators[0].(func(string) error)
+	// inviteDescEmail is the schema descriptor for email field.
+	inviteDescEmail := inviteFields[4].Descriptor()
+	// invite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invite.EmailValidator = inviteDescEmail.Validators[0].(func(string) error)
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[5].Descriptor()
+	// invite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invite.DefaultMaxUses = inviteDescMaxUses.Default.(int)
+	// invite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invite.MaxUsesValidator = inviteDescMaxUses.Validators[0].(func(int) error)
+	// inviteDescUses is the schema descriptor for uses field.
+	inviteDescUses := inviteFields[6].Descriptor()
+	// invite.DefaultUses holds the default value on creation for the uses field.
+	invite.DefaultUses = inviteDescUses.Default.(int)
+	// invite.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	invite.UsesValidator = inviteDescUses.Validators[0].(func(int) error)
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteFields[8].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	// inviteDescID is the schema descriptor for id field.
+	inviteDescID := inviteFields[0].Descriptor()
+	// invite.DefaultID holds the default value on creation for the id field.
+	invite.DefaultID = inviteDescID.Default.(func() uuid.UUID)
+	like.Policy = privacy.NewPolicies(schema.Like{})
+	like.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := like.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	likeFields := schema.Like{}.Fields()
+	_ = likeFields
+	// likeDescCreatedAt is the schema descriptor for created_at field.
+	likeDescCreatedAt := likeFields[3].Descriptor()
+	// like.DefaultCreatedAt holds the default value on creation for the created_at field.
+	like.DefaultCreatedAt = likeDescCreatedAt.Default.(func() time.Time)
+	// likeDescID is the schema descriptor for id field.
+	likeDescID := likeFields[0].Descriptor()
+	// like.DefaultID holds the default value on creation for the id field.
+	like.DefaultID = likeDescID.Default.(func() uuid.UUID)
+	operationFields := schema.Operation{}.Fields()
+	_ = operationFields
+	// operationDescKind is the schema descriptor for kind field.
+	operationDescKind := operationFields[1].Descriptor()
+	// operation.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	operation.KindValidator = operationDescKind.Validators[0].(func(string) error)
+	// operationDescDone is the schema descriptor for done field.
+	operationDescDone := operationFields[4].Descriptor()
+	// operation.DefaultDone holds the default value on creation for the done field.
+	operation.DefaultDone = operationDescDone.Default.(int)
+	// operationDescTotal is the schema descriptor for total field.
+	operationDescTotal := operationFields[5].Descriptor()
+	// operation.DefaultTotal holds the default value on creation for the total field.
+	operation.DefaultTotal = operationDescTotal.Default.(int)
+	// operationDescCancelRequested is the schema descriptor for cancel_requested field.
+	operationDescCancelRequested := operationFields[8].Descriptor()
+	// operation.DefaultCancelRequested holds the default value on creation for the cancel_requested field.
+	operation.DefaultCancelRequested = operationDescCancelRequested.Default.(bool)
+	// operationDescCreatedAt is the schema descriptor for created_at field.
+	operationDescCreatedAt := operationFields[9].Descriptor()
+	// operation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	operation.DefaultCreatedAt = operationDescCreatedAt.Default.(func() time.Time)
+	// operationDescUpdatedAt is the schema descriptor for updated_at field.
+	operationDescUpdatedAt := operationFields[10].Descriptor()
+	// operation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	operation.DefaultUpdatedAt = operationDescUpdatedAt.Default.(func() time.Time)
+	// operation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	operation.UpdateDefaultUpdatedAt = operationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// operationDescID is the schema descriptor for id field.
+	operationDescID := operationFields[0].Descriptor()
+	// operation.DefaultID holds the default value on creation for the id field.
+	operation.DefaultID = operationDescID.Default.(func() uuid.UUID)
+	playFields := schema.Play{}.Fields()
+	_ = playFields
+	// playDescTerritory is the schema descriptor for territory field.
+	playDescTerritory := playFields[3].Descriptor()
+	// play.TerritoryValidator is a validator for the "territory" field. It is called by the builders before save.
+	play.TerritoryValidator = playDescTerritory.Validators[0].(func(string) error)
+	// playDescPlayedAt is the schema descriptor for played_at field.
+	playDescPlayedAt := playFields[4].Descriptor()
+	// play.DefaultPlayedAt holds the default value on creation for the played_at field.
+	play.DefaultPlayedAt = playDescPlayedAt.Default.(func() time.Time)
+	// playDescProgressMs is the schema descriptor for progress_ms field.
+	playDescProgressMs := playFields[5].Descriptor()
+	// play.DefaultProgressMs holds the default value on creation for the progress_ms field.
+	play.DefaultProgressMs = playDescProgressMs.Default.(int)
+	// playDescID is the schema descriptor for id field.
+	playDescID := playFields[0].Descriptor()
+	// play.DefaultID holds the default value on creation for the id field.
+	play.DefaultID = playDescID.Default.(func() uuid.UUID)
+	playcountFields := schema.PlayCount{}.Fields()
+	_ = playcountFields
+	// playcountDescPlays is the schema descriptor for plays field.
+	playcountDescPlays := playcountFields[4].Descriptor()
+	// playcount.DefaultPlays holds the default value on creation for the plays field.
+	playcount.DefaultPlays = playcountDescPlays.Default.(int64)
+	// playcount.PlaysValidator is a validator for the "plays" field. It is called by the builders before save.
+	playcount.PlaysValidator = playcountDescPlays.Validators[0].(func(int64) error)
+	// playcountDescID is the schema descriptor for id field.
+	playcountDescID := playcountFields[0].Descriptor()
+	// playcount.DefaultID holds the default value on creation for the id field.
+	playcount.DefaultID = playcountDescID.Default.(func() uuid.UUID)
+	playlist.Policy = privacy.NewPolicies(schema.Playlist{})
+	playlist.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := playlist.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescName is the schema descriptor for name field.
+	playlistDescName := playlistFields[2].Descriptor()
+	// playlist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	playlist.NameValidator = playlistDescName.Validators[0].(func(string) error)
+	// playlistDescPublic is the schema descriptor for public field.
+	playlistDescPublic := playlistFields[3].Descriptor()
+	// playlist.DefaultPublic holds the default value on creation for the public field.
+	playlist.DefaultPublic = playlistDescPublic.Default.(bool)
+	// playlistDescCreatedAt is the schema descriptor for created_at field.
+	playlistDescCreatedAt := playlistFields[4].Descriptor()
+	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
+	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
+	playlistDescUpdatedAt := playlistFields[5].Descriptor()
+	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
+	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// playlistDescID is the schema descriptor for id field.
+	playlistDescID := playlistFields[0].Descriptor()
+	// playlist.DefaultID holds the default value on creation for the id field.
+	playlist.DefaultID = playlistDescID.Default.(func() uuid.UUID)
+	policyacceptanceFields := schema.PolicyAcceptance{}.Fields()
+	_ = policyacceptanceFields
+	// policyacceptanceDescAcceptedAt is the schema descriptor for accepted_at field.
+	policyacceptanceDescAcceptedAt := policyacceptanceFields[4].Descriptor()
+	// policyacceptance.DefaultAcceptedAt holds the default value on creation for the accepted_at field.
+	policyacceptance.DefaultAcceptedAt = policyacceptanceDescAcceptedAt.Default.(func() time.Time)
+	// policyacceptanceDescID is the schema descriptor for id field.
+	policyacceptanceDescID := policyacceptanceFields[0].Descriptor()
+	// policyacceptance.DefaultID holds the default value on creation for the id field.
+	policyacceptance.DefaultID = policyacceptanceDescID.Default.(func() uuid.UUID)
+	policyversionFields := schema.PolicyVersion{}.Fields()
+	_ = policyversionFields
+	// policyversionDescVersion is the schema descriptor for version field.
+	policyversionDescVersion := policyversionFields[2].Descriptor()
+	// policyversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	policyversion.VersionValidator = policyversionDescVersion.Validators[0].(func(string) error)
+	// policyversionDescPublishedAt is the schema descriptor for published_at field.
+	policyversionDescPublishedAt := policyversionFields[6].Descriptor()
+	// policyversion.DefaultPublishedAt holds the default value on creation for the published_at field.
+	policyversion.DefaultPublishedAt = policyversionDescPublishedAt.Default.(func() time.Time)
+	// policyversionDescID is the schema descriptor for id field.
+	policyversionDescID := policyversionFields[0].Descriptor()
+	// policyversion.DefaultID holds the default value on creation for the id field.
+	policyversion.DefaultID = policyversionDescID.Default.(func() uuid.UUID)
+	promocode.Policy = privacy.NewPolicies(schema.PromoCode{})
+	promocode.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := promocode.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	promocodeFields := schema.PromoCode{}.Fields()
+	_ = promocodeFields
+	// promocodeDescCode is the schema descriptor for code field.
+	promocodeDescCode := promocodeFields[1].Descriptor()
+	// promocode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	promocode.CodeValidator = promocodeDescCode.Validators[0].(func(string) error)
+	// promocodeDescPercentOff is the schema descriptor for percent_off field.
+	promocodeDescPercentOff := promocodeFields[3].Descriptor()
+	// promocode.PercentOffValidator is a validator for the "percent_off" field. It is called by the builders before save.
+	promocode.PercentOffValidator = promocodeDescPercentOff.Validators[0].(func(int) error)
+	// promocodeDescMonths is the schema descriptor for months field.
+	promocodeDescMonths := promocodeFields[4].Descriptor()
+	// promocode.MonthsValidator is a validator for the "months" field. It is called by the builders before save.
+	promocode.MonthsValidator = promocodeDescMonths.Validators[0].(func(int) error)
+	// promocodeDescMaxRedemptions is the schema descriptor for max_redemptions field.
+	promocodeDescMaxRedemptions := promocodeFields[6].Descriptor()
+	// promocode.MaxRedemptionsValidator is a validator for the "max_redemptions" field. It is called by the builders before save.
+	promocode.MaxRedemptionsValidator = promocodeDescMaxRedemptions.Validators[0].(func(int) error)
+	// promocodeDescPerUserLimit is the schema descriptor for per_user_limit field.
+	promocodeDescPerUserLimit := promocodeFields[7].Descriptor()
+	// promocode.DefaultPerUserLimit holds the default value on creation for the per_user_limit field.
+	promocode.DefaultPerUserLimit = promocodeDescPerUserLimit.Default.(int)
+	// promocode.PerUserLimitValidator is a validator for the "per_user_limit" field. It is called by the builders before save.
+	promocode.PerUserLimitValidator = promocodeDescPerUserLimit.Validators[0].(func(int) error)
+	// promocodeDescRedemptions is the schema descriptor for redemptions field.
+	promocodeDescRedemptions := promocodeFields[8].Descriptor()
+	// promocode.DefaultRedemptions holds the default value on creation for the redemptions field.
+	promocode.DefaultRedemptions = promocodeDescRedemptions.Default.(int)
+	// promocode.RedemptionsValidator is a validator for the "redemptions" field. It is called by the builders before save.
+	promocode.RedemptionsValidator = promocodeDescRedemptions.Validators[0].(func(int) error)
+	// promocodeDescCreatedAt is the schema descriptor for created_at field.
+	promocodeDescCreatedAt := promocodeFields[11].Descriptor()
+	// promocode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promocode.DefaultCreatedAt = promocodeDescCreatedAt.Default.(func() time.Time)
+	// promocodeDescID is the schema descriptor for id field.
+	promocodeDescID := promocodeFields[0].Descriptor()
+	// promocode.DefaultID holds the default value on creation for the id field.
+	promocode.DefaultID = promocodeDescID.Default.(func() uuid.UUID)
+	promoredemption.Policy = privacy.NewPolicies(schema.PromoRedemption{})
+	promoredemption.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := promoredemption.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	promoredemptionFields := schema.PromoRedemption{}.Fields()
+	_ = promoredemptionFields
+	// promoredemptionDescCreatedAt is the schema descriptor for created_at field.
+	promoredemptionDescCreatedAt := promoredemptionFields[6].Descriptor()
+	// promoredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promoredemption.DefaultCreatedAt = promoredemptionDescCreatedAt.Default.(func() time.Time)
+	// promoredemptionDescID is the schema descriptor for id field.
+	promoredemptionDescID := promoredemptionFields[0].Descriptor()
+	// promoredemption.DefaultID holds the default value on creation for the id field.
+	promoredemption.DefaultID = promoredemptionDescID.Default.(func() uuid.UUID)
+	referral.Policy = privacy.NewPolicies(schema.Referral{})
+	referral.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := referral.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	referralFields := schema.Referral{}.Fields()
+	_ = referralFields
+	// referralDescRewardDays is the schema descriptor for reward_days field.
+	referralDescRewardDays := referralFields[6].Descriptor()
+	// referral.DefaultRewardDays holds the default value on creation for the reward_days field.
+	referral.DefaultRewardDays = referralDescRewardDays.Default.(int)
+	// referral.RewardDaysValidator is a validator for the "reward_days" field. It is called by the builders before save.
+	referral.RewardDaysValidator = referralDescRewardDays.Validators[0].(func(int) error)
+	// referralDescSignupIP is the schema descriptor for signup_ip field.
+	referralDescSignupIP := referralFields[8].Descriptor()
+	// referral.SignupIPValidator is a validator for the "signup_ip" field. It is called by the builders before save.
+	referral.SignupIPValidator = referralDescSignupIP.Validators[0].(func(string) error)
+	// referralDescCreatedAt is the schema descriptor for created_at field.
+	referralDescCreatedAt := referralFields[9].Descriptor()
+	// referral.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referral.DefaultCreatedAt = referralDescCreatedAt.Default.(func() time.Time)
+	// referralDescID is the schema descriptor for id field.
+	referralDescID := referralFields[0].Descriptor()
+	// referral.DefaultID holds the default value on creation for the id field.
+	referral.DefaultID = referralDescID.Default.(func() uuid.UUID)
+	scimgroupFields := schema.SCIMGroup{}.Fields()
+	_ = scimgroupFields
+	// scimgroupDescDisplayName is the schema descriptor for display_name field.
+	scimgroupDescDisplayName := scimgroupFields[2].Descriptor()
+	// scimgroup.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	scimgroup.DisplayNameValidator = func() func(string) error {
+		validators := scimgroupDescDisplayName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(display_name string) error {
+			for _, fn := range fns {
+				if err := fn(display_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scimgroupDescCreatedAt is the schema descriptor for created_at field.
+	scimgroupDescCreatedAt := scimgroupFields[5].Descriptor()
+	// scimgroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scimgroup.DefaultCreatedAt = scimgroupDescCreatedAt.Default.(func() time.Time)
+	// scimgroupDescUpdatedAt is the schema descriptor for updated_at field.
+	scimgroupDescUpdatedAt := scimgroupFields[6].Descriptor()
+	// scimgroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scimgroup.DefaultUpdatedAt = scimgroupDescUpdatedAt.Default.(func() time.Time)
+	// scimgroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scimgroup.UpdateDefaultUpdatedAt = scimgroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scimgroupDescID is the schema descriptor for id field.
+	scimgroupDescID := scimgroupFields[0].Descriptor()
+	// scimgroup.DefaultID holds the default value on creation for the id field.
+	scimgroup.DefaultID = scimgroupDescID.Default.(func() uuid.UUID)
+	ssoproviderFields := schema.SSOProvider{}.Fields()
+	_ = ssoproviderFields
+	// ssoproviderDescSlug is the schema descriptor for slug field.
+	ssoproviderDescSlug := ssoproviderFields[1].Descriptor()
+	// ssoprovider.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	ssoprovider.SlugValidator = func() func(string) error {
+		validators := ssoproviderDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ssoproviderDescName is the schema descriptor for name field.
+	ssoproviderDescName := ssoproviderFields[2].Descriptor()
+	// ssoprovider.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ssoprovider.NameValidator = func() func(string) error {
+		validators := ssoproviderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ssoproviderDescIssuer is the schema descriptor for issuer field.
+	ssoproviderDescIssuer := ssoproviderFields[3].Descriptor()
+	// ssoprovider.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	ssoprovider.IssuerValidator = ssoproviderDescIssuer.Validators[0].(func(string) error)
+	// ssoproviderDescClientID is the schema descriptor for client_id field.
+	ssoproviderDescClientID := ssoproviderFields[4].Descriptor()
+	// ssoprovider.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ssoprovider.ClientIDValidator = ssoproviderDescClientID.Validators[0].(func(string) error)
+	// ssoproviderDescClientSecret is the schema descriptor for client_secret field.
+	ssoproviderDescClientSecret := ssoproviderFields[5].Descriptor()
+	// ssoprovider.ClientSecretValidator is a validator for the "client_secret" field. It is called by the builders before save.
+	ssoprovider.ClientSecretValidator = ssoproviderDescClientSecret.Validators[0].(func(string) error)
+	// ssoproviderDescGroupsClaim is the schema descriptor for groups_claim field.
+	ssoproviderDescGroupsClaim := ssoproviderFields[6].Descriptor()
+	// ssoprovider.DefaultGroupsClaim holds the default value on creation for the groups_claim field.
+	ssoprovider.DefaultGroupsClaim = ssoproviderDescGroupsClaim.Default.(string)
+	// ssoproviderDescScimTokenHash is the schema descriptor for scim_token_hash field.
+	ssoproviderDescScimTokenHash := ssoproviderFields[9].Descriptor()
+	// ssoprovider.ScimTokenHashValidator is a validator for the "scim_token_hash" field. It is called by the builders before save.
+	ssoprovider.ScimTokenHashValidator = ssoproviderDescScimTokenHash.Validators[0].(func(string) error)
+	// ssoproviderDescRegion is the schema descriptor for region field.
+	ssoproviderDescRegion := ssoproviderFields[10].Descriptor()
+	// ssoprovider.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	ssoprovider.RegionValidator = ssoproviderDescRegion.Validators[0].(func(string) error)
+	// ssoproviderDescProvisioning is the schema descriptor for provisioning field.
+	ssoproviderDescProvisioning := ssoproviderFields[11].Descriptor()
+	// ssoprovider.DefaultProvisioning holds the default value on creation for the provisioning field.
+	ssoprovider.DefaultProvisioning = ssoproviderDescProvisioning.Default.(bool)
+	// ssoproviderDescEnabled is the schema descriptor for enabled field.
+	ssoproviderDescEnabled := ssoproviderFields[12].Descriptor()
+	// ssoprovider.DefaultEnabled holds the default value on creation for the enabled field.
+	ssoprovider.DefaultEnabled = ssoproviderDescEnabled.Default.(bool)
+	// ssoproviderDescCreatedAt is the schema descriptor for created_at field.
+	ssoproviderDescCreatedAt := ssoproviderFields[13].Descriptor()
+	// ssoprovider.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ssoprovider.DefaultCreatedAt = ssoproviderDescCreatedAt.Default.(func() time.Time)
+	// ssoproviderDescID is the schema descriptor for id field.
+	ssoproviderDescID := ssoproviderFields[0].Descriptor()
+	// ssoprovider.DefaultID holds the default value on creation for the id field.
+	ssoprovider.DefaultID = ssoproviderDescID.Default.(func() uuid.UUID)
+	securityalertFields := schema.SecurityAlert{}.Fields()
+	_ = securityalertFields
+	// securityalertDescRule is the schema descriptor for rule field.
+	securityalertDescRule := securityalertFields[2].Descriptor()
+	// securityalert.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	securityalert.RuleValidator = func() func(string) error {
+		validators := securityalertDescRule.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rule string) error {
+			for _, fn := range fns {
+				if err := fn(rule); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// securityalertDescCountry is the schema descriptor for country field.
+	securityalertDescCountry := securityalertFields[6].Descriptor()
+	// securityalert.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	securityalert.CountryValidator = securityalertDescCountry.Validators[0].(func(string) error)
+	// securityalertDescReauthForced is the schema descriptor for reauth_forced field.
+	securityalertDescReauthForced := securityalertFields[7].Descriptor()
+	// securityalert.DefaultReauthForced holds the default value on creation for the reauth_forced field.
+	securityalert.DefaultReauthForced = securityalertDescReauthForced.Default.(bool)
+	// securityalertDescCreatedAt is the schema descriptor for created_at field.
+	securityalertDescCreatedAt := securityalertFields[10].Descriptor()
+	// securityalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityalert.DefaultCreatedAt = securityalertDescCreatedAt.Default.(func() time.Time)
+	// securityalertDescID is the schema descriptor for id field.
+	securityalertDescID := securityalertFields[0].Descriptor()
+	// securityalert.DefaultID holds the default value on creation for the id field.
+	securityalert.DefaultID = securityalertDescID.Default.(func() uuid.UUID)
+	sharelinkFields := schema.ShareLink{}.Fields()
+	_ = sharelinkFields
+	// sharelinkDescToken is the schema descriptor for token field.
+	sharelinkDescToken := sharelinkFields[1].Descriptor()
+	// sharelink.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	sharelink.TokenValidator = sharelinkDescToken.Validators[0].(func(string) error)
+	// sharelinkDescVisits is the schema descriptor for visits field.
+	sharelinkDescVisits := sharelinkFields[5].Descriptor()
+	// sharelink.DefaultVisits holds the default value on creation for the visits field.
+	sharelink.DefaultVisits = sharelinkDescVisits.Default.(int)
+	// sharelink.VisitsValidator is a validator for the "visits" field. It is called by the builders before save.
+	sharelink.VisitsValidator = sharelinkDescVisits.Validators[0].(func(int) error)
+	// sharelinkDescCreatedAt is the schema descriptor for created_at field.
+	sharelinkDescCreatedAt := sharelinkFields[6].Descriptor()
+	// sharelink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sharelink.DefaultCreatedAt = sharelinkDescCreatedAt.Default.(func() time.Time)
+	// sharelinkDescID is the schema descriptor for id field.
+	sharelinkDescID := sharelinkFields[0].Descriptor()
+	// sharelink.DefaultID holds the default value on creation for the id field.
+	sharelink.DefaultID = sharelinkDescID.Default.(func() uuid.UUID)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescSecret is the schema descriptor for secret field.
+	signingkeyDescSecret := signingkeyFields[1].Descriptor()
+	// signingkey.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	signingkey.SecretValidator = signingkeyDescSecret.Validators[0].(func(string) error)
+	// signingkeyDescFromEnv is the schema descriptor for from_env field.
+	signingkeyDescFromEnv := signingkeyFields[2].Descriptor()
+	// signingkey.DefaultFromEnv holds the default value on creation for the from_env field.
+	signingkey.DefaultFromEnv = signingkeyDescFromEnv.Default.(bool)
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[3].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	// signingkeyDescID is the schema descriptor for id field.
+	signingkeyDescID := signingkeyFields[0].Descriptor()
+	// signingkey.DefaultID holds the default value on creation for the id field.
+	signingkey.DefaultID = signingkeyDescID.Default.(func() uuid.UUID)
+	supportattachment.Policy = privacy.NewPolicies(schema.SupportAttachment{})
+	supportattachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := supportattachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	supportattachmentFields := schema.SupportAttachment{}.Fields()
+	_ = supportattachmentFields
+	// supportattachmentDescFilename is the schema descriptor for filename field.
+	supportattachmentDescFilename := supportattachmentFields[3].Descriptor()
+	// supportattachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	supportattachment.FilenameValidator = func() func(string) error {
+		validators := supportattachmentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// supportattachmentDescSize is the schema descriptor for size field.
+	supportattachmentDescSize := supportattachmentFields[5].Descriptor()
+	// supportattachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	supportattachment.SizeValidator = supportattachmentDescSize.Validators[0].(func(int64) error)
+	// supportattachmentDescCreatedAt is the schema descriptor for created_at field.
+	supportattachmentDescCreatedAt := supportattachmentFields[6].Descriptor()
+	// supportattachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	supportattachment.DefaultCreatedAt = supportattachmentDescCreatedAt.Default.(func() time.Time)
+	// supportattachmentDescID is the schema descriptor for id field.
+	supportattachmentDescID := supportattachmentFields[0].Descriptor()
+	// supportattachment.DefaultID holds the default value on creation for the id field.
+	supportattachment.DefaultID = supportattachmentDescID.Default.(func() uuid.UUID)
+	supportreply.Policy = privacy.NewPolicies(schema.SupportReply{})
+	supportreply.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := supportreply.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	supportreplyFields := schema.SupportReply{}.Fields()
+	_ = supportreplyFields
+	// supportreplyDescStaff is the schema descriptor for staff field.
+	supportreplyDescStaff := supportreplyFields[3].Descriptor()
+	// supportreply.DefaultStaff holds the default value on creation for the staff field.
+	supportreply.DefaultStaff = supportreplyDescStaff.Default.(bool)
+	// supportreplyDescBody is the schema descriptor for body field.
+	supportreplyDescBody := supportreplyFields[4].Descriptor()
+	// supportreply.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	supportreply.BodyValidator = func() func(string) error {
+		validators := supportreplyDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// supportreplyDescCreatedAt is the schema descriptor for created_at field.
+	supportreplyDescCreatedAt := supportreplyFields[5].Descriptor()
+	// supportreply.DefaultCreatedAt holds the default value on creation for the created_at field.
+	supportreply.DefaultCreatedAt = supportreplyDescCreatedAt.Default.(func() time.Time)
+	// supportreplyDescID is the schema descriptor for id field.
+	supportreplyDescID := supportreplyFields[0].Descriptor()
+	// supportreply.DefaultID holds the default value on creation for the id field.
+	supportreply.DefaultID = supportreplyDescID.Default.(func() uuid.UUID)
+	supportticket.Policy = privacy.NewPolicies(schema.SupportTicket{})
+	supportticket.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := supportticket.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	supportticketFields := schema.SupportTicket{}.Fields()
+	_ = supportticketFields
+	// supportticketDescSubject is the schema descriptor for subject field.
+	supportticketDescSubject := supportticketFields[2].Descriptor()
+	// supportticket.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	supportticket.SubjectValidator = func() func(string) error {
+		validators := supportticketDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// supportticketDescBody is the schema descriptor for body field.
+	supportticketDescBody := supportticketFields[3].Descriptor()
+	// supportticket.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	supportticket.BodyValidator = func() func(string) error {
+		validators := supportticketDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// supportticketDescCreatedAt is the schema descriptor for created_at field.
+	supportticketDescCreatedAt := supportticketFields[6].Descriptor()
+	// supportticket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	supportticket.DefaultCreatedAt = supportticketDescCreatedAt.Default.(func() time.Time)
+	// supportticketDescUpdatedAt is the schema descriptor for updated_at field.
+	supportticketDescUpdatedAt := supportticketFields[7].Descriptor()
+	// supportticket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	supportticket.DefaultUpdatedAt = supportticketDescUpdatedAt.Default.(func() time.Time)
+	// supportticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	supportticket.UpdateDefaultUpdatedAt = supportticketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// supportticketDescID is the schema descriptor for id field.
+	supportticketDescID := supportticketFields[0].Descriptor()
+	// supportticket.DefaultID holds the default value on creation for the id field.
+	supportticket.DefaultID = supportticketDescID.Default.(func() uuid.UUID)
+	tombstoneFields := schema.Tombstone{}.Fields()
+	_ = tombstoneFields
+	// tombstoneDescDeletedAt is the schema descriptor for deleted_at field.
+	tombstoneDescDeletedAt := tombstoneFields[4].Descriptor()
+	// tombstone.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	tombstone.DefaultDeletedAt = tombstoneDescDeletedAt.Default.(func() time.Time)
+	// tombstoneDescID is the schema descriptor for id field.
+	tombstoneDescID := tombstoneFields[0].Descriptor()
+	// tombstone.DefaultID holds the default value on creation for the id field.
+	tombstone.DefaultID = tombstoneDescID.Default.(func() uuid.UUID)
+	track.Policy = privacy.NewPolicies(schema.Track{})
+	track.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := track.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	trackFields := schema.Track{}.Fields()
+	_ = trackFields
+	// trackDescTitle is the schema descriptor for title field.
+	trackDescTitle := trackFields[1].Descriptor()
+	// track.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	track.TitleValidator = trackDescTitle.Validators[0].(func(string) error)
+	// trackDescTrackNumber is the schema descriptor for track_number field.
+	trackDescTrackNumber := trackFields[3].Descriptor()
+	// track.TrackNumberValidator is a validator for the "track_number" field. It is called by the builders before save.
+	track.TrackNumberValidator = trackDescTrackNumber.Validators[0].(func(int) error)
+	// trackDescDiscNumber is the schema descriptor for disc_number field.
+	trackDescDiscNumber := trackFields[4].Descriptor()
+	// track.DefaultDiscNumber holds the default value on creation for the disc_number field.
+	track.DefaultDiscNumber = trackDescDiscNumber.Default.(int)
+	// track.DiscNumberValidator is a validator for the "disc_number" field. It is called by the builders before save.
+	track.DiscNumberValidator = trackDescDiscNumber.Validators[0].(func(int) error)
+	// trackDescDurationMs is the schema descriptor for duration_ms field.
+	trackDescDurationMs := trackFields[7].Descriptor()
+	// track.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	track.DurationMsValidator = trackDescDurationMs.Validators[0].(func(int64) error)
+	// trackDescCreatedAt is the schema descriptor for created_at field.
+	trackDescCreatedAt := trackFields[10].Descriptor()
+	// track.DefaultCreatedAt holds the default value on creation for the created_at field.
+	track.DefaultCreatedAt = trackDescCreatedAt.Default.(func() time.Time)
+	// trackDescID is the schema descriptor for id field.
+	trackDescID := trackFields[0].Descriptor()
+	// track.DefaultID holds the default value on creation for the id field.
+	track.DefaultID = trackDescID.Default.(func() uuid.UUID)
+	trackcredit.Policy = privacy.NewPolicies(schema.TrackCredit{})
+	trackcredit.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := trackcredit.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	trackcreditFields := schema.TrackCredit{}.Fields()
+	_ = trackcreditFields
+	// trackcreditDescCreatedAt is the schema descriptor for created_at field.
+	trackcreditDescCreatedAt := trackcreditFields[4].Descriptor()
+	// trackcredit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trackcredit.DefaultCreatedAt = trackcreditDescCreatedAt.Default.(func() time.Time)
+	// trackcreditDescID is the schema descriptor for id field.
+	trackcreditDescID := trackcreditFields[0].Descriptor()
+	// trackcredit.DefaultID holds the default value on creation for the id field.
+	trackcredit.DefaultID = trackcreditDescID.Default.(func() uuid.UUID)
+	uploadsession.Policy = privacy.NewPolicies(schema.UploadSession{})
+	uploadsession.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := uploadsession.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	uploadsessionFields := schema.UploadSession{}.Fields()
+	_ = uploadsessionFields
+	// uploadsessionDescLength is the schema descriptor for length field.
+	uploadsessionDescLength := uploadsessionFields[3].Descriptor()
+	// uploadsession.LengthValidator is a validator for the "length" field. It is called by the builders before save.
+	uploadsession.LengthValidator = uploadsessionDescLength.Validators[0].(func(int64) error)
+	// uploadsessionDescOffset is the schema descriptor for offset field.
+	uploadsessionDescOffset := uploadsessionFields[4].Descriptor()
+	// uploadsession.DefaultOffset holds the default value on creation for the offset field.
+	uploadsession.DefaultOffset = uploadsessionDescOffset.Default.(int64)
+	// uploadsession.OffsetValidator is a validator for the "offset" field. It is called by the builders before save.
+	uploadsession.OffsetValidator = uploadsessionDescOffset.Validators[0].(func(int64) error)
+	// uploadsessionDescCreatedAt is the schema descriptor for created_at field.
+	uploadsessionDescCreatedAt := uploadsessionFields[8].Descriptor()
+	// uploadsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	uploadsession.DefaultCreatedAt = uploadsessionDescCreatedAt.Default.(func() time.Time)
+	// uploadsessionDescID is the schema descriptor for id field.
+	uploadsessionDescID := uploadsessionFields[0].Descriptor()
+	// uploadsession.DefaultID holds the default value on creation for the id field.
+	uploadsession.DefaultID = uploadsessionDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[1].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescFirstName is the schema descriptor for first_name field.
+	userDescFirstName := userFields[2].Descriptor()
+	// user.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
+	user.FirstNameValidator = userDescFirstName.Validators[0].(func(string) error)
+	// userDescLastName is the schema descriptor for last_name field.
+	userDescLastName := userFields[3].Descriptor()
+	// user.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
+	user.LastNameValidator = userDescLastName.Validators[0].(func(string) error)
+	// userDescAnalyticsOptOut is the schema descriptor for analytics_opt_out field.
+	userDescAnalyticsOptOut := userFields[10].Descriptor()
+	// user.DefaultAnalyticsOptOut holds the default value on creation for the analytics_opt_out field.
+	user.DefaultAnalyticsOptOut = userDescAnalyticsOptOut.Default.(bool)
+	// userDescRegion is the schema descriptor for region field.
+	userDescRegion := userFields[15].Descriptor()
+	// user.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	user.RegionValidator = userDescRegion.Validators[0].(func(string) error)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescEmail is the schema descriptor for email field.
+	waitlistentryDescEmail := waitlistentryFields[1].Descriptor()
+	// waitlistentry.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	waitlistentry.EmailValidator = waitlistentryDescEmail.Validators[0].(func(string) error)
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[4].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	// waitlistentryDescID is the schema descriptor for id field.
+	waitlistentryDescID := waitlistentryFields[0].Descriptor()
+	// waitlistentry.DefaultID holds the default value on creation for the id field.
+	waitlistentry.DefaultID = waitlistentryDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -6,7 +6,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
 )
 
 // Album holds the schema definition for the Album entity.
@@ -18,20 +23,60 @@ type Album struct {
 func (Album) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(ids.New).
 			Unique(),
 		field.String("title").
+			Comment("Title as printed on the release").
+			Annotations(Doc{Example: "Pastel Blues"}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
 				"mysql":    "varchar(255)",
 				"sqlite3":  "varchar(255)",
 			}),
-		field.UUID("artist_id", uuid.UUID{}),
+		field.UUID("artist_id", uuid.UUID{}).
+			Comment("The album's main artist"),
 		field.String("image_url").
+			Comment("Cover art").
+			Annotations(Doc{Example: "https://cdn.example.com/albums/pastel-blues.jpg", Rules: []string{"format=url"}}).
+			Optional(),
+		field.JSON("palette", []string{}).
+			Comment("Dominant colors of the artwork as #rrggbb, most dominant first, for clients to tint the UI with").
+			Annotations(Doc{Example: []string{"#1d2b53", "#e0c097"}, Rules: []string{"format=hex_color"}}).
+			Optional(),
+		field.String("label").
+			Comment("Record label that released the album").
+			Annotations(Doc{Example: "Philips"}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}).
 			Optional(),
+		field.Enum("album_type").
+			Comment("Kind of release").
+			Values("album", "single", "ep", "compilation").
+			Default("album"),
+		field.Time("release_date").
+			Comment("When the album was first released").
+			Optional().
+			Nillable(),
+		field.Int("track_count").
+			Comment("Live tracks on the album, kept up to date as tracks change").
+			Annotations(Doc{Example: 12, Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Int64("total_duration_ms").
+			Comment("Summed length of the album's live tracks in milliseconds; tracks of unknown length add nothing").
+			Annotations(Doc{Example: 2580000, Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -46,3 +91,22 @@ func (Album) Edges() []ent.Edge {
 			Ref("album"),
 	}
 }
+
+// Indexes of the Album.
+func (Album) Indexes() []ent.Index {
+	return []ent.Index{
+		// Artist album listings ordered by creation time
+		index.Fields("artist_id", "created_at"),
+	}
+}
+
+// Policy of the Album. Only admins change the catalog.
+func (Album) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+// An API key lets an external integration call the API on behalf of its owner.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The developer who owns the key").
+			Immutable(),
+		field.String("name").
+			Comment("Name the owner gave the key").
+			Annotations(Doc{Example: "CI pipeline", Rules: []string{"not_empty"}}).
+			MaxLen(100).
+			NotEmpty(),
+		field.String("prefix").
+			Comment("The first characters of the key, shown so owners can tell keys apart").
+			Annotations(Doc{Example: "sk_4f2k9d"}).
+			MaxLen(16).
+			Immutable(),
+		field.String("key_hash").
+			Comment("SHA-256 of the key; the key itself is only shown once, on creation").
+			MaxLen(64).
+			SchemaType(map[string]string{
+				"postgres": "char(64)",
+				"mysql":    "char(64)",
+				"sqlite3":  "char(64)",
+			}).
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.Enum("tier").
+			Comment("The tier sets the key's default monthly quota and overage behavior").
+			Values("free", "pro", "enterprise").
+			Default("free"),
+		field.Int64("monthly_quota").
+			Comment("Overrides the tier's monthly request quota; 0 means unlimited").
+			Annotations(Doc{Example: 100000, Rules: []string{"min=0"}}).
+			Optional().
+			Nillable().
+			NonNegative(),
+		field.Enum("overage").
+			Comment("Overrides what happens once the quota is used up: block answers 429, allow serves the request and reports the overage").
+			Values("block", "allow").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Comment("When a request last authenticated with the key").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Comment("Revoked keys are refused").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKeyUsage holds the schema definition for the APIKeyUsage entity.
+// Each row counts one API key's requests during one hour.
+type APIKeyUsage struct {
+	ent.Schema
+}
+
+// Fields of the APIKeyUsage.
+func (APIKeyUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("key_id", uuid.UUID{}).
+			Comment("The API key").
+			Immutable(),
+		field.Time("bucket").
+			Comment("Start of the hour, in UTC").
+			Immutable(),
+		field.Int64("requests").
+			Comment("Requests made with the key in the hour").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Int64("rate_limited").
+			Comment("Requests answered 429 Too Many Requests").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Int64("client_errors").
+			Comment("Other 4xx answers").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Int64("server_errors").
+			Comment("5xx answers").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the APIKeyUsage.
+func (APIKeyUsage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("key", APIKey.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("key_id"),
+	}
+}
+
+// Indexes of the APIKeyUsage.
+func (APIKeyUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key_id", "bucket").
+			Unique(),
+		// Old buckets are purged by age
+		index.Fields("bucket"),
+	}
+}
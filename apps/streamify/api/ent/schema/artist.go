@@ -7,6 +7,10 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
 )
 
 // Artist holds the schema definition for the Artist entity.
@@ -18,9 +22,11 @@ type Artist struct {
 func (Artist) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(ids.New).
 			Unique(),
 		field.String("name").
+			Comment("Name the artist performs under").
+			Annotations(Doc{Example: "Nina Simone"}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -28,9 +34,18 @@ func (Artist) Fields() []ent.Field {
 				"sqlite3":  "varchar(255)",
 			}),
 		field.String("image_url").
+			Comment("Artist photo").
+			Annotations(Doc{Example: "https://cdn.example.com/artists/nina-simone.jpg", Rules: []string{"format=url"}}).
+			Optional(),
+		field.JSON("palette", []string{}).
+			Comment("Dominant colors of the artwork as #rrggbb, most dominant first, for clients to tint the UI with").
+			Annotations(Doc{Example: []string{"#1d2b53", "#e0c097"}, Rules: []string{"format=hex_color"}}).
 			Optional(),
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -41,3 +56,14 @@ func (Artist) Edges() []ent.Edge {
 			Ref("artist"),
 	}
 }
+
+// Policy of the Artist. Only admins change the catalog.
+func (Artist) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
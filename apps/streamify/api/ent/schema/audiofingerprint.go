@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// AudioFingerprint holds the schema definition for the AudioFingerprint entity.
+// It identifies the audio uploaded for a track, exactly and acoustically.
+type AudioFingerprint struct {
+	ent.Schema
+}
+
+// Fields of the AudioFingerprint.
+func (AudioFingerprint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The fingerprinted track").
+			Unique(),
+		field.String("sha256").
+			Comment("SHA-256 of the uploaded file, hex encoded").
+			Annotations(Doc{Rules: []string{"format=hex"}}).
+			SchemaType(map[string]string{
+				"postgres": "char(64)",
+				"mysql":    "char(64)",
+				"sqlite3":  "char(64)",
+			}),
+		field.Int("duration").
+			Comment("Duration in seconds; 0 when the audio couldn't be fingerprinted").
+			Annotations(Doc{Example: 622, Rules: []string{"min=0"}}).
+			NonNegative().
+			Default(0),
+		field.Bytes("fingerprint").
+			Comment("Raw chromaprint fingerprint, little-endian uint32s; empty when unavailable").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the AudioFingerprint.
+func (AudioFingerprint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Field("track_id"),
+	}
+}
+
+// Indexes of the AudioFingerprint.
+func (AudioFingerprint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sha256"),
+		// Near-match candidates are narrowed by duration
+		index.Fields("duration"),
+	}
+}
+
+// Policy of the AudioFingerprint. Only admins change the catalog.
+func (AudioFingerprint) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
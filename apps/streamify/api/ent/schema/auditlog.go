@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Entries reference users by ID only, so the trail outlives deleted accounts.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("actor_id", uuid.UUID{}).
+			Comment("The user who made the request; unset for anonymous ones").
+			Optional().
+			Nillable(),
+		field.String("action").
+			Comment("Method and route of the request").
+			Annotations(Doc{Example: "DELETE /api/v1/artists/:id"}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}),
+		field.String("target_id").
+			Comment("ID of the entity acted on").
+			Optional(),
+		field.Int("status").
+			Comment("HTTP status the request was answered with").
+			Annotations(Doc{Example: 204}).
+			Default(0),
+		field.String("ip").
+			Comment("Client address").
+			Annotations(Doc{Example: "203.0.113.7", Rules: []string{"format=ip"}}).
+			Optional(),
+		field.JSON("metadata", map[string]string{}).
+			Comment("Details of the action, by name").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// Retention sweeps and time-range queries
+		index.Fields("created_at"),
+		// Activity of one admin
+		index.Fields("actor_id", "created_at"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Backup holds the schema definition for the Backup entity.
+type Backup struct {
+	ent.Schema
+}
+
+// Fields of the Backup.
+func (Backup) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("key").
+			Comment("Storage key of the dump").
+			Annotations(Doc{Example: "backups/2026-10-16T020000Z.dump"}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}).
+			Unique(),
+		field.Enum("status").
+			Comment("Running until the dump is written or fails").
+			Values("running", "completed", "failed").
+			Default("running"),
+		field.Enum("trigger").
+			Comment("Whether an admin or the schedule started the backup").
+			Values("manual", "scheduled").
+			Default("manual"),
+		field.Int64("size").
+			Comment("Size of the dump in bytes").
+			Default(0),
+		field.String("checksum").
+			Comment("SHA-256 of the dump, hex encoded").
+			Annotations(Doc{Rules: []string{"format=hex"}}).
+			Optional(),
+		field.String("error").
+			Comment("Why the backup failed").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("completed_at").
+			Comment("When the dump was written").
+			Optional().
+			Nillable(),
+		field.Time("verified_at").
+			Comment("When the dump was last checked to be readable by pg_restore and to match its checksum").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Backup.
+func (Backup) Edges() []ent.Edge {
+	return nil
+}
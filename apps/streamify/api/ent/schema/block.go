@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Block holds the schema definition for the Block entity.
+type Block struct {
+	ent.Schema
+}
+
+// Fields of the Block.
+func (Block) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("blocker_id", uuid.UUID{}).
+			Comment("The user blocking"),
+		field.UUID("blocked_id", uuid.UUID{}).
+			Comment("The user blocked"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Block.
+func (Block) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("blocker", User.Type).
+			Unique().
+			Required().
+			Field("blocker_id"),
+		edge.To("blocked", User.Type).
+			Unique().
+			Required().
+			Field("blocked_id"),
+	}
+}
+
+// Indexes of the Block.
+func (Block) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("blocker_id", "blocked_id").
+			Unique(),
+		// Who has blocked a given user
+		index.Fields("blocked_id"),
+	}
+}
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChangelogEntry holds the schema definition for the ChangelogEntry entity.
+// Each entry describes what a release brought, written by an admin and shown
+// to users in the what's new feed once it is published.
+type ChangelogEntry struct {
+	ent.Schema
+}
+
+// Fields of the ChangelogEntry.
+func (ChangelogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("version").
+			Comment("The release the entry is about").
+			Annotations(Doc{Example: "4.2.0"}).
+			MaxLen(64).
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+				"mysql":    "varchar(64)",
+				"sqlite3":  "varchar(64)",
+			}).
+			Unique(),
+		field.String("title").
+			Annotations(Doc{Example: "Offline playlists"}).
+			MaxLen(200).
+			NotEmpty(),
+		field.Text("body").
+			Comment("What changed, in Markdown").
+			Annotations(Doc{Rules: []string{"max=20000"}}).
+			MaxLen(20000),
+		field.Time("published_at").
+			Comment("When the entry appears in the feed; unset for drafts. Entries published at a later time stay hidden until then.").
+			Optional().
+			Nillable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("Kept out of responses since every user reads the feed; the audit log has it").
+			StructTag(`json:"-"`).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ChangelogEntry.
+func (ChangelogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// The feed lists published entries, newest first
+		index.Fields("published_at"),
+	}
+}
+
+// Policy of the ChangelogEntry. Every user reads the published entries; only
+// admins write them.
+func (ChangelogEntry) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Confirmation holds the schema definition for the Confirmation entity.
+// A user re-enters their password to get one before a sensitive change; it is
+// bound to one action and session and can be used once.
+type Confirmation struct {
+	ent.Schema
+}
+
+// Fields of the Confirmation.
+func (Confirmation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user who re-entered their password").
+			Immutable(),
+		field.Enum("action").
+			Comment("The change the confirmation allows").
+			Values("change_password", "change_email").
+			Immutable(),
+		field.String("token_hash").
+			Comment("SHA-256 of the token handed to the client; the token itself is never stored").
+			MaxLen(64).
+			SchemaType(map[string]string{
+				"postgres": "char(64)",
+				"mysql":    "char(64)",
+				"sqlite3":  "char(64)",
+			}).
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.String("session").
+			Comment("Fingerprint of the access token the confirmation was issued to").
+			MaxLen(32).
+			Sensitive().
+			Immutable(),
+		field.Time("expires_at").
+			Comment("Confirmations are short-lived").
+			Immutable(),
+		field.Time("used_at").
+			Comment("Set when the confirmation is spent; each works once").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Confirmation.
+func (Confirmation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the Confirmation.
+func (Confirmation) Indexes() []ent.Index {
+	return []ent.Index{
+		// Expired confirmations are purged by age
+		index.Fields("expires_at"),
+	}
+}
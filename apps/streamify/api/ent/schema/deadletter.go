@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeadLetter holds the schema definition for the DeadLetter entity.
+// It keeps background work that failed so an admin can inspect and replay it.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields of the DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.Enum("kind").
+			Comment("What failed to be delivered").
+			Values("job", "event", "mail").
+			Immutable(),
+		field.String("name").
+			Comment("Job name, event type or mail subject").
+			Annotations(Doc{Example: "playlist.updated"}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}).
+			Immutable(),
+		field.String("summary").
+			Comment("Human-readable description of the item, safe to show admins").
+			Optional(),
+		field.JSON("payload", json.RawMessage{}).
+			Comment("What replay needs; may hold message bodies with links, so never serialized").
+			Sensitive().
+			Optional(),
+		field.Text("error").
+			Comment("The last failure"),
+		field.Int("attempts").
+			Comment("How many times delivery was tried").
+			Annotations(Doc{Rules: []string{"min=1"}}).
+			Default(1).
+			Positive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_failed_at").
+			Comment("When delivery last failed").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "last_failed_at"),
+		// Repeated failures of the same job are folded into one item
+		index.Fields("kind", "name"),
+	}
+}
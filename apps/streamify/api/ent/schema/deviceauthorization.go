@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeviceAuthorization holds the schema definition for the DeviceAuthorization entity.
+// A device that can't take a password, such as a TV, gets one to show its user
+// a short code; it polls until the user approves the code from a signed-in
+// phone or browser.
+type DeviceAuthorization struct {
+	ent.Schema
+}
+
+// Fields of the DeviceAuthorization.
+func (DeviceAuthorization) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("device_code_hash").
+			Comment("SHA-256 of the device code the device polls with; the code itself is never stored").
+			MaxLen(64).
+			SchemaType(map[string]string{
+				"postgres": "char(64)",
+				"mysql":    "char(64)",
+				"sqlite3":  "char(64)",
+			}).
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.String("user_code").
+			Comment("The code the user types in, without its separator").
+			Annotations(Doc{Example: "WDJBMJHT"}).
+			MaxLen(8).
+			Unique().
+			Immutable(),
+		field.Enum("status").
+			Comment("Pending until the user approves or denies the code, redeemed once the device got its tokens").
+			Values("pending", "approved", "denied", "redeemed").
+			Default("pending"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user who approved or denied the code").
+			Optional().
+			Nillable(),
+		field.Time("polled_at").
+			Comment("Polls closer together than the interval are answered with slow_down").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Comment("The code can't be approved or redeemed after this").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the DeviceAuthorization.
+func (DeviceAuthorization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the DeviceAuthorization.
+func (DeviceAuthorization) Indexes() []ent.Index {
+	return []ent.Index{
+		// Expired authorizations are purged by age
+		index.Fields("expires_at"),
+	}
+}
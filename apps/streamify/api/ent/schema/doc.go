@@ -0,0 +1,16 @@
+package schema
+
+// Doc documents a field for the data dictionary beyond its comment: a value
+// to show as an example, and rules for forms to check. Rules read like
+// binding tags: "min=1" or "not_empty" mirror the field's validators, which
+// Ent can't report since they are plain functions, and "format=email" says
+// what a string holds.
+type Doc struct {
+	Example any      `json:"example,omitempty"`
+	Rules   []string `json:"rules,omitempty"`
+}
+
+// Name implements ent's schema.Annotation
+func (Doc) Name() string {
+	return "Doc"
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// DuplicateReview holds the schema definition for the DuplicateReview entity.
+// It queues an upload that sounds like an existing track for an admin to judge.
+type DuplicateReview struct {
+	ent.Schema
+}
+
+// Fields of the DuplicateReview.
+func (DuplicateReview) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The track whose upload was flagged"),
+		field.UUID("match_track_id", uuid.UUID{}).
+			Comment("The existing track it resembles"),
+		field.Float("similarity").
+			Comment("How alike the fingerprints are, from 0 to 1").
+			Annotations(Doc{Example: 0.93, Rules: []string{"min=0", "max=1"}}).
+			Min(0).
+			Max(1),
+		field.Enum("status").
+			Comment("Pending until an admin marks the upload a duplicate or dismisses the flag").
+			Values("pending", "duplicate", "dismissed").
+			Default("pending"),
+		field.UUID("reviewed_by", uuid.UUID{}).
+			Comment("The admin who reviewed the flag").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Comment("When the flag was reviewed").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the DuplicateReview.
+func (DuplicateReview) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Field("track_id"),
+		edge.To("match", Track.Type).
+			Unique().
+			Required().
+			Field("match_track_id"),
+	}
+}
+
+// Indexes of the DuplicateReview.
+func (DuplicateReview) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("track_id", "match_track_id").
+			Unique(),
+	}
+}
+
+// Policy of the DuplicateReview. Only admins change the catalog.
+func (DuplicateReview) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// Entitlement holds the schema definition for the Entitlement entity.
+// It grants a user a paid feature, or premium with every feature, for a while.
+type Entitlement struct {
+	ent.Schema
+}
+
+// Fields of the Entitlement.
+func (Entitlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The entitled user"),
+		field.Enum("feature").
+			Comment("What the entitlement unlocks").
+			Values("premium", "downloads"),
+		field.Enum("source").
+			Comment("Why the entitlement was granted").
+			Values("admin", "promo", "referral").
+			Default("admin"),
+		field.Time("expires_at").
+			Comment("Unset for entitlements that don't expire").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Entitlement.
+func (Entitlement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the Entitlement.
+func (Entitlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "feature"),
+	}
+}
+
+// Policy of the Entitlement. Only admins grant entitlements; promo codes
+// and referral rewards grant them in the system context.
+func (Entitlement) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExternalIdentity holds the schema definition for the ExternalIdentity entity.
+// It links an account at an SSO provider to the local user it signs in as.
+// Accounts provisioned through SCIM are linked before their first sign-in.
+type ExternalIdentity struct {
+	ent.Schema
+}
+
+// Fields of the ExternalIdentity.
+func (ExternalIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("provider_id", uuid.UUID{}).
+			Comment("The SSO provider").
+			Immutable(),
+		field.String("subject").
+			Comment("The provider's stable ID for the account, the ID token's sub claim. Unset until an account provisioned through SCIM first signs in.").
+			Annotations(Doc{Rules: []string{"not_empty"}}).
+			NotEmpty().
+			Optional().
+			Nillable(),
+		field.String("external_id").
+			Comment("The provider's ID for an account provisioned through SCIM").
+			Optional().
+			Nillable(),
+		field.Bool("provisioned").
+			Comment("Managed by the provider through SCIM").
+			Default(false),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The local account").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_login_at").
+			Comment("When the user last signed in through the provider").
+			Default(time.Now),
+	}
+}
+
+// Edges of the ExternalIdentity.
+func (ExternalIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("provider", SSOProvider.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("provider_id"),
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the ExternalIdentity.
+func (ExternalIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_id", "subject").
+			Unique(),
+		index.Fields("provider_id", "user_id"),
+	}
+}
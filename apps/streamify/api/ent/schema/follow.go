@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Follow holds the schema definition for the Follow entity.
+type Follow struct {
+	ent.Schema
+}
+
+// Fields of the Follow.
+func (Follow) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("follower_id", uuid.UUID{}).
+			Comment("The user following"),
+		field.UUID("followee_id", uuid.UUID{}).
+			Comment("The user followed"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Follow.
+func (Follow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("follower", User.Type).
+			Unique().
+			Required().
+			Field("follower_id"),
+		edge.To("followee", User.Type).
+			Unique().
+			Required().
+			Field("followee_id"),
+	}
+}
+
+// Indexes of the Follow.
+func (Follow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("follower_id", "followee_id").
+			Unique(),
+		// Followers of a given user
+		index.Fields("followee_id"),
+	}
+}
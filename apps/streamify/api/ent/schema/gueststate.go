@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GuestState holds the schema definition for the GuestState entity.
+// It stores state a guest session synced from the client until it is
+// claimed by a new account or expires.
+type GuestState struct {
+	ent.Schema
+}
+
+// Fields of the GuestState.
+func (GuestState) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Comment("The guest_id from the guest token").
+			Immutable(),
+		field.JSON("queue", []uuid.UUID{}).
+			Comment("Ordered track IDs of the guest's play queue").
+			Optional(),
+		field.JSON("likes", []uuid.UUID{}).
+			Comment("IDs of the tracks the guest liked, moved to the account on sign-up").
+			Optional(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("expires_at").
+			Comment("When the guest token expires and the state can be deleted"),
+	}
+}
+
+// Indexes of the GuestState.
+func (GuestState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
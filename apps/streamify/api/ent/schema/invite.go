@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invite holds the schema definition for the Invite entity.
+// An invite code lets its holder register while signups are invite only.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("code").
+			Comment("Code to sign up with").
+			Annotations(Doc{Example: "JAZZ-4F2K"}).
+			MaxLen(16).
+			SchemaType(map[string]string{
+				"postgres": "varchar(16)",
+				"mysql":    "varchar(16)",
+				"sqlite3":  "varchar(16)",
+			}).
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Comment("Admin codes are minted in bulk, referral codes by users for friends and waitlist codes when a waitlist entry is released").
+			Values("admin", "referral", "waitlist").
+			Immutable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("The user who minted the code").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("email").
+			Comment("When set, only this email can redeem the code").
+			Annotations(Doc{Example: "ada@example.com", Rules: []string{"format=email"}}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("max_uses").
+			Comment("How many sign-ups the code allows").
+			Annotations(Doc{Example: 1, Rules: []string{"min=1"}}).
+			Default(1).
+			Positive(),
+		field.Int("uses").
+			Comment("How many sign-ups redeemed it").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Time("expires_at").
+			Comment("Unset for codes that don't expire").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Invite.
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("creator", User.Type).
+			Unique().
+			Immutable().
+			Field("created_by"),
+	}
+}
+
+// Indexes of the Invite.
+func (Invite) Indexes() []ent.Index {
+	return []ent.Index{
+		// Referral codes are listed and counted per user
+		index.Fields("created_by", "kind"),
+	}
+}
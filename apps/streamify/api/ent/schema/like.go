@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// Like holds the schema definition for the Like entity.
+type Like struct {
+	ent.Schema
+}
+
+// Fields of the Like.
+func (Like) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user who liked the track"),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The liked track"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Like.
+func (Like) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Field("track_id"),
+	}
+}
+
+// Indexes of the Like.
+func (Like) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "track_id").
+			Unique(),
+	}
+}
+
+// Policy of the Like. Users can only like and unlike on their own behalf.
+func (Like) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfLikeOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
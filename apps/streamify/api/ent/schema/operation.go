@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Operation holds the schema definition for the Operation entity.
+// It tracks a long-running task started by a request, so the caller can poll
+// its progress and results, or cancel it, from any instance.
+type Operation struct {
+	ent.Schema
+}
+
+// Fields of the Operation.
+func (Operation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("kind").
+			Comment("What the operation does").
+			Annotations(Doc{Example: "albums.bulk_delete"}).
+			MaxLen(64).
+			Immutable(),
+		field.Enum("status").
+			Comment("Running until the operation ends").
+			Values("running", "succeeded", "failed", "cancelled").
+			Default("running"),
+		field.UUID("owner_id", uuid.UUID{}).
+			Comment("The user who started the operation").
+			Immutable(),
+		field.Int("done").
+			Comment("Units of work done out of total").
+			Default(0),
+		field.Int("total").
+			Comment("Units of work in all; 0 while unknown").
+			Default(0),
+		field.JSON("result", json.RawMessage{}).
+			Comment("Results so far, and the final results once the operation ends").
+			Optional(),
+		field.Text("error").
+			Comment("Why the operation failed").
+			Optional(),
+		field.Bool("cancel_requested").
+			Comment("Set by a cancel request; the instance running the operation stops it at the next batch boundary").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("Refreshed by the running instance as a heartbeat").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("finished_at").
+			Comment("When the operation ended").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Operation.
+func (Operation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "created_at"),
+		index.Fields("status", "updated_at"),
+	}
+}
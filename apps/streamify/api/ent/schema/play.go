@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Play holds the schema definition for the Play entity.
+type Play struct {
+	ent.Schema
+}
+
+// Fields of the Play.
+func (Play) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The listener"),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The track played"),
+		field.String("territory").
+			Comment("Country the play happened in, as an ISO 3166-1 alpha-2 code").
+			Annotations(Doc{Example: "SE", Rules: []string{"format=country"}}).
+			MaxLen(2).
+			SchemaType(map[string]string{
+				"postgres": "varchar(2)",
+				"mysql":    "varchar(2)",
+				"sqlite3":  "varchar(2)",
+			}).
+			Optional(),
+		field.Time("played_at").
+			Comment("When playback started").
+			Default(time.Now),
+		field.Int("progress_ms").
+			Comment("How far into the track playback got, from the client's heartbeats").
+			Annotations(Doc{Example: 183000}).
+			Default(0),
+		field.Time("progress_at").
+			Comment("When the last heartbeat was received").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Play.
+func (Play) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Field("track_id"),
+	}
+}
+
+// Indexes of the Play.
+func (Play) Indexes() []ent.Index {
+	return []ent.Index{
+		// Listening history per user ordered by time
+		index.Fields("user_id", "played_at"),
+	}
+}
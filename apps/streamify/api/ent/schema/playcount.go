@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PlayCount holds the schema definition for the PlayCount entity.
+// Each row counts one user's plays of one track during one UTC day, for
+// their personal top lists. Rows are added to by the playcounts package as
+// plays are written, never edited through Ent.
+type PlayCount struct {
+	ent.Schema
+}
+
+// Fields of the PlayCount.
+func (PlayCount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The listener").
+			Immutable(),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The track played").
+			Immutable(),
+		field.Time("day").
+			Comment("The UTC day the plays started on").
+			SchemaType(map[string]string{dialect.Postgres: "date"}).
+			Immutable(),
+		field.Int64("plays").
+			Comment("Plays of the track by the user that day").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+	}
+}
+
+// Edges of the PlayCount.
+func (PlayCount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("track_id"),
+	}
+}
+
+// Indexes of the PlayCount.
+func (PlayCount) Indexes() []ent.Index {
+	return []ent.Index{
+		// Top lists sum a user's days within a period
+		index.Fields("user_id", "day", "track_id").
+			Unique(),
+	}
+}
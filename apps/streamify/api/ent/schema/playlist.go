@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// Playlist holds the schema definition for the Playlist entity.
+type Playlist struct {
+	ent.Schema
+}
+
+// Fields of the Playlist.
+func (Playlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("owner_id", uuid.UUID{}).
+			Comment("The user who owns the playlist"),
+		field.String("name").
+			Comment("Name shown to listeners").
+			Annotations(Doc{Example: "Late night jazz"}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}),
+		field.Bool("public").
+			Comment("Public playlists can be seen by anyone the owner's privacy settings allow").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.JSON("track_order", []uuid.UUID{}).
+			Comment("Ordered IDs of the playlist's tracks; tracks missing from it sort last").
+			Optional(),
+		field.JSON("clock", map[string]time.Time{}).
+			Comment("When the name, the public flag and each track's membership last changed, for last-writer-wins sync merges").
+			Optional(),
+	}
+}
+
+// Edges of the Playlist.
+func (Playlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).
+			Unique().
+			Required().
+			Field("owner_id"),
+		edge.To("tracks", Track.Type),
+	}
+}
+
+// Indexes of the Playlist.
+func (Playlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "created_at"),
+	}
+}
+
+// Policy of the Playlist. Only the owner can change a playlist or its tracks.
+func (Playlist) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfPlaylistOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
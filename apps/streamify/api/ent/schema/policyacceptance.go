@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PolicyAcceptance holds the schema definition for the PolicyAcceptance entity.
+// It records that a user accepted one policy version, and when.
+type PolicyAcceptance struct {
+	ent.Schema
+}
+
+// Fields of the PolicyAcceptance.
+func (PolicyAcceptance) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user who accepted").
+			Immutable(),
+		field.UUID("policy_version_id", uuid.UUID{}).
+			Comment("The version accepted").
+			Immutable(),
+		field.String("ip").
+			Comment("Client address the acceptance came from").
+			Annotations(Doc{Example: "203.0.113.7", Rules: []string{"format=ip"}}).
+			Optional().
+			Immutable(),
+		field.Time("accepted_at").
+			Comment("When the version was accepted").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PolicyAcceptance.
+func (PolicyAcceptance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.To("policy_version", PolicyVersion.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("policy_version_id"),
+	}
+}
+
+// Indexes of the PolicyAcceptance.
+func (PolicyAcceptance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "policy_version_id").
+			Unique(),
+		index.Fields("policy_version_id"),
+	}
+}
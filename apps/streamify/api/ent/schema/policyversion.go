@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PolicyVersion holds the schema definition for the PolicyVersion entity.
+// The latest published version of each kind is the one users must accept.
+type PolicyVersion struct {
+	ent.Schema
+}
+
+// Fields of the PolicyVersion.
+func (PolicyVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.Enum("kind").
+			Comment("Which policy the version is of").
+			Values("terms", "privacy").
+			Immutable(),
+		field.String("version").
+			Comment("Label shown to users").
+			Annotations(Doc{Example: "2026-10-01"}).
+			MaxLen(64).
+			SchemaType(map[string]string{
+				"postgres": "varchar(64)",
+				"mysql":    "varchar(64)",
+				"sqlite3":  "varchar(64)",
+			}).
+			Immutable(),
+		field.String("url").
+			Comment("Where the full text is published").
+			Annotations(Doc{Example: "https://streamify.example.com/legal/terms", Rules: []string{"format=url"}}).
+			Optional().
+			Immutable(),
+		field.Text("summary").
+			Comment("What changed since the previous version").
+			Optional().
+			Immutable(),
+		field.UUID("published_by", uuid.UUID{}).
+			Comment("Kept out of responses since every user sees current versions; the audit log has it").
+			StructTag(`json:"-"`).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("published_at").
+			Comment("When the version took effect").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the PolicyVersion.
+func (PolicyVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "version").
+			Unique(),
+		index.Fields("kind", "published_at"),
+	}
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PromoCode holds the schema definition for the PromoCode entity.
+// A promo code is minted by an admin and redeemed by users for a discount,
+// free months of premium or a single feature.
+type PromoCode struct {
+	ent.Schema
+}
+
+// Fields of the PromoCode.
+func (PromoCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("code").
+			Comment("Code to redeem, upper case").
+			Annotations(Doc{Example: "SUMMER25"}).
+			MaxLen(32).
+			SchemaType(map[string]string{
+				"postgres": "varchar(32)",
+				"mysql":    "varchar(32)",
+				"sqlite3":  "varchar(32)",
+			}).
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Comment("percent_off discounts the subscription, free_months grants premium and feature_unlock grants a single feature").
+			Values("percent_off", "free_months", "feature_unlock").
+			Immutable(),
+		field.Int("percent_off").
+			Comment("Discount in percent, for percent_off codes").
+			Annotations(Doc{Example: 25, Rules: []string{"min=1", "max=100"}}).
+			Range(1, 100).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("months").
+			Comment("How many months the discount, premium or feature lasts; unset for a feature unlocked for good or a discount that doesn't end").
+			Annotations(Doc{Example: 3, Rules: []string{"min=1"}}).
+			Positive().
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Enum("feature").
+			Comment("The feature unlocked, for feature_unlock codes").
+			Values("premium", "downloads").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("max_redemptions").
+			Comment("How many redemptions the code allows in all; unset for no limit").
+			Annotations(Doc{Example: 1000, Rules: []string{"min=1"}}).
+			Positive().
+			Optional().
+			Nillable(),
+		field.Int("per_user_limit").
+			Comment("How many times each user may redeem the code").
+			Annotations(Doc{Example: 1, Rules: []string{"min=1"}}).
+			Default(1).
+			Positive(),
+		field.Int("redemptions").
+			Comment("How many times the code was redeemed").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("The admin who minted the code").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("expires_at").
+			Comment("Unset for codes that don't expire").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PromoCode.
+func (PromoCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("creator", User.Type).
+			Unique().
+			Immutable().
+			Field("created_by"),
+		edge.From("redeemed", PromoRedemption.Type).
+			Ref("code"),
+	}
+}
+
+// Policy of the PromoCode. Only admins mint and end codes; redeeming counts
+// the redemption in the system context.
+func (PromoCode) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
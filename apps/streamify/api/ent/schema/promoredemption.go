@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PromoRedemption holds the schema definition for the PromoRedemption entity.
+// Each row records one user redeeming one promo code and what it gave them:
+// the entitlement granted, or the discount for billing to apply. Rows are
+// kept as an audit trail and never changed.
+type PromoRedemption struct {
+	ent.Schema
+}
+
+// Fields of the PromoRedemption.
+func (PromoRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("code_id", uuid.UUID{}).
+			Comment("The promo code redeemed").
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user who redeemed it").
+			Immutable(),
+		field.UUID("entitlement_id", uuid.UUID{}).
+			Comment("The entitlement granted, for free_months and feature_unlock codes").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("percent_off").
+			Comment("The discount owed, for percent_off codes").
+			Annotations(Doc{Example: 25, Rules: []string{"min=1", "max=100"}}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("discount_ends_at").
+			Comment("When the discount stops applying; unset for a discount that doesn't end").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PromoRedemption.
+func (PromoRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("code", PromoCode.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("code_id"),
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.To("entitlement", Entitlement.Type).
+			Unique().
+			Immutable().
+			Field("entitlement_id"),
+	}
+}
+
+// Indexes of the PromoRedemption.
+func (PromoRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		// Redeeming counts the user's earlier redemptions of the code
+		index.Fields("code_id", "user_id"),
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// Policy of the PromoRedemption. Redemptions are only written by the promos
+// package in the system context.
+func (PromoRedemption) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Referral holds the schema definition for the Referral entity.
+// Each row records a signup with a user's referral code and whether the
+// referrer was rewarded for it. Rows are written by the referrals package
+// when the referred user registers, never edited through Ent.
+type Referral struct {
+	ent.Schema
+}
+
+// Fields of the Referral.
+func (Referral) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("referrer_id", uuid.UUID{}).
+			Comment("The user whose code was used").
+			Immutable(),
+		field.UUID("referred_id", uuid.UUID{}).
+			Comment("The user who signed up").
+			Unique().
+			Immutable(),
+		field.UUID("invite_id", uuid.UUID{}).
+			Comment("The referral code signed up with").
+			Immutable(),
+		field.Enum("status").
+			Comment("rewarded once the referrer got their reward, rejected when the signup looked like the referrer referring themselves").
+			Values("rewarded", "rejected").
+			Immutable(),
+		field.Enum("reason").
+			Comment("Why a rejected referral was rejected: the referred email is an alias of the referrer's, or the signup came from an address the referrer's other signups did").
+			Values("same_email", "shared_ip").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("reward_days").
+			Comment("Free premium days the referrer got; 0 when rejected or the referrer has premium for good").
+			Annotations(Doc{Example: 30, Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative().
+			Immutable(),
+		field.UUID("entitlement_id", uuid.UUID{}).
+			Comment("The referrer's reward").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("signup_ip").
+			Comment("The client IP the referred user signed up from, compared with the referrer's other referrals").
+			MaxLen(45).
+			Optional().
+			Sensitive().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Referral.
+func (Referral) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("referrer", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("referrer_id"),
+		edge.To("referred", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("referred_id"),
+		edge.To("invite", Invite.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("invite_id"),
+		edge.To("entitlement", Entitlement.Type).
+			Unique().
+			Immutable().
+			Field("entitlement_id"),
+	}
+}
+
+// Indexes of the Referral.
+func (Referral) Indexes() []ent.Index {
+	return []ent.Index{
+		// Referrals are listed per referrer and checked against their signup IPs
+		index.Fields("referrer_id", "created_at"),
+		index.Fields("referrer_id", "signup_ip"),
+	}
+}
+
+// Policy of the Referral. Referrals are only written by the referrals
+// package in the system context.
+func (Referral) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,113 @@
+// Package rule holds the privacy rules the Ent schemas enforce on every
+// mutation, evaluated against the viewer in the context.
+package rule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/like"
+	"streamify/ent/playlist"
+	"streamify/ent/privacy"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/viewer"
+)
+
+// SystemContext returns a copy of ctx that skips every privacy rule, for work
+// done by the service itself (seeding, jobs, CLI commands) rather than a user
+func SystemContext(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
+
+// DenyIfNoViewer denies mutations made without a user viewer in the context
+func DenyIfNoViewer() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if v := viewer.FromContext(ctx); v == nil || v.UserID == uuid.Nil {
+			return privacy.Denyf("viewer is missing from context")
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfAdmin allows mutations made by an admin
+func AllowIfAdmin() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if viewer.FromContext(ctx).IsAdmin() {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// ownedBy decides a mutation of rows owned by the viewer. Creates must name
+// the viewer as owner, no mutation may hand a row to someone else, and updates
+// and deletes are narrowed by where to the viewer's rows, so other rows are
+// left untouched (and UpdateOne/DeleteOne report not found).
+func ownedBy(ctx context.Context, op ent.Op, owner func() (uuid.UUID, bool), where func(uuid.UUID)) error {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return privacy.Skip
+	}
+	id, set := owner()
+	if set && id != v.UserID {
+		return privacy.Denyf("rows can only be owned by the viewer")
+	}
+	if op.Is(ent.OpCreate) {
+		if !set {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	}
+	where(v.UserID)
+	return privacy.Allow
+}
+
+// AllowIfPlaylistOwner allows mutations of the viewer's own playlists
+func AllowIfPlaylistOwner() privacy.MutationRule {
+	return privacy.PlaylistMutationRuleFunc(func(ctx context.Context, m *ent.PlaylistMutation) error {
+		return ownedBy(ctx, m.Op(), m.OwnerID, func(id uuid.UUID) {
+			m.Where(playlist.OwnerIDEQ(id))
+		})
+	})
+}
+
+// AllowIfLikeOwner allows mutations of the viewer's own likes
+func AllowIfLikeOwner() privacy.MutationRule {
+	return privacy.LikeMutationRuleFunc(func(ctx context.Context, m *ent.LikeMutation) error {
+		return ownedBy(ctx, m.Op(), m.UserID, func(id uuid.UUID) {
+			m.Where(like.UserIDEQ(id))
+		})
+	})
+}
+
+// AllowIfSupportTicketOwner allows mutations of the viewer's own support tickets
+func AllowIfSupportTicketOwner() privacy.MutationRule {
+	return privacy.SupportTicketMutationRuleFunc(func(ctx context.Context, m *ent.SupportTicketMutation) error {
+		return ownedBy(ctx, m.Op(), m.UserID, func(id uuid.UUID) {
+			m.Where(supportticket.UserIDEQ(id))
+		})
+	})
+}
+
+// AllowIfSupportReplyAuthor allows mutations of the viewer's own support replies
+func AllowIfSupportReplyAuthor() privacy.MutationRule {
+	return privacy.SupportReplyMutationRuleFunc(func(ctx context.Context, m *ent.SupportReplyMutation) error {
+		return ownedBy(ctx, m.Op(), m.AuthorID, func(id uuid.UUID) {
+			m.Where(supportreply.AuthorIDEQ(id))
+		})
+	})
+}
+
+// AllowIfSupportAttachmentUploader allows mutations of the files the viewer
+// added to support tickets
+func AllowIfSupportAttachmentUploader() privacy.MutationRule {
+	return privacy.SupportAttachmentMutationRuleFunc(func(ctx context.Context, m *ent.SupportAttachmentMutation) error {
+		return ownedBy(ctx, m.Op(), m.UploadedBy, func(id uuid.UUID) {
+			m.Where(supportattachment.UploadedByEQ(id))
+		})
+	})
+}
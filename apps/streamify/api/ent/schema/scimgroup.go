@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SCIMGroup holds the schema definition for the SCIMGroup entity.
+// It is a group an SSO provider pushed through SCIM; its display name is
+// what the provider's group_roles maps to a role.
+type SCIMGroup struct {
+	ent.Schema
+}
+
+// Fields of the SCIMGroup.
+func (SCIMGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("provider_id", uuid.UUID{}).
+			Comment("The SSO provider the group was pushed by").
+			Immutable(),
+		field.String("display_name").
+			Comment("Name of the group at the provider").
+			Annotations(Doc{Example: "streamify-admins", Rules: []string{"not_empty"}}).
+			NotEmpty().
+			MaxLen(255),
+		field.String("external_id").
+			Comment("The provider's ID for the group").
+			Optional().
+			Nillable(),
+		field.JSON("members", []uuid.UUID{}).
+			Comment("IDs of the local users in the group").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SCIMGroup.
+func (SCIMGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("provider", SSOProvider.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("provider_id"),
+	}
+}
+
+// Indexes of the SCIMGroup.
+func (SCIMGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_id", "display_name").
+			Unique(),
+	}
+}
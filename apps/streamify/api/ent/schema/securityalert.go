@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SecurityAlert holds the schema definition for the SecurityAlert entity.
+// An alert is raised when one of the anomaly rules flags a user's activity,
+// such as a sign-in from a new country, and stays open until an admin
+// resolves it.
+type SecurityAlert struct {
+	ent.Schema
+}
+
+// Fields of the SecurityAlert.
+func (SecurityAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user whose account the alert is about").
+			Immutable(),
+		field.String("rule").
+			Comment("The name of the rule that raised the alert").
+			Annotations(Doc{Example: "new_country", Rules: []string{"not_empty"}}).
+			MaxLen(64).
+			NotEmpty().
+			Immutable(),
+		field.Enum("severity").
+			Comment("How urgently the alert needs looking at").
+			Values("low", "medium", "high").
+			Immutable(),
+		field.JSON("details", map[string]string{}).
+			Comment("What the rule saw, e.g. the new country and the ones seen before").
+			Optional().
+			Immutable(),
+		field.String("ip").
+			Comment("Client address that triggered the rule").
+			Annotations(Doc{Example: "203.0.113.7", Rules: []string{"format=ip"}}).
+			Optional().
+			Immutable(),
+		field.String("country").
+			Comment("Country of that address, as an ISO 3166-1 alpha-2 code").
+			Annotations(Doc{Example: "SE", Rules: []string{"format=country"}}).
+			MaxLen(2).
+			Optional().
+			Immutable(),
+		field.Bool("reauth_forced").
+			Comment("Set when the user's sessions were ended so they must sign in again").
+			Default(false).
+			Immutable(),
+		field.Time("resolved_at").
+			Comment("When an admin resolved the alert").
+			Optional().
+			Nillable(),
+		field.UUID("resolved_by", uuid.UUID{}).
+			Comment("The admin who resolved the alert").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SecurityAlert.
+func (SecurityAlert) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the SecurityAlert.
+func (SecurityAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShareLink holds the schema definition for the ShareLink entity.
+type ShareLink struct {
+	ent.Schema
+}
+
+// Fields of the ShareLink.
+func (ShareLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("token").
+			Comment("Short token in the shared URL").
+			Annotations(Doc{Example: "k3Jd9sQ2"}).
+			MaxLen(16).
+			SchemaType(map[string]string{
+				"postgres": "varchar(16)",
+				"mysql":    "varchar(16)",
+				"sqlite3":  "varchar(16)",
+			}).
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Comment("What the link points at").
+			Values("track", "album", "playlist").
+			Immutable(),
+		field.UUID("target_id", uuid.UUID{}).
+			Comment("ID of the shared track, album or playlist").
+			Immutable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Comment("The user who shared it").
+			Immutable(),
+		field.Int("visits").
+			Comment("How many times the link was opened").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			Default(0).
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ShareLink.
+func (ShareLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("creator", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("created_by"),
+	}
+}
+
+// Indexes of the ShareLink.
+func (ShareLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// Reuse an existing link when the same user shares the same item again
+		index.Fields("created_by", "kind", "target_id"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Comment("Tokens name the key that signed them by this ID in their kid header").
+			Default(ids.New).
+			Unique(),
+		field.String("secret").
+			Comment("HMAC secret tokens are signed with").
+			Annotations(Doc{Rules: []string{"not_empty"}}).
+			Sensitive().
+			NotEmpty().
+			Immutable(),
+		field.Bool("from_env").
+			Comment("Keys seeded from JWT_SECRET or JWT_PREVIOUS_SECRETS, as opposed to generated through the admin API").
+			Default(false).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("retires_at").
+			Comment("A retiring key signs nothing and verifies tokens until then").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
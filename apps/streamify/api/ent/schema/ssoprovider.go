@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ssoSlugPattern keeps tenant slugs safe to put in URLs
+var ssoSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// SSOProvider holds the schema definition for the SSOProvider entity.
+// It is the OpenID Connect provider of one enterprise tenant, such as a
+// label, whose members sign in through it instead of with a password.
+type SSOProvider struct {
+	ent.Schema
+}
+
+// Fields of the SSOProvider.
+func (SSOProvider) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("slug").
+			Comment("Names the tenant in sign-in URLs, as in /api/auth/sso/:slug/login").
+			Annotations(Doc{Example: "acme", Rules: []string{"pattern=^[a-z0-9]+(-[a-z0-9]+)*$"}}).
+			MaxLen(64).
+			Match(ssoSlugPattern).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Comment("Name shown on the sign-in page").
+			Annotations(Doc{Example: "Acme Corp", Rules: []string{"not_empty"}}).
+			NotEmpty().
+			MaxLen(255),
+		field.String("issuer").
+			Comment("The provider's issuer URL, where its discovery document is served").
+			Annotations(Doc{Example: "https://acme.okta.com", Rules: []string{"not_empty", "format=url"}}).
+			NotEmpty(),
+		field.String("client_id").
+			Comment("Client ID registered with the provider").
+			Annotations(Doc{Example: "0oa1b2c3d4", Rules: []string{"not_empty"}}).
+			NotEmpty(),
+		field.String("client_secret").
+			Comment("Client secret registered with the provider").
+			Annotations(Doc{Rules: []string{"not_empty"}}).
+			Sensitive().
+			NotEmpty(),
+		field.String("groups_claim").
+			Comment("ID token claim holding the user's groups").
+			Default("groups"),
+		field.JSON("group_roles", map[string]string{}).
+			Comment("Maps provider groups to roles. When set, roles are synced at every sign-in; users in no mapped group get the user role.").
+			Annotations(Doc{Example: map[string]string{"streamify-admins": "admin"}}).
+			Optional(),
+		field.JSON("email_domains", []string{}).
+			Comment("Only emails in these domains may sign in; any when empty. Existing local accounts are only linked by email for listed domains.").
+			Annotations(Doc{Example: []string{"acme.com"}}).
+			Optional(),
+		field.String("scim_token_hash").
+			Comment("SHA-256 of the bearer token the provider's SCIM client authenticates with").
+			MaxLen(64).
+			Unique().
+			Sensitive().
+			Optional().
+			Nillable(),
+		field.String("region").
+			Comment("The region the tenant's users are pinned to when their accounts are created; empty keeps them in the home region").
+			Annotations(Doc{Example: "eu"}).
+			MaxLen(32).
+			Optional().
+			Nillable(),
+		field.Bool("provisioning").
+			Comment("Creates accounts for people signing in for the first time").
+			Default(true),
+		field.Bool("enabled").
+			Comment("Disabled providers refuse sign-ins and SCIM requests").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SSOProvider.
+func (SSOProvider) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("identities", ExternalIdentity.Type).
+			Ref("provider"),
+		edge.From("groups", SCIMGroup.Type).
+			Ref("provider"),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SupportAttachment holds the schema definition for the SupportAttachment entity.
+// An attachment is a file added to a support ticket, such as a screenshot;
+// its contents are kept in object storage.
+type SupportAttachment struct {
+	ent.Schema
+}
+
+// Fields of the SupportAttachment.
+func (SupportAttachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("ticket_id", uuid.UUID{}).
+			Comment("The ticket the file belongs to").
+			Immutable(),
+		field.UUID("uploaded_by", uuid.UUID{}).
+			Comment("Who added the file").
+			Immutable(),
+		field.String("filename").
+			Comment("The file's name as uploaded").
+			Annotations(Doc{Example: "screenshot.png"}).
+			MaxLen(255).
+			NotEmpty().
+			Immutable(),
+		field.String("content_type").
+			Comment("The file's type, detected from its contents").
+			Annotations(Doc{Example: "image/png"}).
+			Immutable(),
+		field.Int64("size").
+			Comment("Size in bytes").
+			NonNegative().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SupportAttachment.
+func (SupportAttachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ticket", SupportTicket.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("ticket_id"),
+		edge.To("uploader", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("uploaded_by"),
+	}
+}
+
+// Policy of the SupportAttachment. Users add files as themselves; admins
+// may add files to any ticket.
+func (SupportAttachment) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowIfSupportAttachmentUploader(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
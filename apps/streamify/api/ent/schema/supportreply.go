@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SupportReply holds the schema definition for the SupportReply entity.
+// A reply is a message on a support ticket from support or the ticket's user.
+type SupportReply struct {
+	ent.Schema
+}
+
+// Fields of the SupportReply.
+func (SupportReply) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("ticket_id", uuid.UUID{}).
+			Comment("The ticket replied to").
+			Immutable(),
+		field.UUID("author_id", uuid.UUID{}).
+			Comment("Who wrote the reply").
+			Immutable(),
+		field.Bool("staff").
+			Comment("Set on replies from support rather than the ticket's user").
+			Default(false).
+			Immutable(),
+		field.Text("body").
+			Annotations(Doc{Rules: []string{"min=1", "max=10000"}}).
+			MaxLen(10000).
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SupportReply.
+func (SupportReply) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("ticket", SupportTicket.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("ticket_id"),
+		edge.To("author", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("author_id"),
+	}
+}
+
+// Indexes of the SupportReply.
+func (SupportReply) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticket_id", "created_at"),
+	}
+}
+
+// Policy of the SupportReply. Users write their own replies; admins reply as
+// support.
+func (SupportReply) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowIfSupportReplyAuthor(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
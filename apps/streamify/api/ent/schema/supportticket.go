@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SupportTicket holds the schema definition for the SupportTicket entity.
+// A ticket is a user's request for help, answered by admins with replies.
+type SupportTicket struct {
+	ent.Schema
+}
+
+// Fields of the SupportTicket.
+func (SupportTicket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user asking for help").
+			Immutable(),
+		field.String("subject").
+			Comment("One line summing up the request").
+			Annotations(Doc{Example: "Album won't play offline", Rules: []string{"min=1", "max=200"}}).
+			MaxLen(200).
+			NotEmpty().
+			Immutable(),
+		field.Text("body").
+			Comment("The request in the user's words").
+			Annotations(Doc{Rules: []string{"min=1", "max=10000"}}).
+			MaxLen(10000).
+			NotEmpty().
+			Immutable(),
+		field.Enum("category").
+			Comment("What the request is about, for routing it to the right people").
+			Values("account", "billing", "playback", "catalog", "bug", "other"),
+		field.Enum("status").
+			Comment("open while support owes an answer, pending while the user does, resolved once answered and closed when no more replies are taken; a reply from the user reopens a resolved ticket").
+			Values("open", "pending", "resolved", "closed").
+			Default("open"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SupportTicket.
+func (SupportTicket) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.From("replies", SupportReply.Type).
+			Ref("ticket"),
+		edge.From("attachments", SupportAttachment.Type).
+			Ref("ticket"),
+	}
+}
+
+// Indexes of the SupportTicket.
+func (SupportTicket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		// Admins work through tickets by status, least recently touched first
+		index.Fields("status", "updated_at"),
+	}
+}
+
+// Policy of the SupportTicket. Users open and update their own tickets;
+// admins handle everyone's.
+func (SupportTicket) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			rule.AllowIfSupportTicketOwner(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Tombstone records that an entity was deleted, so clients syncing changes
+// and caches holding copies can drop it. Tombstones reference entities by ID
+// only and are kept for the sync retention window.
+type Tombstone struct {
+	ent.Schema
+}
+
+// Fields of the Tombstone.
+func (Tombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.Enum("entity_type").
+			Comment("What kind of entity was deleted").
+			Values("artist", "album", "track", "like", "user"),
+		field.UUID("entity_id", uuid.UUID{}).
+			Comment("The deleted entity's ID; for likes it is the track's"),
+		field.UUID("owner_id", uuid.UUID{}).
+			Comment("The user a deleted entity belonged to, who alone sees its tombstone; catalog tombstones have none and are seen by everyone").
+			Optional().
+			Nillable(),
+		field.Time("deleted_at").
+			Comment("When the entity was deleted").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Tombstone.
+func (Tombstone) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Tombstone.
+func (Tombstone) Indexes() []ent.Index {
+	return []ent.Index{
+		// Sync feed pages and retention sweeps
+		index.Fields("deleted_at", "id"),
+		index.Fields("owner_id", "deleted_at"),
+	}
+}
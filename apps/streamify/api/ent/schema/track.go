@@ -6,7 +6,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
 )
 
 // Track holds the schema definition for the Track entity.
@@ -18,20 +23,55 @@ type Track struct {
 func (Track) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(ids.New).
 			Unique(),
 		field.String("title").
+			Comment("Title of the recording").
+			Annotations(Doc{Example: "Sinnerman"}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
 				"mysql":    "varchar(255)",
 				"sqlite3":  "varchar(255)",
 			}),
-		field.UUID("album_id", uuid.UUID{}),
+		field.UUID("album_id", uuid.UUID{}).
+			Comment("The album the track is on"),
+		field.Int("track_number").
+			Comment("Position on its disc, from 1; 0 or unset when unknown").
+			Annotations(Doc{Example: 5, Rules: []string{"min=0"}}).
+			Optional().
+			NonNegative(),
+		field.Int("disc_number").
+			Comment("Disc of a multi-disc album the track is on").
+			Annotations(Doc{Example: 1, Rules: []string{"min=1"}}).
+			Default(1).
+			Positive(),
 		field.String("url").
+			Comment("Where the audio is streamed from when it isn't uploaded").
+			Annotations(Doc{Example: "https://cdn.example.com/tracks/sinnerman.mp3", Rules: []string{"format=url"}}).
+			Optional(),
+		field.String("audio_key").
+			Comment("Storage key of the uploaded audio file").
 			Optional(),
+		field.Int64("duration_ms").
+			Comment("Length of the audio in milliseconds, read from the uploaded file; unset when unknown").
+			Annotations(Doc{Example: 215000, Rules: []string{"min=0"}}).
+			NonNegative().
+			Optional().
+			Nillable(),
+		field.UUID("canonical_track_id", uuid.UUID{}).
+			Comment("Links a remaster, live version or remix to the original recording").
+			Optional().
+			Nillable(),
+		field.Enum("version_type").
+			Comment("How the recording relates to its canonical track").
+			Values("original", "remaster", "live", "remix", "acoustic", "edit").
+			Default("original"),
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -42,6 +82,33 @@ func (Track) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("album_id"),
+		edge.From("plays", Play.Type).
+			Ref("track"),
+		edge.From("playlists", Playlist.Type).
+			Ref("tracks"),
+		edge.To("versions", Track.Type).
+			From("canonical").
+			Unique().
+			Field("canonical_track_id"),
+	}
+}
+
+// Indexes of the Track.
+func (Track) Indexes() []ent.Index {
+	return []ent.Index{
+		// Album tracklists ordered by disc and track number
+		index.Fields("album_id", "disc_number", "track_number"),
+		index.Fields("canonical_track_id"),
 	}
 }
 
+// Policy of the Track. Only admins change the catalog.
+func (Track) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
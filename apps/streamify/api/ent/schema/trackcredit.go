@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// TrackCredit holds the schema definition for the TrackCredit entity.
+// It credits an artist other than the album's own on a track.
+type TrackCredit struct {
+	ent.Schema
+}
+
+// Fields of the TrackCredit.
+func (TrackCredit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The credited track"),
+		field.UUID("artist_id", uuid.UUID{}).
+			Comment("The credited artist"),
+		field.Enum("role").
+			Comment("What the artist did on the track").
+			Values("featured", "remixer", "producer", "composer").
+			Default("featured"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TrackCredit.
+func (TrackCredit) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Field("track_id"),
+		edge.To("artist", Artist.Type).
+			Unique().
+			Required().
+			Field("artist_id"),
+	}
+}
+
+// Indexes of the TrackCredit.
+func (TrackCredit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("track_id", "artist_id", "role").
+			Unique(),
+		// Appears-on lookups for an artist's discography
+		index.Fields("artist_id"),
+	}
+}
+
+// Policy of the TrackCredit. Only admins change the catalog.
+func (TrackCredit) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"streamify/ent/privacy"
+	"streamify/ent/schema/rule"
+	"streamify/ids"
+)
+
+// UploadSession holds the schema definition for the UploadSession entity.
+// It tracks a resumable (tus) audio upload whose parts sit in object storage
+// until the last one arrives.
+type UploadSession struct {
+	ent.Schema
+}
+
+// Fields of the UploadSession.
+func (UploadSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("The user uploading").
+			Immutable(),
+		field.UUID("track_id", uuid.UUID{}).
+			Comment("The track the audio is for").
+			Immutable(),
+		field.Int64("length").
+			Comment("Size of the whole file in bytes").
+			Annotations(Doc{Example: 8388608, Rules: []string{"min=1"}}).
+			Positive().
+			Immutable(),
+		field.Int64("offset").
+			Comment("How many bytes have been received").
+			Annotations(Doc{Rules: []string{"min=0"}}).
+			NonNegative().
+			Default(0),
+		field.Enum("status").
+			Comment("Active while parts are being received").
+			Values("active", "completed", "failed").
+			Default("active"),
+		field.String("error").
+			Comment("Why the upload failed").
+			Optional(),
+		field.Time("expires_at").
+			Comment("Moves forward with every part received"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the UploadSession.
+func (UploadSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.To("track", Track.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("track_id"),
+	}
+}
+
+// Indexes of the UploadSession.
+func (UploadSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expires_at"),
+	}
+}
+
+// Policy of the UploadSession. Only admins upload track audio.
+func (UploadSession) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfAdmin(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -2,8 +2,12 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"streamify/ids"
+	"streamify/preferences"
 )
 
 // User holds the schema definition for the User entity.
@@ -15,9 +19,11 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(ids.New).
 			Unique(),
 		field.String("email").
+			Comment("Address the user signs in with and receives mail at").
+			Annotations(Doc{Example: "ada@example.com", Rules: []string{"format=email"}}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -26,6 +32,8 @@ func (User) Fields() []ent.Field {
 			}).
 			Unique(),
 		field.String("first_name").
+			Comment("Given name, shown on the profile").
+			Annotations(Doc{Example: "Ada"}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -34,6 +42,8 @@ func (User) Fields() []ent.Field {
 			}).
 			Optional(),
 		field.String("last_name").
+			Comment("Family name, shown on the profile").
+			Annotations(Doc{Example: "Lovelace"}).
 			MaxLen(255).
 			SchemaType(map[string]string{
 				"postgres": "varchar(255)",
@@ -42,6 +52,7 @@ func (User) Fields() []ent.Field {
 			}).
 			Optional(),
 		field.String("password").
+			Comment("bcrypt hash of the password; unset for accounts that only sign in through SSO").
 			Sensitive().
 			Optional().
 			SchemaType(map[string]string{
@@ -49,10 +60,63 @@ func (User) Fields() []ent.Field {
 				"mysql":    "varchar(255)",
 				"sqlite3":  "varchar(255)",
 			}),
+		field.Enum("role").
+			Comment("Admins manage the catalog, users and settings").
+			Values("user", "admin").
+			Default("user"),
+		field.JSON("preferences", preferences.Preferences{}).
+			Comment("Playback, notification and display settings, merged from PATCH /me/preferences").
+			Sensitive().
+			Optional(),
+		field.Enum("playlists_visibility").
+			Comment("Who can see the user's playlists").
+			Values("public", "private").
+			Default("public"),
+		field.Enum("activity_visibility").
+			Comment("Who can see what the user listens to").
+			Values("public", "private").
+			Default("public"),
+		field.Enum("followers_visibility").
+			Comment("Who can see who follows the user").
+			Values("public", "private").
+			Default("public"),
+		field.Bool("analytics_opt_out").
+			Comment("Plays are reported to analytics under a daily pseudonym instead of the user's ID. Plays reported before opting out are unaffected.").
+			Default(false),
+		field.JSON("queue", []uuid.UUID{}).
+			Comment("Ordered track IDs of the user's play queue").
+			Optional(),
+		field.Time("deactivated_at").
+			Comment("Deactivated users can't sign in or use their tokens and API keys. Set when an identity provider deprovisions the account through SCIM.").
+			Optional().
+			Nillable(),
+		field.JSON("login_countries", []string{}).
+			Comment("Countries the user has signed in from, so a sign-in from another one can be flagged").
+			Optional().
+			Sensitive(),
+		field.Time("sessions_valid_after").
+			Comment("Tokens issued before this are refused, signing the user out everywhere. Set when an anomaly rule forces re-authentication.").
+			Optional().
+			Nillable(),
+		field.String("region").
+			Comment("The region whose database holds the user's data; empty is the home region. Set once, when the user is pinned.").
+			Annotations(Doc{Example: "eu"}).
+			MaxLen(32).
+			Optional().
+			Nillable(),
+		field.Time("changelog_seen_at").
+			Comment("When the user last caught up on the changelog; entries published since are new to them").
+			Optional().
+			Nillable(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("plays", Play.Type).
+			Ref("user"),
+		edge.From("following", Follow.Type).
+			Ref("follower"),
+	}
 }
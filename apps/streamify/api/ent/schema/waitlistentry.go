@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"streamify/ids"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WaitlistEntry holds the schema definition for the WaitlistEntry entity.
+// People without an invite join the waitlist and are released in signup order.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+// Fields of the WaitlistEntry.
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(ids.New).
+			Unique(),
+		field.String("email").
+			Comment("Stored lowercased so an address can only join once").
+			Annotations(Doc{Example: "ada@example.com", Rules: []string{"format=email"}}).
+			MaxLen(255).
+			SchemaType(map[string]string{
+				"postgres": "varchar(255)",
+				"mysql":    "varchar(255)",
+				"sqlite3":  "varchar(255)",
+			}).
+			Unique().
+			Immutable(),
+		field.UUID("invite_id", uuid.UUID{}).
+			Comment("The invite sent when the entry was released").
+			Optional().
+			Nillable(),
+		field.Time("invited_at").
+			Comment("When the entry was released").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WaitlistEntry.
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("invite", Invite.Type).
+			Unique().
+			Field("invite_id"),
+	}
+}
+
+// Indexes of the WaitlistEntry.
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// Positions and releases walk the entries still waiting in signup order
+		index.Fields("invited_at", "created_at"),
+	}
+}
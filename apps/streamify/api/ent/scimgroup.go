@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"streamify/ent/scimgroup"
+	"streamify/ent/ssoprovider"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SCIMGroup is the model entity for the SCIMGroup schema.
+type SCIMGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The SSO provider the group was pushed by
+	ProviderID uuid.UUID `json:"provider_id,omitempty"`
+	// Name of the group at the provider
+	DisplayName string `json:"display_name,omitempty"`
+	// The provider's ID for the group
+	ExternalID *string `json:"external_id,omitempty"`
+	// IDs of the local users in the group
+	Members []uuid.UUID `json:"members,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SCIMGroupQuery when eager-loading is set.
+	Edges        SCIMGroupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SCIMGroupEdges holds the relations/edges for other nodes in the graph.
+type SCIMGroupEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *SSOProvider `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SCIMGroupEdges) ProviderOrErr() (*SSOProvider, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ssoprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SCIMGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scimgroup.FieldMembers:
+			values[i] = new([]byte)
+		case scimgroup.FieldDisplayName, scimgroup.FieldExternalID:
+			values[i] = new(sql.NullString)
+		case scimgroup.FieldCreatedAt, scimgroup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case scimgroup.FieldID, scimgroup.FieldProviderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SCIMGroup fields.
+func (_m *SCIMGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scimgroup.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scimgroup.FieldProviderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value != nil {
+				_m.ProviderID = *value
+			}
+		case scimgroup.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				_m.DisplayName = value.String
+			}
+		case scimgroup.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = new(string)
+				*_m.ExternalID = value.String
+			}
+		case scimgroup.FieldMembers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field members", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Members); err != nil {
+					return fmt.Errorf("unmarshal field members: %w", err)
+				}
+			}
+		case scimgroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scimgroup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SCIMGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *SCIMGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the SCIMGroup entity.
+func (_m *SCIMGroup) QueryProvider() *SSOProviderQuery {
+	return NewSCIMGroupClient(_m.config).QueryProvider(_m)
+}
+
+// Update returns a builder for updating this SCIMGroup.
+// Note that you need to call SCIMGroup.Unwrap() before calling this method if this SCIMGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SCIMGroup) Update() *SCIMGroupUpdateOne {
+	return NewSCIMGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SCIMGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SCIMGroup) Unwrap() *SCIMGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SCIMGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SCIMGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("SCIMGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(_m.DisplayName)
+	builder.WriteString(", ")
+	if v := _m.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("members=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Members))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SCIMGroups is a parsable slice of SCIMGroup.
+type SCIMGroups []*SCIMGroup
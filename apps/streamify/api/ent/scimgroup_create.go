@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/scimgroup"
+	"streamify/ent/ssoprovider"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SCIMGroupCreate is the builder for creating a SCIMGroup entity.
+type SCIMGroupCreate struct {
+	config
+	mutation *SCIMGroupMutation
+	hooks    []Hook
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *SCIMGroupCreate) SetProviderID(v uuid.UUID) *SCIMGroupCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *SCIMGroupCreate) SetDisplayName(v string) *SCIMGroupCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *SCIMGroupCreate) SetExternalID(v string) *SCIMGroupCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableExternalID(v *string) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetMembers sets the "members" field.
+func (_c *SCIMGroupCreate) SetMembers(v []uuid.UUID) *SCIMGroupCreate {
+	_c.mutation.SetMembers(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SCIMGroupCreate) SetCreatedAt(v time.Time) *SCIMGroupCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableCreatedAt(v *time.Time) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SCIMGroupCreate) SetUpdatedAt(v time.Time) *SCIMGroupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableUpdatedAt(v *time.Time) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SCIMGroupCreate) SetID(v uuid.UUID) *SCIMGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableID(v *uuid.UUID) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" edge to the SSOProvider entity.
+func (_c *SCIMGroupCreate) SetProvider(v *SSOProvider) *SCIMGroupCreate {
+	return _c.SetProviderID(v.ID)
+}
+
+// Mutation returns the SCIMGroupMutation object of the builder.
+func (_c *SCIMGroupCreate) Mutation() *SCIMGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the SCIMGroup in the database.
+func (_c *SCIMGroupCreate) Save(ctx context.Context) (*SCIMGroup, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SCIMGroupCreate) SaveX(ctx context.Context) *SCIMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SCIMGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SCIMGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SCIMGroupCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scimgroup.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scimgroup.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := scimgroup.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SCIMGroupCreate) check() error {
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "SCIMGroup.provider_id"`)}
+	}
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "SCIMGroup.display_name"`)}
+	}
+	if v, ok := _c.mutation.DisplayName(); ok {
+		if err := scimgroup.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SCIMGroup.display_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SCIMGroup.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SCIMGroup.updated_at"`)}
+	}
+	if len(_c.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "SCIMGroup.provider"`)}
+	}
+	return nil
+}
+
+func (_c *SCIMGroupCreate) sqlSave(ctx context.Context) (*SCIMGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SCIMGroupCreate) createSpec() (*SCIMGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SCIMGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scimgroup.Table, sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(scimgroup.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(scimgroup.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
+	if value, ok := _c.mutation.Members(); ok {
+		_spec.SetField(scimgroup.FieldMembers, field.TypeJSON, value)
+		_node.Members = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scimgroup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimgroup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scimgroup.ProviderTable,
+			Columns: []string{scimgroup.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoprovider.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SCIMGroupCreateBulk is the builder for creating many SCIMGroup entities in bulk.
+type SCIMGroupCreateBulk struct {
+	config
+	err      error
+	builders []*SCIMGroupCreate
+}
+
+// Save creates the SCIMGroup entities in the database.
+func (_c *SCIMGroupCreateBulk) Save(ctx context.Context) ([]*SCIMGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SCIMGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SCIMGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SCIMGroupCreateBulk) SaveX(ctx context.Context) []*SCIMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SCIMGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SCIMGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/scimgroup"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SCIMGroupUpdate is the builder for updating SCIMGroup entities.
+type SCIMGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SCIMGroupMutation
+}
+
+// Where appends a list predicates to the SCIMGroupUpdate builder.
+func (_u *SCIMGroupUpdate) Where(ps ...predicate.SCIMGroup) *SCIMGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SCIMGroupUpdate) SetDisplayName(v string) *SCIMGroupUpdate {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SCIMGroupUpdate) SetNillableDisplayName(v *string) *SCIMGroupUpdate {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *SCIMGroupUpdate) SetExternalID(v string) *SCIMGroupUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *SCIMGroupUpdate) SetNillableExternalID(v *string) *SCIMGroupUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *SCIMGroupUpdate) ClearExternalID() *SCIMGroupUpdate {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetMembers sets the "members" field.
+func (_u *SCIMGroupUpdate) SetMembers(v []uuid.UUID) *SCIMGroupUpdate {
+	_u.mutation.SetMembers(v)
+	return _u
+}
+
+// AppendMembers appends value to the "members" field.
+func (_u *SCIMGroupUpdate) AppendMembers(v []uuid.UUID) *SCIMGroupUpdate {
+	_u.mutation.AppendMembers(v)
+	return _u
+}
+
+// ClearMembers clears the value of the "members" field.
+func (_u *SCIMGroupUpdate) ClearMembers() *SCIMGroupUpdate {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SCIMGroupUpdate) SetUpdatedAt(v time.Time) *SCIMGroupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SCIMGroupMutation object of the builder.
+func (_u *SCIMGroupUpdate) Mutation() *SCIMGroupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SCIMGroupUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SCIMGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SCIMGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SCIMGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SCIMGroupUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scimgroup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SCIMGroupUpdate) check() error {
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := scimgroup.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SCIMGroup.display_name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SCIMGroup.provider"`)
+	}
+	return nil
+}
+
+func (_u *SCIMGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimgroup.Table, scimgroup.Columns, sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(scimgroup.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(scimgroup.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(scimgroup.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Members(); ok {
+		_spec.SetField(scimgroup.FieldMembers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scimgroup.FieldMembers, value)
+		})
+	}
+	if _u.mutation.MembersCleared() {
+		_spec.ClearField(scimgroup.FieldMembers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimgroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SCIMGroupUpdateOne is the builder for updating a single SCIMGroup entity.
+type SCIMGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SCIMGroupMutation
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *SCIMGroupUpdateOne) SetDisplayName(v string) *SCIMGroupUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *SCIMGroupUpdateOne) SetNillableDisplayName(v *string) *SCIMGroupUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *SCIMGroupUpdateOne) SetExternalID(v string) *SCIMGroupUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *SCIMGroupUpdateOne) SetNillableExternalID(v *string) *SCIMGroupUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *SCIMGroupUpdateOne) ClearExternalID() *SCIMGroupUpdateOne {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetMembers sets the "members" field.
+func (_u *SCIMGroupUpdateOne) SetMembers(v []uuid.UUID) *SCIMGroupUpdateOne {
+	_u.mutation.SetMembers(v)
+	return _u
+}
+
+// AppendMembers appends value to the "members" field.
+func (_u *SCIMGroupUpdateOne) AppendMembers(v []uuid.UUID) *SCIMGroupUpdateOne {
+	_u.mutation.AppendMembers(v)
+	return _u
+}
+
+// ClearMembers clears the value of the "members" field.
+func (_u *SCIMGroupUpdateOne) ClearMembers() *SCIMGroupUpdateOne {
+	_u.mutation.ClearMembers()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SCIMGroupUpdateOne) SetUpdatedAt(v time.Time) *SCIMGroupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SCIMGroupMutation object of the builder.
+func (_u *SCIMGroupUpdateOne) Mutation() *SCIMGroupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SCIMGroupUpdate builder.
+func (_u *SCIMGroupUpdateOne) Where(ps ...predicate.SCIMGroup) *SCIMGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SCIMGroupUpdateOne) Select(field string, fields ...string) *SCIMGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SCIMGroup entity.
+func (_u *SCIMGroupUpdateOne) Save(ctx context.Context) (*SCIMGroup, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SCIMGroupUpdateOne) SaveX(ctx context.Context) *SCIMGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SCIMGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SCIMGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SCIMGroupUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scimgroup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SCIMGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := scimgroup.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "SCIMGroup.display_name": %w`, err)}
+		}
+	}
+	if _u.mutation.ProviderCleared() && len(_u.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SCIMGroup.provider"`)
+	}
+	return nil
+}
+
+func (_u *SCIMGroupUpdateOne) sqlSave(ctx context.Context) (_node *SCIMGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scimgroup.Table, scimgroup.Columns, sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SCIMGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scimgroup.FieldID)
+		for _, f := range fields {
+			if !scimgroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scimgroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(scimgroup.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(scimgroup.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(scimgroup.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Members(); ok {
+		_spec.SetField(scimgroup.FieldMembers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMembers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, scimgroup.FieldMembers, value)
+		})
+	}
+	if _u.mutation.MembersCleared() {
+		_spec.ClearField(scimgroup.FieldMembers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimgroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SCIMGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scimgroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"streamify/ent/securityalert"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SecurityAlert is the model entity for the SecurityAlert schema.
+type SecurityAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user whose account the alert is about
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// The name of the rule that raised the alert
+	Rule string `json:"rule,omitempty"`
+	// How urgently the alert needs looking at
+	Severity securityalert.Severity `json:"severity,omitempty"`
+	// What the rule saw, e.g. the new country and the ones seen before
+	Details map[string]string `json:"details,omitempty"`
+	// Client address that triggered the rule
+	IP string `json:"ip,omitempty"`
+	// Country of that address, as an ISO 3166-1 alpha-2 code
+	Country string `json:"country,omitempty"`
+	// Set when the user's sessions were ended so they must sign in again
+	ReauthForced bool `json:"reauth_forced,omitempty"`
+	// When an admin resolved the alert
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// The admin who resolved the alert
+	ResolvedBy *uuid.UUID `json:"resolved_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityAlertQuery when eager-loading is set.
+	Edges        SecurityAlertEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityAlertEdges holds the relations/edges for other nodes in the graph.
+type SecurityAlertEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SecurityAlertEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityalert.FieldResolvedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case securityalert.FieldDetails:
+			values[i] = new([]byte)
+		case securityalert.FieldReauthForced:
+			values[i] = new(sql.NullBool)
+		case securityalert.FieldRule, securityalert.FieldSeverity, securityalert.FieldIP, securityalert.FieldCountry:
+			values[i] = new(sql.NullString)
+		case securityalert.FieldResolvedAt, securityalert.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case securityalert.FieldID, securityalert.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityAlert fields.
+func (_m *SecurityAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityalert.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case securityalert.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case securityalert.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				_m.Rule = value.String
+			}
+		case securityalert.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = securityalert.Severity(value.String)
+			}
+		case securityalert.FieldDetails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Details); err != nil {
+					return fmt.Errorf("unmarshal field details: %w", err)
+				}
+			}
+		case securityalert.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case securityalert.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case securityalert.FieldReauthForced:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field reauth_forced", values[i])
+			} else if value.Valid {
+				_m.ReauthForced = value.Bool
+			}
+		case securityalert.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case securityalert.FieldResolvedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by", values[i])
+			} else if value.Valid {
+				_m.ResolvedBy = new(uuid.UUID)
+				*_m.ResolvedBy = *value.S.(*uuid.UUID)
+			}
+		case securityalert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityAlert.
+// This includes values selected through modifiers, order, etc.
+func (_m *SecurityAlert) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SecurityAlert entity.
+func (_m *SecurityAlert) QueryUser() *UserQuery {
+	return NewSecurityAlertClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this SecurityAlert.
+// Note that you need to call SecurityAlert.Unwrap() before calling this method if this SecurityAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SecurityAlert) Update() *SecurityAlertUpdateOne {
+	return NewSecurityAlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SecurityAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SecurityAlert) Unwrap() *SecurityAlert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SecurityAlert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SecurityAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(_m.Rule)
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Severity))
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Details))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("reauth_forced=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReauthForced))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedBy; v != nil {
+		builder.WriteString("resolved_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityAlerts is a parsable slice of SecurityAlert.
+type SecurityAlerts []*SecurityAlert
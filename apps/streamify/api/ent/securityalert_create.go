@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/securityalert"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SecurityAlertCreate is the builder for creating a SecurityAlert entity.
+type SecurityAlertCreate struct {
+	config
+	mutation *SecurityAlertMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SecurityAlertCreate) SetUserID(v uuid.UUID) *SecurityAlertCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *SecurityAlertCreate) SetRule(v string) *SecurityAlertCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *SecurityAlertCreate) SetSeverity(v securityalert.Severity) *SecurityAlertCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *SecurityAlertCreate) SetDetails(v map[string]string) *SecurityAlertCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *SecurityAlertCreate) SetIP(v string) *SecurityAlertCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableIP(v *string) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *SecurityAlertCreate) SetCountry(v string) *SecurityAlertCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableCountry(v *string) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetReauthForced sets the "reauth_forced" field.
+func (_c *SecurityAlertCreate) SetReauthForced(v bool) *SecurityAlertCreate {
+	_c.mutation.SetReauthForced(v)
+	return _c
+}
+
+// SetNillableReauthForced sets the "reauth_forced" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableReauthForced(v *bool) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetReauthForced(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *SecurityAlertCreate) SetResolvedAt(v time.Time) *SecurityAlertCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableResolvedAt(v *time.Time) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_c *SecurityAlertCreate) SetResolvedBy(v uuid.UUID) *SecurityAlertCreate {
+	_c.mutation.SetResolvedBy(v)
+	return _c
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableResolvedBy(v *uuid.UUID) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetResolvedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SecurityAlertCreate) SetCreatedAt(v time.Time) *SecurityAlertCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableCreatedAt(v *time.Time) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SecurityAlertCreate) SetID(v uuid.UUID) *SecurityAlertCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SecurityAlertCreate) SetNillableID(v *uuid.UUID) *SecurityAlertCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SecurityAlertCreate) SetUser(v *User) *SecurityAlertCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the SecurityAlertMutation object of the builder.
+func (_c *SecurityAlertCreate) Mutation() *SecurityAlertMutation {
+	return _c.mutation
+}
+
+// Save creates the SecurityAlert in the database.
+func (_c *SecurityAlertCreate) Save(ctx context.Context) (*SecurityAlert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SecurityAlertCreate) SaveX(ctx context.Context) *SecurityAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SecurityAlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SecurityAlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SecurityAlertCreate) defaults() {
+	if _, ok := _c.mutation.ReauthForced(); !ok {
+		v := securityalert.DefaultReauthForced
+		_c.mutation.SetReauthForced(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := securityalert.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := securityalert.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SecurityAlertCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SecurityAlert.user_id"`)}
+	}
+	if _, ok := _c.mutation.Rule(); !ok {
+		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required field "SecurityAlert.rule"`)}
+	}
+	if v, ok := _c.mutation.Rule(); ok {
+		if err := securityalert.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "SecurityAlert.rule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "SecurityAlert.severity"`)}
+	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := securityalert.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "SecurityAlert.severity": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := securityalert.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "SecurityAlert.country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReauthForced(); !ok {
+		return &ValidationError{Name: "reauth_forced", err: errors.New(`ent: missing required field "SecurityAlert.reauth_forced"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SecurityAlert.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SecurityAlert.user"`)}
+	}
+	return nil
+}
+
+func (_c *SecurityAlertCreate) sqlSave(ctx context.Context) (*SecurityAlert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SecurityAlertCreate) createSpec() (*SecurityAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityAlert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(securityalert.Table, sqlgraph.NewFieldSpec(securityalert.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(securityalert.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(securityalert.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(securityalert.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(securityalert.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(securityalert.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.ReauthForced(); ok {
+		_spec.SetField(securityalert.FieldReauthForced, field.TypeBool, value)
+		_node.ReauthForced = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(securityalert.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.ResolvedBy(); ok {
+		_spec.SetField(securityalert.FieldResolvedBy, field.TypeUUID, value)
+		_node.ResolvedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(securityalert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   securityalert.UserTable,
+			Columns: []string{securityalert.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SecurityAlertCreateBulk is the builder for creating many SecurityAlert entities in bulk.
+type SecurityAlertCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityAlertCreate
+}
+
+// Save creates the SecurityAlert entities in the database.
+func (_c *SecurityAlertCreateBulk) Save(ctx context.Context) ([]*SecurityAlert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SecurityAlert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SecurityAlertCreateBulk) SaveX(ctx context.Context) []*SecurityAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SecurityAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SecurityAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/securityalert"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SecurityAlertUpdate is the builder for updating SecurityAlert entities.
+type SecurityAlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SecurityAlertMutation
+}
+
+// Where appends a list predicates to the SecurityAlertUpdate builder.
+func (_u *SecurityAlertUpdate) Where(ps ...predicate.SecurityAlert) *SecurityAlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *SecurityAlertUpdate) SetResolvedAt(v time.Time) *SecurityAlertUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *SecurityAlertUpdate) SetNillableResolvedAt(v *time.Time) *SecurityAlertUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *SecurityAlertUpdate) ClearResolvedAt() *SecurityAlertUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_u *SecurityAlertUpdate) SetResolvedBy(v uuid.UUID) *SecurityAlertUpdate {
+	_u.mutation.SetResolvedBy(v)
+	return _u
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_u *SecurityAlertUpdate) SetNillableResolvedBy(v *uuid.UUID) *SecurityAlertUpdate {
+	if v != nil {
+		_u.SetResolvedBy(*v)
+	}
+	return _u
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (_u *SecurityAlertUpdate) ClearResolvedBy() *SecurityAlertUpdate {
+	_u.mutation.ClearResolvedBy()
+	return _u
+}
+
+// Mutation returns the SecurityAlertMutation object of the builder.
+func (_u *SecurityAlertUpdate) Mutation() *SecurityAlertMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SecurityAlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SecurityAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SecurityAlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SecurityAlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SecurityAlertUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SecurityAlert.user"`)
+	}
+	return nil
+}
+
+func (_u *SecurityAlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityalert.Table, securityalert.Columns, sqlgraph.NewFieldSpec(securityalert.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(securityalert.FieldDetails, field.TypeJSON)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(securityalert.FieldIP, field.TypeString)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(securityalert.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(securityalert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(securityalert.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedBy(); ok {
+		_spec.SetField(securityalert.FieldResolvedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ResolvedByCleared() {
+		_spec.ClearField(securityalert.FieldResolvedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SecurityAlertUpdateOne is the builder for updating a single SecurityAlert entity.
+type SecurityAlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SecurityAlertMutation
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *SecurityAlertUpdateOne) SetResolvedAt(v time.Time) *SecurityAlertUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *SecurityAlertUpdateOne) SetNillableResolvedAt(v *time.Time) *SecurityAlertUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *SecurityAlertUpdateOne) ClearResolvedAt() *SecurityAlertUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_u *SecurityAlertUpdateOne) SetResolvedBy(v uuid.UUID) *SecurityAlertUpdateOne {
+	_u.mutation.SetResolvedBy(v)
+	return _u
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_u *SecurityAlertUpdateOne) SetNillableResolvedBy(v *uuid.UUID) *SecurityAlertUpdateOne {
+	if v != nil {
+		_u.SetResolvedBy(*v)
+	}
+	return _u
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (_u *SecurityAlertUpdateOne) ClearResolvedBy() *SecurityAlertUpdateOne {
+	_u.mutation.ClearResolvedBy()
+	return _u
+}
+
+// Mutation returns the SecurityAlertMutation object of the builder.
+func (_u *SecurityAlertUpdateOne) Mutation() *SecurityAlertMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SecurityAlertUpdate builder.
+func (_u *SecurityAlertUpdateOne) Where(ps ...predicate.SecurityAlert) *SecurityAlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SecurityAlertUpdateOne) Select(field string, fields ...string) *SecurityAlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SecurityAlert entity.
+func (_u *SecurityAlertUpdateOne) Save(ctx context.Context) (*SecurityAlert, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SecurityAlertUpdateOne) SaveX(ctx context.Context) *SecurityAlert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SecurityAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SecurityAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SecurityAlertUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SecurityAlert.user"`)
+	}
+	return nil
+}
+
+func (_u *SecurityAlertUpdateOne) sqlSave(ctx context.Context) (_node *SecurityAlert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityalert.Table, securityalert.Columns, sqlgraph.NewFieldSpec(securityalert.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SecurityAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityalert.FieldID)
+		for _, f := range fields {
+			if !securityalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != securityalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(securityalert.FieldDetails, field.TypeJSON)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(securityalert.FieldIP, field.TypeString)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(securityalert.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(securityalert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(securityalert.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedBy(); ok {
+		_spec.SetField(securityalert.FieldResolvedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ResolvedByCleared() {
+		_spec.ClearField(securityalert.FieldResolvedBy, field.TypeUUID)
+	}
+	_node = &SecurityAlert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/sharelink"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShareLinkCreate is the builder for creating a ShareLink entity.
+type ShareLinkCreate struct {
+	config
+	mutation *ShareLinkMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (_c *ShareLinkCreate) SetToken(v string) *ShareLinkCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ShareLinkCreate) SetKind(v sharelink.Kind) *ShareLinkCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *ShareLinkCreate) SetTargetID(v uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ShareLinkCreate) SetCreatedBy(v uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetVisits sets the "visits" field.
+func (_c *ShareLinkCreate) SetVisits(v int) *ShareLinkCreate {
+	_c.mutation.SetVisits(v)
+	return _c
+}
+
+// SetNillableVisits sets the "visits" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableVisits(v *int) *ShareLinkCreate {
+	if v != nil {
+		_c.SetVisits(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShareLinkCreate) SetCreatedAt(v time.Time) *ShareLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableCreatedAt(v *time.Time) *ShareLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ShareLinkCreate) SetID(v uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ShareLinkCreate) SetNillableID(v *uuid.UUID) *ShareLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (_c *ShareLinkCreate) SetCreatorID(id uuid.UUID) *ShareLinkCreate {
+	_c.mutation.SetCreatorID(id)
+	return _c
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (_c *ShareLinkCreate) SetCreator(v *User) *ShareLinkCreate {
+	return _c.SetCreatorID(v.ID)
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_c *ShareLinkCreate) Mutation() *ShareLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the ShareLink in the database.
+func (_c *ShareLinkCreate) Save(ctx context.Context) (*ShareLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShareLinkCreate) SaveX(ctx context.Context) *ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShareLinkCreate) defaults() {
+	if _, ok := _c.mutation.Visits(); !ok {
+		v := sharelink.DefaultVisits
+		_c.mutation.SetVisits(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sharelink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := sharelink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShareLinkCreate) check() error {
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "ShareLink.token"`)}
+	}
+	if v, ok := _c.mutation.Token(); ok {
+		if err := sharelink.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "ShareLink.token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ShareLink.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := sharelink.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ShareLink.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "ShareLink.target_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ShareLink.created_by"`)}
+	}
+	if _, ok := _c.mutation.Visits(); !ok {
+		return &ValidationError{Name: "visits", err: errors.New(`ent: missing required field "ShareLink.visits"`)}
+	}
+	if v, ok := _c.mutation.Visits(); ok {
+		if err := sharelink.VisitsValidator(v); err != nil {
+			return &ValidationError{Name: "visits", err: fmt.Errorf(`ent: validator failed for field "ShareLink.visits": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShareLink.created_at"`)}
+	}
+	if len(_c.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "ShareLink.creator"`)}
+	}
+	return nil
+}
+
+func (_c *ShareLinkCreate) sqlSave(ctx context.Context) (*ShareLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShareLinkCreate) createSpec() (*ShareLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShareLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sharelink.Table, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(sharelink.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(sharelink.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(sharelink.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Visits(); ok {
+		_spec.SetField(sharelink.FieldVisits, field.TypeInt, value)
+		_node.Visits = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sharelink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sharelink.CreatorTable,
+			Columns: []string{sharelink.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedBy = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShareLinkCreateBulk is the builder for creating many ShareLink entities in bulk.
+type ShareLinkCreateBulk struct {
+	config
+	err      error
+	builders []*ShareLinkCreate
+}
+
+// Save creates the ShareLink entities in the database.
+func (_c *ShareLinkCreateBulk) Save(ctx context.Context) ([]*ShareLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShareLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) SaveX(ctx context.Context) []*ShareLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShareLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShareLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/sharelink"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShareLinkUpdate is the builder for updating ShareLink entities.
+type ShareLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (_u *ShareLinkUpdate) Where(ps ...predicate.ShareLink) *ShareLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVisits sets the "visits" field.
+func (_u *ShareLinkUpdate) SetVisits(v int) *ShareLinkUpdate {
+	_u.mutation.ResetVisits()
+	_u.mutation.SetVisits(v)
+	return _u
+}
+
+// SetNillableVisits sets the "visits" field if the given value is not nil.
+func (_u *ShareLinkUpdate) SetNillableVisits(v *int) *ShareLinkUpdate {
+	if v != nil {
+		_u.SetVisits(*v)
+	}
+	return _u
+}
+
+// AddVisits adds value to the "visits" field.
+func (_u *ShareLinkUpdate) AddVisits(v int) *ShareLinkUpdate {
+	_u.mutation.AddVisits(v)
+	return _u
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_u *ShareLinkUpdate) Mutation() *ShareLinkMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShareLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShareLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareLinkUpdate) check() error {
+	if v, ok := _u.mutation.Visits(); ok {
+		if err := sharelink.VisitsValidator(v); err != nil {
+			return &ValidationError{Name: "visits", err: fmt.Errorf(`ent: validator failed for field "ShareLink.visits": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.creator"`)
+	}
+	return nil
+}
+
+func (_u *ShareLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Visits(); ok {
+		_spec.SetField(sharelink.FieldVisits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVisits(); ok {
+		_spec.AddField(sharelink.FieldVisits, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShareLinkUpdateOne is the builder for updating a single ShareLink entity.
+type ShareLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShareLinkMutation
+}
+
+// SetVisits sets the "visits" field.
+func (_u *ShareLinkUpdateOne) SetVisits(v int) *ShareLinkUpdateOne {
+	_u.mutation.ResetVisits()
+	_u.mutation.SetVisits(v)
+	return _u
+}
+
+// SetNillableVisits sets the "visits" field if the given value is not nil.
+func (_u *ShareLinkUpdateOne) SetNillableVisits(v *int) *ShareLinkUpdateOne {
+	if v != nil {
+		_u.SetVisits(*v)
+	}
+	return _u
+}
+
+// AddVisits adds value to the "visits" field.
+func (_u *ShareLinkUpdateOne) AddVisits(v int) *ShareLinkUpdateOne {
+	_u.mutation.AddVisits(v)
+	return _u
+}
+
+// Mutation returns the ShareLinkMutation object of the builder.
+func (_u *ShareLinkUpdateOne) Mutation() *ShareLinkMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ShareLinkUpdate builder.
+func (_u *ShareLinkUpdateOne) Where(ps ...predicate.ShareLink) *ShareLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShareLinkUpdateOne) Select(field string, fields ...string) *ShareLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShareLink entity.
+func (_u *ShareLinkUpdateOne) Save(ctx context.Context) (*ShareLink, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShareLinkUpdateOne) SaveX(ctx context.Context) *ShareLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShareLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShareLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShareLinkUpdateOne) check() error {
+	if v, ok := _u.mutation.Visits(); ok {
+		if err := sharelink.VisitsValidator(v); err != nil {
+			return &ValidationError{Name: "visits", err: fmt.Errorf(`ent: validator failed for field "ShareLink.visits": %w`, err)}
+		}
+	}
+	if _u.mutation.CreatorCleared() && len(_u.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShareLink.creator"`)
+	}
+	return nil
+}
+
+func (_u *ShareLinkUpdateOne) sqlSave(ctx context.Context) (_node *ShareLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sharelink.Table, sharelink.Columns, sqlgraph.NewFieldSpec(sharelink.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShareLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sharelink.FieldID)
+		for _, f := range fields {
+			if !sharelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sharelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Visits(); ok {
+		_spec.SetField(sharelink.FieldVisits, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVisits(); ok {
+		_spec.AddField(sharelink.FieldVisits, field.TypeInt, value)
+	}
+	_node = &ShareLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sharelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
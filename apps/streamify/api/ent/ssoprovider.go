@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"streamify/ent/ssoprovider"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SSOProvider is the model entity for the SSOProvider schema.
+type SSOProvider struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Names the tenant in sign-in URLs, as in /api/auth/sso/:slug/login
+	Slug string `json:"slug,omitempty"`
+	// Name shown on the sign-in page
+	Name string `json:"name,omitempty"`
+	// The provider's issuer URL, where its discovery document is served
+	Issuer string `json:"issuer,omitempty"`
+	// Client ID registered with the provider
+	ClientID string `json:"client_id,omitempty"`
+	// Client secret registered with the provider
+	ClientSecret string `json:"-"`
+	// ID token claim holding the user's groups
+	GroupsClaim string `json:"groups_claim,omitempty"`
+	// Maps provider groups to roles. When set, roles are synced at every sign-in; users in no mapped group get the user role.
+	GroupRoles map[string]string `json:"group_roles,omitempty"`
+	// Only emails in these domains may sign in; any when empty. Existing local accounts are only linked by email for listed domains.
+	EmailDomains []string `json:"email_domains,omitempty"`
+	// SHA-256 of the bearer token the provider's SCIM client authenticates with
+	ScimTokenHash *string `json:"-"`
+	// The region the tenant's users are pinned to when their accounts are created; empty keeps them in the home region
+	Region *string `json:"region,omitempty"`
+	// Creates accounts for people signing in for the first time
+	Provisioning bool `json:"provisioning,omitempty"`
+	// Disabled providers refuse sign-ins and SCIM requests
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SSOProviderQuery when eager-loading is set.
+	Edges        SSOProviderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SSOProviderEdges holds the relations/edges for other nodes in the graph.
+type SSOProviderEdges struct {
+	// Identities holds the value of the identities edge.
+	Identities []*ExternalIdentity `json:"identities,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*SCIMGroup `json:"groups,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// IdentitiesOrErr returns the Identities value or an error if the edge
+// was not loaded in eager-loading.
+func (e SSOProviderEdges) IdentitiesOrErr() ([]*ExternalIdentity, error) {
+	if e.loadedTypes[0] {
+		return e.Identities, nil
+	}
+	return nil, &NotLoadedError{edge: "identities"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e SSOProviderEdges) GroupsOrErr() ([]*SCIMGroup, error) {
+	if e.loadedTypes[1] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSOProvider) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ssoprovider.FieldGroupRoles, ssoprovider.FieldEmailDomains:
+			values[i] = new([]byte)
+		case ssoprovider.FieldProvisioning, ssoprovider.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case ssoprovider.FieldSlug, ssoprovider.FieldName, ssoprovider.FieldIssuer, ssoprovider.FieldClientID, ssoprovider.FieldClientSecret, ssoprovider.FieldGroupsClaim, ssoprovider.FieldScimTokenHash, ssoprovider.FieldRegion:
+			values[i] = new(sql.NullString)
+		case ssoprovider.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ssoprovider.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSOProvider fields.
+func (_m *SSOProvider) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ssoprovider.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ssoprovider.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = value.String
+			}
+		case ssoprovider.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ssoprovider.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				_m.Issuer = value.String
+			}
+		case ssoprovider.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case ssoprovider.FieldClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret", values[i])
+			} else if value.Valid {
+				_m.ClientSecret = value.String
+			}
+		case ssoprovider.FieldGroupsClaim:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field groups_claim", values[i])
+			} else if value.Valid {
+				_m.GroupsClaim = value.String
+			}
+		case ssoprovider.FieldGroupRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupRoles); err != nil {
+					return fmt.Errorf("unmarshal field group_roles: %w", err)
+				}
+			}
+		case ssoprovider.FieldEmailDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field email_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EmailDomains); err != nil {
+					return fmt.Errorf("unmarshal field email_domains: %w", err)
+				}
+			}
+		case ssoprovider.FieldScimTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scim_token_hash", values[i])
+			} else if value.Valid {
+				_m.ScimTokenHash = new(string)
+				*_m.ScimTokenHash = value.String
+			}
+		case ssoprovider.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = new(string)
+				*_m.Region = value.String
+			}
+		case ssoprovider.FieldProvisioning:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field provisioning", values[i])
+			} else if value.Valid {
+				_m.Provisioning = value.Bool
+			}
+		case ssoprovider.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case ssoprovider.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSOProvider.
+// This includes values selected through modifiers, order, etc.
+func (_m *SSOProvider) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryIdentities queries the "identities" edge of the SSOProvider entity.
+func (_m *SSOProvider) QueryIdentities() *ExternalIdentityQuery {
+	return NewSSOProviderClient(_m.config).QueryIdentities(_m)
+}
+
+// QueryGroups queries the "groups" edge of the SSOProvider entity.
+func (_m *SSOProvider) QueryGroups() *SCIMGroupQuery {
+	return NewSSOProviderClient(_m.config).QueryGroups(_m)
+}
+
+// Update returns a builder for updating this SSOProvider.
+// Note that you need to call SSOProvider.Unwrap() before calling this method if this SSOProvider
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SSOProvider) Update() *SSOProviderUpdateOne {
+	return NewSSOProviderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SSOProvider entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SSOProvider) Unwrap() *SSOProvider {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSOProvider is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SSOProvider) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSOProvider(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(_m.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(_m.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("client_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("groups_claim=")
+	builder.WriteString(_m.GroupsClaim)
+	builder.WriteString(", ")
+	builder.WriteString("group_roles=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupRoles))
+	builder.WriteString(", ")
+	builder.WriteString("email_domains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailDomains))
+	builder.WriteString(", ")
+	builder.WriteString("scim_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.Region; v != nil {
+		builder.WriteString("region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provisioning=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Provisioning))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSOProviders is a parsable slice of SSOProvider.
+type SSOProviders []*SSOProvider
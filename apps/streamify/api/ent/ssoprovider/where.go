@@ -0,0 +1,793 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssoprovider
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldID, id))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldSlug, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldName, v))
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecret applies equality check predicate on the "client_secret" field. It's identical to ClientSecretEQ.
+func ClientSecret(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// GroupsClaim applies equality check predicate on the "groups_claim" field. It's identical to GroupsClaimEQ.
+func GroupsClaim(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldGroupsClaim, v))
+}
+
+// ScimTokenHash applies equality check predicate on the "scim_token_hash" field. It's identical to ScimTokenHashEQ.
+func ScimTokenHash(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldRegion, v))
+}
+
+// Provisioning applies equality check predicate on the "provisioning" field. It's identical to ProvisioningEQ.
+func Provisioning(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldProvisioning, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldName, v))
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldIssuer, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretEQ applies the EQ predicate on the "client_secret" field.
+func ClientSecretEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// ClientSecretNEQ applies the NEQ predicate on the "client_secret" field.
+func ClientSecretNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldClientSecret, v))
+}
+
+// ClientSecretIn applies the In predicate on the "client_secret" field.
+func ClientSecretIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretNotIn applies the NotIn predicate on the "client_secret" field.
+func ClientSecretNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretGT applies the GT predicate on the "client_secret" field.
+func ClientSecretGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldClientSecret, v))
+}
+
+// ClientSecretGTE applies the GTE predicate on the "client_secret" field.
+func ClientSecretGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldClientSecret, v))
+}
+
+// ClientSecretLT applies the LT predicate on the "client_secret" field.
+func ClientSecretLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldClientSecret, v))
+}
+
+// ClientSecretLTE applies the LTE predicate on the "client_secret" field.
+func ClientSecretLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldClientSecret, v))
+}
+
+// ClientSecretContains applies the Contains predicate on the "client_secret" field.
+func ClientSecretContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldClientSecret, v))
+}
+
+// ClientSecretHasPrefix applies the HasPrefix predicate on the "client_secret" field.
+func ClientSecretHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldClientSecret, v))
+}
+
+// ClientSecretHasSuffix applies the HasSuffix predicate on the "client_secret" field.
+func ClientSecretHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldClientSecret, v))
+}
+
+// ClientSecretEqualFold applies the EqualFold predicate on the "client_secret" field.
+func ClientSecretEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldClientSecret, v))
+}
+
+// ClientSecretContainsFold applies the ContainsFold predicate on the "client_secret" field.
+func ClientSecretContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldClientSecret, v))
+}
+
+// GroupsClaimEQ applies the EQ predicate on the "groups_claim" field.
+func GroupsClaimEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldGroupsClaim, v))
+}
+
+// GroupsClaimNEQ applies the NEQ predicate on the "groups_claim" field.
+func GroupsClaimNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldGroupsClaim, v))
+}
+
+// GroupsClaimIn applies the In predicate on the "groups_claim" field.
+func GroupsClaimIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldGroupsClaim, vs...))
+}
+
+// GroupsClaimNotIn applies the NotIn predicate on the "groups_claim" field.
+func GroupsClaimNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldGroupsClaim, vs...))
+}
+
+// GroupsClaimGT applies the GT predicate on the "groups_claim" field.
+func GroupsClaimGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldGroupsClaim, v))
+}
+
+// GroupsClaimGTE applies the GTE predicate on the "groups_claim" field.
+func GroupsClaimGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldGroupsClaim, v))
+}
+
+// GroupsClaimLT applies the LT predicate on the "groups_claim" field.
+func GroupsClaimLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldGroupsClaim, v))
+}
+
+// GroupsClaimLTE applies the LTE predicate on the "groups_claim" field.
+func GroupsClaimLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldGroupsClaim, v))
+}
+
+// GroupsClaimContains applies the Contains predicate on the "groups_claim" field.
+func GroupsClaimContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldGroupsClaim, v))
+}
+
+// GroupsClaimHasPrefix applies the HasPrefix predicate on the "groups_claim" field.
+func GroupsClaimHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldGroupsClaim, v))
+}
+
+// GroupsClaimHasSuffix applies the HasSuffix predicate on the "groups_claim" field.
+func GroupsClaimHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldGroupsClaim, v))
+}
+
+// GroupsClaimEqualFold applies the EqualFold predicate on the "groups_claim" field.
+func GroupsClaimEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldGroupsClaim, v))
+}
+
+// GroupsClaimContainsFold applies the ContainsFold predicate on the "groups_claim" field.
+func GroupsClaimContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldGroupsClaim, v))
+}
+
+// GroupRolesIsNil applies the IsNil predicate on the "group_roles" field.
+func GroupRolesIsNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIsNull(FieldGroupRoles))
+}
+
+// GroupRolesNotNil applies the NotNil predicate on the "group_roles" field.
+func GroupRolesNotNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotNull(FieldGroupRoles))
+}
+
+// EmailDomainsIsNil applies the IsNil predicate on the "email_domains" field.
+func EmailDomainsIsNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIsNull(FieldEmailDomains))
+}
+
+// EmailDomainsNotNil applies the NotNil predicate on the "email_domains" field.
+func EmailDomainsNotNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotNull(FieldEmailDomains))
+}
+
+// ScimTokenHashEQ applies the EQ predicate on the "scim_token_hash" field.
+func ScimTokenHashEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashNEQ applies the NEQ predicate on the "scim_token_hash" field.
+func ScimTokenHashNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIn applies the In predicate on the "scim_token_hash" field.
+func ScimTokenHashIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashNotIn applies the NotIn predicate on the "scim_token_hash" field.
+func ScimTokenHashNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashGT applies the GT predicate on the "scim_token_hash" field.
+func ScimTokenHashGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashGTE applies the GTE predicate on the "scim_token_hash" field.
+func ScimTokenHashGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLT applies the LT predicate on the "scim_token_hash" field.
+func ScimTokenHashLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLTE applies the LTE predicate on the "scim_token_hash" field.
+func ScimTokenHashLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContains applies the Contains predicate on the "scim_token_hash" field.
+func ScimTokenHashContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasPrefix applies the HasPrefix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasSuffix applies the HasSuffix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIsNil applies the IsNil predicate on the "scim_token_hash" field.
+func ScimTokenHashIsNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIsNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashNotNil applies the NotNil predicate on the "scim_token_hash" field.
+func ScimTokenHashNotNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashEqualFold applies the EqualFold predicate on the "scim_token_hash" field.
+func ScimTokenHashEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContainsFold applies the ContainsFold predicate on the "scim_token_hash" field.
+func ScimTokenHashContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldScimTokenHash, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// ProvisioningEQ applies the EQ predicate on the "provisioning" field.
+func ProvisioningEQ(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldProvisioning, v))
+}
+
+// ProvisioningNEQ applies the NEQ predicate on the "provisioning" field.
+func ProvisioningNEQ(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldProvisioning, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasIdentities applies the HasEdge predicate on the "identities" edge.
+func HasIdentities() predicate.SSOProvider {
+	return predicate.SSOProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, IdentitiesTable, IdentitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentitiesWith applies the HasEdge predicate on the "identities" edge with a given conditions (other predicates).
+func HasIdentitiesWith(preds ...predicate.ExternalIdentity) predicate.SSOProvider {
+	return predicate.SSOProvider(func(s *sql.Selector) {
+		step := newIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroups applies the HasEdge predicate on the "groups" edge.
+func HasGroups() predicate.SSOProvider {
+	return predicate.SSOProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, GroupsTable, GroupsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
+func HasGroupsWith(preds ...predicate.SCIMGroup) predicate.SSOProvider {
+	return predicate.SSOProvider(func(s *sql.Selector) {
+		step := newGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SSOProvider) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SSOProvider) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SSOProvider) predicate.SSOProvider {
+	return predicate.SSOProvider(sql.NotPredicates(p))
+}
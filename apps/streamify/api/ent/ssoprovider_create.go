@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/externalidentity"
+	"streamify/ent/scimgroup"
+	"streamify/ent/ssoprovider"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SSOProviderCreate is the builder for creating a SSOProvider entity.
+type SSOProviderCreate struct {
+	config
+	mutation *SSOProviderMutation
+	hooks    []Hook
+}
+
+// SetSlug sets the "slug" field.
+func (_c *SSOProviderCreate) SetSlug(v string) *SSOProviderCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SSOProviderCreate) SetName(v string) *SSOProviderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetIssuer sets the "issuer" field.
+func (_c *SSOProviderCreate) SetIssuer(v string) *SSOProviderCreate {
+	_c.mutation.SetIssuer(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *SSOProviderCreate) SetClientID(v string) *SSOProviderCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_c *SSOProviderCreate) SetClientSecret(v string) *SSOProviderCreate {
+	_c.mutation.SetClientSecret(v)
+	return _c
+}
+
+// SetGroupsClaim sets the "groups_claim" field.
+func (_c *SSOProviderCreate) SetGroupsClaim(v string) *SSOProviderCreate {
+	_c.mutation.SetGroupsClaim(v)
+	return _c
+}
+
+// SetNillableGroupsClaim sets the "groups_claim" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableGroupsClaim(v *string) *SSOProviderCreate {
+	if v != nil {
+		_c.SetGroupsClaim(*v)
+	}
+	return _c
+}
+
+// SetGroupRoles sets the "group_roles" field.
+func (_c *SSOProviderCreate) SetGroupRoles(v map[string]string) *SSOProviderCreate {
+	_c.mutation.SetGroupRoles(v)
+	return _c
+}
+
+// SetEmailDomains sets the "email_domains" field.
+func (_c *SSOProviderCreate) SetEmailDomains(v []string) *SSOProviderCreate {
+	_c.mutation.SetEmailDomains(v)
+	return _c
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_c *SSOProviderCreate) SetScimTokenHash(v string) *SSOProviderCreate {
+	_c.mutation.SetScimTokenHash(v)
+	return _c
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableScimTokenHash(v *string) *SSOProviderCreate {
+	if v != nil {
+		_c.SetScimTokenHash(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *SSOProviderCreate) SetRegion(v string) *SSOProviderCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableRegion(v *string) *SSOProviderCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetProvisioning sets the "provisioning" field.
+func (_c *SSOProviderCreate) SetProvisioning(v bool) *SSOProviderCreate {
+	_c.mutation.SetProvisioning(v)
+	return _c
+}
+
+// SetNillableProvisioning sets the "provisioning" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableProvisioning(v *bool) *SSOProviderCreate {
+	if v != nil {
+		_c.SetProvisioning(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *SSOProviderCreate) SetEnabled(v bool) *SSOProviderCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableEnabled(v *bool) *SSOProviderCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SSOProviderCreate) SetCreatedAt(v time.Time) *SSOProviderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableCreatedAt(v *time.Time) *SSOProviderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SSOProviderCreate) SetID(v uuid.UUID) *SSOProviderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SSOProviderCreate) SetNillableID(v *uuid.UUID) *SSOProviderCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddIdentityIDs adds the "identities" edge to the ExternalIdentity entity by IDs.
+func (_c *SSOProviderCreate) AddIdentityIDs(ids ...uuid.UUID) *SSOProviderCreate {
+	_c.mutation.AddIdentityIDs(ids...)
+	return _c
+}
+
+// AddIdentities adds the "identities" edges to the ExternalIdentity entity.
+func (_c *SSOProviderCreate) AddIdentities(v ...*ExternalIdentity) *SSOProviderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIdentityIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the SCIMGroup entity by IDs.
+func (_c *SSOProviderCreate) AddGroupIDs(ids ...uuid.UUID) *SSOProviderCreate {
+	_c.mutation.AddGroupIDs(ids...)
+	return _c
+}
+
+// AddGroups adds the "groups" edges to the SCIMGroup entity.
+func (_c *SSOProviderCreate) AddGroups(v ...*SCIMGroup) *SSOProviderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGroupIDs(ids...)
+}
+
+// Mutation returns the SSOProviderMutation object of the builder.
+func (_c *SSOProviderCreate) Mutation() *SSOProviderMutation {
+	return _c.mutation
+}
+
+// Save creates the SSOProvider in the database.
+func (_c *SSOProviderCreate) Save(ctx context.Context) (*SSOProvider, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SSOProviderCreate) SaveX(ctx context.Context) *SSOProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOProviderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOProviderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SSOProviderCreate) defaults() {
+	if _, ok := _c.mutation.GroupsClaim(); !ok {
+		v := ssoprovider.DefaultGroupsClaim
+		_c.mutation.SetGroupsClaim(v)
+	}
+	if _, ok := _c.mutation.Provisioning(); !ok {
+		v := ssoprovider.DefaultProvisioning
+		_c.mutation.SetProvisioning(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ssoprovider.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ssoprovider.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ssoprovider.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SSOProviderCreate) check() error {
+	if _, ok := _c.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "SSOProvider.slug"`)}
+	}
+	if v, ok := _c.mutation.Slug(); ok {
+		if err := ssoprovider.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SSOProvider.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ssoprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "SSOProvider.issuer"`)}
+	}
+	if v, ok := _c.mutation.Issuer(); ok {
+		if err := ssoprovider.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.issuer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "SSOProvider.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := ssoprovider.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientSecret(); !ok {
+		return &ValidationError{Name: "client_secret", err: errors.New(`ent: missing required field "SSOProvider.client_secret"`)}
+	}
+	if v, ok := _c.mutation.ClientSecret(); ok {
+		if err := ssoprovider.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GroupsClaim(); !ok {
+		return &ValidationError{Name: "groups_claim", err: errors.New(`ent: missing required field "SSOProvider.groups_claim"`)}
+	}
+	if v, ok := _c.mutation.ScimTokenHash(); ok {
+		if err := ssoprovider.ScimTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "scim_token_hash", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.scim_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := ssoprovider.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.region": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provisioning(); !ok {
+		return &ValidationError{Name: "provisioning", err: errors.New(`ent: missing required field "SSOProvider.provisioning"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SSOProvider.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSOProvider.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *SSOProviderCreate) sqlSave(ctx context.Context) (*SSOProvider, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SSOProviderCreate) createSpec() (*SSOProvider, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSOProvider{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ssoprovider.Table, sqlgraph.NewFieldSpec(ssoprovider.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(ssoprovider.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ssoprovider.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Issuer(); ok {
+		_spec.SetField(ssoprovider.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(ssoprovider.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoprovider.FieldClientSecret, field.TypeString, value)
+		_node.ClientSecret = value
+	}
+	if value, ok := _c.mutation.GroupsClaim(); ok {
+		_spec.SetField(ssoprovider.FieldGroupsClaim, field.TypeString, value)
+		_node.GroupsClaim = value
+	}
+	if value, ok := _c.mutation.GroupRoles(); ok {
+		_spec.SetField(ssoprovider.FieldGroupRoles, field.TypeJSON, value)
+		_node.GroupRoles = value
+	}
+	if value, ok := _c.mutation.EmailDomains(); ok {
+		_spec.SetField(ssoprovider.FieldEmailDomains, field.TypeJSON, value)
+		_node.EmailDomains = value
+	}
+	if value, ok := _c.mutation.ScimTokenHash(); ok {
+		_spec.SetField(ssoprovider.FieldScimTokenHash, field.TypeString, value)
+		_node.ScimTokenHash = &value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(ssoprovider.FieldRegion, field.TypeString, value)
+		_node.Region = &value
+	}
+	if value, ok := _c.mutation.Provisioning(); ok {
+		_spec.SetField(ssoprovider.FieldProvisioning, field.TypeBool, value)
+		_node.Provisioning = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ssoprovider.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ssoprovider.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SSOProviderCreateBulk is the builder for creating many SSOProvider entities in bulk.
+type SSOProviderCreateBulk struct {
+	config
+	err      error
+	builders []*SSOProviderCreate
+}
+
+// Save creates the SSOProvider entities in the database.
+func (_c *SSOProviderCreateBulk) Save(ctx context.Context) ([]*SSOProvider, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SSOProvider, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSOProviderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SSOProviderCreateBulk) SaveX(ctx context.Context) []*SSOProvider {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOProviderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOProviderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1015 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/externalidentity"
+	"streamify/ent/predicate"
+	"streamify/ent/scimgroup"
+	"streamify/ent/ssoprovider"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SSOProviderUpdate is the builder for updating SSOProvider entities.
+type SSOProviderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSOProviderMutation
+}
+
+// Where appends a list predicates to the SSOProviderUpdate builder.
+func (_u *SSOProviderUpdate) Where(ps ...predicate.SSOProvider) *SSOProviderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SSOProviderUpdate) SetName(v string) *SSOProviderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableName(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *SSOProviderUpdate) SetIssuer(v string) *SSOProviderUpdate {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableIssuer(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *SSOProviderUpdate) SetClientID(v string) *SSOProviderUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableClientID(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *SSOProviderUpdate) SetClientSecret(v string) *SSOProviderUpdate {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableClientSecret(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// SetGroupsClaim sets the "groups_claim" field.
+func (_u *SSOProviderUpdate) SetGroupsClaim(v string) *SSOProviderUpdate {
+	_u.mutation.SetGroupsClaim(v)
+	return _u
+}
+
+// SetNillableGroupsClaim sets the "groups_claim" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableGroupsClaim(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetGroupsClaim(*v)
+	}
+	return _u
+}
+
+// SetGroupRoles sets the "group_roles" field.
+func (_u *SSOProviderUpdate) SetGroupRoles(v map[string]string) *SSOProviderUpdate {
+	_u.mutation.SetGroupRoles(v)
+	return _u
+}
+
+// ClearGroupRoles clears the value of the "group_roles" field.
+func (_u *SSOProviderUpdate) ClearGroupRoles() *SSOProviderUpdate {
+	_u.mutation.ClearGroupRoles()
+	return _u
+}
+
+// SetEmailDomains sets the "email_domains" field.
+func (_u *SSOProviderUpdate) SetEmailDomains(v []string) *SSOProviderUpdate {
+	_u.mutation.SetEmailDomains(v)
+	return _u
+}
+
+// AppendEmailDomains appends value to the "email_domains" field.
+func (_u *SSOProviderUpdate) AppendEmailDomains(v []string) *SSOProviderUpdate {
+	_u.mutation.AppendEmailDomains(v)
+	return _u
+}
+
+// ClearEmailDomains clears the value of the "email_domains" field.
+func (_u *SSOProviderUpdate) ClearEmailDomains() *SSOProviderUpdate {
+	_u.mutation.ClearEmailDomains()
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *SSOProviderUpdate) SetScimTokenHash(v string) *SSOProviderUpdate {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableScimTokenHash(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *SSOProviderUpdate) ClearScimTokenHash() *SSOProviderUpdate {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *SSOProviderUpdate) SetRegion(v string) *SSOProviderUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableRegion(v *string) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *SSOProviderUpdate) ClearRegion() *SSOProviderUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetProvisioning sets the "provisioning" field.
+func (_u *SSOProviderUpdate) SetProvisioning(v bool) *SSOProviderUpdate {
+	_u.mutation.SetProvisioning(v)
+	return _u
+}
+
+// SetNillableProvisioning sets the "provisioning" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableProvisioning(v *bool) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetProvisioning(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SSOProviderUpdate) SetEnabled(v bool) *SSOProviderUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SSOProviderUpdate) SetNillableEnabled(v *bool) *SSOProviderUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddIdentityIDs adds the "identities" edge to the ExternalIdentity entity by IDs.
+func (_u *SSOProviderUpdate) AddIdentityIDs(ids ...uuid.UUID) *SSOProviderUpdate {
+	_u.mutation.AddIdentityIDs(ids...)
+	return _u
+}
+
+// AddIdentities adds the "identities" edges to the ExternalIdentity entity.
+func (_u *SSOProviderUpdate) AddIdentities(v ...*ExternalIdentity) *SSOProviderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIdentityIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the SCIMGroup entity by IDs.
+func (_u *SSOProviderUpdate) AddGroupIDs(ids ...uuid.UUID) *SSOProviderUpdate {
+	_u.mutation.AddGroupIDs(ids...)
+	return _u
+}
+
+// AddGroups adds the "groups" edges to the SCIMGroup entity.
+func (_u *SSOProviderUpdate) AddGroups(v ...*SCIMGroup) *SSOProviderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// Mutation returns the SSOProviderMutation object of the builder.
+func (_u *SSOProviderUpdate) Mutation() *SSOProviderMutation {
+	return _u.mutation
+}
+
+// ClearIdentities clears all "identities" edges to the ExternalIdentity entity.
+func (_u *SSOProviderUpdate) ClearIdentities() *SSOProviderUpdate {
+	_u.mutation.ClearIdentities()
+	return _u
+}
+
+// RemoveIdentityIDs removes the "identities" edge to ExternalIdentity entities by IDs.
+func (_u *SSOProviderUpdate) RemoveIdentityIDs(ids ...uuid.UUID) *SSOProviderUpdate {
+	_u.mutation.RemoveIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveIdentities removes "identities" edges to ExternalIdentity entities.
+func (_u *SSOProviderUpdate) RemoveIdentities(v ...*ExternalIdentity) *SSOProviderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIdentityIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the SCIMGroup entity.
+func (_u *SSOProviderUpdate) ClearGroups() *SSOProviderUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to SCIMGroup entities by IDs.
+func (_u *SSOProviderUpdate) RemoveGroupIDs(ids ...uuid.UUID) *SSOProviderUpdate {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to SCIMGroup entities.
+func (_u *SSOProviderUpdate) RemoveGroups(v ...*SCIMGroup) *SSOProviderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SSOProviderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOProviderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SSOProviderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOProviderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOProviderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ssoprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Issuer(); ok {
+		if err := ssoprovider.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.issuer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := ssoprovider.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := ssoprovider.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScimTokenHash(); ok {
+		if err := ssoprovider.ScimTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "scim_token_hash", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.scim_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := ssoprovider.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SSOProviderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoprovider.Table, ssoprovider.Columns, sqlgraph.NewFieldSpec(ssoprovider.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ssoprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(ssoprovider.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(ssoprovider.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoprovider.FieldClientSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupsClaim(); ok {
+		_spec.SetField(ssoprovider.FieldGroupsClaim, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupRoles(); ok {
+		_spec.SetField(ssoprovider.FieldGroupRoles, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRolesCleared() {
+		_spec.ClearField(ssoprovider.FieldGroupRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EmailDomains(); ok {
+		_spec.SetField(ssoprovider.FieldEmailDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmailDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoprovider.FieldEmailDomains, value)
+		})
+	}
+	if _u.mutation.EmailDomainsCleared() {
+		_spec.ClearField(ssoprovider.FieldEmailDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(ssoprovider.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(ssoprovider.FieldScimTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(ssoprovider.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(ssoprovider.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provisioning(); ok {
+		_spec.SetField(ssoprovider.FieldProvisioning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ssoprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SSOProviderUpdateOne is the builder for updating a single SSOProvider entity.
+type SSOProviderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSOProviderMutation
+}
+
+// SetName sets the "name" field.
+func (_u *SSOProviderUpdateOne) SetName(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableName(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *SSOProviderUpdateOne) SetIssuer(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableIssuer(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *SSOProviderUpdateOne) SetClientID(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableClientID(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *SSOProviderUpdateOne) SetClientSecret(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableClientSecret(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// SetGroupsClaim sets the "groups_claim" field.
+func (_u *SSOProviderUpdateOne) SetGroupsClaim(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetGroupsClaim(v)
+	return _u
+}
+
+// SetNillableGroupsClaim sets the "groups_claim" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableGroupsClaim(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetGroupsClaim(*v)
+	}
+	return _u
+}
+
+// SetGroupRoles sets the "group_roles" field.
+func (_u *SSOProviderUpdateOne) SetGroupRoles(v map[string]string) *SSOProviderUpdateOne {
+	_u.mutation.SetGroupRoles(v)
+	return _u
+}
+
+// ClearGroupRoles clears the value of the "group_roles" field.
+func (_u *SSOProviderUpdateOne) ClearGroupRoles() *SSOProviderUpdateOne {
+	_u.mutation.ClearGroupRoles()
+	return _u
+}
+
+// SetEmailDomains sets the "email_domains" field.
+func (_u *SSOProviderUpdateOne) SetEmailDomains(v []string) *SSOProviderUpdateOne {
+	_u.mutation.SetEmailDomains(v)
+	return _u
+}
+
+// AppendEmailDomains appends value to the "email_domains" field.
+func (_u *SSOProviderUpdateOne) AppendEmailDomains(v []string) *SSOProviderUpdateOne {
+	_u.mutation.AppendEmailDomains(v)
+	return _u
+}
+
+// ClearEmailDomains clears the value of the "email_domains" field.
+func (_u *SSOProviderUpdateOne) ClearEmailDomains() *SSOProviderUpdateOne {
+	_u.mutation.ClearEmailDomains()
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *SSOProviderUpdateOne) SetScimTokenHash(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableScimTokenHash(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *SSOProviderUpdateOne) ClearScimTokenHash() *SSOProviderUpdateOne {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *SSOProviderUpdateOne) SetRegion(v string) *SSOProviderUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableRegion(v *string) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *SSOProviderUpdateOne) ClearRegion() *SSOProviderUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetProvisioning sets the "provisioning" field.
+func (_u *SSOProviderUpdateOne) SetProvisioning(v bool) *SSOProviderUpdateOne {
+	_u.mutation.SetProvisioning(v)
+	return _u
+}
+
+// SetNillableProvisioning sets the "provisioning" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableProvisioning(v *bool) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetProvisioning(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SSOProviderUpdateOne) SetEnabled(v bool) *SSOProviderUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SSOProviderUpdateOne) SetNillableEnabled(v *bool) *SSOProviderUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// AddIdentityIDs adds the "identities" edge to the ExternalIdentity entity by IDs.
+func (_u *SSOProviderUpdateOne) AddIdentityIDs(ids ...uuid.UUID) *SSOProviderUpdateOne {
+	_u.mutation.AddIdentityIDs(ids...)
+	return _u
+}
+
+// AddIdentities adds the "identities" edges to the ExternalIdentity entity.
+func (_u *SSOProviderUpdateOne) AddIdentities(v ...*ExternalIdentity) *SSOProviderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIdentityIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the SCIMGroup entity by IDs.
+func (_u *SSOProviderUpdateOne) AddGroupIDs(ids ...uuid.UUID) *SSOProviderUpdateOne {
+	_u.mutation.AddGroupIDs(ids...)
+	return _u
+}
+
+// AddGroups adds the "groups" edges to the SCIMGroup entity.
+func (_u *SSOProviderUpdateOne) AddGroups(v ...*SCIMGroup) *SSOProviderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// Mutation returns the SSOProviderMutation object of the builder.
+func (_u *SSOProviderUpdateOne) Mutation() *SSOProviderMutation {
+	return _u.mutation
+}
+
+// ClearIdentities clears all "identities" edges to the ExternalIdentity entity.
+func (_u *SSOProviderUpdateOne) ClearIdentities() *SSOProviderUpdateOne {
+	_u.mutation.ClearIdentities()
+	return _u
+}
+
+// RemoveIdentityIDs removes the "identities" edge to ExternalIdentity entities by IDs.
+func (_u *SSOProviderUpdateOne) RemoveIdentityIDs(ids ...uuid.UUID) *SSOProviderUpdateOne {
+	_u.mutation.RemoveIdentityIDs(ids...)
+	return _u
+}
+
+// RemoveIdentities removes "identities" edges to ExternalIdentity entities.
+func (_u *SSOProviderUpdateOne) RemoveIdentities(v ...*ExternalIdentity) *SSOProviderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIdentityIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the SCIMGroup entity.
+func (_u *SSOProviderUpdateOne) ClearGroups() *SSOProviderUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to SCIMGroup entities by IDs.
+func (_u *SSOProviderUpdateOne) RemoveGroupIDs(ids ...uuid.UUID) *SSOProviderUpdateOne {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to SCIMGroup entities.
+func (_u *SSOProviderUpdateOne) RemoveGroups(v ...*SCIMGroup) *SSOProviderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// Where appends a list predicates to the SSOProviderUpdate builder.
+func (_u *SSOProviderUpdateOne) Where(ps ...predicate.SSOProvider) *SSOProviderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SSOProviderUpdateOne) Select(field string, fields ...string) *SSOProviderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SSOProvider entity.
+func (_u *SSOProviderUpdateOne) Save(ctx context.Context) (*SSOProvider, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOProviderUpdateOne) SaveX(ctx context.Context) *SSOProvider {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SSOProviderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOProviderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOProviderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ssoprovider.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Issuer(); ok {
+		if err := ssoprovider.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.issuer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := ssoprovider.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := ssoprovider.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.client_secret": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScimTokenHash(); ok {
+		if err := ssoprovider.ScimTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "scim_token_hash", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.scim_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := ssoprovider.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "SSOProvider.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SSOProviderUpdateOne) sqlSave(ctx context.Context) (_node *SSOProvider, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoprovider.Table, ssoprovider.Columns, sqlgraph.NewFieldSpec(ssoprovider.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSOProvider.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssoprovider.FieldID)
+		for _, f := range fields {
+			if !ssoprovider.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ssoprovider.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ssoprovider.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(ssoprovider.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(ssoprovider.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(ssoprovider.FieldClientSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupsClaim(); ok {
+		_spec.SetField(ssoprovider.FieldGroupsClaim, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GroupRoles(); ok {
+		_spec.SetField(ssoprovider.FieldGroupRoles, field.TypeJSON, value)
+	}
+	if _u.mutation.GroupRolesCleared() {
+		_spec.ClearField(ssoprovider.FieldGroupRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EmailDomains(); ok {
+		_spec.SetField(ssoprovider.FieldEmailDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmailDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, ssoprovider.FieldEmailDomains, value)
+		})
+	}
+	if _u.mutation.EmailDomainsCleared() {
+		_spec.ClearField(ssoprovider.FieldEmailDomains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(ssoprovider.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(ssoprovider.FieldScimTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(ssoprovider.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(ssoprovider.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provisioning(); ok {
+		_spec.SetField(ssoprovider.FieldProvisioning, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ssoprovider.FieldEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.IdentitiesTable,
+			Columns: []string{ssoprovider.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externalidentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   ssoprovider.GroupsTable,
+			Columns: []string{ssoprovider.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SSOProvider{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoprovider.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SupportAttachment is the model entity for the SupportAttachment schema.
+type SupportAttachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ticket the file belongs to
+	TicketID uuid.UUID `json:"ticket_id,omitempty"`
+	// Who added the file
+	UploadedBy uuid.UUID `json:"uploaded_by,omitempty"`
+	// The file's name as uploaded
+	Filename string `json:"filename,omitempty"`
+	// The file's type, detected from its contents
+	ContentType string `json:"content_type,omitempty"`
+	// Size in bytes
+	Size int64 `json:"size,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SupportAttachmentQuery when eager-loading is set.
+	Edges        SupportAttachmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SupportAttachmentEdges holds the relations/edges for other nodes in the graph.
+type SupportAttachmentEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *SupportTicket `json:"ticket,omitempty"`
+	// Uploader holds the value of the uploader edge.
+	Uploader *User `json:"uploader,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupportAttachmentEdges) TicketOrErr() (*SupportTicket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: supportticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// UploaderOrErr returns the Uploader value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupportAttachmentEdges) UploaderOrErr() (*User, error) {
+	if e.Uploader != nil {
+		return e.Uploader, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "uploader"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SupportAttachment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case supportattachment.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case supportattachment.FieldFilename, supportattachment.FieldContentType:
+			values[i] = new(sql.NullString)
+		case supportattachment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case supportattachment.FieldID, supportattachment.FieldTicketID, supportattachment.FieldUploadedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SupportAttachment fields.
+func (_m *SupportAttachment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case supportattachment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case supportattachment.FieldTicketID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value != nil {
+				_m.TicketID = *value
+			}
+		case supportattachment.FieldUploadedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_by", values[i])
+			} else if value != nil {
+				_m.UploadedBy = *value
+			}
+		case supportattachment.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case supportattachment.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = value.String
+			}
+		case supportattachment.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case supportattachment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SupportAttachment.
+// This includes values selected through modifiers, order, etc.
+func (_m *SupportAttachment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the SupportAttachment entity.
+func (_m *SupportAttachment) QueryTicket() *SupportTicketQuery {
+	return NewSupportAttachmentClient(_m.config).QueryTicket(_m)
+}
+
+// QueryUploader queries the "uploader" edge of the SupportAttachment entity.
+func (_m *SupportAttachment) QueryUploader() *UserQuery {
+	return NewSupportAttachmentClient(_m.config).QueryUploader(_m)
+}
+
+// Update returns a builder for updating this SupportAttachment.
+// Note that you need to call SupportAttachment.Unwrap() before calling this method if this SupportAttachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SupportAttachment) Update() *SupportAttachmentUpdateOne {
+	return NewSupportAttachmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SupportAttachment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SupportAttachment) Unwrap() *SupportAttachment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SupportAttachment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SupportAttachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("SupportAttachment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ticket_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketID))
+	builder.WriteString(", ")
+	builder.WriteString("uploaded_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadedBy))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(_m.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SupportAttachments is a parsable slice of SupportAttachment.
+type SupportAttachments []*SupportAttachment
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SupportAttachmentCreate is the builder for creating a SupportAttachment entity.
+type SupportAttachmentCreate struct {
+	config
+	mutation *SupportAttachmentMutation
+	hooks    []Hook
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *SupportAttachmentCreate) SetTicketID(v uuid.UUID) *SupportAttachmentCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_c *SupportAttachmentCreate) SetUploadedBy(v uuid.UUID) *SupportAttachmentCreate {
+	_c.mutation.SetUploadedBy(v)
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *SupportAttachmentCreate) SetFilename(v string) *SupportAttachmentCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *SupportAttachmentCreate) SetContentType(v string) *SupportAttachmentCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *SupportAttachmentCreate) SetSize(v int64) *SupportAttachmentCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SupportAttachmentCreate) SetCreatedAt(v time.Time) *SupportAttachmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SupportAttachmentCreate) SetNillableCreatedAt(v *time.Time) *SupportAttachmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SupportAttachmentCreate) SetID(v uuid.UUID) *SupportAttachmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SupportAttachmentCreate) SetNillableID(v *uuid.UUID) *SupportAttachmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the SupportTicket entity.
+func (_c *SupportAttachmentCreate) SetTicket(v *SupportTicket) *SupportAttachmentCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by ID.
+func (_c *SupportAttachmentCreate) SetUploaderID(id uuid.UUID) *SupportAttachmentCreate {
+	_c.mutation.SetUploaderID(id)
+	return _c
+}
+
+// SetUploader sets the "uploader" edge to the User entity.
+func (_c *SupportAttachmentCreate) SetUploader(v *User) *SupportAttachmentCreate {
+	return _c.SetUploaderID(v.ID)
+}
+
+// Mutation returns the SupportAttachmentMutation object of the builder.
+func (_c *SupportAttachmentCreate) Mutation() *SupportAttachmentMutation {
+	return _c.mutation
+}
+
+// Save creates the SupportAttachment in the database.
+func (_c *SupportAttachmentCreate) Save(ctx context.Context) (*SupportAttachment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SupportAttachmentCreate) SaveX(ctx context.Context) *SupportAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportAttachmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportAttachmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SupportAttachmentCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if supportattachment.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized supportattachment.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := supportattachment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if supportattachment.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized supportattachment.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := supportattachment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SupportAttachmentCreate) check() error {
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "SupportAttachment.ticket_id"`)}
+	}
+	if _, ok := _c.mutation.UploadedBy(); !ok {
+		return &ValidationError{Name: "uploaded_by", err: errors.New(`ent: missing required field "SupportAttachment.uploaded_by"`)}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "SupportAttachment.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := supportattachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "SupportAttachment.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "SupportAttachment.content_type"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "SupportAttachment.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := supportattachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "SupportAttachment.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SupportAttachment.created_at"`)}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`ent: missing required edge "SupportAttachment.ticket"`)}
+	}
+	if len(_c.mutation.UploaderIDs()) == 0 {
+		return &ValidationError{Name: "uploader", err: errors.New(`ent: missing required edge "SupportAttachment.uploader"`)}
+	}
+	return nil
+}
+
+func (_c *SupportAttachmentCreate) sqlSave(ctx context.Context) (*SupportAttachment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SupportAttachmentCreate) createSpec() (*SupportAttachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SupportAttachment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(supportattachment.Table, sqlgraph.NewFieldSpec(supportattachment.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(supportattachment.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(supportattachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(supportattachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(supportattachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supportattachment.TicketTable,
+			Columns: []string{supportattachment.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supportticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TicketID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UploaderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supportattachment.UploaderTable,
+			Columns: []string{supportattachment.UploaderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UploadedBy = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SupportAttachmentCreateBulk is the builder for creating many SupportAttachment entities in bulk.
+type SupportAttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*SupportAttachmentCreate
+}
+
+// Save creates the SupportAttachment entities in the database.
+func (_c *SupportAttachmentCreateBulk) Save(ctx context.Context) ([]*SupportAttachment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SupportAttachment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SupportAttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SupportAttachmentCreateBulk) SaveX(ctx context.Context) []*SupportAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportAttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportAttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/supportattachment"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SupportAttachmentUpdate is the builder for updating SupportAttachment entities.
+type SupportAttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SupportAttachmentMutation
+}
+
+// Where appends a list predicates to the SupportAttachmentUpdate builder.
+func (_u *SupportAttachmentUpdate) Where(ps ...predicate.SupportAttachment) *SupportAttachmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the SupportAttachmentMutation object of the builder.
+func (_u *SupportAttachmentUpdate) Mutation() *SupportAttachmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SupportAttachmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupportAttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SupportAttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupportAttachmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupportAttachmentUpdate) check() error {
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupportAttachment.ticket"`)
+	}
+	if _u.mutation.UploaderCleared() && len(_u.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupportAttachment.uploader"`)
+	}
+	return nil
+}
+
+func (_u *SupportAttachmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supportattachment.Table, supportattachment.Columns, sqlgraph.NewFieldSpec(supportattachment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supportattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SupportAttachmentUpdateOne is the builder for updating a single SupportAttachment entity.
+type SupportAttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SupportAttachmentMutation
+}
+
+// Mutation returns the SupportAttachmentMutation object of the builder.
+func (_u *SupportAttachmentUpdateOne) Mutation() *SupportAttachmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SupportAttachmentUpdate builder.
+func (_u *SupportAttachmentUpdateOne) Where(ps ...predicate.SupportAttachment) *SupportAttachmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SupportAttachmentUpdateOne) Select(field string, fields ...string) *SupportAttachmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SupportAttachment entity.
+func (_u *SupportAttachmentUpdateOne) Save(ctx context.Context) (*SupportAttachment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SupportAttachmentUpdateOne) SaveX(ctx context.Context) *SupportAttachment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SupportAttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SupportAttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SupportAttachmentUpdateOne) check() error {
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupportAttachment.ticket"`)
+	}
+	if _u.mutation.UploaderCleared() && len(_u.mutation.UploaderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SupportAttachment.uploader"`)
+	}
+	return nil
+}
+
+func (_u *SupportAttachmentUpdateOne) sqlSave(ctx context.Context) (_node *SupportAttachment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(supportattachment.Table, supportattachment.Columns, sqlgraph.NewFieldSpec(supportattachment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SupportAttachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, supportattachment.FieldID)
+		for _, f := range fields {
+			if !supportattachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != supportattachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &SupportAttachment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{supportattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SupportReply is the model entity for the SupportReply schema.
+type SupportReply struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ticket replied to
+	TicketID uuid.UUID `json:"ticket_id,omitempty"`
+	// Who wrote the reply
+	AuthorID uuid.UUID `json:"author_id,omitempty"`
+	// Set on replies from support rather than the ticket's user
+	Staff bool `json:"staff,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SupportReplyQuery when eager-loading is set.
+	Edges        SupportReplyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SupportReplyEdges holds the relations/edges for other nodes in the graph.
+type SupportReplyEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *SupportTicket `json:"ticket,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupportReplyEdges) TicketOrErr() (*SupportTicket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: supportticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupportReplyEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SupportReply) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case supportreply.FieldStaff:
+			values[i] = new(sql.NullBool)
+		case supportreply.FieldBody:
+			values[i] = new(sql.NullString)
+		case supportreply.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case supportreply.FieldID, supportreply.FieldTicketID, supportreply.FieldAuthorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SupportReply fields.
+func (_m *SupportReply) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case supportreply.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case supportreply.FieldTicketID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value != nil {
+				_m.TicketID = *value
+			}
+		case supportreply.FieldAuthorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				_m.AuthorID = *value
+			}
+		case supportreply.FieldStaff:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field staff", values[i])
+			} else if value.Valid {
+				_m.Staff = value.Bool
+			}
+		case supportreply.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case supportreply.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SupportReply.
+// This includes values selected through modifiers, order, etc.
+func (_m *SupportReply) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the SupportReply entity.
+func (_m *SupportReply) QueryTicket() *SupportTicketQuery {
+	return NewSupportReplyClient(_m.config).QueryTicket(_m)
+}
+
+// QueryAuthor queries the "author" edge of the SupportReply entity.
+func (_m *SupportReply) QueryAuthor() *UserQuery {
+	return NewSupportReplyClient(_m.config).QueryAuthor(_m)
+}
+
+// Update returns a builder for updating this SupportReply.
+// Note that you need to call SupportReply.Unwrap() before calling this method if this SupportReply
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SupportReply) Update() *SupportReplyUpdateOne {
+	return NewSupportReplyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SupportReply entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SupportReply) Unwrap() *SupportReply {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SupportReply is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SupportReply) String() string {
+	var builder strings.Builder
+	builder.WriteString("SupportReply(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ticket_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("staff=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Staff))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SupportReplies is a parsable slice of SupportReply.
+type SupportReplies []*SupportReply
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SupportReplyCreate is the builder for creating a SupportReply entity.
+type SupportReplyCreate struct {
+	config
+	mutation *SupportReplyMutation
+	hooks    []Hook
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *SupportReplyCreate) SetTicketID(v uuid.UUID) *SupportReplyCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *SupportReplyCreate) SetAuthorID(v uuid.UUID) *SupportReplyCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetStaff sets the "staff" field.
+func (_c *SupportReplyCreate) SetStaff(v bool) *SupportReplyCreate {
+	_c.mutation.SetStaff(v)
+	return _c
+}
+
+// SetNillableStaff sets the "staff" field if the given value is not nil.
+func (_c *SupportReplyCreate) SetNillableStaff(v *bool) *SupportReplyCreate {
+	if v != nil {
+		_c.SetStaff(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *SupportReplyCreate) SetBody(v string) *SupportReplyCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SupportReplyCreate) SetCreatedAt(v time.Time) *SupportReplyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SupportReplyCreate) SetNillableCreatedAt(v *time.Time) *SupportReplyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SupportReplyCreate) SetID(v uuid.UUID) *SupportReplyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SupportReplyCreate) SetNillableID(v *uuid.UUID) *SupportReplyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the SupportTicket entity.
+func (_c *SupportReplyCreate) SetTicket(v *SupportTicket) *SupportReplyCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_c *SupportReplyCreate) SetAuthor(v *User) *SupportReplyCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// Mutation returns the SupportReplyMutation object of the builder.
+func (_c *SupportReplyCreate) Mutation() *SupportReplyMutation {
+	return _c.mutation
+}
+
+// Save creates the SupportReply in the database.
+func (_c *SupportReplyCreate) Save(ctx context.Context) (*SupportReply, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SupportReplyCreate) SaveX(ctx context.Context) *SupportReply {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportReplyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportReplyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SupportReplyCreate) defaults() error {
+	if _, ok := _c.mutation.Staff(); !ok {
+		v := supportreply.DefaultStaff
+		_c.mutation.SetStaff(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if supportreply.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized supportreply.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := supportreply.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if supportreply.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized supportreply.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := supportreply.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SupportReplyCreate) check() error {
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "SupportReply.ticket_id"`)}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "SupportReply.author_id"`)}
+	}
+	if _, ok := _c.mutation.Staff(); !ok {
+		return &ValidationError{Name: "staff", err: errors.New(`ent: missing required field "SupportReply.staff"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "SupportReply.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := supportreply.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "SupportReply.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SupportReply.created_at"`)}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`ent: missing required edge "SupportReply.ticket"`)}
+	}
+	if len(_c.mutation.AuthorIDs()) == 0 {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required edge "SupportReply.author"`)}
+	}
+	return nil
+}
+
+func (_c *SupportReplyCreate) sqlSave(ctx context.Context) (*SupportReply, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SupportReplyCreate) createSpec() (*SupportReply, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SupportReply{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(supportreply.Table, sqlgraph.NewFieldSpec(supportreply.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Staff(); ok {
+		_spec.SetField(supportreply.FieldStaff, field.TypeBool, value)
+		_node.Staff = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(supportreply.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(supportreply.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supportreply.TicketTable,
+			Columns: []string{supportreply.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supportticket.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TicketID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supportreply.AuthorTable,
+			Columns: []string{supportreply.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SupportReplyCreateBulk is the builder for creating many SupportReply entities in bulk.
+type SupportReplyCreateBulk struct {
+	config
+	err      error
+	builders []*SupportReplyCreate
+}
+
+// Save creates the SupportReply entities in the database.
+func (_c *SupportReplyCreateBulk) Save(ctx context.Context) ([]*SupportReply, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SupportReply, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SupportReplyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SupportReplyCreateBulk) SaveX(ctx context.Context) []*SupportReply {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportReplyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportReplyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
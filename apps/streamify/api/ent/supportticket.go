@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SupportTicket is the model entity for the SupportTicket schema.
+type SupportTicket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The user asking for help
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// One line summing up the request
+	Subject string `json:"subject,omitempty"`
+	// The request in the user's words
+	Body string `json:"body,omitempty"`
+	// What the request is about, for routing it to the right people
+	Category supportticket.Category `json:"category,omitempty"`
+	// open while support owes an answer, pending while the user does, resolved once answered and closed when no more replies are taken; a reply from the user reopens a resolved ticket
+	Status supportticket.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SupportTicketQuery when eager-loading is set.
+	Edges        SupportTicketEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SupportTicketEdges holds the relations/edges for other nodes in the graph.
+type SupportTicketEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*SupportReply `json:"replies,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*SupportAttachment `json:"attachments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SupportTicketEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e SupportTicketEdges) RepliesOrErr() ([]*SupportReply, error) {
+	if e.loadedTypes[1] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e SupportTicketEdges) AttachmentsOrErr() ([]*SupportAttachment, error) {
+	if e.loadedTypes[2] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SupportTicket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case supportticket.FieldSubject, supportticket.FieldBody, supportticket.FieldCategory, supportticket.FieldStatus:
+			values[i] = new(sql.NullString)
+		case supportticket.FieldCreatedAt, supportticket.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case supportticket.FieldID, supportticket.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SupportTicket fields.
+func (_m *SupportTicket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case supportticket.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case supportticket.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case supportticket.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case supportticket.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case supportticket.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = supportticket.Category(value.String)
+			}
+		case supportticket.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = supportticket.Status(value.String)
+			}
+		case supportticket.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case supportticket.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SupportTicket.
+// This includes values selected through modifiers, order, etc.
+func (_m *SupportTicket) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SupportTicket entity.
+func (_m *SupportTicket) QueryUser() *UserQuery {
+	return NewSupportTicketClient(_m.config).QueryUser(_m)
+}
+
+// QueryReplies queries the "replies" edge of the SupportTicket entity.
+func (_m *SupportTicket) QueryReplies() *SupportReplyQuery {
+	return NewSupportTicketClient(_m.config).QueryReplies(_m)
+}
+
+// QueryAttachments queries the "attachments" edge of the SupportTicket entity.
+func (_m *SupportTicket) QueryAttachments() *SupportAttachmentQuery {
+	return NewSupportTicketClient(_m.config).QueryAttachments(_m)
+}
+
+// Update returns a builder for updating this SupportTicket.
+// Note that you need to call SupportTicket.Unwrap() before calling this method if this SupportTicket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SupportTicket) Update() *SupportTicketUpdateOne {
+	return NewSupportTicketClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SupportTicket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SupportTicket) Unwrap() *SupportTicket {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SupportTicket is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SupportTicket) String() string {
+	var builder strings.Builder
+	builder.WriteString("SupportTicket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Category))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SupportTickets is a parsable slice of SupportTicket.
+type SupportTickets []*SupportTicket
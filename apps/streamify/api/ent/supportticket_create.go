@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SupportTicketCreate is the builder for creating a SupportTicket entity.
+type SupportTicketCreate struct {
+	config
+	mutation *SupportTicketMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SupportTicketCreate) SetUserID(v uuid.UUID) *SupportTicketCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *SupportTicketCreate) SetSubject(v string) *SupportTicketCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *SupportTicketCreate) SetBody(v string) *SupportTicketCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *SupportTicketCreate) SetCategory(v supportticket.Category) *SupportTicketCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SupportTicketCreate) SetStatus(v supportticket.Status) *SupportTicketCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SupportTicketCreate) SetNillableStatus(v *supportticket.Status) *SupportTicketCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SupportTicketCreate) SetCreatedAt(v time.Time) *SupportTicketCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SupportTicketCreate) SetNillableCreatedAt(v *time.Time) *SupportTicketCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SupportTicketCreate) SetUpdatedAt(v time.Time) *SupportTicketCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SupportTicketCreate) SetNillableUpdatedAt(v *time.Time) *SupportTicketCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SupportTicketCreate) SetID(v uuid.UUID) *SupportTicketCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SupportTicketCreate) SetNillableID(v *uuid.UUID) *SupportTicketCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SupportTicketCreate) SetUser(v *User) *SupportTicketCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the SupportReply entity by IDs.
+func (_c *SupportTicketCreate) AddReplyIDs(ids ...uuid.UUID) *SupportTicketCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the SupportReply entity.
+func (_c *SupportTicketCreate) AddReplies(v ...*SupportReply) *SupportTicketCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the SupportAttachment entity by IDs.
+func (_c *SupportTicketCreate) AddAttachmentIDs(ids ...uuid.UUID) *SupportTicketCreate {
+	_c.mutation.AddAttachmentIDs(ids...)
+	return _c
+}
+
+// AddAttachments adds the "attachments" edges to the SupportAttachment entity.
+func (_c *SupportTicketCreate) AddAttachments(v ...*SupportAttachment) *SupportTicketCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the SupportTicketMutation object of the builder.
+func (_c *SupportTicketCreate) Mutation() *SupportTicketMutation {
+	return _c.mutation
+}
+
+// Save creates the SupportTicket in the database.
+func (_c *SupportTicketCreate) Save(ctx context.Context) (*SupportTicket, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SupportTicketCreate) SaveX(ctx context.Context) *SupportTicket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportTicketCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportTicketCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SupportTicketCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := supportticket.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if supportticket.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized supportticket.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := supportticket.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if supportticket.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized supportticket.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := supportticket.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if supportticket.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized supportticket.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := supportticket.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SupportTicketCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SupportTicket.user_id"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "SupportTicket.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := supportticket.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "SupportTicket.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "SupportTicket.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := supportticket.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "SupportTicket.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "SupportTicket.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := supportticket.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "SupportTicket.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SupportTicket.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := supportticket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SupportTicket.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SupportTicket.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SupportTicket.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SupportTicket.user"`)}
+	}
+	return nil
+}
+
+func (_c *SupportTicketCreate) sqlSave(ctx context.Context) (*SupportTicket, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SupportTicketCreate) createSpec() (*SupportTicket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SupportTicket{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(supportticket.Table, sqlgraph.NewFieldSpec(supportticket.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(supportticket.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(supportticket.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(supportticket.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(supportticket.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(supportticket.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(supportticket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   supportticket.UserTable,
+			Columns: []string{supportticket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   supportticket.RepliesTable,
+			Columns: []string{supportticket.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supportreply.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   supportticket.AttachmentsTable,
+			Columns: []string{supportticket.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(supportattachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SupportTicketCreateBulk is the builder for creating many SupportTicket entities in bulk.
+type SupportTicketCreateBulk struct {
+	config
+	err      error
+	builders []*SupportTicketCreate
+}
+
+// Save creates the SupportTicket entities in the database.
+func (_c *SupportTicketCreateBulk) Save(ctx context.Context) ([]*SupportTicket, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SupportTicket, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SupportTicketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SupportTicketCreateBulk) SaveX(ctx context.Context) []*SupportTicket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SupportTicketCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SupportTicketCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
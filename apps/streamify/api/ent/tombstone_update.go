@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/tombstone"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TombstoneUpdate is the builder for updating Tombstone entities.
+type TombstoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TombstoneMutation
+}
+
+// Where appends a list predicates to the TombstoneUpdate builder.
+func (_u *TombstoneUpdate) Where(ps ...predicate.Tombstone) *TombstoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *TombstoneUpdate) SetEntityType(v tombstone.EntityType) *TombstoneUpdate {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *TombstoneUpdate) SetNillableEntityType(v *tombstone.EntityType) *TombstoneUpdate {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *TombstoneUpdate) SetEntityID(v uuid.UUID) *TombstoneUpdate {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *TombstoneUpdate) SetNillableEntityID(v *uuid.UUID) *TombstoneUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *TombstoneUpdate) SetOwnerID(v uuid.UUID) *TombstoneUpdate {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *TombstoneUpdate) SetNillableOwnerID(v *uuid.UUID) *TombstoneUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *TombstoneUpdate) ClearOwnerID() *TombstoneUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (_u *TombstoneUpdate) Mutation() *TombstoneMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TombstoneUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TombstoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TombstoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TombstoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TombstoneUpdate) check() error {
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := tombstone.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Tombstone.entity_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TombstoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tombstone.Table, tombstone.Columns, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(tombstone.FieldEntityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(tombstone.FieldEntityID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(tombstone.FieldOwnerID, field.TypeUUID, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(tombstone.FieldOwnerID, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TombstoneUpdateOne is the builder for updating a single Tombstone entity.
+type TombstoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TombstoneMutation
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *TombstoneUpdateOne) SetEntityType(v tombstone.EntityType) *TombstoneUpdateOne {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *TombstoneUpdateOne) SetNillableEntityType(v *tombstone.EntityType) *TombstoneUpdateOne {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *TombstoneUpdateOne) SetEntityID(v uuid.UUID) *TombstoneUpdateOne {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *TombstoneUpdateOne) SetNillableEntityID(v *uuid.UUID) *TombstoneUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *TombstoneUpdateOne) SetOwnerID(v uuid.UUID) *TombstoneUpdateOne {
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *TombstoneUpdateOne) SetNillableOwnerID(v *uuid.UUID) *TombstoneUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *TombstoneUpdateOne) ClearOwnerID() *TombstoneUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (_u *TombstoneUpdateOne) Mutation() *TombstoneMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TombstoneUpdate builder.
+func (_u *TombstoneUpdateOne) Where(ps ...predicate.Tombstone) *TombstoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TombstoneUpdateOne) Select(field string, fields ...string) *TombstoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tombstone entity.
+func (_u *TombstoneUpdateOne) Save(ctx context.Context) (*Tombstone, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TombstoneUpdateOne) SaveX(ctx context.Context) *Tombstone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TombstoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TombstoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TombstoneUpdateOne) check() error {
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := tombstone.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Tombstone.entity_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TombstoneUpdateOne) sqlSave(ctx context.Context) (_node *Tombstone, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tombstone.Table, tombstone.Columns, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tombstone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tombstone.FieldID)
+		for _, f := range fields {
+			if !tombstone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(tombstone.FieldEntityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(tombstone.FieldEntityID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(tombstone.FieldOwnerID, field.TypeUUID, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(tombstone.FieldOwnerID, field.TypeUUID)
+	}
+	_node = &Tombstone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
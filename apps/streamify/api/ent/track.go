@@ -19,14 +19,28 @@ type Track struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Title holds the value of the "title" field.
+	// Title of the recording
 	Title string `json:"title,omitempty"`
-	// AlbumID holds the value of the "album_id" field.
+	// The album the track is on
 	AlbumID uuid.UUID `json:"album_id,omitempty"`
-	// URL holds the value of the "url" field.
+	// Position on its disc, from 1; 0 or unset when unknown
+	TrackNumber int `json:"track_number,omitempty"`
+	// Disc of a multi-disc album the track is on
+	DiscNumber int `json:"disc_number,omitempty"`
+	// Where the audio is streamed from when it isn't uploaded
 	URL string `json:"url,omitempty"`
+	// Storage key of the uploaded audio file
+	AudioKey string `json:"audio_key,omitempty"`
+	// Length of the audio in milliseconds, read from the uploaded file; unset when unknown
+	DurationMs *int64 `json:"duration_ms,omitempty"`
+	// Links a remaster, live version or remix to the original recording
+	CanonicalTrackID *uuid.UUID `json:"canonical_track_id,omitempty"`
+	// How the recording relates to its canonical track
+	VersionType track.VersionType `json:"version_type,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TrackQuery when eager-loading is set.
 	Edges        TrackEdges `json:"edges"`
@@ -37,9 +51,17 @@ type Track struct {
 type TrackEdges struct {
 	// Album holds the value of the album edge.
 	Album *Album `json:"album,omitempty"`
+	// Plays holds the value of the plays edge.
+	Plays []*Play `json:"plays,omitempty"`
+	// Playlists holds the value of the playlists edge.
+	Playlists []*Playlist `json:"playlists,omitempty"`
+	// Canonical holds the value of the canonical edge.
+	Canonical *Track `json:"canonical,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*Track `json:"versions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // AlbumOrErr returns the Album value or an error if the edge
@@ -53,14 +75,56 @@ func (e TrackEdges) AlbumOrErr() (*Album, error) {
 	return nil, &NotLoadedError{edge: "album"}
 }
 
+// PlaysOrErr returns the Plays value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) PlaysOrErr() ([]*Play, error) {
+	if e.loadedTypes[1] {
+		return e.Plays, nil
+	}
+	return nil, &NotLoadedError{edge: "plays"}
+}
+
+// PlaylistsOrErr returns the Playlists value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) PlaylistsOrErr() ([]*Playlist, error) {
+	if e.loadedTypes[2] {
+		return e.Playlists, nil
+	}
+	return nil, &NotLoadedError{edge: "playlists"}
+}
+
+// CanonicalOrErr returns the Canonical value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackEdges) CanonicalOrErr() (*Track, error) {
+	if e.Canonical != nil {
+		return e.Canonical, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "canonical"}
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TrackEdges) VersionsOrErr() ([]*Track, error) {
+	if e.loadedTypes[4] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Track) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case track.FieldTitle, track.FieldURL:
+		case track.FieldCanonicalTrackID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case track.FieldTrackNumber, track.FieldDiscNumber, track.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case track.FieldTitle, track.FieldURL, track.FieldAudioKey, track.FieldVersionType:
 			values[i] = new(sql.NullString)
-		case track.FieldCreatedAt:
+		case track.FieldCreatedAt, track.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case track.FieldID, track.FieldAlbumID:
 			values[i] = new(uuid.UUID)
@@ -97,18 +161,63 @@ func (_m *Track) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.AlbumID = *value
 			}
+		case track.FieldTrackNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field track_number", values[i])
+			} else if value.Valid {
+				_m.TrackNumber = int(value.Int64)
+			}
+		case track.FieldDiscNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disc_number", values[i])
+			} else if value.Valid {
+				_m.DiscNumber = int(value.Int64)
+			}
 		case track.FieldURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field url", values[i])
 			} else if value.Valid {
 				_m.URL = value.String
 			}
+		case track.FieldAudioKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_key", values[i])
+			} else if value.Valid {
+				_m.AudioKey = value.String
+			}
+		case track.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = new(int64)
+				*_m.DurationMs = value.Int64
+			}
+		case track.FieldCanonicalTrackID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field canonical_track_id", values[i])
+			} else if value.Valid {
+				_m.CanonicalTrackID = new(uuid.UUID)
+				*_m.CanonicalTrackID = *value.S.(*uuid.UUID)
+			}
+		case track.FieldVersionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version_type", values[i])
+			} else if value.Valid {
+				_m.VersionType = track.VersionType(value.String)
+			}
 		case track.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case track.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -127,6 +236,26 @@ func (_m *Track) QueryAlbum() *AlbumQuery {
 	return NewTrackClient(_m.config).QueryAlbum(_m)
 }
 
+// QueryPlays queries the "plays" edge of the Track entity.
+func (_m *Track) QueryPlays() *PlayQuery {
+	return NewTrackClient(_m.config).QueryPlays(_m)
+}
+
+// QueryPlaylists queries the "playlists" edge of the Track entity.
+func (_m *Track) QueryPlaylists() *PlaylistQuery {
+	return NewTrackClient(_m.config).QueryPlaylists(_m)
+}
+
+// QueryCanonical queries the "canonical" edge of the Track entity.
+func (_m *Track) QueryCanonical() *TrackQuery {
+	return NewTrackClient(_m.config).QueryCanonical(_m)
+}
+
+// QueryVersions queries the "versions" edge of the Track entity.
+func (_m *Track) QueryVersions() *TrackQuery {
+	return NewTrackClient(_m.config).QueryVersions(_m)
+}
+
 // Update returns a builder for updating this Track.
 // Note that you need to call Track.Unwrap() before calling this method if this Track
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -156,11 +285,38 @@ func (_m *Track) String() string {
 	builder.WriteString("album_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AlbumID))
 	builder.WriteString(", ")
+	builder.WriteString("track_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackNumber))
+	builder.WriteString(", ")
+	builder.WriteString("disc_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscNumber))
+	builder.WriteString(", ")
 	builder.WriteString("url=")
 	builder.WriteString(_m.URL)
 	builder.WriteString(", ")
+	builder.WriteString("audio_key=")
+	builder.WriteString(_m.AudioKey)
+	builder.WriteString(", ")
+	if v := _m.DurationMs; v != nil {
+		builder.WriteString("duration_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CanonicalTrackID; v != nil {
+		builder.WriteString("canonical_track_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VersionType))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
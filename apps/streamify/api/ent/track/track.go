@@ -3,8 +3,10 @@
 package track
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -19,12 +21,34 @@ const (
 	FieldTitle = "title"
 	// FieldAlbumID holds the string denoting the album_id field in the database.
 	FieldAlbumID = "album_id"
+	// FieldTrackNumber holds the string denoting the track_number field in the database.
+	FieldTrackNumber = "track_number"
+	// FieldDiscNumber holds the string denoting the disc_number field in the database.
+	FieldDiscNumber = "disc_number"
 	// FieldURL holds the string denoting the url field in the database.
 	FieldURL = "url"
+	// FieldAudioKey holds the string denoting the audio_key field in the database.
+	FieldAudioKey = "audio_key"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldCanonicalTrackID holds the string denoting the canonical_track_id field in the database.
+	FieldCanonicalTrackID = "canonical_track_id"
+	// FieldVersionType holds the string denoting the version_type field in the database.
+	FieldVersionType = "version_type"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeAlbum holds the string denoting the album edge name in mutations.
 	EdgeAlbum = "album"
+	// EdgePlays holds the string denoting the plays edge name in mutations.
+	EdgePlays = "plays"
+	// EdgePlaylists holds the string denoting the playlists edge name in mutations.
+	EdgePlaylists = "playlists"
+	// EdgeCanonical holds the string denoting the canonical edge name in mutations.
+	EdgeCanonical = "canonical"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
 	// Table holds the table name of the track in the database.
 	Table = "tracks"
 	// AlbumTable is the table that holds the album relation/edge.
@@ -34,6 +58,26 @@ const (
 	AlbumInverseTable = "albums"
 	// AlbumColumn is the table column denoting the album relation/edge.
 	AlbumColumn = "album_id"
+	// PlaysTable is the table that holds the plays relation/edge.
+	PlaysTable = "plays"
+	// PlaysInverseTable is the table name for the Play entity.
+	// It exists in this package in order to avoid circular dependency with the "play" package.
+	PlaysInverseTable = "plays"
+	// PlaysColumn is the table column denoting the plays relation/edge.
+	PlaysColumn = "track_id"
+	// PlaylistsTable is the table that holds the playlists relation/edge. The primary key declared below.
+	PlaylistsTable = "playlist_tracks"
+	// PlaylistsInverseTable is the table name for the Playlist entity.
+	// It exists in this package in order to avoid circular dependency with the "playlist" package.
+	PlaylistsInverseTable = "playlists"
+	// CanonicalTable is the table that holds the canonical relation/edge.
+	CanonicalTable = "tracks"
+	// CanonicalColumn is the table column denoting the canonical relation/edge.
+	CanonicalColumn = "canonical_track_id"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "tracks"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "canonical_track_id"
 )
 
 // Columns holds all SQL columns for track fields.
@@ -41,10 +85,23 @@ var Columns = []string{
 	FieldID,
 	FieldTitle,
 	FieldAlbumID,
+	FieldTrackNumber,
+	FieldDiscNumber,
 	FieldURL,
+	FieldAudioKey,
+	FieldDurationMs,
+	FieldCanonicalTrackID,
+	FieldVersionType,
 	FieldCreatedAt,
+	FieldDeletedAt,
 }
 
+var (
+	// PlaylistsPrimaryKey and PlaylistsColumn2 are the table columns denoting the
+	// primary key for the playlists relation (M2M).
+	PlaylistsPrimaryKey = []string{"playlist_id", "track_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -55,15 +112,60 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "streamify/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// TrackNumberValidator is a validator for the "track_number" field. It is called by the builders before save.
+	TrackNumberValidator func(int) error
+	// DefaultDiscNumber holds the default value on creation for the "disc_number" field.
+	DefaultDiscNumber int
+	// DiscNumberValidator is a validator for the "disc_number" field. It is called by the builders before save.
+	DiscNumberValidator func(int) error
+	// DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	DurationMsValidator func(int64) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// VersionType defines the type for the "version_type" enum field.
+type VersionType string
+
+// VersionTypeOriginal is the default value of the VersionType enum.
+const DefaultVersionType = VersionTypeOriginal
+
+// VersionType values.
+const (
+	VersionTypeOriginal VersionType = "original"
+	VersionTypeRemaster VersionType = "remaster"
+	VersionTypeLive     VersionType = "live"
+	VersionTypeRemix    VersionType = "remix"
+	VersionTypeAcoustic VersionType = "acoustic"
+	VersionTypeEdit     VersionType = "edit"
+)
+
+func (vt VersionType) String() string {
+	return string(vt)
+}
+
+// VersionTypeValidator is a validator for the "version_type" field enum values. It is called by the builders before save.
+func VersionTypeValidator(vt VersionType) error {
+	switch vt {
+	case VersionTypeOriginal, VersionTypeRemaster, VersionTypeLive, VersionTypeRemix, VersionTypeAcoustic, VersionTypeEdit:
+		return nil
+	default:
+		return fmt.Errorf("track: invalid enum value for version_type field: %q", vt)
+	}
+}
+
 // OrderOption defines the ordering options for the Track queries.
 type OrderOption func(*sql.Selector)
 
@@ -82,22 +184,106 @@ func ByAlbumID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAlbumID, opts...).ToFunc()
 }
 
+// ByTrackNumber orders the results by the track_number field.
+func ByTrackNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackNumber, opts...).ToFunc()
+}
+
+// ByDiscNumber orders the results by the disc_number field.
+func ByDiscNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscNumber, opts...).ToFunc()
+}
+
 // ByURL orders the results by the url field.
 func ByURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURL, opts...).ToFunc()
 }
 
+// ByAudioKey orders the results by the audio_key field.
+func ByAudioKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioKey, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByCanonicalTrackID orders the results by the canonical_track_id field.
+func ByCanonicalTrackID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanonicalTrackID, opts...).ToFunc()
+}
+
+// ByVersionType orders the results by the version_type field.
+func ByVersionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionType, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByAlbumField orders the results by album field.
 func ByAlbumField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newAlbumStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPlaysCount orders the results by plays count.
+func ByPlaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaysStep(), opts...)
+	}
+}
+
+// ByPlays orders the results by plays terms.
+func ByPlays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPlaylistsCount orders the results by playlists count.
+func ByPlaylistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaylistsStep(), opts...)
+	}
+}
+
+// ByPlaylists orders the results by playlists terms.
+func ByPlaylists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaylistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCanonicalField orders the results by canonical field.
+func ByCanonicalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCanonicalStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVersionsCount orders the results by versions count.
+func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVersionsStep(), opts...)
+	}
+}
+
+// ByVersions orders the results by versions terms.
+func ByVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAlbumStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -105,3 +291,31 @@ func newAlbumStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, AlbumTable, AlbumColumn),
 	)
 }
+func newPlaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PlaysTable, PlaysColumn),
+	)
+}
+func newPlaylistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaylistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, PlaylistsTable, PlaylistsPrimaryKey...),
+	)
+}
+func newCanonicalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CanonicalTable, CanonicalColumn),
+	)
+}
+func newVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+	)
+}
@@ -66,16 +66,46 @@ func AlbumID(v uuid.UUID) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldAlbumID, v))
 }
 
+// TrackNumber applies equality check predicate on the "track_number" field. It's identical to TrackNumberEQ.
+func TrackNumber(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldTrackNumber, v))
+}
+
+// DiscNumber applies equality check predicate on the "disc_number" field. It's identical to DiscNumberEQ.
+func DiscNumber(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDiscNumber, v))
+}
+
 // URL applies equality check predicate on the "url" field. It's identical to URLEQ.
 func URL(v string) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldURL, v))
 }
 
+// AudioKey applies equality check predicate on the "audio_key" field. It's identical to AudioKeyEQ.
+func AudioKey(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldAudioKey, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CanonicalTrackID applies equality check predicate on the "canonical_track_id" field. It's identical to CanonicalTrackIDEQ.
+func CanonicalTrackID(v uuid.UUID) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldCanonicalTrackID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldTitle, v))
@@ -161,6 +191,96 @@ func AlbumIDNotIn(vs ...uuid.UUID) predicate.Track {
 	return predicate.Track(sql.FieldNotIn(FieldAlbumID, vs...))
 }
 
+// TrackNumberEQ applies the EQ predicate on the "track_number" field.
+func TrackNumberEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldTrackNumber, v))
+}
+
+// TrackNumberNEQ applies the NEQ predicate on the "track_number" field.
+func TrackNumberNEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldTrackNumber, v))
+}
+
+// TrackNumberIn applies the In predicate on the "track_number" field.
+func TrackNumberIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldTrackNumber, vs...))
+}
+
+// TrackNumberNotIn applies the NotIn predicate on the "track_number" field.
+func TrackNumberNotIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldTrackNumber, vs...))
+}
+
+// TrackNumberGT applies the GT predicate on the "track_number" field.
+func TrackNumberGT(v int) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldTrackNumber, v))
+}
+
+// TrackNumberGTE applies the GTE predicate on the "track_number" field.
+func TrackNumberGTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldTrackNumber, v))
+}
+
+// TrackNumberLT applies the LT predicate on the "track_number" field.
+func TrackNumberLT(v int) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldTrackNumber, v))
+}
+
+// TrackNumberLTE applies the LTE predicate on the "track_number" field.
+func TrackNumberLTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldTrackNumber, v))
+}
+
+// TrackNumberIsNil applies the IsNil predicate on the "track_number" field.
+func TrackNumberIsNil() predicate.Track {
+	return predicate.Track(sql.FieldIsNull(FieldTrackNumber))
+}
+
+// TrackNumberNotNil applies the NotNil predicate on the "track_number" field.
+func TrackNumberNotNil() predicate.Track {
+	return predicate.Track(sql.FieldNotNull(FieldTrackNumber))
+}
+
+// DiscNumberEQ applies the EQ predicate on the "disc_number" field.
+func DiscNumberEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDiscNumber, v))
+}
+
+// DiscNumberNEQ applies the NEQ predicate on the "disc_number" field.
+func DiscNumberNEQ(v int) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldDiscNumber, v))
+}
+
+// DiscNumberIn applies the In predicate on the "disc_number" field.
+func DiscNumberIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldDiscNumber, vs...))
+}
+
+// DiscNumberNotIn applies the NotIn predicate on the "disc_number" field.
+func DiscNumberNotIn(vs ...int) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldDiscNumber, vs...))
+}
+
+// DiscNumberGT applies the GT predicate on the "disc_number" field.
+func DiscNumberGT(v int) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldDiscNumber, v))
+}
+
+// DiscNumberGTE applies the GTE predicate on the "disc_number" field.
+func DiscNumberGTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldDiscNumber, v))
+}
+
+// DiscNumberLT applies the LT predicate on the "disc_number" field.
+func DiscNumberLT(v int) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldDiscNumber, v))
+}
+
+// DiscNumberLTE applies the LTE predicate on the "disc_number" field.
+func DiscNumberLTE(v int) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldDiscNumber, v))
+}
+
 // URLEQ applies the EQ predicate on the "url" field.
 func URLEQ(v string) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldURL, v))
@@ -236,6 +356,181 @@ func URLContainsFold(v string) predicate.Track {
 	return predicate.Track(sql.FieldContainsFold(FieldURL, v))
 }
 
+// AudioKeyEQ applies the EQ predicate on the "audio_key" field.
+func AudioKeyEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldAudioKey, v))
+}
+
+// AudioKeyNEQ applies the NEQ predicate on the "audio_key" field.
+func AudioKeyNEQ(v string) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldAudioKey, v))
+}
+
+// AudioKeyIn applies the In predicate on the "audio_key" field.
+func AudioKeyIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldAudioKey, vs...))
+}
+
+// AudioKeyNotIn applies the NotIn predicate on the "audio_key" field.
+func AudioKeyNotIn(vs ...string) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldAudioKey, vs...))
+}
+
+// AudioKeyGT applies the GT predicate on the "audio_key" field.
+func AudioKeyGT(v string) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldAudioKey, v))
+}
+
+// AudioKeyGTE applies the GTE predicate on the "audio_key" field.
+func AudioKeyGTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldAudioKey, v))
+}
+
+// AudioKeyLT applies the LT predicate on the "audio_key" field.
+func AudioKeyLT(v string) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldAudioKey, v))
+}
+
+// AudioKeyLTE applies the LTE predicate on the "audio_key" field.
+func AudioKeyLTE(v string) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldAudioKey, v))
+}
+
+// AudioKeyContains applies the Contains predicate on the "audio_key" field.
+func AudioKeyContains(v string) predicate.Track {
+	return predicate.Track(sql.FieldContains(FieldAudioKey, v))
+}
+
+// AudioKeyHasPrefix applies the HasPrefix predicate on the "audio_key" field.
+func AudioKeyHasPrefix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasPrefix(FieldAudioKey, v))
+}
+
+// AudioKeyHasSuffix applies the HasSuffix predicate on the "audio_key" field.
+func AudioKeyHasSuffix(v string) predicate.Track {
+	return predicate.Track(sql.FieldHasSuffix(FieldAudioKey, v))
+}
+
+// AudioKeyIsNil applies the IsNil predicate on the "audio_key" field.
+func AudioKeyIsNil() predicate.Track {
+	return predicate.Track(sql.FieldIsNull(FieldAudioKey))
+}
+
+// AudioKeyNotNil applies the NotNil predicate on the "audio_key" field.
+func AudioKeyNotNil() predicate.Track {
+	return predicate.Track(sql.FieldNotNull(FieldAudioKey))
+}
+
+// AudioKeyEqualFold applies the EqualFold predicate on the "audio_key" field.
+func AudioKeyEqualFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldEqualFold(FieldAudioKey, v))
+}
+
+// AudioKeyContainsFold applies the ContainsFold predicate on the "audio_key" field.
+func AudioKeyContainsFold(v string) predicate.Track {
+	return predicate.Track(sql.FieldContainsFold(FieldAudioKey, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.Track {
+	return predicate.Track(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.Track {
+	return predicate.Track(sql.FieldNotNull(FieldDurationMs))
+}
+
+// CanonicalTrackIDEQ applies the EQ predicate on the "canonical_track_id" field.
+func CanonicalTrackIDEQ(v uuid.UUID) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldCanonicalTrackID, v))
+}
+
+// CanonicalTrackIDNEQ applies the NEQ predicate on the "canonical_track_id" field.
+func CanonicalTrackIDNEQ(v uuid.UUID) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldCanonicalTrackID, v))
+}
+
+// CanonicalTrackIDIn applies the In predicate on the "canonical_track_id" field.
+func CanonicalTrackIDIn(vs ...uuid.UUID) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldCanonicalTrackID, vs...))
+}
+
+// CanonicalTrackIDNotIn applies the NotIn predicate on the "canonical_track_id" field.
+func CanonicalTrackIDNotIn(vs ...uuid.UUID) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldCanonicalTrackID, vs...))
+}
+
+// CanonicalTrackIDIsNil applies the IsNil predicate on the "canonical_track_id" field.
+func CanonicalTrackIDIsNil() predicate.Track {
+	return predicate.Track(sql.FieldIsNull(FieldCanonicalTrackID))
+}
+
+// CanonicalTrackIDNotNil applies the NotNil predicate on the "canonical_track_id" field.
+func CanonicalTrackIDNotNil() predicate.Track {
+	return predicate.Track(sql.FieldNotNull(FieldCanonicalTrackID))
+}
+
+// VersionTypeEQ applies the EQ predicate on the "version_type" field.
+func VersionTypeEQ(v VersionType) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldVersionType, v))
+}
+
+// VersionTypeNEQ applies the NEQ predicate on the "version_type" field.
+func VersionTypeNEQ(v VersionType) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldVersionType, v))
+}
+
+// VersionTypeIn applies the In predicate on the "version_type" field.
+func VersionTypeIn(vs ...VersionType) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldVersionType, vs...))
+}
+
+// VersionTypeNotIn applies the NotIn predicate on the "version_type" field.
+func VersionTypeNotIn(vs ...VersionType) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldVersionType, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Track {
 	return predicate.Track(sql.FieldEQ(FieldCreatedAt, v))
@@ -276,6 +571,56 @@ func CreatedAtLTE(v time.Time) predicate.Track {
 	return predicate.Track(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Track {
+	return predicate.Track(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Track {
+	return predicate.Track(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Track {
+	return predicate.Track(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Track {
+	return predicate.Track(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Track {
+	return predicate.Track(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // HasAlbum applies the HasEdge predicate on the "album" edge.
 func HasAlbum() predicate.Track {
 	return predicate.Track(func(s *sql.Selector) {
@@ -299,6 +644,98 @@ func HasAlbumWith(preds ...predicate.Album) predicate.Track {
 	})
 }
 
+// HasPlays applies the HasEdge predicate on the "plays" edge.
+func HasPlays() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PlaysTable, PlaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaysWith applies the HasEdge predicate on the "plays" edge with a given conditions (other predicates).
+func HasPlaysWith(preds ...predicate.Play) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newPlaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPlaylists applies the HasEdge predicate on the "playlists" edge.
+func HasPlaylists() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, PlaylistsTable, PlaylistsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaylistsWith applies the HasEdge predicate on the "playlists" edge with a given conditions (other predicates).
+func HasPlaylistsWith(preds ...predicate.Playlist) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newPlaylistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCanonical applies the HasEdge predicate on the "canonical" edge.
+func HasCanonical() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CanonicalTable, CanonicalColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCanonicalWith applies the HasEdge predicate on the "canonical" edge with a given conditions (other predicates).
+func HasCanonicalWith(preds ...predicate.Track) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newCanonicalStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.Track) predicate.Track {
+	return predicate.Track(func(s *sql.Selector) {
+		step := newVersionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Track) predicate.Track {
 	return predicate.Track(sql.AndPredicates(predicates...))
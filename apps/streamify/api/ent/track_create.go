@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"streamify/ent/album"
+	"streamify/ent/play"
+	"streamify/ent/playlist"
 	"streamify/ent/track"
 	"time"
 
@@ -34,6 +36,34 @@ func (_c *TrackCreate) SetAlbumID(v uuid.UUID) *TrackCreate {
 	return _c
 }
 
+// SetTrackNumber sets the "track_number" field.
+func (_c *TrackCreate) SetTrackNumber(v int) *TrackCreate {
+	_c.mutation.SetTrackNumber(v)
+	return _c
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableTrackNumber(v *int) *TrackCreate {
+	if v != nil {
+		_c.SetTrackNumber(*v)
+	}
+	return _c
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (_c *TrackCreate) SetDiscNumber(v int) *TrackCreate {
+	_c.mutation.SetDiscNumber(v)
+	return _c
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableDiscNumber(v *int) *TrackCreate {
+	if v != nil {
+		_c.SetDiscNumber(*v)
+	}
+	return _c
+}
+
 // SetURL sets the "url" field.
 func (_c *TrackCreate) SetURL(v string) *TrackCreate {
 	_c.mutation.SetURL(v)
@@ -48,6 +78,62 @@ func (_c *TrackCreate) SetNillableURL(v *string) *TrackCreate {
 	return _c
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (_c *TrackCreate) SetAudioKey(v string) *TrackCreate {
+	_c.mutation.SetAudioKey(v)
+	return _c
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableAudioKey(v *string) *TrackCreate {
+	if v != nil {
+		_c.SetAudioKey(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *TrackCreate) SetDurationMs(v int64) *TrackCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableDurationMs(v *int64) *TrackCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetCanonicalTrackID sets the "canonical_track_id" field.
+func (_c *TrackCreate) SetCanonicalTrackID(v uuid.UUID) *TrackCreate {
+	_c.mutation.SetCanonicalTrackID(v)
+	return _c
+}
+
+// SetNillableCanonicalTrackID sets the "canonical_track_id" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableCanonicalTrackID(v *uuid.UUID) *TrackCreate {
+	if v != nil {
+		_c.SetCanonicalTrackID(*v)
+	}
+	return _c
+}
+
+// SetVersionType sets the "version_type" field.
+func (_c *TrackCreate) SetVersionType(v track.VersionType) *TrackCreate {
+	_c.mutation.SetVersionType(v)
+	return _c
+}
+
+// SetNillableVersionType sets the "version_type" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableVersionType(v *track.VersionType) *TrackCreate {
+	if v != nil {
+		_c.SetVersionType(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TrackCreate) SetCreatedAt(v time.Time) *TrackCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -62,6 +148,20 @@ func (_c *TrackCreate) SetNillableCreatedAt(v *time.Time) *TrackCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TrackCreate) SetDeletedAt(v time.Time) *TrackCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TrackCreate) SetNillableDeletedAt(v *time.Time) *TrackCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TrackCreate) SetID(v uuid.UUID) *TrackCreate {
 	_c.mutation.SetID(v)
@@ -81,6 +181,70 @@ func (_c *TrackCreate) SetAlbum(v *Album) *TrackCreate {
 	return _c.SetAlbumID(v.ID)
 }
 
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_c *TrackCreate) AddPlayIDs(ids ...uuid.UUID) *TrackCreate {
+	_c.mutation.AddPlayIDs(ids...)
+	return _c
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_c *TrackCreate) AddPlays(v ...*Play) *TrackCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (_c *TrackCreate) AddPlaylistIDs(ids ...uuid.UUID) *TrackCreate {
+	_c.mutation.AddPlaylistIDs(ids...)
+	return _c
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (_c *TrackCreate) AddPlaylists(v ...*Playlist) *TrackCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPlaylistIDs(ids...)
+}
+
+// SetCanonicalID sets the "canonical" edge to the Track entity by ID.
+func (_c *TrackCreate) SetCanonicalID(id uuid.UUID) *TrackCreate {
+	_c.mutation.SetCanonicalID(id)
+	return _c
+}
+
+// SetNillableCanonicalID sets the "canonical" edge to the Track entity by ID if the given value is not nil.
+func (_c *TrackCreate) SetNillableCanonicalID(id *uuid.UUID) *TrackCreate {
+	if id != nil {
+		_c = _c.SetCanonicalID(*id)
+	}
+	return _c
+}
+
+// SetCanonical sets the "canonical" edge to the Track entity.
+func (_c *TrackCreate) SetCanonical(v *Track) *TrackCreate {
+	return _c.SetCanonicalID(v.ID)
+}
+
+// AddVersionIDs adds the "versions" edge to the Track entity by IDs.
+func (_c *TrackCreate) AddVersionIDs(ids ...uuid.UUID) *TrackCreate {
+	_c.mutation.AddVersionIDs(ids...)
+	return _c
+}
+
+// AddVersions adds the "versions" edges to the Track entity.
+func (_c *TrackCreate) AddVersions(v ...*Track) *TrackCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVersionIDs(ids...)
+}
+
 // Mutation returns the TrackMutation object of the builder.
 func (_c *TrackCreate) Mutation() *TrackMutation {
 	return _c.mutation
@@ -88,7 +252,9 @@ func (_c *TrackCreate) Mutation() *TrackMutation {
 
 // Save creates the Track in the database.
 func (_c *TrackCreate) Save(ctx context.Context) (*Track, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -115,15 +281,30 @@ func (_c *TrackCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TrackCreate) defaults() {
+func (_c *TrackCreate) defaults() error {
+	if _, ok := _c.mutation.DiscNumber(); !ok {
+		v := track.DefaultDiscNumber
+		_c.mutation.SetDiscNumber(v)
+	}
+	if _, ok := _c.mutation.VersionType(); !ok {
+		v := track.DefaultVersionType
+		_c.mutation.SetVersionType(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if track.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized track.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := track.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if track.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized track.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := track.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -139,6 +320,32 @@ func (_c *TrackCreate) check() error {
 	if _, ok := _c.mutation.AlbumID(); !ok {
 		return &ValidationError{Name: "album_id", err: errors.New(`ent: missing required field "Track.album_id"`)}
 	}
+	if v, ok := _c.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscNumber(); !ok {
+		return &ValidationError{Name: "disc_number", err: errors.New(`ent: missing required field "Track.disc_number"`)}
+	}
+	if v, ok := _c.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VersionType(); !ok {
+		return &ValidationError{Name: "version_type", err: errors.New(`ent: missing required field "Track.version_type"`)}
+	}
+	if v, ok := _c.mutation.VersionType(); ok {
+		if err := track.VersionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "version_type", err: fmt.Errorf(`ent: validator failed for field "Track.version_type": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Track.created_at"`)}
 	}
@@ -184,14 +391,38 @@ func (_c *TrackCreate) createSpec() (*Track, *sqlgraph.CreateSpec) {
 		_spec.SetField(track.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+		_node.TrackNumber = value
+	}
+	if value, ok := _c.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+		_node.DiscNumber = value
+	}
 	if value, ok := _c.mutation.URL(); ok {
 		_spec.SetField(track.FieldURL, field.TypeString, value)
 		_node.URL = value
 	}
+	if value, ok := _c.mutation.AudioKey(); ok {
+		_spec.SetField(track.FieldAudioKey, field.TypeString, value)
+		_node.AudioKey = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = &value
+	}
+	if value, ok := _c.mutation.VersionType(); ok {
+		_spec.SetField(track.FieldVersionType, field.TypeEnum, value)
+		_node.VersionType = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(track.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(track.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.AlbumIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -209,6 +440,71 @@ func (_c *TrackCreate) createSpec() (*Track, *sqlgraph.CreateSpec) {
 		_node.AlbumID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CanonicalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   track.CanonicalTable,
+			Columns: []string{track.CanonicalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CanonicalTrackID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,9 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 	"streamify/ent/album"
+	"streamify/ent/play"
+	"streamify/ent/playlist"
 	"streamify/ent/predicate"
 	"streamify/ent/track"
 
@@ -20,11 +24,15 @@ import (
 // TrackQuery is the builder for querying Track entities.
 type TrackQuery struct {
 	config
-	ctx        *QueryContext
-	order      []track.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Track
-	withAlbum  *AlbumQuery
+	ctx           *QueryContext
+	order         []track.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Track
+	withAlbum     *AlbumQuery
+	withPlays     *PlayQuery
+	withPlaylists *PlaylistQuery
+	withCanonical *TrackQuery
+	withVersions  *TrackQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +91,94 @@ func (_q *TrackQuery) QueryAlbum() *AlbumQuery {
 	return query
 }
 
+// QueryPlays chains the current query on the "plays" edge.
+func (_q *TrackQuery) QueryPlays() *PlayQuery {
+	query := (&PlayClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, selector),
+			sqlgraph.To(play.Table, play.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, track.PlaysTable, track.PlaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPlaylists chains the current query on the "playlists" edge.
+func (_q *TrackQuery) QueryPlaylists() *PlaylistQuery {
+	query := (&PlaylistClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, selector),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, track.PlaylistsTable, track.PlaylistsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCanonical chains the current query on the "canonical" edge.
+func (_q *TrackQuery) QueryCanonical() *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, track.CanonicalTable, track.CanonicalColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVersions chains the current query on the "versions" edge.
+func (_q *TrackQuery) QueryVersions() *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(track.Table, track.FieldID, selector),
+			sqlgraph.To(track.Table, track.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, track.VersionsTable, track.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Track entity from the query.
 // Returns a *NotFoundError when no Track was found.
 func (_q *TrackQuery) First(ctx context.Context) (*Track, error) {
@@ -270,12 +366,16 @@ func (_q *TrackQuery) Clone() *TrackQuery {
 		return nil
 	}
 	return &TrackQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]track.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Track{}, _q.predicates...),
-		withAlbum:  _q.withAlbum.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]track.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.Track{}, _q.predicates...),
+		withAlbum:     _q.withAlbum.Clone(),
+		withPlays:     _q.withPlays.Clone(),
+		withPlaylists: _q.withPlaylists.Clone(),
+		withCanonical: _q.withCanonical.Clone(),
+		withVersions:  _q.withVersions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +393,50 @@ func (_q *TrackQuery) WithAlbum(opts ...func(*AlbumQuery)) *TrackQuery {
 	return _q
 }
 
+// WithPlays tells the query-builder to eager-load the nodes that are connected to
+// the "plays" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TrackQuery) WithPlays(opts ...func(*PlayQuery)) *TrackQuery {
+	query := (&PlayClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlays = query
+	return _q
+}
+
+// WithPlaylists tells the query-builder to eager-load the nodes that are connected to
+// the "playlists" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TrackQuery) WithPlaylists(opts ...func(*PlaylistQuery)) *TrackQuery {
+	query := (&PlaylistClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlaylists = query
+	return _q
+}
+
+// WithCanonical tells the query-builder to eager-load the nodes that are connected to
+// the "canonical" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TrackQuery) WithCanonical(opts ...func(*TrackQuery)) *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCanonical = query
+	return _q
+}
+
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TrackQuery) WithVersions(opts ...func(*TrackQuery)) *TrackQuery {
+	query := (&TrackClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVersions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -364,6 +508,12 @@ func (_q *TrackQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if track.Policy == nil {
+		return errors.New("ent: uninitialized track.Policy (forgotten import ent/runtime?)")
+	}
+	if err := track.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -371,8 +521,12 @@ func (_q *TrackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Track,
 	var (
 		nodes       = []*Track{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			_q.withAlbum != nil,
+			_q.withPlays != nil,
+			_q.withPlaylists != nil,
+			_q.withCanonical != nil,
+			_q.withVersions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -399,6 +553,33 @@ func (_q *TrackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Track,
 			return nil, err
 		}
 	}
+	if query := _q.withPlays; query != nil {
+		if err := _q.loadPlays(ctx, query, nodes,
+			func(n *Track) { n.Edges.Plays = []*Play{} },
+			func(n *Track, e *Play) { n.Edges.Plays = append(n.Edges.Plays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPlaylists; query != nil {
+		if err := _q.loadPlaylists(ctx, query, nodes,
+			func(n *Track) { n.Edges.Playlists = []*Playlist{} },
+			func(n *Track, e *Playlist) { n.Edges.Playlists = append(n.Edges.Playlists, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCanonical; query != nil {
+		if err := _q.loadCanonical(ctx, query, nodes, nil,
+			func(n *Track, e *Track) { n.Edges.Canonical = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVersions; query != nil {
+		if err := _q.loadVersions(ctx, query, nodes,
+			func(n *Track) { n.Edges.Versions = []*Track{} },
+			func(n *Track, e *Track) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,6 +612,162 @@ func (_q *TrackQuery) loadAlbum(ctx context.Context, query *AlbumQuery, nodes []
 	}
 	return nil
 }
+func (_q *TrackQuery) loadPlays(ctx context.Context, query *PlayQuery, nodes []*Track, init func(*Track), assign func(*Track, *Play)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Track)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(play.FieldTrackID)
+	}
+	query.Where(predicate.Play(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(track.PlaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TrackID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "track_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TrackQuery) loadPlaylists(ctx context.Context, query *PlaylistQuery, nodes []*Track, init func(*Track), assign func(*Track, *Playlist)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Track)
+	nids := make(map[uuid.UUID]map[*Track]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(track.PlaylistsTable)
+		s.Join(joinT).On(s.C(playlist.FieldID), joinT.C(track.PlaylistsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(track.PlaylistsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(track.PlaylistsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Track]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Playlist](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "playlists" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *TrackQuery) loadCanonical(ctx context.Context, query *TrackQuery, nodes []*Track, init func(*Track), assign func(*Track, *Track)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Track)
+	for i := range nodes {
+		if nodes[i].CanonicalTrackID == nil {
+			continue
+		}
+		fk := *nodes[i].CanonicalTrackID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(track.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "canonical_track_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TrackQuery) loadVersions(ctx context.Context, query *TrackQuery, nodes []*Track, init func(*Track), assign func(*Track, *Track)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Track)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(track.FieldCanonicalTrackID)
+	}
+	query.Where(predicate.Track(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(track.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CanonicalTrackID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "canonical_track_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "canonical_track_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TrackQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -460,6 +797,9 @@ func (_q *TrackQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withAlbum != nil {
 			_spec.Node.AddColumnOnce(track.FieldAlbumID)
 		}
+		if _q.withCanonical != nil {
+			_spec.Node.AddColumnOnce(track.FieldCanonicalTrackID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"streamify/ent/album"
+	"streamify/ent/play"
+	"streamify/ent/playlist"
 	"streamify/ent/predicate"
 	"streamify/ent/track"
 	"time"
@@ -58,6 +60,54 @@ func (_u *TrackUpdate) SetNillableAlbumID(v *uuid.UUID) *TrackUpdate {
 	return _u
 }
 
+// SetTrackNumber sets the "track_number" field.
+func (_u *TrackUpdate) SetTrackNumber(v int) *TrackUpdate {
+	_u.mutation.ResetTrackNumber()
+	_u.mutation.SetTrackNumber(v)
+	return _u
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableTrackNumber(v *int) *TrackUpdate {
+	if v != nil {
+		_u.SetTrackNumber(*v)
+	}
+	return _u
+}
+
+// AddTrackNumber adds value to the "track_number" field.
+func (_u *TrackUpdate) AddTrackNumber(v int) *TrackUpdate {
+	_u.mutation.AddTrackNumber(v)
+	return _u
+}
+
+// ClearTrackNumber clears the value of the "track_number" field.
+func (_u *TrackUpdate) ClearTrackNumber() *TrackUpdate {
+	_u.mutation.ClearTrackNumber()
+	return _u
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (_u *TrackUpdate) SetDiscNumber(v int) *TrackUpdate {
+	_u.mutation.ResetDiscNumber()
+	_u.mutation.SetDiscNumber(v)
+	return _u
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableDiscNumber(v *int) *TrackUpdate {
+	if v != nil {
+		_u.SetDiscNumber(*v)
+	}
+	return _u
+}
+
+// AddDiscNumber adds value to the "disc_number" field.
+func (_u *TrackUpdate) AddDiscNumber(v int) *TrackUpdate {
+	_u.mutation.AddDiscNumber(v)
+	return _u
+}
+
 // SetURL sets the "url" field.
 func (_u *TrackUpdate) SetURL(v string) *TrackUpdate {
 	_u.mutation.SetURL(v)
@@ -78,6 +128,87 @@ func (_u *TrackUpdate) ClearURL() *TrackUpdate {
 	return _u
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (_u *TrackUpdate) SetAudioKey(v string) *TrackUpdate {
+	_u.mutation.SetAudioKey(v)
+	return _u
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableAudioKey(v *string) *TrackUpdate {
+	if v != nil {
+		_u.SetAudioKey(*v)
+	}
+	return _u
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (_u *TrackUpdate) ClearAudioKey() *TrackUpdate {
+	_u.mutation.ClearAudioKey()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TrackUpdate) SetDurationMs(v int64) *TrackUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableDurationMs(v *int64) *TrackUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TrackUpdate) AddDurationMs(v int64) *TrackUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TrackUpdate) ClearDurationMs() *TrackUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetCanonicalTrackID sets the "canonical_track_id" field.
+func (_u *TrackUpdate) SetCanonicalTrackID(v uuid.UUID) *TrackUpdate {
+	_u.mutation.SetCanonicalTrackID(v)
+	return _u
+}
+
+// SetNillableCanonicalTrackID sets the "canonical_track_id" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableCanonicalTrackID(v *uuid.UUID) *TrackUpdate {
+	if v != nil {
+		_u.SetCanonicalTrackID(*v)
+	}
+	return _u
+}
+
+// ClearCanonicalTrackID clears the value of the "canonical_track_id" field.
+func (_u *TrackUpdate) ClearCanonicalTrackID() *TrackUpdate {
+	_u.mutation.ClearCanonicalTrackID()
+	return _u
+}
+
+// SetVersionType sets the "version_type" field.
+func (_u *TrackUpdate) SetVersionType(v track.VersionType) *TrackUpdate {
+	_u.mutation.SetVersionType(v)
+	return _u
+}
+
+// SetNillableVersionType sets the "version_type" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableVersionType(v *track.VersionType) *TrackUpdate {
+	if v != nil {
+		_u.SetVersionType(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TrackUpdate) SetCreatedAt(v time.Time) *TrackUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -92,11 +223,95 @@ func (_u *TrackUpdate) SetNillableCreatedAt(v *time.Time) *TrackUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TrackUpdate) SetDeletedAt(v time.Time) *TrackUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TrackUpdate) SetNillableDeletedAt(v *time.Time) *TrackUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TrackUpdate) ClearDeletedAt() *TrackUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetAlbum sets the "album" edge to the Album entity.
 func (_u *TrackUpdate) SetAlbum(v *Album) *TrackUpdate {
 	return _u.SetAlbumID(v.ID)
 }
 
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_u *TrackUpdate) AddPlayIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.AddPlayIDs(ids...)
+	return _u
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_u *TrackUpdate) AddPlays(v ...*Play) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (_u *TrackUpdate) AddPlaylistIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.AddPlaylistIDs(ids...)
+	return _u
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (_u *TrackUpdate) AddPlaylists(v ...*Playlist) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlaylistIDs(ids...)
+}
+
+// SetCanonicalID sets the "canonical" edge to the Track entity by ID.
+func (_u *TrackUpdate) SetCanonicalID(id uuid.UUID) *TrackUpdate {
+	_u.mutation.SetCanonicalID(id)
+	return _u
+}
+
+// SetNillableCanonicalID sets the "canonical" edge to the Track entity by ID if the given value is not nil.
+func (_u *TrackUpdate) SetNillableCanonicalID(id *uuid.UUID) *TrackUpdate {
+	if id != nil {
+		_u = _u.SetCanonicalID(*id)
+	}
+	return _u
+}
+
+// SetCanonical sets the "canonical" edge to the Track entity.
+func (_u *TrackUpdate) SetCanonical(v *Track) *TrackUpdate {
+	return _u.SetCanonicalID(v.ID)
+}
+
+// AddVersionIDs adds the "versions" edge to the Track entity by IDs.
+func (_u *TrackUpdate) AddVersionIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the Track entity.
+func (_u *TrackUpdate) AddVersions(v ...*Track) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
 // Mutation returns the TrackMutation object of the builder.
 func (_u *TrackUpdate) Mutation() *TrackMutation {
 	return _u.mutation
@@ -108,6 +323,75 @@ func (_u *TrackUpdate) ClearAlbum() *TrackUpdate {
 	return _u
 }
 
+// ClearPlays clears all "plays" edges to the Play entity.
+func (_u *TrackUpdate) ClearPlays() *TrackUpdate {
+	_u.mutation.ClearPlays()
+	return _u
+}
+
+// RemovePlayIDs removes the "plays" edge to Play entities by IDs.
+func (_u *TrackUpdate) RemovePlayIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.RemovePlayIDs(ids...)
+	return _u
+}
+
+// RemovePlays removes "plays" edges to Play entities.
+func (_u *TrackUpdate) RemovePlays(v ...*Play) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlayIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (_u *TrackUpdate) ClearPlaylists() *TrackUpdate {
+	_u.mutation.ClearPlaylists()
+	return _u
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (_u *TrackUpdate) RemovePlaylistIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.RemovePlaylistIDs(ids...)
+	return _u
+}
+
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (_u *TrackUpdate) RemovePlaylists(v ...*Playlist) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlaylistIDs(ids...)
+}
+
+// ClearCanonical clears the "canonical" edge to the Track entity.
+func (_u *TrackUpdate) ClearCanonical() *TrackUpdate {
+	_u.mutation.ClearCanonical()
+	return _u
+}
+
+// ClearVersions clears all "versions" edges to the Track entity.
+func (_u *TrackUpdate) ClearVersions() *TrackUpdate {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to Track entities by IDs.
+func (_u *TrackUpdate) RemoveVersionIDs(ids ...uuid.UUID) *TrackUpdate {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to Track entities.
+func (_u *TrackUpdate) RemoveVersions(v ...*Track) *TrackUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TrackUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -142,6 +426,26 @@ func (_u *TrackUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Track.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VersionType(); ok {
+		if err := track.VersionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "version_type", err: fmt.Errorf(`ent: validator failed for field "Track.version_type": %w`, err)}
+		}
+	}
 	if _u.mutation.AlbumCleared() && len(_u.mutation.AlbumIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Track.album"`)
 	}
@@ -163,15 +467,54 @@ func (_u *TrackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(track.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrackNumber(); ok {
+		_spec.AddField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if _u.mutation.TrackNumberCleared() {
+		_spec.ClearField(track.FieldTrackNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscNumber(); ok {
+		_spec.AddField(track.FieldDiscNumber, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(track.FieldURL, field.TypeString, value)
 	}
 	if _u.mutation.URLCleared() {
 		_spec.ClearField(track.FieldURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.AudioKey(); ok {
+		_spec.SetField(track.FieldAudioKey, field.TypeString, value)
+	}
+	if _u.mutation.AudioKeyCleared() {
+		_spec.ClearField(track.FieldAudioKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(track.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(track.FieldDurationMs, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.VersionType(); ok {
+		_spec.SetField(track.FieldVersionType, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(track.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(track.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(track.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.AlbumCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -201,6 +544,170 @@ func (_u *TrackUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaysIDs(); len(nodes) > 0 && !_u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !_u.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CanonicalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   track.CanonicalTable,
+			Columns: []string{track.CanonicalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CanonicalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   track.CanonicalTable,
+			Columns: []string{track.CanonicalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{track.Label}
@@ -227,28 +734,76 @@ func (_u *TrackUpdateOne) SetTitle(v string) *TrackUpdateOne {
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *TrackUpdateOne) SetNillableTitle(v *string) *TrackUpdateOne {
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableTitle(v *string) *TrackUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAlbumID sets the "album_id" field.
+func (_u *TrackUpdateOne) SetAlbumID(v uuid.UUID) *TrackUpdateOne {
+	_u.mutation.SetAlbumID(v)
+	return _u
+}
+
+// SetNillableAlbumID sets the "album_id" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableAlbumID(v *uuid.UUID) *TrackUpdateOne {
+	if v != nil {
+		_u.SetAlbumID(*v)
+	}
+	return _u
+}
+
+// SetTrackNumber sets the "track_number" field.
+func (_u *TrackUpdateOne) SetTrackNumber(v int) *TrackUpdateOne {
+	_u.mutation.ResetTrackNumber()
+	_u.mutation.SetTrackNumber(v)
+	return _u
+}
+
+// SetNillableTrackNumber sets the "track_number" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableTrackNumber(v *int) *TrackUpdateOne {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetTrackNumber(*v)
 	}
 	return _u
 }
 
-// SetAlbumID sets the "album_id" field.
-func (_u *TrackUpdateOne) SetAlbumID(v uuid.UUID) *TrackUpdateOne {
-	_u.mutation.SetAlbumID(v)
+// AddTrackNumber adds value to the "track_number" field.
+func (_u *TrackUpdateOne) AddTrackNumber(v int) *TrackUpdateOne {
+	_u.mutation.AddTrackNumber(v)
 	return _u
 }
 
-// SetNillableAlbumID sets the "album_id" field if the given value is not nil.
-func (_u *TrackUpdateOne) SetNillableAlbumID(v *uuid.UUID) *TrackUpdateOne {
+// ClearTrackNumber clears the value of the "track_number" field.
+func (_u *TrackUpdateOne) ClearTrackNumber() *TrackUpdateOne {
+	_u.mutation.ClearTrackNumber()
+	return _u
+}
+
+// SetDiscNumber sets the "disc_number" field.
+func (_u *TrackUpdateOne) SetDiscNumber(v int) *TrackUpdateOne {
+	_u.mutation.ResetDiscNumber()
+	_u.mutation.SetDiscNumber(v)
+	return _u
+}
+
+// SetNillableDiscNumber sets the "disc_number" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableDiscNumber(v *int) *TrackUpdateOne {
 	if v != nil {
-		_u.SetAlbumID(*v)
+		_u.SetDiscNumber(*v)
 	}
 	return _u
 }
 
+// AddDiscNumber adds value to the "disc_number" field.
+func (_u *TrackUpdateOne) AddDiscNumber(v int) *TrackUpdateOne {
+	_u.mutation.AddDiscNumber(v)
+	return _u
+}
+
 // SetURL sets the "url" field.
 func (_u *TrackUpdateOne) SetURL(v string) *TrackUpdateOne {
 	_u.mutation.SetURL(v)
@@ -269,6 +824,87 @@ func (_u *TrackUpdateOne) ClearURL() *TrackUpdateOne {
 	return _u
 }
 
+// SetAudioKey sets the "audio_key" field.
+func (_u *TrackUpdateOne) SetAudioKey(v string) *TrackUpdateOne {
+	_u.mutation.SetAudioKey(v)
+	return _u
+}
+
+// SetNillableAudioKey sets the "audio_key" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableAudioKey(v *string) *TrackUpdateOne {
+	if v != nil {
+		_u.SetAudioKey(*v)
+	}
+	return _u
+}
+
+// ClearAudioKey clears the value of the "audio_key" field.
+func (_u *TrackUpdateOne) ClearAudioKey() *TrackUpdateOne {
+	_u.mutation.ClearAudioKey()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TrackUpdateOne) SetDurationMs(v int64) *TrackUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableDurationMs(v *int64) *TrackUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TrackUpdateOne) AddDurationMs(v int64) *TrackUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TrackUpdateOne) ClearDurationMs() *TrackUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetCanonicalTrackID sets the "canonical_track_id" field.
+func (_u *TrackUpdateOne) SetCanonicalTrackID(v uuid.UUID) *TrackUpdateOne {
+	_u.mutation.SetCanonicalTrackID(v)
+	return _u
+}
+
+// SetNillableCanonicalTrackID sets the "canonical_track_id" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableCanonicalTrackID(v *uuid.UUID) *TrackUpdateOne {
+	if v != nil {
+		_u.SetCanonicalTrackID(*v)
+	}
+	return _u
+}
+
+// ClearCanonicalTrackID clears the value of the "canonical_track_id" field.
+func (_u *TrackUpdateOne) ClearCanonicalTrackID() *TrackUpdateOne {
+	_u.mutation.ClearCanonicalTrackID()
+	return _u
+}
+
+// SetVersionType sets the "version_type" field.
+func (_u *TrackUpdateOne) SetVersionType(v track.VersionType) *TrackUpdateOne {
+	_u.mutation.SetVersionType(v)
+	return _u
+}
+
+// SetNillableVersionType sets the "version_type" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableVersionType(v *track.VersionType) *TrackUpdateOne {
+	if v != nil {
+		_u.SetVersionType(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TrackUpdateOne) SetCreatedAt(v time.Time) *TrackUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -283,11 +919,95 @@ func (_u *TrackUpdateOne) SetNillableCreatedAt(v *time.Time) *TrackUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TrackUpdateOne) SetDeletedAt(v time.Time) *TrackUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableDeletedAt(v *time.Time) *TrackUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TrackUpdateOne) ClearDeletedAt() *TrackUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetAlbum sets the "album" edge to the Album entity.
 func (_u *TrackUpdateOne) SetAlbum(v *Album) *TrackUpdateOne {
 	return _u.SetAlbumID(v.ID)
 }
 
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_u *TrackUpdateOne) AddPlayIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.AddPlayIDs(ids...)
+	return _u
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_u *TrackUpdateOne) AddPlays(v ...*Play) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlayIDs(ids...)
+}
+
+// AddPlaylistIDs adds the "playlists" edge to the Playlist entity by IDs.
+func (_u *TrackUpdateOne) AddPlaylistIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.AddPlaylistIDs(ids...)
+	return _u
+}
+
+// AddPlaylists adds the "playlists" edges to the Playlist entity.
+func (_u *TrackUpdateOne) AddPlaylists(v ...*Playlist) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlaylistIDs(ids...)
+}
+
+// SetCanonicalID sets the "canonical" edge to the Track entity by ID.
+func (_u *TrackUpdateOne) SetCanonicalID(id uuid.UUID) *TrackUpdateOne {
+	_u.mutation.SetCanonicalID(id)
+	return _u
+}
+
+// SetNillableCanonicalID sets the "canonical" edge to the Track entity by ID if the given value is not nil.
+func (_u *TrackUpdateOne) SetNillableCanonicalID(id *uuid.UUID) *TrackUpdateOne {
+	if id != nil {
+		_u = _u.SetCanonicalID(*id)
+	}
+	return _u
+}
+
+// SetCanonical sets the "canonical" edge to the Track entity.
+func (_u *TrackUpdateOne) SetCanonical(v *Track) *TrackUpdateOne {
+	return _u.SetCanonicalID(v.ID)
+}
+
+// AddVersionIDs adds the "versions" edge to the Track entity by IDs.
+func (_u *TrackUpdateOne) AddVersionIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the Track entity.
+func (_u *TrackUpdateOne) AddVersions(v ...*Track) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
 // Mutation returns the TrackMutation object of the builder.
 func (_u *TrackUpdateOne) Mutation() *TrackMutation {
 	return _u.mutation
@@ -299,6 +1019,75 @@ func (_u *TrackUpdateOne) ClearAlbum() *TrackUpdateOne {
 	return _u
 }
 
+// ClearPlays clears all "plays" edges to the Play entity.
+func (_u *TrackUpdateOne) ClearPlays() *TrackUpdateOne {
+	_u.mutation.ClearPlays()
+	return _u
+}
+
+// RemovePlayIDs removes the "plays" edge to Play entities by IDs.
+func (_u *TrackUpdateOne) RemovePlayIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.RemovePlayIDs(ids...)
+	return _u
+}
+
+// RemovePlays removes "plays" edges to Play entities.
+func (_u *TrackUpdateOne) RemovePlays(v ...*Play) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlayIDs(ids...)
+}
+
+// ClearPlaylists clears all "playlists" edges to the Playlist entity.
+func (_u *TrackUpdateOne) ClearPlaylists() *TrackUpdateOne {
+	_u.mutation.ClearPlaylists()
+	return _u
+}
+
+// RemovePlaylistIDs removes the "playlists" edge to Playlist entities by IDs.
+func (_u *TrackUpdateOne) RemovePlaylistIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.RemovePlaylistIDs(ids...)
+	return _u
+}
+
+// RemovePlaylists removes "playlists" edges to Playlist entities.
+func (_u *TrackUpdateOne) RemovePlaylists(v ...*Playlist) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlaylistIDs(ids...)
+}
+
+// ClearCanonical clears the "canonical" edge to the Track entity.
+func (_u *TrackUpdateOne) ClearCanonical() *TrackUpdateOne {
+	_u.mutation.ClearCanonical()
+	return _u
+}
+
+// ClearVersions clears all "versions" edges to the Track entity.
+func (_u *TrackUpdateOne) ClearVersions() *TrackUpdateOne {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to Track entities by IDs.
+func (_u *TrackUpdateOne) RemoveVersionIDs(ids ...uuid.UUID) *TrackUpdateOne {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to Track entities.
+func (_u *TrackUpdateOne) RemoveVersions(v ...*Track) *TrackUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
 // Where appends a list predicates to the TrackUpdate builder.
 func (_u *TrackUpdateOne) Where(ps ...predicate.Track) *TrackUpdateOne {
 	_u.mutation.Where(ps...)
@@ -346,6 +1135,26 @@ func (_u *TrackUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Track.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrackNumber(); ok {
+		if err := track.TrackNumberValidator(v); err != nil {
+			return &ValidationError{Name: "track_number", err: fmt.Errorf(`ent: validator failed for field "Track.track_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscNumber(); ok {
+		if err := track.DiscNumberValidator(v); err != nil {
+			return &ValidationError{Name: "disc_number", err: fmt.Errorf(`ent: validator failed for field "Track.disc_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := track.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Track.duration_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VersionType(); ok {
+		if err := track.VersionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "version_type", err: fmt.Errorf(`ent: validator failed for field "Track.version_type": %w`, err)}
+		}
+	}
 	if _u.mutation.AlbumCleared() && len(_u.mutation.AlbumIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Track.album"`)
 	}
@@ -384,15 +1193,54 @@ func (_u *TrackUpdateOne) sqlSave(ctx context.Context) (_node *Track, err error)
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(track.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrackNumber(); ok {
+		_spec.SetField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrackNumber(); ok {
+		_spec.AddField(track.FieldTrackNumber, field.TypeInt, value)
+	}
+	if _u.mutation.TrackNumberCleared() {
+		_spec.ClearField(track.FieldTrackNumber, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DiscNumber(); ok {
+		_spec.SetField(track.FieldDiscNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscNumber(); ok {
+		_spec.AddField(track.FieldDiscNumber, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.URL(); ok {
 		_spec.SetField(track.FieldURL, field.TypeString, value)
 	}
 	if _u.mutation.URLCleared() {
 		_spec.ClearField(track.FieldURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.AudioKey(); ok {
+		_spec.SetField(track.FieldAudioKey, field.TypeString, value)
+	}
+	if _u.mutation.AudioKeyCleared() {
+		_spec.ClearField(track.FieldAudioKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(track.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(track.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(track.FieldDurationMs, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.VersionType(); ok {
+		_spec.SetField(track.FieldVersionType, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(track.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(track.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(track.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.AlbumCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -422,6 +1270,170 @@ func (_u *TrackUpdateOne) sqlSave(ctx context.Context) (_node *Track, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaysIDs(); len(nodes) > 0 && !_u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   track.PlaysTable,
+			Columns: []string{track.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaylistsIDs(); len(nodes) > 0 && !_u.mutation.PlaylistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   track.PlaylistsTable,
+			Columns: track.PlaylistsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CanonicalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   track.CanonicalTable,
+			Columns: []string{track.CanonicalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CanonicalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   track.CanonicalTable,
+			Columns: []string{track.CanonicalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   track.VersionsTable,
+			Columns: []string{track.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Track{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TrackCredit is the model entity for the TrackCredit schema.
+type TrackCredit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The credited track
+	TrackID uuid.UUID `json:"track_id,omitempty"`
+	// The credited artist
+	ArtistID uuid.UUID `json:"artist_id,omitempty"`
+	// What the artist did on the track
+	Role trackcredit.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TrackCreditQuery when eager-loading is set.
+	Edges        TrackCreditEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TrackCreditEdges holds the relations/edges for other nodes in the graph.
+type TrackCreditEdges struct {
+	// Track holds the value of the track edge.
+	Track *Track `json:"track,omitempty"`
+	// Artist holds the value of the artist edge.
+	Artist *Artist `json:"artist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TrackOrErr returns the Track value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackCreditEdges) TrackOrErr() (*Track, error) {
+	if e.Track != nil {
+		return e.Track, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: track.Label}
+	}
+	return nil, &NotLoadedError{edge: "track"}
+}
+
+// ArtistOrErr returns the Artist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackCreditEdges) ArtistOrErr() (*Artist, error) {
+	if e.Artist != nil {
+		return e.Artist, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: artist.Label}
+	}
+	return nil, &NotLoadedError{edge: "artist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrackCredit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trackcredit.FieldRole:
+			values[i] = new(sql.NullString)
+		case trackcredit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case trackcredit.FieldID, trackcredit.FieldTrackID, trackcredit.FieldArtistID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrackCredit fields.
+func (_m *TrackCredit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trackcredit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case trackcredit.FieldTrackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field track_id", values[i])
+			} else if value != nil {
+				_m.TrackID = *value
+			}
+		case trackcredit.FieldArtistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field artist_id", values[i])
+			} else if value != nil {
+				_m.ArtistID = *value
+			}
+		case trackcredit.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = trackcredit.Role(value.String)
+			}
+		case trackcredit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrackCredit.
+// This includes values selected through modifiers, order, etc.
+func (_m *TrackCredit) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTrack queries the "track" edge of the TrackCredit entity.
+func (_m *TrackCredit) QueryTrack() *TrackQuery {
+	return NewTrackCreditClient(_m.config).QueryTrack(_m)
+}
+
+// QueryArtist queries the "artist" edge of the TrackCredit entity.
+func (_m *TrackCredit) QueryArtist() *ArtistQuery {
+	return NewTrackCreditClient(_m.config).QueryArtist(_m)
+}
+
+// Update returns a builder for updating this TrackCredit.
+// Note that you need to call TrackCredit.Unwrap() before calling this method if this TrackCredit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TrackCredit) Update() *TrackCreditUpdateOne {
+	return NewTrackCreditClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TrackCredit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TrackCredit) Unwrap() *TrackCredit {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrackCredit is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TrackCredit) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrackCredit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("track_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackID))
+	builder.WriteString(", ")
+	builder.WriteString("artist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ArtistID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrackCredits is a parsable slice of TrackCredit.
+type TrackCredits []*TrackCredit
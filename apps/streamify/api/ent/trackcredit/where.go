@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trackcredit
+
+import (
+	"streamify/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldLTE(FieldID, id))
+}
+
+// TrackID applies equality check predicate on the "track_id" field. It's identical to TrackIDEQ.
+func TrackID(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldTrackID, v))
+}
+
+// ArtistID applies equality check predicate on the "artist_id" field. It's identical to ArtistIDEQ.
+func ArtistID(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldArtistID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TrackIDEQ applies the EQ predicate on the "track_id" field.
+func TrackIDEQ(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldTrackID, v))
+}
+
+// TrackIDNEQ applies the NEQ predicate on the "track_id" field.
+func TrackIDNEQ(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNEQ(FieldTrackID, v))
+}
+
+// TrackIDIn applies the In predicate on the "track_id" field.
+func TrackIDIn(vs ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldIn(FieldTrackID, vs...))
+}
+
+// TrackIDNotIn applies the NotIn predicate on the "track_id" field.
+func TrackIDNotIn(vs ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNotIn(FieldTrackID, vs...))
+}
+
+// ArtistIDEQ applies the EQ predicate on the "artist_id" field.
+func ArtistIDEQ(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldArtistID, v))
+}
+
+// ArtistIDNEQ applies the NEQ predicate on the "artist_id" field.
+func ArtistIDNEQ(v uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNEQ(FieldArtistID, v))
+}
+
+// ArtistIDIn applies the In predicate on the "artist_id" field.
+func ArtistIDIn(vs ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldIn(FieldArtistID, vs...))
+}
+
+// ArtistIDNotIn applies the NotIn predicate on the "artist_id" field.
+func ArtistIDNotIn(vs ...uuid.UUID) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNotIn(FieldArtistID, vs...))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTrack applies the HasEdge predicate on the "track" edge.
+func HasTrack() predicate.TrackCredit {
+	return predicate.TrackCredit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TrackTable, TrackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTrackWith applies the HasEdge predicate on the "track" edge with a given conditions (other predicates).
+func HasTrackWith(preds ...predicate.Track) predicate.TrackCredit {
+	return predicate.TrackCredit(func(s *sql.Selector) {
+		step := newTrackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArtist applies the HasEdge predicate on the "artist" edge.
+func HasArtist() predicate.TrackCredit {
+	return predicate.TrackCredit(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtistTable, ArtistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtistWith applies the HasEdge predicate on the "artist" edge with a given conditions (other predicates).
+func HasArtistWith(preds ...predicate.Artist) predicate.TrackCredit {
+	return predicate.TrackCredit(func(s *sql.Selector) {
+		step := newArtistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrackCredit) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrackCredit) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrackCredit) predicate.TrackCredit {
+	return predicate.TrackCredit(sql.NotPredicates(p))
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TrackCreditCreate is the builder for creating a TrackCredit entity.
+type TrackCreditCreate struct {
+	config
+	mutation *TrackCreditMutation
+	hooks    []Hook
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *TrackCreditCreate) SetTrackID(v uuid.UUID) *TrackCreditCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetArtistID sets the "artist_id" field.
+func (_c *TrackCreditCreate) SetArtistID(v uuid.UUID) *TrackCreditCreate {
+	_c.mutation.SetArtistID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *TrackCreditCreate) SetRole(v trackcredit.Role) *TrackCreditCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *TrackCreditCreate) SetNillableRole(v *trackcredit.Role) *TrackCreditCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TrackCreditCreate) SetCreatedAt(v time.Time) *TrackCreditCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TrackCreditCreate) SetNillableCreatedAt(v *time.Time) *TrackCreditCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TrackCreditCreate) SetID(v uuid.UUID) *TrackCreditCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TrackCreditCreate) SetNillableID(v *uuid.UUID) *TrackCreditCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *TrackCreditCreate) SetTrack(v *Track) *TrackCreditCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (_c *TrackCreditCreate) SetArtist(v *Artist) *TrackCreditCreate {
+	return _c.SetArtistID(v.ID)
+}
+
+// Mutation returns the TrackCreditMutation object of the builder.
+func (_c *TrackCreditCreate) Mutation() *TrackCreditMutation {
+	return _c.mutation
+}
+
+// Save creates the TrackCredit in the database.
+func (_c *TrackCreditCreate) Save(ctx context.Context) (*TrackCredit, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TrackCreditCreate) SaveX(ctx context.Context) *TrackCredit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TrackCreditCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TrackCreditCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TrackCreditCreate) defaults() error {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := trackcredit.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if trackcredit.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized trackcredit.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := trackcredit.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if trackcredit.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized trackcredit.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := trackcredit.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TrackCreditCreate) check() error {
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "TrackCredit.track_id"`)}
+	}
+	if _, ok := _c.mutation.ArtistID(); !ok {
+		return &ValidationError{Name: "artist_id", err: errors.New(`ent: missing required field "TrackCredit.artist_id"`)}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TrackCredit.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := trackcredit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TrackCredit.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrackCredit.created_at"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "TrackCredit.track"`)}
+	}
+	if len(_c.mutation.ArtistIDs()) == 0 {
+		return &ValidationError{Name: "artist", err: errors.New(`ent: missing required edge "TrackCredit.artist"`)}
+	}
+	return nil
+}
+
+func (_c *TrackCreditCreate) sqlSave(ctx context.Context) (*TrackCredit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TrackCreditCreate) createSpec() (*TrackCredit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrackCredit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(trackcredit.Table, sqlgraph.NewFieldSpec(trackcredit.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(trackcredit.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(trackcredit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.TrackTable,
+			Columns: []string{trackcredit.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.ArtistTable,
+			Columns: []string{trackcredit.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtistID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TrackCreditCreateBulk is the builder for creating many TrackCredit entities in bulk.
+type TrackCreditCreateBulk struct {
+	config
+	err      error
+	builders []*TrackCreditCreate
+}
+
+// Save creates the TrackCredit entities in the database.
+func (_c *TrackCreditCreateBulk) Save(ctx context.Context) ([]*TrackCredit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TrackCredit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrackCreditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TrackCreditCreateBulk) SaveX(ctx context.Context) []*TrackCredit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TrackCreditCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TrackCreditCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
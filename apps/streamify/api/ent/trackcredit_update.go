@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/artist"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TrackCreditUpdate is the builder for updating TrackCredit entities.
+type TrackCreditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrackCreditMutation
+}
+
+// Where appends a list predicates to the TrackCreditUpdate builder.
+func (_u *TrackCreditUpdate) Where(ps ...predicate.TrackCredit) *TrackCreditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *TrackCreditUpdate) SetTrackID(v uuid.UUID) *TrackCreditUpdate {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *TrackCreditUpdate) SetNillableTrackID(v *uuid.UUID) *TrackCreditUpdate {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetArtistID sets the "artist_id" field.
+func (_u *TrackCreditUpdate) SetArtistID(v uuid.UUID) *TrackCreditUpdate {
+	_u.mutation.SetArtistID(v)
+	return _u
+}
+
+// SetNillableArtistID sets the "artist_id" field if the given value is not nil.
+func (_u *TrackCreditUpdate) SetNillableArtistID(v *uuid.UUID) *TrackCreditUpdate {
+	if v != nil {
+		_u.SetArtistID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TrackCreditUpdate) SetRole(v trackcredit.Role) *TrackCreditUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TrackCreditUpdate) SetNillableRole(v *trackcredit.Role) *TrackCreditUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *TrackCreditUpdate) SetTrack(v *Track) *TrackCreditUpdate {
+	return _u.SetTrackID(v.ID)
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (_u *TrackCreditUpdate) SetArtist(v *Artist) *TrackCreditUpdate {
+	return _u.SetArtistID(v.ID)
+}
+
+// Mutation returns the TrackCreditMutation object of the builder.
+func (_u *TrackCreditUpdate) Mutation() *TrackCreditMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *TrackCreditUpdate) ClearTrack() *TrackCreditUpdate {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (_u *TrackCreditUpdate) ClearArtist() *TrackCreditUpdate {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TrackCreditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TrackCreditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TrackCreditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TrackCreditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TrackCreditUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := trackcredit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TrackCredit.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackCredit.track"`)
+	}
+	if _u.mutation.ArtistCleared() && len(_u.mutation.ArtistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackCredit.artist"`)
+	}
+	return nil
+}
+
+func (_u *TrackCreditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackcredit.Table, trackcredit.Columns, sqlgraph.NewFieldSpec(trackcredit.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(trackcredit.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.TrackTable,
+			Columns: []string{trackcredit.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.TrackTable,
+			Columns: []string{trackcredit.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ArtistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.ArtistTable,
+			Columns: []string{trackcredit.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.ArtistTable,
+			Columns: []string{trackcredit.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackcredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TrackCreditUpdateOne is the builder for updating a single TrackCredit entity.
+type TrackCreditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrackCreditMutation
+}
+
+// SetTrackID sets the "track_id" field.
+func (_u *TrackCreditUpdateOne) SetTrackID(v uuid.UUID) *TrackCreditUpdateOne {
+	_u.mutation.SetTrackID(v)
+	return _u
+}
+
+// SetNillableTrackID sets the "track_id" field if the given value is not nil.
+func (_u *TrackCreditUpdateOne) SetNillableTrackID(v *uuid.UUID) *TrackCreditUpdateOne {
+	if v != nil {
+		_u.SetTrackID(*v)
+	}
+	return _u
+}
+
+// SetArtistID sets the "artist_id" field.
+func (_u *TrackCreditUpdateOne) SetArtistID(v uuid.UUID) *TrackCreditUpdateOne {
+	_u.mutation.SetArtistID(v)
+	return _u
+}
+
+// SetNillableArtistID sets the "artist_id" field if the given value is not nil.
+func (_u *TrackCreditUpdateOne) SetNillableArtistID(v *uuid.UUID) *TrackCreditUpdateOne {
+	if v != nil {
+		_u.SetArtistID(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TrackCreditUpdateOne) SetRole(v trackcredit.Role) *TrackCreditUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TrackCreditUpdateOne) SetNillableRole(v *trackcredit.Role) *TrackCreditUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_u *TrackCreditUpdateOne) SetTrack(v *Track) *TrackCreditUpdateOne {
+	return _u.SetTrackID(v.ID)
+}
+
+// SetArtist sets the "artist" edge to the Artist entity.
+func (_u *TrackCreditUpdateOne) SetArtist(v *Artist) *TrackCreditUpdateOne {
+	return _u.SetArtistID(v.ID)
+}
+
+// Mutation returns the TrackCreditMutation object of the builder.
+func (_u *TrackCreditUpdateOne) Mutation() *TrackCreditMutation {
+	return _u.mutation
+}
+
+// ClearTrack clears the "track" edge to the Track entity.
+func (_u *TrackCreditUpdateOne) ClearTrack() *TrackCreditUpdateOne {
+	_u.mutation.ClearTrack()
+	return _u
+}
+
+// ClearArtist clears the "artist" edge to the Artist entity.
+func (_u *TrackCreditUpdateOne) ClearArtist() *TrackCreditUpdateOne {
+	_u.mutation.ClearArtist()
+	return _u
+}
+
+// Where appends a list predicates to the TrackCreditUpdate builder.
+func (_u *TrackCreditUpdateOne) Where(ps ...predicate.TrackCredit) *TrackCreditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TrackCreditUpdateOne) Select(field string, fields ...string) *TrackCreditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TrackCredit entity.
+func (_u *TrackCreditUpdateOne) Save(ctx context.Context) (*TrackCredit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TrackCreditUpdateOne) SaveX(ctx context.Context) *TrackCredit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TrackCreditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TrackCreditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TrackCreditUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := trackcredit.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TrackCredit.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackCredit.track"`)
+	}
+	if _u.mutation.ArtistCleared() && len(_u.mutation.ArtistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TrackCredit.artist"`)
+	}
+	return nil
+}
+
+func (_u *TrackCreditUpdateOne) sqlSave(ctx context.Context) (_node *TrackCredit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackcredit.Table, trackcredit.Columns, sqlgraph.NewFieldSpec(trackcredit.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrackCredit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trackcredit.FieldID)
+		for _, f := range fields {
+			if !trackcredit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trackcredit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(trackcredit.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.TrackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.TrackTable,
+			Columns: []string{trackcredit.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.TrackTable,
+			Columns: []string{trackcredit.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ArtistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.ArtistTable,
+			Columns: []string{trackcredit.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ArtistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   trackcredit.ArtistTable,
+			Columns: []string{trackcredit.ArtistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TrackCredit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackcredit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
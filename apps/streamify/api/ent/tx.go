@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,14 +14,90 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// APIKeyUsage is the client for interacting with the APIKeyUsage builders.
+	APIKeyUsage *APIKeyUsageClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// Artist is the client for interacting with the Artist builders.
 	Artist *ArtistClient
+	// AudioFingerprint is the client for interacting with the AudioFingerprint builders.
+	AudioFingerprint *AudioFingerprintClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Backup is the client for interacting with the Backup builders.
+	Backup *BackupClient
+	// Block is the client for interacting with the Block builders.
+	Block *BlockClient
+	// ChangelogEntry is the client for interacting with the ChangelogEntry builders.
+	ChangelogEntry *ChangelogEntryClient
+	// Confirmation is the client for interacting with the Confirmation builders.
+	Confirmation *ConfirmationClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
+	// DeviceAuthorization is the client for interacting with the DeviceAuthorization builders.
+	DeviceAuthorization *DeviceAuthorizationClient
+	// DuplicateReview is the client for interacting with the DuplicateReview builders.
+	DuplicateReview *DuplicateReviewClient
+	// Entitlement is the client for interacting with the Entitlement builders.
+	Entitlement *EntitlementClient
+	// ExternalIdentity is the client for interacting with the ExternalIdentity builders.
+	ExternalIdentity *ExternalIdentityClient
+	// Follow is the client for interacting with the Follow builders.
+	Follow *FollowClient
+	// GuestState is the client for interacting with the GuestState builders.
+	GuestState *GuestStateClient
+	// Invite is the client for interacting with the Invite builders.
+	Invite *InviteClient
+	// Like is the client for interacting with the Like builders.
+	Like *LikeClient
+	// Operation is the client for interacting with the Operation builders.
+	Operation *OperationClient
+	// Play is the client for interacting with the Play builders.
+	Play *PlayClient
+	// PlayCount is the client for interacting with the PlayCount builders.
+	PlayCount *PlayCountClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// PolicyAcceptance is the client for interacting with the PolicyAcceptance builders.
+	PolicyAcceptance *PolicyAcceptanceClient
+	// PolicyVersion is the client for interacting with the PolicyVersion builders.
+	PolicyVersion *PolicyVersionClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
+	// Referral is the client for interacting with the Referral builders.
+	Referral *ReferralClient
+	// SCIMGroup is the client for interacting with the SCIMGroup builders.
+	SCIMGroup *SCIMGroupClient
+	// SSOProvider is the client for interacting with the SSOProvider builders.
+	SSOProvider *SSOProviderClient
+	// SecurityAlert is the client for interacting with the SecurityAlert builders.
+	SecurityAlert *SecurityAlertClient
+	// ShareLink is the client for interacting with the ShareLink builders.
+	ShareLink *ShareLinkClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// SupportAttachment is the client for interacting with the SupportAttachment builders.
+	SupportAttachment *SupportAttachmentClient
+	// SupportReply is the client for interacting with the SupportReply builders.
+	SupportReply *SupportReplyClient
+	// SupportTicket is the client for interacting with the SupportTicket builders.
+	SupportTicket *SupportTicketClient
+	// Tombstone is the client for interacting with the Tombstone builders.
+	Tombstone *TombstoneClient
 	// Track is the client for interacting with the Track builders.
 	Track *TrackClient
+	// TrackCredit is the client for interacting with the TrackCredit builders.
+	TrackCredit *TrackCreditClient
+	// UploadSession is the client for interacting with the UploadSession builders.
+	UploadSession *UploadSessionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +229,48 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.APIKeyUsage = NewAPIKeyUsageClient(tx.config)
 	tx.Album = NewAlbumClient(tx.config)
 	tx.Artist = NewArtistClient(tx.config)
+	tx.AudioFingerprint = NewAudioFingerprintClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Backup = NewBackupClient(tx.config)
+	tx.Block = NewBlockClient(tx.config)
+	tx.ChangelogEntry = NewChangelogEntryClient(tx.config)
+	tx.Confirmation = NewConfirmationClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
+	tx.DeviceAuthorization = NewDeviceAuthorizationClient(tx.config)
+	tx.DuplicateReview = NewDuplicateReviewClient(tx.config)
+	tx.Entitlement = NewEntitlementClient(tx.config)
+	tx.ExternalIdentity = NewExternalIdentityClient(tx.config)
+	tx.Follow = NewFollowClient(tx.config)
+	tx.GuestState = NewGuestStateClient(tx.config)
+	tx.Invite = NewInviteClient(tx.config)
+	tx.Like = NewLikeClient(tx.config)
+	tx.Operation = NewOperationClient(tx.config)
+	tx.Play = NewPlayClient(tx.config)
+	tx.PlayCount = NewPlayCountClient(tx.config)
+	tx.Playlist = NewPlaylistClient(tx.config)
+	tx.PolicyAcceptance = NewPolicyAcceptanceClient(tx.config)
+	tx.PolicyVersion = NewPolicyVersionClient(tx.config)
+	tx.PromoCode = NewPromoCodeClient(tx.config)
+	tx.PromoRedemption = NewPromoRedemptionClient(tx.config)
+	tx.Referral = NewReferralClient(tx.config)
+	tx.SCIMGroup = NewSCIMGroupClient(tx.config)
+	tx.SSOProvider = NewSSOProviderClient(tx.config)
+	tx.SecurityAlert = NewSecurityAlertClient(tx.config)
+	tx.ShareLink = NewShareLinkClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
+	tx.SupportAttachment = NewSupportAttachmentClient(tx.config)
+	tx.SupportReply = NewSupportReplyClient(tx.config)
+	tx.SupportTicket = NewSupportTicketClient(tx.config)
+	tx.Tombstone = NewTombstoneClient(tx.config)
 	tx.Track = NewTrackClient(tx.config)
+	tx.TrackCredit = NewTrackCreditClient(tx.config)
+	tx.UploadSession = NewUploadSessionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +280,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Album.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -217,3 +333,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
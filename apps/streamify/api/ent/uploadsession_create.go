@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/track"
+	"streamify/ent/uploadsession"
+	"streamify/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UploadSessionCreate is the builder for creating a UploadSession entity.
+type UploadSessionCreate struct {
+	config
+	mutation *UploadSessionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UploadSessionCreate) SetUserID(v uuid.UUID) *UploadSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTrackID sets the "track_id" field.
+func (_c *UploadSessionCreate) SetTrackID(v uuid.UUID) *UploadSessionCreate {
+	_c.mutation.SetTrackID(v)
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *UploadSessionCreate) SetLength(v int64) *UploadSessionCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetOffset sets the "offset" field.
+func (_c *UploadSessionCreate) SetOffset(v int64) *UploadSessionCreate {
+	_c.mutation.SetOffset(v)
+	return _c
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableOffset(v *int64) *UploadSessionCreate {
+	if v != nil {
+		_c.SetOffset(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *UploadSessionCreate) SetStatus(v uploadsession.Status) *UploadSessionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableStatus(v *uploadsession.Status) *UploadSessionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *UploadSessionCreate) SetError(v string) *UploadSessionCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableError(v *string) *UploadSessionCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *UploadSessionCreate) SetExpiresAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadSessionCreate) SetCreatedAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableCreatedAt(v *time.Time) *UploadSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UploadSessionCreate) SetID(v uuid.UUID) *UploadSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableID(v *uuid.UUID) *UploadSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *UploadSessionCreate) SetUser(v *User) *UploadSessionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetTrack sets the "track" edge to the Track entity.
+func (_c *UploadSessionCreate) SetTrack(v *Track) *UploadSessionCreate {
+	return _c.SetTrackID(v.ID)
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_c *UploadSessionCreate) Mutation() *UploadSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the UploadSession in the database.
+func (_c *UploadSessionCreate) Save(ctx context.Context) (*UploadSession, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UploadSessionCreate) SaveX(ctx context.Context) *UploadSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UploadSessionCreate) defaults() error {
+	if _, ok := _c.mutation.Offset(); !ok {
+		v := uploadsession.DefaultOffset
+		_c.mutation.SetOffset(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := uploadsession.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if uploadsession.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized uploadsession.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := uploadsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if uploadsession.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized uploadsession.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := uploadsession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UploadSessionCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UploadSession.user_id"`)}
+	}
+	if _, ok := _c.mutation.TrackID(); !ok {
+		return &ValidationError{Name: "track_id", err: errors.New(`ent: missing required field "UploadSession.track_id"`)}
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "UploadSession.length"`)}
+	}
+	if v, ok := _c.mutation.Length(); ok {
+		if err := uploadsession.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "UploadSession.length": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "UploadSession.offset"`)}
+	}
+	if v, ok := _c.mutation.Offset(); ok {
+		if err := uploadsession.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "UploadSession.offset": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "UploadSession.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := uploadsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UploadSession.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UploadSession.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UploadSession.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UploadSession.user"`)}
+	}
+	if len(_c.mutation.TrackIDs()) == 0 {
+		return &ValidationError{Name: "track", err: errors.New(`ent: missing required edge "UploadSession.track"`)}
+	}
+	return nil
+}
+
+func (_c *UploadSessionCreate) sqlSave(ctx context.Context) (*UploadSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UploadSessionCreate) createSpec() (*UploadSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UploadSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(uploadsession.Table, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(uploadsession.FieldLength, field.TypeInt64, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Offset(); ok {
+		_spec.SetField(uploadsession.FieldOffset, field.TypeInt64, value)
+		_node.Offset = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(uploadsession.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(uploadsession.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(uploadsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   uploadsession.UserTable,
+			Columns: []string{uploadsession.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TrackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   uploadsession.TrackTable,
+			Columns: []string{uploadsession.TrackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(track.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TrackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// UploadSessionCreateBulk is the builder for creating many UploadSession entities in bulk.
+type UploadSessionCreateBulk struct {
+	config
+	err      error
+	builders []*UploadSessionCreate
+}
+
+// Save creates the UploadSession entities in the database.
+func (_c *UploadSessionCreateBulk) Save(ctx context.Context) ([]*UploadSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UploadSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UploadSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UploadSessionCreateBulk) SaveX(ctx context.Context) []*UploadSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UploadSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UploadSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
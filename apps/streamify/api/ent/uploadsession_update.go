@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/predicate"
+	"streamify/ent/uploadsession"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UploadSessionUpdate is the builder for updating UploadSession entities.
+type UploadSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UploadSessionMutation
+}
+
+// Where appends a list predicates to the UploadSessionUpdate builder.
+func (_u *UploadSessionUpdate) Where(ps ...predicate.UploadSession) *UploadSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *UploadSessionUpdate) SetOffset(v int64) *UploadSessionUpdate {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableOffset(v *int64) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *UploadSessionUpdate) AddOffset(v int64) *UploadSessionUpdate {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UploadSessionUpdate) SetStatus(v uploadsession.Status) *UploadSessionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableStatus(v *uploadsession.Status) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *UploadSessionUpdate) SetError(v string) *UploadSessionUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableError(v *string) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *UploadSessionUpdate) ClearError() *UploadSessionUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadSessionUpdate) SetExpiresAt(v time.Time) *UploadSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableExpiresAt(v *time.Time) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_u *UploadSessionUpdate) Mutation() *UploadSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UploadSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UploadSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadSessionUpdate) check() error {
+	if v, ok := _u.mutation.Offset(); ok {
+		if err := uploadsession.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "UploadSession.offset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := uploadsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UploadSession.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.track"`)
+	}
+	return nil
+}
+
+func (_u *UploadSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(uploadsession.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(uploadsession.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(uploadsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(uploadsession.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(uploadsession.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UploadSessionUpdateOne is the builder for updating a single UploadSession entity.
+type UploadSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UploadSessionMutation
+}
+
+// SetOffset sets the "offset" field.
+func (_u *UploadSessionUpdateOne) SetOffset(v int64) *UploadSessionUpdateOne {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableOffset(v *int64) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *UploadSessionUpdateOne) AddOffset(v int64) *UploadSessionUpdateOne {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *UploadSessionUpdateOne) SetStatus(v uploadsession.Status) *UploadSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableStatus(v *uploadsession.Status) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *UploadSessionUpdateOne) SetError(v string) *UploadSessionUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableError(v *string) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *UploadSessionUpdateOne) ClearError() *UploadSessionUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *UploadSessionUpdateOne) SetExpiresAt(v time.Time) *UploadSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the UploadSessionMutation object of the builder.
+func (_u *UploadSessionUpdateOne) Mutation() *UploadSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UploadSessionUpdate builder.
+func (_u *UploadSessionUpdateOne) Where(ps ...predicate.UploadSession) *UploadSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UploadSessionUpdateOne) Select(field string, fields ...string) *UploadSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UploadSession entity.
+func (_u *UploadSessionUpdateOne) Save(ctx context.Context) (*UploadSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UploadSessionUpdateOne) SaveX(ctx context.Context) *UploadSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UploadSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UploadSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UploadSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.Offset(); ok {
+		if err := uploadsession.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "UploadSession.offset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := uploadsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "UploadSession.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.user"`)
+	}
+	if _u.mutation.TrackCleared() && len(_u.mutation.TrackIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "UploadSession.track"`)
+	}
+	return nil
+}
+
+func (_u *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UploadSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, uploadsession.FieldID)
+		for _, f := range fields {
+			if !uploadsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != uploadsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(uploadsession.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(uploadsession.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(uploadsession.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(uploadsession.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(uploadsession.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &UploadSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{uploadsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"streamify/ent/user"
+	"streamify/preferences"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -17,24 +20,86 @@ type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// Email holds the value of the "email" field.
+	// Address the user signs in with and receives mail at
 	Email string `json:"email,omitempty"`
-	// FirstName holds the value of the "first_name" field.
+	// Given name, shown on the profile
 	FirstName string `json:"first_name,omitempty"`
-	// LastName holds the value of the "last_name" field.
+	// Family name, shown on the profile
 	LastName string `json:"last_name,omitempty"`
-	// Password holds the value of the "password" field.
-	Password     string `json:"-"`
+	// bcrypt hash of the password; unset for accounts that only sign in through SSO
+	Password string `json:"-"`
+	// Admins manage the catalog, users and settings
+	Role user.Role `json:"role,omitempty"`
+	// Playback, notification and display settings, merged from PATCH /me/preferences
+	Preferences preferences.Preferences `json:"-"`
+	// Who can see the user's playlists
+	PlaylistsVisibility user.PlaylistsVisibility `json:"playlists_visibility,omitempty"`
+	// Who can see what the user listens to
+	ActivityVisibility user.ActivityVisibility `json:"activity_visibility,omitempty"`
+	// Who can see who follows the user
+	FollowersVisibility user.FollowersVisibility `json:"followers_visibility,omitempty"`
+	// Plays are reported to analytics under a daily pseudonym instead of the user's ID. Plays reported before opting out are unaffected.
+	AnalyticsOptOut bool `json:"analytics_opt_out,omitempty"`
+	// Ordered track IDs of the user's play queue
+	Queue []uuid.UUID `json:"queue,omitempty"`
+	// Deactivated users can't sign in or use their tokens and API keys. Set when an identity provider deprovisions the account through SCIM.
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+	// Countries the user has signed in from, so a sign-in from another one can be flagged
+	LoginCountries []string `json:"-"`
+	// Tokens issued before this are refused, signing the user out everywhere. Set when an anomaly rule forces re-authentication.
+	SessionsValidAfter *time.Time `json:"sessions_valid_after,omitempty"`
+	// The region whose database holds the user's data; empty is the home region. Set once, when the user is pinned.
+	Region *string `json:"region,omitempty"`
+	// When the user last caught up on the changelog; entries published since are new to them
+	ChangelogSeenAt *time.Time `json:"changelog_seen_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Plays holds the value of the plays edge.
+	Plays []*Play `json:"plays,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*Follow `json:"following,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PlaysOrErr returns the Plays value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PlaysOrErr() ([]*Play, error) {
+	if e.loadedTypes[0] {
+		return e.Plays, nil
+	}
+	return nil, &NotLoadedError{edge: "plays"}
+}
+
+// FollowingOrErr returns the Following value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowingOrErr() ([]*Follow, error) {
+	if e.loadedTypes[1] {
+		return e.Following, nil
+	}
+	return nil, &NotLoadedError{edge: "following"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmail, user.FieldFirstName, user.FieldLastName, user.FieldPassword:
+		case user.FieldPreferences, user.FieldQueue, user.FieldLoginCountries:
+			values[i] = new([]byte)
+		case user.FieldAnalyticsOptOut:
+			values[i] = new(sql.NullBool)
+		case user.FieldEmail, user.FieldFirstName, user.FieldLastName, user.FieldPassword, user.FieldRole, user.FieldPlaylistsVisibility, user.FieldActivityVisibility, user.FieldFollowersVisibility, user.FieldRegion:
 			values[i] = new(sql.NullString)
+		case user.FieldDeactivatedAt, user.FieldSessionsValidAfter, user.FieldChangelogSeenAt:
+			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -82,6 +147,88 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Password = value.String
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
+		case user.FieldPreferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field preferences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Preferences); err != nil {
+					return fmt.Errorf("unmarshal field preferences: %w", err)
+				}
+			}
+		case user.FieldPlaylistsVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field playlists_visibility", values[i])
+			} else if value.Valid {
+				_m.PlaylistsVisibility = user.PlaylistsVisibility(value.String)
+			}
+		case user.FieldActivityVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_visibility", values[i])
+			} else if value.Valid {
+				_m.ActivityVisibility = user.ActivityVisibility(value.String)
+			}
+		case user.FieldFollowersVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field followers_visibility", values[i])
+			} else if value.Valid {
+				_m.FollowersVisibility = user.FollowersVisibility(value.String)
+			}
+		case user.FieldAnalyticsOptOut:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field analytics_opt_out", values[i])
+			} else if value.Valid {
+				_m.AnalyticsOptOut = value.Bool
+			}
+		case user.FieldQueue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Queue); err != nil {
+					return fmt.Errorf("unmarshal field queue: %w", err)
+				}
+			}
+		case user.FieldDeactivatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deactivated_at", values[i])
+			} else if value.Valid {
+				_m.DeactivatedAt = new(time.Time)
+				*_m.DeactivatedAt = value.Time
+			}
+		case user.FieldLoginCountries:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field login_countries", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LoginCountries); err != nil {
+					return fmt.Errorf("unmarshal field login_countries: %w", err)
+				}
+			}
+		case user.FieldSessionsValidAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sessions_valid_after", values[i])
+			} else if value.Valid {
+				_m.SessionsValidAfter = new(time.Time)
+				*_m.SessionsValidAfter = value.Time
+			}
+		case user.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = new(string)
+				*_m.Region = value.String
+			}
+		case user.FieldChangelogSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changelog_seen_at", values[i])
+			} else if value.Valid {
+				_m.ChangelogSeenAt = new(time.Time)
+				*_m.ChangelogSeenAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -95,6 +242,16 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPlays queries the "plays" edge of the User entity.
+func (_m *User) QueryPlays() *PlayQuery {
+	return NewUserClient(_m.config).QueryPlays(_m)
+}
+
+// QueryFollowing queries the "following" edge of the User entity.
+func (_m *User) QueryFollowing() *FollowQuery {
+	return NewUserClient(_m.config).QueryFollowing(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -128,6 +285,48 @@ func (_m *User) String() string {
 	builder.WriteString(_m.LastName)
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("preferences=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("playlists_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlaylistsVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("activity_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActivityVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("followers_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FollowersVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("analytics_opt_out=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnalyticsOptOut))
+	builder.WriteString(", ")
+	builder.WriteString("queue=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Queue))
+	builder.WriteString(", ")
+	if v := _m.DeactivatedAt; v != nil {
+		builder.WriteString("deactivated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("login_countries=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.SessionsValidAfter; v != nil {
+		builder.WriteString("sessions_valid_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Region; v != nil {
+		builder.WriteString("region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ChangelogSeenAt; v != nil {
+		builder.WriteString("changelog_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
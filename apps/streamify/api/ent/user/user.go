@@ -3,7 +3,10 @@
 package user
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -20,8 +23,50 @@ const (
 	FieldLastName = "last_name"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldPreferences holds the string denoting the preferences field in the database.
+	FieldPreferences = "preferences"
+	// FieldPlaylistsVisibility holds the string denoting the playlists_visibility field in the database.
+	FieldPlaylistsVisibility = "playlists_visibility"
+	// FieldActivityVisibility holds the string denoting the activity_visibility field in the database.
+	FieldActivityVisibility = "activity_visibility"
+	// FieldFollowersVisibility holds the string denoting the followers_visibility field in the database.
+	FieldFollowersVisibility = "followers_visibility"
+	// FieldAnalyticsOptOut holds the string denoting the analytics_opt_out field in the database.
+	FieldAnalyticsOptOut = "analytics_opt_out"
+	// FieldQueue holds the string denoting the queue field in the database.
+	FieldQueue = "queue"
+	// FieldDeactivatedAt holds the string denoting the deactivated_at field in the database.
+	FieldDeactivatedAt = "deactivated_at"
+	// FieldLoginCountries holds the string denoting the login_countries field in the database.
+	FieldLoginCountries = "login_countries"
+	// FieldSessionsValidAfter holds the string denoting the sessions_valid_after field in the database.
+	FieldSessionsValidAfter = "sessions_valid_after"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldChangelogSeenAt holds the string denoting the changelog_seen_at field in the database.
+	FieldChangelogSeenAt = "changelog_seen_at"
+	// EdgePlays holds the string denoting the plays edge name in mutations.
+	EdgePlays = "plays"
+	// EdgeFollowing holds the string denoting the following edge name in mutations.
+	EdgeFollowing = "following"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// PlaysTable is the table that holds the plays relation/edge.
+	PlaysTable = "plays"
+	// PlaysInverseTable is the table name for the Play entity.
+	// It exists in this package in order to avoid circular dependency with the "play" package.
+	PlaysInverseTable = "plays"
+	// PlaysColumn is the table column denoting the plays relation/edge.
+	PlaysColumn = "user_id"
+	// FollowingTable is the table that holds the following relation/edge.
+	FollowingTable = "follows"
+	// FollowingInverseTable is the table name for the Follow entity.
+	// It exists in this package in order to avoid circular dependency with the "follow" package.
+	FollowingInverseTable = "follows"
+	// FollowingColumn is the table column denoting the following relation/edge.
+	FollowingColumn = "follower_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -31,6 +76,18 @@ var Columns = []string{
 	FieldFirstName,
 	FieldLastName,
 	FieldPassword,
+	FieldRole,
+	FieldPreferences,
+	FieldPlaylistsVisibility,
+	FieldActivityVisibility,
+	FieldFollowersVisibility,
+	FieldAnalyticsOptOut,
+	FieldQueue,
+	FieldDeactivatedAt,
+	FieldLoginCountries,
+	FieldSessionsValidAfter,
+	FieldRegion,
+	FieldChangelogSeenAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -50,10 +107,118 @@ var (
 	FirstNameValidator func(string) error
 	// LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
 	LastNameValidator func(string) error
+	// DefaultAnalyticsOptOut holds the default value on creation for the "analytics_opt_out" field.
+	DefaultAnalyticsOptOut bool
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
+// PlaylistsVisibility defines the type for the "playlists_visibility" enum field.
+type PlaylistsVisibility string
+
+// PlaylistsVisibilityPublic is the default value of the PlaylistsVisibility enum.
+const DefaultPlaylistsVisibility = PlaylistsVisibilityPublic
+
+// PlaylistsVisibility values.
+const (
+	PlaylistsVisibilityPublic  PlaylistsVisibility = "public"
+	PlaylistsVisibilityPrivate PlaylistsVisibility = "private"
+)
+
+func (pv PlaylistsVisibility) String() string {
+	return string(pv)
+}
+
+// PlaylistsVisibilityValidator is a validator for the "playlists_visibility" field enum values. It is called by the builders before save.
+func PlaylistsVisibilityValidator(pv PlaylistsVisibility) error {
+	switch pv {
+	case PlaylistsVisibilityPublic, PlaylistsVisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for playlists_visibility field: %q", pv)
+	}
+}
+
+// ActivityVisibility defines the type for the "activity_visibility" enum field.
+type ActivityVisibility string
+
+// ActivityVisibilityPublic is the default value of the ActivityVisibility enum.
+const DefaultActivityVisibility = ActivityVisibilityPublic
+
+// ActivityVisibility values.
+const (
+	ActivityVisibilityPublic  ActivityVisibility = "public"
+	ActivityVisibilityPrivate ActivityVisibility = "private"
+)
+
+func (av ActivityVisibility) String() string {
+	return string(av)
+}
+
+// ActivityVisibilityValidator is a validator for the "activity_visibility" field enum values. It is called by the builders before save.
+func ActivityVisibilityValidator(av ActivityVisibility) error {
+	switch av {
+	case ActivityVisibilityPublic, ActivityVisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for activity_visibility field: %q", av)
+	}
+}
+
+// FollowersVisibility defines the type for the "followers_visibility" enum field.
+type FollowersVisibility string
+
+// FollowersVisibilityPublic is the default value of the FollowersVisibility enum.
+const DefaultFollowersVisibility = FollowersVisibilityPublic
+
+// FollowersVisibility values.
+const (
+	FollowersVisibilityPublic  FollowersVisibility = "public"
+	FollowersVisibilityPrivate FollowersVisibility = "private"
+)
+
+func (fv FollowersVisibility) String() string {
+	return string(fv)
+}
+
+// FollowersVisibilityValidator is a validator for the "followers_visibility" field enum values. It is called by the builders before save.
+func FollowersVisibilityValidator(fv FollowersVisibility) error {
+	switch fv {
+	case FollowersVisibilityPublic, FollowersVisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for followers_visibility field: %q", fv)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -81,3 +246,90 @@ func ByLastName(opts ...sql.OrderTermOption) OrderOption {
 func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByPlaylistsVisibility orders the results by the playlists_visibility field.
+func ByPlaylistsVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaylistsVisibility, opts...).ToFunc()
+}
+
+// ByActivityVisibility orders the results by the activity_visibility field.
+func ByActivityVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityVisibility, opts...).ToFunc()
+}
+
+// ByFollowersVisibility orders the results by the followers_visibility field.
+func ByFollowersVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFollowersVisibility, opts...).ToFunc()
+}
+
+// ByAnalyticsOptOut orders the results by the analytics_opt_out field.
+func ByAnalyticsOptOut(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnalyticsOptOut, opts...).ToFunc()
+}
+
+// ByDeactivatedAt orders the results by the deactivated_at field.
+func ByDeactivatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeactivatedAt, opts...).ToFunc()
+}
+
+// BySessionsValidAfter orders the results by the sessions_valid_after field.
+func BySessionsValidAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionsValidAfter, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByChangelogSeenAt orders the results by the changelog_seen_at field.
+func ByChangelogSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangelogSeenAt, opts...).ToFunc()
+}
+
+// ByPlaysCount orders the results by plays count.
+func ByPlaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPlaysStep(), opts...)
+	}
+}
+
+// ByPlays orders the results by plays terms.
+func ByPlays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFollowingCount orders the results by following count.
+func ByFollowingCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFollowingStep(), opts...)
+	}
+}
+
+// ByFollowing orders the results by following terms.
+func ByFollowing(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFollowingStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPlaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, PlaysTable, PlaysColumn),
+	)
+}
+func newFollowingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FollowingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FollowingTable, FollowingColumn),
+	)
+}
@@ -4,8 +4,10 @@ package user
 
 import (
 	"streamify/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -74,6 +76,31 @@ func Password(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
+// AnalyticsOptOut applies equality check predicate on the "analytics_opt_out" field. It's identical to AnalyticsOptOutEQ.
+func AnalyticsOptOut(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAnalyticsOptOut, v))
+}
+
+// DeactivatedAt applies equality check predicate on the "deactivated_at" field. It's identical to DeactivatedAtEQ.
+func DeactivatedAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
+// SessionsValidAfter applies equality check predicate on the "sessions_valid_after" field. It's identical to SessionsValidAfterEQ.
+func SessionsValidAfter(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionsValidAfter, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRegion, v))
+}
+
+// ChangelogSeenAt applies equality check predicate on the "changelog_seen_at" field. It's identical to ChangelogSeenAtEQ.
+func ChangelogSeenAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldChangelogSeenAt, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -364,6 +391,397 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// PreferencesIsNil applies the IsNil predicate on the "preferences" field.
+func PreferencesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPreferences))
+}
+
+// PreferencesNotNil applies the NotNil predicate on the "preferences" field.
+func PreferencesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPreferences))
+}
+
+// PlaylistsVisibilityEQ applies the EQ predicate on the "playlists_visibility" field.
+func PlaylistsVisibilityEQ(v PlaylistsVisibility) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPlaylistsVisibility, v))
+}
+
+// PlaylistsVisibilityNEQ applies the NEQ predicate on the "playlists_visibility" field.
+func PlaylistsVisibilityNEQ(v PlaylistsVisibility) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPlaylistsVisibility, v))
+}
+
+// PlaylistsVisibilityIn applies the In predicate on the "playlists_visibility" field.
+func PlaylistsVisibilityIn(vs ...PlaylistsVisibility) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPlaylistsVisibility, vs...))
+}
+
+// PlaylistsVisibilityNotIn applies the NotIn predicate on the "playlists_visibility" field.
+func PlaylistsVisibilityNotIn(vs ...PlaylistsVisibility) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPlaylistsVisibility, vs...))
+}
+
+// ActivityVisibilityEQ applies the EQ predicate on the "activity_visibility" field.
+func ActivityVisibilityEQ(v ActivityVisibility) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldActivityVisibility, v))
+}
+
+// ActivityVisibilityNEQ applies the NEQ predicate on the "activity_visibility" field.
+func ActivityVisibilityNEQ(v ActivityVisibility) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldActivityVisibility, v))
+}
+
+// ActivityVisibilityIn applies the In predicate on the "activity_visibility" field.
+func ActivityVisibilityIn(vs ...ActivityVisibility) predicate.User {
+	return predicate.User(sql.FieldIn(FieldActivityVisibility, vs...))
+}
+
+// ActivityVisibilityNotIn applies the NotIn predicate on the "activity_visibility" field.
+func ActivityVisibilityNotIn(vs ...ActivityVisibility) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldActivityVisibility, vs...))
+}
+
+// FollowersVisibilityEQ applies the EQ predicate on the "followers_visibility" field.
+func FollowersVisibilityEQ(v FollowersVisibility) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFollowersVisibility, v))
+}
+
+// FollowersVisibilityNEQ applies the NEQ predicate on the "followers_visibility" field.
+func FollowersVisibilityNEQ(v FollowersVisibility) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFollowersVisibility, v))
+}
+
+// FollowersVisibilityIn applies the In predicate on the "followers_visibility" field.
+func FollowersVisibilityIn(vs ...FollowersVisibility) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFollowersVisibility, vs...))
+}
+
+// FollowersVisibilityNotIn applies the NotIn predicate on the "followers_visibility" field.
+func FollowersVisibilityNotIn(vs ...FollowersVisibility) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFollowersVisibility, vs...))
+}
+
+// AnalyticsOptOutEQ applies the EQ predicate on the "analytics_opt_out" field.
+func AnalyticsOptOutEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldAnalyticsOptOut, v))
+}
+
+// AnalyticsOptOutNEQ applies the NEQ predicate on the "analytics_opt_out" field.
+func AnalyticsOptOutNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldAnalyticsOptOut, v))
+}
+
+// QueueIsNil applies the IsNil predicate on the "queue" field.
+func QueueIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldQueue))
+}
+
+// QueueNotNil applies the NotNil predicate on the "queue" field.
+func QueueNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldQueue))
+}
+
+// DeactivatedAtEQ applies the EQ predicate on the "deactivated_at" field.
+func DeactivatedAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtNEQ applies the NEQ predicate on the "deactivated_at" field.
+func DeactivatedAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIn applies the In predicate on the "deactivated_at" field.
+func DeactivatedAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtNotIn applies the NotIn predicate on the "deactivated_at" field.
+func DeactivatedAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtGT applies the GT predicate on the "deactivated_at" field.
+func DeactivatedAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtGTE applies the GTE predicate on the "deactivated_at" field.
+func DeactivatedAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLT applies the LT predicate on the "deactivated_at" field.
+func DeactivatedAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLTE applies the LTE predicate on the "deactivated_at" field.
+func DeactivatedAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIsNil applies the IsNil predicate on the "deactivated_at" field.
+func DeactivatedAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeactivatedAt))
+}
+
+// DeactivatedAtNotNil applies the NotNil predicate on the "deactivated_at" field.
+func DeactivatedAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeactivatedAt))
+}
+
+// LoginCountriesIsNil applies the IsNil predicate on the "login_countries" field.
+func LoginCountriesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLoginCountries))
+}
+
+// LoginCountriesNotNil applies the NotNil predicate on the "login_countries" field.
+func LoginCountriesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLoginCountries))
+}
+
+// SessionsValidAfterEQ applies the EQ predicate on the "sessions_valid_after" field.
+func SessionsValidAfterEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterNEQ applies the NEQ predicate on the "sessions_valid_after" field.
+func SessionsValidAfterNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterIn applies the In predicate on the "sessions_valid_after" field.
+func SessionsValidAfterIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSessionsValidAfter, vs...))
+}
+
+// SessionsValidAfterNotIn applies the NotIn predicate on the "sessions_valid_after" field.
+func SessionsValidAfterNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSessionsValidAfter, vs...))
+}
+
+// SessionsValidAfterGT applies the GT predicate on the "sessions_valid_after" field.
+func SessionsValidAfterGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterGTE applies the GTE predicate on the "sessions_valid_after" field.
+func SessionsValidAfterGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterLT applies the LT predicate on the "sessions_valid_after" field.
+func SessionsValidAfterLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterLTE applies the LTE predicate on the "sessions_valid_after" field.
+func SessionsValidAfterLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSessionsValidAfter, v))
+}
+
+// SessionsValidAfterIsNil applies the IsNil predicate on the "sessions_valid_after" field.
+func SessionsValidAfterIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSessionsValidAfter))
+}
+
+// SessionsValidAfterNotNil applies the NotNil predicate on the "sessions_valid_after" field.
+func SessionsValidAfterNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSessionsValidAfter))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// ChangelogSeenAtEQ applies the EQ predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtNEQ applies the NEQ predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtIn applies the In predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldChangelogSeenAt, vs...))
+}
+
+// ChangelogSeenAtNotIn applies the NotIn predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldChangelogSeenAt, vs...))
+}
+
+// ChangelogSeenAtGT applies the GT predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtGTE applies the GTE predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtLT applies the LT predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtLTE applies the LTE predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldChangelogSeenAt, v))
+}
+
+// ChangelogSeenAtIsNil applies the IsNil predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldChangelogSeenAt))
+}
+
+// ChangelogSeenAtNotNil applies the NotNil predicate on the "changelog_seen_at" field.
+func ChangelogSeenAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldChangelogSeenAt))
+}
+
+// HasPlays applies the HasEdge predicate on the "plays" edge.
+func HasPlays() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, PlaysTable, PlaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlaysWith applies the HasEdge predicate on the "plays" edge with a given conditions (other predicates).
+func HasPlaysWith(preds ...predicate.Play) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPlaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFollowing applies the HasEdge predicate on the "following" edge.
+func HasFollowing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FollowingTable, FollowingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFollowingWith applies the HasEdge predicate on the "following" edge with a given conditions (other predicates).
+func HasFollowingWith(preds ...predicate.Follow) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFollowingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -6,7 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"streamify/ent/follow"
+	"streamify/ent/play"
 	"streamify/ent/user"
+	"streamify/preferences"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -68,6 +72,150 @@ func (_c *UserCreate) SetNillablePassword(v *string) *UserCreate {
 	return _c
 }
 
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v user.Role) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *user.Role) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetPreferences sets the "preferences" field.
+func (_c *UserCreate) SetPreferences(v preferences.Preferences) *UserCreate {
+	_c.mutation.SetPreferences(v)
+	return _c
+}
+
+// SetPlaylistsVisibility sets the "playlists_visibility" field.
+func (_c *UserCreate) SetPlaylistsVisibility(v user.PlaylistsVisibility) *UserCreate {
+	_c.mutation.SetPlaylistsVisibility(v)
+	return _c
+}
+
+// SetNillablePlaylistsVisibility sets the "playlists_visibility" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePlaylistsVisibility(v *user.PlaylistsVisibility) *UserCreate {
+	if v != nil {
+		_c.SetPlaylistsVisibility(*v)
+	}
+	return _c
+}
+
+// SetActivityVisibility sets the "activity_visibility" field.
+func (_c *UserCreate) SetActivityVisibility(v user.ActivityVisibility) *UserCreate {
+	_c.mutation.SetActivityVisibility(v)
+	return _c
+}
+
+// SetNillableActivityVisibility sets the "activity_visibility" field if the given value is not nil.
+func (_c *UserCreate) SetNillableActivityVisibility(v *user.ActivityVisibility) *UserCreate {
+	if v != nil {
+		_c.SetActivityVisibility(*v)
+	}
+	return _c
+}
+
+// SetFollowersVisibility sets the "followers_visibility" field.
+func (_c *UserCreate) SetFollowersVisibility(v user.FollowersVisibility) *UserCreate {
+	_c.mutation.SetFollowersVisibility(v)
+	return _c
+}
+
+// SetNillableFollowersVisibility sets the "followers_visibility" field if the given value is not nil.
+func (_c *UserCreate) SetNillableFollowersVisibility(v *user.FollowersVisibility) *UserCreate {
+	if v != nil {
+		_c.SetFollowersVisibility(*v)
+	}
+	return _c
+}
+
+// SetAnalyticsOptOut sets the "analytics_opt_out" field.
+func (_c *UserCreate) SetAnalyticsOptOut(v bool) *UserCreate {
+	_c.mutation.SetAnalyticsOptOut(v)
+	return _c
+}
+
+// SetNillableAnalyticsOptOut sets the "analytics_opt_out" field if the given value is not nil.
+func (_c *UserCreate) SetNillableAnalyticsOptOut(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetAnalyticsOptOut(*v)
+	}
+	return _c
+}
+
+// SetQueue sets the "queue" field.
+func (_c *UserCreate) SetQueue(v []uuid.UUID) *UserCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_c *UserCreate) SetDeactivatedAt(v time.Time) *UserCreate {
+	_c.mutation.SetDeactivatedAt(v)
+	return _c
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeactivatedAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetDeactivatedAt(*v)
+	}
+	return _c
+}
+
+// SetLoginCountries sets the "login_countries" field.
+func (_c *UserCreate) SetLoginCountries(v []string) *UserCreate {
+	_c.mutation.SetLoginCountries(v)
+	return _c
+}
+
+// SetSessionsValidAfter sets the "sessions_valid_after" field.
+func (_c *UserCreate) SetSessionsValidAfter(v time.Time) *UserCreate {
+	_c.mutation.SetSessionsValidAfter(v)
+	return _c
+}
+
+// SetNillableSessionsValidAfter sets the "sessions_valid_after" field if the given value is not nil.
+func (_c *UserCreate) SetNillableSessionsValidAfter(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetSessionsValidAfter(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *UserCreate) SetRegion(v string) *UserCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRegion(v *string) *UserCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetChangelogSeenAt sets the "changelog_seen_at" field.
+func (_c *UserCreate) SetChangelogSeenAt(v time.Time) *UserCreate {
+	_c.mutation.SetChangelogSeenAt(v)
+	return _c
+}
+
+// SetNillableChangelogSeenAt sets the "changelog_seen_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableChangelogSeenAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetChangelogSeenAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -82,6 +230,36 @@ func (_c *UserCreate) SetNillableID(v *uuid.UUID) *UserCreate {
 	return _c
 }
 
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_c *UserCreate) AddPlayIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPlayIDs(ids...)
+	return _c
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_c *UserCreate) AddPlays(v ...*Play) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPlayIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (_c *UserCreate) AddFollowingIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddFollowingIDs(ids...)
+	return _c
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (_c *UserCreate) AddFollowing(v ...*Follow) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFollowingIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -117,6 +295,26 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.PlaylistsVisibility(); !ok {
+		v := user.DefaultPlaylistsVisibility
+		_c.mutation.SetPlaylistsVisibility(v)
+	}
+	if _, ok := _c.mutation.ActivityVisibility(); !ok {
+		v := user.DefaultActivityVisibility
+		_c.mutation.SetActivityVisibility(v)
+	}
+	if _, ok := _c.mutation.FollowersVisibility(); !ok {
+		v := user.DefaultFollowersVisibility
+		_c.mutation.SetFollowersVisibility(v)
+	}
+	if _, ok := _c.mutation.AnalyticsOptOut(); !ok {
+		v := user.DefaultAnalyticsOptOut
+		_c.mutation.SetAnalyticsOptOut(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := user.DefaultID()
 		_c.mutation.SetID(v)
@@ -143,6 +341,46 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PlaylistsVisibility(); !ok {
+		return &ValidationError{Name: "playlists_visibility", err: errors.New(`ent: missing required field "User.playlists_visibility"`)}
+	}
+	if v, ok := _c.mutation.PlaylistsVisibility(); ok {
+		if err := user.PlaylistsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "playlists_visibility", err: fmt.Errorf(`ent: validator failed for field "User.playlists_visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ActivityVisibility(); !ok {
+		return &ValidationError{Name: "activity_visibility", err: errors.New(`ent: missing required field "User.activity_visibility"`)}
+	}
+	if v, ok := _c.mutation.ActivityVisibility(); ok {
+		if err := user.ActivityVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "activity_visibility", err: fmt.Errorf(`ent: validator failed for field "User.activity_visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FollowersVisibility(); !ok {
+		return &ValidationError{Name: "followers_visibility", err: errors.New(`ent: missing required field "User.followers_visibility"`)}
+	}
+	if v, ok := _c.mutation.FollowersVisibility(); ok {
+		if err := user.FollowersVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "followers_visibility", err: fmt.Errorf(`ent: validator failed for field "User.followers_visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AnalyticsOptOut(); !ok {
+		return &ValidationError{Name: "analytics_opt_out", err: errors.New(`ent: missing required field "User.analytics_opt_out"`)}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := user.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "User.region": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -194,6 +432,86 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+		_node.Preferences = value
+	}
+	if value, ok := _c.mutation.PlaylistsVisibility(); ok {
+		_spec.SetField(user.FieldPlaylistsVisibility, field.TypeEnum, value)
+		_node.PlaylistsVisibility = value
+	}
+	if value, ok := _c.mutation.ActivityVisibility(); ok {
+		_spec.SetField(user.FieldActivityVisibility, field.TypeEnum, value)
+		_node.ActivityVisibility = value
+	}
+	if value, ok := _c.mutation.FollowersVisibility(); ok {
+		_spec.SetField(user.FieldFollowersVisibility, field.TypeEnum, value)
+		_node.FollowersVisibility = value
+	}
+	if value, ok := _c.mutation.AnalyticsOptOut(); ok {
+		_spec.SetField(user.FieldAnalyticsOptOut, field.TypeBool, value)
+		_node.AnalyticsOptOut = value
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(user.FieldQueue, field.TypeJSON, value)
+		_node.Queue = value
+	}
+	if value, ok := _c.mutation.DeactivatedAt(); ok {
+		_spec.SetField(user.FieldDeactivatedAt, field.TypeTime, value)
+		_node.DeactivatedAt = &value
+	}
+	if value, ok := _c.mutation.LoginCountries(); ok {
+		_spec.SetField(user.FieldLoginCountries, field.TypeJSON, value)
+		_node.LoginCountries = value
+	}
+	if value, ok := _c.mutation.SessionsValidAfter(); ok {
+		_spec.SetField(user.FieldSessionsValidAfter, field.TypeTime, value)
+		_node.SessionsValidAfter = &value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(user.FieldRegion, field.TypeString, value)
+		_node.Region = &value
+	}
+	if value, ok := _c.mutation.ChangelogSeenAt(); ok {
+		_spec.SetField(user.FieldChangelogSeenAt, field.TypeTime, value)
+		_node.ChangelogSeenAt = &value
+	}
+	if nodes := _c.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,12 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"streamify/ent/follow"
+	"streamify/ent/play"
 	"streamify/ent/predicate"
 	"streamify/ent/user"
+	"streamify/preferences"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -101,11 +107,281 @@ func (_u *UserUpdate) ClearPassword() *UserUpdate {
 	return _u
 }
 
+// SetRole sets the "role" field.
+func (_u *UserUpdate) SetRole(v user.Role) *UserUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRole(v *user.Role) *UserUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetPreferences sets the "preferences" field.
+func (_u *UserUpdate) SetPreferences(v preferences.Preferences) *UserUpdate {
+	_u.mutation.SetPreferences(v)
+	return _u
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (_u *UserUpdate) ClearPreferences() *UserUpdate {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
+// SetPlaylistsVisibility sets the "playlists_visibility" field.
+func (_u *UserUpdate) SetPlaylistsVisibility(v user.PlaylistsVisibility) *UserUpdate {
+	_u.mutation.SetPlaylistsVisibility(v)
+	return _u
+}
+
+// SetNillablePlaylistsVisibility sets the "playlists_visibility" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePlaylistsVisibility(v *user.PlaylistsVisibility) *UserUpdate {
+	if v != nil {
+		_u.SetPlaylistsVisibility(*v)
+	}
+	return _u
+}
+
+// SetActivityVisibility sets the "activity_visibility" field.
+func (_u *UserUpdate) SetActivityVisibility(v user.ActivityVisibility) *UserUpdate {
+	_u.mutation.SetActivityVisibility(v)
+	return _u
+}
+
+// SetNillableActivityVisibility sets the "activity_visibility" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableActivityVisibility(v *user.ActivityVisibility) *UserUpdate {
+	if v != nil {
+		_u.SetActivityVisibility(*v)
+	}
+	return _u
+}
+
+// SetFollowersVisibility sets the "followers_visibility" field.
+func (_u *UserUpdate) SetFollowersVisibility(v user.FollowersVisibility) *UserUpdate {
+	_u.mutation.SetFollowersVisibility(v)
+	return _u
+}
+
+// SetNillableFollowersVisibility sets the "followers_visibility" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableFollowersVisibility(v *user.FollowersVisibility) *UserUpdate {
+	if v != nil {
+		_u.SetFollowersVisibility(*v)
+	}
+	return _u
+}
+
+// SetAnalyticsOptOut sets the "analytics_opt_out" field.
+func (_u *UserUpdate) SetAnalyticsOptOut(v bool) *UserUpdate {
+	_u.mutation.SetAnalyticsOptOut(v)
+	return _u
+}
+
+// SetNillableAnalyticsOptOut sets the "analytics_opt_out" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableAnalyticsOptOut(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetAnalyticsOptOut(*v)
+	}
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *UserUpdate) SetQueue(v []uuid.UUID) *UserUpdate {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// AppendQueue appends value to the "queue" field.
+func (_u *UserUpdate) AppendQueue(v []uuid.UUID) *UserUpdate {
+	_u.mutation.AppendQueue(v)
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *UserUpdate) ClearQueue() *UserUpdate {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *UserUpdate) SetDeactivatedAt(v time.Time) *UserUpdate {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDeactivatedAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *UserUpdate) ClearDeactivatedAt() *UserUpdate {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
+// SetLoginCountries sets the "login_countries" field.
+func (_u *UserUpdate) SetLoginCountries(v []string) *UserUpdate {
+	_u.mutation.SetLoginCountries(v)
+	return _u
+}
+
+// AppendLoginCountries appends value to the "login_countries" field.
+func (_u *UserUpdate) AppendLoginCountries(v []string) *UserUpdate {
+	_u.mutation.AppendLoginCountries(v)
+	return _u
+}
+
+// ClearLoginCountries clears the value of the "login_countries" field.
+func (_u *UserUpdate) ClearLoginCountries() *UserUpdate {
+	_u.mutation.ClearLoginCountries()
+	return _u
+}
+
+// SetSessionsValidAfter sets the "sessions_valid_after" field.
+func (_u *UserUpdate) SetSessionsValidAfter(v time.Time) *UserUpdate {
+	_u.mutation.SetSessionsValidAfter(v)
+	return _u
+}
+
+// SetNillableSessionsValidAfter sets the "sessions_valid_after" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableSessionsValidAfter(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetSessionsValidAfter(*v)
+	}
+	return _u
+}
+
+// ClearSessionsValidAfter clears the value of the "sessions_valid_after" field.
+func (_u *UserUpdate) ClearSessionsValidAfter() *UserUpdate {
+	_u.mutation.ClearSessionsValidAfter()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *UserUpdate) SetRegion(v string) *UserUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRegion(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *UserUpdate) ClearRegion() *UserUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetChangelogSeenAt sets the "changelog_seen_at" field.
+func (_u *UserUpdate) SetChangelogSeenAt(v time.Time) *UserUpdate {
+	_u.mutation.SetChangelogSeenAt(v)
+	return _u
+}
+
+// SetNillableChangelogSeenAt sets the "changelog_seen_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableChangelogSeenAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetChangelogSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearChangelogSeenAt clears the value of the "changelog_seen_at" field.
+func (_u *UserUpdate) ClearChangelogSeenAt() *UserUpdate {
+	_u.mutation.ClearChangelogSeenAt()
+	return _u
+}
+
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_u *UserUpdate) AddPlayIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPlayIDs(ids...)
+	return _u
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_u *UserUpdate) AddPlays(v ...*Play) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlayIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (_u *UserUpdate) AddFollowingIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddFollowingIDs(ids...)
+	return _u
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (_u *UserUpdate) AddFollowing(v ...*Follow) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowingIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPlays clears all "plays" edges to the Play entity.
+func (_u *UserUpdate) ClearPlays() *UserUpdate {
+	_u.mutation.ClearPlays()
+	return _u
+}
+
+// RemovePlayIDs removes the "plays" edge to Play entities by IDs.
+func (_u *UserUpdate) RemovePlayIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePlayIDs(ids...)
+	return _u
+}
+
+// RemovePlays removes "plays" edges to Play entities.
+func (_u *UserUpdate) RemovePlays(v ...*Play) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlayIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the Follow entity.
+func (_u *UserUpdate) ClearFollowing() *UserUpdate {
+	_u.mutation.ClearFollowing()
+	return _u
+}
+
+// RemoveFollowingIDs removes the "following" edge to Follow entities by IDs.
+func (_u *UserUpdate) RemoveFollowingIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveFollowingIDs(ids...)
+	return _u
+}
+
+// RemoveFollowing removes "following" edges to Follow entities.
+func (_u *UserUpdate) RemoveFollowing(v ...*Follow) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -150,6 +426,31 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlaylistsVisibility(); ok {
+		if err := user.PlaylistsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "playlists_visibility", err: fmt.Errorf(`ent: validator failed for field "User.playlists_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActivityVisibility(); ok {
+		if err := user.ActivityVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "activity_visibility", err: fmt.Errorf(`ent: validator failed for field "User.activity_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FollowersVisibility(); ok {
+		if err := user.FollowersVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "followers_visibility", err: fmt.Errorf(`ent: validator failed for field "User.followers_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := user.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "User.region": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -186,6 +487,163 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferencesCleared() {
+		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PlaylistsVisibility(); ok {
+		_spec.SetField(user.FieldPlaylistsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActivityVisibility(); ok {
+		_spec.SetField(user.FieldActivityVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FollowersVisibility(); ok {
+		_spec.SetField(user.FieldFollowersVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AnalyticsOptOut(); ok {
+		_spec.SetField(user.FieldAnalyticsOptOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(user.FieldQueue, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldQueue, value)
+		})
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(user.FieldQueue, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(user.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(user.FieldDeactivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LoginCountries(); ok {
+		_spec.SetField(user.FieldLoginCountries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLoginCountries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldLoginCountries, value)
+		})
+	}
+	if _u.mutation.LoginCountriesCleared() {
+		_spec.ClearField(user.FieldLoginCountries, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SessionsValidAfter(); ok {
+		_spec.SetField(user.FieldSessionsValidAfter, field.TypeTime, value)
+	}
+	if _u.mutation.SessionsValidAfterCleared() {
+		_spec.ClearField(user.FieldSessionsValidAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(user.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(user.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangelogSeenAt(); ok {
+		_spec.SetField(user.FieldChangelogSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChangelogSeenAtCleared() {
+		_spec.ClearField(user.FieldChangelogSeenAt, field.TypeTime)
+	}
+	if _u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaysIDs(); len(nodes) > 0 && !_u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -280,11 +738,281 @@ func (_u *UserUpdateOne) ClearPassword() *UserUpdateOne {
 	return _u
 }
 
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetPreferences sets the "preferences" field.
+func (_u *UserUpdateOne) SetPreferences(v preferences.Preferences) *UserUpdateOne {
+	_u.mutation.SetPreferences(v)
+	return _u
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (_u *UserUpdateOne) ClearPreferences() *UserUpdateOne {
+	_u.mutation.ClearPreferences()
+	return _u
+}
+
+// SetPlaylistsVisibility sets the "playlists_visibility" field.
+func (_u *UserUpdateOne) SetPlaylistsVisibility(v user.PlaylistsVisibility) *UserUpdateOne {
+	_u.mutation.SetPlaylistsVisibility(v)
+	return _u
+}
+
+// SetNillablePlaylistsVisibility sets the "playlists_visibility" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePlaylistsVisibility(v *user.PlaylistsVisibility) *UserUpdateOne {
+	if v != nil {
+		_u.SetPlaylistsVisibility(*v)
+	}
+	return _u
+}
+
+// SetActivityVisibility sets the "activity_visibility" field.
+func (_u *UserUpdateOne) SetActivityVisibility(v user.ActivityVisibility) *UserUpdateOne {
+	_u.mutation.SetActivityVisibility(v)
+	return _u
+}
+
+// SetNillableActivityVisibility sets the "activity_visibility" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableActivityVisibility(v *user.ActivityVisibility) *UserUpdateOne {
+	if v != nil {
+		_u.SetActivityVisibility(*v)
+	}
+	return _u
+}
+
+// SetFollowersVisibility sets the "followers_visibility" field.
+func (_u *UserUpdateOne) SetFollowersVisibility(v user.FollowersVisibility) *UserUpdateOne {
+	_u.mutation.SetFollowersVisibility(v)
+	return _u
+}
+
+// SetNillableFollowersVisibility sets the "followers_visibility" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFollowersVisibility(v *user.FollowersVisibility) *UserUpdateOne {
+	if v != nil {
+		_u.SetFollowersVisibility(*v)
+	}
+	return _u
+}
+
+// SetAnalyticsOptOut sets the "analytics_opt_out" field.
+func (_u *UserUpdateOne) SetAnalyticsOptOut(v bool) *UserUpdateOne {
+	_u.mutation.SetAnalyticsOptOut(v)
+	return _u
+}
+
+// SetNillableAnalyticsOptOut sets the "analytics_opt_out" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAnalyticsOptOut(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetAnalyticsOptOut(*v)
+	}
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *UserUpdateOne) SetQueue(v []uuid.UUID) *UserUpdateOne {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// AppendQueue appends value to the "queue" field.
+func (_u *UserUpdateOne) AppendQueue(v []uuid.UUID) *UserUpdateOne {
+	_u.mutation.AppendQueue(v)
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *UserUpdateOne) ClearQueue() *UserUpdateOne {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *UserUpdateOne) SetDeactivatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeactivatedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *UserUpdateOne) ClearDeactivatedAt() *UserUpdateOne {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
+// SetLoginCountries sets the "login_countries" field.
+func (_u *UserUpdateOne) SetLoginCountries(v []string) *UserUpdateOne {
+	_u.mutation.SetLoginCountries(v)
+	return _u
+}
+
+// AppendLoginCountries appends value to the "login_countries" field.
+func (_u *UserUpdateOne) AppendLoginCountries(v []string) *UserUpdateOne {
+	_u.mutation.AppendLoginCountries(v)
+	return _u
+}
+
+// ClearLoginCountries clears the value of the "login_countries" field.
+func (_u *UserUpdateOne) ClearLoginCountries() *UserUpdateOne {
+	_u.mutation.ClearLoginCountries()
+	return _u
+}
+
+// SetSessionsValidAfter sets the "sessions_valid_after" field.
+func (_u *UserUpdateOne) SetSessionsValidAfter(v time.Time) *UserUpdateOne {
+	_u.mutation.SetSessionsValidAfter(v)
+	return _u
+}
+
+// SetNillableSessionsValidAfter sets the "sessions_valid_after" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSessionsValidAfter(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetSessionsValidAfter(*v)
+	}
+	return _u
+}
+
+// ClearSessionsValidAfter clears the value of the "sessions_valid_after" field.
+func (_u *UserUpdateOne) ClearSessionsValidAfter() *UserUpdateOne {
+	_u.mutation.ClearSessionsValidAfter()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *UserUpdateOne) SetRegion(v string) *UserUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRegion(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *UserUpdateOne) ClearRegion() *UserUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetChangelogSeenAt sets the "changelog_seen_at" field.
+func (_u *UserUpdateOne) SetChangelogSeenAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetChangelogSeenAt(v)
+	return _u
+}
+
+// SetNillableChangelogSeenAt sets the "changelog_seen_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableChangelogSeenAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetChangelogSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearChangelogSeenAt clears the value of the "changelog_seen_at" field.
+func (_u *UserUpdateOne) ClearChangelogSeenAt() *UserUpdateOne {
+	_u.mutation.ClearChangelogSeenAt()
+	return _u
+}
+
+// AddPlayIDs adds the "plays" edge to the Play entity by IDs.
+func (_u *UserUpdateOne) AddPlayIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPlayIDs(ids...)
+	return _u
+}
+
+// AddPlays adds the "plays" edges to the Play entity.
+func (_u *UserUpdateOne) AddPlays(v ...*Play) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPlayIDs(ids...)
+}
+
+// AddFollowingIDs adds the "following" edge to the Follow entity by IDs.
+func (_u *UserUpdateOne) AddFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddFollowingIDs(ids...)
+	return _u
+}
+
+// AddFollowing adds the "following" edges to the Follow entity.
+func (_u *UserUpdateOne) AddFollowing(v ...*Follow) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFollowingIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
+// ClearPlays clears all "plays" edges to the Play entity.
+func (_u *UserUpdateOne) ClearPlays() *UserUpdateOne {
+	_u.mutation.ClearPlays()
+	return _u
+}
+
+// RemovePlayIDs removes the "plays" edge to Play entities by IDs.
+func (_u *UserUpdateOne) RemovePlayIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePlayIDs(ids...)
+	return _u
+}
+
+// RemovePlays removes "plays" edges to Play entities.
+func (_u *UserUpdateOne) RemovePlays(v ...*Play) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePlayIDs(ids...)
+}
+
+// ClearFollowing clears all "following" edges to the Follow entity.
+func (_u *UserUpdateOne) ClearFollowing() *UserUpdateOne {
+	_u.mutation.ClearFollowing()
+	return _u
+}
+
+// RemoveFollowingIDs removes the "following" edge to Follow entities by IDs.
+func (_u *UserUpdateOne) RemoveFollowingIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveFollowingIDs(ids...)
+	return _u
+}
+
+// RemoveFollowing removes "following" edges to Follow entities.
+func (_u *UserUpdateOne) RemoveFollowing(v ...*Follow) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFollowingIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -342,6 +1070,31 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlaylistsVisibility(); ok {
+		if err := user.PlaylistsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "playlists_visibility", err: fmt.Errorf(`ent: validator failed for field "User.playlists_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActivityVisibility(); ok {
+		if err := user.ActivityVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "activity_visibility", err: fmt.Errorf(`ent: validator failed for field "User.activity_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FollowersVisibility(); ok {
+		if err := user.FollowersVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "followers_visibility", err: fmt.Errorf(`ent: validator failed for field "User.followers_visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := user.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "User.region": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -395,6 +1148,163 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.PasswordCleared() {
 		_spec.ClearField(user.FieldPassword, field.TypeString)
 	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Preferences(); ok {
+		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
+	}
+	if _u.mutation.PreferencesCleared() {
+		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PlaylistsVisibility(); ok {
+		_spec.SetField(user.FieldPlaylistsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ActivityVisibility(); ok {
+		_spec.SetField(user.FieldActivityVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FollowersVisibility(); ok {
+		_spec.SetField(user.FieldFollowersVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AnalyticsOptOut(); ok {
+		_spec.SetField(user.FieldAnalyticsOptOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(user.FieldQueue, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedQueue(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldQueue, value)
+		})
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(user.FieldQueue, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(user.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(user.FieldDeactivatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LoginCountries(); ok {
+		_spec.SetField(user.FieldLoginCountries, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLoginCountries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldLoginCountries, value)
+		})
+	}
+	if _u.mutation.LoginCountriesCleared() {
+		_spec.ClearField(user.FieldLoginCountries, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SessionsValidAfter(); ok {
+		_spec.SetField(user.FieldSessionsValidAfter, field.TypeTime, value)
+	}
+	if _u.mutation.SessionsValidAfterCleared() {
+		_spec.ClearField(user.FieldSessionsValidAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(user.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(user.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChangelogSeenAt(); ok {
+		_spec.SetField(user.FieldChangelogSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChangelogSeenAtCleared() {
+		_spec.ClearField(user.FieldChangelogSeenAt, field.TypeTime)
+	}
+	if _u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPlaysIDs(); len(nodes) > 0 && !_u.mutation.PlaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlaysTable,
+			Columns: []string{user.PlaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(play.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFollowingIDs(); len(nodes) > 0 && !_u.mutation.FollowingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FollowingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.FollowingTable,
+			Columns: []string{user.FollowingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(follow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
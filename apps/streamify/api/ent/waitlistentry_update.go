@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"streamify/ent/invite"
+	"streamify/ent/predicate"
+	"streamify/ent/waitlistentry"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetInviteID sets the "invite_id" field.
+func (_u *WaitlistEntryUpdate) SetInviteID(v uuid.UUID) *WaitlistEntryUpdate {
+	_u.mutation.SetInviteID(v)
+	return _u
+}
+
+// SetNillableInviteID sets the "invite_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableInviteID(v *uuid.UUID) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetInviteID(*v)
+	}
+	return _u
+}
+
+// ClearInviteID clears the value of the "invite_id" field.
+func (_u *WaitlistEntryUpdate) ClearInviteID() *WaitlistEntryUpdate {
+	_u.mutation.ClearInviteID()
+	return _u
+}
+
+// SetInvitedAt sets the "invited_at" field.
+func (_u *WaitlistEntryUpdate) SetInvitedAt(v time.Time) *WaitlistEntryUpdate {
+	_u.mutation.SetInvitedAt(v)
+	return _u
+}
+
+// SetNillableInvitedAt sets the "invited_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdate) SetNillableInvitedAt(v *time.Time) *WaitlistEntryUpdate {
+	if v != nil {
+		_u.SetInvitedAt(*v)
+	}
+	return _u
+}
+
+// ClearInvitedAt clears the value of the "invited_at" field.
+func (_u *WaitlistEntryUpdate) ClearInvitedAt() *WaitlistEntryUpdate {
+	_u.mutation.ClearInvitedAt()
+	return _u
+}
+
+// SetInvite sets the "invite" edge to the Invite entity.
+func (_u *WaitlistEntryUpdate) SetInvite(v *Invite) *WaitlistEntryUpdate {
+	return _u.SetInviteID(v.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// ClearInvite clears the "invite" edge to the Invite entity.
+func (_u *WaitlistEntryUpdate) ClearInvite() *WaitlistEntryUpdate {
+	_u.mutation.ClearInvite()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WaitlistEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InvitedAt(); ok {
+		_spec.SetField(waitlistentry.FieldInvitedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InvitedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldInvitedAt, field.TypeTime)
+	}
+	if _u.mutation.InviteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   waitlistentry.InviteTable,
+			Columns: []string{waitlistentry.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   waitlistentry.InviteTable,
+			Columns: []string{waitlistentry.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetInviteID sets the "invite_id" field.
+func (_u *WaitlistEntryUpdateOne) SetInviteID(v uuid.UUID) *WaitlistEntryUpdateOne {
+	_u.mutation.SetInviteID(v)
+	return _u
+}
+
+// SetNillableInviteID sets the "invite_id" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableInviteID(v *uuid.UUID) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetInviteID(*v)
+	}
+	return _u
+}
+
+// ClearInviteID clears the value of the "invite_id" field.
+func (_u *WaitlistEntryUpdateOne) ClearInviteID() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearInviteID()
+	return _u
+}
+
+// SetInvitedAt sets the "invited_at" field.
+func (_u *WaitlistEntryUpdateOne) SetInvitedAt(v time.Time) *WaitlistEntryUpdateOne {
+	_u.mutation.SetInvitedAt(v)
+	return _u
+}
+
+// SetNillableInvitedAt sets the "invited_at" field if the given value is not nil.
+func (_u *WaitlistEntryUpdateOne) SetNillableInvitedAt(v *time.Time) *WaitlistEntryUpdateOne {
+	if v != nil {
+		_u.SetInvitedAt(*v)
+	}
+	return _u
+}
+
+// ClearInvitedAt clears the value of the "invited_at" field.
+func (_u *WaitlistEntryUpdateOne) ClearInvitedAt() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearInvitedAt()
+	return _u
+}
+
+// SetInvite sets the "invite" edge to the Invite entity.
+func (_u *WaitlistEntryUpdateOne) SetInvite(v *Invite) *WaitlistEntryUpdateOne {
+	return _u.SetInviteID(v.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (_u *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return _u.mutation
+}
+
+// ClearInvite clears the "invite" edge to the Invite entity.
+func (_u *WaitlistEntryUpdateOne) ClearInvite() *WaitlistEntryUpdateOne {
+	_u.mutation.ClearInvite()
+	return _u
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (_u *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (_u *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InvitedAt(); ok {
+		_spec.SetField(waitlistentry.FieldInvitedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InvitedAtCleared() {
+		_spec.ClearField(waitlistentry.FieldInvitedAt, field.TypeTime)
+	}
+	if _u.mutation.InviteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   waitlistentry.InviteTable,
+			Columns: []string{waitlistentry.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   waitlistentry.InviteTable,
+			Columns: []string{waitlistentry.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,108 @@
+package entitlements
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/entitlement"
+	"streamify/ent/privacy"
+	"streamify/ent/user"
+	"streamify/ids"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Mine returns the current user's active entitlements
+func Mine(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		active, err := Active(c.Request.Context(), client, userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, active)
+	}
+}
+
+// GrantRequest is the request body for Grant
+type GrantRequest struct {
+	Feature   string     `json:"feature" binding:"required,oneof=premium downloads"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// Grant gives a user an entitlement (admin)
+func Grant(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		var req GrantRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be in the future"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		exists, err := client.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return
+		}
+		e, err := client.Entitlement.Create().
+			SetUserID(userID).
+			SetFeature(entitlement.Feature(req.Feature)).
+			SetSource(entitlement.SourceAdmin).
+			SetNillableExpiresAt(req.ExpiresAt).
+			Save(ctx)
+		if err != nil {
+			if errors.Is(err, privacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, e)
+	}
+}
+
+// Revoke ends an entitlement immediately (admin)
+func Revoke(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid entitlement ID"})
+			return
+		}
+		e, err := client.Entitlement.UpdateOneID(id).
+			SetExpiresAt(time.Now()).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "entitlement not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, e)
+	}
+}
@@ -0,0 +1,256 @@
+// Package errtrack reports panics and server errors, with their stack and
+// request context, to an error tracker such as Sentry or Rollbar.
+package errtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"streamify/resilience"
+)
+
+// Request is the request an event happened in. Headers, query strings and
+// bodies are left out since they can carry credentials.
+type Request struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Route     string `json:"route"`
+	ClientIP  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Event is one reported error
+type Event struct {
+	ID      string    `json:"id"`
+	Time    time.Time `json:"time"`
+	Message string    `json:"message"`
+	// Panic is true when the handler panicked rather than answering 500
+	Panic bool   `json:"panic"`
+	Stack string `json:"stack,omitempty"`
+	// UserID is the authenticated user, if any
+	UserID      string            `json:"user_id,omitempty"`
+	Request     Request           `json:"request"`
+	Release     string            `json:"release"`
+	Environment string            `json:"environment"`
+	Tags        map[string]string `json:"tags"`
+}
+
+// Reporter is the interface implemented by error trackers
+type Reporter interface {
+	Report(ctx context.Context, e Event) error
+}
+
+// Release identifies the running build: APP_RELEASE when set, otherwise the VCS
+// revision the binary was built from
+func Release() string {
+	if v := os.Getenv("APP_RELEASE"); v != "" {
+		return v
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	var revision string
+	dirty := false
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			dirty = s.Value == "true"
+		}
+	}
+	if revision == "" {
+		if v := info.Main.Version; v != "" && v != "(devel)" {
+			return v
+		}
+		return "dev"
+	}
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	if dirty {
+		revision += "-dirty"
+	}
+	return revision
+}
+
+// post sends body as JSON and treats any non-2xx answer as a failure
+func post(ctx context.Context, client *http.Client, endpoint string, headers map[string]string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned %s", req.URL.Host, resp.Status)
+	}
+	return nil
+}
+
+// Sentry sends events to a Sentry project's store endpoint
+type Sentry struct {
+	endpoint string
+	key      string
+	Client   *http.Client
+}
+
+// NewSentry parses a Sentry DSN of the form https://<key>@<host>/<project>
+func NewSentry(dsn string) (*Sentry, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SENTRY_DSN: %w", err)
+	}
+	project := strings.Trim(u.Path, "/")
+	if u.User == nil || u.User.Username() == "" || u.Host == "" || project == "" {
+		return nil, errors.New("invalid SENTRY_DSN: want https://<key>@<host>/<project>")
+	}
+	base := *u
+	base.User, base.Path = nil, ""
+	return &Sentry{
+		endpoint: base.String() + "/api/" + project + "/store/",
+		key:      u.User.Username(),
+	}, nil
+}
+
+// Report implements Reporter
+func (s *Sentry) Report(ctx context.Context, e Event) error {
+	kind := "error"
+	if e.Panic {
+		kind = "panic"
+	}
+	body := map[string]any{
+		"event_id":    strings.ReplaceAll(e.ID, "-", ""),
+		"timestamp":   e.Time.UTC().Format(time.RFC3339Nano),
+		"level":       "error",
+		"platform":    "go",
+		"logger":      "streamify",
+		"release":     e.Release,
+		"environment": e.Environment,
+		"transaction": e.Request.Method + " " + e.Request.Route,
+		"exception": map[string]any{"values": []map[string]any{{
+			"type":  kind,
+			"value": e.Message,
+		}}},
+		"request": map[string]any{
+			"method": e.Request.Method,
+			"url":    e.Request.Path,
+			"env":    map[string]string{"REMOTE_ADDR": e.Request.ClientIP},
+		},
+		"tags":  e.Tags,
+		"extra": map[string]any{"stack": e.Stack, "request_id": e.Request.RequestID, "user_agent": e.Request.UserAgent},
+	}
+	if e.UserID != "" {
+		body["user"] = map[string]string{"id": e.UserID}
+	}
+	auth := "Sentry sentry_version=7, sentry_client=streamify-errtrack/1.0, sentry_key=" + s.key
+	return post(ctx, s.Client, s.endpoint, map[string]string{"X-Sentry-Auth": auth}, body)
+}
+
+const rollbarURL = "https://api.rollbar.com/api/1/item/"
+
+// Rollbar sends events to Rollbar's item API with a post_server_item token
+type Rollbar struct {
+	Token  string
+	URL    string
+	Client *http.Client
+}
+
+// Report implements Reporter
+func (r *Rollbar) Report(ctx context.Context, e Event) error {
+	endpoint := r.URL
+	if endpoint == "" {
+		endpoint = rollbarURL
+	}
+	text := e.Message
+	if e.Stack != "" {
+		text += "\n\n" + e.Stack
+	}
+	data := map[string]any{
+		"uuid":         e.ID,
+		"timestamp":    e.Time.Unix(),
+		"environment":  e.Environment,
+		"level":        "error",
+		"platform":     "go",
+		"language":     "go",
+		"code_version": e.Release,
+		"context":      e.Request.Method + " " + e.Request.Route,
+		"body":         map[string]any{"message": map[string]any{"body": text, "panic": e.Panic}},
+		"request": map[string]any{
+			"method":  e.Request.Method,
+			"url":     e.Request.Path,
+			"user_ip": e.Request.ClientIP,
+		},
+		"custom": e.Tags,
+	}
+	if e.UserID != "" {
+		data["person"] = map[string]string{"id": e.UserID}
+	}
+	return post(ctx, r.Client, endpoint, map[string]string{"X-Rollbar-Access-Token": r.Token}, map[string]any{"data": data})
+}
+
+// FromEnv returns the reporter selected by ERROR_REPORTER ("sentry" with
+// SENTRY_DSN, or "rollbar" with ROLLBAR_TOKEN), or nil when none is configured
+func FromEnv() (Reporter, error) {
+	switch provider := os.Getenv("ERROR_REPORTER"); provider {
+	case "":
+		return nil, nil
+	case "sentry":
+		dsn := os.Getenv("SENTRY_DSN")
+		if dsn == "" {
+			return nil, errors.New("SENTRY_DSN is required when ERROR_REPORTER=sentry")
+		}
+		return NewSentry(dsn)
+	case "rollbar":
+		token := os.Getenv("ROLLBAR_TOKEN")
+		if token == "" {
+			return nil, errors.New("ROLLBAR_TOKEN is required when ERROR_REPORTER=rollbar")
+		}
+		return &Rollbar{Token: token}, nil
+	default:
+		return nil, fmt.Errorf("unknown ERROR_REPORTER %q (want sentry or rollbar)", provider)
+	}
+}
+
+type resilient struct {
+	r   Reporter
+	dep *resilience.Dependency
+}
+
+// Resilient wraps r so reports go through dep's breaker and retry policy. Events
+// carry their ID, so a retried report is deduplicated by the tracker.
+func Resilient(r Reporter, dep *resilience.Dependency) Reporter {
+	return &resilient{r: r, dep: dep}
+}
+
+// Report implements Reporter
+func (r *resilient) Report(ctx context.Context, e Event) error {
+	return r.dep.Do(ctx, func(ctx context.Context) error {
+		return r.r.Report(ctx, e)
+	})
+}
@@ -0,0 +1,160 @@
+package errtrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"streamify/logging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("errors")
+
+// maxCaptured is how much of a 500 response body is kept for the report
+const maxCaptured = 4 << 10
+
+// captureWriter holds back the body of a 500 so internals in it (SQL errors,
+// file paths) reach the error tracker instead of the client
+type captureWriter struct {
+	gin.ResponseWriter
+	capturing bool
+	body      []byte
+}
+
+func (w *captureWriter) WriteHeader(code int) {
+	w.capturing = code == http.StatusInternalServerError && !w.ResponseWriter.Written()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	if w.capturing {
+		if room := maxCaptured - len(w.body); room > 0 {
+			w.body = append(w.body, b[:min(room, len(b))]...)
+		}
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *captureWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// message extracts the error from a captured {"error": ...} body
+func message(body []byte) string {
+	var v struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(body, &v) == nil && v.Error != "" {
+		return v.Error
+	}
+	if len(body) > 0 {
+		return string(body)
+	}
+	return "internal server error"
+}
+
+// userID is the user v acts as, or empty for anonymous requests and guests
+func userID(v *viewer.Viewer) string {
+	if v == nil || v.UserID == uuid.Nil {
+		return ""
+	}
+	return v.UserID.String()
+}
+
+// Recover turns panics into 500s and replaces the body of every 500 with a
+// generic message and an error ID. The original error, the stack for panics, and
+// the request context are logged and sent to r, which may be nil. Register it
+// before other middleware so panics in them are caught too.
+func Recover(r Reporter) gin.HandlerFunc {
+	release := Release()
+	environment := os.Getenv("APP_ENV")
+	if environment == "" {
+		environment = "production"
+	}
+
+	return func(c *gin.Context) {
+		w := &captureWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		defer func() {
+			p := recover()
+			if p == http.ErrAbortHandler {
+				// The handler deliberately aborted the connection
+				panic(p)
+			}
+			if p == nil && !w.capturing {
+				return
+			}
+
+			v := viewer.FromContext(c.Request.Context())
+			e := Event{
+				ID:          uuid.NewString(),
+				Time:        time.Now(),
+				UserID:      userID(v),
+				Release:     release,
+				Environment: environment,
+				Request: Request{
+					Method:    c.Request.Method,
+					Path:      c.Request.URL.Path,
+					Route:     c.FullPath(),
+					ClientIP:  c.ClientIP(),
+					UserAgent: c.Request.UserAgent(),
+					RequestID: c.GetHeader("X-Request-ID"),
+				},
+				Tags: map[string]string{
+					"release":     release,
+					"environment": environment,
+					"method":      c.Request.Method,
+					"route":       c.FullPath(),
+					"status":      strconv.Itoa(http.StatusInternalServerError),
+				},
+			}
+			if v.Impersonated() {
+				e.Tags["impersonator_id"] = v.ImpersonatorID.String()
+			}
+			if p != nil {
+				e.Panic = true
+				e.Message = fmt.Sprint(p)
+				e.Stack = string(debug.Stack())
+				logger.Error("panic", "error_id", e.ID, "route", e.Tags["route"], "panic", e.Message, "stack", e.Stack)
+			} else {
+				e.Message = message(w.body)
+				logger.Error("server error", "error_id", e.ID, "route", e.Tags["route"], "error", e.Message)
+			}
+			if r != nil {
+				go func() {
+					ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+					defer cancel()
+					if err := r.Report(ctx, e); err != nil {
+						logger.Warn("failed reporting error", "error_id", e.ID, "error", err)
+					}
+				}()
+			}
+
+			// Nothing more can be sent once a streamed response has started
+			inner := w.ResponseWriter
+			c.Writer = inner
+			if p != nil && inner.Written() {
+				c.Abort()
+				return
+			}
+			inner.Header().Set("Content-Type", "application/json; charset=utf-8")
+			inner.WriteHeader(http.StatusInternalServerError)
+			body, _ := json.Marshal(gin.H{"error": "internal server error", "error_id": e.ID})
+			inner.Write(body)
+			c.Abort()
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserRegistered is emitted when an account is created
+type UserRegistered struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+	// InviteCode is the code redeemed at signup, if any
+	InviteCode string `json:"invite_code,omitempty"`
+	// ClaimedGuestState is true when a guest session's state was migrated into the account
+	ClaimedGuestState bool `json:"claimed_guest_state" binding:"required"`
+}
+
+// PlayRecorded is emitted when a user plays a track
+type PlayRecorded struct {
+	PlayID    uuid.UUID `json:"play_id" binding:"required"`
+	UserID    uuid.UUID `json:"user_id" binding:"required"`
+	TrackID   uuid.UUID `json:"track_id" binding:"required"`
+	PlayedAt  time.Time `json:"played_at" binding:"required"`
+	Territory string    `json:"territory,omitempty" binding:"omitempty,len=2"`
+	// Pseudonymous is set when UserID is a pseudonym, changing daily, and
+	// PlayID one that can't be looked up, for a user who opted out of analytics
+	Pseudonymous bool `json:"pseudonymous,omitempty"`
+}
+
+// TrackLiked is emitted when a user likes a track
+type TrackLiked struct {
+	UserID  uuid.UUID `json:"user_id" binding:"required"`
+	TrackID uuid.UUID `json:"track_id" binding:"required"`
+}
+
+// TrackUnliked is emitted when a user removes a like
+type TrackUnliked struct {
+	UserID  uuid.UUID `json:"user_id" binding:"required"`
+	TrackID uuid.UUID `json:"track_id" binding:"required"`
+}
+
+// PlaylistCreated is emitted when a user creates a playlist
+type PlaylistCreated struct {
+	PlaylistID uuid.UUID `json:"playlist_id" binding:"required"`
+	OwnerID    uuid.UUID `json:"owner_id" binding:"required"`
+	Public     bool      `json:"public" binding:"required"`
+}
+
+// UserFollowed is emitted when a user follows another
+type UserFollowed struct {
+	FollowerID uuid.UUID `json:"follower_id" binding:"required"`
+	FolloweeID uuid.UUID `json:"followee_id" binding:"required"`
+}
+
+// UserUnfollowed is emitted when a user stops following another
+type UserUnfollowed struct {
+	FollowerID uuid.UUID `json:"follower_id" binding:"required"`
+	FolloweeID uuid.UUID `json:"followee_id" binding:"required"`
+}
+
+// PolicyPublished is emitted when a new terms of service or privacy policy version is published
+type PolicyPublished struct {
+	VersionID uuid.UUID `json:"version_id" binding:"required"`
+	Kind      string    `json:"kind" binding:"required,oneof=terms privacy"`
+	Version   string    `json:"version" binding:"required,max=64"`
+}
+
+func init() {
+	register("user.registered", 1, "An account was created", UserRegistered{})
+	register("play.recorded", 1, "A user played a track", PlayRecorded{})
+	register("track.liked", 1, "A user liked a track", TrackLiked{})
+	register("track.unliked", 1, "A user removed a like", TrackUnliked{})
+	register("playlist.created", 1, "A user created a playlist", PlaylistCreated{})
+	register("user.followed", 1, "A user followed another user", UserFollowed{})
+	register("user.unfollowed", 1, "A user stopped following another user", UserUnfollowed{})
+	register("policy.published", 1, "A new terms of service or privacy policy version was published", PolicyPublished{})
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+
+	"streamify/openapi"
+)
+
+// Breaking lists the changes from prev to next that a consumer written against prev
+// could trip over. Additions (new optional properties, tighter constraints) are
+// not breaking; removals, retyping, relaxing nullability or requiredness and new
+// enum values are.
+func Breaking(prev, next *openapi.Schema) []string {
+	var out []string
+	breaking("", prev, next, &out)
+	return out
+}
+
+func breaking(path string, prev, next *openapi.Schema, out *[]string) {
+	at := path
+	if at == "" {
+		at = "/"
+	}
+	fail := func(format string, args ...any) {
+		*out = append(*out, at+": "+fmt.Sprintf(format, args...))
+	}
+	if prev == nil {
+		return
+	}
+	if next == nil {
+		fail("schema removed")
+		return
+	}
+	if prev.Type != next.Type {
+		fail("type changed from %q to %q", prev.Type, next.Type)
+		return
+	}
+	if prev.Format != next.Format {
+		fail("format changed from %q to %q", prev.Format, next.Format)
+	}
+	if next.Nullable && !prev.Nullable {
+		fail("became nullable")
+	}
+	if len(prev.Enum) > 0 {
+		known := make(map[string]bool, len(prev.Enum))
+		for _, v := range prev.Enum {
+			known[v] = true
+		}
+		for _, v := range next.Enum {
+			if !known[v] {
+				fail("enum value %q added", v)
+			}
+		}
+		if len(next.Enum) == 0 {
+			fail("enum restriction removed")
+		}
+	}
+
+	required := make(map[string]bool, len(next.Required))
+	for _, name := range next.Required {
+		required[name] = true
+	}
+	for _, name := range prev.Required {
+		if !required[name] {
+			fail("property %q no longer required", name)
+		}
+	}
+
+	names := make([]string, 0, len(prev.Properties))
+	for name := range prev.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prop, ok := next.Properties[name]
+		if !ok {
+			fail("property %q removed", name)
+			continue
+		}
+		breaking(path+"/"+name, prev.Properties[name], prop, out)
+	}
+	if prev.Items != nil {
+		breaking(path+"/items", prev.Items, next.Items, out)
+	}
+}
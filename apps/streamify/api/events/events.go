@@ -0,0 +1,221 @@
+// Package events publishes domain events for other systems to consume. Every
+// event type is registered with a name, a version and a payload struct whose
+// JSON schema is derived from its json and binding tags. Payloads are validated
+// against that schema before they leave the process, and the schemas are served
+// so consumers can generate code or check compatibility against them.
+//
+// A change that could break an existing consumer (removing or retyping a field,
+// making it optional or nullable, adding an enum value) must bump the version;
+// the compatibility test in this package fails otherwise.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/logging"
+	"streamify/openapi"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("events")
+
+// ErrUnregistered is returned when publishing a payload type that was never registered
+var ErrUnregistered = errors.New("unregistered event type")
+
+// Definition describes one event type
+type Definition struct {
+	Name        string          `json:"name"`
+	Version     int             `json:"version"`
+	Description string          `json:"description"`
+	Schema      *openapi.Schema `json:"schema"`
+}
+
+var (
+	byType = map[reflect.Type]*Definition{}
+	byName = map[string]*Definition{}
+)
+
+// register adds an event type; it panics on duplicates since registration happens at init
+func register(name string, version int, description string, payload any) {
+	t := reflect.TypeOf(payload)
+	if _, ok := byName[name]; ok {
+		panic("events: duplicate event " + name)
+	}
+	if _, ok := byType[t]; ok {
+		panic("events: payload type registered twice: " + t.String())
+	}
+	schema := openapi.SchemaOf(payload)
+	closed := false
+	schema.AdditionalProperties = &closed
+	d := &Definition{Name: name, Version: version, Description: description, Schema: schema}
+	byType[t] = d
+	byName[name] = d
+}
+
+// Definitions returns every registered event type, sorted by name
+func Definitions() []Definition {
+	out := make([]Definition, 0, len(byName))
+	for _, d := range byName {
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Envelope is an event as published
+type Envelope struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	Version    int             `json:"version"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// ValidationError reports a payload that does not match its event's schema
+type ValidationError struct {
+	Type       string
+	Violations []openapi.Violation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.String()
+	}
+	return fmt.Sprintf("invalid %s payload: %s", e.Type, strings.Join(msgs, "; "))
+}
+
+// New wraps payload in an envelope after validating it against its event's schema
+func New(payload any) (Envelope, error) {
+	d, ok := byType[reflect.TypeOf(payload)]
+	if !ok {
+		return Envelope{}, fmt.Errorf("%w: %T", ErrUnregistered, payload)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, err
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return Envelope{}, err
+	}
+	if violations := d.Schema.Validate(decoded); len(violations) > 0 {
+		return Envelope{}, &ValidationError{Type: d.Name, Violations: violations}
+	}
+	return Envelope{
+		ID:         uuid.New(),
+		Type:       d.Name,
+		Version:    d.Version,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}, nil
+}
+
+// Publisher is the interface implemented by event transports
+type Publisher interface {
+	Publish(ctx context.Context, e Envelope) error
+}
+
+// Log writes events to the server log instead of delivering them. Every play
+// is an event, so they are logged at debug level, shown with
+// LOG_LEVELS=events=debug.
+type Log struct{}
+
+// Publish implements Publisher
+func (Log) Publish(ctx context.Context, e Envelope) error {
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		logger.Debug("event", "type", e.Type, "version", e.Version, "id", e.ID, "data", string(e.Data))
+	}
+	return nil
+}
+
+// Memory records events instead of publishing them. It is meant for tests.
+type Memory struct {
+	mu        sync.Mutex
+	published []Envelope
+}
+
+// Publish implements Publisher
+func (m *Memory) Publish(ctx context.Context, e Envelope) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.published = append(m.published, e)
+	return nil
+}
+
+// Published returns the events published so far, oldest first
+func (m *Memory) Published() []Envelope {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Envelope(nil), m.published...)
+}
+
+var (
+	mu        sync.RWMutex
+	publisher Publisher = Log{}
+	onFailure func(ctx context.Context, e Envelope, err error)
+)
+
+// SetPublisher replaces the transport events are published through
+func SetPublisher(p Publisher) {
+	mu.Lock()
+	defer mu.Unlock()
+	publisher = p
+}
+
+// OnFailure registers fn to be called with events the publisher failed to deliver,
+// so they can be kept and published again later with Republish
+func OnFailure(fn func(ctx context.Context, e Envelope, err error)) {
+	mu.Lock()
+	defer mu.Unlock()
+	onFailure = fn
+}
+
+// Republish publishes an already validated envelope again, keeping its ID so
+// consumers can deduplicate
+func Republish(ctx context.Context, e Envelope) error {
+	mu.RLock()
+	p := publisher
+	mu.RUnlock()
+	return p.Publish(ctx, e)
+}
+
+// Emit validates and publishes payload. Producers call it after their change is
+// committed; a failure is logged rather than failing a request that already succeeded.
+func Emit(ctx context.Context, payload any) {
+	e, err := New(payload)
+	if err != nil {
+		logger.Warn("not publishing event", "payload", fmt.Sprintf("%T", payload), "error", err)
+		return
+	}
+	mu.RLock()
+	p, failed := publisher, onFailure
+	mu.RUnlock()
+	// The request context may be cancelled as soon as the response is written
+	ctx = context.WithoutCancel(ctx)
+	if err := p.Publish(ctx, e); err != nil {
+		logger.Warn("publishing event failed", "type", e.Type, "id", e.ID, "error", err)
+		if failed != nil {
+			failed(ctx, e, err)
+		}
+	}
+}
+
+// Schemas serves the definition of every event type
+func Schemas() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"events": Definitions()})
+	}
+}
@@ -0,0 +1,136 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"streamify/openapi"
+	"streamify/testutil"
+
+	"github.com/google/uuid"
+)
+
+// snapshot is the published form of every event schema, kept in testdata
+type snapshot map[string]struct {
+	Version int             `json:"version"`
+	Schema  *openapi.Schema `json:"schema"`
+}
+
+func current() snapshot {
+	out := snapshot{}
+	for _, d := range Definitions() {
+		out[d.Name] = struct {
+			Version int             `json:"version"`
+			Schema  *openapi.Schema `json:"schema"`
+		}{d.Version, d.Schema}
+	}
+	return out
+}
+
+// TestSchemasCompatible fails when a producer change would break consumers of an
+// already published event version. Bump the event's version for such changes,
+// then run with UPDATE_GOLDEN=1 to publish the new schemas.
+func TestSchemasCompatible(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "event_schemas.golden"))
+	if err != nil {
+		t.Fatalf("reading published schemas: %v", err)
+	}
+	var published snapshot
+	if err := json.Unmarshal(data, &published); err != nil {
+		t.Fatalf("decoding published schemas: %v", err)
+	}
+
+	now := current()
+	for name, was := range published {
+		is, ok := now[name]
+		switch {
+		case !ok:
+			t.Errorf("%s: event removed; consumers may still expect it", name)
+		case is.Version < was.Version:
+			t.Errorf("%s: version went back from %d to %d", name, was.Version, is.Version)
+		case is.Version == was.Version:
+			for _, b := range Breaking(was.Schema, is.Schema) {
+				t.Errorf("%s v%d: breaking change %s; bump the version", name, is.Version, b)
+			}
+		}
+	}
+
+	testutil.GoldenJSON(t, "event_schemas", now)
+}
+
+func TestBreaking(t *testing.T) {
+	base := func() *openapi.Schema {
+		return openapi.Object(map[string]*openapi.Schema{
+			"id":   {Type: "string", Format: "uuid"},
+			"kind": {Type: "string", Enum: []string{"a", "b"}},
+			"note": {Type: "string"},
+		}, "id", "kind")
+	}
+	tests := []struct {
+		name     string
+		change   func(s *openapi.Schema)
+		breaking bool
+	}{
+		{"unchanged", func(s *openapi.Schema) {}, false},
+		{"property added", func(s *openapi.Schema) { s.Properties["extra"] = &openapi.Schema{Type: "integer"} }, false},
+		{"enum narrowed", func(s *openapi.Schema) { s.Properties["kind"].Enum = []string{"a"} }, false},
+		{"property removed", func(s *openapi.Schema) { delete(s.Properties, "note") }, true},
+		{"type changed", func(s *openapi.Schema) { s.Properties["note"].Type = "integer" }, true},
+		{"format changed", func(s *openapi.Schema) { s.Properties["id"].Format = "" }, true},
+		{"became nullable", func(s *openapi.Schema) { s.Properties["note"].Nullable = true }, true},
+		{"no longer required", func(s *openapi.Schema) { s.Required = []string{"id"} }, true},
+		{"enum value added", func(s *openapi.Schema) { s.Properties["kind"].Enum = []string{"a", "b", "c"} }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := base()
+			tt.change(next)
+			got := Breaking(base(), next)
+			if (len(got) > 0) != tt.breaking {
+				t.Errorf("Breaking = %v, want breaking %v", got, tt.breaking)
+			}
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	mem := &Memory{}
+	SetPublisher(mem)
+	defer SetPublisher(Log{})
+
+	play := PlayRecorded{PlayID: uuid.New(), UserID: uuid.New(), TrackID: uuid.New(), PlayedAt: time.Now(), Territory: "US"}
+	Emit(context.Background(), play)
+	// An invalid territory is caught before anything is published
+	Emit(context.Background(), PlayRecorded{PlayID: uuid.New(), UserID: uuid.New(), TrackID: uuid.New(), PlayedAt: time.Now(), Territory: "USA"})
+
+	got := mem.Published()
+	if len(got) != 1 {
+		t.Fatalf("published %d events, want 1", len(got))
+	}
+	if got[0].Type != "play.recorded" || got[0].Version != 1 {
+		t.Errorf("envelope = %s v%d, want play.recorded v1", got[0].Type, got[0].Version)
+	}
+	var data PlayRecorded
+	if err := json.Unmarshal(got[0].Data, &data); err != nil || data.PlayID != play.PlayID {
+		t.Errorf("data = %s (%v), want play %s", got[0].Data, err, play.PlayID)
+	}
+}
+
+func TestNewRejects(t *testing.T) {
+	_, err := New(struct{ X int }{})
+	if !errors.Is(err, ErrUnregistered) {
+		t.Errorf("unregistered payload: err = %v, want ErrUnregistered", err)
+	}
+
+	_, err = New(PolicyPublished{VersionID: uuid.New(), Kind: "cookies", Version: "1"})
+	var verr *ValidationError
+	if !errors.As(err, &verr) || !strings.Contains(err.Error(), "/kind") {
+		t.Errorf("invalid kind: err = %v, want a ValidationError at /kind", err)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/play"
+	"streamify/ent/predicate"
+	"streamify/ent/track"
+	"streamify/wire"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportPageSize is how many rows an export fetches per query; only one page
+// is held in memory while it is encoded and flushed
+const exportPageSize = 1000
+
+// trackPages pages through every live track by primary key
+func trackPages(client *ent.Client) wire.Pager[*ent.Track] {
+	return func(ctx context.Context, last *ent.Track) ([]*ent.Track, error) {
+		q := client.Track.Query().
+			Where(track.DeletedAtIsNil()).
+			Order(ent.Asc(track.FieldID)).
+			Limit(exportPageSize)
+		if last != nil {
+			q.Where(track.IDGT(last.ID))
+		}
+		return q.All(ctx)
+	}
+}
+
+// playPages pages through the play history by primary key, optionally limited
+// to plays at or after since
+func playPages(client *ent.Client, since time.Time) wire.Pager[*ent.Play] {
+	var filters []predicate.Play
+	if !since.IsZero() {
+		filters = append(filters, play.PlayedAtGTE(since))
+	}
+	return func(ctx context.Context, last *ent.Play) ([]*ent.Play, error) {
+		q := client.Play.Query().
+			Where(filters...).
+			Order(ent.Asc(play.FieldID)).
+			Limit(exportPageSize)
+		if last != nil {
+			q.Where(play.IDGT(last.ID))
+		}
+		return q.All(ctx)
+	}
+}
+
+// exportTracks streams every live track as a JSON array, paging through the
+// table by primary key so memory stays flat regardless of catalog size
+func exportTracks(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		wire.StreamArray(c, trackPages(client))
+	}
+}
+
+// exportPlays streams the play history as a JSON array, optionally limited to
+// plays at or after the RFC 3339 timestamp in ?since=
+func exportPlays(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var since time.Time
+		if v := c.Query("since"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "since must be an RFC 3339 timestamp"})
+				return
+			}
+			since = t
+		}
+		wire.StreamArray(c, playPages(client, since))
+	}
+}
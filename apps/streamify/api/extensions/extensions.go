@@ -0,0 +1,209 @@
+// Package extensions lets optional modules such as podcasts or billing add
+// routes, Ent hooks, background jobs and event subscribers at startup.
+//
+// A module registers itself from an init function in its own package. It is
+// compiled in by a file of package main that imports the package under a
+// build tag, e.g.
+//
+//	//go:build podcasts
+//
+//	package main
+//
+//	import _ "streamify/extensions/podcasts"
+//
+// so `go build -tags podcasts` includes it. EXTENSIONS then narrows which of
+// the compiled-in modules run; every one does when it is unset.
+package extensions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/events"
+	"streamify/jobs"
+	"streamify/logging"
+	"streamify/routing"
+	"streamify/storage"
+)
+
+var logger = logging.For("extensions")
+
+// Extension is an optional module
+type Extension interface {
+	// Name identifies the module in EXTENSIONS, e.g. "podcasts"
+	Name() string
+	// Setup registers what the module adds with h. An error stops the server
+	// from starting.
+	Setup(h *Host) error
+}
+
+var (
+	mu         sync.Mutex
+	registered = map[string]Extension{}
+)
+
+// Register makes ext available. It is called from init; registering a name
+// twice is a programming error and panics.
+func Register(ext Extension) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := registered[ext.Name()]; ok {
+		panic(fmt.Sprintf("extensions: %s registered twice", ext.Name()))
+	}
+	registered[ext.Name()] = ext
+}
+
+// Registered returns the names of the compiled-in extensions, sorted
+func Registered() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(registered))
+	for name := range registered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FromEnv returns the extensions to run: those named in the comma-separated
+// EXTENSIONS, or every compiled-in one when it is unset. "none" runs none.
+func FromEnv() ([]Extension, error) {
+	names := Registered()
+	if v, ok := os.LookupEnv("EXTENSIONS"); ok {
+		names = nil
+		for _, name := range strings.Split(v, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" || name == "none" || slices.Contains(names, name) {
+				continue
+			}
+			names = append(names, name)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	exts := make([]Extension, 0, len(names))
+	for _, name := range names {
+		ext, ok := registered[name]
+		if !ok {
+			return nil, fmt.Errorf("EXTENSIONS: %q is not compiled in (build with -tags %s)", name, name)
+		}
+		exts = append(exts, ext)
+	}
+	return exts, nil
+}
+
+// Subscriber is called with each published event of the type it subscribed to
+type Subscriber func(ctx context.Context, e events.Envelope) error
+
+// job is a background job registered by an extension
+type job struct {
+	name     string
+	interval time.Duration
+	// hour and minute are set for daily jobs, which have no interval
+	hour, minute int
+	fn           jobs.Func
+}
+
+// Host is what extensions register with. Ent hooks take effect as they are
+// added; routes, jobs and subscribers are picked up by the server with
+// Routes, Jobs and Publisher.
+type Host struct {
+	Client *ent.Client
+	Store  storage.Storage
+
+	names       []string
+	routes      []routing.Route
+	jobs        []job
+	subscribers map[string][]Subscriber
+}
+
+// Load sets up exts in order
+func Load(client *ent.Client, store storage.Storage, exts []Extension) (*Host, error) {
+	h := &Host{Client: client, Store: store, subscribers: map[string][]Subscriber{}}
+	for _, ext := range exts {
+		if err := ext.Setup(h); err != nil {
+			return nil, fmt.Errorf("setting up extension %s: %w", ext.Name(), err)
+		}
+		h.names = append(h.names, ext.Name())
+	}
+	return h, nil
+}
+
+// Names returns the names of the extensions set up
+func (h *Host) Names() []string {
+	return h.names
+}
+
+// Route adds routes, mounted with the server's own under their auth level
+func (h *Host) Route(routes ...routing.Route) {
+	h.routes = append(h.routes, routes...)
+}
+
+// Hook adds Ent hooks to every mutation made through the client
+func (h *Host) Hook(hooks ...ent.Hook) {
+	h.Client.Use(hooks...)
+}
+
+// Every runs fn once at startup and then every interval
+func (h *Host) Every(name string, interval time.Duration, fn jobs.Func) {
+	h.jobs = append(h.jobs, job{name: name, interval: interval, fn: fn})
+}
+
+// Daily runs fn every day at hour:minute UTC
+func (h *Host) Daily(name string, hour, minute int, fn jobs.Func) {
+	h.jobs = append(h.jobs, job{name: name, hour: hour, minute: minute, fn: fn})
+}
+
+// Subscribe calls fn with every event of eventType, e.g. "play.recorded".
+// Delivery is best effort: fn's errors are logged and the event isn't retried.
+func (h *Host) Subscribe(eventType string, fn Subscriber) {
+	h.subscribers[eventType] = append(h.subscribers[eventType], fn)
+}
+
+// Routes returns the routes the extensions added
+func (h *Host) Routes() []routing.Route {
+	return h.routes
+}
+
+// Jobs schedules the jobs the extensions added on s
+func (h *Host) Jobs(s *jobs.Scheduler) {
+	for _, j := range h.jobs {
+		if j.interval > 0 {
+			s.Every(j.name, j.interval, j.fn)
+		} else {
+			s.Daily(j.name, j.hour, j.minute, j.fn)
+		}
+	}
+}
+
+// Publisher returns an events.Publisher that publishes through next and then
+// calls the subscribers of each event. Only next's errors are returned, since
+// a republished event would reach next twice.
+func (h *Host) Publisher(next events.Publisher) events.Publisher {
+	if len(h.subscribers) == 0 {
+		return next
+	}
+	return &publisher{next: next, subscribers: h.subscribers}
+}
+
+type publisher struct {
+	next        events.Publisher
+	subscribers map[string][]Subscriber
+}
+
+func (p *publisher) Publish(ctx context.Context, e events.Envelope) error {
+	err := p.next.Publish(ctx, e)
+	for _, fn := range p.subscribers[e.Type] {
+		if serr := fn(ctx, e); serr != nil {
+			logger.Warn("event subscriber failed", "type", e.Type, "id", e.ID, "error", serr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,366 @@
+// Package failover keeps the database connection pointed at the current
+// Postgres primary. When queries fail with connection errors, or with
+// read-only errors because the server they reached was demoted, the pool is
+// replaced by a fresh one: reopening the DSN resolves its host name again,
+// which is how DNS-based failover moves clients, and any standby DSNs are
+// tried in turn until one answers as primary. The process keeps running
+// throughout; requests that hit the old primary fail and later ones reach
+// the new one.
+package failover
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"streamify/config"
+	"streamify/logging"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+var logger = logging.For("failover")
+
+const (
+	// minReconnectInterval spaces out reconnects, so a burst of failing
+	// queries triggers one
+	minReconnectInterval = 5 * time.Second
+	// connectTimeout bounds connecting to and probing one candidate
+	connectTimeout = 5 * time.Second
+	// drainDelay is how long a replaced pool stays open for queries already
+	// running on it
+	drainDelay = 30 * time.Second
+)
+
+// ErrNoPrimary is returned when no candidate DSN reaches a writable primary
+var ErrNoPrimary = errors.New("failover: no reachable primary")
+
+// IsFailover reports whether err suggests the primary went away: the
+// connection broke or was refused, the server is shutting down or not yet
+// accepting connections, or it is now a read-only standby
+func IsFailover(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "25006", // read_only_sql_transaction
+			"57P01", // admin_shutdown
+			"57P02", // crash_shutdown
+			"57P03": // cannot_connect_now
+			return true
+		}
+		return pqErr.Code.Class() == "08" // connection_exception
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && !netErr.Timeout()
+}
+
+// Driver is an Ent driver over the current primary's pool. It satisfies
+// dialect.Driver plus the raw ExecContext, QueryContext and BeginTx that
+// callers reach through type assertions.
+type Driver struct {
+	dsns []string
+	cur  atomic.Pointer[entsql.Driver]
+	// host is the DSN, redacted, of the current pool
+	host atomic.Value
+
+	mu          sync.Mutex // serializes reconnects
+	lastAttempt time.Time
+	reconnect   atomic.Bool // a reconnect is running
+
+	stats struct {
+		sync.Mutex
+		failovers    uint64
+		attempts     uint64
+		failures     uint64
+		lastFailover time.Time
+		lastError    string
+		healthy      bool
+	}
+}
+
+// Open connects to the first of dsns that answers as a writable primary.
+// dsns[0] is the configured DATABASE_URL; the rest are standbys that may be
+// promoted.
+func Open(ctx context.Context, dsns ...string) (*Driver, error) {
+	d := &Driver{dsns: dsns}
+	drv, dsn, err := d.findPrimary(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d.cur.Store(drv)
+	d.host.Store(config.Redact(dsn))
+	d.stats.healthy = true
+	return d, nil
+}
+
+// findPrimary opens each candidate in turn and returns the first primary
+func (d *Driver) findPrimary(ctx context.Context) (*entsql.Driver, string, error) {
+	var errs []error
+	for _, dsn := range d.dsns {
+		drv, err := probe(ctx, dsn)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", config.Redact(dsn), err))
+			continue
+		}
+		return drv, dsn, nil
+	}
+	return nil, "", fmt.Errorf("%w: %w", ErrNoPrimary, errors.Join(errs...))
+}
+
+// probe opens a pool for dsn and checks it reaches a server out of recovery
+func probe(ctx context.Context, dsn string) (*entsql.Driver, error) {
+	drv, err := entsql.Open(dialect.Postgres, dsn)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	var standby bool
+	if err := drv.DB().QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&standby); err != nil {
+		drv.Close()
+		return nil, err
+	}
+	if standby {
+		drv.Close()
+		return nil, errors.New("server is a read-only standby")
+	}
+	return drv, nil
+}
+
+// observe starts a reconnect in the background when err looks like a
+// failover. The failing call still returns err.
+func (d *Driver) observe(err error) {
+	if !IsFailover(err) || !d.reconnect.CompareAndSwap(false, true) {
+		return
+	}
+	logger.Warn("database error suggests failover; reconnecting", "error", err)
+	go func() {
+		defer d.reconnect.Store(false)
+		d.Reconnect(context.Background())
+	}()
+}
+
+// Reconnect replaces the pool with one to the current primary, at most once
+// per minReconnectInterval. The old pool is closed once queries running on
+// it have had time to finish.
+func (d *Driver) Reconnect(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if time.Since(d.lastAttempt) < minReconnectInterval {
+		return nil
+	}
+	d.lastAttempt = time.Now()
+
+	d.stats.Lock()
+	d.stats.attempts++
+	d.stats.Unlock()
+
+	drv, dsn, err := d.findPrimary(ctx)
+	if err != nil {
+		d.stats.Lock()
+		d.stats.failures++
+		d.stats.lastError = err.Error()
+		d.stats.healthy = false
+		d.stats.Unlock()
+		logger.Error("database reconnect failed", "error", err)
+		return err
+	}
+	old := d.cur.Swap(drv)
+	d.host.Store(config.Redact(dsn))
+	time.AfterFunc(drainDelay, func() { old.Close() })
+
+	d.stats.Lock()
+	d.stats.failovers++
+	d.stats.lastFailover = time.Now()
+	d.stats.lastError = ""
+	d.stats.healthy = true
+	d.stats.Unlock()
+	logger.Warn("database reconnected", "primary", config.Redact(dsn))
+	return nil
+}
+
+// Check pings the current primary and makes sure it still is one,
+// reconnecting otherwise. Scheduled, it notices a failover before queries do.
+func (d *Driver) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	var standby bool
+	err := d.cur.Load().DB().QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&standby)
+	if err == nil && !standby {
+		d.stats.Lock()
+		d.stats.healthy = true
+		d.stats.Unlock()
+		return nil
+	}
+	if err == nil {
+		logger.Warn("database primary was demoted; reconnecting")
+	} else if !IsFailover(err) {
+		return err
+	}
+	return d.Reconnect(ctx)
+}
+
+// DB returns the current pool
+func (d *Driver) DB() *stdsql.DB {
+	return d.cur.Load().DB()
+}
+
+// Dialect implements dialect.Driver
+func (d *Driver) Dialect() string {
+	return dialect.Postgres
+}
+
+// Exec implements dialect.Driver
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	err := d.cur.Load().Exec(ctx, query, args, v)
+	d.observe(err)
+	return err
+}
+
+// Query implements dialect.Driver
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	err := d.cur.Load().Query(ctx, query, args, v)
+	d.observe(err)
+	return err
+}
+
+// ExecContext executes a raw statement on the current pool
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	res, err := d.cur.Load().ExecContext(ctx, query, args...)
+	d.observe(err)
+	return res, err
+}
+
+// QueryContext executes a raw query on the current pool
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	rows, err := d.cur.Load().QueryContext(ctx, query, args...)
+	d.observe(err)
+	return rows, err
+}
+
+// Tx implements dialect.Driver
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction on the current pool
+func (d *Driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.cur.Load().BeginTx(ctx, opts)
+	if err != nil {
+		d.observe(err)
+		return nil, err
+	}
+	return &observedTx{Tx: tx, d: d}, nil
+}
+
+// Close implements dialect.Driver
+func (d *Driver) Close() error {
+	return d.cur.Load().Close()
+}
+
+// observedTx watches a transaction's errors for failovers
+type observedTx struct {
+	dialect.Tx
+	d *Driver
+}
+
+func (t *observedTx) Exec(ctx context.Context, query string, args, v any) error {
+	err := t.Tx.Exec(ctx, query, args, v)
+	t.d.observe(err)
+	return err
+}
+
+func (t *observedTx) Query(ctx context.Context, query string, args, v any) error {
+	err := t.Tx.Query(ctx, query, args, v)
+	t.d.observe(err)
+	return err
+}
+
+// ExecContext executes a raw statement in the transaction
+func (t *observedTx) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("failover: transaction does not support ExecContext")
+	}
+	res, err := ex.ExecContext(ctx, query, args...)
+	t.d.observe(err)
+	return res, err
+}
+
+// QueryContext executes a raw query in the transaction
+func (t *observedTx) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("failover: transaction does not support QueryContext")
+	}
+	rows, err := q.QueryContext(ctx, query, args...)
+	t.d.observe(err)
+	return rows, err
+}
+
+func (t *observedTx) Commit() error {
+	err := t.Tx.Commit()
+	t.d.observe(err)
+	return err
+}
+
+// Status is the driver's state for /health
+type Status struct {
+	Primary        string     `json:"primary"`
+	Failovers      uint64     `json:"failovers"`
+	LastFailoverAt *time.Time `json:"last_failover_at,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+}
+
+// Health implements resilience.Component: unhealthy while the last
+// reconnect found no primary
+func (d *Driver) Health() (bool, any) {
+	d.stats.Lock()
+	defer d.stats.Unlock()
+	s := Status{Primary: d.host.Load().(string), Failovers: d.stats.failovers, LastError: d.stats.lastError}
+	if !d.stats.lastFailover.IsZero() {
+		t := d.stats.lastFailover
+		s.LastFailoverAt = &t
+	}
+	return d.stats.healthy, s
+}
+
+// WriteMetrics implements resilience.Component
+func (d *Driver) WriteMetrics(w io.Writer) {
+	d.stats.Lock()
+	defer d.stats.Unlock()
+	healthy := 0
+	if d.stats.healthy {
+		healthy = 1
+	}
+	var last int64
+	if !d.stats.lastFailover.IsZero() {
+		last = d.stats.lastFailover.Unix()
+	}
+	fmt.Fprintf(w, "# HELP db_primary_up Whether the database pool reaches a writable primary.\n# TYPE db_primary_up gauge\ndb_primary_up %d\n", healthy)
+	fmt.Fprintf(w, "# HELP db_failovers_total Times the pool was moved to a new primary.\n# TYPE db_failovers_total counter\ndb_failovers_total %d\n", d.stats.failovers)
+	fmt.Fprintf(w, "# HELP db_reconnect_attempts_total Reconnects attempted after a suspected failover.\n# TYPE db_reconnect_attempts_total counter\ndb_reconnect_attempts_total %d\n", d.stats.attempts)
+	fmt.Fprintf(w, "# HELP db_reconnect_failures_total Reconnects that found no primary.\n# TYPE db_reconnect_failures_total counter\ndb_reconnect_failures_total %d\n", d.stats.failures)
+	fmt.Fprintf(w, "# HELP db_last_failover_timestamp_seconds When the pool last moved to a new primary.\n# TYPE db_last_failover_timestamp_seconds gauge\ndb_last_failover_timestamp_seconds %d\n", last)
+}
@@ -0,0 +1,143 @@
+package faults
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver wraps an Ent driver and fails the statements of requests whose
+// route has a db fault
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps drv so that statements can be failed on purpose
+func NewDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv}
+}
+
+// Exec executes a statement unless it is failed
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	if failDB(ctx) {
+		return ErrInjected
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query executes a query unless it is failed
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	if failDB(ctx) {
+		return ErrInjected
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// ExecContext executes a raw statement on the underlying driver unless it is failed
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ex, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("faults: driver does not support ExecContext")
+	}
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes a raw query on the underlying driver unless it is failed
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	q, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("faults: driver does not support QueryContext")
+	}
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	return q.QueryContext(ctx, query, args...)
+}
+
+// Tx starts a transaction whose statements may also be failed
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// BeginTx starts a transaction with options when the underlying driver supports it
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx}, nil
+}
+
+// Tx wraps an Ent transaction and fails its statements like Driver
+type Tx struct {
+	dialect.Tx
+}
+
+// Exec executes a statement in the transaction unless it is failed
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	if failDB(ctx) {
+		return ErrInjected
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query executes a query in the transaction unless it is failed
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	if failDB(ctx) {
+		return ErrInjected
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+// ExecContext executes a raw statement in the transaction unless it is failed
+func (t *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ex, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("faults: transaction does not support ExecContext")
+	}
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes a raw query in the transaction unless it is failed
+func (t *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	q, ok := t.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("faults: transaction does not support QueryContext")
+	}
+	if failDB(ctx) {
+		return nil, ErrInjected
+	}
+	return q.QueryContext(ctx, query, args...)
+}
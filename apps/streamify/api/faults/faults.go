@@ -0,0 +1,223 @@
+// Package faults injects failures into requests for resilience testing in
+// staging: random latency, 5xx responses on chosen routes and failing
+// database statements, so that clients' retry and backoff behavior can be
+// watched against a real server. Faults are configured with FAULT_INJECTION
+// and are refused outside development and staging.
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/logging"
+
+	"github.com/gin-gonic/gin"
+)
+
+var logger = logging.For("faults")
+
+// AllRoutes keys the rule applied to every /api route without a rule of its own
+const AllRoutes = "*"
+
+// Rule is the faults injected into one route. Each fault fires on its own
+// with its rate, a probability from 0 to 1.
+type Rule struct {
+	// Latency delays the request by MinLatency to MaxLatency
+	MinLatency  time.Duration
+	MaxLatency  time.Duration
+	LatencyRate float64
+	// Status answers the request with an error instead of running it
+	Status     int
+	StatusRate float64
+	// DBRate fails each database statement the request makes
+	DBRate float64
+}
+
+// Config holds the rules keyed by method and gin route pattern, e.g.
+// "GET /api/v1/artists", or AllRoutes
+type Config struct {
+	Routes map[string]Rule
+}
+
+// Enabled reports whether any fault is configured
+func (cfg Config) Enabled() bool {
+	return len(cfg.Routes) > 0
+}
+
+// InjectsDB reports whether any rule fails database statements, which needs
+// the client built on NewDriver
+func (cfg Config) InjectsDB() bool {
+	for _, r := range cfg.Routes {
+		if r.DBRate > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// For returns the rule for a method and route pattern
+func (cfg Config) For(method, route string) (Rule, bool) {
+	if r, ok := cfg.Routes[method+" "+route]; ok {
+		return r, true
+	}
+	if strings.HasPrefix(route, "/api/") {
+		r, ok := cfg.Routes[AllRoutes]
+		return r, ok
+	}
+	return Rule{}, false
+}
+
+// FromEnv reads FAULT_INJECTION, rules of the form "METHOD /path=fault,..."
+// separated by semicolons, with * for every /api route. A fault is one of
+//
+//	latency:200ms or latency:100ms-2s   delay the request
+//	error:503                           answer with that status
+//	db                                  fail database statements
+//
+// each optionally followed by @rate, 1 when left out, e.g.
+// "*=latency:50ms-500ms@0.5;POST /api/v1/plays=error:503@0.1,db@0.05".
+// Faults are only allowed when APP_ENV is development or staging.
+func FromEnv() (Config, error) {
+	v := os.Getenv("FAULT_INJECTION")
+	if v == "" {
+		return Config{}, nil
+	}
+	switch env := os.Getenv("APP_ENV"); env {
+	case "development", "staging":
+	default:
+		return Config{}, fmt.Errorf("FAULT_INJECTION: only allowed when APP_ENV is development or staging, got %q", env)
+	}
+	cfg, err := Parse(v)
+	if err != nil {
+		return Config{}, fmt.Errorf("FAULT_INJECTION: %w", err)
+	}
+	return cfg, nil
+}
+
+// Parse parses rules in the form FromEnv reads
+func Parse(s string) (Config, error) {
+	cfg := Config{Routes: map[string]Rule{}}
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, faults, ok := strings.Cut(entry, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("%q is not METHOD /path=faults", entry)
+		}
+		var rule Rule
+		for _, fault := range strings.Split(faults, ",") {
+			if err := rule.add(strings.TrimSpace(fault)); err != nil {
+				return Config{}, fmt.Errorf("%q: %w", entry, err)
+			}
+		}
+		cfg.Routes[strings.Join(strings.Fields(route), " ")] = rule
+	}
+	return cfg, nil
+}
+
+// add parses one fault into r
+func (r *Rule) add(fault string) error {
+	rate := 1.0
+	if f, at, ok := strings.Cut(fault, "@"); ok {
+		var err error
+		if rate, err = strconv.ParseFloat(at, 64); err != nil || rate < 0 || rate > 1 {
+			return fmt.Errorf("rate of %q must be between 0 and 1", fault)
+		}
+		fault = f
+	}
+	kind, arg, _ := strings.Cut(fault, ":")
+	switch kind {
+	case "latency":
+		from, to, ranged := strings.Cut(arg, "-")
+		lo, err := time.ParseDuration(from)
+		if err != nil || lo < 0 {
+			return fmt.Errorf("latency must be a duration or a range such as 100ms-2s, got %q", arg)
+		}
+		hi := lo
+		if ranged {
+			if hi, err = time.ParseDuration(to); err != nil || hi < lo {
+				return fmt.Errorf("latency must be a duration or a range such as 100ms-2s, got %q", arg)
+			}
+		}
+		r.MinLatency, r.MaxLatency, r.LatencyRate = lo, hi, rate
+	case "error":
+		status, err := strconv.Atoi(arg)
+		if err != nil || (status != http.StatusTooManyRequests && (status < 500 || status > 599)) {
+			return fmt.Errorf("error status must be 429 or 5xx, got %q", arg)
+		}
+		r.Status, r.StatusRate = status, rate
+	case "db":
+		if arg != "" {
+			return fmt.Errorf("db takes no argument, got %q", fault)
+		}
+		r.DBRate = rate
+	default:
+		return fmt.Errorf("unknown fault %q (want latency, error or db)", kind)
+	}
+	return nil
+}
+
+// roll reports whether a fault with rate fires
+func roll(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate
+}
+
+// Header is set on responses a fault was injected into, naming the faults
+const Header = "X-Fault-Injected"
+
+// Middleware injects the faults configured for each request's route
+func Middleware(cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule, ok := cfg.For(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+		if roll(rule.LatencyRate) {
+			d := rule.MinLatency
+			if rule.MaxLatency > d {
+				d += rand.N(rule.MaxLatency - d)
+			}
+			c.Writer.Header().Add(Header, "latency")
+			t := time.NewTimer(d)
+			select {
+			case <-t.C:
+			case <-c.Request.Context().Done():
+				t.Stop()
+			}
+		}
+		if roll(rule.StatusRate) {
+			logger.Debug("injected error", "route", c.FullPath(), "status", rule.Status)
+			c.Writer.Header().Add(Header, "error")
+			if rule.Status == http.StatusServiceUnavailable || rule.Status == http.StatusTooManyRequests {
+				c.Header("Retry-After", "1")
+			}
+			c.AbortWithStatusJSON(rule.Status, gin.H{"error": "injected fault"})
+			return
+		}
+		if rule.DBRate > 0 {
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey{}, rule.DBRate))
+		}
+		c.Next()
+	}
+}
+
+// ErrInjected is returned by database statements failed on purpose
+var ErrInjected = errors.New("faults: injected database error")
+
+type ctxKey struct{}
+
+// failDB reports whether a statement made with ctx should fail
+func failDB(ctx context.Context) bool {
+	rate, _ := ctx.Value(ctxKey{}).(float64)
+	return roll(rate)
+}
@@ -0,0 +1,228 @@
+// Package feeds publishes the public catalog for crawlers and feed readers:
+// a sitemap of the app's artist and album pages, and RSS and Atom feeds of
+// new releases. Files are built by a scheduled job and kept in storage, so
+// serving them never touches the database.
+package feeds
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/logging"
+	"streamify/storage"
+)
+
+var logger = logging.For("feeds")
+
+// Prefix is the storage key prefix under which feeds are written
+const Prefix = "feeds/"
+
+// Names of the published files
+const (
+	SitemapName = "sitemap.xml"
+	RSSName     = "releases.rss"
+	AtomName    = "releases.atom"
+)
+
+const (
+	// fullRebuildEvery is how often the sitemap is rebuilt from scratch,
+	// which also drops pages whose rows were removed outright
+	fullRebuildEvery = 24 * time.Hour
+	// overlap re-reads changes made shortly before the last build, so rows
+	// committed while it ran aren't missed
+	overlap = 5 * time.Minute
+
+	indexKey    = Prefix + "index.json"
+	manifestKey = Prefix + "manifest.json"
+)
+
+// Config holds the public URLs the feeds link to
+type Config struct {
+	// BaseURL is where the feeds are served, e.g. https://api.streamify.example
+	BaseURL string
+	// AppURL is the frontend whose pages are listed
+	AppURL string
+}
+
+func (cfg Config) appURL(path string) string {
+	return strings.TrimRight(cfg.AppURL, "/") + path
+}
+
+// feedURL returns the URL a published file is served at: the feeds under
+// /feeds/, sitemaps at the root where crawlers expect them
+func (cfg Config) feedURL(name string) string {
+	path := "/" + name
+	if name == RSSName || name == AtomName {
+		path = "/feeds/" + name
+	}
+	return strings.TrimRight(cfg.BaseURL, "/") + path
+}
+
+// File describes a published file, for caching headers
+type File struct {
+	ETag     string    `json:"etag"`
+	Modified time.Time `json:"modified"`
+}
+
+// manifest lists the published files
+type manifest struct {
+	Files map[string]File `json:"files"`
+}
+
+// index is what the sitemap was last built from
+type index struct {
+	BuiltAt time.Time `json:"built_at"`
+	FullAt  time.Time `json:"full_at"`
+	// Pages maps the app path of each listed page to when it last changed
+	Pages map[string]time.Time `json:"pages"`
+}
+
+// Builder builds and serves the feeds
+type Builder struct {
+	client *ent.Client
+	store  storage.Storage
+	cfg    Config
+	// mu keeps a build from racing another on this instance
+	mu sync.Mutex
+}
+
+// NewBuilder returns a Builder keeping feeds in store
+func NewBuilder(client *ent.Client, store storage.Storage, cfg Config) *Builder {
+	return &Builder{client: client, store: store, cfg: cfg}
+}
+
+// Build brings the sitemap and feeds up to date. The sitemap only reads
+// pages added or removed since the previous build, with a full rebuild once
+// a day; files whose content didn't change are left alone, keeping their
+// ETag and Last-Modified.
+func (b *Builder) Build(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now().UTC()
+	var idx index
+	found, err := b.readJSON(ctx, indexKey, &idx)
+	if err != nil {
+		return err
+	}
+	if !found || now.Sub(idx.FullAt) >= fullRebuildEvery {
+		idx = index{FullAt: now, Pages: map[string]time.Time{}}
+		err = b.addAllPages(ctx, &idx)
+	} else {
+		err = b.updatePages(ctx, &idx, idx.BuiltAt.Add(-overlap))
+	}
+	if err != nil {
+		return fmt.Errorf("feeds: reading catalog: %w", err)
+	}
+	idx.BuiltAt = now
+
+	var m manifest
+	if _, err := b.readJSON(ctx, manifestKey, &m); err != nil {
+		return err
+	}
+	if m.Files == nil {
+		m.Files = map[string]File{}
+	}
+
+	files := b.sitemaps(&idx)
+	releases, err := b.releases(ctx, now)
+	if err != nil {
+		return fmt.Errorf("feeds: reading releases: %w", err)
+	}
+	files[RSSName] = b.rss(releases)
+	files[AtomName] = b.atom(releases)
+
+	for name, data := range files {
+		if err := b.publish(ctx, &m, name, data, now); err != nil {
+			return err
+		}
+	}
+	// Sitemap parts beyond the current count are left over from a larger catalog
+	for name := range m.Files {
+		if _, ok := files[name]; !ok {
+			if err := b.store.Delete(ctx, Prefix+name); err != nil && !storage.IsNotFound(err) {
+				return err
+			}
+			delete(m.Files, name)
+		}
+	}
+
+	if err := b.writeJSON(ctx, indexKey, idx); err != nil {
+		return err
+	}
+	if err := b.writeJSON(ctx, manifestKey, m); err != nil {
+		return err
+	}
+	logger.Info("feeds built", "pages", len(idx.Pages), "releases", len(releases))
+	return nil
+}
+
+// publish stores a file unless its content is unchanged
+func (b *Builder) publish(ctx context.Context, m *manifest, name string, data []byte, now time.Time) error {
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	if m.Files[name].ETag == etag {
+		return nil
+	}
+	if err := b.store.Put(ctx, Prefix+name, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("feeds: storing %s: %w", name, err)
+	}
+	m.Files[name] = File{ETag: etag, Modified: now}
+	return nil
+}
+
+// Open returns a published file and its description; files that don't exist
+// (yet) are storage.ErrNotFound
+func (b *Builder) Open(ctx context.Context, name string) ([]byte, File, error) {
+	var m manifest
+	if _, err := b.readJSON(ctx, manifestKey, &m); err != nil {
+		return nil, File{}, err
+	}
+	f, ok := m.Files[name]
+	if !ok {
+		return nil, File{}, storage.ErrNotFound
+	}
+	rc, err := b.store.Get(ctx, Prefix+name)
+	if err != nil {
+		return nil, File{}, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	return data, f, err
+}
+
+// readJSON decodes the object at key into v, reporting whether it exists
+func (b *Builder) readJSON(ctx context.Context, key string, v any) (bool, error) {
+	rc, err := b.store.Get(ctx, key)
+	if storage.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+	if err := json.NewDecoder(rc).Decode(v); err != nil {
+		return false, fmt.Errorf("feeds: reading %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (b *Builder) writeJSON(ctx context.Context, key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := b.store.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("feeds: storing %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package feeds
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"streamify/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxAge is how long clients and caches may keep a file; feeds are rebuilt
+// hourly
+const maxAge = "public, max-age=3600"
+
+var contentTypes = map[string]string{
+	SitemapName: "application/xml; charset=utf-8",
+	RSSName:     "application/rss+xml; charset=utf-8",
+	AtomName:    "application/atom+xml; charset=utf-8",
+}
+
+// Serve serves the published file name with its ETag and Last-Modified,
+// answering conditional requests with 304
+func Serve(b *Builder, name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		serve(c, b, name)
+	}
+}
+
+// ServeSitemapPart serves /sitemaps/:part, a part of the sitemap listed by
+// the sitemap index
+func ServeSitemapPart(b *Builder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		part := c.Param("part")
+		if !strings.HasSuffix(part, ".xml") || strings.Contains(part, "/") {
+			c.JSON(http.StatusNotFound, gin.H{"error": "sitemap not found"})
+			return
+		}
+		serve(c, b, "sitemaps/"+part)
+	}
+}
+
+func serve(c *gin.Context, b *Builder, name string) {
+	data, f, err := b.Open(c.Request.Context(), name)
+	if err != nil {
+		if storage.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	contentType, ok := contentTypes[name]
+	if !ok {
+		contentType = contentTypes[SitemapName]
+	}
+	c.Header("Content-Type", contentType)
+	c.Header("Cache-Control", maxAge)
+	c.Header("ETag", f.ETag)
+	// ServeContent sets Last-Modified and handles If-None-Match and
+	// If-Modified-Since
+	http.ServeContent(c.Writer, c.Request, name, f.Modified, bytes.NewReader(data))
+}
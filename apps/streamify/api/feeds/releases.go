@@ -0,0 +1,196 @@
+package feeds
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+)
+
+// feedSize is how many releases the feeds list
+const feedSize = 50
+
+// release is an album as listed in the feeds
+type release struct {
+	album  *ent.Album
+	artist *ent.Artist
+	// published is when the album became available: when it was added, or
+	// its release date for albums added ahead of it
+	published time.Time
+}
+
+// releases returns the newest released albums, newest first
+func (b *Builder) releases(ctx context.Context, now time.Time) ([]release, error) {
+	// Albums added ahead of their release date sort by the date, so read
+	// more than needed before ordering by publication
+	albums, err := b.client.Album.Query().
+		Where(
+			album.DeletedAtIsNil(),
+			album.Or(album.ReleaseDateIsNil(), album.ReleaseDateLTE(now)),
+			album.HasArtistWith(artist.DeletedAtIsNil()),
+		).
+		WithArtist().
+		Order(ent.Desc(album.FieldCreatedAt), ent.Asc(album.FieldID)).
+		Limit(feedSize * 4).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]release, 0, len(albums))
+	for _, a := range albums {
+		r := release{album: a, artist: a.Edges.Artist, published: a.CreatedAt.UTC()}
+		if a.ReleaseDate != nil && a.ReleaseDate.After(a.CreatedAt) {
+			r.published = a.ReleaseDate.UTC()
+		}
+		out = append(out, r)
+	}
+	slices.SortStableFunc(out, func(x, y release) int { return y.published.Compare(x.published) })
+	if len(out) > feedSize {
+		out = out[:feedSize]
+	}
+	return out, nil
+}
+
+func (r release) title() string {
+	return r.album.Title + " - " + r.artist.Name
+}
+
+func (r release) summary() string {
+	kind := string(r.album.AlbumType)
+	if kind == "ep" {
+		kind = "EP"
+	} else {
+		kind = strings.ToUpper(kind[:1]) + kind[1:]
+	}
+	s := fmt.Sprintf("%s by %s", kind, r.artist.Name)
+	if r.album.Label != "" {
+		s += " on " + r.album.Label
+	}
+	return s
+}
+
+const feedTitle = "Streamify new releases"
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// rss renders the releases as an RSS 2.0 feed
+func (b *Builder) rss(releases []release) []byte {
+	feed := rssFeed{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       feedTitle,
+			Link:        b.cfg.appURL("/"),
+			Description: "Albums, EPs and singles newly released on Streamify",
+			Self:        atomLink{Href: b.cfg.feedURL(RSSName), Rel: "self", Type: "application/rss+xml"},
+		},
+	}
+	// The build date is the newest release's so an unchanged catalog gives
+	// an unchanged file
+	if len(releases) > 0 {
+		feed.Channel.LastBuildDate = releases[0].published.Format(time.RFC1123Z)
+	}
+	for _, r := range releases {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       r.title(),
+			Link:        b.cfg.appURL(albumPath(r.album)),
+			Description: r.summary(),
+			GUID:        rssGUID{Value: "urn:uuid:" + r.album.ID.String()},
+			PubDate:     r.published.Format(time.RFC1123Z),
+		})
+	}
+	return marshalXML(feed)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Updated   string     `xml:"updated"`
+	Published string     `xml:"published"`
+	Link      atomLink   `xml:"link"`
+	Author    atomPerson `xml:"author"`
+	Summary   string     `xml:"summary"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+// atom renders the releases as an Atom feed
+func (b *Builder) atom(releases []release) []byte {
+	feed := atomFeed{
+		ID:    b.cfg.feedURL(AtomName),
+		Title: feedTitle,
+		// A feed without entries still needs a date; the epoch keeps it stable
+		Updated: time.Unix(0, 0).UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: b.cfg.feedURL(AtomName), Rel: "self", Type: "application/atom+xml"},
+			{Href: b.cfg.appURL("/"), Rel: "alternate", Type: "text/html"},
+		},
+	}
+	if len(releases) > 0 {
+		feed.Updated = releases[0].published.Format(time.RFC3339)
+	}
+	for _, r := range releases {
+		published := r.published.Format(time.RFC3339)
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:        "urn:uuid:" + r.album.ID.String(),
+			Title:     r.title(),
+			Updated:   published,
+			Published: published,
+			Link:      atomLink{Href: b.cfg.appURL(albumPath(r.album)), Rel: "alternate", Type: "text/html"},
+			Author:    atomPerson{Name: r.artist.Name},
+			Summary:   r.summary(),
+		})
+	}
+	return marshalXML(feed)
+}
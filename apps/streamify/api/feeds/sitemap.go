@@ -0,0 +1,154 @@
+package feeds
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+)
+
+// maxSitemapURLs is the most URLs the sitemap protocol allows in one file;
+// larger catalogs are split into parts listed by the sitemap index
+const maxSitemapURLs = 50000
+
+const sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapURL `xml:"sitemap"`
+}
+
+// sitemapPartName returns the name of the nth (from 1) part of the sitemap
+func sitemapPartName(n int) string {
+	return fmt.Sprintf("sitemaps/%d.xml", n)
+}
+
+func artistPath(a *ent.Artist) string { return "/artist/" + a.ID.String() }
+func albumPath(a *ent.Album) string   { return "/album/" + a.ID.String() }
+
+// addAllPages lists every live artist and album page
+func (b *Builder) addAllPages(ctx context.Context, idx *index) error {
+	artists, err := b.client.Artist.Query().
+		Where(artist.DeletedAtIsNil()).
+		Select(artist.FieldID, artist.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range artists {
+		idx.Pages[artistPath(a)] = a.CreatedAt.UTC()
+	}
+	albums, err := b.client.Album.Query().
+		Where(album.DeletedAtIsNil()).
+		Select(album.FieldID, album.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range albums {
+		idx.Pages[albumPath(a)] = a.CreatedAt.UTC()
+	}
+	return nil
+}
+
+// updatePages applies the artists and albums added or deleted since
+func (b *Builder) updatePages(ctx context.Context, idx *index, since time.Time) error {
+	artists, err := b.client.Artist.Query().
+		Where(artist.Or(artist.CreatedAtGT(since), artist.DeletedAtGT(since))).
+		Select(artist.FieldID, artist.FieldCreatedAt, artist.FieldDeletedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range artists {
+		if a.DeletedAt != nil {
+			delete(idx.Pages, artistPath(a))
+		} else {
+			idx.Pages[artistPath(a)] = a.CreatedAt.UTC()
+		}
+	}
+	albums, err := b.client.Album.Query().
+		Where(album.Or(album.CreatedAtGT(since), album.DeletedAtGT(since))).
+		Select(album.FieldID, album.FieldCreatedAt, album.FieldDeletedAt).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range albums {
+		if a.DeletedAt != nil {
+			delete(idx.Pages, albumPath(a))
+		} else {
+			idx.Pages[albumPath(a)] = a.CreatedAt.UTC()
+		}
+	}
+	return nil
+}
+
+// sitemaps renders the sitemap index and its parts, by name. Pages are
+// sorted so that a part only changes when pages in its range do.
+func (b *Builder) sitemaps(idx *index) map[string][]byte {
+	paths := make([]string, 0, len(idx.Pages))
+	for p := range idx.Pages {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	files := map[string][]byte{}
+	index := sitemapIndex{Xmlns: sitemapNS}
+	// The home page leads the first part
+	part := urlSet{Xmlns: sitemapNS, URLs: []sitemapURL{{Loc: b.cfg.appURL("/")}}}
+	var newest time.Time
+	flush := func() {
+		name := sitemapPartName(len(index.Sitemaps) + 1)
+		files[name] = marshalXML(part)
+		ref := sitemapURL{Loc: b.cfg.feedURL(name)}
+		if !newest.IsZero() {
+			ref.LastMod = newest.Format(time.RFC3339)
+		}
+		index.Sitemaps = append(index.Sitemaps, ref)
+		part = urlSet{Xmlns: sitemapNS}
+		newest = time.Time{}
+	}
+	for _, p := range paths {
+		changed := idx.Pages[p]
+		part.URLs = append(part.URLs, sitemapURL{Loc: b.cfg.appURL(p), LastMod: changed.Format(time.RFC3339)})
+		if changed.After(newest) {
+			newest = changed
+		}
+		if len(part.URLs) == maxSitemapURLs {
+			flush()
+		}
+	}
+	if len(part.URLs) > 0 || len(index.Sitemaps) == 0 {
+		flush()
+	}
+	files[SitemapName] = marshalXML(index)
+	return files
+}
+
+// marshalXML encodes v as an indented XML document
+func marshalXML(v any) []byte {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		// The feed types always encode
+		panic(err)
+	}
+	return append([]byte(xml.Header), data...)
+}
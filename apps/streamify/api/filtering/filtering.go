@@ -0,0 +1,216 @@
+// Package filtering filters and sorts list endpoints by query parameters.
+// Each list names the fields it offers, so a request can't reach any other
+// column: ?sort=name&order=desc sorts by one of them, and each takes the
+// filters of its kind, such as ?email= or ?name_contains= for text and
+// ?created_after= for times. Other parameters are left to the list.
+package filtering
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ids"
+	"streamify/viewer"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// Kind is what a field holds, which decides the filters it takes
+type Kind int
+
+const (
+	// Text fields take ?f=, ignoring case, and ?f_contains=
+	Text Kind = iota
+	// Enum fields take ?f= with one of the field's values
+	Enum
+	// Time fields take ?f_after= and ?f_before=, as RFC 3339 times or dates
+	Time
+	// Int fields take ?f=, ?f_min= and ?f_max=
+	Int
+	// Bool fields take ?f=true or ?f=false
+	Bool
+	// ID fields take ?f= with a UUID or public ID
+	ID
+)
+
+// Field is a column a list may be filtered and sorted by
+type Field struct {
+	Column string
+	Kind   Kind
+	// Values are an enum field's values, see EnumValues
+	Values []string
+	// Admin keeps the field to admins, for columns only they are shown
+	Admin bool
+}
+
+// EnumValues returns the values the Ent schema fields declare for the enum
+// field name, so an enum filter takes what the schema does as it changes
+func EnumValues(fields []ent.Field, name string) []string {
+	for _, f := range fields {
+		if d := f.Descriptor(); d.Name == name && len(d.Enums) > 0 {
+			values := make([]string, len(d.Enums))
+			for i, e := range d.Enums {
+				values[i] = e.V
+			}
+			return values
+		}
+	}
+	panic(fmt.Sprintf("filtering: no enum field %q", name))
+}
+
+// Fields are the fields a list offers, by the name requests use for them
+type Fields map[string]Field
+
+// Query is the filters and sort order a request asked for
+type Query struct {
+	where []func(*sql.Selector)
+	order []func(*sql.Selector)
+}
+
+// Parse reads the filters of fields and ?sort= and ?order=, writing a 400
+// response and returning ok=false when one isn't valid
+func Parse(c *gin.Context, fields Fields) (q Query, ok bool) {
+	if c.Request.URL.RawQuery == "" {
+		return q, true
+	}
+	admin := viewer.FromContext(c.Request.Context()).IsAdmin()
+	for key, values := range c.Request.URL.Query() {
+		if key == "sort" || key == "order" {
+			continue
+		}
+		name, op := key, ""
+		f, found := fields[key]
+		if i := strings.LastIndexByte(key, '_'); !found && i > 0 {
+			name, op = key[:i], key[i+1:]
+			f, found = fields[name]
+		}
+		if !found {
+			continue
+		}
+		if f.Admin && !admin {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("can't filter by %q", name)})
+			return q, false
+		}
+		p, err := f.predicate(op, values[0])
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s: %v", key, err)})
+			return q, false
+		}
+		q.where = append(q.where, p)
+	}
+
+	name, order := c.Query("sort"), c.Query("order")
+	if order != "" && order != "asc" && order != "desc" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "order must be asc or desc"})
+		return q, false
+	}
+	if name == "" {
+		if order != "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "order needs a sort"})
+			return q, false
+		}
+		return q, true
+	}
+	f, found := fields[name]
+	if !found || f.Admin && !admin {
+		names := make([]string, 0, len(fields))
+		for n, f := range fields {
+			if !f.Admin || admin {
+				names = append(names, n)
+			}
+		}
+		slices.Sort(names)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort must be one of " + strings.Join(names, ", ")})
+		return q, false
+	}
+	var opts []sql.OrderTermOption
+	if order == "desc" {
+		opts = append(opts, sql.OrderDesc())
+	}
+	// Ties keep their order from page to page
+	q.order = []func(*sql.Selector){
+		sql.OrderByField(f.Column, opts...).ToFunc(),
+		sql.OrderByField("id", opts...).ToFunc(),
+	}
+	return q, true
+}
+
+// predicate returns the filter op of the field matching the value s
+func (f Field) predicate(op, s string) (func(*sql.Selector), error) {
+	switch {
+	case f.Kind == Text && op == "":
+		return sql.FieldEqualFold(f.Column, s), nil
+	case f.Kind == Text && op == "contains":
+		return sql.FieldContainsFold(f.Column, s), nil
+	case f.Kind == Enum && op == "":
+		if !slices.Contains(f.Values, s) {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(f.Values, ", "))
+		}
+		return sql.FieldEQ(f.Column, s), nil
+	case f.Kind == Time && (op == "after" || op == "before"):
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, s); err != nil {
+				return nil, fmt.Errorf("must be an RFC 3339 time or a date")
+			}
+		}
+		if op == "after" {
+			return sql.FieldGT(f.Column, t), nil
+		}
+		return sql.FieldLT(f.Column, t), nil
+	case f.Kind == Int && (op == "" || op == "min" || op == "max"):
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("must be an integer")
+		}
+		switch op {
+		case "min":
+			return sql.FieldGTE(f.Column, n), nil
+		case "max":
+			return sql.FieldLTE(f.Column, n), nil
+		}
+		return sql.FieldEQ(f.Column, n), nil
+	case f.Kind == Bool && op == "":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("must be true or false")
+		}
+		return sql.FieldEQ(f.Column, b), nil
+	case f.Kind == ID && op == "":
+		id, err := ids.Parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("must be a UUID or public ID")
+		}
+		return sql.FieldEQ(f.Column, id), nil
+	}
+	return nil, fmt.Errorf("no such filter")
+}
+
+// Where returns the filters as predicates of an entity, such as
+// predicate.User
+func Where[P ~func(*sql.Selector)](q Query) []P {
+	ps := make([]P, len(q.where))
+	for i, w := range q.where {
+		ps[i] = P(w)
+	}
+	return ps
+}
+
+// Order returns the sort order asked for as order options of an entity, such
+// as user.OrderOption, or defaults when none was
+func Order[O ~func(*sql.Selector)](q Query, defaults ...O) []O {
+	if len(q.order) == 0 {
+		return defaults
+	}
+	os := make([]O, len(q.order))
+	for i, o := range q.order {
+		os[i] = O(o)
+	}
+	return os
+}
@@ -0,0 +1,101 @@
+package ids
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+)
+
+// Public IDs are a compact form of an entity's UUID for shareable URLs: 22
+// letters and digits instead of 36 characters. The UUID is encrypted before
+// it is encoded, so a public ID shows neither the creation time a UUIDv7
+// carries nor how close two entities' IDs are, and IDs can't be walked by
+// counting. Nothing is stored: the server turns public IDs back into UUIDs
+// with the same key, from PUBLIC_ID_SECRET.
+//
+// The key must never change once public IDs have been handed out, or every
+// shared link breaks. Without PUBLIC_ID_SECRET a built-in key is used, which
+// keeps IDs compact and opaque but lets anyone with the source decode them.
+
+const (
+	publicLength   = 22
+	publicAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// ErrInvalid is returned by Parse for strings that are neither a UUID nor a
+// public ID
+var ErrInvalid = errors.New("invalid ID")
+
+// defaultPublicSecret is used when PUBLIC_ID_SECRET is unset
+const defaultPublicSecret = "streamify public IDs"
+
+var publicBlock atomic.Pointer[cipher.Block]
+
+func init() {
+	SetPublicSecret(defaultPublicSecret)
+}
+
+// SetPublicSecret sets the key public IDs are encrypted with
+func SetPublicSecret(secret string) {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:16])
+	if err != nil {
+		panic(err) // 16 bytes is always a valid AES key
+	}
+	publicBlock.Store(&block)
+}
+
+// Public returns id's public ID
+func Public(id uuid.UUID) string {
+	var b [16]byte
+	(*publicBlock.Load()).Encrypt(b[:], id[:])
+	n := new(big.Int).SetBytes(b[:])
+	base := big.NewInt(int64(len(publicAlphabet)))
+	digit := new(big.Int)
+	out := make([]byte, publicLength)
+	for i := publicLength - 1; i >= 0; i-- {
+		n.DivMod(n, base, digit)
+		out[i] = publicAlphabet[digit.Int64()]
+	}
+	return string(out)
+}
+
+// Parse returns the UUID that s names, as a UUID or a public ID. Path
+// parameters naming entities accept both forms.
+func Parse(s string) (uuid.UUID, error) {
+	if len(s) != publicLength {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return uuid.Nil, ErrInvalid
+		}
+		return id, nil
+	}
+	n := new(big.Int)
+	base := big.NewInt(int64(len(publicAlphabet)))
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(publicAlphabet, s[i])
+		if d < 0 {
+			return uuid.Nil, ErrInvalid
+		}
+		n.Mul(n, base).Add(n, big.NewInt(int64(d)))
+	}
+	if n.BitLen() > 128 {
+		return uuid.Nil, ErrInvalid
+	}
+	var b [16]byte
+	n.FillBytes(b[:])
+	var id uuid.UUID
+	(*publicBlock.Load()).Decrypt(id[:], b[:])
+	// Every ID this server makes has the RFC 4122 variant, which rules out
+	// most mistyped public IDs before they reach the database
+	if id.Variant() != uuid.RFC4122 {
+		return uuid.Nil, ErrInvalid
+	}
+	return id, nil
+}
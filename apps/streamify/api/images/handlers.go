@@ -0,0 +1,91 @@
+package images
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"streamify/ids"
+	"streamify/storage"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UploadImage stores the request body as artwork (admin) and returns its URL
+// to set as an artist's or album's image_url
+func UploadImage(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !viewer.FromContext(c.Request.Context()).IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			return
+		}
+		if c.Request.ContentLength > MaxUploadSize {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": ErrTooLarge.Error()})
+			return
+		}
+		img, err := s.Upload(c.Request.Context(), c.Request.Body)
+		switch {
+		case errors.Is(err, ErrTooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case errors.Is(err, ErrNotImage):
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusCreated, img)
+		}
+	}
+}
+
+// ServeImage serves an image resized to ?w= and ?h= (pixels, either may be
+// left out) with ?fit=contain|cover|fill (default contain). Sizes are rounded
+// up to a fixed ladder and never exceed the original; renditions are cached,
+// and since images never change they can be cached forever downstream.
+func ServeImage(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid image ID"})
+			return
+		}
+		w, err := parseDimension(c.Query("w"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "w " + err.Error()})
+			return
+		}
+		h, err := parseDimension(c.Query("h"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "h " + err.Error()})
+			return
+		}
+		fit := Fit(c.DefaultQuery("fit", string(FitContain)))
+		switch fit {
+		case FitContain, FitCover, FitFill:
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "fit must be contain, cover or fill"})
+			return
+		}
+		w, h = Snap(w), Snap(h)
+
+		etag := strconv.Quote(id.String() + "-" + strconv.Itoa(w) + "x" + strconv.Itoa(h) + "-" + string(fit))
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Header("ETag", etag)
+		if c.GetHeader("If-None-Match") == etag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		data, contentType, err := s.Variant(c.Request.Context(), id, w, h, fit)
+		if err != nil {
+			c.Header("Cache-Control", "no-store")
+			if storage.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "image not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Data(http.StatusOK, contentType, data)
+	}
+}
@@ -0,0 +1,267 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // accepted on upload
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+
+	"streamify/logging"
+	"streamify/storage"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("images")
+
+const (
+	// MaxUploadSize caps the size of an uploaded image file
+	MaxUploadSize = 20 << 20
+	// MaxPixels caps the decoded size of an uploaded image
+	MaxPixels = 40_000_000
+	// MaxDimension is the largest width or height served
+	MaxDimension = 2048
+
+	jpegQuality = 85
+)
+
+// steps are the sizes requested dimensions are rounded up to, so that only
+// a handful of renditions are cached per image
+var steps = []int{32, 48, 64, 96, 128, 160, 192, 256, 320, 384, 512, 640, 768, 1024, 1280, 1600, 2048}
+
+var (
+	// ErrNotImage is returned for uploads that aren't JPEG, PNG or GIF images
+	ErrNotImage = errors.New("file is not a JPEG, PNG or GIF image")
+	// ErrTooLarge is returned for uploads over MaxUploadSize or MaxPixels
+	ErrTooLarge = fmt.Errorf("image exceeds %d MB or %d megapixels", MaxUploadSize>>20, MaxPixels/1_000_000)
+)
+
+// Image describes an uploaded image
+type Image struct {
+	ID     uuid.UUID `json:"id"`
+	URL    string    `json:"url"`
+	Width  int       `json:"width"`
+	Height int       `json:"height"`
+	Format string    `json:"format"`
+	// Palette is the image's dominant colors, see Palette
+	Palette []string `json:"palette"`
+}
+
+// URL returns the path an image is served from; w, h and fit are added as
+// query parameters to get a resized rendition
+func URL(id uuid.UUID) string {
+	return "/img/" + id.String()
+}
+
+// ParseURL returns the ID of the image an image_url points to, if it is one
+// served by ServeImage. Absolute URLs and query strings are accepted.
+func ParseURL(u string) (uuid.UUID, bool) {
+	u, _, _ = strings.Cut(u, "?")
+	i := strings.LastIndex(u, "/img/")
+	if i < 0 {
+		return uuid.UUID{}, false
+	}
+	id, err := uuid.Parse(u[i+len("/img/"):])
+	return id, err == nil
+}
+
+func originalKey(id uuid.UUID) string {
+	return "images/originals/" + id.String()
+}
+
+func infoKey(id uuid.UUID) string {
+	return "images/info/" + id.String()
+}
+
+func variantKey(id uuid.UUID, w, h int, fit Fit) string {
+	return fmt.Sprintf("images/variants/%s/%dx%d-%s", id, w, h, fit)
+}
+
+// Service stores uploaded artwork and renders resized copies of it, which
+// are cached in storage next to the originals
+type Service struct {
+	store storage.Storage
+}
+
+// New returns a Service keeping images in store
+func New(store storage.Storage) *Service {
+	return &Service{store: store}
+}
+
+// Snap rounds a requested dimension up to the next cached size; 0 stays 0
+func Snap(v int) int {
+	if v <= 0 {
+		return 0
+	}
+	for _, s := range steps {
+		if s >= v {
+			return s
+		}
+	}
+	return MaxDimension
+}
+
+// Upload stores the image in r as an original
+func (s *Service) Upload(ctx context.Context, r io.Reader) (*Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxUploadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxUploadSize {
+		return nil, ErrTooLarge
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrNotImage
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooLarge
+	}
+	// Decoding it all refuses broken files now rather than on first view
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrNotImage
+	}
+
+	id := uuid.New()
+	img := &Image{
+		ID:      id,
+		URL:     URL(id),
+		Width:   cfg.Width,
+		Height:  cfg.Height,
+		Format:  format,
+		Palette: Palette(src, PaletteSize),
+	}
+	if err := s.store.Put(ctx, originalKey(id), bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("storing image: %w", err)
+	}
+	if err := s.putInfo(ctx, img); err != nil {
+		return nil, err
+	}
+	logger.Info("image uploaded", "image_id", id, "format", format, "width", cfg.Width, "height", cfg.Height)
+	return img, nil
+}
+
+// Info describes image id. Images uploaded before their description was
+// kept are described from the original, once. Missing images are
+// storage.ErrNotFound.
+func (s *Service) Info(ctx context.Context, id uuid.UUID) (*Image, error) {
+	if data, err := s.read(ctx, infoKey(id)); err == nil {
+		var img Image
+		if err := json.Unmarshal(data, &img); err != nil {
+			return nil, fmt.Errorf("reading image %s: %w", id, err)
+		}
+		return &img, nil
+	} else if !storage.IsNotFound(err) {
+		return nil, err
+	}
+
+	original, err := s.read(ctx, originalKey(id))
+	if err != nil {
+		return nil, err
+	}
+	src, format, err := image.Decode(bytes.NewReader(original))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image %s: %w", id, err)
+	}
+	img := &Image{
+		ID:      id,
+		URL:     URL(id),
+		Width:   src.Bounds().Dx(),
+		Height:  src.Bounds().Dy(),
+		Format:  format,
+		Palette: Palette(src, PaletteSize),
+	}
+	if err := s.putInfo(ctx, img); err != nil {
+		logger.Warn("caching image info failed", "image_id", id, "error", err)
+	}
+	return img, nil
+}
+
+func (s *Service) putInfo(ctx context.Context, img *Image) error {
+	data, err := json.Marshal(img)
+	if err != nil {
+		return err
+	}
+	if err := s.store.Put(ctx, infoKey(img.ID), bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("storing image info: %w", err)
+	}
+	return nil
+}
+
+// Variant returns image id scaled to fit a w×h box and its content type;
+// either dimension may be 0 to follow the aspect ratio. JPEGs stay JPEGs and
+// other formats are served as PNG. Missing images are storage.ErrNotFound.
+func (s *Service) Variant(ctx context.Context, id uuid.UUID, w, h int, fit Fit) ([]byte, string, error) {
+	key := variantKey(id, w, h, fit)
+	if data, err := s.read(ctx, key); err == nil {
+		return data, contentType(data), nil
+	} else if !storage.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	original, err := s.read(ctx, originalKey(id))
+	if err != nil {
+		return nil, "", err
+	}
+	src, format, err := image.Decode(bytes.NewReader(original))
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding image %s: %w", id, err)
+	}
+	size, region := target(src.Bounds(), w, h, fit)
+	if region == src.Bounds() && size == region.Size() {
+		return original, "image/" + format, nil
+	}
+
+	resized := Resize(src, region, size)
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, resized)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.store.Put(ctx, key, bytes.NewReader(buf.Bytes())); err != nil {
+		logger.Warn("caching image variant failed", "key", key, "error", err)
+	}
+	return buf.Bytes(), contentType(buf.Bytes()), nil
+}
+
+func (s *Service) read(ctx context.Context, key string) ([]byte, error) {
+	rc, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// contentType returns the content type of an encoded JPEG or PNG
+func contentType(data []byte) string {
+	if bytes.HasPrefix(data, []byte("\xff\xd8")) {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// parseDimension parses a w or h query parameter
+func parseDimension(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 || v > MaxDimension {
+		return 0, fmt.Errorf("must be an integer between 0 and %d", MaxDimension)
+	}
+	return v, nil
+}
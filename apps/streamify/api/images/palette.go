@@ -0,0 +1,104 @@
+package images
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"slices"
+)
+
+const (
+	// PaletteSize is how many colors are extracted from artwork
+	PaletteSize = 5
+
+	// paletteSample is how many pixels are sampled along the longer side
+	paletteSample = 64
+	// minDistance keeps near-identical shades out of the same palette; it is
+	// a squared distance in 8-bit RGB
+	minDistance = 48 * 48
+)
+
+// bucket gathers the pixels falling into one cell of a 4-bit-per-channel grid
+type bucket struct {
+	count   int
+	r, g, b int
+}
+
+func (b bucket) color() [3]int {
+	return [3]int{b.r / b.count, b.g / b.count, b.b / b.count}
+}
+
+// Palette returns up to n dominant colors of img as #rrggbb, most dominant
+// first. The colors of a grid of sampled pixels are counted in a coarse
+// color grid, and a color too close to one already picked is skipped so the
+// palette isn't five shades of the background.
+func Palette(img image.Image, n int) []string {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return nil
+	}
+	// Sampling a grid of pixels rather than shrinking keeps the colors real:
+	// averaging would invent blends along every edge
+	step := max(1, max(bounds.Dx(), bounds.Dy())/paletteSample)
+	buckets := map[int]*bucket{}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 128 {
+				continue // transparent areas aren't part of the artwork's look
+			}
+			addSample(buckets, int(c.R), int(c.G), int(c.B))
+		}
+	}
+
+	sorted := make([]*bucket, 0, len(buckets))
+	for _, b := range buckets {
+		sorted = append(sorted, b)
+	}
+	slices.SortFunc(sorted, func(x, y *bucket) int {
+		if x.count != y.count {
+			return y.count - x.count
+		}
+		// Ties are broken by color so the palette is deterministic
+		xc, yc := x.color(), y.color()
+		return slices.Compare(xc[:], yc[:])
+	})
+
+	var picked [][3]int
+	for _, b := range sorted {
+		if len(picked) == n {
+			break
+		}
+		c := b.color()
+		if slices.ContainsFunc(picked, func(p [3]int) bool { return distance(p, c) < minDistance }) {
+			continue
+		}
+		picked = append(picked, c)
+	}
+
+	palette := make([]string, len(picked))
+	for i, c := range picked {
+		palette[i] = fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+	}
+	return palette
+}
+
+// addSample counts a pixel into its bucket
+func addSample(buckets map[int]*bucket, r, g, b int) {
+	key := r>>4<<8 | g>>4<<4 | b>>4
+	bk := buckets[key]
+	if bk == nil {
+		bk = &bucket{}
+		buckets[key] = bk
+	}
+	bk.count++
+	bk.r += r
+	bk.g += g
+	bk.b += b
+}
+
+// distance returns the squared distance between two RGB colors
+func distance(a, b [3]int) int {
+	dr, dg, db := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dr*dr + dg*dg + db*db
+}
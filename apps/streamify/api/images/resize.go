@@ -0,0 +1,133 @@
+package images
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Fit says how an image is made to fit the requested box
+type Fit string
+
+const (
+	// FitContain scales the image to fit inside the box, keeping its aspect ratio
+	FitContain Fit = "contain"
+	// FitCover scales the image to cover the box and crops the overflow
+	FitCover Fit = "cover"
+	// FitFill stretches the image to the box
+	FitFill Fit = "fill"
+)
+
+// target returns the size src is scaled to and the part of src used for a
+// w×h box; a zero w or h leaves that side to follow the aspect ratio. Images
+// are never scaled up.
+func target(src image.Rectangle, w, h int, fit Fit) (image.Point, image.Rectangle) {
+	sw, sh := src.Dx(), src.Dy()
+	switch {
+	case w == 0 && h == 0:
+		return image.Pt(sw, sh), src
+	case w == 0:
+		w = max(1, sw*h/sh)
+		fit = FitFill
+	case h == 0:
+		h = max(1, sh*w/sw)
+		fit = FitFill
+	}
+
+	crop := src
+	switch fit {
+	case FitContain:
+		// The smaller of the two scales wins
+		if w*sh < h*sw {
+			h = max(1, sh*w/sw)
+		} else {
+			w = max(1, sw*h/sh)
+		}
+	case FitCover:
+		// Crop the source to the box's aspect ratio around its center
+		if w*sh < h*sw {
+			cw := sh * w / h
+			crop.Min.X += (sw - cw) / 2
+			crop.Max.X = crop.Min.X + cw
+		} else {
+			ch := sw * h / w
+			crop.Min.Y += (sh - ch) / 2
+			crop.Max.Y = crop.Min.Y + ch
+		}
+	}
+
+	// Never upscale: shrink the box by the same factor on both sides
+	if w > crop.Dx() || h > crop.Dy() {
+		f := min(float64(crop.Dx())/float64(w), float64(crop.Dy())/float64(h))
+		w, h = max(1, int(math.Round(float64(w)*f))), max(1, int(math.Round(float64(h)*f)))
+	}
+	return image.Pt(w, h), crop
+}
+
+// Resize returns the src region scaled to size with an area-averaging (box)
+// filter, which is what downscaling artwork needs
+func Resize(src image.Image, region image.Rectangle, size image.Point) *image.RGBA {
+	// Work on premultiplied RGBA so transparent pixels don't bleed their color
+	rgba := image.NewRGBA(image.Rect(0, 0, region.Dx(), region.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, region.Min, draw.Src)
+
+	sw, sh := region.Dx(), region.Dy()
+	dw, dh := size.X, size.Y
+
+	// Horizontal pass into a float buffer of dw × sh pixels
+	tmp := make([]float32, dw*sh*4)
+	columns := spans(dw, sw)
+	for y := 0; y < sh; y++ {
+		src := rgba.Pix[y*rgba.Stride:]
+		row := tmp[y*dw*4 : (y+1)*dw*4]
+		for x, col := range columns {
+			for _, sp := range col {
+				for c := 0; c < 4; c++ {
+					row[x*4+c] += float32(src[sp.src*4+c]) * sp.weight
+				}
+			}
+		}
+	}
+
+	// Vertical pass into the destination
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	acc := make([]float32, dw*4)
+	for y, spansY := range spans(dh, sh) {
+		clear(acc)
+		for _, sp := range spansY {
+			row := tmp[sp.src*dw*4 : (sp.src+1)*dw*4]
+			for i, v := range row {
+				acc[i] += v * sp.weight
+			}
+		}
+		out := dst.Pix[y*dst.Stride : y*dst.Stride+dw*4]
+		for i, v := range acc {
+			out[i] = uint8(min(255, v+0.5))
+		}
+	}
+	return dst
+}
+
+// span is a source pixel's share of a destination pixel
+type span struct {
+	src    int
+	weight float32
+}
+
+// spans returns, for each of n destination pixels scaled from src pixels,
+// the source pixels it covers weighted by how much of each it covers; the
+// weights of a destination pixel sum to 1
+func spans(n, src int) [][]span {
+	scale := float32(src) / float32(n)
+	out := make([][]span, n)
+	for i := range out {
+		start, end := float32(i)*scale, float32(i+1)*scale
+		for s := int(start); s < src && float32(s) < end; s++ {
+			lo, hi := max(start, float32(s)), min(end, float32(s+1))
+			if hi > lo {
+				out[i] = append(out[i], span{src: s, weight: (hi - lo) / scale})
+			}
+		}
+	}
+	return out
+}
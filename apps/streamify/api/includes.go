@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"streamify/ent"
+	"streamify/loader"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// includes is the set of relation paths requested with ?include=, such as
+// "track.album.artist"; a nested path implies each of its parents
+type includes map[string]bool
+
+// parseIncludes reads the comma-separated ?include= parameter, rejecting any
+// path the endpoint does not offer
+func parseIncludes(c *gin.Context, allowed ...string) (includes, error) {
+	inc := includes{}
+	for _, p := range strings.Split(c.Query("include"), ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !slices.Contains(allowed, p) {
+			return nil, fmt.Errorf("cannot include %q; expected one of: %s", p, strings.Join(allowed, ", "))
+		}
+		for i := range len(p) {
+			if p[i] == '.' {
+				inc[p[:i]] = true
+			}
+		}
+		inc[p] = true
+	}
+	return inc, nil
+}
+
+// under returns the paths nested below relation, relative to it
+func (inc includes) under(relation string) includes {
+	out := includes{}
+	for p := range inc {
+		if rest, ok := strings.CutPrefix(p, relation+"."); ok {
+			out[rest] = true
+		}
+	}
+	return out
+}
+
+// expandTracks fills the requested relations of tracks with one batched query
+// per relation, however many tracks there are
+func expandTracks(ctx context.Context, l *loader.Loaders, tracks []*ent.Track, inc includes) error {
+	if !inc["album"] {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(tracks))
+	for _, t := range tracks {
+		ids = append(ids, t.AlbumID)
+	}
+	albums, err := l.Albums.LoadMany(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range tracks {
+		t.Edges.Album = albums[t.AlbumID]
+	}
+	loaded := make([]*ent.Album, 0, len(albums))
+	for _, a := range albums {
+		loaded = append(loaded, a)
+	}
+	return expandAlbums(ctx, l, loaded, inc.under("album"))
+}
+
+// expandAlbums fills the requested relations of albums with batched queries
+func expandAlbums(ctx context.Context, l *loader.Loaders, albums []*ent.Album, inc includes) error {
+	if !inc["artist"] {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(albums))
+	for _, a := range albums {
+		ids = append(ids, a.ArtistID)
+	}
+	artists, err := l.Artists.LoadMany(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, a := range albums {
+		a.Edges.Artist = artists[a.ArtistID]
+	}
+	return nil
+}
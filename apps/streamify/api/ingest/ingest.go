@@ -0,0 +1,470 @@
+// Package ingest writes plays behind the request that records them. Accepted
+// plays are buffered and inserted in batches every Interval, which sustains
+// far more plays per second than an INSERT per request. Without a
+// write-ahead log a crash loses the plays of up to one Interval; with one,
+// a play is synced to local disk before it is acknowledged and replayed on
+// the next start if it never reached the database.
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/track"
+	"streamify/events"
+	"streamify/logging"
+	"streamify/playcounts"
+	"streamify/privacy"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var logger = logging.For("ingest")
+
+const (
+	// DefaultMaxPending is how many plays may be accepted but not yet written
+	// unless configured otherwise
+	DefaultMaxPending = 200000
+	// writeBatch is how many plays one INSERT writes
+	writeBatch = 5000
+	// groupCommit is the most plays synced to the write-ahead log at once
+	groupCommit = 1024
+	// maxAttempts is how often a batch is tried before it is given up
+	maxAttempts = 8
+	// trackCacheTTL is how long a track is taken to exist after it was seen
+	trackCacheTTL = 5 * time.Minute
+	// maxCachedTracks caps the tracks remembered at once
+	maxCachedTracks = 100000
+)
+
+var (
+	// ErrFull is returned while more plays are pending than configured
+	ErrFull = errors.New("play buffer is full")
+	// ErrUnknownTrack is returned for plays of tracks that don't exist
+	ErrUnknownTrack = errors.New("track not found")
+	// ErrClosed is returned after Close
+	ErrClosed = errors.New("play buffer is closed")
+)
+
+// Play is a play accepted for writing
+type Play struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	TrackID   uuid.UUID `json:"track_id"`
+	Territory string    `json:"territory,omitempty"`
+	PlayedAt  time.Time `json:"played_at"`
+}
+
+// Config configures a Buffer
+type Config struct {
+	// Interval is how often buffered plays are written
+	Interval time.Duration
+	// WALDir, if set, keeps plays in a local write-ahead log until written
+	WALDir string
+	// MaxPending caps the plays accepted but not yet written
+	MaxPending int
+}
+
+type request struct {
+	play Play
+	done chan error
+}
+
+type batch struct {
+	plays   []Play
+	segment string
+}
+
+// Buffer accepts plays and writes them in batches
+type Buffer struct {
+	client *ent.Client
+	cfg    Config
+	wal    *wal
+
+	in      chan request
+	batches chan batch
+	closing chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+
+	tracksMu sync.Mutex
+	tracks   map[uuid.UUID]time.Time
+
+	pending  atomic.Int64
+	accepted atomic.Int64
+	written  atomic.Int64
+	skipped  atomic.Int64
+	failed   atomic.Int64
+}
+
+// New returns a Buffer writing to client. Plays left in the write-ahead log
+// by an earlier run are written first.
+func New(ctx context.Context, client *ent.Client, cfg Config) (*Buffer, error) {
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("ingest: interval must be positive")
+	}
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = DefaultMaxPending
+	}
+	b := &Buffer{
+		client:  client,
+		cfg:     cfg,
+		in:      make(chan request, groupCommit),
+		batches: make(chan batch, 1),
+		closing: make(chan struct{}),
+		stopped: make(chan struct{}),
+		tracks:  map[uuid.UUID]time.Time{},
+	}
+	if cfg.WALDir != "" {
+		if err := b.replay(ctx); err != nil {
+			return nil, fmt.Errorf("ingest: replaying write-ahead log: %w", err)
+		}
+		w, err := openWAL(cfg.WALDir)
+		if err != nil {
+			return nil, fmt.Errorf("ingest: opening write-ahead log: %w", err)
+		}
+		b.wal = w
+	}
+	go b.run()
+	go b.writeLoop()
+	return b, nil
+}
+
+// replay writes the plays of segments left by an earlier run
+func (b *Buffer) replay(ctx context.Context) error {
+	paths, err := segments(b.cfg.WALDir)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		plays, err := readSegment(path)
+		if err != nil {
+			return err
+		}
+		if err := b.write(ctx, plays); err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		logger.Info("replayed plays from write-ahead log", "segment", path, "plays", len(plays))
+	}
+	return nil
+}
+
+// Accept buffers p for writing. Tracks are checked against a short-lived
+// cache, so most plays don't touch the database; a track deleted meanwhile
+// has its plays skipped when they are written. With a write-ahead log,
+// Accept returns once p is on disk.
+func (b *Buffer) Accept(ctx context.Context, p Play) error {
+	if !b.knownTrack(p.TrackID) {
+		exists, err := b.client.Track.Query().
+			Where(track.IDEQ(p.TrackID), track.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrUnknownTrack
+		}
+		b.rememberTrack(p.TrackID)
+	}
+
+	if b.pending.Add(1) > int64(b.cfg.MaxPending) {
+		b.pending.Add(-1)
+		return ErrFull
+	}
+	r := request{play: p, done: make(chan error, 1)}
+	select {
+	case b.in <- r:
+	case <-b.closing:
+		b.pending.Add(-1)
+		return ErrClosed
+	case <-ctx.Done():
+		b.pending.Add(-1)
+		return ctx.Err()
+	}
+	var err error
+	select {
+	case err = <-r.done:
+	case <-b.stopped:
+		err = ErrClosed
+	}
+	if err != nil {
+		b.pending.Add(-1)
+	}
+	return err
+}
+
+func (b *Buffer) knownTrack(id uuid.UUID) bool {
+	b.tracksMu.Lock()
+	defer b.tracksMu.Unlock()
+	seen, ok := b.tracks[id]
+	return ok && time.Since(seen) < trackCacheTTL
+}
+
+func (b *Buffer) rememberTrack(id uuid.UUID) {
+	b.tracksMu.Lock()
+	defer b.tracksMu.Unlock()
+	if len(b.tracks) >= maxCachedTracks {
+		b.tracks = map[uuid.UUID]time.Time{}
+	}
+	b.tracks[id] = time.Now()
+}
+
+// run collects accepted plays, logging them in groups, and hands them to
+// the writer every Interval or once a batch is full
+func (b *Buffer) run() {
+	ticker := time.NewTicker(b.cfg.Interval)
+	defer ticker.Stop()
+	var buf []Play
+	for {
+		select {
+		case r := <-b.in:
+			reqs := []request{r}
+		group:
+			for len(reqs) < groupCommit {
+				select {
+				case r := <-b.in:
+					reqs = append(reqs, r)
+				default:
+					break group
+				}
+			}
+			buf = b.log(reqs, buf)
+			if len(buf) >= writeBatch {
+				buf = b.cut(buf, false)
+			}
+		case <-ticker.C:
+			buf = b.cut(buf, false)
+		case <-b.closing:
+		drain:
+			for {
+				select {
+				case r := <-b.in:
+					buf = b.log([]request{r}, buf)
+				default:
+					break drain
+				}
+			}
+			b.cut(buf, true)
+			close(b.batches)
+			return
+		}
+	}
+}
+
+// log syncs reqs to the write-ahead log, if any, acknowledges them and
+// adds them to buf
+func (b *Buffer) log(reqs []request, buf []Play) []Play {
+	plays := make([]Play, len(reqs))
+	for i, r := range reqs {
+		plays[i] = r.play
+	}
+	var err error
+	if b.wal != nil {
+		if err = b.wal.append(plays); err != nil {
+			logger.Error("writing plays to write-ahead log failed", "plays", len(plays), "error", err)
+		}
+	}
+	for _, r := range reqs {
+		r.done <- err
+	}
+	if err != nil {
+		return buf
+	}
+	b.accepted.Add(int64(len(plays)))
+	return append(buf, plays...)
+}
+
+// cut hands buf to the writer, along with its write-ahead log segment. While
+// a batch is already queued behind the one being written, plays keep
+// accumulating instead, unless wait is set.
+func (b *Buffer) cut(buf []Play, wait bool) []Play {
+	if len(buf) == 0 || (!wait && len(b.batches) > 0) {
+		return buf
+	}
+	bt := batch{plays: buf}
+	if b.wal != nil {
+		segment, err := b.wal.rotate()
+		if err != nil {
+			logger.Error("rotating write-ahead log failed", "error", err)
+			return buf
+		}
+		bt.segment = segment
+	}
+	b.batches <- bt
+	return nil
+}
+
+func (b *Buffer) writeLoop() {
+	defer close(b.stopped)
+	for bt := range b.batches {
+		b.flush(bt)
+	}
+}
+
+// flush writes a batch, retrying with backoff. A batch that still fails is
+// given up; its write-ahead log segment is kept as .failed for an operator.
+func (b *Buffer) flush(bt batch) {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = b.write(context.Background(), bt.plays); err == nil {
+			break
+		}
+		logger.Warn("writing plays failed", "plays", len(bt.plays), "attempt", attempt, "error", err)
+		if attempt < maxAttempts {
+			time.Sleep(min(100*time.Millisecond<<attempt, 30*time.Second))
+		}
+	}
+	b.pending.Add(-int64(len(bt.plays)))
+	if err != nil {
+		b.failed.Add(int64(len(bt.plays)))
+		logger.Error("gave up writing plays", "plays", len(bt.plays), "segment", bt.segment, "error", err)
+		if bt.segment != "" {
+			if err := os.Rename(bt.segment, bt.segment+".failed"); err != nil {
+				logger.Error("keeping failed write-ahead log segment failed", "segment", bt.segment, "error", err)
+			}
+		}
+		return
+	}
+	if bt.segment != "" {
+		if err := os.Remove(bt.segment); err != nil {
+			logger.Warn("removing written write-ahead log segment failed", "segment", bt.segment, "error", err)
+		}
+	}
+}
+
+// insertPlays inserts plays of live tracks by existing users, returning each
+// new play with whether its user opted out of analytics. Plays already
+// written, e.g. by an attempt that timed out after committing or a replay,
+// are left alone.
+const insertPlays = `INSERT INTO plays (id, user_id, track_id, territory, played_at, progress_ms)
+SELECT b.id, b.user_id, b.track_id, NULLIF(b.territory, ''), b.played_at, 0
+FROM unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::text[], $5::timestamptz[]) AS b(id, user_id, track_id, territory, played_at)
+JOIN tracks t ON t.id = b.track_id AND t.deleted_at IS NULL
+JOIN users u ON u.id = b.user_id
+ON CONFLICT DO NOTHING
+RETURNING plays.id, u.analytics_opt_out`
+
+// write inserts plays in chunks, adding the new ones to their users' play
+// counts in the same transaction, and emits play.recorded for them
+func (b *Buffer) write(ctx context.Context, plays []Play) error {
+	for chunk := range slices.Chunk(plays, writeBatch) {
+		ids := make([]string, len(chunk))
+		users := make([]string, len(chunk))
+		tracks := make([]string, len(chunk))
+		territories := make([]string, len(chunk))
+		playedAt := make([]string, len(chunk))
+		for i, p := range chunk {
+			ids[i] = p.ID.String()
+			users[i] = p.UserID.String()
+			tracks[i] = p.TrackID.String()
+			territories[i] = p.Territory
+			playedAt[i] = p.PlayedAt.Format(time.RFC3339Nano)
+		}
+		tx, err := b.client.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		rows, err := tx.QueryContext(ctx, insertPlays,
+			pq.Array(ids), pq.Array(users), pq.Array(tracks), pq.Array(territories), pq.Array(playedAt))
+		if err != nil {
+			return rollback(tx, err)
+		}
+		// inserted maps each new play to whether its user opted out of analytics
+		inserted := map[uuid.UUID]bool{}
+		for rows.Next() {
+			var (
+				id     uuid.UUID
+				optOut bool
+			)
+			if err := rows.Scan(&id, &optOut); err != nil {
+				rows.Close()
+				return rollback(tx, err)
+			}
+			inserted[id] = optOut
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return rollback(tx, err)
+		}
+		counts := playcounts.Tally{}
+		for _, p := range chunk {
+			if _, ok := inserted[p.ID]; ok {
+				counts.Add(p.UserID, p.TrackID, p.PlayedAt)
+			}
+		}
+		if err := counts.Write(ctx, tx); err != nil {
+			return rollback(tx, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		b.written.Add(int64(len(inserted)))
+		b.skipped.Add(int64(len(chunk) - len(inserted)))
+		for _, p := range chunk {
+			if optOut, ok := inserted[p.ID]; ok {
+				events.Emit(ctx, privacy.PlayEvent(events.PlayRecorded{
+					PlayID:    p.ID,
+					UserID:    p.UserID,
+					TrackID:   p.TrackID,
+					PlayedAt:  p.PlayedAt,
+					Territory: p.Territory,
+				}, optOut))
+			}
+		}
+	}
+	return nil
+}
+
+// rollback aborts tx and returns err, wrapping any rollback failure
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
+
+// Close stops accepting plays and writes those still buffered
+func (b *Buffer) Close() error {
+	b.once.Do(func() { close(b.closing) })
+	<-b.stopped
+	if b.wal != nil {
+		return b.wal.close()
+	}
+	return nil
+}
+
+// Status is the buffer's state for /health
+type Status struct {
+	Pending  int64 `json:"pending"`
+	Accepted int64 `json:"accepted"`
+	Written  int64 `json:"written"`
+	Failed   int64 `json:"failed"`
+}
+
+// Health implements resilience.Component. The buffer is unhealthy while
+// full, as plays are then refused.
+func (b *Buffer) Health() (bool, any) {
+	s := Status{Pending: b.pending.Load(), Accepted: b.accepted.Load(), Written: b.written.Load(), Failed: b.failed.Load()}
+	return s.Pending < int64(b.cfg.MaxPending), s
+}
+
+// WriteMetrics implements resilience.Component
+func (b *Buffer) WriteMetrics(w io.Writer) {
+	fmt.Fprintf(w, "# HELP plays_ingest_pending Plays accepted but not yet written.\n# TYPE plays_ingest_pending gauge\nplays_ingest_pending %d\n", b.pending.Load())
+	fmt.Fprintf(w, "# HELP plays_ingest_accepted_total Plays accepted into the buffer.\n# TYPE plays_ingest_accepted_total counter\nplays_ingest_accepted_total %d\n", b.accepted.Load())
+	fmt.Fprintf(w, "# HELP plays_ingest_written_total Plays inserted from the buffer.\n# TYPE plays_ingest_written_total counter\nplays_ingest_written_total %d\n", b.written.Load())
+	fmt.Fprintf(w, "# HELP plays_ingest_skipped_total Buffered plays not inserted because they already existed or their track or user was deleted.\n# TYPE plays_ingest_skipped_total counter\nplays_ingest_skipped_total %d\n", b.skipped.Load())
+	fmt.Fprintf(w, "# HELP plays_ingest_failed_total Buffered plays given up after repeated write failures.\n# TYPE plays_ingest_failed_total counter\nplays_ingest_failed_total %d\n", b.failed.Load())
+}
@@ -0,0 +1,108 @@
+package ingest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// wal is an append-only log of accepted plays, split into segments. The
+// segment being written is rotated each time the buffer hands a batch to the
+// writer, and deleted once that batch is in the database.
+type wal struct {
+	dir  string
+	f    *os.File
+	path string
+}
+
+func openWAL(dir string) (*wal, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	w := &wal{dir: dir}
+	if err := w.next(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// segments lists the segments left by earlier runs, oldest first
+func segments(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "plays-*.wal"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func (w *wal) next() error {
+	path := filepath.Join(w.dir, fmt.Sprintf("plays-%020d.wal", time.Now().UnixNano()))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	w.f, w.path = f, path
+	return nil
+}
+
+// append writes plays and syncs them to disk before returning
+func (w *wal) append(plays []Play) error {
+	bw := bufio.NewWriter(w.f)
+	enc := json.NewEncoder(bw)
+	for _, p := range plays {
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// rotate starts a new segment and returns the path of the finished one
+func (w *wal) rotate() (string, error) {
+	done := w.path
+	if err := w.f.Close(); err != nil {
+		return "", err
+	}
+	return done, w.next()
+}
+
+// close closes the current segment, removing it when nothing was written
+func (w *wal) close() error {
+	info, err := w.f.Stat()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && info.Size() == 0 {
+		err = os.Remove(w.path)
+	}
+	return err
+}
+
+// readSegment returns the plays in a segment. A torn last line, left by a
+// crash in the middle of a write that was never acknowledged, is skipped.
+func readSegment(path string) ([]Play, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var plays []Play
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var p Play
+		if err := json.Unmarshal(sc.Bytes(), &p); err != nil {
+			logger.Warn("skipping unreadable play in write-ahead log", "segment", path, "error", err)
+			continue
+		}
+		plays = append(plays, p)
+	}
+	return plays, sc.Err()
+}
@@ -0,0 +1,282 @@
+package invites
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/invite"
+	"streamify/ids"
+	"streamify/mail"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReferralLimit is how many referral codes each user may create
+const ReferralLimit = 5
+
+// expiry converts a number of days from now into an expiry time; zero means never
+func expiry(days int) *time.Time {
+	if days == 0 {
+		return nil
+	}
+	t := time.Now().AddDate(0, 0, days)
+	return &t
+}
+
+// CreateReferral creates a single-use referral code for the current user to share
+func CreateReferral(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		n, err := client.Invite.Query().
+			Where(invite.CreatedByEQ(userID), invite.KindEQ(invite.KindReferral)).
+			Count(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n >= ReferralLimit {
+			c.JSON(http.StatusForbidden, gin.H{"error": "referral invite limit reached", "limit": ReferralLimit})
+			return
+		}
+
+		inv, err := Create(ctx, client, Options{Kind: invite.KindReferral, CreatedBy: &userID})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusCreated, inv)
+	}
+}
+
+// ListReferrals lists the current user's referral codes, newest first
+func ListReferrals(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		invs, err := client.Invite.Query().
+			Where(invite.CreatedByEQ(userID), invite.KindEQ(invite.KindReferral)).
+			Order(ent.Desc(invite.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"invites": invs, "limit": ReferralLimit})
+	}
+}
+
+// CreateInvitesRequest is the request body for CreateInvites
+type CreateInvitesRequest struct {
+	Count         int    `json:"count" binding:"required,min=1,max=500"`
+	MaxUses       int    `json:"max_uses" binding:"omitempty,min=1,max=100000"`
+	ExpiresInDays int    `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+	Email         string `json:"email" binding:"omitempty,email"`
+}
+
+// CreateInvites mints a batch of admin invite codes
+func CreateInvites(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreateInvitesRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if body.Email != "" && body.Count > 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "an email-bound invite must be created on its own"})
+			return
+		}
+
+		opts := Options{
+			Kind:      invite.KindAdmin,
+			Email:     body.Email,
+			MaxUses:   body.MaxUses,
+			ExpiresAt: expiry(body.ExpiresInDays),
+		}
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			opts.CreatedBy = &id
+		}
+
+		invs := make([]*ent.Invite, 0, body.Count)
+		for i := 0; i < body.Count; i++ {
+			inv, err := Create(c.Request.Context(), client, opts)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "invites": invs})
+				return
+			}
+			invs = append(invs, inv)
+		}
+		c.JSON(http.StatusCreated, gin.H{"invites": invs})
+	}
+}
+
+// ListInvites lists invite codes, newest first, optionally filtered by ?kind=
+func ListInvites(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := client.Invite.Query()
+		if kind := c.Query("kind"); kind != "" {
+			k := invite.Kind(kind)
+			if err := invite.KindValidator(k); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be admin, referral or waitlist"})
+				return
+			}
+			q = q.Where(invite.KindEQ(k))
+		}
+
+		limit := 100
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 1000 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+				return
+			}
+			limit = n
+		}
+
+		invs, err := q.Order(ent.Desc(invite.FieldCreatedAt)).Limit(limit).All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"invites": invs})
+	}
+}
+
+// JoinWaitlistRequest is the request body for JoinWaitlist
+type JoinWaitlistRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// waitlistStatus is how a waitlist entry is reported to the person waiting
+type waitlistStatus struct {
+	ID       uuid.UUID `json:"id"`
+	Position int       `json:"position"` // 0 once invited
+	Invited  bool      `json:"invited"`
+}
+
+func statusOf(c *gin.Context, client *ent.Client, entry *ent.WaitlistEntry) (*waitlistStatus, error) {
+	pos, err := Position(c.Request.Context(), client, entry)
+	if err != nil {
+		return nil, err
+	}
+	return &waitlistStatus{ID: entry.ID, Position: pos, Invited: entry.InvitedAt != nil}, nil
+}
+
+// JoinWaitlist adds an email to the waitlist and returns its position. Joining
+// again returns the existing entry. Keep the ID to look the position up later.
+func JoinWaitlist(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body JoinWaitlistRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		entry, created, err := Join(c.Request.Context(), client, body.Email)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		status, err := statusOf(c, client, entry)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		code := http.StatusOK
+		if created {
+			code = http.StatusCreated
+		}
+		c.JSON(code, status)
+	}
+}
+
+// GetWaitlistPosition reports where a waitlist entry stands
+func GetWaitlistPosition(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id format"})
+			return
+		}
+		entry, err := client.WaitlistEntry.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "waitlist entry not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		status, err := statusOf(c, client, entry)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, status)
+	}
+}
+
+// GetWaitlist summarizes the waitlist for admins
+func GetWaitlist(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		counts, err := Counts(c.Request.Context(), client)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, counts)
+	}
+}
+
+// ReleaseWaitlistRequest is the request body for ReleaseWaitlist
+type ReleaseWaitlistRequest struct {
+	Count         int `json:"count" binding:"required,min=1,max=1000"`
+	ExpiresInDays int `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+}
+
+// ReleaseWaitlist invites the longest-waiting entries and emails them their codes
+func ReleaseWaitlist(client *ent.Client, mailer mail.Mailer, appURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body ReleaseWaitlistRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		opts := ReleaseOptions{
+			Count:     body.Count,
+			ExpiresAt: expiry(body.ExpiresInDays),
+			Mailer:    mailer,
+			AppURL:    appURL,
+		}
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			opts.ReleasedBy = &id
+		}
+
+		ctx := c.Request.Context()
+		released, err := Release(ctx, client, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "released": released})
+			return
+		}
+		counts, err := Counts(ctx, client)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "released": released})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"released": released, "waitlist": counts})
+	}
+}
@@ -0,0 +1,113 @@
+// Package invites gates registration while signups are invite only. Codes are
+// minted in bulk by admins, handed out by users as referrals, or sent to people
+// released from the waitlist.
+package invites
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/invite"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCode is returned when a code does not exist, has expired, is used up
+// or is bound to another email
+var ErrInvalidCode = errors.New("invalid or expired invitation code")
+
+const (
+	codeLength = 10
+	// Upper case without easily confused characters, since codes get typed in
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// newCode returns a random invitation code
+func newCode() (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+	b := make([]byte, codeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = codeAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// normalizeCode makes codes case-insensitive and tolerant of surrounding whitespace
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Options describes invites to create
+type Options struct {
+	Kind      invite.Kind
+	CreatedBy *uuid.UUID
+	Email     string // optional; restricts redemption to this address
+	MaxUses   int    // defaults to 1
+	ExpiresAt *time.Time
+}
+
+// Create stores a new invite, retrying on the unlikely event of a code collision
+func Create(ctx context.Context, client *ent.Client, opts Options) (*ent.Invite, error) {
+	maxUses := opts.MaxUses
+	if maxUses < 1 {
+		maxUses = 1
+	}
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		var code string
+		code, err = newCode()
+		if err != nil {
+			return nil, err
+		}
+		create := client.Invite.Create().
+			SetCode(code).
+			SetKind(opts.Kind).
+			SetNillableCreatedBy(opts.CreatedBy).
+			SetMaxUses(maxUses).
+			SetNillableExpiresAt(opts.ExpiresAt)
+		if opts.Email != "" {
+			create.SetEmail(strings.ToLower(opts.Email))
+		}
+		var inv *ent.Invite
+		inv, err = create.Save(ctx)
+		if !ent.IsConstraintError(err) {
+			return inv, err
+		}
+	}
+	return nil, err
+}
+
+// Redeem uses up one redemption of code for a registration by email and
+// returns the invite. Call it in the registration transaction so a failed
+// signup doesn't consume the code.
+func Redeem(ctx context.Context, client *ent.Client, code, email string) (*ent.Invite, error) {
+	code = normalizeCode(code)
+	n, err := client.Invite.Update().
+		Where(
+			invite.CodeEQ(code),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invite.FieldUses), s.C(invite.FieldMaxUses)))
+			},
+			invite.Or(invite.ExpiresAtIsNil(), invite.ExpiresAtGT(time.Now())),
+			invite.Or(invite.EmailIsNil(), invite.EmailEQ(strings.ToLower(email))),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidCode
+	}
+	return client.Invite.Query().Where(invite.CodeEQ(code)).Only(ctx)
+}
@@ -0,0 +1,158 @@
+package invites
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/invite"
+	"streamify/ent/waitlistentry"
+	"streamify/mail"
+
+	"github.com/google/uuid"
+)
+
+// Join adds email to the waitlist, returning the existing entry if it already joined
+func Join(ctx context.Context, client *ent.Client, email string) (entry *ent.WaitlistEntry, created bool, err error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	entry, err = client.WaitlistEntry.Create().SetEmail(email).Save(ctx)
+	if ent.IsConstraintError(err) {
+		entry, err = client.WaitlistEntry.Query().Where(waitlistentry.EmailEQ(email)).Only(ctx)
+		return entry, false, err
+	}
+	return entry, err == nil, err
+}
+
+// Position returns how many entries are still waiting up to and including entry,
+// or 0 once it has been released
+func Position(ctx context.Context, client *ent.Client, entry *ent.WaitlistEntry) (int, error) {
+	if entry.InvitedAt != nil {
+		return 0, nil
+	}
+	ahead, err := client.WaitlistEntry.Query().
+		Where(
+			waitlistentry.InvitedAtIsNil(),
+			waitlistentry.Or(
+				waitlistentry.CreatedAtLT(entry.CreatedAt),
+				waitlistentry.And(waitlistentry.CreatedAtEQ(entry.CreatedAt), waitlistentry.IDLT(entry.ID)),
+			),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return ahead + 1, nil
+}
+
+// ReleaseOptions controls a batch release from the waitlist
+type ReleaseOptions struct {
+	Count     int
+	ExpiresAt *time.Time
+	// ReleasedBy is recorded as the creator of the invites
+	ReleasedBy *uuid.UUID
+	Mailer     mail.Mailer
+	// AppURL is the frontend the invitation emails link to
+	AppURL string
+}
+
+// Release invites the longest-waiting entries, up to opts.Count. Each gets a
+// single-use code bound to its email, sent once the entry is marked released.
+// A failed email is logged and doesn't undo the release; the code stays valid.
+func Release(ctx context.Context, client *ent.Client, opts ReleaseOptions) (int, error) {
+	entries, err := client.WaitlistEntry.Query().
+		Where(waitlistentry.InvitedAtIsNil()).
+		Order(waitlistentry.ByCreatedAt(), waitlistentry.ByID()).
+		Limit(opts.Count).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, entry := range entries {
+		inv, err := releaseEntry(ctx, client, entry, opts)
+		if err != nil {
+			return released, fmt.Errorf("releasing %s: %w", entry.ID, err)
+		}
+		if inv == nil {
+			continue // released concurrently
+		}
+		released++
+		if err := opts.Mailer.Send(ctx, invitationMessage(entry.Email, inv, opts.AppURL)); err != nil {
+			log.Printf("invites: failed emailing waitlist entry %s: %v", entry.ID, err)
+		}
+	}
+	return released, nil
+}
+
+// releaseEntry creates the invite for entry and marks it released in one transaction.
+// It returns nil when another release got to the entry first.
+func releaseEntry(ctx context.Context, client *ent.Client, entry *ent.WaitlistEntry, opts ReleaseOptions) (*ent.Invite, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	inv, err := Create(ctx, tx.Client(), Options{
+		Kind:      invite.KindWaitlist,
+		CreatedBy: opts.ReleasedBy,
+		Email:     entry.Email,
+		ExpiresAt: opts.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n, err := tx.WaitlistEntry.Update().
+		Where(waitlistentry.IDEQ(entry.ID), waitlistentry.InvitedAtIsNil()).
+		SetInviteID(inv.ID).
+		SetInvitedAt(time.Now()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	return inv, tx.Commit()
+}
+
+// invitationMessage is the email sent to a released waitlist entry
+func invitationMessage(email string, inv *ent.Invite, appURL string) mail.Message {
+	var b strings.Builder
+	b.WriteString("Your spot on the Streamify waitlist has come up.\n\n")
+	fmt.Fprintf(&b, "Your invitation code is %s. Create your account here:\n", inv.Code)
+	fmt.Fprintf(&b, "%s/register?invite=%s\n", strings.TrimRight(appURL, "/"), url.QueryEscape(inv.Code))
+	if inv.ExpiresAt != nil {
+		fmt.Fprintf(&b, "\nThe code expires on %s.\n", inv.ExpiresAt.UTC().Format("January 2, 2006"))
+	}
+	return mail.Message{To: []string{email}, Subject: "You're invited to Streamify", Text: b.String()}
+}
+
+// WaitlistCounts summarizes the waitlist for admins
+type WaitlistCounts struct {
+	Waiting int `json:"waiting"`
+	Invited int `json:"invited"`
+	// Registered counts released entries whose invite has been redeemed
+	Registered int `json:"registered"`
+}
+
+// Counts summarizes the waitlist
+func Counts(ctx context.Context, client *ent.Client) (*WaitlistCounts, error) {
+	var out WaitlistCounts
+	var err error
+	if out.Waiting, err = client.WaitlistEntry.Query().Where(waitlistentry.InvitedAtIsNil()).Count(ctx); err != nil {
+		return nil, err
+	}
+	if out.Invited, err = client.WaitlistEntry.Query().Where(waitlistentry.InvitedAtNotNil()).Count(ctx); err != nil {
+		return nil, err
+	}
+	out.Registered, err = client.WaitlistEntry.Query().
+		Where(waitlistentry.HasInviteWith(invite.UsesGT(0))).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"streamify/logging"
+)
+
+var logger = logging.For("jobs")
+
+// ErrUnknownJob is returned by Run for a name that was never registered
+var ErrUnknownJob = errors.New("unknown job")
+
+// Func is a unit of background work
+type Func func(ctx context.Context) error
+
+type job struct {
+	name string
+	fn   Func
+	// next returns the time of the run following now
+	next func(now time.Time) time.Time
+	// immediate runs the job once at startup before waiting for next
+	immediate bool
+}
+
+// Scheduler runs registered jobs periodically in the background
+type Scheduler struct {
+	jobs      []job
+	onFailure func(ctx context.Context, name string, err error)
+}
+
+// NewScheduler creates an empty scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers fn to run once at startup and then on every interval
+func (s *Scheduler) Every(name string, interval time.Duration, fn Func) {
+	s.jobs = append(s.jobs, job{
+		name:      name,
+		fn:        fn,
+		next:      func(now time.Time) time.Time { return now.Add(interval) },
+		immediate: true,
+	})
+}
+
+// Daily registers fn to run every day at hour:minute UTC
+func (s *Scheduler) Daily(name string, hour, minute int, fn Func) {
+	s.jobs = append(s.jobs, job{
+		name: name,
+		fn:   fn,
+		next: func(now time.Time) time.Time {
+			now = now.UTC()
+			at := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, time.UTC)
+			if !at.After(now) {
+				at = at.AddDate(0, 0, 1)
+			}
+			return at
+		},
+	})
+}
+
+// OnFailure registers fn to be called whenever a scheduled run fails
+func (s *Scheduler) OnFailure(fn func(ctx context.Context, name string, err error)) {
+	s.onFailure = fn
+}
+
+// Run executes the named job once, now, outside its schedule
+func (s *Scheduler) Run(ctx context.Context, name string) error {
+	for _, j := range s.jobs {
+		if j.name == name {
+			return j.fn(ctx)
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownJob, name)
+}
+
+// Start launches all registered jobs; they stop when ctx is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+}
+
+// loop runs a single job until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	if j.immediate {
+		s.run(ctx, j)
+	}
+
+	for {
+		timer := time.NewTimer(time.Until(j.next(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		s.run(ctx, j)
+	}
+}
+
+// run executes a job once, logging failures instead of stopping the loop
+func (s *Scheduler) run(ctx context.Context, j job) {
+	start := time.Now()
+	logger.Debug("job starting", "job", j.name)
+	if err := j.fn(ctx); err != nil {
+		logger.Error("job failed", "job", j.name, "elapsed", time.Since(start), "error", err)
+		if s.onFailure != nil && ctx.Err() == nil {
+			s.onFailure(ctx, j.name, err)
+		}
+		return
+	}
+	logger.Info("job completed", "job", j.name, "elapsed", time.Since(start))
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/like"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/events"
+	"streamify/ids"
+	"streamify/loader"
+	"streamify/tombstones"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// getLikes returns the tracks liked by the authenticated user, most recent first
+func getLikes(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		inc, err := parseIncludes(c, "track.album", "track.album.artist")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		likes, err := client.Like.Query().
+			Where(like.UserIDEQ(userID), like.HasTrackWith(track.DeletedAtIsNil())).
+			WithTrack().
+			Order(ent.Desc(like.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		tracks := make([]*ent.Track, 0, len(likes))
+		for _, l := range likes {
+			tracks = append(tracks, l.Edges.Track)
+		}
+		if err := expandTracks(c.Request.Context(), loader.For(c.Request.Context(), client), tracks, inc.under("track")); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, likes)
+	}
+}
+
+// likeTrackRequest is the request body for likeTrack
+type likeTrackRequest struct {
+	TrackID string `json:"track_id" binding:"required"`
+}
+
+// likeTrack adds a track to the authenticated user's likes
+func likeTrack(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body likeTrackRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		trackID, err := uuid.Parse(body.TrackID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track_id format"})
+			return
+		}
+
+		// Verify track exists
+		exists, err := client.Track.Query().
+			Where(track.IDEQ(trackID), track.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "track not found"})
+			return
+		}
+
+		l, err := client.Like.Create().
+			SetUserID(userID).
+			SetTrackID(trackID).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "track already liked"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		events.Emit(c.Request.Context(), events.TrackLiked{UserID: userID, TrackID: trackID})
+		c.JSON(http.StatusCreated, l)
+	}
+}
+
+// unlikeTrack removes a track from the authenticated user's likes
+func unlikeTrack(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		trackID, err := ids.Parse(c.Param("track_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track ID"})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		n, err := client.Like.Delete().
+			Where(like.UserIDEQ(userID), like.TrackIDEQ(trackID)).
+			Exec(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "like not found"})
+			return
+		}
+		if err := tombstones.Record(c.Request.Context(), client, tombstone.EntityTypeLike, &userID, trackID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		events.Emit(c.Request.Context(), events.TrackUnliked{UserID: userID, TrackID: trackID})
+		c.JSON(http.StatusOK, gin.H{"message": "like removed"})
+	}
+}
+
+// getQueue returns the authenticated user's play queue as ordered track IDs
+func getQueue(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		u, err := client.User.Get(c.Request.Context(), userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		queue := u.Queue
+		if queue == nil {
+			queue = []uuid.UUID{}
+		}
+		c.JSON(http.StatusOK, gin.H{"queue": queue})
+	}
+}
+
+// replaceQueueRequest is the request body for replaceQueue
+type replaceQueueRequest struct {
+	Queue []uuid.UUID `json:"queue" binding:"max=500"`
+}
+
+// replaceQueue replaces the authenticated user's play queue
+func replaceQueue(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body replaceQueueRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		if len(body.Queue) > 0 {
+			live, err := client.Track.Query().
+				Where(track.IDIn(body.Queue...), track.DeletedAtIsNil()).
+				Count(c.Request.Context())
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			if live != len(uniqueIDs(body.Queue)) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "queue contains unknown tracks"})
+				return
+			}
+		}
+
+		queue := body.Queue
+		if queue == nil {
+			queue = []uuid.UUID{}
+		}
+		if err := client.User.UpdateOneID(userID).SetQueue(queue).Exec(c.Request.Context()); err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"queue": queue})
+	}
+}
+
+// uniqueIDs returns ids with duplicates removed, keeping first occurrences
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,552 @@
+// Package librarysync merges library edits made offline. Clients queue the
+// likes and playlist changes they make without a connection, each stamped
+// with when it was made, and send them in one batch once back online. Likes,
+// playlist names, public flags and track membership are resolved
+// last-writer-wins against the server's own timestamps; track positions are
+// given relative to a neighbouring track rather than an index, so moves made
+// on different devices compose instead of overwriting each other.
+package librarysync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/like"
+	"streamify/ent/playlist"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/events"
+	"streamify/tombstones"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Operation types
+const (
+	OpLike        = "like"
+	OpUnlike      = "unlike"
+	OpRename      = "playlist.rename"
+	OpSetPublic   = "playlist.set_public"
+	OpAddTrack    = "playlist.add_track"
+	OpRemoveTrack = "playlist.remove_track"
+	OpMoveTrack   = "playlist.move_track"
+)
+
+// Clock keys of playlist fields
+const (
+	clockName   = "name"
+	clockPublic = "public"
+)
+
+// trackKey is the clock key of a track's membership in a playlist
+func trackKey(id uuid.UUID) string {
+	return "track:" + id.String()
+}
+
+// Op is one offline edit
+type Op struct {
+	Type       string     `json:"type" binding:"required,oneof=like unlike playlist.rename playlist.set_public playlist.add_track playlist.remove_track playlist.move_track"`
+	TrackID    *uuid.UUID `json:"track_id"`
+	PlaylistID *uuid.UUID `json:"playlist_id"`
+	Name       *string    `json:"name" binding:"omitempty,min=1,max=255"`
+	Public     *bool      `json:"public"`
+	// After places an added or moved track after this one; null puts it
+	// first. When After is no longer in the playlist the track goes last.
+	After *uuid.UUID `json:"after"`
+	// At is when the client made the edit. Times ahead of the server's clock
+	// are taken as now, so a fast device clock can't win every later merge.
+	At time.Time `json:"at" binding:"required"`
+}
+
+// MergeRequest is the request body for Merge
+type MergeRequest struct {
+	Ops []Op `json:"ops" binding:"required,max=500,dive"`
+}
+
+// Outcomes of an operation
+const (
+	// StatusApplied means the edit is part of the merged state
+	StatusApplied = "applied"
+	// StatusSuperseded means the server has a later change that wins
+	StatusSuperseded = "superseded"
+	// StatusRejected means the edit can't be applied, e.g. its track is gone
+	StatusRejected = "rejected"
+)
+
+// Result is the outcome of the operation at Index in the request
+type Result struct {
+	Index  int    `json:"index"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// LikeState is a liked track in the merged library
+type LikeState struct {
+	TrackID uuid.UUID `json:"track_id"`
+	LikedAt time.Time `json:"liked_at"`
+}
+
+// PlaylistState is one of the caller's playlists in the merged library
+type PlaylistState struct {
+	ID        uuid.UUID   `json:"id"`
+	Name      string      `json:"name"`
+	Public    bool        `json:"public"`
+	TrackIDs  []uuid.UUID `json:"track_ids"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// MergeResponse is the reconciled library the client should replace its
+// local copy with
+type MergeResponse struct {
+	Results   []Result        `json:"results"`
+	Likes     []LikeState     `json:"likes"`
+	Playlists []PlaylistState `json:"playlists"`
+}
+
+// Order returns the playlist's track IDs in order: members listed in
+// trackOrder first, then any others, such as tracks added before playlists
+// were ordered, by ID
+func Order(trackOrder, members []uuid.UUID) []uuid.UUID {
+	in := make(map[uuid.UUID]bool, len(members))
+	for _, id := range members {
+		in[id] = true
+	}
+	out := make([]uuid.UUID, 0, len(members))
+	for _, id := range trackOrder {
+		if in[id] {
+			out = append(out, id)
+			delete(in, id)
+		}
+	}
+	rest := make([]uuid.UUID, 0, len(in))
+	for id := range in {
+		rest = append(rest, id)
+	}
+	slices.SortFunc(rest, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	return append(out, rest...)
+}
+
+// place moves id to just after anchor in order (first when anchor is nil,
+// last when anchor isn't in order)
+func place(order []uuid.UUID, id uuid.UUID, anchor *uuid.UUID) []uuid.UUID {
+	order = slices.DeleteFunc(slices.Clone(order), func(x uuid.UUID) bool { return x == id })
+	if anchor == nil {
+		return slices.Insert(order, 0, id)
+	}
+	if i := slices.Index(order, *anchor); i >= 0 {
+		return slices.Insert(order, i+1, id)
+	}
+	return append(order, id)
+}
+
+// AddTrack returns p's track order and clock with trackID appended at at,
+// for handlers adding tracks outside a merge
+func AddTrack(p *ent.Playlist, members []uuid.UUID, trackID uuid.UUID, at time.Time) ([]uuid.UUID, map[string]time.Time) {
+	order := Order(p.TrackOrder, members)
+	clock := cloneClock(p.Clock)
+	clock[trackKey(trackID)] = at
+	return place(order, trackID, lastOf(order)), clock
+}
+
+func lastOf(order []uuid.UUID) *uuid.UUID {
+	if len(order) == 0 {
+		return nil
+	}
+	return &order[len(order)-1]
+}
+
+func cloneClock(c map[string]time.Time) map[string]time.Time {
+	out := make(map[string]time.Time, len(c)+1)
+	for k, v := range c {
+		out[k] = v
+	}
+	return out
+}
+
+// playlistDraft is a playlist being edited by the merge
+type playlistDraft struct {
+	p       *ent.Playlist
+	members map[uuid.UUID]bool
+	order   []uuid.UUID
+	clock   map[string]time.Time
+	added   []uuid.UUID
+	removed []uuid.UUID
+	name    string
+	public  bool
+	dirty   bool
+}
+
+// changedAt is when the clock says key last changed, or the playlist's
+// creation when it never did
+func (d *playlistDraft) changedAt(key string) time.Time {
+	if t, ok := d.clock[key]; ok {
+		return t
+	}
+	return d.p.CreatedAt
+}
+
+// merger applies one request's operations inside a transaction
+type merger struct {
+	tx     *ent.Tx
+	userID uuid.UUID
+	now    time.Time
+	drafts map[uuid.UUID]*playlistDraft
+	// impersonated sessions can't remove anything, as they can't DELETE, or
+	// change who sees a playlist, as they can't change privacy settings
+	impersonated bool
+	// emit holds events to publish once the transaction commits
+	emit []any
+}
+
+// Merge applies a batch of offline edits to the caller's likes and playlists
+// and returns the outcome of each along with the reconciled library.
+// Operations are applied in request order within one transaction. Unlikes,
+// track removals and visibility changes by impersonated sessions are
+// rejected, as the DELETE and privacy routes they stand in for are denied
+// to them.
+func Merge(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body MergeRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ctx := c.Request.Context()
+		userID, ok := viewer.UserID(ctx)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+
+		m := &merger{
+			tx:           tx,
+			userID:       userID,
+			now:          time.Now().UTC(),
+			drafts:       map[uuid.UUID]*playlistDraft{},
+			impersonated: viewer.FromContext(ctx).Impersonated(),
+		}
+		results := make([]Result, len(body.Ops))
+		for i, op := range body.Ops {
+			status, reason, err := m.apply(ctx, op)
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "index": i})
+				return
+			}
+			results[i] = Result{Index: i, Status: status, Reason: reason}
+		}
+		if err := m.flush(ctx); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		state, err := m.state(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		for _, e := range m.emit {
+			events.Emit(ctx, e)
+		}
+		state.Results = results
+		c.JSON(http.StatusOK, state)
+	}
+}
+
+// apply applies one operation, returning its status and, unless it was
+// applied, why not
+func (m *merger) apply(ctx context.Context, op Op) (string, string, error) {
+	at := op.At.UTC()
+	if at.After(m.now) {
+		at = m.now
+	}
+	if m.impersonated && (op.Type == OpUnlike || op.Type == OpRemoveTrack || op.Type == OpSetPublic) {
+		return StatusRejected, "impersonated sessions can't remove likes or playlist tracks or change who sees playlists", nil
+	}
+	switch op.Type {
+	case OpLike, OpUnlike:
+		if op.TrackID == nil {
+			return StatusRejected, "track_id is required", nil
+		}
+		if op.Type == OpLike {
+			return m.like(ctx, *op.TrackID, at)
+		}
+		return m.unlike(ctx, *op.TrackID, at)
+	}
+
+	if op.PlaylistID == nil {
+		return StatusRejected, "playlist_id is required", nil
+	}
+	d, err := m.draft(ctx, *op.PlaylistID)
+	if err != nil {
+		return "", "", err
+	}
+	if d == nil {
+		return StatusRejected, "playlist not found", nil
+	}
+
+	switch op.Type {
+	case OpRename:
+		if op.Name == nil {
+			return StatusRejected, "name is required", nil
+		}
+		if d.changedAt(clockName).After(at) {
+			return StatusSuperseded, "renamed later", nil
+		}
+		d.name, d.clock[clockName], d.dirty = *op.Name, at, true
+	case OpSetPublic:
+		if op.Public == nil {
+			return StatusRejected, "public is required", nil
+		}
+		if d.changedAt(clockPublic).After(at) {
+			return StatusSuperseded, "visibility changed later", nil
+		}
+		d.public, d.clock[clockPublic], d.dirty = *op.Public, at, true
+	case OpAddTrack, OpRemoveTrack, OpMoveTrack:
+		if op.TrackID == nil {
+			return StatusRejected, "track_id is required", nil
+		}
+		return m.editTracks(ctx, d, op, at)
+	}
+	return StatusApplied, "", nil
+}
+
+func (m *merger) editTracks(ctx context.Context, d *playlistDraft, op Op, at time.Time) (string, string, error) {
+	id := *op.TrackID
+	switch op.Type {
+	case OpAddTrack:
+		if d.changedAt(trackKey(id)).After(at) {
+			return StatusSuperseded, "removed later", nil
+		}
+		if d.members[id] {
+			// Already there, possibly added from another device: keep its position
+			return StatusApplied, "", nil
+		}
+		live, err := m.tx.Track.Query().Where(track.IDEQ(id), track.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			return "", "", err
+		}
+		if !live {
+			return StatusRejected, "track not found", nil
+		}
+		d.members[id] = true
+		d.added = append(d.added, id)
+		d.order = place(d.order, id, op.After)
+	case OpRemoveTrack:
+		if d.changedAt(trackKey(id)).After(at) {
+			return StatusSuperseded, "added later", nil
+		}
+		if d.members[id] {
+			delete(d.members, id)
+			d.removed = append(d.removed, id)
+			d.order = slices.DeleteFunc(d.order, func(x uuid.UUID) bool { return x == id })
+		}
+	case OpMoveTrack:
+		if !d.members[id] {
+			return StatusRejected, "track not in playlist", nil
+		}
+		if op.After != nil && *op.After == id {
+			return StatusRejected, "a track can't follow itself", nil
+		}
+		d.order = place(d.order, id, op.After)
+		d.dirty = true
+		return StatusApplied, "", nil
+	}
+	d.clock[trackKey(id)] = at
+	d.dirty = true
+	return StatusApplied, "", nil
+}
+
+// draft loads one of the caller's playlists for editing, or returns nil when
+// there is no such playlist
+func (m *merger) draft(ctx context.Context, id uuid.UUID) (*playlistDraft, error) {
+	if d, ok := m.drafts[id]; ok {
+		return d, nil
+	}
+	p, err := m.tx.Playlist.Query().
+		Where(playlist.IDEQ(id), playlist.OwnerIDEQ(m.userID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	members, err := p.QueryTracks().IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d := &playlistDraft{
+		p:       p,
+		members: make(map[uuid.UUID]bool, len(members)),
+		order:   Order(p.TrackOrder, members),
+		clock:   cloneClock(p.Clock),
+		name:    p.Name,
+		public:  p.Public,
+	}
+	for _, t := range members {
+		d.members[t] = true
+	}
+	m.drafts[id] = d
+	return d, nil
+}
+
+// flush saves the edited playlists
+func (m *merger) flush(ctx context.Context) error {
+	for id, d := range m.drafts {
+		if !d.dirty {
+			continue
+		}
+		err := m.tx.Playlist.UpdateOneID(id).
+			SetName(d.name).
+			SetPublic(d.public).
+			SetTrackOrder(d.order).
+			SetClock(d.clock).
+			AddTrackIDs(d.added...).
+			RemoveTrackIDs(d.removed...).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("saving playlist %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// like adds a like unless the caller removed it after at
+func (m *merger) like(ctx context.Context, trackID uuid.UUID, at time.Time) (string, string, error) {
+	exists, err := m.tx.Like.Query().
+		Where(like.UserIDEQ(m.userID), like.TrackIDEQ(trackID)).
+		Exist(ctx)
+	if err != nil || exists {
+		return StatusApplied, "", err
+	}
+	removed, err := m.lastUnlike(ctx, trackID)
+	if err != nil {
+		return "", "", err
+	}
+	if removed.After(at) {
+		return StatusSuperseded, "unliked later", nil
+	}
+	live, err := m.tx.Track.Query().Where(track.IDEQ(trackID), track.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if !live {
+		return StatusRejected, "track not found", nil
+	}
+	err = m.tx.Like.Create().
+		SetUserID(m.userID).
+		SetTrackID(trackID).
+		SetCreatedAt(at).
+		Exec(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	m.emit = append(m.emit, events.TrackLiked{UserID: m.userID, TrackID: trackID})
+	return StatusApplied, "", nil
+}
+
+// unlike removes a like unless it was made after at. The removal is
+// tombstoned as of now, not at, so that sync feed cursors stay monotonic.
+func (m *merger) unlike(ctx context.Context, trackID uuid.UUID, at time.Time) (string, string, error) {
+	l, err := m.tx.Like.Query().
+		Where(like.UserIDEQ(m.userID), like.TrackIDEQ(trackID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return StatusApplied, "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if l.CreatedAt.After(at) {
+		return StatusSuperseded, "liked again later", nil
+	}
+	if err := m.tx.Like.DeleteOne(l).Exec(ctx); err != nil {
+		return "", "", err
+	}
+	if err := tombstones.Record(ctx, m.tx.Client(), tombstone.EntityTypeLike, &m.userID, trackID); err != nil {
+		return "", "", err
+	}
+	m.emit = append(m.emit, events.TrackUnliked{UserID: m.userID, TrackID: trackID})
+	return StatusApplied, "", nil
+}
+
+// lastUnlike returns when the caller last unliked trackID, or the zero time
+// if no tombstone records it
+func (m *merger) lastUnlike(ctx context.Context, trackID uuid.UUID) (time.Time, error) {
+	t, err := m.tx.Tombstone.Query().
+		Where(
+			tombstone.EntityTypeEQ(tombstone.EntityTypeLike),
+			tombstone.OwnerIDEQ(m.userID),
+			tombstone.EntityIDEQ(trackID),
+		).
+		Order(ent.Desc(tombstone.FieldDeletedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.DeletedAt, nil
+}
+
+// state reads the caller's merged likes and playlists
+func (m *merger) state(ctx context.Context) (*MergeResponse, error) {
+	likes, err := m.tx.Like.Query().
+		Where(like.UserIDEQ(m.userID), like.HasTrackWith(track.DeletedAtIsNil())).
+		Order(ent.Desc(like.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	playlists, err := m.tx.Playlist.Query().
+		Where(playlist.OwnerIDEQ(m.userID)).
+		WithTracks(func(q *ent.TrackQuery) {
+			q.Where(track.DeletedAtIsNil()).Select(track.FieldID)
+		}).
+		Order(ent.Desc(playlist.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &MergeResponse{
+		Likes:     make([]LikeState, len(likes)),
+		Playlists: make([]PlaylistState, len(playlists)),
+	}
+	for i, l := range likes {
+		out.Likes[i] = LikeState{TrackID: l.TrackID, LikedAt: l.CreatedAt}
+	}
+	for i, p := range playlists {
+		members := make([]uuid.UUID, len(p.Edges.Tracks))
+		for j, t := range p.Edges.Tracks {
+			members[j] = t.ID
+		}
+		out.Playlists[i] = PlaylistState{
+			ID:        p.ID,
+			Name:      p.Name,
+			Public:    p.Public,
+			TrackIDs:  Order(p.TrackOrder, members),
+			UpdatedAt: p.UpdatedAt,
+		}
+	}
+	return out, nil
+}
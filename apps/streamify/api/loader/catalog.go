@@ -0,0 +1,74 @@
+package loader
+
+import (
+	"context"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ent/user"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Loaders holds one loader per entity that responses expand into
+type Loaders struct {
+	Artists *Loader[uuid.UUID, *ent.Artist]
+	Albums  *Loader[uuid.UUID, *ent.Album]
+	Tracks  *Loader[uuid.UUID, *ent.Track]
+	Users   *Loader[uuid.UUID, *ent.User]
+}
+
+// NewLoaders returns empty loaders backed by client. Soft-deleted catalog rows
+// are never loaded, so expansions treat them like missing relations.
+func NewLoaders(client *ent.Client) *Loaders {
+	return &Loaders{
+		Artists: New(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ent.Artist, error) {
+			rows, err := client.Artist.Query().Where(artist.IDIn(ids...), artist.DeletedAtIsNil()).All(ctx)
+			return byID(rows, func(a *ent.Artist) uuid.UUID { return a.ID }), err
+		}),
+		Albums: New(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ent.Album, error) {
+			rows, err := client.Album.Query().Where(album.IDIn(ids...), album.DeletedAtIsNil()).All(ctx)
+			return byID(rows, func(a *ent.Album) uuid.UUID { return a.ID }), err
+		}),
+		Tracks: New(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ent.Track, error) {
+			rows, err := client.Track.Query().Where(track.IDIn(ids...), track.DeletedAtIsNil()).All(ctx)
+			return byID(rows, func(t *ent.Track) uuid.UUID { return t.ID }), err
+		}),
+		Users: New(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*ent.User, error) {
+			rows, err := client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+			return byID(rows, func(u *ent.User) uuid.UUID { return u.ID }), err
+		}),
+	}
+}
+
+func byID[V any](rows []V, id func(V) uuid.UUID) map[uuid.UUID]V {
+	m := make(map[uuid.UUID]V, len(rows))
+	for _, r := range rows {
+		m[id(r)] = r
+	}
+	return m
+}
+
+type ctxKey struct{}
+
+// Middleware gives every request its own Loaders, reachable through For on
+// the request context
+func Middleware(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l := NewLoaders(client)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey{}, l))
+		c.Next()
+	}
+}
+
+// For returns the request's Loaders. Outside the middleware it returns fresh
+// loaders so callers never need a nil check, at the cost of per-call caching.
+func For(ctx context.Context, client *ent.Client) *Loaders {
+	if l, ok := ctx.Value(ctxKey{}).(*Loaders); ok {
+		return l
+	}
+	return NewLoaders(client)
+}
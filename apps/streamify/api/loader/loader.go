@@ -0,0 +1,194 @@
+// Package loader coalesces relation lookups made while serving one request
+// into batched IN queries, the DataLoader pattern. Resolvers call Load for a
+// single key and are grouped with every other call made within a short
+// window; expansion code that already holds all of its keys calls LoadMany.
+package loader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by Load when the batch function has no row for a key
+var ErrNotFound = errors.New("loader: not found")
+
+// BatchFunc fetches the rows for keys, returning them keyed for lookup. Keys
+// with no row are simply absent from the map.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// DefaultWait is how long Load waits for other calls to join its batch
+const DefaultWait = 2 * time.Millisecond
+
+// DefaultMaxBatch bounds the IN list of a single query, keeping it well below
+// Postgres' bind parameter limit
+const DefaultMaxBatch = 1000
+
+// result is the outcome of loading one key, shared by every caller asking for it
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	found bool
+	err   error
+}
+
+// Loader batches and caches lookups of V by K for the lifetime of one request
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	cache   map[K]*result[V]
+	pending []K
+	timer   *time.Timer
+	batches int
+}
+
+// New returns a Loader calling fetch with at most DefaultMaxBatch keys at a time
+func New[K comparable, V any](fetch BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     DefaultWait,
+		maxBatch: DefaultMaxBatch,
+		cache:    make(map[K]*result[V]),
+	}
+}
+
+// Load returns the row for key, waiting briefly so concurrent callers share
+// one query
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	r, ok := l.cache[key]
+	if !ok {
+		r = &result[V]{done: make(chan struct{})}
+		l.cache[key] = r
+		l.pending = append(l.pending, key)
+		if len(l.pending) >= l.maxBatch {
+			l.dispatchLocked(ctx)
+		} else if l.timer == nil {
+			l.timer = time.AfterFunc(l.wait, func() {
+				l.mu.Lock()
+				l.dispatchLocked(context.WithoutCancel(ctx))
+				l.mu.Unlock()
+			})
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+	if r.err != nil {
+		var zero V
+		return zero, r.err
+	}
+	if !r.found {
+		var zero V
+		return zero, ErrNotFound
+	}
+	return r.value, nil
+}
+
+// LoadMany returns the rows for keys that exist, fetching every uncached key
+// immediately in batches of at most the loader's maximum size
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) (map[K]V, error) {
+	var (
+		missing []K
+		waits   = make(map[K]*result[V], len(keys))
+	)
+	l.mu.Lock()
+	for _, k := range keys {
+		if _, seen := waits[k]; seen {
+			continue
+		}
+		r, ok := l.cache[k]
+		if !ok {
+			r = &result[V]{done: make(chan struct{})}
+			l.cache[k] = r
+			missing = append(missing, k)
+		}
+		waits[k] = r
+	}
+	l.mu.Unlock()
+
+	for start := 0; start < len(missing); start += l.maxBatch {
+		l.run(ctx, missing[start:min(start+l.maxBatch, len(missing))])
+	}
+
+	out := make(map[K]V, len(waits))
+	for k, r := range waits {
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.found {
+			out[k] = r.value
+		}
+	}
+	return out, nil
+}
+
+// Prime stores a row the caller already has, so later loads of key skip the query
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.cache[key]; ok {
+		return
+	}
+	r := &result[V]{done: make(chan struct{}), value: value, found: true}
+	close(r.done)
+	l.cache[key] = r
+}
+
+// Batches reports how many queries the loader has issued
+func (l *Loader[K, V]) Batches() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.batches
+}
+
+// dispatchLocked starts fetching the pending keys; l.mu must be held
+func (l *Loader[K, V]) dispatchLocked(ctx context.Context) {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	if len(l.pending) == 0 {
+		return
+	}
+	keys := l.pending
+	l.pending = nil
+	go l.run(ctx, keys)
+}
+
+// run fetches keys and resolves their cache entries
+func (l *Loader[K, V]) run(ctx context.Context, keys []K) {
+	l.mu.Lock()
+	l.batches++
+	l.mu.Unlock()
+
+	rows, err := l.fetch(ctx, keys)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, k := range keys {
+		r := l.cache[k]
+		if err != nil {
+			r.err = err
+			// Let a later request retry instead of caching the failure
+			delete(l.cache, k)
+		} else {
+			r.value, r.found = rows[k]
+		}
+		close(r.done)
+	}
+}
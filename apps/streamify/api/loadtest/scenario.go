@@ -0,0 +1,218 @@
+// Package loadtest generates k6 and vegeta scenarios from data in the database,
+// typically after seeding it with the "load" profile.
+package loadtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+	"text/template"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ent/user"
+	"streamify/seed"
+
+	"github.com/google/uuid"
+)
+
+// Sample holds IDs drawn from the database to build requests with
+type Sample struct {
+	ArtistIDs []uuid.UUID
+	AlbumIDs  []uuid.UUID
+	TrackIDs  []uuid.UUID
+	UserIDs   []uuid.UUID
+	Emails    []string
+}
+
+// Collect samples up to limit IDs of each kind. Users are restricted to seeded
+// accounts, whose password is seed.Password.
+func Collect(ctx context.Context, client *ent.Client, limit int) (*Sample, error) {
+	s := &Sample{}
+	var err error
+	if s.ArtistIDs, err = client.Artist.Query().Where(artist.DeletedAtIsNil()).Limit(limit).IDs(ctx); err != nil {
+		return nil, err
+	}
+	if s.AlbumIDs, err = client.Album.Query().Where(album.DeletedAtIsNil()).Limit(limit).IDs(ctx); err != nil {
+		return nil, err
+	}
+	if s.TrackIDs, err = client.Track.Query().Where(track.DeletedAtIsNil()).Limit(limit).IDs(ctx); err != nil {
+		return nil, err
+	}
+	users, err := client.User.Query().
+		Where(user.EmailHasPrefix("loadtest+")).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		s.UserIDs = append(s.UserIDs, u.ID)
+		s.Emails = append(s.Emails, u.Email)
+	}
+	if len(s.ArtistIDs) == 0 || len(s.AlbumIDs) == 0 || len(s.TrackIDs) == 0 || len(s.UserIDs) == 0 {
+		return nil, fmt.Errorf("loadtest: database has no seeded data; run `streamify seed` first")
+	}
+	return s, nil
+}
+
+// Request is one generated HTTP request
+type Request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// route is a weighted entry in the traffic mix
+type route struct {
+	weight int
+	build  func(r *rand.Rand, s *Sample) Request
+}
+
+// mix approximates production traffic on the hot paths
+var mix = []route{
+	{40, func(r *rand.Rand, s *Sample) Request {
+		return Request{Method: "GET", Path: "/api/v1/artists"}
+	}},
+	{25, func(r *rand.Rand, s *Sample) Request {
+		return Request{Method: "GET", Path: "/api/v1/artists/" + pick(r, s.ArtistIDs).String() + "/albums"}
+	}},
+	{20, func(r *rand.Rand, s *Sample) Request {
+		return Request{Method: "GET", Path: "/api/v1/albums/" + pick(r, s.AlbumIDs).String() + "/tracks"}
+	}},
+	{15, func(r *rand.Rand, s *Sample) Request {
+		return Request{Method: "POST", Path: "/api/v1/plays", Body: fmt.Sprintf(`{"track_id":%q,"territory":"US"}`, pick(r, s.TrackIDs))}
+	}},
+}
+
+func pick(r *rand.Rand, ids []uuid.UUID) uuid.UUID {
+	return ids[r.IntN(len(ids))]
+}
+
+// Requests returns n requests drawn from the traffic mix
+func Requests(s *Sample, n int, seedValue uint64) []Request {
+	r := rand.New(rand.NewPCG(seedValue, seedValue+1))
+	total := 0
+	for _, m := range mix {
+		total += m.weight
+	}
+	out := make([]Request, n)
+	for i := range out {
+		w := r.IntN(total)
+		for _, m := range mix {
+			if w < m.weight {
+				out[i] = m.build(r, s)
+				break
+			}
+			w -= m.weight
+		}
+	}
+	return out
+}
+
+// vegetaTarget is vegeta's JSON target format (vegeta attack -format=json)
+type vegetaTarget struct {
+	Method string              `json:"method"`
+	URL    string              `json:"url"`
+	Body   string              `json:"body,omitempty"`
+	Header map[string][]string `json:"header"`
+}
+
+// WriteVegeta writes reqs as vegeta JSON targets, rotating through tokens for auth
+func WriteVegeta(w io.Writer, baseURL string, reqs []Request, tokens []string) error {
+	enc := json.NewEncoder(w)
+	baseURL = strings.TrimRight(baseURL, "/")
+	for i, req := range reqs {
+		t := vegetaTarget{
+			Method: req.Method,
+			URL:    baseURL + req.Path,
+			Header: map[string][]string{"Authorization": {"Bearer " + tokens[i%len(tokens)]}},
+		}
+		if req.Body != "" {
+			t.Body = base64.StdEncoding.EncodeToString([]byte(req.Body))
+			t.Header["Content-Type"] = []string{"application/json"}
+		}
+		if err := enc.Encode(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var k6Template = template.Must(template.New("k6").Parse(`// Generated by streamify seed --scenario. Run with: k6 run {{.File}}
+import http from "k6/http";
+import { check } from "k6";
+
+const BASE_URL = __ENV.BASE_URL || {{.BaseURL}};
+const PASSWORD = {{.Password}};
+const EMAILS = {{.Emails}};
+const ARTISTS = {{.Artists}};
+const ALBUMS = {{.Albums}};
+const TRACKS = {{.Tracks}};
+
+export const options = {
+  scenarios: {
+    browse: { executor: "ramping-vus", startVUs: 1, stages: [
+      { duration: "30s", target: 50 },
+      { duration: "2m", target: 50 },
+      { duration: "30s", target: 0 },
+    ] },
+  },
+  thresholds: {
+    http_req_failed: ["rate<0.01"],
+    http_req_duration: ["p(95)<300"],
+  },
+};
+
+const pick = (xs) => xs[Math.floor(Math.random() * xs.length)];
+
+export function setup() {
+  const tokens = [];
+  for (const email of EMAILS.slice(0, 50)) {
+    const res = http.post(BASE_URL + "/api/auth/login", JSON.stringify({ email, password: PASSWORD }),
+      { headers: { "Content-Type": "application/json" } });
+    if (res.status === 200) tokens.push(res.json("access_token"));
+  }
+  return { tokens };
+}
+
+export default function (data) {
+  const params = { headers: { Authorization: "Bearer " + pick(data.tokens), "Content-Type": "application/json" } };
+  const roll = Math.random() * 100;
+  let res;
+  if (roll < 40) {
+    res = http.get(BASE_URL + "/api/v1/artists", Object.assign({ tags: { name: "list artists" } }, params));
+  } else if (roll < 65) {
+    res = http.get(BASE_URL + "/api/v1/artists/" + pick(ARTISTS) + "/albums", Object.assign({ tags: { name: "artist albums" } }, params));
+  } else if (roll < 85) {
+    res = http.get(BASE_URL + "/api/v1/albums/" + pick(ALBUMS) + "/tracks", Object.assign({ tags: { name: "album tracks" } }, params));
+  } else {
+    res = http.post(BASE_URL + "/api/v1/plays", JSON.stringify({ track_id: pick(TRACKS), territory: "US" }),
+      Object.assign({ tags: { name: "record play" } }, params));
+  }
+  check(res, { "status is 2xx": (r) => r.status >= 200 && r.status < 300 });
+}
+`))
+
+// WriteK6 writes a k6 script exercising the same traffic mix as Requests
+func WriteK6(w io.Writer, file, baseURL string, s *Sample) error {
+	js := func(v any) string {
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+	return k6Template.Execute(w, map[string]string{
+		"File":     file,
+		"BaseURL":  js(baseURL),
+		"Password": js(seed.Password),
+		"Emails":   js(s.Emails),
+		"Artists":  js(s.ArtistIDs),
+		"Albums":   js(s.AlbumIDs),
+		"Tracks":   js(s.TrackIDs),
+	})
+}
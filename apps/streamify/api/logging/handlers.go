@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"streamify/viewer"
+)
+
+var logger = For("logging")
+
+// maxTemporary caps how long a temporary level can stay on
+const maxTemporary = 24 * time.Hour
+
+// GetLevels returns the default level and every module's current level
+func GetLevels() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"default": Default().String(), "modules": Levels()})
+	}
+}
+
+// SetLevelRequest is the request body for SetLevels. Without a module it changes
+// the default level. With a module and no level, the module follows the default
+// again. Duration (e.g. "15m") makes a module's level temporary.
+type SetLevelRequest struct {
+	Module   string `json:"module" binding:"max=64"`
+	Level    string `json:"level" binding:"omitempty,oneof=debug info warn error DEBUG INFO WARN ERROR"`
+	Duration string `json:"duration"`
+}
+
+// SetLevels changes a log level at runtime
+func SetLevels() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body SetLevelRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var d time.Duration
+		if body.Duration != "" {
+			if body.Module == "" {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "duration applies to a module, not the default level"})
+				return
+			}
+			parsed, err := time.ParseDuration(body.Duration)
+			if err != nil || parsed <= 0 || parsed > maxTemporary {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "duration must be a positive duration of at most 24h, e.g. 15m"})
+				return
+			}
+			d = parsed
+		}
+
+		switch {
+		case body.Level == "" && body.Module == "":
+			c.JSON(http.StatusBadRequest, gin.H{"error": "level is required to change the default level"})
+			return
+		case body.Level == "":
+			Reset(body.Module)
+		default:
+			l, err := ParseLevel(body.Level)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			if body.Module == "" {
+				SetDefault(l)
+			} else {
+				SetLevel(body.Module, l, d)
+			}
+		}
+		logger.Info("log level changed",
+			"target", body.Module, "level", body.Level, "duration", body.Duration, "by", viewer.FromContext(c.Request.Context()).UserID)
+		c.JSON(http.StatusOK, gin.H{"default": Default().String(), "modules": Levels()})
+	}
+}
@@ -0,0 +1,214 @@
+// Package logging provides per-module loggers whose levels can be changed while
+// the server runs, so one area can be debugged without flooding the logs. A
+// module without its own level follows the default level.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	mu           sync.Mutex
+	defaultLevel = new(slog.LevelVar) // Info
+	modules      = map[string]*module{}
+	// base writes every record; levels are filtered and values masked per module before it
+	base slog.Handler = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+)
+
+// module is the level state shared by every logger of one module
+type module struct {
+	name     string
+	mu       sync.RWMutex
+	level    *slog.Level // nil follows the default level
+	revert   *time.Timer // pending revert of a temporary level
+	revertAt time.Time
+	// restoreTo is the level a temporary change reverts to
+	restoreTo *slog.Level
+}
+
+func (m *module) enabled(l slog.Level) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.level != nil {
+		return l >= *m.level
+	}
+	return l >= defaultLevel.Level()
+}
+
+func lookup(name string) *module {
+	mu.Lock()
+	defer mu.Unlock()
+	m, ok := modules[name]
+	if !ok {
+		m = &module{name: name}
+		modules[name] = m
+	}
+	return m
+}
+
+// For returns the logger for module. Loggers for the same module share a level.
+func For(name string) *slog.Logger {
+	m := lookup(name)
+	// The module name is added outside the redactor, since loggers are
+	// created during package initialization
+	return slog.New(&handler{next: redactor{next: base.WithAttrs([]slog.Attr{slog.String("module", name)})}, m: m})
+}
+
+// handler drops records below its module's current level
+type handler struct {
+	next slog.Handler
+	m    *module
+}
+
+func (h *handler) Enabled(ctx context.Context, l slog.Level) bool { return h.m.enabled(l) }
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error { return h.next.Handle(ctx, r) }
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &handler{next: h.next.WithAttrs(attrs), m: h.m}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{next: h.next.WithGroup(name), m: h.m}
+}
+
+// ParseLevel accepts debug, info, warn or error in any case
+func ParseLevel(s string) (slog.Level, error) {
+	var l slog.Level
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		l = slog.LevelDebug
+	case "info":
+		l = slog.LevelInfo
+	case "warn", "warning":
+		l = slog.LevelWarn
+	case "error":
+		l = slog.LevelError
+	default:
+		return 0, fmt.Errorf("unknown log level %q; use debug, info, warn or error", s)
+	}
+	return l, nil
+}
+
+// SetDefault sets the level of every module without its own level
+func SetDefault(l slog.Level) {
+	defaultLevel.Set(l)
+}
+
+// Default returns the default level
+func Default() slog.Level {
+	return defaultLevel.Level()
+}
+
+// SetLevel gives module its own level. When d is positive the module goes back
+// to what it was before once d has passed, so a debugging session can't be left on.
+func SetLevel(name string, l slog.Level, d time.Duration) {
+	m := lookup(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.level
+	if m.revert != nil {
+		// Changing a temporary level still reverts to the level from before it
+		m.revert.Stop()
+		previous = m.restoreTo
+	}
+	m.level = &l
+	m.revert, m.revertAt, m.restoreTo = nil, time.Time{}, nil
+	if d <= 0 {
+		return
+	}
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.revert != t {
+			return
+		}
+		m.level = m.restoreTo
+		m.revert, m.revertAt, m.restoreTo = nil, time.Time{}, nil
+	})
+	m.revert, m.revertAt, m.restoreTo = t, time.Now().Add(d), previous
+}
+
+// Reset makes module follow the default level again
+func Reset(name string) {
+	m := lookup(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.revert != nil {
+		m.revert.Stop()
+	}
+	m.level = nil
+	m.revert, m.revertAt, m.restoreTo = nil, time.Time{}, nil
+}
+
+// ModuleLevel describes one module's current level
+type ModuleLevel struct {
+	Module string     `json:"module"`
+	Level  string     `json:"level"`
+	Own    bool       `json:"own"`
+	Until  *time.Time `json:"until,omitempty"`
+}
+
+// Levels returns the level of every module that has logged or been configured, sorted by name
+func Levels() []ModuleLevel {
+	mu.Lock()
+	all := make([]*module, 0, len(modules))
+	for _, m := range modules {
+		all = append(all, m)
+	}
+	mu.Unlock()
+
+	out := make([]ModuleLevel, 0, len(all))
+	for _, m := range all {
+		m.mu.RLock()
+		ml := ModuleLevel{Module: m.name, Level: defaultLevel.Level().String()}
+		if m.level != nil {
+			ml.Level, ml.Own = m.level.String(), true
+		}
+		if !m.revertAt.IsZero() {
+			until := m.revertAt
+			ml.Until = &until
+		}
+		m.mu.RUnlock()
+		out = append(out, ml)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Module < out[j].Module })
+	return out
+}
+
+// Configure applies LOG_LEVEL-style and LOG_LEVELS-style settings: a default
+// level, and comma separated module=level pairs such as "auth=debug,jobs=warn"
+func Configure(defaultSpec, moduleSpec string) error {
+	if defaultSpec != "" {
+		l, err := ParseLevel(defaultSpec)
+		if err != nil {
+			return err
+		}
+		SetDefault(l)
+	}
+	for _, pair := range strings.Split(moduleSpec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, spec, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid module level %q; use module=level", pair)
+		}
+		l, err := ParseLevel(spec)
+		if err != nil {
+			return fmt.Errorf("module %s: %w", name, err)
+		}
+		SetLevel(strings.TrimSpace(name), l, 0)
+	}
+	return nil
+}
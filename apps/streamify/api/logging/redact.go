@@ -0,0 +1,237 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Redaction selects the personal data and credentials masked in log output.
+// Messages and attribute values are scanned, including error strings, which
+// often quote the input that caused them.
+type Redaction struct {
+	Emails bool
+	// Tokens covers bearer tokens, JWTs, API keys, credentials in query
+	// strings and the whole value of attributes named like a secret
+	Tokens bool
+	IPs    bool
+}
+
+// DefaultRedaction masks everything it knows how to find
+var DefaultRedaction = Redaction{Emails: true, Tokens: true, IPs: true}
+
+// redaction is nil until SetRedaction is called, meaning DefaultRedaction
+var redaction atomic.Pointer[Redaction]
+
+// SetRedaction changes what is masked in records logged from now on
+func SetRedaction(r Redaction) {
+	redaction.Store(&r)
+}
+
+func currentRedaction() Redaction {
+	if r := redaction.Load(); r != nil {
+		return *r
+	}
+	return DefaultRedaction
+}
+
+// RedactionFromEnv reads LOG_REDACT, a comma-separated list of emails,
+// tokens and ips, or "none". Unset means DefaultRedaction.
+func RedactionFromEnv() (Redaction, error) {
+	v := os.Getenv("LOG_REDACT")
+	if v == "" {
+		return DefaultRedaction, nil
+	}
+	var r Redaction
+	for _, name := range strings.Split(v, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "emails":
+			r.Emails = true
+		case "tokens":
+			r.Tokens = true
+		case "ips":
+			r.IPs = true
+		case "none", "":
+		default:
+			return Redaction{}, fmt.Errorf("LOG_REDACT: unknown kind %q (want emails, tokens, ips or none)", name)
+		}
+	}
+	return r, nil
+}
+
+// RedactsTokens reports whether tokens are masked, for callers holding text
+// whose secrets can't be told apart from the rest, which they then leave out
+func RedactsTokens() bool {
+	return currentRedaction().Tokens
+}
+
+func (r Redaction) enabled() bool {
+	return r.Emails || r.Tokens || r.IPs
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+
+	bearerPattern = regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`)
+	jwtPattern    = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	apiKeyPattern = regexp.MustCompile(`\bsk_[A-Za-z0-9_-]{8,}`)
+	// paramPattern finds credentials in query strings and key=value text
+	paramPattern = regexp.MustCompile(`(?i)\b((?:access_|refresh_|id_)?token|api_?key|password|secret|code|invite)=[^&\s"']+`)
+
+	// Candidates are checked with netip, so times like 12:30:45 are left alone
+	ipv4Pattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	ipv6Pattern = regexp.MustCompile(`[0-9A-Fa-f]{0,4}(?::[0-9A-Fa-f]{0,4}){2,7}(?:%[0-9A-Za-z]+)?`)
+)
+
+// secretKeys are attribute key fragments whose values are always masked
+// when tokens are
+var secretKeys = []string{"password", "secret", "token", "authorization", "cookie", "api_key", "apikey"}
+
+// Redact masks what the current Redaction selects in s
+func Redact(s string) string {
+	return currentRedaction().redact(s)
+}
+
+func (r Redaction) redact(s string) string {
+	if r.Tokens {
+		s = bearerPattern.ReplaceAllString(s, "$1 [redacted token]")
+		s = jwtPattern.ReplaceAllString(s, "[redacted token]")
+		s = apiKeyPattern.ReplaceAllString(s, "[redacted token]")
+		s = paramPattern.ReplaceAllString(s, "$1=[redacted token]")
+	}
+	if r.Emails {
+		s = emailPattern.ReplaceAllString(s, "[redacted email]")
+	}
+	if r.IPs {
+		s = ipv4Pattern.ReplaceAllStringFunc(s, redactIP)
+		if strings.Count(s, ":") >= 2 {
+			s = ipv6Pattern.ReplaceAllStringFunc(s, redactIP)
+		}
+	}
+	return s
+}
+
+func redactIP(s string) string {
+	if strings.Trim(s, ":") == "" {
+		return s
+	}
+	if _, err := netip.ParseAddr(s); err != nil {
+		return s
+	}
+	return "[redacted ip]"
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range secretKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Redaction) attr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if r.Tokens && isSecretKey(a.Key) {
+		return slog.String(a.Key, "[redacted]")
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, r.redact(a.Value.String()))
+	case slog.KindGroup:
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(r.attrs(a.Value.Group())...)}
+	case slog.KindAny:
+		var s string
+		switch v := a.Value.Any().(type) {
+		case error:
+			s = v.Error()
+		case fmt.Stringer:
+			s = v.String()
+		default:
+			s = fmt.Sprintf("%+v", v)
+		}
+		// Values without anything to mask keep their type
+		if red := r.redact(s); red != s {
+			return slog.String(a.Key, red)
+		}
+	}
+	return a
+}
+
+func (r Redaction) attrs(attrs []slog.Attr) []slog.Attr {
+	out := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		out[i] = r.attr(a)
+	}
+	return out
+}
+
+// redactor masks records before passing them to next
+type redactor struct {
+	next slog.Handler
+}
+
+func (h redactor) Enabled(ctx context.Context, l slog.Level) bool { return h.next.Enabled(ctx, l) }
+
+func (h redactor) Handle(ctx context.Context, r slog.Record) error {
+	red := currentRedaction()
+	if !red.enabled() {
+		return h.next.Handle(ctx, r)
+	}
+	out := slog.NewRecord(r.Time, r.Level, red.redact(r.Message), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(red.attr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h redactor) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return redactor{next: h.next.WithAttrs(currentRedaction().attrs(attrs))}
+}
+
+func (h redactor) WithGroup(name string) slog.Handler {
+	return redactor{next: h.next.WithGroup(name)}
+}
+
+// RedirectStdlog sends what the log package writes through the redactor, as
+// records of the "log" module
+func RedirectStdlog() {
+	slog.SetDefault(For("log"))
+}
+
+// GinLogger logs requests like gin.Logger, masking the client IP and what
+// the path and query string hold, such as ?email= or ?code=
+func GinLogger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(p gin.LogFormatterParams) string {
+		return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v\n%s",
+			p.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			p.StatusCode,
+			p.Latency,
+			Redact(p.ClientIP),
+			p.Method,
+			redactPath(p.Path),
+			Redact(p.ErrorMessage),
+		)
+	})
+}
+
+// redactPath masks a request path and query, unescaped first so that
+// escaped values such as ?email=jane%40example.com are found
+func redactPath(p string) string {
+	if u, err := url.QueryUnescape(p); err == nil {
+		if red := Redact(u); red != u {
+			return red
+		}
+	}
+	return Redact(p)
+}
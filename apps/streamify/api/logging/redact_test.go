@@ -0,0 +1,123 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"email", "no user with email jane.doe+tag@example.co.uk", "no user with email [redacted email]"},
+		{"bearer token", "Authorization: Bearer abc.def-123", "Authorization: Bearer [redacted token]"},
+		{"jwt", "bad token eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig_-1", "bad token [redacted token]"},
+		{"api key", "revoked key sk_live4f9A0b3c2d", "revoked key [redacted token]"},
+		{"query parameter", "GET /confirm?token=s3cret&next=/home", "GET /confirm?token=[redacted token]&next=/home"},
+		{"ipv4", "dial tcp 10.0.12.7:5432: connection refused", "dial tcp [redacted ip]:5432: connection refused"},
+		{"ipv6", "request from 2001:db8::8a2e:370:7334 denied", "request from [redacted ip] denied"},
+		{"loopback ipv6", "listening on ::1", "listening on [redacted ip]"},
+		{"time", "retry at 12:30:45", "retry at 12:30:45"},
+		{"version", "client 1.2.3 is outdated", "client 1.2.3 is outdated"},
+		{"uuid", "track 8f14e45f-ceea-467a-9af0-4f1c3c0a1d2e not found", "track 8f14e45f-ceea-467a-9af0-4f1c3c0a1d2e not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultRedaction.redact(tt.in); got != tt.want {
+				t.Errorf("redact(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactPath(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"/api/v1/users/exists?email=jane%40example.com", "/api/v1/users/exists?email=[redacted email]"},
+		{"/api/v1/device/confirm?code=WDJB-MJHT", "/api/v1/device/confirm?code=[redacted token]"},
+		{"/api/v1/artists?name=The%20Band", "/api/v1/artists?name=The%20Band"},
+	}
+	for _, tt := range tests {
+		if got := redactPath(tt.in); got != tt.want {
+			t.Errorf("redactPath(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRedactOnlyConfiguredKinds(t *testing.T) {
+	in := "jane@example.com from 192.168.1.20 with Bearer abc"
+	got := Redaction{Emails: true}.redact(in)
+	if want := "[redacted email] from 192.168.1.20 with Bearer abc"; got != want {
+		t.Errorf("redact = %q, want %q", got, want)
+	}
+	if got := (Redaction{}).redact(in); got != in {
+		t.Errorf("redact with nothing enabled = %q, want input unchanged", got)
+	}
+}
+
+type account struct{ Email string }
+
+func TestHandlerNeverWritesSensitiveValues(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(redactor{next: slog.NewTextHandler(&buf, nil)})
+
+	l = l.With("requested_by", "admin@example.com")
+	l.Info("password reset for jane@example.com",
+		"error", fmt.Errorf("sending mail: %w", errors.New("mailbox jane@example.com full")),
+		"client_ip", "203.0.113.9",
+		"password", "hunter2",
+		"confirmation_token", "opaque",
+		"headers", map[string]string{"Authorization": "Bearer abc123"},
+		slog.Group("request", "remote", "[2001:db8::1]:443", "account", account{Email: "jane@example.com"}),
+	)
+	out := buf.String()
+
+	for _, secret := range []string{"jane@example.com", "admin@example.com", "203.0.113.9", "2001:db8::1", "hunter2", "opaque", "abc123"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log output contains %q:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "[redacted email]") || !strings.Contains(out, "[redacted ip]") {
+		t.Errorf("log output lacks redaction markers:\n%s", out)
+	}
+}
+
+func TestHandlerKeepsUnaffectedValues(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(redactor{next: slog.NewTextHandler(&buf, nil)})
+	l.Info("chart refreshed", "plays", 42, "territory", "US")
+	out := buf.String()
+	if !strings.Contains(out, "plays=42") || !strings.Contains(out, "territory=US") {
+		t.Errorf("log output = %s, want values unchanged", out)
+	}
+}
+
+func TestRedactionFromEnv(t *testing.T) {
+	tests := []struct {
+		env     string
+		want    Redaction
+		wantErr bool
+	}{
+		{"", DefaultRedaction, false},
+		{"emails, ips", Redaction{Emails: true, IPs: true}, false},
+		{"none", Redaction{}, false},
+		{"phones", Redaction{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("LOG_REDACT", tt.env)
+			got, err := RedactionFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RedactionFromEnv() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RedactionFromEnv() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
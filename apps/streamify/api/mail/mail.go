@@ -0,0 +1,99 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"os"
+	"strings"
+
+	"streamify/logging"
+	"streamify/resilience"
+)
+
+// Message is an email to send
+type Message struct {
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	Text    string   `json:"text"`
+}
+
+// Mailer is the interface implemented by mail transports
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+var logger = logging.For("mail")
+
+// Log writes messages to the server log instead of delivering them. Bodies
+// hold reset links and invite codes that can't be reliably picked out of the
+// text, so they are only logged when tokens aren't redacted (LOG_REDACT
+// without tokens), as in development.
+type Log struct{}
+
+// Send implements Mailer
+func (Log) Send(ctx context.Context, msg Message) error {
+	attrs := []any{"to", strings.Join(msg.To, ","), "subject", msg.Subject}
+	if !logging.RedactsTokens() {
+		attrs = append(attrs, "text", msg.Text)
+	}
+	logger.Info("mail", attrs...)
+	return nil
+}
+
+// SMTP delivers messages through an SMTP relay
+type SMTP struct {
+	Addr string // host:port
+	From string
+	Auth smtp.Auth
+}
+
+// Send implements Mailer
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Text)
+	return smtp.SendMail(s.Addr, s.Auth, s.From, msg.To, []byte(b.String()))
+}
+
+// FromEnv returns an SMTP mailer when SMTP_ADDR is set and a Log mailer otherwise.
+// SMTP_FROM sets the sender; SMTP_USERNAME and SMTP_PASSWORD enable PLAIN auth.
+func FromEnv() Mailer {
+	addr := os.Getenv("SMTP_ADDR")
+	if addr == "" {
+		return Log{}
+	}
+	from := os.Getenv("SMTP_FROM")
+	if from == "" {
+		from = "no-reply@streamify.local"
+	}
+	m := &SMTP{Addr: addr, From: from}
+	if user := os.Getenv("SMTP_USERNAME"); user != "" {
+		host, _, _ := strings.Cut(addr, ":")
+		m.Auth = smtp.PlainAuth("", user, os.Getenv("SMTP_PASSWORD"), host)
+	}
+	return m
+}
+
+type resilient struct {
+	m   Mailer
+	dep *resilience.Dependency
+}
+
+// Resilient wraps m so sends go through dep's breaker and retry policy
+func Resilient(m Mailer, dep *resilience.Dependency) Mailer {
+	return &resilient{m: m, dep: dep}
+}
+
+// Send implements Mailer
+func (r *resilient) Send(ctx context.Context, msg Message) error {
+	return r.dep.Do(ctx, func(ctx context.Context) error {
+		return r.m.Send(ctx, msg)
+	})
+}
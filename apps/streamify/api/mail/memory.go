@@ -0,0 +1,60 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory records messages instead of sending them. It is meant for tests.
+type Memory struct {
+	mu   sync.Mutex
+	sent []Message
+	// Err, when set, is returned by Send and the message is not recorded
+	Err error
+}
+
+// NewMemory creates an empty in-memory mailer
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+// Send implements Mailer
+func (m *Memory) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Err != nil {
+		return m.Err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// Sent returns the messages sent so far, oldest first
+func (m *Memory) Sent() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.sent...)
+}
+
+// SentTo returns the messages addressed to addr
+func (m *Memory) SentTo(addr string) []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Message
+	for _, msg := range m.sent {
+		for _, to := range msg.To {
+			if to == addr {
+				out = append(out, msg)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// Reset forgets all sent messages
+func (m *Memory) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = nil
+}
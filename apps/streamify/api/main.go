@@ -2,110 +2,1170 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"streamify/admindata"
+	"streamify/albumtotals"
+	"streamify/analytics"
+	"streamify/anomaly"
+	"streamify/apikeys"
+	"streamify/archive"
+	"streamify/audio"
+	"streamify/audit"
 	"streamify/auth"
+	"streamify/backups"
+	"streamify/caching"
+	"streamify/captcha"
+	"streamify/catalog"
+	"streamify/changelog"
+	"streamify/changes"
+	"streamify/charts"
+	"streamify/coalesce"
+	"streamify/concurrency"
+	"streamify/config"
+	"streamify/consent"
+	"streamify/diagnostics"
+	"streamify/diagram"
+	"streamify/directory"
+	"streamify/dlq"
 	"streamify/ent"
 	"streamify/ent/album"
 	"streamify/ent/artist"
+	"streamify/ent/entitlement"
+	"streamify/ent/play"
+	"streamify/ent/predicate"
+	entprivacy "streamify/ent/privacy"
+	_ "streamify/ent/runtime"
+	"streamify/ent/schema"
+	"streamify/ent/tombstone"
+	"streamify/ent/track"
+	"streamify/ent/trackcredit"
 	"streamify/ent/user"
+	"streamify/entitlements"
+	"streamify/errtrack"
+	"streamify/events"
+	"streamify/extensions"
+	"streamify/faults"
+	"streamify/feeds"
+	"streamify/filtering"
+	"streamify/ids"
+	"streamify/images"
+	"streamify/ingest"
+	"streamify/invites"
+	"streamify/jobs"
+	"streamify/librarysync"
+	"streamify/loader"
+	"streamify/loadtest"
+	"streamify/logging"
+	"streamify/mail"
+	"streamify/migration"
+	"streamify/notfound"
+	"streamify/openapi"
+	"streamify/operations"
+	"streamify/paging"
+	"streamify/playback"
+	"streamify/privacy"
+	"streamify/promos"
+	"streamify/public"
+	"streamify/querylog"
+	"streamify/quota"
+	"streamify/realtime"
+	"streamify/referrals"
+	"streamify/reports"
+	"streamify/residency"
+	"streamify/resilience"
+	"streamify/revocation"
+	"streamify/routing"
+	"streamify/seed"
+	"streamify/sharing"
+	"streamify/social"
+	"streamify/sso"
+	"streamify/storage"
+	"streamify/support"
+	"streamify/timeouts"
+	"streamify/tombstones"
+	"streamify/viewer"
+	"streamify/webapp"
+	"streamify/wire"
 
+	"entgo.io/ent/dialect"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 )
 
+// partitionsAhead is how many months of future play partitions are kept ready
+const partitionsAhead = 3
+
 func main() {
-	client, err := ent.Open("postgres", os.Getenv("DATABASE_URL"))
+	if err := newRootCmd().Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// serve applies pending safe migrations, starts the background jobs and serves the API
+func serve(cfg *config.Config) error {
+	// Emails, tokens and IPs are masked in logs; LOG_REDACT narrows what is masked
+	redaction, err := logging.RedactionFromEnv()
+	if err != nil {
+		log.Fatalf("invalid log redaction config: %v", err)
+	}
+	logging.SetRedaction(redaction)
+	// Masked in what the log package and gin write too, not only module loggers
+	logging.RedirectStdlog()
+
+	// Slow query threshold in milliseconds (defaults to 200ms)
+	slowQueryThreshold := 200 * time.Millisecond
+	if v := os.Getenv("SLOW_QUERY_THRESHOLD_MS"); v != "" {
+		ms, err := strconv.Atoi(v)
+		if err != nil || ms < 0 {
+			log.Fatalf("SLOW_QUERY_THRESHOLD_MS must be a non-negative integer, got %q", v)
+		}
+		slowQueryThreshold = time.Duration(ms) * time.Millisecond
+	}
+	queryRecorder := querylog.NewRecorder(slowQueryThreshold, 1000)
+
+	drv, err := openFailoverDB(cfg)
 	if err != nil {
-		log.Fatalf("failed opening connection to postgres: %v", err)
+		return err
+	}
+	// Plays, likes and playlists of users pinned to a region are kept in that
+	// region's database, listed in REGION_DATABASES; everything else is home's
+	regionDrivers, err := openRegionDBs(cfg)
+	if err != nil {
+		return err
+	}
+	var clientDriver dialect.Driver = drv
+	var regions *residency.Driver
+	if len(regionDrivers) > 0 {
+		regional := make(map[string]dialect.Driver, len(regionDrivers))
+		for name, rd := range regionDrivers {
+			regional[name] = rd
+		}
+		regions = residency.NewDriver(drv, regional)
+		residency.Enable(regions)
+		clientDriver = regions
+		log.Printf("data residency regions: %s", strings.Join(regions.Regions(), ", "))
 	}
+	// FAULT_INJECTION slows down, fails or breaks the database under chosen
+	// routes in development and staging, for testing clients' retries
+	faultConfig, err := faults.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if faultConfig.InjectsDB() {
+		clientDriver = faults.NewDriver(clientDriver)
+	}
+	client := ent.NewClient(ent.Driver(querylog.NewDriver(clientDriver, queryRecorder)))
 	defer client.Close()
+	// Changes to cached entities are broadcast so every instance drops them from its caches
+	client.Use(changes.Hook(ent.TypeArtist, ent.TypeAlbum, ent.TypeTrack, ent.TypeTrackCredit, ent.TypePolicyVersion, ent.TypeSigningKey))
+	// Artists and albums lookups didn't find are remembered briefly, until created or changed
+	missing := notfound.New()
+	client.Use(missing.Hook(ent.TypeArtist, ent.TypeAlbum))
+	useCountHooks(client)
+	if regions != nil {
+		inter, hook := residency.Regional(ent.TypePlay, ent.TypePlayCount, ent.TypeLike, ent.TypePlaylist)
+		client.Intercept(inter)
+		client.Use(hook)
+	}
 
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+	// Run the auto migration tool, refusing changes that could lose data.
+	// Every region's database has the same schema as home's.
+	migrateCtx := []context.Context{context.Background()}
+	if regions != nil {
+		for _, name := range regions.Regions() {
+			migrateCtx = append(migrateCtx, residency.NewContext(context.Background(), name))
+		}
+	}
+	for _, ctx := range migrateCtx {
+		if plan, err := migration.Apply(ctx, client, false, false); err != nil {
+			if errors.Is(err, migration.ErrDestructive) {
+				printPlan(plan)
+				log.Fatal("refusing to start: pending migration is destructive; run `streamify migrate --allow-destructive`")
+			}
+			log.Fatalf("failed creating schema resources: %v", err)
+		}
 	}
 
 	// Initialize auth
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET environment variable is required")
+	if err := initJWT(); err != nil {
+		return err
 	}
-	auth.InitJWT(jwtSecret)
 
 	// Initialize auth config (24 hours access token, 168 hours refresh token)
 	auth.InitAuthConfig(24, 168)
 
-	// Setup Gin router
-	r := gin.Default()
+	// Tokens are signed with keys kept in the database, seeded from the JWT
+	// secrets, so admins can rotate and revoke keys without a redeploy
+	signingKeys, err := auth.LoadSigningKeys(context.Background(), client)
+	if err != nil {
+		log.Fatalf("failed loading signing keys: %v", err)
+	}
 
-	// Health check endpoint
-	r.GET("/health", func(c *gin.Context) {
-		c.Status(http.StatusOK)
-	})
+	// While INVITE_ONLY is set, registration requires an invitation code
+	if v := os.Getenv("INVITE_ONLY"); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Fatalf("invalid INVITE_ONLY %q: %v", v, err)
+		}
+		auth.SetInviteOnly(enabled)
+		if enabled {
+			log.Println("registration is invite only")
+		}
+	}
+
+	// Each referral earns its referrer REFERRAL_REWARD_DAYS of premium
+	if err := referrals.FromEnv(); err != nil {
+		log.Fatalf("invalid referral config: %v", err)
+	}
+
+	// Initialize object storage (defaults to ./data)
+	storageDir := os.Getenv("STORAGE_DIR")
+	if storageDir == "" {
+		storageDir = "data"
+	}
+	localStore, err := storage.NewLocal(storageDir)
+	if err != nil {
+		log.Fatalf("failed initializing storage: %v", err)
+	}
+
+	// External integrations go through circuit breakers with bounded retries.
+	// Storage reads are streamed after the call returns, so attempts aren't time-boxed.
+	dependencies := resilience.NewRegistry()
+	dependencies.Attach("database", drv)
+	for name, rd := range regionDrivers {
+		dependencies.Attach("database-"+name, rd)
+	}
+
+	// LISTEN holds a session, so behind a transaction pooler change
+	// notifications are received over a direct DATABASE_LISTEN_URL
+	changeListener := changes.NewListener()
+	listenDSN := os.Getenv("DATABASE_LISTEN_URL")
+	if listenDSN == "" && !cfg.PoolerCompat {
+		listenDSN = cfg.DSN
+	}
+	if listenDSN != "" {
+		if err := changeListener.Start(listenDSN); err != nil {
+			log.Fatalf("failed listening for changes: %v", err)
+		}
+		defer changeListener.Close()
+		dependencies.Attach("change-notifications", changeListener)
+	} else {
+		log.Println("DATABASE_LISTEN_URL not set: changes made on other instances reach caches only when entries expire")
+	}
+	storagePolicy := resilience.DefaultPolicy
+	storagePolicy.AttemptTimeout = 0
+	storagePolicy.Permanent = storage.IsNotFound
+	store := storage.Resilient(localStore, dependencies.Register("storage", storagePolicy))
+
+	backupManager := backups.NewManager(client, store, cfg.DSN)
+	// Long admin tasks run as operations the caller polls instead of blocking the request
+	ops := operations.NewManager(client)
+	// Playback heartbeats are buffered and written in batches
+	heartbeats := playback.NewAggregator(client)
+	// Plays are written behind the request in batches every PLAY_BUFFER_INTERVAL
+	// when set. A crash loses up to that much unless PLAY_BUFFER_WAL_DIR keeps
+	// them in a local write-ahead log first. PLAY_BUFFER_MAX_PENDING caps the backlog.
+	var playBuffer *ingest.Buffer
+	if v := os.Getenv("PLAY_BUFFER_INTERVAL"); v != "" {
+		// Buffered plays are written without the user's region
+		if regions != nil {
+			log.Fatal("PLAY_BUFFER_INTERVAL can't be used with REGION_DATABASES")
+		}
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			log.Fatalf("PLAY_BUFFER_INTERVAL must be a positive duration, got %q", v)
+		}
+		playConfig := ingest.Config{Interval: interval, WALDir: os.Getenv("PLAY_BUFFER_WAL_DIR")}
+		if v := os.Getenv("PLAY_BUFFER_MAX_PENDING"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				log.Fatalf("PLAY_BUFFER_MAX_PENDING must be a positive integer, got %q", v)
+			}
+			playConfig.MaxPending = n
+		}
+		if playBuffer, err = ingest.New(context.Background(), client, playConfig); err != nil {
+			log.Fatalf("failed starting play buffer: %v", err)
+		}
+		defer playBuffer.Close()
+		dependencies.Attach("play-ingest", playBuffer)
+	}
+
+	// Uploaded audio is fingerprinted with Chromaprint's fpcalc (FPCALC_PATH or PATH) to catch duplicates
+	fingerprinter, err := audio.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid audio fingerprinting config: %v", err)
+	}
+	if fingerprinter == nil {
+		log.Println("fpcalc not found: uploads are only checked for byte-identical duplicates")
+	}
+	// Uploads are checked by content against per-tier codec, size and bitrate limits (AUDIO_UPLOAD_POLICIES overrides them)
+	uploadPolicies := audio.DefaultPolicies()
+	if v := os.Getenv("AUDIO_UPLOAD_POLICIES"); v != "" {
+		if uploadPolicies, err = audio.ParsePolicies(v, uploadPolicies); err != nil {
+			log.Fatalf("invalid AUDIO_UPLOAD_POLICIES: %v", err)
+		}
+	}
+	audioUploader := audio.NewUploader(client, store, fingerprinter, uploadPolicies)
+	// Large files can also be uploaded resumably over tus, in parts kept in storage until the last arrives
+	uploadSessions := audio.NewSessions(client, store, audioUploader)
+
+	// Artwork is served resized from GET /img/:id, with renditions cached in storage
+	artwork := images.New(store)
+
+	// Background work that fails (job runs, event publishes, emails) is kept for admins to replay
+	deadLetters := dlq.New(client)
+	deadLetters.Events()
+
+	// Events are also pushed to connected clients, through Redis pub/sub
+	// (REDIS_URL) so clients of every replica get them
+	var broker realtime.Broker = realtime.NewMemory()
+	if v := os.Getenv("REDIS_URL"); v != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		redisBroker, err := realtime.NewRedis(ctx, v)
+		cancel()
+		if err != nil {
+			log.Fatalf("failed connecting to Redis: %v", err)
+		}
+		broker = redisBroker
+	}
+	hub := realtime.NewHub(broker)
+	defer hub.Close()
+	dependencies.Attach("realtime", hub)
+	var publisher events.Publisher = events.Log{}
+
+	// Plays by users who opted out of analytics are published under daily
+	// pseudonyms derived from ANALYTICS_PSEUDONYM_KEY, which instances share
+	if v := os.Getenv("ANALYTICS_PSEUDONYM_KEY"); v != "" {
+		privacy.SetPseudonymKey([]byte(v))
+	} else {
+		log.Println("ANALYTICS_PSEUDONYM_KEY not set: pseudonyms differ between instances and restarts")
+	}
+
+	// With ANALYTICS_CLICKHOUSE_URL set, plays are mirrored to ClickHouse and
+	// charts and listening stats are computed there
+	var clickhouse *analytics.ClickHouse
+	var analyticsSink *analytics.Sink
+	if v := os.Getenv("ANALYTICS_CLICKHOUSE_URL"); v != "" {
+		if clickhouse, err = analytics.NewClickHouse(v, dependencies.Register("clickhouse", resilience.DefaultPolicy)); err != nil {
+			log.Fatalf("invalid ANALYTICS_CLICKHOUSE_URL: %v", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err := clickhouse.EnsureSchema(ctx)
+		cancel()
+		if err != nil {
+			log.Fatalf("failed preparing analytics store: %v", err)
+		}
+		analyticsSink = analytics.NewSink(clickhouse, client)
+		dependencies.Attach("analytics", analyticsSink)
+		publisher = analytics.Publisher(publisher, analyticsSink)
+	}
+
+	// Optional modules compiled in with build tags, narrowed by EXTENSIONS
+	enabledExtensions, err := extensions.FromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	extensionHost, err := extensions.Load(client, store, enabledExtensions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if names := extensionHost.Names(); len(names) > 0 {
+		log.Printf("extensions enabled: %s", strings.Join(names, ", "))
+	}
+	events.SetPublisher(extensionHost.Publisher(realtime.Publisher(publisher, hub)))
+	mailer := deadLetters.Mailer(mail.Resilient(mail.FromEnv(), dependencies.Register("mail", resilience.DefaultPolicy)))
+
+	// Bot challenges on public signup and password reset (CAPTCHA_PROVIDER, CAPTCHA_SECRET)
+	captchaVerifier, err := captcha.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid captcha config: %v", err)
+	}
+	if captchaVerifier != nil {
+		captchaPolicy := resilience.DefaultPolicy
+		captchaPolicy.Permanent = captcha.IsRejected
+		captchaVerifier = captcha.Resilient(captchaVerifier, dependencies.Register("captcha", captchaPolicy))
+		log.Printf("captcha verification enabled (%s)", os.Getenv("CAPTCHA_PROVIDER"))
+	}
+
+	// Share links are served from the API and redirect visitors to the frontend
+	shareConfig := sharing.Config{
+		BaseURL: os.Getenv("SHARE_BASE_URL"),
+		AppURL:  os.Getenv("APP_URL"),
+	}
+	if shareConfig.BaseURL == "" {
+		shareConfig.BaseURL = fmt.Sprintf("http://localhost:%d", cfg.Port)
+	}
+	if shareConfig.AppURL == "" {
+		shareConfig.AppURL = "http://localhost:5173"
+	}
+	shareCards := sharing.NewRenderer(store, artwork)
+
+	// Sitemap and release feeds for crawlers link to the same public URLs
+	catalogFeeds := feeds.NewBuilder(client, store, feeds.Config{BaseURL: shareConfig.BaseURL, AppURL: shareConfig.AppURL})
+
+	// Start background jobs
+	scheduler := jobs.NewScheduler()
+	deadLetters.Jobs(scheduler)
+	extensionHost.Jobs(scheduler)
+	scheduler.Every("monthly-reports", 24*time.Hour, reports.NewGenerator(client, store).GeneratePreviousMonth)
+	scheduler.Daily("nightly-backup", 3, 0, backupManager.Scheduled)
+	scheduler.Every("guest-state-cleanup", time.Hour, auth.PurgeExpiredGuestState(client))
+	scheduler.Every("confirmation-cleanup", time.Hour, auth.PurgeExpiredConfirmations(client))
+	scheduler.Every("device-authorization-cleanup", time.Hour, auth.PurgeExpiredDeviceAuthorizations(client))
+	scheduler.Every("upload-cleanup", time.Hour, uploadSessions.Cleanup)
+	scheduler.Every("operation-reaper", time.Minute, operations.Reap(client))
+	scheduler.Every("playback-heartbeats", playback.FlushInterval, heartbeats.Flush)
+	scheduler.Every("database-primary-check", 30*time.Second, drv.Check)
+	for name, rd := range regionDrivers {
+		scheduler.Every("database-primary-check-"+name, 30*time.Second, rd.Check)
+	}
+	// Picks up key changes when change notifications aren't received
+	scheduler.Every("signing-key-reload", time.Minute, signingKeys.Reload)
+	scheduler.Every("catalog-feeds", time.Hour, catalogFeeds.Build)
+	// Fixes album totals that drifted from their tracks
+	scheduler.Every("album-totals", 24*time.Hour, albumtotals.Reconcile(client))
+	// Deletions are kept as tombstones for the sync feed for TOMBSTONE_RETENTION (default 30 days)
+	tombstoneRetention := tombstones.DefaultRetention
+	if v := os.Getenv("TOMBSTONE_RETENTION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("TOMBSTONE_RETENTION must be a positive duration, got %q", v)
+		}
+		tombstoneRetention = d
+	}
+	scheduler.Daily("tombstone-purge", 3, 30, tombstones.Purge(client, tombstoneRetention))
+	apiKeyMeter := apikeys.NewMeter(client)
+	// Monthly API key quotas are counted in Redis (REDIS_URL) so every instance shares them
+	quotaCounter, err := quota.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid quota config: %v", err)
+	}
+	quotaCounter = quota.Resilient(quotaCounter, dependencies.Register("redis", resilience.DefaultPolicy))
+	// Revoked token IDs share the quota counter's Redis
+	revocations, err := revocation.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid revocation config: %v", err)
+	}
+	auth.SetRevocations(revocation.Resilient(revocations, dependencies.Register("redis", resilience.DefaultPolicy)))
+	// Sign-ins, refreshes and playlist deletions are checked for signs of a
+	// taken-over account; bursts are counted with the quota counter
+	anomalyConfig, err := anomaly.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid anomaly detection config: %v", err)
+	}
+	if anomalyConfig.CountryHeader == "" {
+		log.Println("GEOIP_COUNTRY_HEADER not set: sign-ins from new countries aren't flagged")
+	}
+	anomaly.SetDetector(anomaly.New(client, quotaCounter, anomalyConfig))
+	// An unauthenticated read-only subset of the catalog API is served when PUBLIC_API lists groups
+	publicConfig, err := public.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid public API config: %v", err)
+	}
+	// Personal top lists cover the periods in TOP_PERIODS
+	topPeriods, err := charts.PeriodsFromEnv()
+	if err != nil {
+		log.Fatalf("invalid top list config: %v", err)
+	}
+	// User lookups and the signup email check are rate limited with the quota counter
+	directoryConfig, err := directory.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid user directory config: %v", err)
+	}
+	scheduler.Every("api-key-usage-flush", time.Minute, apiKeyMeter.Flush)
+	scheduler.Daily("api-key-usage-purge", 3, 15, apikeys.PurgeUsage(client, apikeys.UsageRetention))
+	scheduler.Every("chart-refresh", 15*time.Minute, migration.RefreshMaterializedViews(client))
+	if analyticsSink != nil {
+		scheduler.Every("analytics-sink", analytics.FlushInterval, analyticsSink.Flush)
+	}
+
+	// Play history partitions are created ahead of time and, when PLAY_RETENTION_MONTHS
+	// is set, archived to storage once they fall out of the retention window
+	playRetention := 0
+	if v := os.Getenv("PLAY_RETENTION_MONTHS"); v != "" {
+		months, err := strconv.Atoi(v)
+		if err != nil || months < 1 {
+			log.Fatalf("PLAY_RETENTION_MONTHS must be a positive integer, got %q", v)
+		}
+		playRetention = months
+	}
+	if partitioned, err := migration.PlaysPartitioned(context.Background(), client); err != nil {
+		log.Fatalf("failed inspecting plays table: %v", err)
+	} else if partitioned {
+		ensurePartitions := migration.EnsurePlayPartitions(client, partitionsAhead)
+		if regions != nil {
+			ensurePartitions = regions.Each(ensurePartitions)
+		}
+		scheduler.Daily("play-partitions", 2, 0, ensurePartitions)
+		// Archives are named by month, so only the home region's plays are archived
+		if playRetention > 0 {
+			scheduler.Daily("play-archive", 2, 30, archive.Plays(client, store, playRetention))
+		}
+	} else if playRetention > 0 {
+		log.Println("PLAY_RETENTION_MONTHS ignored: plays is not partitioned; run `streamify migrate partition-plays`")
+	}
 
-	// Auth routes (public)
-	authGroup := r.Group("/api/auth")
-	{
-		authGroup.POST("/login", auth.Login(client))
-		authGroup.POST("/register", auth.Register(client))
-		authGroup.POST("/refresh", auth.Refresh(client))
+	// Audit entries stay in the database for AUDIT_RETENTION_DAYS (default 90), then move to storage
+	auditRetention := 90
+	if v := os.Getenv("AUDIT_RETENTION_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 1 {
+			log.Fatalf("AUDIT_RETENTION_DAYS must be a positive integer, got %q", v)
+		}
+		auditRetention = days
 	}
+	scheduler.Daily("audit-archive", 2, 45, archive.AuditLogs(client, store, auditRetention))
+	scheduler.Start(context.Background())
+
+	ssoService := sso.NewService(client, sso.Config{BaseURL: shareConfig.BaseURL, AppURL: shareConfig.AppURL})
+	supportDesk := support.NewService(client, store, mailer, shareConfig.AppURL)
+	consentChecker := consent.NewChecker(client)
+	appearsOn := catalog.NewAppearsOnCache(client)
+	changeListener.On(ent.TypePolicyVersion, func([]uuid.UUID) { consentChecker.Invalidate() })
+	changeListener.On(ent.TypeSigningKey, func([]uuid.UUID) {
+		if err := signingKeys.Reload(context.Background()); err != nil {
+			log.Printf("reloading signing keys failed: %v", err)
+		}
+	})
+	// Appearances include other artists' names and releases, so any catalog change drops them all
+	for _, typ := range []string{ent.TypeArtist, ent.TypeAlbum, ent.TypeTrack, ent.TypeTrackCredit} {
+		changeListener.On(typ, func([]uuid.UUID) { appearsOn.Invalidate() })
+	}
+	missing.Listen(changeListener, ent.TypeArtist, ent.TypeAlbum)
+	data := admindata.New(client, dataEntities...)
+	spec := openapi.NewDocument("Streamify API", "1.0.0")
+
+	// Every route, with who may call it and its rate-limit class. The auth
+	// levels' middleware is set up with the router below.
+	reg := routing.New()
+	reg.Add([]routing.Route{
+		// Health check endpoint
+		{Method: "GET", Path: "/health", Auth: routing.Public, Handler: resilience.Health(dependencies), Description: "Health check with dependency circuit breaker states"},
+		{Method: "GET", Path: "/metrics", Auth: routing.Public, Handler: resilience.Metrics(dependencies), Description: "Dependency breaker and retry metrics (Prometheus text format)"},
+
+		// Auth routes (public)
+		{Method: "POST", Path: "/api/auth/login", Auth: routing.Public, Handler: auth.Login(client), Description: "Sign in with email and password for an access and a refresh token"},
+		{Method: "POST", Path: "/api/auth/register", Auth: routing.Public, Middleware: []gin.HandlerFunc{captcha.Require(captchaVerifier)}, Handler: auth.Register(client), Description: "Create an account, redeeming an invite code when sign-ups are invite-only"},
+		{Method: "POST", Path: "/api/auth/refresh", Auth: routing.Public, Handler: auth.Refresh(client), Description: "Exchange a refresh token for a new access and refresh token"},
+		{Method: "POST", Path: "/api/auth/logout", Auth: routing.Public, Middleware: []gin.HandlerFunc{auth.AuthMiddleware(client)}, Handler: auth.Logout(), Description: "Revoke the access token the request was made with and, when given, the caller's refresh token (user token)"},
+		{Method: "POST", Path: "/api/auth/forgot-password", Auth: routing.Public, Middleware: []gin.HandlerFunc{captcha.Require(captchaVerifier)}, Handler: auth.ForgotPassword(client, mailer, shareConfig.AppURL), Description: "Email a password reset link; answers the same whether or not the email is registered"},
+		{Method: "POST", Path: "/api/auth/reset-password", Auth: routing.Public, Handler: auth.ResetPassword(client), Description: "Set a new password with the token from a reset link"},
+		{Method: "POST", Path: "/api/auth/waitlist", Auth: routing.Public, Middleware: []gin.HandlerFunc{captcha.Require(captchaVerifier)}, Handler: invites.JoinWaitlist(client), Description: "Join the waitlist and get the entry's position"},
+		{Method: "GET", Path: "/api/auth/waitlist/:id", Auth: routing.Public, Handler: invites.GetWaitlistPosition(client), Description: "Get where a waitlist entry stands"},
+		{Method: "POST", Path: "/api/auth/guest", Auth: routing.Public, Handler: auth.Guest(), Description: "Get a guest token for logged-out browsing"},
+		{Method: "POST", Path: "/api/auth/device/code", Auth: routing.Public, Handler: auth.DeviceCode(client, shareConfig.AppURL), Description: "Start the sign-in of a device without a keyboard, getting a device code and a user code to show"},
+		{Method: "POST", Path: "/api/auth/device/token", Auth: routing.Public, Handler: auth.DeviceToken(client), Description: "Poll with a device code for tokens once the user approved it (RFC 8628 errors while pending)"},
+		{Method: "GET", Path: "/api/auth/sso/:tenant/login", Auth: routing.Public, Handler: sso.Login(ssoService), Description: "Send the browser to sign in at the tenant's OpenID Connect provider"},
+		{Method: "GET", Path: "/api/auth/sso/:tenant/callback", Auth: routing.Public, Handler: sso.Callback(ssoService), Description: "Finish a tenant sign-in, redirecting to the app with tokens or an error code"},
 
-	// Protected routes - apply auth middleware to entire /api/v1/* group
-	api := r.Group("/api/v1")
-	api.Use(auth.AuthMiddleware()) // Apply auth middleware to all v1 routes
-	{
-		api.GET("/me", auth.Me(client))
+		// SCIM provisioning, authenticated by each tenant's own bearer token
+		{Method: "GET", Path: "/scim/v2/ServiceProviderConfig", Auth: routing.SCIM, Handler: sso.ServiceProviderConfig(), Description: "Describe which parts of SCIM are supported"},
+		{Method: "GET", Path: "/scim/v2/Users", Auth: routing.SCIM, Handler: sso.ListSCIMUsers(ssoService), Description: "List the tenant's users, optionally filtered by userName or externalId"},
+		{Method: "POST", Path: "/scim/v2/Users", Auth: routing.SCIM, Handler: sso.CreateSCIMUser(ssoService), Description: "Provision an account for one of the tenant's people"},
+		{Method: "GET", Path: "/scim/v2/Users/:id", Auth: routing.SCIM, Handler: sso.GetSCIMUser(ssoService), Description: "Get one of the tenant's users"},
+		{Method: "PUT", Path: "/scim/v2/Users/:id", Auth: routing.SCIM, Handler: sso.ReplaceSCIMUser(ssoService), Description: "Replace a user's attributes"},
+		{Method: "PATCH", Path: "/scim/v2/Users/:id", Auth: routing.SCIM, Handler: sso.PatchSCIMUser(ssoService), Description: "Change some of a user's attributes; setting active to false deprovisions them"},
+		{Method: "DELETE", Path: "/scim/v2/Users/:id", Auth: routing.SCIM, Handler: sso.DeleteSCIMUser(ssoService), Description: "Deprovision a user, keeping their data"},
+		{Method: "GET", Path: "/scim/v2/Groups", Auth: routing.SCIM, Handler: sso.ListSCIMGroups(ssoService), Description: "List the tenant's groups, optionally filtered by displayName or externalId"},
+		{Method: "POST", Path: "/scim/v2/Groups", Auth: routing.SCIM, Handler: sso.CreateSCIMGroup(ssoService), Description: "Add a group, giving its members the role it maps to"},
+		{Method: "GET", Path: "/scim/v2/Groups/:id", Auth: routing.SCIM, Handler: sso.GetSCIMGroup(ssoService), Description: "Get one of the tenant's groups"},
+		{Method: "PUT", Path: "/scim/v2/Groups/:id", Auth: routing.SCIM, Handler: sso.ReplaceSCIMGroup(ssoService), Description: "Replace a group's name and members"},
+		{Method: "PATCH", Path: "/scim/v2/Groups/:id", Auth: routing.SCIM, Handler: sso.PatchSCIMGroup(ssoService), Description: "Add or remove a group's members, or rename it"},
+		{Method: "DELETE", Path: "/scim/v2/Groups/:id", Auth: routing.SCIM, Handler: sso.DeleteSCIMGroup(ssoService), Description: "Remove a group; its members lose the role it gave them"},
+
+		// Signed-in endpoints
+		{Method: "GET", Path: "/api/v1/me", Auth: routing.User, Handler: auth.Me(client), Description: "Get the current user"},
+		{Method: "POST", Path: "/api/v1/me/confirm", Auth: routing.User, Handler: auth.Confirm(client), Description: "Re-enter the password to get a single-use confirmation token for a password or email change"},
+		{Method: "POST", Path: "/api/v1/device/confirm", Auth: routing.User, Handler: auth.ConfirmDevice(client), Description: "Approve or deny the sign-in of a TV or console showing a user code"},
+		{Method: "PUT", Path: "/api/v1/me/password", Auth: routing.User, Handler: auth.ChangePassword(client), Description: "Change the current user's password (requires X-Confirmation-Token)"},
+		{Method: "PUT", Path: "/api/v1/me/email", Auth: routing.User, Handler: auth.ChangeEmail(client), Description: "Change the current user's email (requires X-Confirmation-Token)"},
+		{Method: "GET", Path: "/api/v1/me/consent", Auth: routing.User, Handler: consent.GetConsent(consentChecker), Description: "List the policy versions the current user accepted and any pending ones"},
+		{Method: "POST", Path: "/api/v1/me/consent", Auth: routing.User, Handler: consent.AcceptPolicies(consentChecker), Description: "Accept the current terms of service or privacy policy versions"},
+		{Method: "GET", Path: "/api/v1/me/entitlements", Auth: routing.User, Handler: entitlements.Mine(client), Description: "List the current user's active entitlements (premium or single features)"},
+		{Method: "GET", Path: "/api/v1/me/preferences", Auth: routing.User, Handler: getPreferences(client), Description: "Get the current user's preferences"},
+		{Method: "PATCH", Path: "/api/v1/me/preferences", Auth: routing.User, Handler: updatePreferences(client), Description: "Update the current user's preferences (JSON merge patch)"},
+		{Method: "GET", Path: "/api/v1/me/privacy", Auth: routing.User, Handler: privacy.GetSettings(client), Description: "Get the current user's privacy settings"},
+		{Method: "PATCH", Path: "/api/v1/me/privacy", Auth: routing.User, Handler: privacy.UpdateSettings(client), Description: "Update the current user's privacy settings"},
+		{Method: "GET", Path: "/api/v1/me/blocks", Auth: routing.User, Handler: social.ListBlocks(client), Description: "List users blocked by the current user"},
+		{Method: "GET", Path: "/api/v1/me/likes", Auth: routing.User, Handler: getLikes(client), Description: "List the current user's liked tracks (?include=track.album,track.album.artist)"},
+		{Method: "POST", Path: "/api/v1/me/likes", Auth: routing.User, Handler: likeTrack(client), Description: "Like a track"},
+		{Method: "DELETE", Path: "/api/v1/me/likes/:track_id", Auth: routing.User, Handler: unlikeTrack(client), Description: "Remove a track from likes"},
+		{Method: "GET", Path: "/api/v1/me/top/tracks", Auth: routing.User, Handler: charts.MyTopTracks(client, topPeriods), Description: "The current user's most played tracks (?period=month&limit=50; periods are set by TOP_PERIODS)"},
+		{Method: "GET", Path: "/api/v1/me/top/artists", Auth: routing.User, Handler: charts.MyTopArtists(client, topPeriods), Description: "The current user's most played artists (?period=month&limit=50; periods are set by TOP_PERIODS)"},
+		{Method: "GET", Path: "/api/v1/me/queue", Auth: routing.User, Handler: getQueue(client), Description: "Get the current user's play queue"},
+		{Method: "PUT", Path: "/api/v1/me/queue", Auth: routing.User, Handler: replaceQueue(client), Description: "Replace the current user's play queue"},
+		{Method: "GET", Path: "/api/v1/sync/tombstones", Auth: routing.User, Handler: tombstones.Feed(client, tombstoneRetention), Description: "Page through catalog and own-library deletions after ?cursor= (omit it to get the current position); 410 once the cursor is past the retention window"},
+		{Method: "POST", Path: "/api/v1/sync/merge", Auth: routing.User, Handler: librarysync.Merge(client), Description: "Apply offline like and playlist edits, resolving conflicts last-writer-wins, and return the reconciled library"},
+
+		{Method: "GET", Path: "/api/v1/operations/:id", Auth: routing.User, Handler: operations.Get(client), Description: "Get a long-running operation you started with its progress and results so far"},
+		{Method: "POST", Path: "/api/v1/operations/:id/cancel", Auth: routing.User, Handler: operations.CancelOperation(ops), Description: "Cancel a running operation; work already committed is kept"},
+		{Method: "GET", Path: "/api/v1/realtime", Auth: routing.User, Class: routing.Stream, Handler: realtime.Stream(hub), Description: "Stream your events and public announcements as server-sent events (?channels=me,public)"},
 
 		// User endpoints
-		api.GET("/users", getUsers(client))
-		api.GET("/users/:id", getUserByID(client))
-		api.POST("/users", createUser(client))
-		api.DELETE("/users/:id", deleteUser(client))
+		{Method: "GET", Path: "/api/v1/users", Auth: routing.User, Handler: getUsers(client), Description: "List users a page at a time with ?limit=&offset=, filtered and sorted by first_name or last_name, and by email, role or deactivated for admins (public profiles unless admin)"},
+		{Method: "GET", Path: "/api/v1/users/:id", Auth: routing.User, Handler: getUserByID(client), Description: "Get user by ID"},
+		{Method: "POST", Path: "/api/v1/users/lookup", Auth: routing.User, Handler: directory.Lookup(client, quotaCounter, directoryConfig), Description: "Look up to 100 users by email or ID, returning names only; rate limited per emails and IDs asked about"},
+		{Method: "HEAD", Path: "/api/v1/users/exists", Auth: routing.Public, Handler: directory.Exists(client, quotaCounter, directoryConfig), Description: "Check whether ?email= has an account: 200 if so, 404 if not; rate limited per client IP"},
+		{Method: "GET", Path: "/api/v1/users/:id/plays", Auth: routing.User, Handler: getUserPlays(client), Description: "Get a user's recent listening activity (respects privacy settings; ?include=track.album,track.album.artist)"},
+		{Method: "GET", Path: "/api/v1/users/:id/playlists", Auth: routing.User, Handler: getUserPlaylists(client), Description: "Get a user's playlists visible to the caller"},
+		{Method: "GET", Path: "/api/v1/users/:id/followers", Auth: routing.User, Handler: social.ListFollowers(client), Description: "Get a user's followers (respects privacy settings and blocks)"},
+		{Method: "POST", Path: "/api/v1/users/:id/follow", Auth: routing.User, Handler: social.FollowUser(client), Description: "Follow a user"},
+		{Method: "DELETE", Path: "/api/v1/users/:id/follow", Auth: routing.User, Handler: social.UnfollowUser(client), Description: "Unfollow a user"},
+		{Method: "POST", Path: "/api/v1/users/:id/block", Auth: routing.User, Handler: social.BlockUser(client), Description: "Block a user"},
+		{Method: "DELETE", Path: "/api/v1/users/:id/block", Auth: routing.User, Handler: social.UnblockUser(client), Description: "Unblock a user"},
+		{Method: "POST", Path: "/api/v1/users", Auth: routing.User, Handler: createUser(client), Description: "Create a new user"},
+		{Method: "DELETE", Path: "/api/v1/users/:id", Auth: routing.User, Handler: deleteUser(client), Description: "Delete user by ID"},
 
 		// Artist endpoints
-		api.GET("/artists", getArtists(client))
-		api.GET("/artists/:id", getArtistByID(client))
-		api.POST("/artists", createArtist(client))
-		api.GET("/artists/:id/albums", getArtistAlbums(client))
+		{Method: "GET", Path: "/api/v1/artists", Auth: routing.User, Handler: getArtists(client), Description: "List artists by name with their albums, a page at a time with ?limit=&offset=, filtered and sorted by name or created"},
+		{Method: "GET", Path: "/api/v1/artists/:id", Auth: routing.User, Handler: getArtistByID(client, missing), Description: "Get artist by ID"},
+		{Method: "POST", Path: "/api/v1/artists", Auth: routing.User, Handler: createArtist(client, artwork), Description: "Create a new artist"},
+		{Method: "PUT", Path: "/api/v1/artists/:id/artwork", Auth: routing.User, Handler: setArtistArtwork(client, artwork), Description: "Replace an artist's image with the uploaded image (raw request body) and store its color palette (admin)"},
+		{Method: "GET", Path: "/api/v1/artists/:id/albums", Auth: routing.User, Handler: getArtistAlbums(client), Description: "List an artist's albums a page at a time with ?limit=&offset=, filtered and sorted by title, label, album_type, released or created (?include=artist)"},
+		{Method: "GET", Path: "/api/v1/artists/:id/discography", Auth: routing.User, Handler: getArtistDiscography(client), Description: "Get an artist's albums, singles, EPs, compilations and appears-on releases, grouped by release year"},
+		{Method: "GET", Path: "/api/v1/artists/:id/appears-on", Auth: routing.User, Handler: getArtistAppearsOn(appearsOn), Description: "Get the compilations and other artists' releases an artist is credited on, with the credited tracks (cached up to 10 minutes)"},
+		{Method: "DELETE", Path: "/api/v1/artists/:id", Auth: routing.User, Handler: deleteArtist(client), Description: "Delete artist by ID (policy=restrict|cascade, hard=true)"},
+		{Method: "GET", Path: "/api/v1/artists/:id/delete-preview", Auth: routing.User, Handler: previewArtistDeletion(client), Description: "Dry run showing what deleting an artist would affect"},
+		{Method: "GET", Path: "/api/v1/artists/:id/stats", Auth: routing.User, Handler: charts.GetArtistStats(client, clickhouse), Description: "Get listening stats for an artist (refreshed every 15 minutes, or within seconds from an analytics store)"},
 
 		// Album endpoints
-		api.GET("/albums/:id", getAlbumByID(client))
-		api.POST("/albums", createAlbum(client))
-		api.GET("/albums/:id/tracks", getAlbumTracks(client))
+		{Method: "GET", Path: "/api/v1/albums/:id", Auth: routing.User, Handler: getAlbumByID(client, missing), Description: "Get album by ID"},
+		{Method: "POST", Path: "/api/v1/albums", Auth: routing.User, Handler: createAlbum(client, artwork), Description: "Create a new album"},
+		{Method: "PUT", Path: "/api/v1/albums/:id/artwork", Auth: routing.User, Handler: setAlbumArtwork(client, artwork), Description: "Replace an album's cover with the uploaded image (raw request body) and store its color palette (admin)"},
+		{Method: "GET", Path: "/api/v1/albums/:id/tracks", Auth: routing.User, Handler: getAlbumTracks(client), Description: "Get an album with its tracks a page at a time under tracks, with ?limit=&offset=, filtered and sorted by title, disc_number, track_number, version_type or created"},
+		{Method: "PUT", Path: "/api/v1/albums/:id/tracklist", Auth: routing.User, Handler: setAlbumTracklist(client), Description: "Reorder an album's tracks and assign discs; the list must name every track on the album (admin)"},
+		{Method: "GET", Path: "/api/v1/albums/:id/download", Auth: routing.User, Class: routing.Download, Middleware: []gin.HandlerFunc{entitlements.Require(client, entitlement.FeatureDownloads)}, Handler: audio.DownloadAlbum(client, store), Description: "Download an album's audio as a ZIP with tags from the catalog (requires premium or downloads)"},
 
 		// Track endpoints
-		api.POST("/tracks", createTrack(client))
+		{Method: "POST", Path: "/api/v1/tracks", Auth: routing.User, Handler: createTrack(client, appearsOn), Description: "Create a new track"},
+		{Method: "GET", Path: "/api/v1/tracks/:id/stats", Auth: routing.User, Handler: charts.GetTrackStats(client, clickhouse), Description: "Get listening stats for a track (refreshed every 15 minutes, or within seconds from an analytics store)"},
+		{Method: "GET", Path: "/api/v1/tracks/:id/versions", Auth: routing.User, Handler: getTrackVersions(client), Description: "Get the original recording of a track with its remasters, live versions and remixes"},
+		{Method: "PUT", Path: "/api/v1/tracks/:id/audio", Auth: routing.User, Handler: audio.UploadAudio(audioUploader), Description: "Upload a track's audio file (MP3, AAC, FLAC, Ogg Vorbis/Opus or M4A, checked by content against the API key tier's codec, size and bitrate limits) as the raw request body; duplicates of other tracks are rejected, near-matches are queued for review (admin)"},
+
+		{Method: "POST", Path: "/api/v1/images", Auth: routing.User, Handler: images.UploadImage(artwork), Description: "Upload artwork (JPEG, PNG or GIF, raw request body) and get the URL to use as an artist's or album's image_url, with its dominant colors (admin)"},
+
+		// Resumable upload endpoints (tus 1.0.0)
+		{Method: "OPTIONS", Path: "/api/v1/uploads", Auth: routing.User, Handler: uploadSessions.Options, Description: "Describe the server's tus resumable upload support"},
+		{Method: "POST", Path: "/api/v1/uploads", Auth: routing.User, Handler: uploadSessions.Create, Description: "Open a resumable (tus) upload of a track's audio; Upload-Length gives the size and Upload-Metadata the track_id (admin)"},
+		{Method: "HEAD", Path: "/api/v1/uploads/:id", Auth: routing.User, Handler: uploadSessions.Head, Description: "Get how many bytes of a resumable upload were received (Upload-Offset)"},
+		{Method: "PATCH", Path: "/api/v1/uploads/:id", Auth: routing.User, Handler: uploadSessions.Patch, Description: "Append a part to a resumable upload at Upload-Offset; the last part stores the audio like PUT /tracks/:id/audio"},
+		{Method: "DELETE", Path: "/api/v1/uploads/:id", Auth: routing.User, Handler: uploadSessions.Terminate, Description: "Abandon a resumable upload and delete its parts"},
+
+		// Chart endpoints, served from materialized play aggregates
+		{Method: "GET", Path: "/api/v1/charts/tracks", Auth: routing.User, Handler: charts.TopTracksChart(client, clickhouse), Description: "Most played tracks (?days=7&territory=US&limit=50)"},
+		{Method: "GET", Path: "/api/v1/charts/artists", Auth: routing.User, Handler: charts.TopArtistsChart(client, clickhouse), Description: "Most played artists (?days=7&territory=US&limit=50)"},
+
+		// Play endpoints
+		{Method: "POST", Path: "/api/v1/plays", Auth: routing.User, Handler: createPlay(client, playBuffer), Description: "Record a play of a track (202 when plays are written behind in batches)"},
+		{Method: "POST", Path: "/api/v1/plays/:id/heartbeat", Auth: routing.User, Handler: playback.Heartbeat(heartbeats), Description: "Report how far one of your plays has got (position_ms); saved within a few seconds"},
+
+		// Playlist endpoints
+		{Method: "POST", Path: "/api/v1/playlists", Auth: routing.User, Handler: createPlaylist(client), Description: "Create a playlist"},
+		{Method: "GET", Path: "/api/v1/playlists/:id", Auth: routing.User, Handler: getPlaylistByID(client), Description: "Get a playlist with its tracks (?include=tracks.album,tracks.album.artist)"},
+		{Method: "POST", Path: "/api/v1/playlists/:id/tracks", Auth: routing.User, Handler: addPlaylistTrack(client), Description: "Add a track to a playlist"},
+		{Method: "DELETE", Path: "/api/v1/playlists/:id", Auth: routing.User, Handler: deletePlaylist(client), Description: "Delete one of your playlists"},
+
+		// Developer portal: API keys for integrations and their usage
+		{Method: "GET", Path: "/api/v1/developer/keys", Auth: routing.User, Handler: apikeys.List(client), Description: "List the current user's API keys"},
+		{Method: "POST", Path: "/api/v1/developer/keys", Auth: routing.User, Handler: apikeys.CreateKey(client), Description: "Create an API key (the key is only shown in this response; send it in X-API-Key)"},
+		{Method: "DELETE", Path: "/api/v1/developer/keys/:id", Auth: routing.User, Handler: apikeys.RevokeKey(client), Description: "Revoke an API key"},
+		{Method: "GET", Path: "/api/v1/developer/keys/:id/usage", Auth: routing.User, Handler: apikeys.Usage(client), Description: "Get an API key's requests, rate-limit hits and error rates over time (?interval=hour|day&from=&to=)"},
+
+		// Referral invites
+		{Method: "GET", Path: "/api/v1/me/invites", Auth: routing.User, Handler: invites.ListReferrals(client), Description: "List the current user's referral invite codes"},
+		{Method: "POST", Path: "/api/v1/me/invites", Auth: routing.User, Handler: invites.CreateReferral(client), Description: "Create a single-use referral invite code (up to 5 per user)"},
+		{Method: "GET", Path: "/api/v1/me/referrals", Auth: routing.User, Handler: referrals.Mine(client, shareConfig.AppURL), Description: "Get the current user's referral links, who signed up with them and the premium days earned"},
+
+		{Method: "POST", Path: "/api/v1/support/tickets", Auth: routing.User, Handler: support.OpenTicket(supportDesk), Description: "Ask support for help with a subject, body and category (account, billing, playback, catalog, bug or other)"},
+		{Method: "GET", Path: "/api/v1/support/tickets", Auth: routing.User, Handler: support.MyTickets(supportDesk), Description: "List the current user's support tickets, newest first, a page at a time with ?limit=&offset=, filtered and sorted by subject, category, status, created or updated"},
+		{Method: "GET", Path: "/api/v1/support/tickets/:id", Auth: routing.User, Handler: support.GetTicket(supportDesk), Description: "Get a support ticket with its replies and attachments (the user who filed it or an admin)"},
+		{Method: "POST", Path: "/api/v1/support/tickets/:id/replies", Auth: routing.User, Handler: support.Reply(supportDesk), Description: "Reply to one of the current user's support tickets, reopening it"},
+		{Method: "POST", Path: "/api/v1/support/tickets/:id/attachments", Auth: routing.User, Handler: support.AddAttachment(supportDesk), Description: "Attach a file (PNG, JPEG, GIF or WebP image, PDF or plain text up to 10 MB, raw request body) named by ?filename= to a support ticket"},
+		{Method: "GET", Path: "/api/v1/support/tickets/:id/attachments/:attachment_id", Auth: routing.User, Handler: support.GetAttachment(supportDesk), Description: "Download a support ticket's attachment (the user who filed it or an admin)"},
+		{Method: "GET", Path: "/api/v1/changelog", Auth: routing.User, Handler: changelog.GetFeed(client), Description: "List the published changelog, newest first, a page at a time, marking the entries new to the current user and counting them"},
+		{Method: "POST", Path: "/api/v1/changelog/seen", Auth: routing.User, Handler: changelog.MarkSeen(client), Description: "Mark every published changelog entry as seen by the current user"},
+		{Method: "GET", Path: "/api/v1/me/redemptions", Auth: routing.User, Handler: promos.MyRedemptions(client), Description: "List the promo codes the current user redeemed and what each granted"},
+		{Method: "POST", Path: "/api/v1/me/redemptions", Auth: routing.User, Handler: promos.RedeemCode(client), Description: "Redeem a promo code for a discount, free months of premium or a feature"},
+
+		// Share endpoints
+		{Method: "POST", Path: "/api/v1/share", Auth: routing.User, Handler: sharing.CreateLink(client, shareConfig), Description: "Create a share link for a track, album or playlist"},
+
+		// Admin endpoints
+		{Method: "GET", Path: "/api/v1/admin/reports", Auth: routing.Admin, Handler: reports.ListReports(store), Description: "List monthly usage reports (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/reports/:month/:file", Auth: routing.Admin, Handler: reports.DownloadReport(store), Description: "Download a monthly usage report (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/schema/diff", Auth: routing.Admin, Handler: getSchemaDiff(client), Description: "List the tables, columns and indexes where the live database drifted from the Ent schema (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/integrity", Auth: routing.Admin, Handler: getIntegrityReport(client), Description: "Scan for orphaned rows (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/integrity/fix", Auth: routing.Admin, Handler: fixIntegrity(client, ops), Description: "Start an operation repairing or purging orphaned rows in batches (admin)"},
+
+		{Method: "POST", Path: "/api/v1/admin/albums/bulk-archive", Auth: routing.Admin, Handler: bulkDeleteAlbums(client, ops, false), Description: "Soft-delete albums by ID list or filter; dry_run returns the selection and the confirmation_token the real run must send, which starts a batched operation (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/albums/bulk-delete", Auth: routing.Admin, Handler: bulkDeleteAlbums(client, ops, true), Description: "Permanently delete albums with their tracks and plays by ID list or filter; dry_run returns the confirmation_token, the real run starts a batched operation (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/backups", Auth: routing.Admin, Handler: backups.ListBackups(client), Description: "List database backups (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/backups", Auth: routing.Admin, Handler: backups.CreateBackup(backupManager), Description: "Start a database backup (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/backups/:id", Auth: routing.Admin, Handler: backups.GetBackup(client), Description: "Get backup by ID (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/backups/:id/verify", Auth: routing.Admin, Handler: backups.VerifyBackup(backupManager), Description: "Verify a backup archive (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/backups/:id/restore", Auth: routing.Admin, Handler: backups.RestoreBackup(backupManager), Description: "Restore the database from a backup (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/slow-queries", Auth: routing.Admin, Handler: querylog.SlowQueries(queryRecorder), Description: "Get the slowest recent database queries (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/debug/*path", Auth: routing.Admin, Handler: diagnostics.Serve(), Description: "Go pprof profiles (debug/pprof/...), goroutine dumps and runtime metrics (debug/runtime) of the serving instance (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/diagnostics/captures", Auth: routing.Admin, Handler: diagnostics.StartCapture(store, ops), Description: "Capture a CPU, heap, goroutine or other profile of the serving instance to object storage as an operation (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/diagnostics/captures", Auth: routing.Admin, Handler: diagnostics.ListCaptures(store), Description: "List captured profiles, optionally of one day (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/diagnostics/captures/:day/:file", Auth: routing.Admin, Handler: diagnostics.DownloadCapture(store), Description: "Download a captured profile (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/exports/tracks", Auth: routing.Admin, Class: routing.Bulk, Handler: exportTracks(client), Description: "Stream every track as a JSON array (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/exports/plays", Auth: routing.Admin, Class: routing.Bulk, Handler: exportPlays(client), Description: "Stream play history as a JSON array, optionally since a timestamp (admin)"},
+
+		{Method: "POST", Path: "/api/v1/admin/users/:id/impersonate", Auth: routing.Admin, Handler: auth.Impersonate(client), Description: "Mint a 15-minute impersonation token for a user, with a reason (admin, audited)"},
+		{Method: "POST", Path: "/api/v1/admin/users/:id/entitlements", Auth: routing.Admin, Handler: entitlements.Grant(client), Description: "Grant a user premium or a single feature, optionally until expires_at (admin)"},
+		{Method: "PUT", Path: "/api/v1/admin/users/:id/region", Auth: routing.Admin, Handler: residency.PinUser(client), Description: "Pin a user's plays, likes and playlists to a region's database (admin)"},
+		{Method: "DELETE", Path: "/api/v1/admin/entitlements/:id", Auth: routing.Admin, Handler: entitlements.Revoke(client), Description: "End an entitlement immediately (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/policies", Auth: routing.Admin, Handler: consent.ListPolicies(client), Description: "List published policy versions with acceptance counts (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/policies", Auth: routing.Admin, Handler: consent.PublishPolicy(consentChecker), Description: "Publish a new terms of service or privacy policy version that users must accept (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/invites", Auth: routing.Admin, Handler: invites.ListInvites(client), Description: "List invite codes, filterable by kind (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/invites", Auth: routing.Admin, Handler: invites.CreateInvites(client), Description: "Mint a batch of invite codes with optional use limit, expiry or bound email (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/waitlist", Auth: routing.Admin, Handler: invites.GetWaitlist(client), Description: "Count waiting, invited and registered waitlist entries (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/waitlist/release", Auth: routing.Admin, Handler: invites.ReleaseWaitlist(client, mailer, shareConfig.AppURL), Description: "Invite the longest-waiting waitlist entries and email their codes (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/promo-codes", Auth: routing.Admin, Handler: promos.ListCodes(client), Description: "List promo codes, filterable by kind (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/promo-codes", Auth: routing.Admin, Handler: promos.CreateCodes(client), Description: "Mint a chosen promo code or a batch of random ones with optional expiry and redemption limits (admin)"},
+		{Method: "DELETE", Path: "/api/v1/admin/promo-codes/:id", Auth: routing.Admin, Handler: promos.ExpireCode(client), Description: "Expire a promo code so it can't be redeemed again (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/promo-codes/:id/redemptions", Auth: routing.Admin, Handler: promos.ListRedemptions(client), Description: "List a promo code's redemptions and the entitlements they granted (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/changelog", Auth: routing.Admin, Handler: changelog.ListEntries(client), Description: "List changelog entries, drafts and scheduled ones included, a page at a time, filtered and sorted by version, title, published or created (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/changelog", Auth: routing.Admin, Handler: changelog.CreateEntry(client), Description: "Write a changelog entry for a release, published now, later or kept as a draft (admin)"},
+		{Method: "PATCH", Path: "/api/v1/admin/changelog/:id", Auth: routing.Admin, Handler: changelog.UpdateEntry(client), Description: "Edit, publish or unpublish a changelog entry (admin)"},
+		{Method: "DELETE", Path: "/api/v1/admin/changelog/:id", Auth: routing.Admin, Handler: changelog.DeleteEntry(client), Description: "Delete a changelog entry (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/support/tickets", Auth: routing.Admin, Handler: support.ListTickets(supportDesk), Description: "List support tickets, longest waiting first, a page at a time, filtered and sorted by subject, category, status, created or updated (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/support/tickets/:id/replies", Auth: routing.Admin, Handler: support.StaffReply(supportDesk), Description: "Answer a support ticket as support, leaving it pending on the user and emailing them (admin)"},
+		{Method: "PUT", Path: "/api/v1/admin/support/tickets/:id/status", Auth: routing.Admin, Handler: support.SetStatus(supportDesk), Description: "Set a support ticket's status; closed tickets take no more replies or files (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/signing-keys", Auth: routing.Admin, Handler: auth.ListSigningKeys(signingKeys), Description: "List JWT signing keys and their status (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/signing-keys", Auth: routing.Admin, Handler: auth.AddSigningKey(signingKeys), Description: "Generate a signing key that signs all new tokens (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/signing-keys/:id/retire", Auth: routing.Admin, Handler: auth.RetireSigningKey(signingKeys), Description: "Retire a signing key; its tokens stay valid for grace_period, or are revoked at once with 0s (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/tokens/revoke", Auth: routing.Admin, Handler: auth.RevokeToken(), Description: "Revoke an access or refresh token before it expires; tokens issued without a jti can't be (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/sso-providers", Auth: routing.Admin, Handler: sso.ListProviders(client), Description: "List enterprise tenants' OpenID Connect providers (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/sso-providers", Auth: routing.Admin, Handler: sso.CreateProvider(ssoService), Description: "Set up a tenant's OpenID Connect provider, with just-in-time provisioning and group-to-role mapping (admin)"},
+		{Method: "PATCH", Path: "/api/v1/admin/sso-providers/:id", Auth: routing.Admin, Handler: sso.UpdateProvider(ssoService), Description: "Update a tenant's OpenID Connect provider (admin)"},
+		{Method: "DELETE", Path: "/api/v1/admin/sso-providers/:id", Auth: routing.Admin, Handler: sso.DeleteProvider(client), Description: "Remove a tenant's OpenID Connect provider; its users keep their accounts (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/sso-providers/:id/scim-token", Auth: routing.Admin, Handler: sso.RotateSCIMToken(client), Description: "Issue a SCIM bearer token for a tenant's identity provider to provision users and groups at /scim/v2; replaces the previous token (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/log-levels", Auth: routing.Admin, Handler: logging.GetLevels(), Description: "Get the default log level and each module's level (admin)"},
+		{Method: "PUT", Path: "/api/v1/admin/log-levels", Auth: routing.Admin, Handler: logging.SetLevels(), Description: "Change the default or one module's log level, optionally for a limited time (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/dead-letters", Auth: routing.Admin, Handler: dlq.List(deadLetters), Description: "List failed job runs, event publishes and emails with their errors, filterable by kind (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/dead-letters/:id", Auth: routing.Admin, Handler: dlq.Get(deadLetters), Description: "Get a failed item with its payload (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/dead-letters/replay", Auth: routing.Admin, Handler: dlq.Replay(deadLetters), Description: "Run selected failed items again; successful ones are removed (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/dead-letters/purge", Auth: routing.Admin, Handler: dlq.Purge(deadLetters), Description: "Delete failed items by id, kind or age (admin)"},
+
+		{Method: "PATCH", Path: "/api/v1/admin/api-keys/:id", Auth: routing.Admin, Handler: apikeys.UpdateLimits(client), Description: "Change an API key's tier or override its monthly quota and overage behavior (admin)"},
+
+		// Generic CRUD over the entities in dataEntities
+		{Method: "GET", Path: "/api/v1/admin/data", Auth: routing.Admin, Handler: data.Entities, Description: "List the entities served by the data API, the actions allowed on each and their fields (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/data/:entity", Auth: routing.Admin, Handler: data.List, Description: "List an entity's rows, filtered by its fields, sorted by ?sort= and ?order= and paged by ?limit= and ?offset= (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/data/:entity", Auth: routing.Admin, Handler: data.Create, Description: "Create a row of an entity from its fields (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/data/:entity/:id", Auth: routing.Admin, Handler: data.Get, Description: "Get a row of an entity (admin)"},
+		{Method: "PATCH", Path: "/api/v1/admin/data/:entity/:id", Auth: routing.Admin, Handler: data.Update, Description: "Update the given fields of a row, clearing optional ones sent as null (admin)"},
+		{Method: "DELETE", Path: "/api/v1/admin/data/:entity/:id", Auth: routing.Admin, Handler: data.Delete, Description: "Delete a row of an entity (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/duplicates", Auth: routing.Admin, Handler: audio.ListReviews(client), Description: "List uploads flagged as possible duplicates (?status=pending|duplicate|dismissed) (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/duplicates/:id/resolve", Auth: routing.Admin, Handler: audio.ResolveReview(client), Description: "Mark a flagged upload as a duplicate (removing the track) or dismiss it (admin)"},
+
+		{Method: "GET", Path: "/api/v1/admin/audit", Auth: routing.Admin, Handler: audit.ListLogs(client), Description: "List recent audit entries for admin actions (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/security-alerts", Auth: routing.Admin, Handler: anomaly.ListAlerts(client), Description: "List alerts raised for suspicious account activity, newest first, with ?status=open|resolved, ?user_id= and ?rule= (admin)"},
+		{Method: "POST", Path: "/api/v1/admin/security-alerts/:id/resolve", Auth: routing.Admin, Handler: anomaly.ResolveAlert(client), Description: "Mark a security alert as reviewed (admin)"},
+		{Method: "GET", Path: "/api/v1/admin/audit/archive", Auth: routing.Admin, Class: routing.Bulk, Handler: archive.QueryAuditLogs(store), Description: "Search archived audit entries by date range (admin)"},
+
+		// User endpoints (non-versioned)
+		{Method: "POST", Path: "/api/users", Auth: routing.Public, Handler: createUserWithBody(client), Description: "Create a new user (non-versioned)"},
+		{Method: "GET", Path: "/api/schema", Auth: routing.Public, Handler: getSchema(), Description: "Get database schema, documenting each field with its comment, enum values, validation rules and an example value for generating forms"},
+		{Method: "GET", Path: "/api/schema/diagram", Auth: routing.Public, Handler: diagram.Serve(), Description: "Entity relationship diagram of the database as Mermaid, or with ?format=dot or ?format=svg as Graphviz DOT or SVG"},
+		{Method: "GET", Path: "/api/policies", Auth: routing.Public, Handler: consent.CurrentPolicies(consentChecker), Description: "Get the current terms of service and privacy policy versions"},
+		{Method: "GET", Path: "/api/events/schemas", Auth: routing.Public, Handler: events.Schemas(), Description: "Get the versioned JSON schemas of every published domain event"},
+		{Method: "GET", Path: "/api/routes", Auth: routing.Public, Handler: reg.Serve(), Description: "Get all API routes"},
+		{Method: "GET", Path: "/api/openapi.json", Auth: routing.Public, Handler: openapi.Serve(spec), Description: "Get the generated OpenAPI document"},
+
+		// Preview endpoints (guest or user tokens)
+		{Method: "GET", Path: "/api/v1/preview/tracks/:id", Auth: routing.Guest, Scopes: []string{"tracks:preview"}, Handler: getTrackPreview(client), Description: "Get a 30-second track preview (guest or user token)"},
+		{Method: "GET", Path: "/api/v1/preview/playlists/:id", Auth: routing.Guest, Scopes: []string{"playlists:read"}, Handler: getPlaylistPreview(client), Description: "Get a public playlist with track previews (guest or user token)"},
+
+		// Guest session endpoints (guest tokens only)
+		{Method: "PUT", Path: "/api/v1/guest/state", Auth: routing.Guest, Scopes: []string{"state:sync"}, Handler: auth.SyncGuestState(client), Description: "Sync a guest session's queue and likes and get a claim token for registration"},
+
+		// Share link resolution (public, rendered as HTML for unfurls)
+		{Method: "GET", Path: "/s/:token", Auth: routing.Public, Handler: sharing.Resolve(client, shareConfig), Description: "Resolve a share link (Open Graph page that redirects to the app)"},
+		{Method: "GET", Path: "/s/:token/image.png", Auth: routing.Public, Handler: sharing.CardImage(client, shareCards), Description: "Get a share link's 1200x630 preview image (artwork, title and artist)"},
+		{Method: "GET", Path: "/oembed", Auth: routing.Public, Handler: sharing.OEmbed(client, shareConfig), Description: "Describe a share link ?url= as an oEmbed link with its preview image as thumbnail"},
+		{Method: "GET", Path: "/img/:id", Auth: routing.Public, Handler: images.ServeImage(artwork), Description: "Get artwork resized to ?w=&h= with ?fit=contain|cover|fill; sizes are rounded up to cached steps and never upscaled"},
+
+		// Sitemap and new release feeds (public, rebuilt hourly)
+		{Method: "GET", Path: "/sitemap.xml", Auth: routing.Public, Handler: feeds.Serve(catalogFeeds, feeds.SitemapName), Description: "Get the sitemap index of public artist and album pages"},
+		{Method: "GET", Path: "/sitemaps/:part", Auth: routing.Public, Handler: feeds.ServeSitemapPart(catalogFeeds), Description: "Get a part of the sitemap, up to 50,000 URLs each"},
+		{Method: "GET", Path: "/feeds/releases.rss", Auth: routing.Public, Handler: feeds.Serve(catalogFeeds, feeds.RSSName), Description: "Get the newest releases as an RSS 2.0 feed"},
+		{Method: "GET", Path: "/feeds/releases.atom", Auth: routing.Public, Handler: feeds.Serve(catalogFeeds, feeds.AtomName), Description: "Get the newest releases as an Atom feed"},
+	}...)
+	// Public read-only catalog (no authentication, stricter per-IP limits)
+	reg.Add(publicRoutes(client, publicConfig, missing)...)
+	reg.Add(extensionHost.Routes()...)
+	// The frontend, when the binary was built with it (-tags webapp)
+	if webapp.Files != nil {
+		reg.Add(
+			routing.Route{Method: "GET", Path: "/", Auth: routing.Public, Handler: webapp.Redirect(), Description: "Redirect to the web app"},
+			routing.Route{Method: "GET", Path: webapp.Prefix + "/*path", Auth: routing.Public, Handler: webapp.Serve(webapp.Files), Description: "Serve the web app; paths that aren't files get its index.html"},
+		)
 	}
 
-	// User endpoints (non-versioned)
-	apiNonVersioned := r.Group("/api")
-	{
-		apiNonVersioned.POST("/users", createUserWithBody(client))
-		apiNonVersioned.GET("/schema", getSchema(client))
-		apiNonVersioned.GET("/routes", getRoutes(r))
+	// Setup Gin router
+	// Request timeouts (defaults to 10s; admin operations that stream or restore get longer)
+	timeoutConfig := timeouts.Config{
+		Default: 10 * time.Second,
+		Routes: map[string]time.Duration{
+			"GET /api/v1/admin/reports/:month/:file": 2 * time.Minute,
+			"POST /api/v1/admin/backups/:id/verify":  10 * time.Minute,
+			"POST /api/v1/admin/backups/:id/restore": 0,
+			"GET /api/v1/admin/exports/tracks":       0,
+			"GET /api/v1/admin/exports/plays":        0,
+			"GET /api/v1/admin/audit/archive":        time.Minute,
+			"GET /api/v1/admin/debug/*path":          0,
+			"POST /api/v1/admin/waitlist/release":    5 * time.Minute,
+			"POST /api/v1/admin/dead-letters/replay": 10 * time.Minute,
+			"PUT /api/v1/tracks/:id/audio":           10 * time.Minute,
+			"PATCH /api/v1/uploads/:id":              10 * time.Minute,
+			"GET /api/v1/albums/:id/download":        0,
+			"GET /api/v1/realtime":                   0,
+		},
+	}
+	if v := os.Getenv("REQUEST_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			log.Fatalf("REQUEST_TIMEOUT must be a non-negative duration, got %q", v)
+		}
+		timeoutConfig.Default = d
+	}
+	if v := os.Getenv("ROUTE_TIMEOUTS"); v != "" {
+		routes, err := timeouts.ParseRoutes(v)
+		if err != nil {
+			log.Fatalf("invalid ROUTE_TIMEOUTS: %v", err)
+		}
+		for route, d := range routes {
+			timeoutConfig.Routes[route] = d
+		}
 	}
 
-	// Start server
-	log.Println("Starting server on :8080")
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("failed to start server: %v", err)
+	// In-flight requests per API key, user or guest (CONCURRENCY_LIMIT, default
+	// 8), with routes of a rate-limit class capped on their own
+	// (CONCURRENCY_ROUTES overrides) and event streams, which stay open,
+	// counted apart from the rest
+	classLimits, exempt := reg.Limits()
+	concurrencyConfig := concurrency.Config{Default: 8, Routes: classLimits, Exempt: exempt}
+	if v := os.Getenv("CONCURRENCY_LIMIT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			log.Fatalf("CONCURRENCY_LIMIT must be a non-negative integer, got %q", v)
+		}
+		concurrencyConfig.Default = n
+	}
+	if v := os.Getenv("CONCURRENCY_ROUTES"); v != "" {
+		routes, err := concurrency.ParseRoutes(v)
+		if err != nil {
+			log.Fatalf("invalid CONCURRENCY_ROUTES: %v", err)
+		}
+		for route, n := range routes {
+			concurrencyConfig.Routes[route] = n
+		}
+	}
+
+	// Cache-Control per route: the public catalog may be cached by CDNs, the
+	// signed-in catalog only by the caller's browser since it carries audio
+	// URLs, and everything else is private and uncached (CACHE_POLICIES
+	// overrides routes)
+	publicCatalog := caching.Policy{Public: true, MaxAge: time.Minute, SMaxAge: 5 * time.Minute, StaleWhileRevalidate: time.Hour, StaleIfError: 24 * time.Hour}
+	userCatalog := caching.Policy{MaxAge: time.Minute, StaleWhileRevalidate: 5 * time.Minute}
+	cacheConfig := caching.Config{
+		Default: caching.Private,
+		Routes: map[string]caching.Policy{
+			"GET /public/v1/artists":              publicCatalog,
+			"GET /public/v1/artists/:id":          publicCatalog,
+			"GET /public/v1/albums/:id":           publicCatalog,
+			"GET /api/v1/artists":                 userCatalog,
+			"GET /api/v1/artists/:id":             userCatalog,
+			"GET /api/v1/artists/:id/albums":      userCatalog,
+			"GET /api/v1/artists/:id/discography": userCatalog,
+			"GET /api/v1/artists/:id/appears-on":  userCatalog,
+			"GET /api/v1/albums/:id":              userCatalog,
+			"GET /api/v1/albums/:id/tracks":       userCatalog,
+			"GET /api/v1/charts/tracks":           userCatalog,
+			"GET /api/v1/charts/artists":          userCatalog,
+		},
+	}
+	if v := os.Getenv("CACHE_POLICIES"); v != "" {
+		routes, err := caching.ParseRoutes(v)
+		if err != nil {
+			log.Fatalf("invalid CACHE_POLICIES: %v", err)
+		}
+		for route, p := range routes {
+			cacheConfig.Routes[route] = p
+		}
+	}
+
+	// Catalog list responses use the hand-rolled encoders unless JSON_ENCODER=std
+	switch v := os.Getenv("JSON_ENCODER"); v {
+	case "", "fast":
+	case "std":
+		wire.UseStdlib = true
+		log.Println("Serving all JSON through encoding/json")
+	default:
+		log.Fatalf("JSON_ENCODER must be fast or std, got %q", v)
+	}
+
+	// Panics and 500s are answered with an error ID; details go to the log and,
+	// when ERROR_REPORTER is set, to Sentry or Rollbar
+	errorReporter, err := errtrack.FromEnv()
+	if err != nil {
+		log.Fatalf("invalid error reporter config: %v", err)
+	}
+	if errorReporter != nil {
+		errorReporter = errtrack.Resilient(errorReporter, dependencies.Register("error-reporter", resilience.DefaultPolicy))
+		log.Printf("reporting errors to %s (release %s)", os.Getenv("ERROR_REPORTER"), errtrack.Release())
+	}
+
+	r := gin.New()
+	r.Use(errtrack.Recover(errorReporter), logging.GinLogger())
+	r.Use(querylog.Middleware())
+	// Before timeouts so that a 504 isn't cached under the route's policy
+	r.Use(caching.Middleware(cacheConfig))
+	r.Use(timeouts.Middleware(timeoutConfig))
+	// After timeouts so that injected latency counts against them
+	if faultConfig.Enabled() {
+		r.Use(faults.Middleware(faultConfig))
+		log.Printf("fault injection enabled: %s", os.Getenv("FAULT_INJECTION"))
+	}
+
+	// Validate payloads against the OpenAPI document outside production
+	buildSpec(spec, reg.Routes())
+	switch os.Getenv("APP_ENV") {
+	case "development", "staging":
+		r.Use(openapi.Middleware(spec))
+		log.Println("OpenAPI request/response validation enabled")
+	}
+
+	// Signed-in routes; admins are signed in too and run these first
+	reg.Use(routing.User,
+		auth.AuthMiddleware(client),
+		apiKeyMeter.Middleware(),
+		quota.Middleware(quotaCounter),
+		concurrency.Middleware(concurrency.NewLimiter(), concurrencyConfig),
+		audit.Impersonation(client),
+		loader.Middleware(client),
+		// Users must accept newly published policies before anything but reviewing them
+		consent.Middleware(consentChecker,
+			"GET /api/v1/me",
+			"GET /api/v1/me/consent",
+			"POST /api/v1/me/consent",
+		),
+	)
+	reg.Use(routing.Admin, auth.AdminMiddleware(), audit.Middleware(client))
+	reg.Use(routing.Guest, auth.GuestMiddleware(client))
+	reg.Use(routing.Anonymous, public.Anonymous(), public.RateLimit(quotaCounter, publicConfig))
+	reg.Use(routing.SCIM, sso.SCIMAuth(client))
+	reg.Mount(r)
+
+	// HTTP/2 over TLS (TLS_CERT_FILE) or as h2c from a proxy (HTTP2_CLEARTEXT),
+	// with stream and keep-alive limits
+	httpConfig, err := config.HTTPFromEnv()
+	if err != nil {
+		return fmt.Errorf("invalid HTTP config: %w", err)
+	}
+
+	// Start server on the port, a Unix socket (--socket) or the socket
+	// systemd passed when socket activated
+	ln, err := cfg.Listen()
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	log.Printf("Starting server on %s %s (%s)", ln.Addr().Network(), ln.Addr(), httpConfig.Protocols())
+	// DIAGNOSTICS_ADDR serves the profiles without authentication on its own
+	// port, e.g. 127.0.0.1:6060, which must not be reachable from outside
+	if addr := os.Getenv("DIAGNOSTICS_ADDR"); addr != "" {
+		go func() {
+			log.Printf("serving diagnostics on %s", addr)
+			if err := http.ListenAndServe(addr, diagnostics.Handler()); err != nil {
+				log.Printf("diagnostics server stopped: %v", err)
+			}
+		}()
+	}
+
+	var handler http.Handler = r
+	if ln.Addr().Network() == "unix" {
+		handler = config.UnixPeer(r)
+	}
+	if err := httpConfig.Serve(httpConfig.Server(handler), ln); err != nil {
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+	return nil
+}
+
+// runMigrate diffs the live database against the Ent schema and applies the result.
+// Destructive changes (dropped tables or columns, narrowed types, new NOT NULL constraints)
+// are only applied when allowDestructive is set.
+func runMigrate(client *ent.Client, dryRun, allowDestructive bool) {
+	ctx := context.Background()
+	if dryRun {
+		plan, err := migration.Diff(ctx, client, true)
+		if err != nil {
+			log.Fatalf("failed computing migration plan: %v", err)
+		}
+		printPlan(plan)
+		fmt.Print(plan.SQL)
+		return
+	}
+
+	plan, err := migration.Apply(ctx, client, true, allowDestructive)
+	if err != nil {
+		if errors.Is(err, migration.ErrDestructive) {
+			printPlan(plan)
+			log.Fatal("refusing to migrate: plan contains destructive changes; re-run with --allow-destructive to apply them")
+		}
+		log.Fatalf("failed applying migration: %v", err)
+	}
+	printPlan(plan)
+	log.Printf("migration applied (%d changes)", len(plan.Changes))
+}
+
+// printPlan logs each change in a migration plan, marking destructive ones
+func printPlan(plan *migration.Plan) {
+	if len(plan.Changes) == 0 {
+		log.Println("schema is up to date")
+		return
+	}
+	for _, c := range plan.Changes {
+		marker := " "
+		if c.Destructive {
+			marker = "!"
+		}
+		target := c.Table
+		if c.Column != "" {
+			target += "." + c.Column
+		}
+		log.Printf("%s %-16s %-32s %s", marker, c.Kind, target, c.Detail)
 	}
 }
 
-// getUsers returns all users
+// runSeed fills the database with the named seed profile
+func runSeed(client *ent.Client, profile string) {
+	p, ok := seed.Profiles[profile]
+	if !ok {
+		log.Fatalf("unknown seed profile %q", profile)
+	}
+	start := time.Now()
+	res, err := seed.Run(context.Background(), client, p, 1)
+	if err != nil {
+		log.Fatalf("seeding failed: %v", err)
+	}
+	log.Printf("seeded %q in %s: %d artists, %d albums, %d tracks, %d users, %d plays",
+		p.Name, time.Since(start).Round(time.Millisecond),
+		len(res.ArtistIDs), len(res.AlbumIDs), len(res.TrackIDs), len(res.UserIDs), res.Plays)
+}
+
+// writeScenarios writes a k6 script and vegeta targets for the seeded data into dir
+func writeScenarios(client *ent.Client, dir, baseURL string) {
+	sample, err := loadtest.Collect(context.Background(), client, 1000)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	k6, err := os.Create(filepath.Join(dir, "k6.js"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer k6.Close()
+	if err := loadtest.WriteK6(k6, "k6.js", baseURL, sample); err != nil {
+		log.Fatal(err)
+	}
+
+	// vegeta can't log in, so mint access tokens for a subset of seeded users
+	tokens := make([]string, 0, 50)
+	for _, id := range sample.UserIDs[:min(50, len(sample.UserIDs))] {
+		token, err := auth.GenerateAccessToken(id.String())
+		if err != nil {
+			log.Fatal(err)
+		}
+		tokens = append(tokens, token)
+	}
+	targets, err := os.Create(filepath.Join(dir, "vegeta.jsonl"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer targets.Close()
+	if err := loadtest.WriteVegeta(targets, baseURL, loadtest.Requests(sample, 10000, 1), tokens); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("wrote %s and %s", k6.Name(), targets.Name())
+	log.Printf("run: k6 run %s  |  vegeta attack -format=json -targets=%s -rate=200 -duration=60s | vegeta report", k6.Name(), targets.Name())
+}
+
+// userFields are what user lists may be filtered and sorted by; only admins
+// see emails and roles, so only they may look users up by them
+var userFields = filtering.Fields{
+	"first_name":  {Column: user.FieldFirstName, Kind: filtering.Text},
+	"last_name":   {Column: user.FieldLastName, Kind: filtering.Text},
+	"email":       {Column: user.FieldEmail, Kind: filtering.Text, Admin: true},
+	"role":        {Column: user.FieldRole, Kind: filtering.Enum, Values: filtering.EnumValues(schema.User{}.Fields(), user.FieldRole), Admin: true},
+	"deactivated": {Column: user.FieldDeactivatedAt, Kind: filtering.Time, Admin: true},
+}
+
+// getUsers returns a page of users in ID order, limited to public profiles
+// for non-admins
 func getUsers(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		users, err := client.User.Query().All(context.Background())
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		f, ok := filtering.Parse(c, userFields)
+		if !ok {
+			return
+		}
+		v := viewer.FromContext(c.Request.Context())
+		q := client.User.Query().Where(filtering.Where[predicate.User](f)...)
+		all := q.Clone()
+		users, err := q.
+			Order(filtering.Order[user.OrderOption](f, ent.Asc(user.FieldID))...).
+			Limit(p.Limit).
+			Offset(p.Offset).
+			All(c.Request.Context())
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, users)
+		total, err := paging.Total(c.Request.Context(), p, len(users), all)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		profiles := make([]any, len(users))
+		for i, u := range users {
+			profiles[i] = privacy.ProfileFor(v, u)
+		}
+		c.JSON(http.StatusOK, paging.New(c, p, profiles, total))
 	}
 }
 
@@ -113,12 +1173,13 @@ func getUsers(client *ent.Client) gin.HandlerFunc {
 func getUserByID(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
+		id, err := ids.Parse(idStr)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
 			return
 		}
-		u, err := client.User.Query().Where(user.IDEQ(id)).Only(context.Background())
+		v := viewer.FromContext(c.Request.Context())
+		u, err := client.User.Query().Where(user.IDEQ(id)).Only(c.Request.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
@@ -127,18 +1188,78 @@ func getUserByID(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, u)
+		c.JSON(http.StatusOK, privacy.ProfileFor(v, u))
 	}
 }
 
+// getUserPlays returns a user's recent listening activity if their privacy settings allow it
+func getUserPlays(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		inc, err := parseIncludes(c, "track.album", "track.album.artist")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		v := viewer.FromContext(c.Request.Context())
+		owner, err := client.User.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		allowed, err := social.CanView(c.Request.Context(), client, v, owner, privacy.SectionActivity)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, gin.H{"error": "listening activity is private"})
+			return
+		}
+		plays, err := client.Play.Query().
+			Where(play.UserIDEQ(id)).
+			WithTrack().
+			Order(ent.Desc(play.FieldPlayedAt)).
+			Limit(50).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		tracks := make([]*ent.Track, 0, len(plays))
+		for _, p := range plays {
+			if p.Edges.Track != nil {
+				tracks = append(tracks, p.Edges.Track)
+			}
+		}
+		if err := expandTracks(c.Request.Context(), loader.For(c.Request.Context(), client), tracks, inc.under("track")); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, plays)
+	}
+}
+
+// createUserRequest is the request body for createUser and createUserWithBody
+type createUserRequest struct {
+	Email     string  `json:"email" binding:"required"`
+	FirstName *string `json:"first_name"`
+	LastName  *string `json:"last_name"`
+}
+
 // createUser creates a new user with email and optional first_name/last_name from request body
 func createUser(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var body struct {
-			Email     string  `json:"email" binding:"required"`
-			FirstName *string `json:"first_name"`
-			LastName  *string `json:"last_name"`
-		}
+		var body createUserRequest
 
 		if err := c.ShouldBindJSON(&body); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -153,7 +1274,7 @@ func createUser(client *ent.Client) gin.HandlerFunc {
 			create = create.SetLastName(*body.LastName)
 		}
 
-		u, err := create.Save(context.Background())
+		u, err := create.Save(c.Request.Context())
 		if err != nil {
 			// Check for unique constraint violation
 			if ent.IsConstraintError(err) {
@@ -171,11 +1292,7 @@ func createUser(client *ent.Client) gin.HandlerFunc {
 // createUserWithBody creates a new user with email and optional first_name/last_name from request body
 func createUserWithBody(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var body struct {
-			Email     string  `json:"email" binding:"required"`
-			FirstName *string `json:"first_name"`
-			LastName  *string `json:"last_name"`
-		}
+		var body createUserRequest
 
 		if err := c.ShouldBindJSON(&body); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -190,71 +1307,390 @@ func createUserWithBody(client *ent.Client) gin.HandlerFunc {
 			create = create.SetLastName(*body.LastName)
 		}
 
-		u, err := create.Save(context.Background())
+		u, err := create.Save(c.Request.Context())
+		if err != nil {
+			// Check for unique constraint violation
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "email already exists"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, u)
+	}
+}
+
+// deleteUser deletes a user by ID
+func deleteUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		err = client.User.DeleteOneID(id).Exec(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := tombstones.Record(c.Request.Context(), client, tombstone.EntityTypeUser, nil, id); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "user deleted"})
+	}
+}
+
+// artistFields are what artist lists may be filtered and sorted by
+var artistFields = filtering.Fields{
+	"name":    {Column: artist.FieldName, Kind: filtering.Text},
+	"created": {Column: artist.FieldCreatedAt, Kind: filtering.Time},
+}
+
+// getArtists returns a page of artists by name with their associated albums
+func getArtists(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		f, ok := filtering.Parse(c, artistFields)
+		if !ok {
+			return
+		}
+		q := client.Artist.Query().Where(artist.DeletedAtIsNil()).Where(filtering.Where[predicate.Artist](f)...)
+		all := q.Clone()
+		// Use WithAlbums() to eager load the albums relation
+		artists, err := q.
+			WithAlbums(func(q *ent.AlbumQuery) { // Eager load albums relation
+				q.Where(album.DeletedAtIsNil())
+			}).
+			Order(filtering.Order[artist.OrderOption](f, ent.Asc(artist.FieldName), ent.Asc(artist.FieldID))...).
+			Limit(p.Limit).
+			Offset(p.Offset).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		total, err := paging.Total(c.Request.Context(), p, len(artists), all)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		wire.JSON(c, http.StatusOK, paging.New(c, p, artists, total)) // Albums are included in each artist
+	}
+}
+
+// getArtistByID returns an artist by ID
+func getArtistByID(client *ent.Client, missing *notfound.Cache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		if missing.Missing(ent.TypeArtist, id) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+			return
+		}
+		a, err := client.Artist.Query().
+			Where(artist.IDEQ(id), artist.DeletedAtIsNil()).
+			WithAlbums(func(q *ent.AlbumQuery) { // Eager load albums relation
+				q.Where(album.DeletedAtIsNil())
+			}).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				missing.Add(ent.TypeArtist, id)
+				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		wire.JSON(c, http.StatusOK, a)
+	}
+}
+
+// createArtistRequest is the request body for createArtist
+type createArtistRequest struct {
+	Name     string  `json:"name" binding:"required"`
+	ImageURL *string `json:"image_url"`
+}
+
+// createArtist creates a new artist with name and optional image_url from request body.
+// Artwork uploaded to /img brings its palette along.
+func createArtist(client *ent.Client, artwork *images.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createArtistRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		create := client.Artist.Create().SetName(body.Name)
+		if body.ImageURL != nil {
+			palette, ok := artworkPalette(c, artwork, *body.ImageURL)
+			if !ok {
+				return
+			}
+			create = create.SetImageURL(*body.ImageURL)
+			if palette != nil {
+				create = create.SetPalette(palette)
+			}
+		}
+
+		a, err := create.Save(c.Request.Context())
+		if err != nil {
+			if errors.Is(err, entprivacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, a)
+	}
+}
+
+// artworkPalette returns the palette of artwork served from /img, or nil for
+// other image URLs. It writes the error response and reports false when the
+// image can't be read.
+func artworkPalette(c *gin.Context, artwork *images.Service, imageURL string) ([]string, bool) {
+	id, ok := images.ParseURL(imageURL)
+	if !ok {
+		return nil, true
+	}
+	img, err := artwork.Info(c.Request.Context(), id)
+	if err != nil {
+		if storage.IsNotFound(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "image_url refers to an unknown image"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return img.Palette, true
+}
+
+// uploadArtwork stores the request body as artwork, writing the error
+// response and returning nil when that fails
+func uploadArtwork(c *gin.Context, artwork *images.Service) *images.Image {
+	// Checked before reading the body since images are stored before the catalog is written
+	if !viewer.FromContext(c.Request.Context()).IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+		return nil
+	}
+	img, err := artwork.Upload(c.Request.Context(), c.Request.Body)
+	switch {
+	case errors.Is(err, images.ErrTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	case errors.Is(err, images.ErrNotImage):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		return img
+	}
+	return nil
+}
+
+// setArtistArtwork replaces an artist's image with the uploaded request body and
+// its palette (admin)
+func setArtistArtwork(client *ent.Client, artwork *images.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		ctx := c.Request.Context()
+		exists, err := client.Artist.Query().Where(artist.IDEQ(id), artist.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+			return
+		}
+		img := uploadArtwork(c, artwork)
+		if img == nil {
+			return
+		}
+
+		a, err := client.Artist.UpdateOneID(id).
+			SetImageURL(img.URL).
+			SetPalette(img.Palette).
+			Save(ctx)
+		if err != nil {
+			if errors.Is(err, entprivacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, a)
+	}
+}
+
+// deleteArtist deletes an artist using the policy and mode given in the query string.
+// policy=restrict (default) refuses to delete artists with albums; policy=cascade deletes them too.
+// hard=true removes rows permanently instead of soft-deleting them.
+func deleteArtist(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		policy, err := catalog.ParseDeletePolicy(c.Query("policy"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		hard := c.Query("hard") == "true"
+
+		impact, err := catalog.DeleteArtist(c.Request.Context(), client, id, policy, hard)
 		if err != nil {
-			// Check for unique constraint violation
-			if ent.IsConstraintError(err) {
-				c.JSON(http.StatusConflict, gin.H{"error": "email already exists"})
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+				return
+			}
+			if errors.Is(err, entprivacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
+			if errors.Is(err, catalog.ErrHasChildren) {
+				c.JSON(http.StatusConflict, gin.H{
+					"error":  "artist has albums; use policy=cascade to delete them as well",
+					"impact": impact,
+				})
 				return
 			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-
-		c.JSON(http.StatusCreated, u)
+		c.JSON(http.StatusOK, gin.H{"message": "artist deleted", "impact": impact})
 	}
 }
 
-// deleteUser deletes a user by ID
-func deleteUser(client *ent.Client) gin.HandlerFunc {
+// previewArtistDeletion reports what deleting an artist would affect without deleting anything
+func previewArtistDeletion(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
+		id, err := ids.Parse(idStr)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		policy, err := catalog.ParseDeletePolicy(c.Query("policy"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
-		err = client.User.DeleteOneID(id).Exec(context.Background())
+		hard := c.Query("hard") == "true"
+
+		impact, err := catalog.ArtistDeletionImpact(c.Request.Context(), client, id, policy, hard)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
 				return
 			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"message": "user deleted"})
+		c.JSON(http.StatusOK, impact)
 	}
 }
 
-// getArtists returns all artists with their associated albums
-func getArtists(client *ent.Client) gin.HandlerFunc {
+// getAlbumByID returns an album by ID with associated tracks
+func getAlbumByID(client *ent.Client, missing *notfound.Cache) gin.HandlerFunc {
+	// Requests for the same album at once, as when a release is shared, share one query
+	reads := coalesce.New[*ent.Album]()
 	return func(c *gin.Context) {
-		// Use WithAlbums() to eager load the albums relation
-		artists, err := client.Artist.Query().
-			WithAlbums(). // Eager load albums relation
-			All(context.Background())
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			return
+		}
+		if missing.Missing(ent.TypeAlbum, id) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+			return
+		}
+		a, err := reads.Do(c.Request.Context(), id.String(), func(ctx context.Context) (*ent.Album, error) {
+			return client.Album.Query().
+				Where(album.IDEQ(id), album.DeletedAtIsNil()).
+				WithArtist(). // Eager load artist relation
+				WithTracks(func(q *ent.TrackQuery) {
+					// Eager load tracks relation, skipping deleted tracks
+					q.Where(track.DeletedAtIsNil()).Order(ent.Asc(track.FieldDiscNumber), ent.Asc(track.FieldTrackNumber))
+				}).
+				Only(ctx)
+		})
 		if err != nil {
+			if ent.IsNotFound(err) {
+				missing.Add(ent.TypeAlbum, id)
+				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, artists) // Albums are included in each artist
+		wire.JSON(c, http.StatusOK, a)
 	}
 }
 
-// getArtistByID returns an artist by ID
-func getArtistByID(client *ent.Client) gin.HandlerFunc {
+// albumFields are what album lists may be filtered and sorted by
+var albumFields = filtering.Fields{
+	"title":      {Column: album.FieldTitle, Kind: filtering.Text},
+	"label":      {Column: album.FieldLabel, Kind: filtering.Text},
+	"album_type": {Column: album.FieldAlbumType, Kind: filtering.Enum, Values: filtering.EnumValues(schema.Album{}.Fields(), album.FieldAlbumType)},
+	"released":   {Column: album.FieldReleaseDate, Kind: filtering.Time},
+	"created":    {Column: album.FieldCreatedAt, Kind: filtering.Time},
+}
+
+// getArtistAlbums returns a page of an artist's albums, oldest first
+func getArtistAlbums(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
+		artistID, err := ids.Parse(idStr)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
 			return
 		}
+		inc, err := parseIncludes(c, "artist")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		f, ok := filtering.Parse(c, albumFields)
+		if !ok {
+			return
+		}
+
+		// Verify artist exists
 		a, err := client.Artist.Query().
-			Where(artist.IDEQ(id)).
-			WithAlbums(). // Eager load albums relation
-			Only(context.Background())
+			Where(artist.IDEQ(artistID), artist.DeletedAtIsNil()).
+			Only(c.Request.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
@@ -263,78 +1699,67 @@ func getArtistByID(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, a)
-	}
-}
-
-// createArtist creates a new artist with name and optional image_url from request body
-func createArtist(client *ent.Client) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		var body struct {
-			Name     string  `json:"name" binding:"required"`
-			ImageURL *string `json:"image_url"`
-		}
 
-		if err := c.ShouldBindJSON(&body); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		q := client.Album.Query().
+			Where(album.ArtistIDEQ(artistID), album.DeletedAtIsNil()).
+			Where(filtering.Where[predicate.Album](f)...)
+		all := q.Clone()
+		albums, err := q.
+			Order(filtering.Order[album.OrderOption](f, ent.Asc(album.FieldCreatedAt), ent.Asc(album.FieldID))...).
+			Limit(p.Limit).
+			Offset(p.Offset).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-
-		create := client.Artist.Create().SetName(body.Name)
-		if body.ImageURL != nil {
-			create = create.SetImageURL(*body.ImageURL)
-		}
-
-		a, err := create.Save(context.Background())
+		total, err := paging.Total(c.Request.Context(), p, len(albums), all)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-
-		c.JSON(http.StatusCreated, a)
+		loaders := loader.For(c.Request.Context(), client)
+		loaders.Artists.Prime(a.ID, a) // Already fetched above
+		if err := expandAlbums(c.Request.Context(), loaders, albums, inc); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		wire.JSON(c, http.StatusOK, paging.New(c, p, albums, total))
 	}
 }
 
-// getAlbumByID returns an album by ID with associated tracks
-func getAlbumByID(client *ent.Client) gin.HandlerFunc {
+// getArtistDiscography returns an artist's releases grouped by type and release
+// year, plus the releases of other artists it's credited on
+func getArtistDiscography(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
+		artistID, err := ids.Parse(c.Param("id"))
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
 			return
 		}
-		a, err := client.Album.Query().
-			Where(album.IDEQ(id)).
-			WithArtist(). // Eager load artist relation
-			WithTracks(). // Eager load tracks relation
-			Only(context.Background())
+		d, err := catalog.ArtistDiscography(c.Request.Context(), client, artistID)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
 				return
 			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, a)
+		c.JSON(http.StatusOK, d)
 	}
 }
 
-// getArtistAlbums returns all albums for an artist
-func getArtistAlbums(client *ent.Client) gin.HandlerFunc {
+// getArtistAppearsOn returns the compilations and other artists' releases an
+// artist is credited on. Results are cached for catalog.AppearsOnTTL.
+func getArtistAppearsOn(appearsOn *catalog.AppearsOnCache) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		idStr := c.Param("id")
-		artistID, err := uuid.Parse(idStr)
+		artistID, err := ids.Parse(c.Param("id"))
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
 			return
 		}
-
-		// Verify artist exists
-		_, err = client.Artist.Query().
-			Where(artist.IDEQ(artistID)).
-			Only(context.Background())
+		res, err := appearsOn.Get(c.Request.Context(), artistID)
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
@@ -343,33 +1768,40 @@ func getArtistAlbums(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-
-		albums, err := client.Album.Query().
-			Where(album.ArtistIDEQ(artistID)).
-			All(context.Background())
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-		c.JSON(http.StatusOK, albums)
+		c.JSON(http.StatusOK, res)
 	}
 }
 
-// getAlbumTracks returns an album with its associated tracks
+// trackFields are what track lists may be filtered and sorted by
+var trackFields = filtering.Fields{
+	"title":        {Column: track.FieldTitle, Kind: filtering.Text},
+	"disc_number":  {Column: track.FieldDiscNumber, Kind: filtering.Int},
+	"track_number": {Column: track.FieldTrackNumber, Kind: filtering.Int},
+	"version_type": {Column: track.FieldVersionType, Kind: filtering.Enum, Values: filtering.EnumValues(schema.Track{}.Fields(), track.FieldVersionType)},
+	"created":      {Column: track.FieldCreatedAt, Kind: filtering.Time},
+}
+
+// getAlbumTracks returns an album with a page of its tracks in disc and track order
 func getAlbumTracks(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
-		albumID, err := uuid.Parse(idStr)
+		albumID, err := ids.Parse(idStr)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
 			return
 		}
+		p, ok := paging.Parse(c)
+		if !ok {
+			return
+		}
+		f, ok := filtering.Parse(c, trackFields)
+		if !ok {
+			return
+		}
 
-		// Use WithTracks() to eager load the tracks relation
 		a, err := client.Album.Query().
-			Where(album.IDEQ(albumID)).
-			WithTracks(). // Eager load tracks relation
-			Only(context.Background())
+			Where(album.IDEQ(albumID), album.DeletedAtIsNil()).
+			Only(c.Request.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
@@ -378,18 +1810,45 @@ func getAlbumTracks(client *ent.Client) gin.HandlerFunc {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
-		c.JSON(http.StatusOK, a) // Tracks are included in the album object
+
+		q := client.Track.Query().
+			Where(track.AlbumIDEQ(albumID), track.DeletedAtIsNil()).
+			Where(filtering.Where[predicate.Track](f)...)
+		all := q.Clone()
+		tracks, err := q.
+			Order(filtering.Order[track.OrderOption](f, ent.Asc(track.FieldDiscNumber), ent.Asc(track.FieldTrackNumber), ent.Asc(track.FieldID))...).
+			Limit(p.Limit).
+			Offset(p.Offset).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		total, err := paging.Total(c.Request.Context(), p, len(tracks), all)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		wire.JSON(c, http.StatusOK, &wire.AlbumTracks{Album: a, Tracks: paging.New(c, p, tracks, total)})
 	}
 }
 
-// createAlbum creates a new album with title, artist_id, and optional image_url from request body
-func createAlbum(client *ent.Client) gin.HandlerFunc {
+// createAlbumRequest is the request body for createAlbum
+type createAlbumRequest struct {
+	Title    string  `json:"title" binding:"required"`
+	ArtistID string  `json:"artist_id" binding:"required"`
+	ImageURL *string `json:"image_url"`
+	Label    *string `json:"label"`
+	// AlbumType is album (default), single, ep or compilation
+	AlbumType   *string `json:"album_type" binding:"omitempty,oneof=album single ep compilation"`
+	ReleaseDate *string `json:"release_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// createAlbum creates a new album with title, artist_id, and optional image_url, label, album_type and release_date from request body.
+// Artwork uploaded to /img brings its palette along.
+func createAlbum(client *ent.Client, artwork *images.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var body struct {
-			Title    string  `json:"title" binding:"required"`
-			ArtistID string  `json:"artist_id" binding:"required"`
-			ImageURL *string `json:"image_url"`
-		}
+		var body createAlbumRequest
 
 		if err := c.ShouldBindJSON(&body); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -404,8 +1863,8 @@ func createAlbum(client *ent.Client) gin.HandlerFunc {
 
 		// Verify artist exists
 		_, err = client.Artist.Query().
-			Where(artist.IDEQ(artistID)).
-			Only(context.Background())
+			Where(artist.IDEQ(artistID), artist.DeletedAtIsNil()).
+			Only(c.Request.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusBadRequest, gin.H{"error": "artist not found"})
@@ -419,11 +1878,32 @@ func createAlbum(client *ent.Client) gin.HandlerFunc {
 			SetTitle(body.Title).
 			SetArtistID(artistID)
 		if body.ImageURL != nil {
+			palette, ok := artworkPalette(c, artwork, *body.ImageURL)
+			if !ok {
+				return
+			}
 			create = create.SetImageURL(*body.ImageURL)
+			if palette != nil {
+				create = create.SetPalette(palette)
+			}
+		}
+		if body.Label != nil {
+			create = create.SetLabel(*body.Label)
+		}
+		if body.AlbumType != nil {
+			create = create.SetAlbumType(album.AlbumType(*body.AlbumType))
+		}
+		if body.ReleaseDate != nil {
+			released, _ := time.Parse(time.DateOnly, *body.ReleaseDate) // validated by binding
+			create = create.SetReleaseDate(released)
 		}
 
-		a, err := create.Save(context.Background())
+		a, err := create.Save(c.Request.Context())
 		if err != nil {
+			if errors.Is(err, entprivacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
@@ -432,14 +1912,134 @@ func createAlbum(client *ent.Client) gin.HandlerFunc {
 	}
 }
 
-// createTrack creates a new track with title, album_id, and optional url from request body
-func createTrack(client *ent.Client) gin.HandlerFunc {
+// setAlbumArtwork replaces an album's cover with the uploaded request body and
+// its palette (admin)
+func setAlbumArtwork(client *ent.Client, artwork *images.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			return
+		}
+		ctx := c.Request.Context()
+		exists, err := client.Album.Query().Where(album.IDEQ(id), album.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+			return
+		}
+		img := uploadArtwork(c, artwork)
+		if img == nil {
+			return
+		}
+
+		a, err := client.Album.UpdateOneID(id).
+			SetImageURL(img.URL).
+			SetPalette(img.Palette).
+			Save(ctx)
+		if err != nil {
+			if errors.Is(err, entprivacy.Deny) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, a)
+	}
+}
+
+// tracklistEntryRequest places one track in setAlbumTracklist
+type tracklistEntryRequest struct {
+	TrackID string `json:"track_id" binding:"required"`
+	Disc    *int   `json:"disc" binding:"omitempty,min=1"`
+}
+
+// setAlbumTracklistRequest is the request body for setAlbumTracklist
+type setAlbumTracklistRequest struct {
+	Tracks []tracklistEntryRequest `json:"tracks" binding:"required,min=1,dive"`
+}
+
+// setAlbumTracklist replaces an album's track order and disc assignments with the
+// given list, which must name every track on the album exactly once. Tracks are
+// renumbered from 1 within each disc; disc defaults to 1.
+func setAlbumTracklist(client *ent.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var body struct {
-			Title   string  `json:"title" binding:"required"`
-			AlbumID string  `json:"album_id" binding:"required"`
-			URL     *string `json:"url"`
+		albumID, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			return
+		}
+
+		var body setAlbumTracklistRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		entries := make([]catalog.TracklistEntry, len(body.Tracks))
+		for i, t := range body.Tracks {
+			id, err := uuid.Parse(t.TrackID)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track_id format", "index": i})
+				return
+			}
+			entries[i] = catalog.TracklistEntry{TrackID: id, Disc: 1}
+			if t.Disc != nil {
+				entries[i].Disc = *t.Disc
+			}
+		}
+
+		tracks, err := catalog.SetTracklist(c.Request.Context(), client, albumID, entries)
+		if err != nil {
+			var tlerr *catalog.TracklistError
+			switch {
+			case ent.IsNotFound(err):
+				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+			case errors.As(err, &tlerr):
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": tlerr.Error(), "details": tlerr})
+			case errors.Is(err, entprivacy.Deny):
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
 		}
+		wire.JSON(c, http.StatusOK, tracks)
+	}
+}
+
+// createTrackRequest is the request body for createTrack
+type createTrackRequest struct {
+	Title       string  `json:"title" binding:"required"`
+	AlbumID     string  `json:"album_id" binding:"required"`
+	URL         *string `json:"url"`
+	TrackNumber *int    `json:"track_number" binding:"omitempty,min=1"`
+	DiscNumber  *int    `json:"disc_number" binding:"omitempty,min=1"`
+	// DurationMs is the length of audio streamed from URL; uploaded audio is
+	// measured when it is uploaded
+	DurationMs *int64 `json:"duration_ms" binding:"omitempty,min=0"`
+	// Credits names other artists on the track, e.g. featured artists
+	Credits []trackCreditRequest `json:"credits" binding:"omitempty,max=50,dive"`
+	// CanonicalTrackID links a remaster, live version or remix to its original;
+	// VersionType is then required
+	CanonicalTrackID *string `json:"canonical_track_id" binding:"omitempty,uuid"`
+	VersionType      *string `json:"version_type" binding:"omitempty,oneof=original remaster live remix acoustic edit"`
+}
+
+// trackCreditRequest credits an artist on a track; role defaults to featured
+type trackCreditRequest struct {
+	ArtistID string  `json:"artist_id" binding:"required,uuid"`
+	Role     *string `json:"role" binding:"omitempty,oneof=featured remixer producer composer"`
+}
+
+// createTrack creates a new track with title, album_id, and optional url, track_number, disc_number, credits
+// and version details from request body
+func createTrack(client *ent.Client, appearsOn *catalog.AppearsOnCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createTrackRequest
 
 		if err := c.ShouldBindJSON(&body); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -454,8 +2054,8 @@ func createTrack(client *ent.Client) gin.HandlerFunc {
 
 		// Verify album exists
 		_, err = client.Album.Query().
-			Where(album.IDEQ(albumID)).
-			Only(context.Background())
+			Where(album.IDEQ(albumID), album.DeletedAtIsNil()).
+			Only(c.Request.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				c.JSON(http.StatusBadRequest, gin.H{"error": "album not found"})
@@ -465,19 +2065,218 @@ func createTrack(client *ent.Client) gin.HandlerFunc {
 			return
 		}
 
-		create := client.Track.Create().
+		var canonicalID *uuid.UUID
+		if body.CanonicalTrackID != nil {
+			if body.VersionType == nil || *body.VersionType == string(track.VersionTypeOriginal) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "version_type is required for versions of another track"})
+				return
+			}
+			id, err := catalog.ResolveCanonical(c.Request.Context(), client, uuid.MustParse(*body.CanonicalTrackID)) // validated by binding
+			if err != nil {
+				if errors.Is(err, catalog.ErrCanonicalNotFound) {
+					c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+					return
+				}
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			canonicalID = &id
+		}
+
+		// Verify credited artists exist
+		credited := map[uuid.UUID]bool{}
+		for _, cr := range body.Credits {
+			credited[uuid.MustParse(cr.ArtistID)] = true // validated by binding
+		}
+		if len(credited) > 0 {
+			ids := make([]uuid.UUID, 0, len(credited))
+			for id := range credited {
+				ids = append(ids, id)
+			}
+			n, err := client.Artist.Query().
+				Where(artist.IDIn(ids...), artist.DeletedAtIsNil()).
+				Count(c.Request.Context())
+			if err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+				return
+			}
+			if n != len(ids) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "credited artist not found"})
+				return
+			}
+		}
+
+		// The catalog is kept in the home database
+		tx, err := client.Tx(residency.Home(c.Request.Context()))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+
+		create := tx.Track.Create().
 			SetTitle(body.Title).
 			SetAlbumID(albumID)
 		if body.URL != nil {
 			create = create.SetURL(*body.URL)
 		}
+		if body.TrackNumber != nil {
+			create = create.SetTrackNumber(*body.TrackNumber)
+		}
+		if body.DiscNumber != nil {
+			create = create.SetDiscNumber(*body.DiscNumber)
+		}
+		create = create.SetNillableDurationMs(body.DurationMs)
+		if body.VersionType != nil {
+			create = create.SetVersionType(track.VersionType(*body.VersionType))
+		}
+		create = create.SetNillableCanonicalTrackID(canonicalID)
 
-		t, err := create.Save(context.Background())
+		t, err := create.Save(c.Request.Context())
+		if err == nil && len(body.Credits) > 0 {
+			credits := make([]*ent.TrackCreditCreate, len(body.Credits))
+			for i, cr := range body.Credits {
+				credits[i] = tx.TrackCredit.Create().
+					SetTrackID(t.ID).
+					SetArtistID(uuid.MustParse(cr.ArtistID))
+				if cr.Role != nil {
+					credits[i].SetRole(trackcredit.Role(*cr.Role))
+				}
+			}
+			err = tx.TrackCredit.CreateBulk(credits...).Exec(c.Request.Context())
+		}
+		if err == nil {
+			err = tx.Commit()
+		}
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			switch {
+			case errors.Is(err, entprivacy.Deny):
+				c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			case ent.IsConstraintError(err):
+				c.JSON(http.StatusBadRequest, gin.H{"error": "duplicate credit"})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
 			return
 		}
+		for id := range credited {
+			appearsOn.Invalidate(id)
+		}
 
 		c.JSON(http.StatusCreated, t)
 	}
 }
+
+// getTrackVersions returns the original recording of a track with its
+// remasters, live versions and remixes
+func getTrackVersions(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track ID"})
+			return
+		}
+		canonical, versions, err := catalog.TrackVersions(c.Request.Context(), client, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "track not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"canonical": canonical, "versions": versions})
+	}
+}
+
+// createPlayRequest is the request body for createPlay
+type createPlayRequest struct {
+	TrackID   string  `json:"track_id" binding:"required"`
+	Territory *string `json:"territory" binding:"omitempty,len=2,alpha"`
+}
+
+// createPlay records a play of a track by the authenticated user. With a play
+// buffer the play is written behind the request, which is answered with 202.
+func createPlay(client *ent.Client, plays *ingest.Buffer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createPlayRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		trackID, err := uuid.Parse(body.TrackID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track_id format"})
+			return
+		}
+
+		if plays != nil {
+			p := ingest.Play{ID: ids.New(), UserID: userID, TrackID: trackID, PlayedAt: time.Now()}
+			if body.Territory != nil {
+				p.Territory = strings.ToUpper(*body.Territory)
+			}
+			switch err := plays.Accept(c.Request.Context(), p); {
+			case err == nil:
+				c.JSON(http.StatusAccepted, p)
+			case errors.Is(err, ingest.ErrUnknownTrack):
+				c.JSON(http.StatusBadRequest, gin.H{"error": "track not found"})
+			case errors.Is(err, ingest.ErrFull), errors.Is(err, ingest.ErrClosed):
+				c.Header("Retry-After", "5")
+				c.JSON(http.StatusServiceUnavailable, gin.H{"error": "too many plays pending; try again shortly"})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
+
+		// Verify track exists
+		exists, err := client.Track.Query().
+			Where(track.IDEQ(trackID), track.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "track not found"})
+			return
+		}
+		optOut, err := client.User.Query().
+			Where(user.IDEQ(userID), user.AnalyticsOptOut(true)).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		create := client.Play.Create().
+			SetUserID(userID).
+			SetTrackID(trackID)
+		if body.Territory != nil {
+			create = create.SetTerritory(strings.ToUpper(*body.Territory))
+		}
+
+		p, err := create.Save(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		events.Emit(c.Request.Context(), privacy.PlayEvent(events.PlayRecorded{
+			PlayID:    p.ID,
+			UserID:    userID,
+			TrackID:   trackID,
+			PlayedAt:  p.PlayedAt,
+			Territory: p.Territory,
+		}, optOut))
+		c.JSON(http.StatusCreated, p)
+	}
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"math"
 	"net/http"
+	"reflect"
 	"strings"
 
 	"streamify/ent"
 	"streamify/ent/schema"
+	"streamify/migration"
 
 	entSchema "entgo.io/ent"
 	"entgo.io/ent/schema/field"
@@ -13,8 +16,21 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// getSchema returns the database schema information dynamically from Ent schemas
-func getSchema(client *ent.Client) gin.HandlerFunc {
+// getSchemaDiff reports how the live database has drifted from the Ent schemas.
+// It inspects the database, so it is for admins only.
+func getSchemaDiff(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		drift, err := migration.Inspect(c.Request.Context(), client)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"in_sync": drift.InSync(), "drift": drift})
+	}
+}
+
+// getSchema returns the database schema information dynamically from Ent schemas.
+func getSchema() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Define all schemas to introspect with their names
 		schemaList := []struct {
@@ -26,6 +42,43 @@ func getSchema(client *ent.Client) gin.HandlerFunc {
 			{"Artist", schema.Artist{}.Fields, schema.Artist{}.Edges},
 			{"Album", schema.Album{}.Fields, schema.Album{}.Edges},
 			{"Track", schema.Track{}.Fields, schema.Track{}.Edges},
+			{"TrackCredit", schema.TrackCredit{}.Fields, schema.TrackCredit{}.Edges},
+			{"AudioFingerprint", schema.AudioFingerprint{}.Fields, schema.AudioFingerprint{}.Edges},
+			{"DuplicateReview", schema.DuplicateReview{}.Fields, schema.DuplicateReview{}.Edges},
+			{"Entitlement", schema.Entitlement{}.Fields, schema.Entitlement{}.Edges},
+			{"UploadSession", schema.UploadSession{}.Fields, schema.UploadSession{}.Edges},
+			{"Play", schema.Play{}.Fields, schema.Play{}.Edges},
+			{"PlayCount", schema.PlayCount{}.Fields, schema.PlayCount{}.Edges},
+			{"Playlist", schema.Playlist{}.Fields, schema.Playlist{}.Edges},
+			{"Follow", schema.Follow{}.Fields, schema.Follow{}.Edges},
+			{"Block", schema.Block{}.Fields, schema.Block{}.Edges},
+			{"ShareLink", schema.ShareLink{}.Fields, schema.ShareLink{}.Edges},
+			{"Like", schema.Like{}.Fields, schema.Like{}.Edges},
+			{"GuestState", schema.GuestState{}.Fields, schema.GuestState{}.Edges},
+			{"Backup", schema.Backup{}.Fields, schema.Backup{}.Edges},
+			{"AuditLog", schema.AuditLog{}.Fields, schema.AuditLog{}.Edges},
+			{"Invite", schema.Invite{}.Fields, schema.Invite{}.Edges},
+			{"Referral", schema.Referral{}.Fields, schema.Referral{}.Edges},
+			{"SupportTicket", schema.SupportTicket{}.Fields, schema.SupportTicket{}.Edges},
+			{"SupportReply", schema.SupportReply{}.Fields, schema.SupportReply{}.Edges},
+			{"SupportAttachment", schema.SupportAttachment{}.Fields, schema.SupportAttachment{}.Edges},
+			{"PromoCode", schema.PromoCode{}.Fields, schema.PromoCode{}.Edges},
+			{"ChangelogEntry", schema.ChangelogEntry{}.Fields, schema.ChangelogEntry{}.Edges},
+			{"PromoRedemption", schema.PromoRedemption{}.Fields, schema.PromoRedemption{}.Edges},
+			{"WaitlistEntry", schema.WaitlistEntry{}.Fields, schema.WaitlistEntry{}.Edges},
+			{"PolicyVersion", schema.PolicyVersion{}.Fields, schema.PolicyVersion{}.Edges},
+			{"PolicyAcceptance", schema.PolicyAcceptance{}.Fields, schema.PolicyAcceptance{}.Edges},
+			{"DeadLetter", schema.DeadLetter{}.Fields, schema.DeadLetter{}.Edges},
+			{"Confirmation", schema.Confirmation{}.Fields, schema.Confirmation{}.Edges},
+			{"DeviceAuthorization", schema.DeviceAuthorization{}.Fields, schema.DeviceAuthorization{}.Edges},
+			{"SSOProvider", schema.SSOProvider{}.Fields, schema.SSOProvider{}.Edges},
+			{"ExternalIdentity", schema.ExternalIdentity{}.Fields, schema.ExternalIdentity{}.Edges},
+			{"SCIMGroup", schema.SCIMGroup{}.Fields, schema.SCIMGroup{}.Edges},
+			{"SecurityAlert", schema.SecurityAlert{}.Fields, schema.SecurityAlert{}.Edges},
+			{"APIKey", schema.APIKey{}.Fields, schema.APIKey{}.Edges},
+			{"APIKeyUsage", schema.APIKeyUsage{}.Fields, schema.APIKeyUsage{}.Edges},
+			{"Tombstone", schema.Tombstone{}.Fields, schema.Tombstone{}.Edges},
+			{"Operation", schema.Operation{}.Fields, schema.Operation{}.Edges},
 		}
 
 		models := make([]map[string]interface{}, 0, len(schemaList))
@@ -105,6 +158,22 @@ func extractModelInfo(modelName string, getFields func() []entSchema.Field, getE
 			fieldInfo["attributes"] = attributes
 		}
 
+		// Data dictionary entries, for generating admin forms
+		if comment := fieldComment(fieldDesc); comment != "" {
+			fieldInfo["comment"] = comment
+		}
+		if len(fieldDesc.Enums) > 0 {
+			values := make([]string, len(fieldDesc.Enums))
+			for i, e := range fieldDesc.Enums {
+				values[i] = e.V
+			}
+			fieldInfo["enum"] = values
+		}
+		fieldInfo["validation"] = fieldValidation(fieldDesc)
+		if example := fieldExample(fieldDesc); example != nil {
+			fieldInfo["example"] = example
+		}
+
 		// Add foreign key info if this field has a foreign key relationship
 		if fkInfo, ok := edgeMap[fieldName]; ok {
 			fieldInfo["foreignKey"] = fkInfo
@@ -120,6 +189,89 @@ func extractModelInfo(modelName string, getFields func() []entSchema.Field, getE
 	}
 }
 
+// commonComments describe the fields most entities share and leave uncommented
+var commonComments = map[string]string{
+	"id":         "Unique identifier; a time-ordered UUIDv7 for rows created since IDs switched from random UUIDv4s",
+	"created_at": "When the row was created",
+	"updated_at": "When the row was last changed",
+	"deleted_at": "When the row was soft-deleted; unset while it's live",
+}
+
+// fieldComment returns the field's Comment, or the common description of its name
+func fieldComment(fd *field.Descriptor) string {
+	if fd.Comment != "" {
+		return fd.Comment
+	}
+	return commonComments[fd.Name]
+}
+
+// fieldDoc returns the field's schema.Doc annotation, if any
+func fieldDoc(fd *field.Descriptor) (schema.Doc, bool) {
+	for _, a := range fd.Annotations {
+		if doc, ok := a.(schema.Doc); ok {
+			return doc, true
+		}
+	}
+	return schema.Doc{}, false
+}
+
+// fieldValidation lists what a value of the field must satisfy. Defaults
+// computed by a function, such as a new UUID or the current time, are
+// reported as "generated".
+func fieldValidation(fd *field.Descriptor) map[string]interface{} {
+	v := map[string]interface{}{
+		"required":  !fd.Optional && fd.Default == nil,
+		"nullable":  fd.Nillable,
+		"unique":    fd.Unique,
+		"immutable": fd.Immutable,
+		"sensitive": fd.Sensitive,
+	}
+	if fd.Info.Type == field.TypeString && fd.Size > 0 && fd.Size < math.MaxInt32 {
+		v["maxLength"] = fd.Size
+	}
+	if fd.Default != nil {
+		if reflect.TypeOf(fd.Default).Kind() == reflect.Func {
+			v["default"] = "generated"
+		} else {
+			v["default"] = fd.Default
+		}
+	}
+	if doc, ok := fieldDoc(fd); ok && len(doc.Rules) > 0 {
+		v["rules"] = doc.Rules
+	}
+	return v
+}
+
+// exampleValues are shown for fields of each type without an example of their own
+var exampleValues = map[field.Type]interface{}{
+	field.TypeUUID:    "4f1c2b7e-9a3d-4e8b-b6a1-2c5d7e9f0a1b",
+	field.TypeString:  "text",
+	field.TypeInt:     1,
+	field.TypeInt64:   1,
+	field.TypeFloat64: 1.5,
+	field.TypeBool:    true,
+	field.TypeTime:    "2026-01-01T12:00:00Z",
+}
+
+// fieldExample returns a value to show for the field: its Doc example, its
+// default or first enum value, or an example of its type. Sensitive fields
+// get none.
+func fieldExample(fd *field.Descriptor) interface{} {
+	if fd.Sensitive {
+		return nil
+	}
+	if doc, ok := fieldDoc(fd); ok && doc.Example != nil {
+		return doc.Example
+	}
+	if len(fd.Enums) > 0 {
+		if d, ok := fd.Default.(string); ok {
+			return d
+		}
+		return fd.Enums[0].V
+	}
+	return exampleValues[fd.Info.Type]
+}
+
 // getFieldType converts Ent field descriptor to a readable type string
 func getFieldType(fd *field.Descriptor) string {
 	fieldType := fd.Info.Type
@@ -146,31 +298,12 @@ func getFieldType(fd *field.Descriptor) string {
 	if fieldType == field.TypeTime {
 		return "Time"
 	}
+	if fieldType == field.TypeEnum {
+		return "Enum"
+	}
+	if fieldType == field.TypeJSON {
+		return "JSON"
+	}
 
 	return "Unknown"
 }
-
-// getRoutes returns all registered API routes
-func getRoutes(r *gin.Engine) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		endpoints := []map[string]string{
-			{"method": "GET", "path": "/api/v1/users", "description": "Get all users"},
-			{"method": "GET", "path": "/api/v1/users/:id", "description": "Get user by ID"},
-			{"method": "POST", "path": "/api/v1/users", "description": "Create a new user"},
-			{"method": "DELETE", "path": "/api/v1/users/:id", "description": "Delete user by ID"},
-			{"method": "GET", "path": "/api/v1/artists", "description": "Get all artists"},
-			{"method": "GET", "path": "/api/v1/artists/:id", "description": "Get artist by ID"},
-			{"method": "POST", "path": "/api/v1/artists", "description": "Create a new artist"},
-			{"method": "GET", "path": "/api/v1/artists/:id/albums", "description": "Get albums for an artist"},
-			{"method": "GET", "path": "/api/v1/albums/:id", "description": "Get album by ID"},
-			{"method": "POST", "path": "/api/v1/albums", "description": "Create a new album"},
-			{"method": "GET", "path": "/api/v1/albums/:id/tracks", "description": "Get tracks for an album"},
-			{"method": "POST", "path": "/api/v1/tracks", "description": "Create a new track"},
-			{"method": "POST", "path": "/api/users", "description": "Create a new user (non-versioned)"},
-			{"method": "GET", "path": "/api/schema", "description": "Get database schema"},
-			{"method": "GET", "path": "/api/routes", "description": "Get all API routes"},
-		}
-
-		c.JSON(http.StatusOK, gin.H{"endpoints": endpoints})
-	}
-}
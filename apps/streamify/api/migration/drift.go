@@ -0,0 +1,278 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"streamify/ent"
+	"streamify/ent/migrate"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// Drift is how the live database differs from the Ent schema, as read from
+// information_schema and pg_indexes. Unlike Diff it reports what is in the
+// database but not in the schema, such as columns added by hand.
+type Drift struct {
+	// MissingTables are in the schema but not the database
+	MissingTables []string `json:"missing_tables"`
+	// ExtraTables are in the database but not the schema
+	ExtraTables []string     `json:"extra_tables"`
+	Tables      []TableDrift `json:"tables"`
+}
+
+// TableDrift is how one table differs
+type TableDrift struct {
+	Table          string        `json:"table"`
+	MissingColumns []string      `json:"missing_columns,omitempty"`
+	ExtraColumns   []string      `json:"extra_columns,omitempty"`
+	Columns        []ColumnDrift `json:"columns,omitempty"`
+	MissingIndexes []string      `json:"missing_indexes,omitempty"`
+	ExtraIndexes   []string      `json:"extra_indexes,omitempty"`
+}
+
+// ColumnDrift is a column whose type or nullability differs
+type ColumnDrift struct {
+	Column   string `json:"column"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// InSync reports whether no drift was found
+func (d *Drift) InSync() bool {
+	return len(d.MissingTables) == 0 && len(d.ExtraTables) == 0 && len(d.Tables) == 0
+}
+
+// liveColumn is a column as information_schema describes it
+type liveColumn struct {
+	dataType string
+	length   int
+	nullable bool
+}
+
+// Inspect compares the tables, columns and indexes of the live database with
+// the Ent schema. Play partitions, materialized views and the indexes kept
+// outside Ent aren't drift.
+func Inspect(ctx context.Context, client *ent.Client) (*Drift, error) {
+	tables, err := liveTables(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := liveColumns(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := liveIndexes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &Drift{MissingTables: []string{}, ExtraTables: []string{}, Tables: []TableDrift{}}
+	known := make(map[string]bool, len(migrate.Tables))
+	for _, t := range migrate.Tables {
+		known[t.Name] = true
+		if !tables[t.Name] {
+			drift.MissingTables = append(drift.MissingTables, t.Name)
+			continue
+		}
+		if td := inspectTable(t, columns[t.Name], indexes[t.Name]); td != nil {
+			drift.Tables = append(drift.Tables, *td)
+		}
+	}
+	for name := range tables {
+		if !known[name] {
+			drift.ExtraTables = append(drift.ExtraTables, name)
+		}
+	}
+	slices.Sort(drift.ExtraTables)
+	return drift, nil
+}
+
+// inspectTable compares one table, returning nil when it matches
+func inspectTable(t *schema.Table, live map[string]liveColumn, liveIdx []string) *TableDrift {
+	td := TableDrift{Table: t.Name}
+	want := make(map[string]bool, len(t.Columns))
+	for _, c := range t.Columns {
+		want[c.Name] = true
+		lc, ok := live[c.Name]
+		if !ok {
+			td.MissingColumns = append(td.MissingColumns, c.Name)
+			continue
+		}
+		if expected, ok := columnType(c); ok && !sameType(expected, lc) {
+			td.Columns = append(td.Columns, ColumnDrift{Column: c.Name, Expected: expected.String(), Actual: lc.String()})
+		}
+		if nullable := c.Nullable && !slices.Contains(t.PrimaryKey, c); nullable != lc.nullable {
+			td.Columns = append(td.Columns, ColumnDrift{Column: c.Name, Expected: nullability(nullable), Actual: nullability(lc.nullable)})
+		}
+	}
+	for name := range live {
+		if !want[name] {
+			td.ExtraColumns = append(td.ExtraColumns, name)
+		}
+	}
+	slices.Sort(td.ExtraColumns)
+
+	wantIdx := expectedIndexes(t)
+	for _, name := range wantIdx {
+		if !slices.Contains(liveIdx, name) {
+			td.MissingIndexes = append(td.MissingIndexes, name)
+		}
+	}
+	for _, name := range liveIdx {
+		if !slices.Contains(wantIdx, name) {
+			td.ExtraIndexes = append(td.ExtraIndexes, name)
+		}
+	}
+
+	if len(td.MissingColumns) == 0 && len(td.ExtraColumns) == 0 && len(td.Columns) == 0 &&
+		len(td.MissingIndexes) == 0 && len(td.ExtraIndexes) == 0 {
+		return nil
+	}
+	return &td
+}
+
+// expectedIndexes names the indexes t should have: its primary key, one per
+// unique column and the ones declared in the schema or kept outside Ent
+func expectedIndexes(t *schema.Table) []string {
+	names := []string{t.Name + "_pkey"}
+	for _, c := range t.Columns {
+		if c.Unique && !slices.Contains(t.PrimaryKey, c) {
+			names = append(names, t.Name+"_"+c.Name+"_key")
+		}
+	}
+	for _, idx := range t.Indexes {
+		names = append(names, idx.Name)
+	}
+	for _, idx := range expressionIndexes {
+		if idx.table == t.Name {
+			names = append(names, idx.name)
+		}
+	}
+	return names
+}
+
+// postgresTypes are the data types Ent creates for each field type on Postgres
+var postgresTypes = map[field.Type]string{
+	field.TypeBool:    "boolean",
+	field.TypeBytes:   "bytea",
+	field.TypeEnum:    "character varying",
+	field.TypeFloat64: "double precision",
+	field.TypeInt:     "bigint",
+	field.TypeInt64:   "bigint",
+	field.TypeJSON:    "jsonb",
+	field.TypeString:  "character varying",
+	field.TypeTime:    "timestamp with time zone",
+	field.TypeUUID:    "uuid",
+}
+
+// sizedType matches the char(n) and varchar(n) schema types set on fields
+var sizedType = regexp.MustCompile(`^(char|varchar)\((\d+)\)$`)
+
+// columnType returns the type c should have, or false when it can't be told
+func columnType(c *schema.Column) (liveColumn, bool) {
+	if st, ok := c.SchemaType["postgres"]; ok {
+		m := sizedType.FindStringSubmatch(st)
+		if m == nil {
+			return liveColumn{}, false
+		}
+		n, _ := strconv.Atoi(m[2])
+		if m[1] == "char" {
+			return liveColumn{dataType: "character", length: n}, true
+		}
+		return liveColumn{dataType: "character varying", length: n}, true
+	}
+	t, ok := postgresTypes[c.Type]
+	if !ok {
+		return liveColumn{}, false
+	}
+	lc := liveColumn{dataType: t}
+	if c.Type == field.TypeString {
+		lc.length = int(c.Size)
+	}
+	return lc, true
+}
+
+// sameType reports whether live has the expected type; a length is only
+// compared when one is expected
+func sameType(expected, live liveColumn) bool {
+	return expected.dataType == live.dataType && (expected.length == 0 || expected.length == live.length)
+}
+
+func (c liveColumn) String() string {
+	if c.length > 0 {
+		return fmt.Sprintf("%s(%d)", c.dataType, c.length)
+	}
+	return c.dataType
+}
+
+func nullability(nullable bool) string {
+	if nullable {
+		return "null"
+	}
+	return "not null"
+}
+
+// liveTables returns the tables in the current schema, leaving out partitions
+func liveTables(ctx context.Context, client *ent.Client) (map[string]bool, error) {
+	rows, err := client.QueryContext(ctx, `SELECT c.relname FROM pg_class c
+WHERE c.relnamespace = current_schema()::regnamespace AND c.relkind IN ('r', 'p') AND NOT c.relispartition`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tables := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables[name] = true
+	}
+	return tables, rows.Err()
+}
+
+// liveColumns returns the columns of the current schema's tables by table
+func liveColumns(ctx context.Context, client *ent.Client) (map[string]map[string]liveColumn, error) {
+	rows, err := client.QueryContext(ctx, `SELECT table_name, column_name, data_type, COALESCE(character_maximum_length, 0), is_nullable = 'YES'
+FROM information_schema.columns WHERE table_schema = current_schema()`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns := map[string]map[string]liveColumn{}
+	for rows.Next() {
+		var table, name string
+		var c liveColumn
+		if err := rows.Scan(&table, &name, &c.dataType, &c.length, &c.nullable); err != nil {
+			return nil, err
+		}
+		if columns[table] == nil {
+			columns[table] = map[string]liveColumn{}
+		}
+		columns[table][name] = c
+	}
+	return columns, rows.Err()
+}
+
+// liveIndexes returns the index names of the current schema's tables by table
+func liveIndexes(ctx context.Context, client *ent.Client) (map[string][]string, error) {
+	rows, err := client.QueryContext(ctx, `SELECT tablename, indexname FROM pg_indexes WHERE schemaname = current_schema()`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	indexes := map[string][]string{}
+	for rows.Next() {
+		var table, name string
+		if err := rows.Scan(&table, &name); err != nil {
+			return nil, err
+		}
+		indexes[table] = append(indexes[table], name)
+	}
+	return indexes, rows.Err()
+}
@@ -0,0 +1,273 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"streamify/ent"
+	"streamify/ent/migrate"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// ErrDestructive is returned by Apply when the plan contains destructive changes that were not allowed
+var ErrDestructive = errors.New("migration contains destructive changes")
+
+// expressionIndex is an index Ent cannot declare in the schema, created after auto migration
+type expressionIndex struct {
+	name  string
+	table string
+	expr  string
+}
+
+// expressionIndexes are maintained outside of Ent so that lookups on computed values stay indexed
+var expressionIndexes = []expressionIndex{
+	// Case-insensitive email lookups during login and registration
+	{name: "user_email_lower", table: "users", expr: "lower(email)"},
+}
+
+// Change describes a single planned schema change
+type Change struct {
+	Table       string `json:"table"`
+	Column      string `json:"column,omitempty"`
+	Kind        string `json:"kind"`
+	Detail      string `json:"detail"`
+	Destructive bool   `json:"destructive"`
+}
+
+// Plan is the set of changes needed to bring the live database in line with the Ent schema
+type Plan struct {
+	Changes []Change `json:"changes"`
+	SQL     string   `json:"sql"`
+}
+
+// Destructive returns the changes in the plan that may lose data or fail on existing rows
+func (p *Plan) Destructive() []Change {
+	var out []Change
+	for _, c := range p.Changes {
+		if c.Destructive {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// Diff computes the migration plan without applying it.
+// When drop is true, columns and indexes that are no longer in the schema are planned for removal.
+func Diff(ctx context.Context, client *ent.Client, drop bool) (*Plan, error) {
+	plan := &Plan{Changes: []Change{}}
+	hook := func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+			plan.Changes = classify(changes)
+			return changes, nil
+		})
+	}
+
+	var buf bytes.Buffer
+	err := client.Schema.WriteTo(ctx, &buf,
+		migrate.WithDropColumn(drop),
+		migrate.WithDropIndex(drop),
+		schema.WithDiffHook(keepPartitioning, keepExpressionIndexes, hook),
+	)
+	if err != nil {
+		return nil, err
+	}
+	plan.SQL = buf.String()
+	return plan, nil
+}
+
+// Apply diffs and then migrates the database, refusing destructive changes unless allowDestructive is set.
+// The returned plan is always populated when the diff succeeds, so callers can report what was refused.
+func Apply(ctx context.Context, client *ent.Client, drop, allowDestructive bool) (*Plan, error) {
+	plan, err := Diff(ctx, client, drop)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.Destructive()) > 0 && !allowDestructive {
+		return plan, ErrDestructive
+	}
+	err = client.Schema.Create(ctx,
+		migrate.WithDropColumn(drop),
+		migrate.WithDropIndex(drop),
+		schema.WithDiffHook(keepPartitioning, keepExpressionIndexes),
+	)
+	if err != nil {
+		return plan, err
+	}
+	if err := createExpressionIndexes(ctx, client); err != nil {
+		return plan, err
+	}
+	if err := createMaterializedViews(ctx, client); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// keepExpressionIndexes removes drops of expression indexes from the diff, since Ent does not know about them
+func keepExpressionIndexes(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range changes {
+			mt, ok := ch.(*atlas.ModifyTable)
+			if !ok {
+				continue
+			}
+			kept := mt.Changes[:0]
+			for _, tc := range mt.Changes {
+				if di, ok := tc.(*atlas.DropIndex); ok && isExpressionIndex(di.I.Name) {
+					continue
+				}
+				kept = append(kept, tc)
+			}
+			mt.Changes = kept
+		}
+		return changes, nil
+	})
+}
+
+// createExpressionIndexes creates any missing expression indexes
+func createExpressionIndexes(ctx context.Context, client *ent.Client) error {
+	for _, idx := range expressionIndexes {
+		stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %q ON %q (%s)", idx.name, idx.table, idx.expr)
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("creating index %s: %w", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// isExpressionIndex reports whether name is an index managed by expressionIndexes
+func isExpressionIndex(name string) bool {
+	for _, idx := range expressionIndexes {
+		if idx.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// classify converts atlas changes into a flat list of table and column changes
+func classify(changes []atlas.Change) []Change {
+	out := []Change{}
+	for _, ch := range changes {
+		switch ch := ch.(type) {
+		case *atlas.AddTable:
+			out = append(out, Change{Table: ch.T.Name, Kind: "add_table", Detail: "create table"})
+		case *atlas.DropTable:
+			out = append(out, Change{Table: ch.T.Name, Kind: "drop_table", Detail: "drop table and all of its rows", Destructive: true})
+		case *atlas.ModifyTable:
+			for _, tc := range ch.Changes {
+				out = append(out, classifyTableChange(ch.T.Name, tc))
+			}
+		default:
+			out = append(out, Change{Kind: "other", Detail: fmt.Sprintf("%T", ch)})
+		}
+	}
+	return out
+}
+
+// classifyTableChange classifies a change within a single table
+func classifyTableChange(table string, ch atlas.Change) Change {
+	switch ch := ch.(type) {
+	case *atlas.AddColumn:
+		return Change{Table: table, Column: ch.C.Name, Kind: "add_column", Detail: "add column " + typeName(ch.C)}
+	case *atlas.DropColumn:
+		return Change{Table: table, Column: ch.C.Name, Kind: "drop_column", Detail: "drop column and its data", Destructive: true}
+	case *atlas.ModifyColumn:
+		return classifyModifyColumn(table, ch)
+	case *atlas.AddIndex:
+		return Change{Table: table, Kind: "add_index", Detail: "add index " + ch.I.Name}
+	case *atlas.DropIndex:
+		return Change{Table: table, Kind: "drop_index", Detail: "drop index " + ch.I.Name}
+	case *atlas.AddForeignKey:
+		return Change{Table: table, Kind: "add_foreign_key", Detail: "add foreign key " + ch.F.Symbol}
+	case *atlas.DropForeignKey:
+		return Change{Table: table, Kind: "drop_foreign_key", Detail: "drop foreign key " + ch.F.Symbol}
+	}
+	return Change{Table: table, Kind: "other", Detail: fmt.Sprintf("%T", ch)}
+}
+
+// classifyModifyColumn flags type narrowing and new NOT NULL constraints as destructive
+func classifyModifyColumn(table string, ch *atlas.ModifyColumn) Change {
+	c := Change{Table: table, Column: ch.To.Name, Kind: "modify_column", Detail: "modify column"}
+	if ch.Change.Is(atlas.ChangeType) {
+		from, to := typeName(ch.From), typeName(ch.To)
+		c.Kind = "change_type"
+		c.Detail = fmt.Sprintf("change type from %s to %s", from, to)
+		if narrows(ch.From.Type.Type, ch.To.Type.Type) {
+			c.Kind = "narrow_type"
+			c.Detail = fmt.Sprintf("narrow type from %s to %s; existing values may be truncated or rejected", from, to)
+			c.Destructive = true
+		}
+	}
+	if ch.Change.Is(atlas.ChangeNull) && ch.From.Type.Null && !ch.To.Type.Null {
+		c.Kind = "set_not_null"
+		c.Detail = "add NOT NULL constraint; fails if existing rows contain NULL"
+		c.Destructive = true
+	}
+	return c
+}
+
+// integerRank orders integer types by width
+var integerRank = map[string]int{
+	"smallint": 1, "int2": 1,
+	"integer": 2, "int": 2, "int4": 2,
+	"bigint": 3, "int8": 3,
+}
+
+// narrows reports whether converting from one column type to another can lose data
+func narrows(from, to atlas.Type) bool {
+	switch f := from.(type) {
+	case *atlas.StringType:
+		t, ok := to.(*atlas.StringType)
+		if !ok {
+			return true
+		}
+		// Size 0 means unbounded (text)
+		return t.Size > 0 && (f.Size == 0 || t.Size < f.Size)
+	case *atlas.IntegerType:
+		t, ok := to.(*atlas.IntegerType)
+		if !ok {
+			return true
+		}
+		return integerRank[t.T] < integerRank[f.T]
+	case *atlas.DecimalType:
+		t, ok := to.(*atlas.DecimalType)
+		if !ok {
+			return true
+		}
+		return t.Precision < f.Precision || t.Scale < f.Scale
+	}
+	// Any other cross-type conversion is treated as unsafe
+	return fmt.Sprintf("%T", from) != fmt.Sprintf("%T", to)
+}
+
+// typeName returns a readable type for a column
+func typeName(c *atlas.Column) string {
+	if c.Type == nil {
+		return "unknown"
+	}
+	if c.Type.Raw != "" {
+		return c.Type.Raw
+	}
+	switch t := c.Type.Type.(type) {
+	case *atlas.StringType:
+		if t.Size > 0 {
+			return fmt.Sprintf("%s(%d)", t.T, t.Size)
+		}
+		return t.T
+	case *atlas.IntegerType:
+		return t.T
+	}
+	return fmt.Sprintf("%T", c.Type.Type)
+}
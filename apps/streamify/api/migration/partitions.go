@@ -0,0 +1,265 @@
+package migration
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"streamify/ent"
+
+	"ariga.io/atlas/sql/postgres"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// playsTable is range-partitioned by month of played_at once PartitionPlays has run
+const playsTable = "plays"
+
+// ErrNotPartitioned is returned by partition maintenance when plays is still a plain table
+var ErrNotPartitioned = errors.New("plays is not partitioned; run `streamify migrate partition-plays` first")
+
+// PlayPartition is one monthly partition of the plays table
+type PlayPartition struct {
+	Name  string    `json:"name"`
+	Month time.Time `json:"month"` // first instant of the month, UTC
+	// Attached is false for partitions detached for archiving but not yet dropped
+	Attached bool `json:"attached"`
+}
+
+// partitionName matches the monthly partitions created by this package
+var partitionName = regexp.MustCompile(`^plays_p(\d{4})_(\d{2})$`)
+
+// monthStart truncates t to the first instant of its month in UTC
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// partitionFor returns the name of the partition holding plays from month
+func partitionFor(month time.Time) string {
+	return fmt.Sprintf("plays_p%04d_%02d", month.Year(), int(month.Month()))
+}
+
+// execer is satisfied by both *ent.Client and *ent.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+}
+
+// createPartition creates the partition for month if it does not exist
+func createPartition(ctx context.Context, db execer, month time.Time) error {
+	stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %q PARTITION OF %q FOR VALUES FROM ('%s') TO ('%s')",
+		partitionFor(month), playsTable,
+		month.Format(time.RFC3339), month.AddDate(0, 1, 0).Format(time.RFC3339))
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("creating partition %s: %w", partitionFor(month), err)
+	}
+	return nil
+}
+
+// PlaysPartitioned reports whether plays has been converted to a partitioned table
+func PlaysPartitioned(ctx context.Context, client *ent.Client) (bool, error) {
+	rows, err := client.QueryContext(ctx, `SELECT EXISTS (
+	SELECT 1 FROM pg_partitioned_table pt
+	JOIN pg_class c ON c.oid = pt.partrelid
+	WHERE c.relname = $1 AND c.relnamespace = current_schema()::regnamespace)`, playsTable)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	var ok bool
+	if rows.Next() {
+		if err := rows.Scan(&ok); err != nil {
+			return false, err
+		}
+	}
+	return ok, rows.Err()
+}
+
+// PartitionPlays converts plays into a table range-partitioned by month of played_at,
+// with partitions from the oldest play through ahead months past the current one and a
+// default partition so inserts never fail if maintenance falls behind. Existing rows are
+// copied under an exclusive lock, so run it during a maintenance window; it is a no-op
+// when plays is already partitioned.
+func PartitionPlays(ctx context.Context, client *ent.Client, ahead int) error {
+	partitioned, err := PlaysPartitioned(ctx, client)
+	if err != nil || partitioned {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The views read plays and would keep the old table alive; Apply recreates them
+	for _, v := range materializedViews {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP MATERIALIZED VIEW IF EXISTS %q", v.name)); err != nil {
+			return err
+		}
+	}
+	stmts := []string{
+		`ALTER TABLE plays RENAME TO plays_unpartitioned`,
+		`ALTER TABLE plays_unpartitioned RENAME CONSTRAINT plays_pkey TO plays_unpartitioned_pkey`,
+		// The primary key of a partitioned table must include the partition key
+		`CREATE TABLE plays (LIKE plays_unpartitioned INCLUDING DEFAULTS, PRIMARY KEY (id, played_at)) PARTITION BY RANGE (played_at)`,
+		`CREATE TABLE plays_default PARTITION OF plays DEFAULT`,
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+
+	oldest := time.Now()
+	rows, err := tx.QueryContext(ctx, `SELECT min(played_at) FROM plays_unpartitioned`)
+	if err != nil {
+		return err
+	}
+	var first stdsql.NullTime
+	if rows.Next() {
+		err = rows.Scan(&first)
+	}
+	rows.Close()
+	if err != nil {
+		return err
+	}
+	if first.Valid {
+		oldest = first.Time
+	}
+	last := monthStart(time.Now()).AddDate(0, ahead, 0)
+	for m := monthStart(oldest); !m.After(last); m = m.AddDate(0, 1, 0) {
+		if err := createPartition(ctx, tx, m); err != nil {
+			return err
+		}
+	}
+
+	for _, stmt := range []string{
+		`INSERT INTO plays SELECT * FROM plays_unpartitioned`,
+		`DROP TABLE plays_unpartitioned`,
+	} {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", stmt, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// Recreate the indexes, foreign keys and views that belonged to the old table
+	_, err = Apply(ctx, client, false, false)
+	return err
+}
+
+// EnsurePlayPartitions returns a job that creates the partitions for the current month
+// and the following ahead months, so they exist before the first play lands in them
+func EnsurePlayPartitions(client *ent.Client, ahead int) func(context.Context) error {
+	return func(ctx context.Context) error {
+		partitioned, err := PlaysPartitioned(ctx, client)
+		if err != nil {
+			return err
+		}
+		if !partitioned {
+			return ErrNotPartitioned
+		}
+		start := monthStart(time.Now())
+		for i := 0; i <= ahead; i++ {
+			if err := createPartition(ctx, client, start.AddDate(0, i, 0)); err != nil {
+				// Fails when plays for that month already sit in the default partition
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// PlayPartitions lists the monthly partitions of plays, oldest first, including ones
+// that have been detached but not yet dropped
+func PlayPartitions(ctx context.Context, client *ent.Client) ([]PlayPartition, error) {
+	rows, err := client.QueryContext(ctx, `SELECT c.relname, c.relispartition
+FROM pg_class c
+WHERE c.relkind = 'r' AND c.relnamespace = current_schema()::regnamespace AND c.relname LIKE 'plays\_p%'
+ORDER BY c.relname`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []PlayPartition
+	for rows.Next() {
+		var p PlayPartition
+		if err := rows.Scan(&p.Name, &p.Attached); err != nil {
+			return nil, err
+		}
+		m := partitionName.FindStringSubmatch(p.Name)
+		if m == nil {
+			continue
+		}
+		month, err := time.Parse("2006-01", m[1]+"-"+m[2])
+		if err != nil {
+			continue
+		}
+		p.Month = month
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// DetachPlayPartition removes p from plays so it no longer receives rows or shows in queries
+func DetachPlayPartition(ctx context.Context, client *ent.Client, p PlayPartition) error {
+	if !p.Attached {
+		return nil
+	}
+	_, err := client.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %q DETACH PARTITION %q", playsTable, p.Name))
+	return err
+}
+
+// DropPlayPartition permanently deletes a detached partition and its rows
+func DropPlayPartition(ctx context.Context, client *ent.Client, p PlayPartition) error {
+	if p.Attached {
+		return fmt.Errorf("partition %s is still attached", p.Name)
+	}
+	_, err := client.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %q", p.Name))
+	return err
+}
+
+// keepPartitioning copies the live partition key and primary key of plays onto the desired
+// schema, since Ent cannot declare partitions and every diff would otherwise try to undo them
+func keepPartitioning(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		cur, ok := current.Table(playsTable)
+		if !ok {
+			return next.Diff(current, desired)
+		}
+		want, ok := desired.Table(playsTable)
+		if !ok {
+			return next.Diff(current, desired)
+		}
+		for _, attr := range cur.Attrs {
+			if p, ok := attr.(*postgres.Partition); ok {
+				want.AddAttrs(p)
+				if cur.PrimaryKey != nil {
+					want.PrimaryKey = mirrorIndex(cur.PrimaryKey, want)
+				}
+			}
+		}
+		return next.Diff(current, desired)
+	})
+}
+
+// mirrorIndex rebuilds idx against the columns of t
+func mirrorIndex(idx *atlas.Index, t *atlas.Table) *atlas.Index {
+	out := &atlas.Index{Name: idx.Name, Unique: idx.Unique, Table: t, Attrs: idx.Attrs}
+	for _, p := range idx.Parts {
+		part := &atlas.IndexPart{SeqNo: p.SeqNo, Desc: p.Desc, X: p.X, Attrs: p.Attrs}
+		if p.C != nil {
+			if c, ok := t.Column(p.C.Name); ok {
+				part.C = c
+			}
+		}
+		out.Parts = append(out.Parts, part)
+	}
+	return out
+}
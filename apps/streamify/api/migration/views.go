@@ -0,0 +1,62 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"streamify/ent"
+)
+
+// materializedView is a precomputed aggregate Ent cannot declare, created after auto migration
+type materializedView struct {
+	name  string
+	query string
+	// unique lists the columns of the unique index REFRESH ... CONCURRENTLY requires
+	unique string
+}
+
+// materializedViews summarize the play history so chart and stats reads cost the
+// same no matter how large the plays table grows
+var materializedViews = []materializedView{
+	{
+		// One row per track, day and territory; an empty territory means unknown
+		name: "track_play_daily",
+		query: `SELECT track_id,
+       (played_at AT TIME ZONE 'UTC')::date AS day,
+       COALESCE(territory, '') AS territory,
+       count(*) AS plays
+FROM plays
+GROUP BY 1, 2, 3`,
+		unique: "track_id, day, territory",
+	},
+}
+
+// createMaterializedViews creates any missing materialized views with their indexes.
+// New views are populated immediately; later updates come from RefreshMaterializedViews.
+func createMaterializedViews(ctx context.Context, client *ent.Client) error {
+	for _, v := range materializedViews {
+		stmt := fmt.Sprintf("CREATE MATERIALIZED VIEW IF NOT EXISTS %q AS %s WITH DATA", v.name, v.query)
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("creating materialized view %s: %w", v.name, err)
+		}
+		stmt = fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %q ON %q (%s)", v.name+"_key", v.name, v.unique)
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("creating index on %s: %w", v.name, err)
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedViews returns a job that recomputes every materialized view.
+// Refreshes run concurrently so chart reads are never blocked while they rebuild.
+func RefreshMaterializedViews(client *ent.Client) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for _, v := range materializedViews {
+			stmt := fmt.Sprintf("REFRESH MATERIALIZED VIEW CONCURRENTLY %q", v.name)
+			if _, err := client.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("refreshing %s: %w", v.name, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+// Package notfound remembers for a short while which entities lookups didn't
+// find, so clients requesting random IDs, as scrapers do, are answered without
+// a query each time. Creating or changing an entity forgets it at once on this
+// instance and, for types broadcast by package changes, on the others.
+package notfound
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"streamify/changes"
+	"streamify/ent"
+
+	"github.com/google/uuid"
+)
+
+// TTL is how long a miss is remembered. It bounds how long an entity made
+// visible on another instance without a broadcast change stays hidden.
+const TTL = 30 * time.Second
+
+// maxEntries caps the memory random IDs can take; the cache starts over
+// when it is full
+const maxEntries = 100000
+
+type key struct {
+	typ string
+	id  uuid.UUID
+}
+
+// Cache remembers missing entities by Ent type and ID
+type Cache struct {
+	mu      sync.Mutex
+	entries map[key]time.Time
+}
+
+// New returns an empty cache
+func New() *Cache {
+	return &Cache{entries: map[key]time.Time{}}
+}
+
+// Missing reports whether a lookup of the entity found nothing within TTL
+func (c *Cache) Missing(typ string, id uuid.UUID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	at, ok := c.entries[key{typ, id}]
+	if ok && time.Since(at) >= TTL {
+		delete(c.entries, key{typ, id})
+		return false
+	}
+	return ok
+}
+
+// Add remembers that a lookup of the entity found nothing
+func (c *Cache) Add(typ string, id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxEntries {
+		c.entries = map[key]time.Time{}
+	}
+	c.entries[key{typ, id}] = time.Now()
+}
+
+// Forget drops the given entities of type typ, or all of them when called
+// without IDs
+func (c *Cache) Forget(typ string, ids ...uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(ids) == 0 {
+		for k := range c.entries {
+			if k.typ == typ {
+				delete(c.entries, k)
+			}
+		}
+		return
+	}
+	for _, id := range ids {
+		delete(c.entries, key{typ, id})
+	}
+}
+
+// Hook returns an Ent hook forgetting the entities of the given types that
+// are created or changed through this instance. Bulk mutations forget every
+// entity of their type.
+func (c *Cache) Hook(types ...string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil || !slices.Contains(types, m.Type()) {
+				return v, err
+			}
+			if im, ok := m.(interface{ ID() (uuid.UUID, bool) }); ok {
+				if id, ok := im.ID(); ok {
+					c.Forget(m.Type(), id)
+					return v, nil
+				}
+			}
+			c.Forget(m.Type())
+			return v, nil
+		})
+	}
+}
+
+// Listen forgets entities of the given types changed on other instances
+func (c *Cache) Listen(l *changes.Listener, types ...string) {
+	for _, typ := range types {
+		l.On(typ, func(ids []uuid.UUID) { c.Forget(typ, ids...) })
+	}
+}
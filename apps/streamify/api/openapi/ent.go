@@ -0,0 +1,56 @@
+package openapi
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FromEnt builds the response schema of an entity from its Ent fields.
+// Ent serializes every field with omitempty, so only the ID is required;
+// sensitive fields are never serialized and are left out.
+func FromEnt(fields []ent.Field) *Schema {
+	s := Object(map[string]*Schema{
+		"edges": {Type: "object"},
+	})
+	for _, f := range fields {
+		d := f.Descriptor()
+		if d.Sensitive {
+			continue
+		}
+		p := &Schema{Nullable: d.Nillable}
+		switch d.Info.Type {
+		case field.TypeUUID:
+			p.Type, p.Format = "string", "uuid"
+		case field.TypeString:
+			p.Type = "string"
+			if d.Size > 0 {
+				size := int(d.Size)
+				p.MaxLength = &size
+			}
+		case field.TypeEnum:
+			p.Type = "string"
+			for _, e := range d.Enums {
+				p.Enum = append(p.Enum, e.V)
+			}
+		case field.TypeTime:
+			p.Type, p.Format = "string", "date-time"
+		case field.TypeBool:
+			p.Type = "boolean"
+		case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+			field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+			p.Type = "integer"
+		case field.TypeFloat32, field.TypeFloat64:
+			p.Type = "number"
+		case field.TypeJSON:
+			// Other JSON fields hold arbitrary documents and stay untyped
+			if d.Info.RType != nil && d.Info.RType.Ident == "[]string" {
+				p.Type, p.Items = "array", &Schema{Type: "string"}
+			}
+		}
+		s.Properties[d.Name] = p
+		if d.Name == "id" {
+			s.Required = append(s.Required, "id")
+		}
+	}
+	return s
+}
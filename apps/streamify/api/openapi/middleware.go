@@ -0,0 +1,85 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bodyRecorder tees the response body so it can be checked after the handler runs
+type bodyRecorder struct {
+	gin.ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *bodyRecorder) Write(b []byte) (int, error) {
+	w.buf.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyRecorder) WriteString(s string) (int, error) {
+	w.buf.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Middleware validates JSON request bodies and responses against d. Invalid requests
+// are rejected with 400 and the schema path of every violation; responses that drift
+// from the contract are logged but delivered unchanged. Routes missing from d pass through.
+// Meant for development and staging, since every response is buffered.
+func Middleware(d *Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op := d.lookup(c.Request.Method, c.FullPath())
+		if op == nil {
+			c.Next()
+			return
+		}
+
+		if op.RequestBody != nil {
+			raw, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(raw))
+
+			var body any
+			if err := json.Unmarshal(raw, &body); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "request body is not valid JSON"})
+				return
+			}
+			schema := op.RequestBody.Content["application/json"].Schema
+			if violations := schema.Validate(body); len(violations) > 0 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error":      "request body does not match schema",
+					"violations": violations,
+				})
+				return
+			}
+		}
+
+		rec := &bodyRecorder{ResponseWriter: c.Writer}
+		c.Writer = rec
+		c.Next()
+
+		resp, ok := op.Responses[strconv.Itoa(rec.Status())]
+		if !ok {
+			resp = op.Responses["default"]
+		}
+		if resp == nil || resp.Content == nil || rec.buf.Len() == 0 {
+			return
+		}
+		var body any
+		if err := json.Unmarshal(rec.buf.Bytes(), &body); err != nil {
+			log.Printf("openapi: contract drift on %s %s: response is not JSON", c.Request.Method, c.FullPath())
+			return
+		}
+		for _, v := range resp.Content["application/json"].Schema.Validate(body) {
+			log.Printf("openapi: contract drift on %s %s (%d): %s", c.Request.Method, c.FullPath(), rec.Status(), v)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	uuidType = reflect.TypeOf(uuid.UUID{})
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// SchemaOf derives a schema from a request struct using its json and gin binding
+// tags (required, min, max, len, oneof, email), so the spec cannot drift from what
+// handlers actually bind
+func SchemaOf(v any) *Schema {
+	return schemaOfType(reflect.TypeOf(v), "")
+}
+
+func schemaOfType(t reflect.Type, binding string) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	var s *Schema
+	switch {
+	case t == uuidType:
+		s = &Schema{Type: "string", Format: "uuid"}
+	case t == timeType:
+		s = &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct:
+		s = structSchema(t)
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		s = ArrayOf(schemaOfType(t.Elem(), ""))
+	case t.Kind() == reflect.Map:
+		s = &Schema{Type: "object"}
+	case t.Kind() == reflect.String:
+		s = &Schema{Type: "string"}
+	case t.Kind() == reflect.Bool:
+		s = &Schema{Type: "boolean"}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		s = &Schema{Type: "integer"}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		s = &Schema{Type: "number"}
+	default:
+		s = &Schema{}
+	}
+	s.Nullable = nullable
+	applyBinding(s, binding)
+	return s
+}
+
+func structSchema(t reflect.Type) *Schema {
+	s := Object(map[string]*Schema{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		binding := f.Tag.Get("binding")
+		s.Properties[name] = schemaOfType(f.Type, binding)
+		for _, rule := range strings.Split(binding, ",") {
+			if rule == "required" {
+				s.Required = append(s.Required, name)
+			}
+		}
+	}
+	return s
+}
+
+// applyBinding maps the validator rules the API uses onto schema constraints
+func applyBinding(s *Schema, binding string) {
+	for _, rule := range strings.Split(binding, ",") {
+		key, arg, _ := strings.Cut(rule, "=")
+		switch key {
+		case "email":
+			s.Format = "email"
+		case "oneof":
+			s.Enum = strings.Fields(arg)
+		case "len":
+			n, err := strconv.Atoi(arg)
+			if err == nil && s.Type == "string" {
+				s.MinLength, s.MaxLength = &n, &n
+			}
+		case "min", "max":
+			n, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				continue
+			}
+			i := int(n)
+			switch {
+			case s.Type == "string" && key == "min":
+				s.MinLength = &i
+			case s.Type == "string":
+				s.MaxLength = &i
+			case s.Type == "array" && key == "min":
+				s.MinItems = &i
+			case s.Type == "array":
+				s.MaxItems = &i
+			case key == "min":
+				s.Minimum = &n
+			default:
+				s.Maximum = &n
+			}
+		}
+	}
+}
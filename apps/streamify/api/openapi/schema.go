@@ -0,0 +1,213 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Schema is the subset of the OpenAPI 3.0 schema object this API uses
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+}
+
+// Violation is a single schema mismatch, located by a JSON pointer into the payload
+type Violation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// Object returns an object schema with the given properties
+func Object(props map[string]*Schema, required ...string) *Schema {
+	return &Schema{Type: "object", Properties: props, Required: required}
+}
+
+// ArrayOf returns an array schema whose items match s
+func ArrayOf(s *Schema) *Schema {
+	return &Schema{Type: "array", Items: s}
+}
+
+var emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// Validate checks a decoded JSON value (as produced by encoding/json into any)
+// against s and returns every violation found
+func (s *Schema) Validate(v any) []Violation {
+	var out []Violation
+	s.validate("", v, &out)
+	return out
+}
+
+func (s *Schema) validate(path string, v any, out *[]Violation) {
+	if s == nil {
+		return
+	}
+	fail := func(format string, args ...any) {
+		p := path
+		if p == "" {
+			p = "/"
+		}
+		*out = append(*out, Violation{Path: p, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if v == nil {
+		if !s.Nullable && s.Type != "" {
+			fail("expected %s, got null", s.Type)
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			fail("expected object, got %s", jsonType(v))
+			return
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				fail("missing required property %q", name)
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			prop, ok := s.Properties[k]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					*out = append(*out, Violation{Path: path + "/" + escape(k), Message: "unknown property"})
+				}
+				continue
+			}
+			prop.validate(path+"/"+escape(k), obj[k], out)
+		}
+	case "array":
+		arr, ok := v.([]any)
+		if !ok {
+			fail("expected array, got %s", jsonType(v))
+			return
+		}
+		if s.MinItems != nil && len(arr) < *s.MinItems {
+			fail("expected at least %d items, got %d", *s.MinItems, len(arr))
+		}
+		if s.MaxItems != nil && len(arr) > *s.MaxItems {
+			fail("expected at most %d items, got %d", *s.MaxItems, len(arr))
+		}
+		for i, item := range arr {
+			s.Items.validate(fmt.Sprintf("%s/%d", path, i), item, out)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			fail("expected string, got %s", jsonType(v))
+			return
+		}
+		n := len([]rune(str))
+		if s.MinLength != nil && n < *s.MinLength {
+			fail("expected at least %d characters, got %d", *s.MinLength, n)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			fail("expected at most %d characters, got %d", *s.MaxLength, n)
+		}
+		if len(s.Enum) > 0 && !contains(s.Enum, str) {
+			fail("expected one of [%s], got %q", strings.Join(s.Enum, ", "), str)
+		}
+		switch s.Format {
+		case "uuid":
+			if _, err := uuid.Parse(str); err != nil {
+				fail("expected uuid, got %q", str)
+			}
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+				fail("expected RFC 3339 date-time, got %q", str)
+			}
+		case "email":
+			if !emailPattern.MatchString(str) {
+				fail("expected email address, got %q", str)
+			}
+		}
+	case "integer", "number":
+		num, ok := v.(float64)
+		if !ok {
+			if n, isNum := v.(json.Number); isNum {
+				f, err := n.Float64()
+				num, ok = f, err == nil
+			}
+		}
+		if !ok {
+			fail("expected %s, got %s", s.Type, jsonType(v))
+			return
+		}
+		if s.Type == "integer" && num != math.Trunc(num) {
+			fail("expected integer, got %v", num)
+		}
+		if s.Minimum != nil && num < *s.Minimum {
+			fail("expected >= %v, got %v", *s.Minimum, num)
+		}
+		if s.Maximum != nil && num > *s.Maximum {
+			fail("expected <= %v, got %v", *s.Maximum, num)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			fail("expected boolean, got %s", jsonType(v))
+		}
+	}
+}
+
+// escape encodes a property name as a JSON pointer token (RFC 6901)
+func escape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64, json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
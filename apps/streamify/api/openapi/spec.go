@@ -0,0 +1,123 @@
+package openapi
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Document is an OpenAPI 3.0 document
+type Document struct {
+	OpenAPI string                           `json:"openapi"`
+	Info    Info                             `json:"info"`
+	Paths   map[string]map[string]*Operation `json:"paths"`
+
+	// operations indexes operations by gin method and route pattern for the middleware
+	operations map[string]*Operation
+}
+
+// Info is the OpenAPI info object
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Operation is an OpenAPI operation object
+type Operation struct {
+	Summary     string               `json:"summary,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is an OpenAPI path parameter
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is an OpenAPI request body object
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is an OpenAPI response object
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is an OpenAPI media type object
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// errorSchema is the shape of every error response
+var errorSchema = Object(map[string]*Schema{"error": {Type: "string"}}, "error")
+
+// NewDocument returns an empty document
+func NewDocument(title, version string) *Document {
+	return &Document{
+		OpenAPI:    "3.0.3",
+		Info:       Info{Title: title, Version: version},
+		Paths:      map[string]map[string]*Operation{},
+		operations: map[string]*Operation{},
+	}
+}
+
+var ginParam = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// Add registers an operation for a gin route pattern. body and response may be nil
+// when the payload isn't described; success is the status returned on success.
+func (d *Document) Add(method, route, summary string, body *Schema, success int, response *Schema) {
+	op := &Operation{
+		Summary: summary,
+		Responses: map[string]*Response{
+			"default": {
+				Description: "Error",
+				Content:     map[string]MediaType{"application/json": {Schema: errorSchema}},
+			},
+		},
+	}
+	for _, m := range ginParam.FindAllStringSubmatch(route, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name: m[1], In: "path", Required: true, Schema: &Schema{Type: "string"},
+		})
+	}
+	if body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: body}},
+		}
+	}
+	resp := &Response{Description: http.StatusText(success)}
+	if response != nil {
+		resp.Content = map[string]MediaType{"application/json": {Schema: response}}
+	}
+	op.Responses[strconv.Itoa(success)] = resp
+
+	path := ginParam.ReplaceAllString(route, "{$1}")
+	if d.Paths[path] == nil {
+		d.Paths[path] = map[string]*Operation{}
+	}
+	d.Paths[path][strings.ToLower(method)] = op
+	d.operations[method+" "+route] = op
+}
+
+// lookup finds the operation for a gin method and route pattern (c.FullPath())
+func (d *Document) lookup(method, route string) *Operation {
+	return d.operations[method+" "+route]
+}
+
+// Serve returns the document as JSON
+func Serve(d *Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, d)
+	}
+}
@@ -0,0 +1,323 @@
+// Package operations runs long tasks started by a request in the background.
+// The request gets 202 Accepted with an operation resource instead of waiting
+// minutes; the caller polls it for progress and partial results and may
+// cancel it. Operations are stored, so any instance can answer for one; the
+// instance running it writes its progress as a heartbeat and picks up cancel
+// requests made elsewhere.
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/operation"
+	"streamify/ids"
+	"streamify/jobs"
+	"streamify/logging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("operations")
+
+const (
+	// heartbeat is how often a running operation saves its progress and
+	// checks for cancel requests
+	heartbeat = 2 * time.Second
+	// staleAfter is how long a running operation may go without a heartbeat
+	// before it is taken to have died with its instance
+	staleAfter = 2 * time.Minute
+	// retention is how long finished operations are kept
+	retention = 7 * 24 * time.Hour
+)
+
+// ErrFinished is returned when cancelling an operation that already ended
+var ErrFinished = errors.New("operation already finished")
+
+// Func is the work of an operation. It reports progress through p and should
+// stop, returning ctx.Err(), between units of work once ctx is cancelled.
+// Its result is stored as the operation's final result.
+type Func func(ctx context.Context, p *Progress) (any, error)
+
+// Progress collects a running operation's progress for the next heartbeat
+type Progress struct {
+	mu      sync.Mutex
+	done    int
+	total   int
+	partial any
+	changed bool
+}
+
+// Set records that done of total units are finished (total 0 when unknown)
+// and the results so far
+func (p *Progress) Set(done, total int, partial any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done, p.total, p.partial, p.changed = done, total, partial, true
+}
+
+// take returns the progress and whether it changed since the last take
+func (p *Progress) take() (int, int, any, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	changed := p.changed
+	p.changed = false
+	return p.done, p.total, p.partial, changed
+}
+
+// Manager starts operations and cancels the ones running on this instance
+type Manager struct {
+	client  *ent.Client
+	mu      sync.Mutex
+	running map[uuid.UUID]context.CancelFunc
+}
+
+// NewManager returns a Manager storing operations in client
+func NewManager(client *ent.Client) *Manager {
+	return &Manager{client: client, running: map[uuid.UUID]context.CancelFunc{}}
+}
+
+// Start records an operation of kind owned by the viewer and runs fn in the
+// background. fn keeps ctx's values, such as the viewer, but not its
+// cancellation, so it outlives the request.
+func (m *Manager) Start(ctx context.Context, kind string, fn Func) (*ent.Operation, error) {
+	userID, ok := viewer.UserID(ctx)
+	if !ok {
+		return nil, errors.New("operations: no viewer to own the operation")
+	}
+	op, err := m.client.Operation.Create().
+		SetKind(kind).
+		SetOwnerID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("operations: recording %s: %w", kind, err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	m.mu.Lock()
+	m.running[op.ID] = cancel
+	m.mu.Unlock()
+	go m.run(runCtx, cancel, op, fn)
+	return op, nil
+}
+
+// run executes fn, saving its progress every heartbeat until it returns
+func (m *Manager) run(ctx context.Context, cancel context.CancelFunc, op *ent.Operation, fn Func) {
+	defer func() {
+		m.mu.Lock()
+		delete(m.running, op.ID)
+		m.mu.Unlock()
+		cancel()
+	}()
+	// Writes keep going after the operation itself is cancelled
+	store := context.WithoutCancel(ctx)
+
+	p := &Progress{}
+	finished := make(chan struct{})
+	var (
+		result any
+		err    error
+	)
+	go func() {
+		defer close(finished)
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("operation panicked: %v", r)
+			}
+		}()
+		result, err = fn(ctx, p)
+	}()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-finished:
+			m.finish(store, op, p, result, err)
+			return
+		case <-ticker.C:
+			if m.beat(store, op.ID, p) {
+				cancel()
+			}
+		}
+	}
+}
+
+// beat saves progress and reports whether cancellation was requested
+func (m *Manager) beat(ctx context.Context, id uuid.UUID, p *Progress) bool {
+	update := m.client.Operation.UpdateOneID(id)
+	if done, total, partial, changed := p.take(); changed {
+		update = update.SetDone(done).SetTotal(total)
+		if data, err := marshal(partial); err == nil {
+			update = update.SetResult(data)
+		}
+	}
+	op, err := update.Save(ctx)
+	if err != nil {
+		logger.Warn("saving operation progress failed", "operation_id", id, "error", err)
+		return false
+	}
+	return op.CancelRequested
+}
+
+// finish records how the operation ended
+func (m *Manager) finish(ctx context.Context, op *ent.Operation, p *Progress, result any, runErr error) {
+	done, total, partial, _ := p.take()
+	if result == nil {
+		result = partial
+	}
+	update := m.client.Operation.UpdateOneID(op.ID).
+		SetDone(done).
+		SetTotal(total).
+		SetFinishedAt(time.Now())
+	if data, err := marshal(result); err == nil {
+		update = update.SetResult(data)
+	}
+
+	cur, err := m.client.Operation.Get(ctx, op.ID)
+	cancelled := err == nil && cur.CancelRequested && runErr != nil && errors.Is(runErr, context.Canceled)
+	switch {
+	case cancelled:
+		update = update.SetStatus(operation.StatusCancelled)
+	case runErr != nil:
+		update = update.SetStatus(operation.StatusFailed).SetError(runErr.Error())
+	default:
+		update = update.SetStatus(operation.StatusSucceeded)
+	}
+	if err := update.Exec(ctx); err != nil {
+		logger.Error("recording operation end failed", "operation_id", op.ID, "error", err)
+		return
+	}
+	if runErr != nil && !cancelled {
+		logger.Warn("operation failed", "operation_id", op.ID, "kind", op.Kind, "error", runErr)
+		return
+	}
+	logger.Info("operation ended", "operation_id", op.ID, "kind", op.Kind, "cancelled", cancelled)
+}
+
+func marshal(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, errors.New("no result")
+	}
+	return json.Marshal(v)
+}
+
+// Cancel asks operation id to stop. It stops at once when running on this
+// instance, otherwise at its runner's next heartbeat.
+func (m *Manager) Cancel(ctx context.Context, id uuid.UUID) (*ent.Operation, error) {
+	n, err := m.client.Operation.Update().
+		Where(operation.IDEQ(id), operation.StatusEQ(operation.StatusRunning)).
+		SetCancelRequested(true).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	op, err := m.client.Operation.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return op, ErrFinished
+	}
+	m.mu.Lock()
+	if cancel, ok := m.running[id]; ok {
+		cancel()
+	}
+	m.mu.Unlock()
+	return op, nil
+}
+
+// Reap returns a job failing operations whose instance stopped sending
+// heartbeats, e.g. because it was restarted, and deleting finished
+// operations older than a week
+func Reap(client *ent.Client) jobs.Func {
+	return func(ctx context.Context) error {
+		now := time.Now()
+		n, err := client.Operation.Update().
+			Where(operation.StatusEQ(operation.StatusRunning), operation.UpdatedAtLT(now.Add(-staleAfter))).
+			SetStatus(operation.StatusFailed).
+			SetError("interrupted: the instance running it stopped").
+			SetFinishedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Warn("failed interrupted operations", "count", n)
+		}
+		_, err = client.Operation.Delete().
+			Where(operation.StatusNEQ(operation.StatusRunning), operation.FinishedAtLT(now.Add(-retention))).
+			Exec(ctx)
+		return err
+	}
+}
+
+// Accepted answers a request that started op with 202 and its location
+func Accepted(c *gin.Context, op *ent.Operation) {
+	c.Header("Location", "/api/v1/operations/"+op.ID.String())
+	c.JSON(http.StatusAccepted, op)
+}
+
+// visible loads an operation the viewer started, or any for admins
+func visible(c *gin.Context, client *ent.Client) (*ent.Operation, bool) {
+	id, err := ids.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid operation ID"})
+		return nil, false
+	}
+	op, err := client.Operation.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "operation not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	v := viewer.FromContext(c.Request.Context())
+	if !v.Is(op.OwnerID) && !v.IsAdmin() {
+		c.JSON(http.StatusNotFound, gin.H{"error": "operation not found"})
+		return nil, false
+	}
+	return op, true
+}
+
+// Get returns an operation with its progress and results so far
+func Get(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := visible(c, client)
+		if !ok {
+			return
+		}
+		c.JSON(http.StatusOK, op)
+	}
+}
+
+// CancelOperation requests that a running operation stop. Work already done,
+// such as committed batches, is kept and reported in its results.
+func CancelOperation(m *Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := visible(c, m.client)
+		if !ok {
+			return
+		}
+		op, err := m.Cancel(c.Request.Context(), op.ID)
+		if err != nil {
+			if errors.Is(err, ErrFinished) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error(), "operation": op})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusAccepted, op)
+	}
+}
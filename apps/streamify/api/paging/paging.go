@@ -0,0 +1,101 @@
+// Package paging pages list endpoints by ?limit= and ?offset=. Each page
+// reports the total number of items and links to the pages either side, so
+// clients can walk a list without working out offsets themselves.
+package paging
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// DefaultLimit is the page size when ?limit= is left out
+	DefaultLimit = 50
+	// MaxLimit is the largest page ?limit= may ask for
+	MaxLimit = 100
+)
+
+// Params is the page a request asked for
+type Params struct {
+	Limit  int
+	Offset int
+}
+
+// Parse reads ?limit= and ?offset=, writing a 400 response and returning
+// ok=false when one isn't valid
+func Parse(c *gin.Context) (p Params, ok bool) {
+	p.Limit = DefaultLimit
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and " + strconv.Itoa(MaxLimit)})
+			return p, false
+		}
+		p.Limit = n
+	}
+	if v := c.Query("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "offset must be a non-negative integer"})
+			return p, false
+		}
+		p.Offset = n
+	}
+	return p, true
+}
+
+// Counter counts the items of a list; every Ent query is one
+type Counter interface {
+	Count(ctx context.Context) (int, error)
+}
+
+// Total returns how many items the list has that a page of n items was
+// taken from. A page with room to spare ends the list, so the list is only
+// counted with all, a copy of its query made before paging, when the page
+// is full or past the end.
+func Total(ctx context.Context, p Params, n int, all Counter) (int, error) {
+	if n > 0 && n < p.Limit || n == 0 && p.Offset == 0 {
+		return p.Offset + n, nil
+	}
+	return all.Count(ctx)
+}
+
+// Page is one page of a list
+type Page[T any] struct {
+	Items  []T `json:"items"`
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	// Next and Prev link to the following and preceding pages with the
+	// request's other query parameters kept; null at either end
+	Next *string `json:"next"`
+	Prev *string `json:"prev"`
+}
+
+// New returns the page of items p asked for out of total
+func New[T any](c *gin.Context, p Params, items []T, total int) *Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	page := &Page[T]{Items: items, Total: total, Limit: p.Limit, Offset: p.Offset}
+	if next := p.Offset + p.Limit; next < total {
+		u := link(c, p.Limit, next)
+		page.Next = &u
+	}
+	if p.Offset > 0 {
+		u := link(c, p.Limit, max(p.Offset-p.Limit, 0))
+		page.Prev = &u
+	}
+	return page
+}
+
+// link is the request's path and query with the page replaced
+func link(c *gin.Context, limit, offset int) string {
+	q := c.Request.URL.Query()
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+	return c.Request.URL.Path + "?" + q.Encode()
+}
@@ -0,0 +1,174 @@
+// Package playback records how far plays get from the heartbeats clients send
+// while a track plays. Heartbeats arrive every few seconds from every
+// listener, so instead of an UPDATE each, an instance keeps the furthest
+// position per play in memory and writes them in batches. Updates only ever
+// move a play forward, so heartbeats for one play may reach any instance in
+// any order.
+package playback
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"streamify/ent"
+	"streamify/ids"
+	"streamify/logging"
+	"streamify/residency"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var logger = logging.For("playback")
+
+const (
+	// FlushInterval is how often buffered positions are written
+	FlushInterval = 5 * time.Second
+	// maxPending bounds the plays buffered between flushes; heartbeats for
+	// new plays are dropped beyond it until the next flush succeeds
+	maxPending = 100000
+	// flushBatch is how many plays one UPDATE statement writes
+	flushBatch = 1000
+	// maxPlayAge is how long after it started a play still takes heartbeats.
+	// It also lets Postgres skip all but the newest partitions of plays.
+	maxPlayAge = 24 * time.Hour
+)
+
+type beat struct {
+	userID uuid.UUID
+	// region is where the play is kept, the one its heartbeat came from
+	region     string
+	positionMs int
+}
+
+// Aggregator buffers heartbeats until they are flushed
+type Aggregator struct {
+	client *ent.Client
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]beat
+}
+
+// NewAggregator returns an Aggregator writing to client
+func NewAggregator(client *ent.Client) *Aggregator {
+	return &Aggregator{client: client, pending: map[uuid.UUID]beat{}}
+}
+
+// Record notes that userID's play, kept in region, got to positionMs, keeping
+// the furthest position seen. It reports false when the buffer is full.
+func (a *Aggregator) Record(playID, userID uuid.UUID, region string, positionMs int) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.merge(playID, beat{userID: userID, region: region, positionMs: positionMs})
+}
+
+func (a *Aggregator) merge(playID uuid.UUID, b beat) bool {
+	cur, ok := a.pending[playID]
+	if !ok && len(a.pending) >= maxPending {
+		return false
+	}
+	// A play's owner never changes; a heartbeat naming someone else won't
+	// match the play at flush time either way
+	if !ok || b.positionMs > cur.positionMs {
+		a.pending[playID] = b
+	}
+	return true
+}
+
+// Flush writes the buffered positions. Plays that don't exist, belong to
+// another user, are too old or are already further along are left as they
+// are. On failure the positions go back in the buffer for the next flush.
+func (a *Aggregator) Flush(ctx context.Context) error {
+	a.mu.Lock()
+	batch := a.pending
+	a.pending = map[uuid.UUID]beat{}
+	a.mu.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+
+	// Each region's plays are written to its own database
+	byRegion := map[string][]uuid.UUID{}
+	for id, b := range batch {
+		byRegion[b.region] = append(byRegion[b.region], id)
+	}
+	plays := len(batch)
+	var written int64
+	for region, ids := range byRegion {
+		for chunk := range slices.Chunk(ids, flushBatch) {
+			n, err := a.write(residency.NewContext(ctx, region), chunk, batch)
+			if err != nil {
+				a.mu.Lock()
+				for id, b := range batch {
+					a.merge(id, b)
+				}
+				a.mu.Unlock()
+				return err
+			}
+			written += n
+			for _, id := range chunk {
+				delete(batch, id)
+			}
+		}
+	}
+	logger.Debug("play progress flushed", "plays", plays, "updated", written)
+	return nil
+}
+
+func (a *Aggregator) write(ctx context.Context, ids []uuid.UUID, batch map[uuid.UUID]beat) (int64, error) {
+	playIDs := make([]string, len(ids))
+	userIDs := make([]string, len(ids))
+	positions := make([]int64, len(ids))
+	for i, id := range ids {
+		playIDs[i] = id.String()
+		userIDs[i] = batch[id].userID.String()
+		positions[i] = int64(batch[id].positionMs)
+	}
+	res, err := a.client.ExecContext(ctx, `UPDATE plays AS p
+SET progress_ms = b.progress_ms, progress_at = now()
+FROM unnest($1::uuid[], $2::uuid[], $3::bigint[]) AS b(id, user_id, progress_ms)
+WHERE p.id = b.id AND p.user_id = b.user_id AND p.played_at >= $4 AND p.progress_ms < b.progress_ms`,
+		pq.Array(playIDs), pq.Array(userIDs), pq.Array(positions), time.Now().Add(-maxPlayAge))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// HeartbeatRequest is the request body for Heartbeat
+type HeartbeatRequest struct {
+	PositionMs *int `json:"position_ms" binding:"required,min=0"`
+}
+
+// Heartbeat records the caller's position in one of their plays. It answers
+// 202 before anything is written; positions are saved within FlushInterval.
+func Heartbeat(a *Aggregator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		playID, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid play ID"})
+			return
+		}
+		var body HeartbeatRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		if !a.Record(playID, userID, residency.FromContext(c.Request.Context()), *body.PositionMs) {
+			c.Header("Retry-After", "5")
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "too many heartbeats pending; try again shortly"})
+			return
+		}
+		c.Status(http.StatusAccepted)
+	}
+}
@@ -0,0 +1,120 @@
+// Package playcounts keeps how often each user played each track per UTC
+// day, which their personal top lists sum over a period. Plays created
+// through Ent are counted by Hook; the ingest buffer, which inserts plays
+// with its own SQL, counts the plays it wrote with a Tally in the same
+// transaction.
+package playcounts
+
+import (
+	"context"
+	stdsql "database/sql"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/hook"
+	"streamify/ids"
+	"streamify/logging"
+	"streamify/savepoint"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var logger = logging.For("playcounts")
+
+// Execer runs statements; *ent.Client, *ent.Tx and mutations all do
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+}
+
+// key is a user's plays of a track on a day
+type key struct {
+	user, track uuid.UUID
+	day         string
+}
+
+// Tally adds up plays to count them with one statement
+type Tally map[key]int64
+
+// Add counts a play of trackID by userID that started at playedAt
+func (t Tally) Add(userID, trackID uuid.UUID, playedAt time.Time) {
+	t[key{user: userID, track: trackID, day: playedAt.UTC().Format(time.DateOnly)}]++
+}
+
+// addCounts adds each row's plays to the count for its user, track and day,
+// creating the counts that don't exist yet
+const addCounts = `INSERT INTO play_counts (id, user_id, track_id, day, plays)
+SELECT * FROM unnest($1::uuid[], $2::uuid[], $3::uuid[], $4::date[], $5::bigint[])
+ON CONFLICT (user_id, day, track_id) DO UPDATE SET plays = play_counts.plays + EXCLUDED.plays`
+
+// Write adds the tallied plays to the stored counts
+func (t Tally) Write(ctx context.Context, ex Execer) error {
+	if len(t) == 0 {
+		return nil
+	}
+	var rowIDs, users, tracks, days []string
+	var plays []int64
+	for k, n := range t {
+		rowIDs = append(rowIDs, ids.New().String())
+		users = append(users, k.user.String())
+		tracks = append(tracks, k.track.String())
+		days = append(days, k.day)
+		plays = append(plays, n)
+	}
+	_, err := ex.ExecContext(ctx, addCounts,
+		pq.Array(rowIDs), pq.Array(users), pq.Array(tracks), pq.Array(days), pq.Array(plays))
+	return err
+}
+
+// Hook counts plays created through Ent. The count is added through the
+// play's own connection, so inside a transaction it commits with the play.
+// A failed count is logged rather than failing the play; inside a
+// transaction it is rolled back to a savepoint, so the caller's transaction
+// carries on without it.
+func Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.PlayFunc(func(ctx context.Context, m *ent.PlayMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			userID, _ := m.UserID()
+			trackID, _ := m.TrackID()
+			playedAt, _ := m.PlayedAt()
+			t := Tally{}
+			t.Add(userID, trackID, playedAt)
+			if err := savepoint.Run(ctx, m, "play_count", func() error { return t.Write(ctx, m) }); err != nil {
+				logger.Warn("counting play failed", "user", userID, "track", trackID, "error", err)
+			}
+			return v, nil
+		})
+	}, ent.OpCreate)
+}
+
+// Rebuild recounts every user's plays from the plays table, for the plays
+// written before counts were kept. Counts of plays archived since are lost.
+// Writers adding to the counts wait until the rebuild commits.
+func Rebuild(ctx context.Context, client *ent.Client) (err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	for _, stmt := range []string{
+		`LOCK TABLE play_counts IN EXCLUSIVE MODE`,
+		`DELETE FROM play_counts`,
+		`INSERT INTO play_counts (id, user_id, track_id, day, plays)
+SELECT gen_random_uuid(), user_id, track_id, (played_at AT TIME ZONE 'UTC')::date, count(*)
+FROM plays
+GROUP BY 2, 3, 4`,
+	} {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
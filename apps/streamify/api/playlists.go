@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"streamify/anomaly"
+	"streamify/ent"
+	"streamify/ent/playlist"
+	"streamify/ent/track"
+	"streamify/events"
+	"streamify/ids"
+	"streamify/librarysync"
+	"streamify/loader"
+	"streamify/privacy"
+	"streamify/social"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// canViewPlaylist reports whether v may see p. Owners and admins see every playlist;
+// everyone else needs the playlist to be public, the owner's playlists to be visible and
+// no block between them. p must have its owner edge loaded.
+func canViewPlaylist(ctx context.Context, client *ent.Client, v *viewer.Viewer, p *ent.Playlist) (bool, error) {
+	if !v.Is(p.OwnerID) && !p.Public && !v.IsAdmin() {
+		return false, nil
+	}
+	return social.CanView(ctx, client, v, p.Edges.Owner, privacy.SectionPlaylists)
+}
+
+// sortPlaylistTracks puts p's loaded tracks in playlist order
+func sortPlaylistTracks(p *ent.Playlist) {
+	tracks := p.Edges.Tracks
+	ids := make([]uuid.UUID, len(tracks))
+	for i, t := range tracks {
+		ids[i] = t.ID
+	}
+	pos := make(map[uuid.UUID]int, len(tracks))
+	for i, id := range librarysync.Order(p.TrackOrder, ids) {
+		pos[id] = i
+	}
+	slices.SortFunc(tracks, func(a, b *ent.Track) int { return pos[a.ID] - pos[b.ID] })
+}
+
+// createPlaylistRequest is the request body for createPlaylist
+type createPlaylistRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Public *bool  `json:"public"`
+}
+
+// createPlaylist creates a playlist owned by the authenticated user
+func createPlaylist(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body createPlaylistRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		create := client.Playlist.Create().
+			SetOwnerID(userID).
+			SetName(body.Name)
+		if body.Public != nil {
+			create = create.SetPublic(*body.Public)
+		}
+
+		p, err := create.Save(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		events.Emit(c.Request.Context(), events.PlaylistCreated{PlaylistID: p.ID, OwnerID: userID, Public: p.Public})
+		c.JSON(http.StatusCreated, p)
+	}
+}
+
+// getPlaylistByID returns a playlist with its tracks if the viewer is allowed to see it
+func getPlaylistByID(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid playlist ID"})
+			return
+		}
+		inc, err := parseIncludes(c, "tracks.album", "tracks.album.artist")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		v := viewer.FromContext(c.Request.Context())
+
+		p, err := client.Playlist.Query().
+			Where(playlist.IDEQ(id)).
+			WithOwner().
+			WithTracks(func(q *ent.TrackQuery) {
+				q.Where(track.DeletedAtIsNil())
+			}).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		allowed, err := canViewPlaylist(c.Request.Context(), client, v, p)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !allowed {
+			// Hidden playlists are indistinguishable from missing ones
+			c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+			return
+		}
+
+		p.Edges.Owner = nil
+		sortPlaylistTracks(p)
+		if err := expandTracks(c.Request.Context(), loader.For(c.Request.Context(), client), p.Edges.Tracks, inc.under("tracks")); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, p)
+	}
+}
+
+// addPlaylistTrackRequest is the request body for addPlaylistTrack
+type addPlaylistTrackRequest struct {
+	TrackID string `json:"track_id" binding:"required"`
+}
+
+// addPlaylistTrack appends a track to a playlist owned by the authenticated user
+func addPlaylistTrack(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid playlist ID"})
+			return
+		}
+
+		var body addPlaylistTrackRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		trackID, err := uuid.Parse(body.TrackID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track_id format"})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		p, err := client.Playlist.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if p.OwnerID != userID {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only the owner can modify this playlist"})
+			return
+		}
+
+		// Verify track exists
+		exists, err := client.Track.Query().
+			Where(track.IDEQ(trackID), track.DeletedAtIsNil()).
+			Exist(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !exists {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "track not found"})
+			return
+		}
+
+		members, err := p.QueryTracks().IDs(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		order, clock := librarysync.AddTrack(p, members, trackID, time.Now().UTC())
+
+		p, err = p.Update().
+			AddTrackIDs(trackID).
+			SetTrackOrder(order).
+			SetClock(clock).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "track already in playlist"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, p)
+	}
+}
+
+// deletePlaylist deletes a playlist owned by the authenticated user
+func deletePlaylist(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid playlist ID"})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		p, err := client.Playlist.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if p.OwnerID != userID {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only the owner can delete this playlist"})
+			return
+		}
+
+		if err := client.Playlist.DeleteOne(p).Exec(c.Request.Context()); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		anomaly.Observe(c, anomaly.PlaylistDeleted, userID)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// getUserPlaylists returns a user's playlists visible to the viewer
+func getUserPlaylists(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+
+		v := viewer.FromContext(c.Request.Context())
+
+		owner, err := client.User.Get(c.Request.Context(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		allowed, err := social.CanView(c.Request.Context(), client, v, owner, privacy.SectionPlaylists)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, gin.H{"error": "playlists are private"})
+			return
+		}
+
+		query := client.Playlist.Query().
+			Where(playlist.OwnerIDEQ(id)).
+			Order(ent.Desc(playlist.FieldCreatedAt))
+		if !v.Is(id) && !v.IsAdmin() {
+			query = query.Where(playlist.Public(true))
+		}
+
+		playlists, err := query.All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, playlists)
+	}
+}
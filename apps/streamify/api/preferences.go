@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"streamify/ent"
+	"streamify/preferences"
+	"streamify/residency"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getPreferences returns the authenticated user's preferences with defaults applied
+func getPreferences(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		u, err := client.User.Get(c.Request.Context(), userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, u.Preferences.WithDefaults())
+	}
+}
+
+// updatePreferences merges the request body into the authenticated user's preferences
+func updatePreferences(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		var patch map[string]json.RawMessage
+		if err := c.ShouldBindJSON(&patch); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "body must be a JSON object"})
+			return
+		}
+
+		// Preferences are kept on the user, in the home database
+		tx, err := client.Tx(residency.Home(c.Request.Context()))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+
+		u, err := tx.User.Get(c.Request.Context(), userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		prefs, err := u.Preferences.Merge(patch)
+		if err != nil {
+			var verr *preferences.ValidationError
+			if errors.As(err, &verr) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": verr.Error(), "key": verr.Key})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err := tx.User.UpdateOneID(userID).SetPreferences(prefs).Exec(c.Request.Context()); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, prefs.WithDefaults())
+	}
+}
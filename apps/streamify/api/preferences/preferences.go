@@ -0,0 +1,159 @@
+package preferences
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Preferences is a user's settings stored as a JSON object.
+// Known keys are validated on update; unknown keys are stored verbatim so newer clients
+// can add settings without a server release.
+type Preferences map[string]json.RawMessage
+
+// ValidationError describes an invalid preference value
+type ValidationError struct {
+	Key     string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid preference %q: %s", e.Key, e.Message)
+}
+
+// validators checks the value of every known preference key
+var validators = map[string]func(json.RawMessage) error{
+	"theme":         oneOf("light", "dark", "system"),
+	"audio_quality": oneOf("low", "normal", "high", "lossless"),
+	"autoplay":      isBool,
+	"notifications": isBoolObject,
+}
+
+// Defaults are returned for known keys the user has not set
+var Defaults = Preferences{
+	"theme":         json.RawMessage(`"system"`),
+	"audio_quality": json.RawMessage(`"normal"`),
+	"autoplay":      json.RawMessage(`true`),
+	"notifications": json.RawMessage(`{"new_releases":true,"playlist_updates":true,"followers":true,"product_updates":false}`),
+}
+
+// WithDefaults returns a copy of p with defaults filled in for missing known keys
+func (p Preferences) WithDefaults() Preferences {
+	out := make(Preferences, len(p)+len(Defaults))
+	for k, v := range Defaults {
+		out[k] = v
+	}
+	for k, v := range p {
+		out[k] = v
+	}
+
+	// Merge notification toggles so new toggles get their default value
+	if v, ok := p["notifications"]; ok {
+		merged, err := mergeObjects(Defaults["notifications"], v)
+		if err == nil {
+			out["notifications"] = merged
+		}
+	}
+	return out
+}
+
+// Merge applies a JSON merge patch (RFC 7386) to p and returns the result.
+// A null value removes a key; known keys are validated before they are stored.
+func (p Preferences) Merge(patch map[string]json.RawMessage) (Preferences, error) {
+	out := make(Preferences, len(p)+len(patch))
+	for k, v := range p {
+		out[k] = v
+	}
+
+	for key, value := range patch {
+		if isNull(value) {
+			delete(out, key)
+			continue
+		}
+
+		if key == "notifications" {
+			if err := isBoolObject(value); err != nil {
+				return nil, &ValidationError{Key: key, Message: err.Error()}
+			}
+			base := out[key]
+			if base == nil {
+				base = json.RawMessage(`{}`)
+			}
+			merged, err := mergeObjects(base, value)
+			if err != nil {
+				return nil, &ValidationError{Key: key, Message: err.Error()}
+			}
+			out[key] = merged
+			continue
+		}
+
+		if validate, ok := validators[key]; ok {
+			if err := validate(value); err != nil {
+				return nil, &ValidationError{Key: key, Message: err.Error()}
+			}
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// mergeObjects merges the keys of patch into base, removing keys set to null
+func mergeObjects(base, patch json.RawMessage) (json.RawMessage, error) {
+	var b, p map[string]json.RawMessage
+	if err := json.Unmarshal(base, &b); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+	if b == nil {
+		b = make(map[string]json.RawMessage)
+	}
+	for k, v := range p {
+		if isNull(v) {
+			delete(b, k)
+			continue
+		}
+		b[k] = v
+	}
+	return json.Marshal(b)
+}
+
+// isNull reports whether v is the JSON null literal
+func isNull(v json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(v), []byte("null"))
+}
+
+// oneOf validates that a value is one of the given strings
+func oneOf(values ...string) func(json.RawMessage) error {
+	return func(v json.RawMessage) error {
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			return fmt.Errorf("must be a string")
+		}
+		for _, allowed := range values {
+			if s == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %v", values)
+	}
+}
+
+// isBool validates that a value is a boolean
+func isBool(v json.RawMessage) error {
+	var b bool
+	if err := json.Unmarshal(v, &b); err != nil {
+		return fmt.Errorf("must be a boolean")
+	}
+	return nil
+}
+
+// isBoolObject validates that a value is an object of boolean (or null) toggles
+func isBoolObject(v json.RawMessage) error {
+	var m map[string]*bool
+	if err := json.Unmarshal(v, &m); err != nil {
+		return fmt.Errorf("must be an object of boolean toggles")
+	}
+	return nil
+}
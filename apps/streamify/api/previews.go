@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/playlist"
+	"streamify/ent/track"
+	"streamify/ids"
+	"streamify/privacy"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// previewSeconds is the length of the clip guests may play
+const previewSeconds = 30
+
+// trackPreview is the guest-safe view of a track
+type trackPreview struct {
+	ID             uuid.UUID `json:"id"`
+	Title          string    `json:"title"`
+	AlbumID        uuid.UUID `json:"album_id"`
+	TrackNumber    int       `json:"track_number,omitempty"`
+	DiscNumber     int       `json:"disc_number,omitempty"`
+	PreviewURL     string    `json:"preview_url,omitempty"`
+	PreviewSeconds int       `json:"preview_seconds"`
+}
+
+// newTrackPreview limits playback to the first previewSeconds using a media fragment
+func newTrackPreview(t *ent.Track) trackPreview {
+	p := trackPreview{
+		ID:             t.ID,
+		Title:          t.Title,
+		AlbumID:        t.AlbumID,
+		TrackNumber:    t.TrackNumber,
+		DiscNumber:     t.DiscNumber,
+		PreviewSeconds: previewSeconds,
+	}
+	if t.URL != "" {
+		p.PreviewURL = t.URL + "#t=0," + strconv.Itoa(previewSeconds)
+	}
+	return p
+}
+
+// getTrackPreview returns a 30-second preview of a track
+func getTrackPreview(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid track ID"})
+			return
+		}
+
+		t, err := client.Track.Query().
+			Where(
+				track.IDEQ(id),
+				track.DeletedAtIsNil(),
+				track.HasAlbumWith(album.DeletedAtIsNil()),
+			).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "track not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, newTrackPreview(t))
+	}
+}
+
+// getPlaylistPreview returns a public playlist with previews of its tracks.
+// Guests and users alike only see what an anonymous visitor could.
+func getPlaylistPreview(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := ids.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid playlist ID"})
+			return
+		}
+
+		p, err := client.Playlist.Query().
+			Where(playlist.IDEQ(id), playlist.Public(true)).
+			WithOwner().
+			WithTracks(func(q *ent.TrackQuery) {
+				q.Where(track.DeletedAtIsNil())
+			}).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !privacy.CanView(nil, p.Edges.Owner, privacy.SectionPlaylists) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "playlist not found"})
+			return
+		}
+
+		sortPlaylistTracks(p)
+		tracks := make([]trackPreview, len(p.Edges.Tracks))
+		for i, t := range p.Edges.Tracks {
+			tracks[i] = newTrackPreview(t)
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"id":     p.ID,
+			"name":   p.Name,
+			"owner":  privacy.PublicProfile(p.Edges.Owner),
+			"tracks": tracks,
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package privacy
+
+import (
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/user"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetSettings returns the authenticated user's privacy settings
+func GetSettings(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		u, err := client.User.Get(c.Request.Context(), userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, OwnOf(u))
+	}
+}
+
+// UpdateRequest is the request body for UpdateSettings; omitted sections are left unchanged
+type UpdateRequest struct {
+	Playlists *string `json:"playlists" binding:"omitempty,oneof=public private"`
+	Activity  *string `json:"activity" binding:"omitempty,oneof=public private"`
+	Followers *string `json:"followers" binding:"omitempty,oneof=public private"`
+	// AnalyticsOptOut applies to plays recorded from now on
+	AnalyticsOptOut *bool `json:"analytics_opt_out"`
+}
+
+// UpdateSettings changes any of the authenticated user's privacy settings
+// present in the request body
+func UpdateSettings(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body UpdateRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		update := client.User.UpdateOneID(userID)
+		if body.Playlists != nil {
+			update = update.SetPlaylistsVisibility(user.PlaylistsVisibility(*body.Playlists))
+		}
+		if body.Activity != nil {
+			update = update.SetActivityVisibility(user.ActivityVisibility(*body.Activity))
+		}
+		if body.Followers != nil {
+			update = update.SetFollowersVisibility(user.FollowersVisibility(*body.Followers))
+		}
+		if body.AnalyticsOptOut != nil {
+			update = update.SetAnalyticsOptOut(*body.AnalyticsOptOut)
+		}
+
+		u, err := update.Save(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, OwnOf(u))
+	}
+}
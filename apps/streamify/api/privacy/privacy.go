@@ -0,0 +1,99 @@
+package privacy
+
+import (
+	"streamify/ent"
+	"streamify/ent/user"
+	"streamify/viewer"
+
+	"github.com/google/uuid"
+)
+
+// Section identifies a part of a user's profile that can be hidden from others
+type Section string
+
+const (
+	SectionPlaylists Section = "playlists"
+	SectionActivity  Section = "activity"
+	SectionFollowers Section = "followers"
+)
+
+// Visibility values shared by every section
+const (
+	Public  = "public"
+	Private = "private"
+)
+
+// Settings is the JSON representation of a user's privacy settings, as
+// shown on their public profile
+type Settings struct {
+	Playlists string `json:"playlists"`
+	Activity  string `json:"activity"`
+	Followers string `json:"followers"`
+}
+
+// Of returns the privacy settings stored on u
+func Of(u *ent.User) Settings {
+	return Settings{
+		Playlists: u.PlaylistsVisibility.String(),
+		Activity:  u.ActivityVisibility.String(),
+		Followers: u.FollowersVisibility.String(),
+	}
+}
+
+// OwnSettings are a user's privacy settings as only they see them, with the
+// choices that would be given away by showing them to others
+type OwnSettings struct {
+	Settings
+	// AnalyticsOptOut reports plays to analytics under a daily pseudonym
+	AnalyticsOptOut bool `json:"analytics_opt_out"`
+}
+
+// OwnOf returns the privacy settings stored on u for u to see
+func OwnOf(u *ent.User) OwnSettings {
+	return OwnSettings{Settings: Of(u), AnalyticsOptOut: u.AnalyticsOptOut}
+}
+
+// CanView reports whether v may see the given section of owner's profile.
+// Owners always see their own data and admins see everything; everyone else,
+// including anonymous requests (nil v), only sees sections the owner has left public.
+func CanView(v *viewer.Viewer, owner *ent.User, section Section) bool {
+	if v.Is(owner.ID) || v.IsAdmin() {
+		return true
+	}
+	switch section {
+	case SectionPlaylists:
+		return owner.PlaylistsVisibility == user.PlaylistsVisibilityPublic
+	case SectionActivity:
+		return owner.ActivityVisibility == user.ActivityVisibilityPublic
+	case SectionFollowers:
+		return owner.FollowersVisibility == user.FollowersVisibilityPublic
+	}
+	return false
+}
+
+// Profile is the subset of a user that other users are allowed to see
+type Profile struct {
+	ID        uuid.UUID `json:"id"`
+	FirstName string    `json:"first_name,omitempty"`
+	LastName  string    `json:"last_name,omitempty"`
+	Privacy   Settings  `json:"privacy"`
+}
+
+// PublicProfile strips contact details and account metadata from u
+func PublicProfile(u *ent.User) Profile {
+	return Profile{
+		ID:        u.ID,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Privacy:   Of(u),
+	}
+}
+
+// ProfileFor returns u in full when v is u or an admin, and the public
+// profile otherwise
+func ProfileFor(v *viewer.Viewer, u *ent.User) any {
+	if v.Is(u.ID) || v.IsAdmin() {
+		return u
+	}
+	return PublicProfile(u)
+}
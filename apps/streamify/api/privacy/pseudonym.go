@@ -0,0 +1,73 @@
+package privacy
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"streamify/events"
+
+	"github.com/google/uuid"
+)
+
+var (
+	keyMu sync.RWMutex
+	// pseudonymKey is random until SetPseudonymKey is called, so pseudonyms
+	// then differ between instances and restarts
+	pseudonymKey = randomKey()
+)
+
+func randomKey() []byte {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}
+
+// SetPseudonymKey sets the secret pseudonyms are derived from. Instances
+// sharing it give a user the same pseudonym on a given day.
+func SetPseudonymKey(key []byte) {
+	keyMu.Lock()
+	pseudonymKey = key
+	keyMu.Unlock()
+}
+
+// Pseudonym returns the ID standing in for userID on at's UTC day. Without
+// the key, pseudonyms can't be traced to the user or linked across days.
+func Pseudonym(userID uuid.UUID, at time.Time) uuid.UUID {
+	return derive([]byte(at.UTC().Format(time.DateOnly)), userID[:])
+}
+
+// playPseudonym returns the ID standing in for playID, which would otherwise
+// lead back to the play's row and its user. It is the same every time, so
+// retried deliveries of a play still share an ID.
+func playPseudonym(playID uuid.UUID) uuid.UUID {
+	return derive([]byte("play"), playID[:])
+}
+
+// derive returns the keyed HMAC of parts as a version 8 (custom) UUID
+func derive(parts ...[]byte) uuid.UUID {
+	keyMu.RLock()
+	mac := hmac.New(sha256.New, pseudonymKey)
+	keyMu.RUnlock()
+	for _, p := range parts {
+		mac.Write(p)
+	}
+	var id uuid.UUID
+	copy(id[:], mac.Sum(nil))
+	id[6] = id[6]&0x0f | 0x80
+	id[8] = id[8]&0x3f | 0x80
+	return id
+}
+
+// PlayEvent returns the play.recorded payload for a play, with the user and
+// the play replaced by pseudonyms when the user opted out of analytics
+func PlayEvent(e events.PlayRecorded, optOut bool) events.PlayRecorded {
+	if optOut {
+		e.PlayID = playPseudonym(e.PlayID)
+		e.UserID = Pseudonym(e.UserID, e.PlayedAt)
+		e.Pseudonymous = true
+	}
+	return e
+}
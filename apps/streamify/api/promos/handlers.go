@@ -0,0 +1,236 @@
+package promos
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/entitlements"
+	"streamify/ids"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateRequest is the request body for CreateCodes
+type CreateRequest struct {
+	// Code is the code to mint, such as SUMMER25; random codes are minted
+	// when it is left out
+	Code           string `json:"code" binding:"omitempty,min=4,max=32,alphanum"`
+	Count          int    `json:"count" binding:"omitempty,min=1,max=500"`
+	Kind           string `json:"kind" binding:"required,oneof=percent_off free_months feature_unlock"`
+	PercentOff     *int   `json:"percent_off" binding:"omitempty,min=1,max=100"`
+	Months         *int   `json:"months" binding:"omitempty,min=1,max=120"`
+	Feature        string `json:"feature" binding:"omitempty,oneof=premium downloads"`
+	MaxRedemptions *int   `json:"max_redemptions" binding:"omitempty,min=1,max=1000000"`
+	PerUserLimit   int    `json:"per_user_limit" binding:"omitempty,min=1,max=100"`
+	ExpiresInDays  int    `json:"expires_in_days" binding:"omitempty,min=1,max=365"`
+}
+
+// CreateCodes mints one chosen promo code or a batch of random ones (admin)
+func CreateCodes(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreateRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		count := max(body.Count, 1)
+		if body.Code != "" && count > 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "a chosen code must be created on its own"})
+			return
+		}
+
+		opts := Options{
+			Code:           body.Code,
+			Kind:           promocode.Kind(body.Kind),
+			PercentOff:     body.PercentOff,
+			Months:         body.Months,
+			MaxRedemptions: body.MaxRedemptions,
+			PerUserLimit:   body.PerUserLimit,
+		}
+		if body.Feature != "" {
+			f := promocode.Feature(body.Feature)
+			opts.Feature = &f
+		}
+		if body.ExpiresInDays > 0 {
+			t := time.Now().AddDate(0, 0, body.ExpiresInDays)
+			opts.ExpiresAt = &t
+		}
+		if id, ok := viewer.UserID(c.Request.Context()); ok {
+			opts.CreatedBy = &id
+		}
+		if err := opts.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		codes := make([]*ent.PromoCode, 0, count)
+		for i := 0; i < count; i++ {
+			pc, err := Create(c.Request.Context(), client, opts)
+			if err != nil {
+				if ent.IsConstraintError(err) {
+					c.JSON(http.StatusConflict, gin.H{"error": "promo code already exists"})
+					return
+				}
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "promo_codes": codes})
+				return
+			}
+			codes = append(codes, pc)
+		}
+		c.JSON(http.StatusCreated, gin.H{"promo_codes": codes})
+	}
+}
+
+// parseLimit reads ?limit=, writing a 400 response and returning ok=false
+// when it isn't valid
+func parseLimit(c *gin.Context) (limit int, ok bool) {
+	limit = 100
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > 1000 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+			return limit, false
+		}
+		limit = n
+	}
+	return limit, true
+}
+
+// ListCodes lists promo codes, newest first, optionally filtered by ?kind= (admin)
+func ListCodes(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := client.PromoCode.Query()
+		if kind := c.Query("kind"); kind != "" {
+			k := promocode.Kind(kind)
+			if err := promocode.KindValidator(k); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "kind must be percent_off, free_months or feature_unlock"})
+				return
+			}
+			q = q.Where(promocode.KindEQ(k))
+		}
+		limit, ok := parseLimit(c)
+		if !ok {
+			return
+		}
+
+		codes, err := q.Order(ent.Desc(promocode.FieldCreatedAt)).Limit(limit).All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"promo_codes": codes})
+	}
+}
+
+// ExpireCode stops a promo code from being redeemed again (admin). What was
+// already redeemed is kept.
+func ExpireCode(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid promo code ID"})
+			return
+		}
+		pc, err := client.PromoCode.UpdateOneID(id).
+			SetExpiresAt(time.Now()).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "promo code not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, pc)
+	}
+}
+
+// ListRedemptions lists a promo code's redemptions, newest first, with the
+// entitlements they granted (admin)
+func ListRedemptions(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid promo code ID"})
+			return
+		}
+		limit, ok := parseLimit(c)
+		if !ok {
+			return
+		}
+		rs, err := client.PromoRedemption.Query().
+			Where(promoredemption.CodeIDEQ(id)).
+			WithEntitlement().
+			Order(ent.Desc(promoredemption.FieldCreatedAt)).
+			Limit(limit).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"redemptions": rs})
+	}
+}
+
+// RedeemRequest is the request body for RedeemCode
+type RedeemRequest struct {
+	Code string `json:"code" binding:"required,max=32"`
+}
+
+// RedeemCode redeems a promo code for the current user
+func RedeemCode(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		var body RedeemRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		r, err := Redeem(c.Request.Context(), client, userID, body.Code)
+		switch {
+		case errors.Is(err, ErrInvalidCode):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Invalid or expired promo code"})
+		case errors.Is(err, ErrLimitReached):
+			c.JSON(http.StatusConflict, gin.H{"error": "You have already redeemed this promo code"})
+		case errors.Is(err, entitlements.ErrUnlimited):
+			c.JSON(http.StatusConflict, gin.H{"error": "You already have this without an expiry"})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusCreated, r)
+		}
+	}
+}
+
+// MyRedemptions lists the current user's redemptions, newest first, with the
+// entitlements they granted
+func MyRedemptions(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		rs, err := client.PromoRedemption.Query().
+			Where(promoredemption.UserIDEQ(userID)).
+			WithEntitlement().
+			Order(ent.Desc(promoredemption.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"redemptions": rs})
+	}
+}
@@ -0,0 +1,227 @@
+// Package promos lets admins mint promo codes that users redeem for a
+// discount on their subscription, free months of premium or a single
+// feature. Every redemption is recorded with what it gave the user: the
+// entitlement granted, or the discount billing owes them.
+package promos
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/entitlement"
+	"streamify/ent/promocode"
+	"streamify/ent/promoredemption"
+	"streamify/ent/schema/rule"
+	"streamify/entitlements"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidCode is returned when a code does not exist, has expired or
+	// is used up
+	ErrInvalidCode = errors.New("invalid or expired promo code")
+	// ErrLimitReached is returned when the user already redeemed a code as
+	// often as it allows
+	ErrLimitReached = errors.New("promo code already redeemed")
+)
+
+const (
+	codeLength = 10
+	// Upper case without easily confused characters, as for invite codes
+	codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// newCode returns a random promo code
+func newCode() (string, error) {
+	max := big.NewInt(int64(len(codeAlphabet)))
+	b := make([]byte, codeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = codeAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// normalizeCode makes codes case-insensitive and tolerant of surrounding whitespace
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Options describes promo codes to create
+type Options struct {
+	Code       string // optional; a random code when empty
+	Kind       promocode.Kind
+	PercentOff *int               // required for percent_off
+	Months     *int               // required for free_months; optional otherwise
+	Feature    *promocode.Feature // required for feature_unlock
+	// MaxRedemptions caps redemptions in all; nil for no limit
+	MaxRedemptions *int
+	PerUserLimit   int // defaults to 1
+	ExpiresAt      *time.Time
+	CreatedBy      *uuid.UUID
+}
+
+// Validate checks that opts set what their kind needs and nothing it doesn't
+func (opts Options) Validate() error {
+	switch opts.Kind {
+	case promocode.KindPercentOff:
+		if opts.PercentOff == nil {
+			return errors.New("percent_off codes need percent_off")
+		}
+	case promocode.KindFreeMonths:
+		if opts.Months == nil {
+			return errors.New("free_months codes need months")
+		}
+	case promocode.KindFeatureUnlock:
+		if opts.Feature == nil {
+			return errors.New("feature_unlock codes need feature")
+		}
+	default:
+		return errors.New("kind must be percent_off, free_months or feature_unlock")
+	}
+	if opts.PercentOff != nil && opts.Kind != promocode.KindPercentOff {
+		return errors.New("only percent_off codes take percent_off")
+	}
+	if opts.Feature != nil && opts.Kind != promocode.KindFeatureUnlock {
+		return errors.New("only feature_unlock codes take feature")
+	}
+	return nil
+}
+
+// Create stores a new promo code. A random code is retried on the unlikely
+// event of a collision; a chosen code that is taken fails with a constraint
+// error.
+func Create(ctx context.Context, client *ent.Client, opts Options) (*ent.PromoCode, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	perUser := opts.PerUserLimit
+	if perUser < 1 {
+		perUser = 1
+	}
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		code := normalizeCode(opts.Code)
+		if code == "" {
+			if code, err = newCode(); err != nil {
+				return nil, err
+			}
+		}
+		var pc *ent.PromoCode
+		pc, err = client.PromoCode.Create().
+			SetCode(code).
+			SetKind(opts.Kind).
+			SetNillablePercentOff(opts.PercentOff).
+			SetNillableMonths(opts.Months).
+			SetNillableFeature(opts.Feature).
+			SetNillableMaxRedemptions(opts.MaxRedemptions).
+			SetPerUserLimit(perUser).
+			SetNillableExpiresAt(opts.ExpiresAt).
+			SetNillableCreatedBy(opts.CreatedBy).
+			Save(ctx)
+		if !ent.IsConstraintError(err) || opts.Code != "" {
+			return pc, err
+		}
+	}
+	return nil, err
+}
+
+// Redeem redeems code for userID and returns the redemption, with the
+// entitlement it granted loaded. Free months and unlocked features start
+// when the user's current access to them ends, so they stack; a user who
+// has them for good gets entitlements.ErrUnlimited.
+func Redeem(ctx context.Context, client *ent.Client, userID uuid.UUID, code string) (r *ent.PromoRedemption, err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	// The code is counted and the entitlement granted for the user, who may
+	// do neither themselves
+	ctx = rule.SystemContext(ctx)
+
+	// Counting the redemption locks the code's row until commit, so the same
+	// user's concurrent redemptions are checked against the limit one at a time
+	code = normalizeCode(code)
+	now := time.Now()
+	n, err := tx.PromoCode.Update().
+		Where(
+			promocode.CodeEQ(code),
+			promocode.Or(promocode.ExpiresAtIsNil(), promocode.ExpiresAtGT(now)),
+			promocode.Or(
+				promocode.MaxRedemptionsIsNil(),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(promocode.FieldRedemptions), s.C(promocode.FieldMaxRedemptions)))
+				},
+			),
+		).
+		AddRedemptions(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidCode
+	}
+	pc, err := tx.PromoCode.Query().Where(promocode.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used, err := tx.PromoRedemption.Query().
+		Where(promoredemption.CodeIDEQ(pc.ID), promoredemption.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if used >= pc.PerUserLimit {
+		return nil, ErrLimitReached
+	}
+
+	create := tx.PromoRedemption.Create().
+		SetCodeID(pc.ID).
+		SetUserID(userID)
+	var granted *ent.Entitlement
+	switch pc.Kind {
+	case promocode.KindPercentOff:
+		create.SetNillablePercentOff(pc.PercentOff)
+		if pc.Months != nil {
+			create.SetDiscountEndsAt(now.AddDate(0, *pc.Months, 0))
+		}
+	case promocode.KindFreeMonths:
+		granted, err = entitlements.Extend(ctx, tx.Client(), userID, entitlement.FeaturePremium, entitlement.SourcePromo, *pc.Months, 0)
+	case promocode.KindFeatureUnlock:
+		var months int
+		if pc.Months != nil {
+			months = *pc.Months
+		}
+		granted, err = entitlements.Extend(ctx, tx.Client(), userID, entitlement.Feature(*pc.Feature), entitlement.SourcePromo, months, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if granted != nil {
+		create.SetEntitlementID(granted.ID)
+	}
+	if r, err = create.Save(ctx); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	r.Edges.Entitlement = granted
+	return r, nil
+}
@@ -0,0 +1,137 @@
+// Package public configures the optional read-only API served without
+// authentication under /public/v1. Deployments choose which groups of
+// catalog endpoints it exposes; callers are anonymous whatever credentials
+// they send, and are rate limited per client IP.
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/logging"
+	"streamify/quota"
+
+	"github.com/gin-gonic/gin"
+)
+
+var logger = logging.For("public")
+
+// Group names a set of public endpoints
+type Group string
+
+const (
+	GroupArtists Group = "artists"
+	GroupAlbums  Group = "albums"
+)
+
+// Groups lists the groups that can be enabled
+var Groups = []Group{GroupArtists, GroupAlbums}
+
+// Defaults for the per-IP rate limit, well below what signed-in clients make
+const (
+	DefaultLimit  = 30
+	DefaultWindow = time.Minute
+)
+
+// Config says which public endpoints are served and how often each client
+// IP may call them
+type Config struct {
+	Groups []Group
+	Limit  int
+	Window time.Duration
+}
+
+// Enabled reports whether group g is served
+func (cfg Config) Enabled(g Group) bool {
+	return slices.Contains(cfg.Groups, g)
+}
+
+// FromEnv reads PUBLIC_API, a comma-separated list of groups to serve (off
+// when empty), and PUBLIC_API_RATE_LIMIT, requests per window per client IP
+// written as "30/1m"
+func FromEnv() (Config, error) {
+	cfg := Config{Limit: DefaultLimit, Window: DefaultWindow}
+	for _, name := range strings.Split(os.Getenv("PUBLIC_API"), ",") {
+		g := Group(strings.TrimSpace(name))
+		if g == "" {
+			continue
+		}
+		if !slices.Contains(Groups, g) {
+			return Config{}, fmt.Errorf("PUBLIC_API: unknown group %q (want %s)", g, joinGroups(Groups))
+		}
+		if !cfg.Enabled(g) {
+			cfg.Groups = append(cfg.Groups, g)
+		}
+	}
+	if v := os.Getenv("PUBLIC_API_RATE_LIMIT"); v != "" {
+		n, window, ok := strings.Cut(v, "/")
+		limit, err := strconv.Atoi(strings.TrimSpace(n))
+		d, derr := time.ParseDuration(strings.TrimSpace(window))
+		if !ok || err != nil || derr != nil || limit <= 0 || d < time.Second {
+			return Config{}, fmt.Errorf("PUBLIC_API_RATE_LIMIT must be requests/window, e.g. 30/1m, got %q", v)
+		}
+		cfg.Limit, cfg.Window = limit, d
+	}
+	return cfg, nil
+}
+
+func joinGroups(groups []Group) string {
+	names := make([]string, len(groups))
+	for i, g := range groups {
+		names[i] = string(g)
+	}
+	return strings.Join(names, ", ")
+}
+
+// credentialHeaders are dropped from public requests
+var credentialHeaders = []string{"Authorization", "Cookie", "X-Api-Key"}
+
+// Anonymous drops the request's credentials, so that nothing behind it can
+// tell who is calling or answer with anything but public data
+func Anonymous() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, h := range credentialHeaders {
+			c.Request.Header.Del(h)
+		}
+		c.Next()
+	}
+}
+
+// RateLimit allows each client IP cfg.Limit requests per cfg.Window, counted
+// in counter so that all instances share the limit, and reports it in the
+// same X-RateLimit-* headers as API key quotas. When the counter can't be
+// reached requests are let through.
+func RateLimit(counter quota.Counter, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		now := time.Now()
+		start := now.Truncate(cfg.Window)
+		reset := start.Add(cfg.Window)
+		key := "public:" + c.ClientIP() + ":" + strconv.FormatInt(start.Unix(), 10)
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second)
+		used, err := counter.Incr(ctx, key, 1, reset)
+		cancel()
+		if err != nil {
+			logger.Warn("rate limit counter unavailable; letting request through", "error", err)
+			c.Next()
+			return
+		}
+
+		limit := int64(cfg.Limit)
+		c.Header(quota.HeaderLimit, strconv.FormatInt(limit, 10))
+		c.Header(quota.HeaderRemaining, strconv.FormatInt(max(limit-used, 0), 10))
+		c.Header(quota.HeaderReset, strconv.FormatInt(reset.Unix(), 10))
+		if used > limit {
+			c.Header("Retry-After", strconv.Itoa(int(reset.Sub(now).Seconds())+1))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many requests"})
+			return
+		}
+		c.Next()
+	}
+}
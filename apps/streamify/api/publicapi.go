@@ -0,0 +1,215 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"streamify/coalesce"
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/artist"
+	"streamify/ent/track"
+	"streamify/ids"
+	"streamify/notfound"
+	"streamify/public"
+	"streamify/routing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// publicArtist is the anonymous view of an artist
+type publicArtist struct {
+	ID       uuid.UUID       `json:"id"`
+	PublicID string          `json:"public_id"`
+	Name     string          `json:"name"`
+	ImageURL string          `json:"image_url,omitempty"`
+	Palette  []string        `json:"palette,omitempty"`
+	Albums   []publicRelease `json:"albums,omitempty"`
+}
+
+// publicRelease is the anonymous view of an album. Tracks are listed
+// without their audio, which takes a guest or user token to play.
+type publicRelease struct {
+	ID          uuid.UUID       `json:"id"`
+	PublicID    string          `json:"public_id"`
+	Title       string          `json:"title"`
+	ArtistID    uuid.UUID       `json:"artist_id"`
+	Artist      *publicArtist   `json:"artist,omitempty"`
+	ImageURL    string          `json:"image_url,omitempty"`
+	Palette     []string        `json:"palette,omitempty"`
+	Label       string          `json:"label,omitempty"`
+	AlbumType   album.AlbumType `json:"album_type"`
+	ReleaseDate *time.Time      `json:"release_date,omitempty"`
+	Tracks      []publicTrack   `json:"tracks,omitempty"`
+}
+
+type publicTrack struct {
+	ID          uuid.UUID `json:"id"`
+	PublicID    string    `json:"public_id"`
+	Title       string    `json:"title"`
+	TrackNumber int       `json:"track_number,omitempty"`
+	DiscNumber  int       `json:"disc_number,omitempty"`
+}
+
+func newPublicArtist(a *ent.Artist) *publicArtist {
+	p := &publicArtist{ID: a.ID, PublicID: ids.Public(a.ID), Name: a.Name, ImageURL: a.ImageURL, Palette: a.Palette}
+	for _, al := range a.Edges.Albums {
+		p.Albums = append(p.Albums, newPublicRelease(al))
+	}
+	return p
+}
+
+func newPublicRelease(a *ent.Album) publicRelease {
+	p := publicRelease{
+		ID:          a.ID,
+		PublicID:    ids.Public(a.ID),
+		Title:       a.Title,
+		ArtistID:    a.ArtistID,
+		ImageURL:    a.ImageURL,
+		Palette:     a.Palette,
+		Label:       a.Label,
+		AlbumType:   a.AlbumType,
+		ReleaseDate: a.ReleaseDate,
+	}
+	if a.Edges.Artist != nil {
+		p.Artist = newPublicArtist(a.Edges.Artist)
+	}
+	for _, t := range a.Edges.Tracks {
+		p.Tracks = append(p.Tracks, publicTrack{ID: t.ID, PublicID: ids.Public(t.ID), Title: t.Title, TrackNumber: t.TrackNumber, DiscNumber: t.DiscNumber})
+	}
+	return p
+}
+
+// publicRoutes are the endpoint groups enabled in cfg, served under
+// /public/v1 without authentication and rate limited per client IP
+func publicRoutes(client *ent.Client, cfg public.Config, missing *notfound.Cache) []routing.Route {
+	var routes []routing.Route
+	if cfg.Enabled(public.GroupArtists) {
+		routes = append(routes,
+			routing.Route{Method: "GET", Path: "/public/v1/artists", Auth: routing.Anonymous, Handler: getPublicArtists(client), Description: "List artists by name with ?limit=&offset= (served when PUBLIC_API includes artists)"},
+			routing.Route{Method: "GET", Path: "/public/v1/artists/:id", Auth: routing.Anonymous, Handler: getPublicArtist(client, missing), Description: "Get an artist with its albums (served when PUBLIC_API includes artists)"},
+		)
+	}
+	if cfg.Enabled(public.GroupAlbums) {
+		routes = append(routes,
+			routing.Route{Method: "GET", Path: "/public/v1/albums/:id", Auth: routing.Anonymous, Handler: getPublicAlbum(client, missing), Description: "Get an album with its artist and tracklist, without audio (served when PUBLIC_API includes albums)"},
+		)
+	}
+	return routes
+}
+
+// getPublicArtists lists artists by name, ?limit= (at most 100) at a time
+// from ?offset=
+func getPublicArtists(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, offset := 50, 0
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > 100 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+				return
+			}
+			limit = n
+		}
+		if v := c.Query("offset"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "offset must be a non-negative integer"})
+				return
+			}
+			offset = n
+		}
+
+		artists, err := client.Artist.Query().
+			Where(artist.DeletedAtIsNil()).
+			Order(ent.Asc(artist.FieldName), ent.Asc(artist.FieldID)).
+			Limit(limit).
+			Offset(offset).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		out := make([]*publicArtist, len(artists))
+		for i, a := range artists {
+			out[i] = newPublicArtist(a)
+		}
+		c.JSON(http.StatusOK, gin.H{"artists": out, "limit": limit, "offset": offset})
+	}
+}
+
+// getPublicArtist returns an artist with its albums
+func getPublicArtist(client *ent.Client, missing *notfound.Cache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid artist ID"})
+			return
+		}
+		if missing.Missing(ent.TypeArtist, id) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+			return
+		}
+		a, err := client.Artist.Query().
+			Where(artist.IDEQ(id), artist.DeletedAtIsNil()).
+			WithAlbums(func(q *ent.AlbumQuery) {
+				q.Where(album.DeletedAtIsNil()).Order(ent.Asc(album.FieldCreatedAt))
+			}).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				missing.Add(ent.TypeArtist, id)
+				c.JSON(http.StatusNotFound, gin.H{"error": "artist not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, newPublicArtist(a))
+	}
+}
+
+// getPublicAlbum returns an album with its artist and tracklist
+func getPublicAlbum(client *ent.Client, missing *notfound.Cache) gin.HandlerFunc {
+	// CDN misses for the same album at once share one query
+	reads := coalesce.New[*ent.Album]()
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid album ID"})
+			return
+		}
+		if missing.Missing(ent.TypeAlbum, id) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+			return
+		}
+		a, err := reads.Do(c.Request.Context(), id.String(), func(ctx context.Context) (*ent.Album, error) {
+			return client.Album.Query().
+				Where(album.IDEQ(id), album.DeletedAtIsNil()).
+				WithArtist(func(q *ent.ArtistQuery) { q.Where(artist.DeletedAtIsNil()) }).
+				WithTracks(func(q *ent.TrackQuery) {
+					q.Where(track.DeletedAtIsNil()).Order(ent.Asc(track.FieldDiscNumber), ent.Asc(track.FieldTrackNumber))
+				}).
+				Only(ctx)
+		})
+		if err != nil {
+			if ent.IsNotFound(err) {
+				missing.Add(ent.TypeAlbum, id)
+				c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		// Albums of a deleted artist are hidden, but not remembered as
+		// missing since signed-in lookups still find them
+		if a.Edges.Artist == nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "album not found"})
+			return
+		}
+		c.JSON(http.StatusOK, newPublicRelease(a))
+	}
+}
@@ -0,0 +1,125 @@
+package querylog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver wraps an Ent driver and reports the duration of every statement to a Recorder
+type Driver struct {
+	dialect.Driver
+	recorder *Recorder
+}
+
+// NewDriver wraps drv so that all statements are timed by recorder
+func NewDriver(drv dialect.Driver, recorder *Recorder) *Driver {
+	return &Driver{Driver: drv, recorder: recorder}
+}
+
+// Exec executes a statement and records its duration
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	d.recorder.Record(ctx, query, time.Since(start))
+	return err
+}
+
+// Query executes a query and records its duration
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	d.recorder.Record(ctx, query, time.Since(start))
+	return err
+}
+
+// ExecContext executes a raw statement on the underlying driver and records its duration
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ex, ok := d.Driver.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("querylog: driver does not support ExecContext")
+	}
+	start := time.Now()
+	res, err := ex.ExecContext(ctx, query, args...)
+	d.recorder.Record(ctx, query, time.Since(start))
+	return res, err
+}
+
+// QueryContext executes a raw query on the underlying driver and records its duration
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	q, ok := d.Driver.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("querylog: driver does not support QueryContext")
+	}
+	start := time.Now()
+	rows, err := q.QueryContext(ctx, query, args...)
+	d.recorder.Record(ctx, query, time.Since(start))
+	return rows, err
+}
+
+// Tx starts a transaction whose statements are also recorded
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, recorder: d.recorder}, nil
+}
+
+// BeginTx starts a transaction with options when the underlying driver supports it
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, recorder: d.recorder}, nil
+}
+
+// Tx wraps an Ent transaction and records the duration of its statements
+type Tx struct {
+	dialect.Tx
+	recorder *Recorder
+}
+
+// Exec executes a statement in the transaction and records its duration
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	t.recorder.Record(ctx, query, time.Since(start))
+	return err
+}
+
+// Query executes a query in the transaction and records its duration
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	t.recorder.Record(ctx, query, time.Since(start))
+	return err
+}
+
+// ExecContext executes a raw statement in the transaction and records its duration
+func (t *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ex, ok := t.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("querylog: transaction does not support ExecContext")
+	}
+	start := time.Now()
+	res, err := ex.ExecContext(ctx, query, args...)
+	t.recorder.Record(ctx, query, time.Since(start))
+	return res, err
+}
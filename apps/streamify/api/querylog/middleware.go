@@ -0,0 +1,52 @@
+package querylog
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type routeKey struct{}
+
+// WithRoute returns a copy of ctx carrying the route that issued the queries
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeKey{}, route)
+}
+
+// RouteFromContext returns the route stored in ctx, or "-" when there is none
+func RouteFromContext(ctx context.Context) string {
+	if route, ok := ctx.Value(routeKey{}).(string); ok {
+		return route
+	}
+	return "-"
+}
+
+// Middleware tags the request context with the matched route so queries can be attributed to it
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.Request.Method + " " + c.FullPath()
+		c.Request = c.Request.WithContext(WithRoute(c.Request.Context(), route))
+		c.Next()
+	}
+}
+
+// SlowQueries returns the slowest recently recorded queries; ?limit= defaults to 10
+func SlowQueries(r *Recorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := 10
+		if l := c.Query("limit"); l != "" {
+			n, err := strconv.Atoi(l)
+			if err != nil || n < 1 || n > 100 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+				return
+			}
+			limit = n
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"threshold_ms": millis(r.Threshold()),
+			"queries":      r.Top(limit),
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package querylog
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// entry is a single slow statement execution
+type entry struct {
+	query    string
+	route    string
+	duration time.Duration
+	at       time.Time
+}
+
+// Summary aggregates the slow executions of one statement from one route
+type Summary struct {
+	Query      string    `json:"query"`
+	Route      string    `json:"route"`
+	Count      int       `json:"count"`
+	MaxMs      float64   `json:"max_ms"`
+	AvgMs      float64   `json:"avg_ms"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// Recorder logs statements slower than a threshold and keeps the most recent ones for reporting
+type Recorder struct {
+	threshold time.Duration
+
+	mu      sync.Mutex
+	entries []entry
+	next    int
+	full    bool
+}
+
+// NewRecorder creates a recorder that keeps up to capacity slow statements
+func NewRecorder(threshold time.Duration, capacity int) *Recorder {
+	return &Recorder{threshold: threshold, entries: make([]entry, capacity)}
+}
+
+// Threshold returns the duration above which statements are considered slow
+func (r *Recorder) Threshold() time.Duration {
+	return r.threshold
+}
+
+// Record logs and stores query if it took longer than the threshold
+func (r *Recorder) Record(ctx context.Context, query string, d time.Duration) {
+	if d < r.threshold {
+		return
+	}
+	route := RouteFromContext(ctx)
+	log.Printf("slow query (%s) route=%s: %s", d, route, query)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) == 0 {
+		return
+	}
+	r.entries[r.next] = entry{query: query, route: route, duration: d, at: time.Now()}
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Top aggregates the retained slow statements by query and route and returns the n slowest
+func (r *Recorder) Top(n int) []Summary {
+	r.mu.Lock()
+	retained := r.next
+	if r.full {
+		retained = len(r.entries)
+	}
+	entries := make([]entry, retained)
+	copy(entries, r.entries[:retained])
+	r.mu.Unlock()
+
+	type key struct{ query, route string }
+	totals := make(map[key]time.Duration)
+	byKey := make(map[key]*Summary)
+	for _, e := range entries {
+		k := key{e.query, e.route}
+		s, ok := byKey[k]
+		if !ok {
+			s = &Summary{Query: e.query, Route: e.route}
+			byKey[k] = s
+		}
+		s.Count++
+		totals[k] += e.duration
+		if ms := millis(e.duration); ms > s.MaxMs {
+			s.MaxMs = ms
+		}
+		if e.at.After(s.LastSeenAt) {
+			s.LastSeenAt = e.at
+		}
+	}
+
+	summaries := make([]Summary, 0, len(byKey))
+	for k, s := range byKey {
+		s.AvgMs = millis(totals[k]) / float64(s.Count)
+		summaries = append(summaries, *s)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].MaxMs > summaries[j].MaxMs })
+	if n > 0 && len(summaries) > n {
+		summaries = summaries[:n]
+	}
+	return summaries
+}
+
+// millis converts a duration to fractional milliseconds
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
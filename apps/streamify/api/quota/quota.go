@@ -0,0 +1,154 @@
+// Package quota enforces the monthly request quotas of API keys. Counts are
+// kept in Redis when REDIS_URL is set so every instance sees the same totals.
+package quota
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"streamify/apikeys"
+	"streamify/ent/apikey"
+	"streamify/logging"
+	"streamify/resilience"
+
+	"github.com/gin-gonic/gin"
+)
+
+var logger = logging.For("quota")
+
+// Response headers describing the caller's quota
+const (
+	HeaderLimit     = "X-RateLimit-Limit"
+	HeaderRemaining = "X-RateLimit-Remaining"
+	HeaderReset     = "X-RateLimit-Reset"
+	// HeaderOverage is set on requests past the quota of keys allowed to go over
+	HeaderOverage = "X-RateLimit-Overage"
+)
+
+// Counter adds n to the counter at key and returns its new total. Counters are
+// dropped at expireAt.
+type Counter interface {
+	Incr(ctx context.Context, key string, n int64, expireAt time.Time) (int64, error)
+}
+
+// Memory counts in process. Each instance counts on its own, so it's only
+// accurate with a single instance.
+type Memory struct {
+	mu       sync.Mutex
+	counts   map[string]int64
+	expireAt map[string]time.Time
+}
+
+// NewMemory returns an empty in-process counter
+func NewMemory() *Memory {
+	return &Memory{counts: make(map[string]int64), expireAt: make(map[string]time.Time)}
+}
+
+// Incr implements Counter
+func (m *Memory) Incr(_ context.Context, key string, n int64, expireAt time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for k, t := range m.expireAt {
+		if !now.Before(t) {
+			delete(m.counts, k)
+			delete(m.expireAt, k)
+		}
+	}
+	m.counts[key] += n
+	m.expireAt[key] = expireAt
+	return m.counts[key], nil
+}
+
+// FromEnv returns a Redis counter when REDIS_URL is set and an in-process one
+// otherwise
+func FromEnv() (Counter, error) {
+	if url := os.Getenv("REDIS_URL"); url != "" {
+		return NewRedis(url)
+	}
+	logger.Warn("REDIS_URL not set; API key quotas are counted per instance")
+	return NewMemory(), nil
+}
+
+type resilient struct {
+	c   Counter
+	dep *resilience.Dependency
+}
+
+// Resilient wraps c so increments go through dep's breaker. Increments aren't
+// idempotent, so they're never retried.
+func Resilient(c Counter, dep *resilience.Dependency) Counter {
+	return &resilient{c: c, dep: dep}
+}
+
+// Incr implements Counter
+func (r *resilient) Incr(ctx context.Context, key string, n int64, expireAt time.Time) (int64, error) {
+	var total int64
+	err := r.dep.DoOnce(ctx, func(ctx context.Context) error {
+		var err error
+		total, err = r.c.Incr(ctx, key, n, expireAt)
+		return err
+	})
+	return total, err
+}
+
+// period returns the calendar month (UTC) t falls in, as a key suffix, and
+// when the next one starts
+func period(t time.Time) (string, time.Time) {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start.Format("2006-01"), start.AddDate(0, 1, 0)
+}
+
+// Middleware counts requests made with API keys against their monthly quota
+// and reports it in X-RateLimit-* headers. Keys over quota get 429 Too Many
+// Requests unless their overage is allowed. Requests without a key and keys
+// without a quota aren't counted. When the counter can't be reached requests
+// are let through.
+func Middleware(counter Counter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		k := apikeys.FromContext(c.Request.Context())
+		if k == nil {
+			c.Next()
+			return
+		}
+		limit, overage := apikeys.Limits(k)
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		now := time.Now()
+		month, reset := period(now)
+		ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second)
+		// Keep the counter a day past the reset so late requests can't restart it
+		used, err := counter.Incr(ctx, "quota:"+k.ID.String()+":"+month, 1, reset.Add(24*time.Hour))
+		cancel()
+		if err != nil {
+			logger.Warn("quota counter unavailable; letting request through", "key_id", k.ID, "error", err)
+			c.Next()
+			return
+		}
+
+		c.Header(HeaderLimit, strconv.FormatInt(limit, 10))
+		c.Header(HeaderRemaining, strconv.FormatInt(max(limit-used, 0), 10))
+		c.Header(HeaderReset, strconv.FormatInt(reset.Unix(), 10))
+		if used > limit {
+			if overage == apikey.OverageBlock {
+				c.Header("Retry-After", strconv.Itoa(int(reset.Sub(now).Seconds())+1))
+				c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+					"error":    "monthly request quota exceeded",
+					"limit":    limit,
+					"reset_at": reset,
+				})
+				return
+			}
+			c.Header(HeaderOverage, strconv.FormatInt(used-limit, 10))
+		}
+		c.Next()
+	}
+}
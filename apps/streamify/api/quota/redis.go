@@ -0,0 +1,260 @@
+package quota
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Redis counts in a Redis server shared by every instance. It speaks just
+// enough RESP to run the commands Incr needs.
+type Redis struct {
+	addr     string
+	username string
+	password string
+	db       int
+	tls      *tls.Config
+
+	// idle holds connections ready for reuse
+	idle chan *redisConn
+}
+
+// maxIdle caps how many idle connections are kept
+const maxIdle = 16
+
+// NewRedis returns a counter for the server at rawURL,
+// redis://[user:password@]host:port[/db] or rediss:// for TLS
+func NewRedis(rawURL string) (*Redis, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	r := &Redis{addr: u.Host, idle: make(chan *redisConn, maxIdle)}
+	switch u.Scheme {
+	case "redis":
+	case "rediss":
+		r.tls = &tls.Config{ServerName: u.Hostname()}
+	default:
+		return nil, fmt.Errorf("unsupported redis URL scheme %q (want redis or rediss)", u.Scheme)
+	}
+	if u.Port() == "" {
+		r.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	if u.User != nil {
+		r.username = u.User.Username()
+		r.password, _ = u.User.Password()
+	}
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if r.db, err = strconv.Atoi(db); err != nil {
+			return nil, fmt.Errorf("invalid redis database %q", db)
+		}
+	}
+	return r, nil
+}
+
+// Incr implements Counter. The increment and expiry run in one transaction so
+// a counter never outlives its period.
+func (r *Redis) Incr(ctx context.Context, key string, n int64, expireAt time.Time) (int64, error) {
+	replies, err := r.do(ctx,
+		[]string{"MULTI"},
+		[]string{"INCRBY", key, strconv.FormatInt(n, 10)},
+		[]string{"EXPIREAT", key, strconv.FormatInt(expireAt.Unix(), 10)},
+		[]string{"EXEC"},
+	)
+	if err != nil {
+		return 0, err
+	}
+	exec, ok := replies[3].([]any)
+	if !ok || len(exec) != 2 {
+		return 0, fmt.Errorf("redis: unexpected EXEC reply %v", replies[3])
+	}
+	total, ok := exec[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: unexpected INCRBY reply %v", exec[0])
+	}
+	return total, nil
+}
+
+// Close closes idle connections
+func (r *Redis) Close() error {
+	for {
+		select {
+		case c := <-r.idle:
+			c.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// do pipelines cmds on one connection and returns their replies. Error
+// replies are returned as errors.
+func (r *Redis) do(ctx context.Context, cmds ...[]string) ([]any, error) {
+	c, err := r.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := c.do(ctx, cmds...)
+	var redisErr redisError
+	if err != nil && !errors.As(err, &redisErr) {
+		// The connection may be mid-reply; don't reuse it
+		c.Close()
+		return nil, err
+	}
+	r.put(c)
+	return replies, err
+}
+
+func (r *Redis) get(ctx context.Context) (*redisConn, error) {
+	select {
+	case c := <-r.idle:
+		return c, nil
+	default:
+	}
+
+	d := net.Dialer{Timeout: 5 * time.Second}
+	var conn net.Conn
+	var err error
+	if r.tls != nil {
+		td := tls.Dialer{NetDialer: &d, Config: r.tls}
+		conn, err = td.DialContext(ctx, "tcp", r.addr)
+	} else {
+		conn, err = d.DialContext(ctx, "tcp", r.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &redisConn{Conn: conn, rd: bufio.NewReader(conn)}
+
+	var setup [][]string
+	if r.password != "" {
+		if r.username != "" {
+			setup = append(setup, []string{"AUTH", r.username, r.password})
+		} else {
+			setup = append(setup, []string{"AUTH", r.password})
+		}
+	}
+	if r.db != 0 {
+		setup = append(setup, []string{"SELECT", strconv.Itoa(r.db)})
+	}
+	if len(setup) > 0 {
+		if _, err := c.do(ctx, setup...); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (r *Redis) put(c *redisConn) {
+	select {
+	case r.idle <- c:
+	default:
+		c.Close()
+	}
+}
+
+type redisConn struct {
+	net.Conn
+	rd *bufio.Reader
+}
+
+// redisError is an error reply from the server
+type redisError string
+
+func (e redisError) Error() string { return "redis: " + string(e) }
+
+func (c *redisConn) do(ctx context.Context, cmds ...[]string) ([]any, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(5 * time.Second)
+	}
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	for _, cmd := range cmds {
+		fmt.Fprintf(&b, "*%d\r\n", len(cmd))
+		for _, arg := range cmd {
+			fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+		}
+	}
+	if _, err := c.Write([]byte(b.String())); err != nil {
+		return nil, err
+	}
+
+	// Read every reply even after an error reply so the connection stays usable
+	replies := make([]any, len(cmds))
+	var firstErr error
+	for i := range cmds {
+		v, err := c.read()
+		var redisErr redisError
+		if err != nil && !errors.As(err, &redisErr) {
+			return nil, err
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		replies[i] = v
+	}
+	return replies, firstErr
+}
+
+// read parses one RESP2 reply
+func (c *redisConn) read() (any, error) {
+	line, err := c.rd.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("redis: empty reply")
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(c.rd, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		items := make([]any, n)
+		var firstErr error
+		for i := range items {
+			v, err := c.read()
+			var redisErr redisError
+			if err != nil && !errors.As(err, &redisErr) {
+				return nil, err
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			items[i] = v
+		}
+		return items, firstErr
+	}
+	return nil, fmt.Errorf("redis: unexpected reply %q", line)
+}
@@ -0,0 +1,148 @@
+package realtime
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Message is a message published on a channel
+type Message struct {
+	Channel string
+	Data    []byte
+}
+
+// Broker carries messages between the hubs of every instance. A hub
+// subscribes to a channel while it has clients on it.
+type Broker interface {
+	Publish(ctx context.Context, channel string, data []byte) error
+	Subscribe(ctx context.Context, channel string) error
+	Unsubscribe(ctx context.Context, channel string) error
+	// Messages delivers the messages of subscribed channels until Close
+	Messages() <-chan Message
+	Close() error
+}
+
+// Memory is a Broker within a single instance, for deployments of one
+// replica and for tests
+type Memory struct {
+	mu         sync.Mutex
+	subscribed map[string]bool
+	closed     bool
+	messages   chan Message
+}
+
+// NewMemory returns an in-process Broker
+func NewMemory() *Memory {
+	return &Memory{subscribed: map[string]bool{}, messages: make(chan Message, 256)}
+}
+
+// Publish implements Broker
+func (m *Memory) Publish(ctx context.Context, channel string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed || !m.subscribed[channel] {
+		return nil
+	}
+	select {
+	case m.messages <- Message{Channel: channel, Data: data}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe implements Broker
+func (m *Memory) Subscribe(ctx context.Context, channel string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribed[channel] = true
+	return nil
+}
+
+// Unsubscribe implements Broker
+func (m *Memory) Unsubscribe(ctx context.Context, channel string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.subscribed, channel)
+	return nil
+}
+
+// Messages implements Broker
+func (m *Memory) Messages() <-chan Message {
+	return m.messages
+}
+
+// Close implements Broker
+func (m *Memory) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.closed {
+		m.closed = true
+		close(m.messages)
+	}
+	return nil
+}
+
+// redisPrefix namespaces the Redis channels, which may be shared with other services
+const redisPrefix = "streamify:realtime:"
+
+// Redis is a Broker over Redis pub/sub, reaching clients connected to any
+// replica. The connection resubscribes by itself after it drops; messages
+// published meanwhile are lost, as pub/sub keeps no history.
+type Redis struct {
+	client   *redis.Client
+	pubsub   *redis.PubSub
+	messages chan Message
+}
+
+// NewRedis connects to the Redis server at url, e.g. redis://localhost:6379/0
+func NewRedis(ctx context.Context, url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, err
+	}
+	r := &Redis{client: client, pubsub: client.Subscribe(ctx), messages: make(chan Message, 256)}
+	go r.receive()
+	return r, nil
+}
+
+func (r *Redis) receive() {
+	defer close(r.messages)
+	for m := range r.pubsub.Channel(redis.WithChannelSize(256)) {
+		r.messages <- Message{Channel: strings.TrimPrefix(m.Channel, redisPrefix), Data: []byte(m.Payload)}
+	}
+}
+
+// Publish implements Broker
+func (r *Redis) Publish(ctx context.Context, channel string, data []byte) error {
+	return r.client.Publish(ctx, redisPrefix+channel, data).Err()
+}
+
+// Subscribe implements Broker
+func (r *Redis) Subscribe(ctx context.Context, channel string) error {
+	return r.pubsub.Subscribe(ctx, redisPrefix+channel)
+}
+
+// Unsubscribe implements Broker
+func (r *Redis) Unsubscribe(ctx context.Context, channel string) error {
+	return r.pubsub.Unsubscribe(ctx, redisPrefix+channel)
+}
+
+// Messages implements Broker
+func (r *Redis) Messages() <-chan Message {
+	return r.messages
+}
+
+// Close implements Broker
+func (r *Redis) Close() error {
+	r.pubsub.Close()
+	return r.client.Close()
+}
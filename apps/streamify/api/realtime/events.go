@@ -0,0 +1,62 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"streamify/events"
+
+	"github.com/google/uuid"
+)
+
+// userFields are the payload fields naming users an event concerns
+var userFields = []string{"user_id", "owner_id", "follower_id", "followee_id"}
+
+// publicEvents go to every connected client
+var publicEvents = map[string]bool{"policy.published": true}
+
+type publisher struct {
+	next events.Publisher
+	hub  *Hub
+}
+
+// Publisher returns an events.Publisher that publishes through next and also
+// pushes each event to the clients of the users it concerns. Only next's
+// errors are returned, since a republished event would reach next twice;
+// realtime delivery is best effort.
+func Publisher(next events.Publisher, h *Hub) events.Publisher {
+	return &publisher{next: next, hub: h}
+}
+
+func (p *publisher) Publish(ctx context.Context, e events.Envelope) error {
+	err := p.next.Publish(ctx, e)
+	data, merr := json.Marshal(e)
+	if merr != nil {
+		return err
+	}
+	for _, ch := range channelsOf(e) {
+		if perr := p.hub.Publish(ctx, ch, data); perr != nil {
+			logger.Warn("pushing event failed", "type", e.Type, "channel", ch, "error", perr)
+		}
+	}
+	return err
+}
+
+// channelsOf returns the channels an event is pushed to
+func channelsOf(e events.Envelope) []string {
+	if publicEvents[e.Type] {
+		return []string{PublicChannel}
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(e.Data, &fields); err != nil {
+		return nil
+	}
+	var channels []string
+	for _, name := range userFields {
+		s, _ := fields[name].(string)
+		if id, err := uuid.Parse(s); err == nil {
+			channels = append(channels, UserChannel(id))
+		}
+	}
+	return channels
+}
@@ -0,0 +1,255 @@
+// Package realtime pushes events to connected clients over server-sent
+// events. Messages go through a Broker, Redis pub/sub when several replicas
+// run, so a client receives events raised on any instance. Each hub
+// subscribes to a channel only while one of its clients is on it, and a
+// client that can't keep up is disconnected rather than slowing the others.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"streamify/logging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("realtime")
+
+const (
+	// clientBuffer is how many messages may wait for a slow client before it
+	// is disconnected
+	clientBuffer = 64
+	// keepAlive is how often an idle stream gets a comment, so proxies don't
+	// close it
+	keepAlive = 25 * time.Second
+)
+
+// PublicChannel carries events for every client, such as policy updates
+const PublicChannel = "public"
+
+// UserChannel carries the events concerning one user, on all their devices
+func UserChannel(id uuid.UUID) string {
+	return "user:" + id.String()
+}
+
+type client struct {
+	send    chan []byte
+	dropped chan struct{}
+	once    sync.Once
+}
+
+// Hub relays broker messages to the clients connected to this instance
+type Hub struct {
+	broker Broker
+
+	// subMu serializes broker subscription changes, so a channel's
+	// subscribe and unsubscribe calls can't overtake each other
+	subMu    sync.Mutex
+	mu       sync.Mutex
+	channels map[string]map[*client]struct{}
+
+	clients   atomic.Int64
+	delivered atomic.Int64
+	dropped   atomic.Int64
+}
+
+// NewHub returns a Hub relaying b's messages
+func NewHub(b Broker) *Hub {
+	h := &Hub{broker: b, channels: map[string]map[*client]struct{}{}}
+	go h.dispatch()
+	return h
+}
+
+// Publish sends data to the clients on channel, on every instance
+func (h *Hub) Publish(ctx context.Context, channel string, data []byte) error {
+	return h.broker.Publish(ctx, channel, data)
+}
+
+// Close stops relaying and closes the broker
+func (h *Hub) Close() error {
+	return h.broker.Close()
+}
+
+func (h *Hub) dispatch() {
+	for m := range h.broker.Messages() {
+		h.mu.Lock()
+		for c := range h.channels[m.Channel] {
+			select {
+			case c.send <- m.Data:
+				h.delivered.Add(1)
+			default:
+				c.once.Do(func() {
+					close(c.dropped)
+					h.dropped.Add(1)
+				})
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+// join adds c to channels, subscribing the broker to those it's the first on
+func (h *Hub) join(ctx context.Context, c *client, channels []string) error {
+	h.subMu.Lock()
+	defer h.subMu.Unlock()
+	for i, ch := range channels {
+		h.mu.Lock()
+		members := h.channels[ch]
+		if members == nil {
+			members = map[*client]struct{}{}
+			h.channels[ch] = members
+		}
+		members[c] = struct{}{}
+		first := len(members) == 1
+		h.mu.Unlock()
+		if first {
+			if err := h.broker.Subscribe(ctx, ch); err != nil {
+				h.leaveLocked(c, channels[:i+1])
+				return err
+			}
+		}
+	}
+	h.clients.Add(1)
+	return nil
+}
+
+// leave removes c from channels, unsubscribing the broker from those it was
+// the last on
+func (h *Hub) leave(c *client, channels []string) {
+	h.subMu.Lock()
+	defer h.subMu.Unlock()
+	h.leaveLocked(c, channels)
+	h.clients.Add(-1)
+}
+
+func (h *Hub) leaveLocked(c *client, channels []string) {
+	for _, ch := range channels {
+		h.mu.Lock()
+		delete(h.channels[ch], c)
+		last := len(h.channels[ch]) == 0
+		if last {
+			delete(h.channels, ch)
+		}
+		h.mu.Unlock()
+		if last {
+			if err := h.broker.Unsubscribe(context.Background(), ch); err != nil {
+				logger.Warn("unsubscribing channel failed", "channel", ch, "error", err)
+			}
+		}
+	}
+}
+
+// channelsFor resolves the channel names a client asked for
+func channelsFor(ctx context.Context, requested string) ([]string, error) {
+	if requested == "" {
+		requested = "me,public"
+	}
+	var channels []string
+	for _, name := range strings.Split(requested, ",") {
+		switch strings.TrimSpace(name) {
+		case "me":
+			userID, ok := viewer.UserID(ctx)
+			if !ok {
+				return nil, fmt.Errorf("channel me needs a signed-in user")
+			}
+			channels = append(channels, UserChannel(userID))
+		case "public":
+			channels = append(channels, PublicChannel)
+		default:
+			return nil, fmt.Errorf("unknown channel %q", name)
+		}
+	}
+	return channels, nil
+}
+
+// Stream serves the caller's events as server-sent events until they
+// disconnect. ?channels= picks "me", the caller's own events, and "public";
+// both by default. A client that falls behind gets an overflow event and is
+// disconnected, and should reload its state after reconnecting.
+func Stream(h *Hub) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		channels, err := channelsFor(c.Request.Context(), c.Query("channels"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		cl := &client{send: make(chan []byte, clientBuffer), dropped: make(chan struct{})}
+		if err := h.join(c.Request.Context(), cl, channels); err != nil {
+			logger.Error("subscribing client failed", "error", err)
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": "realtime events are unavailable"})
+			return
+		}
+		defer h.leave(cl, channels)
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		// Stops nginx buffering the stream
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		fmt.Fprint(c.Writer, ": connected\n\n")
+		c.Writer.Flush()
+
+		ticker := time.NewTicker(keepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case data := <-cl.send:
+				if err := writeEvent(c.Writer, data); err != nil {
+					return
+				}
+			case <-cl.dropped:
+				fmt.Fprint(c.Writer, "event: overflow\ndata: {}\n\n")
+				c.Writer.Flush()
+				return
+			case <-ticker.C:
+				if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case <-c.Request.Context().Done():
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent writes an event envelope, named after its type. Only write
+// errors are returned; a malformed message is skipped.
+func writeEvent(w io.Writer, data []byte) error {
+	var head struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		logger.Warn("skipping malformed message", "error", err)
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", head.ID, head.Type, data)
+	return err
+}
+
+// Health implements resilience.Component
+func (h *Hub) Health() (bool, any) {
+	return true, gin.H{"clients": h.clients.Load()}
+}
+
+// WriteMetrics implements resilience.Component
+func (h *Hub) WriteMetrics(w io.Writer) {
+	h.mu.Lock()
+	channels := len(h.channels)
+	h.mu.Unlock()
+	fmt.Fprintf(w, "# HELP realtime_clients Clients connected to this instance's event stream.\n# TYPE realtime_clients gauge\nrealtime_clients %d\n", h.clients.Load())
+	fmt.Fprintf(w, "# HELP realtime_channels Channels this instance is subscribed to.\n# TYPE realtime_channels gauge\nrealtime_channels %d\n", channels)
+	fmt.Fprintf(w, "# HELP realtime_messages_delivered_total Messages handed to connected clients.\n# TYPE realtime_messages_delivered_total counter\nrealtime_messages_delivered_total %d\n", h.delivered.Load())
+	fmt.Fprintf(w, "# HELP realtime_clients_dropped_total Clients disconnected for falling behind.\n# TYPE realtime_clients_dropped_total counter\nrealtime_clients_dropped_total %d\n", h.dropped.Load())
+}
@@ -0,0 +1,72 @@
+package referrals
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/invite"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Code is one of a user's referral codes with the link to share it by
+type Code struct {
+	Code      string     `json:"code"`
+	Link      string     `json:"link"`
+	Used      bool       `json:"used"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Status is how a user is doing in the referral program
+type Status struct {
+	Codes     []Code          `json:"codes"`
+	Referrals []*ent.Referral `json:"referrals"`
+	// RewardDays adds up the free premium days earned
+	RewardDays int `json:"reward_days"`
+}
+
+// Mine returns the current user's referral codes with their signup links,
+// the signups made with them and the premium days they earned. Codes are
+// created with POST /api/v1/me/invites.
+func Mine(client *ent.Client, appURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		invs, err := client.Invite.Query().
+			Where(invite.CreatedByEQ(userID), invite.KindEQ(invite.KindReferral)).
+			Order(ent.Desc(invite.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		refs, err := Of(ctx, client, userID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		status := Status{Codes: make([]Code, len(invs)), Referrals: refs}
+		for i, inv := range invs {
+			status.Codes[i] = Code{
+				Code:      inv.Code,
+				Link:      strings.TrimRight(appURL, "/") + "/register?invite=" + url.QueryEscape(inv.Code),
+				Used:      inv.Uses >= inv.MaxUses,
+				ExpiresAt: inv.ExpiresAt,
+			}
+		}
+		for _, r := range refs {
+			status.RewardDays += r.RewardDays
+		}
+		c.JSON(http.StatusOK, status)
+	}
+}
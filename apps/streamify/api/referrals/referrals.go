@@ -0,0 +1,129 @@
+// Package referrals rewards users for the people who sign up with their
+// referral codes. Each signup with a referral code is recorded as a referral
+// of the code's creator, who earns free premium days for it unless the
+// signup looks like them referring themselves.
+package referrals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/entitlement"
+	"streamify/ent/referral"
+	"streamify/ent/schema/rule"
+	"streamify/entitlements"
+	"streamify/logging"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("referrals")
+
+// rewardDays is how many free premium days each referral earns
+var rewardDays = 30
+
+// FromEnv sets the free premium days each referral earns from
+// REFERRAL_REWARD_DAYS, when set; 0 records referrals without rewarding them
+func FromEnv() error {
+	v := os.Getenv("REFERRAL_REWARD_DAYS")
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 || n > 365 {
+		return fmt.Errorf("REFERRAL_REWARD_DAYS must be between 0 and 365, got %q", v)
+	}
+	rewardDays = n
+	return nil
+}
+
+// mailbox reduces an email to the mailbox it delivers to: case, +tags and,
+// on Gmail, dots make no difference
+func mailbox(email string) string {
+	local, domain, _ := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	local, _, _ = strings.Cut(local, "+")
+	if domain == "gmail.com" || domain == "googlemail.com" {
+		local = strings.ReplaceAll(local, ".", "")
+		domain = "gmail.com"
+	}
+	return local + "@" + domain
+}
+
+// suspicious returns why a signup with email from ip looks like referrer
+// referring themselves, or nil when it doesn't
+func suspicious(ctx context.Context, client *ent.Client, referrer *ent.User, email, ip string) (*referral.Reason, error) {
+	if mailbox(email) == mailbox(referrer.Email) {
+		r := referral.ReasonSameEmail
+		return &r, nil
+	}
+	if ip == "" {
+		return nil, nil
+	}
+	// The referrer's other referrals, and their own signup if they were referred
+	shared, err := client.Referral.Query().
+		Where(
+			referral.SignupIPEQ(ip),
+			referral.Or(referral.ReferrerIDEQ(referrer.ID), referral.ReferredIDEQ(referrer.ID)),
+		).
+		Exist(ctx)
+	if err != nil || !shared {
+		return nil, err
+	}
+	r := referral.ReasonSharedIP
+	return &r, nil
+}
+
+// Attribute records that referred signed up from ip with the referral code
+// inv and rewards the code's creator, stacking the days after the premium
+// they already have. Call it in the registration transaction, with its
+// client, so the referral commits with the user.
+func Attribute(ctx context.Context, client *ent.Client, inv *ent.Invite, referred *ent.User, ip string) (*ent.Referral, error) {
+	if inv.CreatedBy == nil {
+		return nil, errors.New("referral code has no creator")
+	}
+	// The reward is granted for the referrer, not by them
+	ctx = rule.SystemContext(ctx)
+	referrer, err := client.User.Get(ctx, *inv.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	create := client.Referral.Create().
+		SetReferrerID(referrer.ID).
+		SetReferredID(referred.ID).
+		SetInviteID(inv.ID).
+		SetSignupIP(ip)
+
+	reason, err := suspicious(ctx, client, referrer, referred.Email, ip)
+	if err != nil {
+		return nil, err
+	}
+	if reason != nil {
+		logger.Info("referral rejected", "referrer", referrer.ID, "referred", referred.ID, "reason", *reason)
+		return create.SetStatus(referral.StatusRejected).SetReason(*reason).Save(ctx)
+	}
+	create.SetStatus(referral.StatusRewarded)
+	if rewardDays > 0 {
+		e, err := entitlements.Extend(ctx, client, referrer.ID, entitlement.FeaturePremium, entitlement.SourceReferral, 0, rewardDays)
+		switch {
+		case errors.Is(err, entitlements.ErrUnlimited):
+		case err != nil:
+			return nil, err
+		default:
+			create.SetEntitlementID(e.ID).SetRewardDays(rewardDays)
+		}
+	}
+	return create.Save(ctx)
+}
+
+// Of returns userID's referrals, newest first
+func Of(ctx context.Context, client *ent.Client, userID uuid.UUID) ([]*ent.Referral, error) {
+	return client.Referral.Query().
+		Where(referral.ReferrerIDEQ(userID)).
+		Order(ent.Desc(referral.FieldCreatedAt)).
+		All(ctx)
+}
@@ -0,0 +1,304 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/play"
+	"streamify/ent/track"
+	"streamify/storage"
+
+	"github.com/google/uuid"
+)
+
+// Prefix is the storage key prefix under which reports are written
+const Prefix = "reports/"
+
+// unlabeled is used for albums that have no label set
+const unlabeled = "unlabeled"
+
+// unknownTerritory is the ISO 3166 user-assigned code for plays without a territory
+const unknownTerritory = "ZZ"
+
+// batchSize is the number of plays loaded per query while aggregating
+const batchSize = 5000
+
+// Generator builds monthly usage reports from play history
+type Generator struct {
+	client *ent.Client
+	store  storage.Storage
+}
+
+// NewGenerator creates a report generator writing to store
+func NewGenerator(client *ent.Client, store storage.Storage) *Generator {
+	return &Generator{client: client, store: store}
+}
+
+// usage accumulates plays and unique listeners
+type usage struct {
+	plays     int
+	listeners map[uuid.UUID]struct{}
+}
+
+func newUsage() *usage {
+	return &usage{listeners: make(map[uuid.UUID]struct{})}
+}
+
+func (u *usage) add(userID uuid.UUID) {
+	u.plays++
+	u.listeners[userID] = struct{}{}
+}
+
+// labelUsage is the usage of a single label broken down by territory
+type labelUsage struct {
+	total       *usage
+	territories map[string]*usage
+}
+
+// MonthKey formats the reporting period used in storage keys, e.g. 2025-10
+func MonthKey(month time.Time) string {
+	return month.Format("2006-01")
+}
+
+// GeneratePreviousMonth generates the report for the last complete month unless it already exists
+func (g *Generator) GeneratePreviousMonth(ctx context.Context) error {
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+
+	existing, err := g.store.List(ctx, Prefix+MonthKey(month)+"/")
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return nil
+	}
+
+	_, err = g.Generate(ctx, month)
+	return err
+}
+
+// Generate writes CSV and XML reports for every label with plays in the given month
+func (g *Generator) Generate(ctx context.Context, month time.Time) ([]string, error) {
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	labels, err := g.aggregate(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	period := MonthKey(start)
+	keys := make([]string, 0, len(labels)*2)
+	for _, name := range sortedKeys(labels) {
+		lu := labels[name]
+		base := Prefix + period + "/" + slugify(name)
+
+		csvData, err := renderCSV(period, name, lu)
+		if err != nil {
+			return keys, err
+		}
+		if err := g.store.Put(ctx, base+".csv", bytes.NewReader(csvData)); err != nil {
+			return keys, fmt.Errorf("writing csv report for %s: %w", name, err)
+		}
+		keys = append(keys, base+".csv")
+
+		xmlData, err := renderXML(period, name, lu)
+		if err != nil {
+			return keys, err
+		}
+		if err := g.store.Put(ctx, base+".xml", bytes.NewReader(xmlData)); err != nil {
+			return keys, fmt.Errorf("writing xml report for %s: %w", name, err)
+		}
+		keys = append(keys, base+".xml")
+	}
+
+	return keys, nil
+}
+
+// aggregate loads the plays in [start, end) in batches and groups them by label and territory
+func (g *Generator) aggregate(ctx context.Context, start, end time.Time) (map[string]*labelUsage, error) {
+	labels := make(map[string]*labelUsage)
+	trackLabels := make(map[uuid.UUID]string)
+
+	for offset := 0; ; offset += batchSize {
+		plays, err := g.client.Play.Query().
+			Where(play.PlayedAtGTE(start), play.PlayedAtLT(end)).
+			Order(ent.Asc(play.FieldPlayedAt), ent.Asc(play.FieldID)).
+			Limit(batchSize).
+			Offset(offset).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(plays) == 0 {
+			break
+		}
+
+		if err := g.resolveLabels(ctx, plays, trackLabels); err != nil {
+			return nil, err
+		}
+
+		for _, p := range plays {
+			name := trackLabels[p.TrackID]
+			lu, ok := labels[name]
+			if !ok {
+				lu = &labelUsage{total: newUsage(), territories: make(map[string]*usage)}
+				labels[name] = lu
+			}
+
+			territory := strings.ToUpper(p.Territory)
+			if territory == "" {
+				territory = unknownTerritory
+			}
+			tu, ok := lu.territories[territory]
+			if !ok {
+				tu = newUsage()
+				lu.territories[territory] = tu
+			}
+
+			lu.total.add(p.UserID)
+			tu.add(p.UserID)
+		}
+
+		if len(plays) < batchSize {
+			break
+		}
+	}
+
+	return labels, nil
+}
+
+// resolveLabels loads the album label for every track in plays not already in cache
+func (g *Generator) resolveLabels(ctx context.Context, plays []*ent.Play, cache map[uuid.UUID]string) error {
+	missing := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+	for _, p := range plays {
+		if _, ok := cache[p.TrackID]; ok {
+			continue
+		}
+		if _, ok := seen[p.TrackID]; ok {
+			continue
+		}
+		seen[p.TrackID] = struct{}{}
+		missing = append(missing, p.TrackID)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	tracks, err := g.client.Track.Query().
+		Where(track.IDIn(missing...)).
+		WithAlbum().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range tracks {
+		name := unlabeled
+		if t.Edges.Album != nil && t.Edges.Album.Label != "" {
+			name = t.Edges.Album.Label
+		}
+		cache[t.ID] = name
+	}
+	return nil
+}
+
+// renderCSV renders a label report with one row per territory followed by a total row
+func renderCSV(period, label string, lu *labelUsage) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	rows := [][]string{{"period", "label", "territory", "plays", "unique_listeners"}}
+	for _, code := range sortedKeys(lu.territories) {
+		tu := lu.territories[code]
+		rows = append(rows, []string{period, label, code, strconv.Itoa(tu.plays), strconv.Itoa(len(tu.listeners))})
+	}
+	rows = append(rows, []string{period, label, "TOTAL", strconv.Itoa(lu.total.plays), strconv.Itoa(len(lu.total.listeners))})
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// xmlReport is a DDEX-like sales/usage report document
+type xmlReport struct {
+	XMLName         xml.Name       `xml:"UsageReport"`
+	Period          string         `xml:"ReportingPeriod"`
+	Label           string         `xml:"LabelName"`
+	CreatedAt       string         `xml:"MessageCreatedDateTime"`
+	Plays           int            `xml:"Summary>NumberOfStreams"`
+	UniqueListeners int            `xml:"Summary>NumberOfUniqueListeners"`
+	Territories     []xmlTerritory `xml:"UsageByTerritory>Territory"`
+}
+
+type xmlTerritory struct {
+	Code            string `xml:"TerritoryCode,attr"`
+	Plays           int    `xml:"NumberOfStreams"`
+	UniqueListeners int    `xml:"NumberOfUniqueListeners"`
+}
+
+// renderXML renders a label report as a DDEX-like XML document
+func renderXML(period, label string, lu *labelUsage) ([]byte, error) {
+	report := xmlReport{
+		Period:          period,
+		Label:           label,
+		CreatedAt:       time.Now().UTC().Format(time.RFC3339),
+		Plays:           lu.total.plays,
+		UniqueListeners: len(lu.total.listeners),
+	}
+	for _, code := range sortedKeys(lu.territories) {
+		tu := lu.territories[code]
+		report.Territories = append(report.Territories, xmlTerritory{
+			Code:            code,
+			Plays:           tu.plays,
+			UniqueListeners: len(tu.listeners),
+		})
+	}
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// slugify converts a label name into a safe file name
+func slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return unlabeled
+	}
+	return slug
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
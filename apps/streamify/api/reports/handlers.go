@@ -0,0 +1,71 @@
+package reports
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+
+	"streamify/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	monthPattern = regexp.MustCompile(`^\d{4}-\d{2}$`)
+	filePattern  = regexp.MustCompile(`^[a-z0-9-]+\.(csv|xml)$`)
+)
+
+// ListReports returns all generated reports
+func ListReports(store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		prefix := Prefix
+		if month := c.Query("month"); month != "" {
+			if !monthPattern.MatchString(month) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "month must be formatted as YYYY-MM"})
+				return
+			}
+			prefix += month + "/"
+		}
+
+		objects, err := store.List(c.Request.Context(), prefix)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"reports": objects})
+	}
+}
+
+// DownloadReport streams a single report file
+func DownloadReport(store storage.Storage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		month := c.Param("month")
+		file := c.Param("file")
+		if !monthPattern.MatchString(month) || !filePattern.MatchString(file) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid report path"})
+			return
+		}
+
+		r, err := store.Get(c.Request.Context(), Prefix+month+"/"+file)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "report not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer r.Close()
+
+		contentType := "text/csv"
+		if path.Ext(file) == ".xml" {
+			contentType = "application/xml"
+		}
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Disposition", `attachment; filename="`+month+"-"+file+`"`)
+		c.Status(http.StatusOK)
+		io.Copy(c.Writer, r)
+	}
+}
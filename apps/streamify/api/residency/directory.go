@@ -0,0 +1,35 @@
+package residency
+
+import (
+	"context"
+	"slices"
+
+	entgo "entgo.io/ent"
+
+	"streamify/ent"
+)
+
+// Regional returns an interceptor and a hook keeping queries and mutations of
+// every entity type but the given ones on the home database, whatever region
+// their context names. Inside a transaction the database was picked when the
+// transaction began, so transactions touching other types must begin with a
+// Home context.
+func Regional(types ...string) (ent.Interceptor, ent.Hook) {
+	inter := ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			if qc := entgo.QueryFromContext(ctx); qc != nil && !slices.Contains(types, qc.Type) {
+				ctx = Home(ctx)
+			}
+			return next.Query(ctx, q)
+		})
+	})
+	hook := func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !slices.Contains(types, m.Type()) {
+				ctx = Home(ctx)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	return inter, hook
+}
@@ -0,0 +1,166 @@
+package residency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect"
+)
+
+// Driver is an Ent driver that sends each statement to the database of the
+// region its context names. A transaction stays on the database it began on.
+type Driver struct {
+	home    dialect.Driver
+	regions map[string]dialect.Driver
+}
+
+// NewDriver routes to home and the drivers of regions, keyed by region name
+func NewDriver(home dialect.Driver, regions map[string]dialect.Driver) *Driver {
+	return &Driver{home: home, regions: regions}
+}
+
+// Regions returns the names of the configured regions, sorted
+func (d *Driver) Regions() []string {
+	names := make([]string, 0, len(d.regions))
+	for name := range d.regions {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// resolve returns the driver for ctx's region. A region without a database
+// fails rather than falling back to home, so pinned data never lands there.
+func (d *Driver) resolve(ctx context.Context) (dialect.Driver, error) {
+	region := FromContext(ctx)
+	if region == "" {
+		return d.home, nil
+	}
+	drv, ok := d.regions[region]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownRegion, region)
+	}
+	return drv, nil
+}
+
+// Exec executes a statement on ctx's region
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	return drv.Exec(ctx, query, args, v)
+}
+
+// Query executes a query on ctx's region
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	return drv.Query(ctx, query, args, v)
+}
+
+// ExecContext executes a raw statement on ctx's region
+func (d *Driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ex, ok := drv.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, errors.New("residency: driver does not support ExecContext")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext executes a raw query on ctx's region
+func (d *Driver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q, ok := drv.(interface {
+		QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	})
+	if !ok {
+		return nil, errors.New("residency: driver does not support QueryContext")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
+
+// Tx starts a transaction on ctx's region
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return drv.Tx(ctx)
+}
+
+// BeginTx starts a transaction with options on ctx's region when its driver
+// supports them
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, err := d.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := drv.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return drv.Tx(ctx)
+	}
+	return b.BeginTx(ctx, opts)
+}
+
+// Dialect returns the home database's dialect, which every region shares
+func (d *Driver) Dialect() string {
+	return d.home.Dialect()
+}
+
+// Close closes every region's driver and then home's
+func (d *Driver) Close() error {
+	var errs []error
+	for _, name := range d.Regions() {
+		errs = append(errs, d.regions[name].Close())
+	}
+	errs = append(errs, d.home.Close())
+	return errors.Join(errs...)
+}
+
+// enabled is the driver Pin checks region names against; nil until Enable
+var enabled *Driver
+
+// Enable makes d's regions the ones users can be pinned to
+func Enable(d *Driver) {
+	enabled = d
+}
+
+// Known reports whether region has a database
+func Known(region string) bool {
+	if enabled == nil {
+		return false
+	}
+	_, ok := enabled.regions[region]
+	return ok
+}
+
+// Each returns a job running fn against home and then every region, for
+// maintenance that every database needs, such as creating partitions
+func (d *Driver) Each(fn func(ctx context.Context) error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		errs := []error{fn(Home(ctx))}
+		for _, name := range d.Regions() {
+			if err := fn(NewContext(ctx, name)); err != nil {
+				errs = append(errs, fmt.Errorf("region %s: %w", name, err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
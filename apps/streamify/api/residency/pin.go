@@ -0,0 +1,98 @@
+package residency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/like"
+	"streamify/ent/play"
+	"streamify/ent/playlist"
+	"streamify/ids"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrPinned is returned when moving a user who is already pinned
+	ErrPinned = errors.New("user is already pinned to another region")
+	// ErrHasData is returned when pinning a user whose data is already in
+	// the home region; it would be left behind
+	ErrHasData = errors.New("user already has plays, likes or playlists in the home region")
+)
+
+// Pin sets the region the user's data is kept in. It's set once, before the
+// user has any data; moving data between regions isn't supported.
+func Pin(ctx context.Context, client *ent.Client, userID uuid.UUID, region string) (*ent.User, error) {
+	if !Known(region) {
+		return nil, ErrUnknownRegion
+	}
+	ctx = Home(ctx)
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Region != nil {
+		if *u.Region == region {
+			return u, nil
+		}
+		return nil, ErrPinned
+	}
+	for _, exists := range []func(context.Context) (bool, error){
+		client.Play.Query().Where(play.UserIDEQ(userID)).Exist,
+		client.Like.Query().Where(like.UserIDEQ(userID)).Exist,
+		client.Playlist.Query().Where(playlist.OwnerIDEQ(userID)).Exist,
+	} {
+		found, err := exists(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return nil, ErrHasData
+		}
+	}
+	u, err = client.User.UpdateOne(u).SetRegion(region).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("user pinned", "user_id", userID, "region", region)
+	return u, nil
+}
+
+// PinUserRequest is the request body for PinUser
+type PinUserRequest struct {
+	Region string `json:"region" binding:"required"`
+}
+
+// PinUser pins a user's data to a region (admin)
+func PinUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+			return
+		}
+		var req PinUserRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		u, err := Pin(c.Request.Context(), client, id, req.Region)
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrUnknownRegion):
+				c.JSON(http.StatusBadRequest, gin.H{"error": "unknown region " + req.Region})
+			case ent.IsNotFound(err):
+				c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			case errors.Is(err, ErrPinned), errors.Is(err, ErrHasData):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
+		c.JSON(http.StatusOK, u)
+	}
+}
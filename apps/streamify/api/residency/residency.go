@@ -0,0 +1,104 @@
+// Package residency keeps each user's data in the database of the region
+// they are pinned to. A deployment has a home database (DATABASE_URL) and
+// one database per region named in REGION_DATABASES, all with the same
+// schema. Queries are routed by Driver to the database of the region their
+// context names: the one set with NewContext, or else the signed-in user's.
+//
+// Only the entity types passed to Regional are kept in regions; the rest,
+// such as accounts, sign-in state and the catalog, stay in the home database.
+// Regional databases need the users and catalog tables replicated to them
+// from home (e.g. with Postgres logical replication) for their foreign keys
+// and joins; the API doesn't copy them. Admins
+// are never pinned, so admin routes see the home database.
+package residency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"streamify/logging"
+	"streamify/viewer"
+)
+
+var logger = logging.For("residency")
+
+var (
+	// ErrUnknownRegion is returned for a region without a configured database
+	ErrUnknownRegion = errors.New("unknown region")
+	// ErrCrossRegion is returned when a request reaches for data pinned to
+	// another region than the one it runs in
+	ErrCrossRegion = errors.New("data is pinned to another region")
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx whose queries go to region's database,
+// whoever the viewer is. The empty region is home.
+func NewContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, region)
+}
+
+// Home returns a copy of ctx whose queries go to the home database, for
+// directory data such as sign-in state and security alerts
+func Home(ctx context.Context) context.Context {
+	return NewContext(ctx, "")
+}
+
+// FromContext returns the region ctx's queries go to: the one set with
+// NewContext, or else the viewer's. Admins and requests without a viewer
+// use the home region, the empty string.
+func FromContext(ctx context.Context) string {
+	if region, ok := ctx.Value(ctxKey{}).(string); ok {
+		return region
+	}
+	v := viewer.FromContext(ctx)
+	if v == nil || v.IsAdmin() {
+		return ""
+	}
+	return v.Region
+}
+
+// Check returns ErrCrossRegion unless data pinned to region, nil for home,
+// may be used by ctx's request
+func Check(ctx context.Context, region *string) error {
+	var r string
+	if region != nil {
+		r = *region
+	}
+	if r != FromContext(ctx) {
+		return ErrCrossRegion
+	}
+	return nil
+}
+
+// namePattern is what region names look like, e.g. eu or us-east
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// FromEnv reads REGION_DATABASES, a comma-separated list of region=DSN pairs
+// such as eu=postgres://db.eu.example.com/streamify. Empty means a single
+// region.
+func FromEnv() (map[string]string, error) {
+	dsns := map[string]string{}
+	for _, pair := range strings.Split(os.Getenv("REGION_DATABASES"), ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, dsn, ok := strings.Cut(pair, "=")
+		name, dsn = strings.TrimSpace(name), strings.TrimSpace(dsn)
+		if !ok || dsn == "" {
+			return nil, fmt.Errorf("REGION_DATABASES: want region=DSN, got %q", pair)
+		}
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("REGION_DATABASES: region names are lowercase letters, digits and dashes, got %q", name)
+		}
+		if _, dup := dsns[name]; dup {
+			return nil, fmt.Errorf("REGION_DATABASES: region %q is listed twice", name)
+		}
+		dsns[name] = dsn
+	}
+	return dsns, nil
+}
@@ -0,0 +1,257 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned without calling the dependency while its circuit is open
+var ErrOpen = errors.New("circuit open")
+
+// ErrBudgetExhausted wraps the last error when a retry was skipped because the
+// dependency's retry budget is spent
+var ErrBudgetExhausted = errors.New("retry budget exhausted")
+
+// State is the state of a circuit breaker
+type State int
+
+const (
+	Closed State = iota
+	HalfOpen
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	}
+	return "unknown"
+}
+
+// Policy configures the breaker, retries and retry budget of a dependency
+type Policy struct {
+	// MaxAttempts is the total number of attempts per call, including the first
+	MaxAttempts int
+	// BaseDelay and MaxDelay bound the exponential backoff; each wait is drawn
+	// uniformly from [0, min(MaxDelay, BaseDelay*2^retry)] (full jitter)
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// AttemptTimeout bounds each attempt; zero leaves only the caller's deadline
+	AttemptTimeout time.Duration
+	// FailureThreshold consecutive failures open the circuit
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before a trial call is let through
+	OpenTimeout time.Duration
+	// RetryBudget is the number of retries earned per call, so retries stay below
+	// that fraction of traffic even while the dependency is failing
+	RetryBudget float64
+	// Permanent reports errors that are part of normal operation (e.g. not found);
+	// they are returned immediately and don't count against the breaker
+	Permanent func(error) bool
+}
+
+// DefaultPolicy suits network services with sub-second latency
+var DefaultPolicy = Policy{
+	MaxAttempts:      3,
+	BaseDelay:        50 * time.Millisecond,
+	MaxDelay:         time.Second,
+	AttemptTimeout:   5 * time.Second,
+	FailureThreshold: 5,
+	OpenTimeout:      30 * time.Second,
+	RetryBudget:      0.2,
+}
+
+// maxBudget caps saved-up retries so a quiet period can't fund a retry storm
+const maxBudget = 10
+
+// Dependency guards calls to one external system
+type Dependency struct {
+	name   string
+	policy Policy
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	trial    bool // a half-open trial call is in flight
+	budget   float64
+	stats    Stats
+}
+
+// Stats counts what happened to calls made through a dependency
+type Stats struct {
+	Calls    uint64 `json:"calls"`
+	Failures uint64 `json:"failures"`
+	Retries  uint64 `json:"retries"`
+	Rejected uint64 `json:"rejected"`
+}
+
+// Status is a point-in-time view of a dependency for health checks and metrics
+type Status struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+	Stats
+}
+
+func newDependency(name string, p Policy) *Dependency {
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+	if p.FailureThreshold < 1 {
+		p.FailureThreshold = 1
+	}
+	return &Dependency{name: name, policy: p, budget: maxBudget}
+}
+
+// Name returns the dependency's name
+func (d *Dependency) Name() string {
+	return d.name
+}
+
+// Status returns the dependency's current breaker state and counters
+func (d *Dependency) Status() Status {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return Status{Name: d.name, State: d.currentState(time.Now()).String(), Stats: d.stats}
+}
+
+// currentState reports Open as HalfOpen once the open timeout has elapsed. Callers hold mu.
+func (d *Dependency) currentState(now time.Time) State {
+	if d.state == Open && now.Sub(d.openedAt) >= d.policy.OpenTimeout {
+		return HalfOpen
+	}
+	return d.state
+}
+
+// Do calls fn with retries, backoff and the circuit breaker. fn must be safe to retry.
+func (d *Dependency) Do(ctx context.Context, fn func(context.Context) error) error {
+	return d.do(ctx, d.policy.MaxAttempts, fn)
+}
+
+// DoOnce calls fn through the circuit breaker without retrying, for calls that
+// can't be repeated (e.g. uploads from a stream)
+func (d *Dependency) DoOnce(ctx context.Context, fn func(context.Context) error) error {
+	return d.do(ctx, 1, fn)
+}
+
+func (d *Dependency) do(ctx context.Context, attempts int, fn func(context.Context) error) error {
+	d.mu.Lock()
+	d.stats.Calls++
+	d.budget = min(d.budget+d.policy.RetryBudget, maxBudget)
+	d.mu.Unlock()
+
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if !d.spendRetry() {
+				return errors.Join(ErrBudgetExhausted, err)
+			}
+			if werr := d.wait(ctx, attempt); werr != nil {
+				return errors.Join(werr, err)
+			}
+		}
+
+		if !d.allow() {
+			return ErrOpen
+		}
+		err = d.attempt(ctx, fn)
+		if err == nil || d.isPermanent(err) {
+			d.record(true)
+			return err
+		}
+		d.record(false)
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (d *Dependency) attempt(ctx context.Context, fn func(context.Context) error) error {
+	if d.policy.AttemptTimeout <= 0 {
+		return fn(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.policy.AttemptTimeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+func (d *Dependency) isPermanent(err error) bool {
+	return d.policy.Permanent != nil && d.policy.Permanent(err)
+}
+
+// allow decides whether a call may proceed, letting a single trial through when half-open
+func (d *Dependency) allow() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch d.currentState(time.Now()) {
+	case Closed:
+		return true
+	case HalfOpen:
+		if d.trial {
+			d.stats.Rejected++
+			return false
+		}
+		d.state = HalfOpen
+		d.trial = true
+		return true
+	}
+	d.stats.Rejected++
+	return false
+}
+
+// record updates the breaker with the outcome of an attempt
+func (d *Dependency) record(ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.trial = false
+	if ok {
+		d.state = Closed
+		d.failures = 0
+		return
+	}
+	d.stats.Failures++
+	d.failures++
+	if d.state == HalfOpen || d.failures >= d.policy.FailureThreshold {
+		d.state = Open
+		d.openedAt = time.Now()
+	}
+}
+
+func (d *Dependency) spendRetry() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.budget < 1 {
+		return false
+	}
+	d.budget--
+	d.stats.Retries++
+	return true
+}
+
+// wait sleeps for the jittered backoff before retry number n (1-based)
+func (d *Dependency) wait(ctx context.Context, n int) error {
+	ceiling := d.policy.BaseDelay << (n - 1)
+	if ceiling <= 0 || ceiling > d.policy.MaxDelay {
+		ceiling = d.policy.MaxDelay
+	}
+	if ceiling <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(rand.N(ceiling + 1))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package resilience
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Registry holds the dependencies of the service so their state can be reported together
+type Registry struct {
+	mu         sync.Mutex
+	deps       map[string]*Dependency
+	components map[string]Component
+}
+
+// Component is a part of the service that reports its own health and
+// metrics, for things not guarded by a breaker such as the database pool
+type Component interface {
+	// Health reports whether the component is healthy, with details for /health
+	Health() (bool, any)
+	// WriteMetrics writes the component's metrics in the Prometheus text format
+	WriteMetrics(w io.Writer)
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{deps: make(map[string]*Dependency), components: make(map[string]Component)}
+}
+
+// Attach adds a component to the health and metrics reports under name
+func (r *Registry) Attach(name string, c Component) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.components[name] = c
+}
+
+// attached returns the components sorted by name
+func (r *Registry) attached() ([]string, []Component) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.components))
+	for name := range r.components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]Component, len(names))
+	for i, name := range names {
+		out[i] = r.components[name]
+	}
+	return names, out
+}
+
+// Register returns the dependency called name, creating it with p on first use
+func (r *Registry) Register(name string, p Policy) *Dependency {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if d, ok := r.deps[name]; ok {
+		return d
+	}
+	d := newDependency(name, p)
+	r.deps[name] = d
+	return d
+}
+
+// Statuses returns every dependency's status, sorted by name
+func (r *Registry) Statuses() []Status {
+	r.mu.Lock()
+	deps := make([]*Dependency, 0, len(r.deps))
+	for _, d := range r.deps {
+		deps = append(deps, d)
+	}
+	r.mu.Unlock()
+
+	out := make([]Status, len(deps))
+	for i, d := range deps {
+		out[i] = d.Status()
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Health reports "ok", or "degraded" when any circuit is not closed or any
+// component is unhealthy, with every dependency's and component's status.
+// It always answers 200: an open circuit degrades features but the process can still serve.
+func Health(r *Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		statuses := r.Statuses()
+		status := "ok"
+		for _, s := range statuses {
+			if s.State != Closed.String() {
+				status = "degraded"
+			}
+		}
+		body := gin.H{"status": status, "dependencies": statuses}
+		names, components := r.attached()
+		if len(components) > 0 {
+			details := make(map[string]any, len(components))
+			for i, comp := range components {
+				healthy, detail := comp.Health()
+				if !healthy {
+					status = "degraded"
+				}
+				details[names[i]] = detail
+			}
+			body["status"], body["components"] = status, details
+		}
+		c.JSON(http.StatusOK, body)
+	}
+}
+
+// Metrics exposes breaker state and call counters in the Prometheus text format
+func Metrics(r *Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		statuses := r.Statuses()
+		var b strings.Builder
+
+		b.WriteString("# HELP resilience_breaker_state Circuit breaker state (0=closed, 1=half-open, 2=open).\n")
+		b.WriteString("# TYPE resilience_breaker_state gauge\n")
+		for _, s := range statuses {
+			fmt.Fprintf(&b, "resilience_breaker_state{dependency=%q} %d\n", s.Name, stateValue(s.State))
+		}
+
+		counters := []struct {
+			name, help string
+			value      func(Status) uint64
+		}{
+			{"resilience_calls_total", "Calls made to the dependency.", func(s Status) uint64 { return s.Calls }},
+			{"resilience_failures_total", "Failed attempts.", func(s Status) uint64 { return s.Failures }},
+			{"resilience_retries_total", "Retried attempts.", func(s Status) uint64 { return s.Retries }},
+			{"resilience_rejected_total", "Calls rejected by an open circuit.", func(s Status) uint64 { return s.Rejected }},
+		}
+		for _, m := range counters {
+			fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s counter\n", m.name, m.help, m.name)
+			for _, s := range statuses {
+				fmt.Fprintf(&b, "%s{dependency=%q} %d\n", m.name, s.Name, m.value(s))
+			}
+		}
+		_, components := r.attached()
+		for _, comp := range components {
+			comp.WriteMetrics(&b)
+		}
+
+		c.Data(http.StatusOK, "text/plain; version=0.0.4; charset=utf-8", []byte(b.String()))
+	}
+}
+
+func stateValue(s string) int {
+	switch s {
+	case HalfOpen.String():
+		return 1
+	case Open.String():
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+// Package revocation keeps the IDs (jti) of revoked tokens until the tokens
+// would have expired anyway, so they can be refused before then. Revocations
+// are kept in Redis when REDIS_URL is set so every instance refuses them.
+package revocation
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"streamify/logging"
+	"streamify/resilience"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var logger = logging.For("revocation")
+
+// Store records revoked token IDs
+type Store interface {
+	// Revoke refuses the token with id until it expires at until
+	Revoke(ctx context.Context, id string, until time.Time) error
+	// Revoked reports whether the token with id was revoked
+	Revoked(ctx context.Context, id string) (bool, error)
+}
+
+// Memory keeps revocations in process. Other instances don't see them, so
+// it's only enough with a single instance.
+type Memory struct {
+	mu      sync.Mutex
+	revoked map[string]time.Time
+}
+
+// NewMemory returns an empty in-process store
+func NewMemory() *Memory {
+	return &Memory{revoked: make(map[string]time.Time)}
+}
+
+// Revoke implements Store
+func (m *Memory) Revoke(_ context.Context, id string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for k, t := range m.revoked {
+		if !now.Before(t) {
+			delete(m.revoked, k)
+		}
+	}
+	if now.Before(until) {
+		m.revoked[id] = until
+	}
+	return nil
+}
+
+// Revoked implements Store
+func (m *Memory) Revoked(_ context.Context, id string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	until, ok := m.revoked[id]
+	return ok && time.Now().Before(until), nil
+}
+
+// redisPrefix namespaces revocation keys
+const redisPrefix = "streamify:revoked:"
+
+// Redis keeps revocations in a Redis server shared by every instance. Each
+// expires with its token.
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis returns a store for the server at url, redis://[user:password@]host:port[/db]
+func NewRedis(url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &Redis{client: redis.NewClient(opts)}, nil
+}
+
+// Revoke implements Store
+func (r *Redis) Revoke(ctx context.Context, id string, until time.Time) error {
+	if !time.Now().Before(until) {
+		return nil
+	}
+	return r.client.SetArgs(ctx, redisPrefix+id, "1", redis.SetArgs{ExpireAt: until}).Err()
+}
+
+// Revoked implements Store
+func (r *Redis) Revoked(ctx context.Context, id string) (bool, error) {
+	n, err := r.client.Exists(ctx, redisPrefix+id).Result()
+	return n > 0, err
+}
+
+// Close closes the connection pool
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
+
+// FromEnv returns a Redis store when REDIS_URL is set and an in-process one
+// otherwise
+func FromEnv() (Store, error) {
+	if url := os.Getenv("REDIS_URL"); url != "" {
+		return NewRedis(url)
+	}
+	logger.Warn("REDIS_URL not set; revoked tokens are only refused by the instance that revoked them")
+	return NewMemory(), nil
+}
+
+type resilient struct {
+	s   Store
+	dep *resilience.Dependency
+}
+
+// Resilient wraps s so calls go through dep's breaker and retry policy.
+// Both calls are idempotent.
+func Resilient(s Store, dep *resilience.Dependency) Store {
+	return &resilient{s: s, dep: dep}
+}
+
+// Revoke implements Store
+func (r *resilient) Revoke(ctx context.Context, id string, until time.Time) error {
+	return r.dep.Do(ctx, func(ctx context.Context) error {
+		return r.s.Revoke(ctx, id, until)
+	})
+}
+
+// Revoked implements Store
+func (r *resilient) Revoked(ctx context.Context, id string) (bool, error) {
+	var revoked bool
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		var err error
+		revoked, err = r.s.Revoked(ctx, id)
+		return err
+	})
+	return revoked, err
+}
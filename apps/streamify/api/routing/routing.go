@@ -0,0 +1,202 @@
+// Package routing declares the server's routes as data. Each route names its
+// method and path, who may call it, the token scopes it needs, its rate-limit
+// class and a description. A Registry mounts the routes on gin behind the
+// middleware of their auth level and describes them for /api/routes and the
+// OpenAPI document, so neither can fall out of step with what is served.
+package routing
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Auth is who may call a route. Each level runs the middleware given to
+// Registry.Use for it.
+type Auth string
+
+const (
+	// Public routes take no credentials
+	Public Auth = "public"
+	// Anonymous routes take no credentials and are rate limited per client
+	Anonymous Auth = "anonymous"
+	// Guest routes take a guest or a user token
+	Guest Auth = "guest"
+	// User routes take a user token or an API key
+	User Auth = "user"
+	// Admin routes take an admin's token; they run User's middleware first
+	Admin Auth = "admin"
+	// SCIM routes take an identity provider's SCIM token
+	SCIM Auth = "scim"
+)
+
+// Class is a route's rate-limit class, setting how many of its requests a
+// caller may have in flight
+type Class string
+
+const (
+	// Standard routes only count toward the caller's overall limit
+	Standard Class = ""
+	// Bulk routes export or scan large amounts of data, one at a time
+	Bulk Class = "bulk"
+	// Download routes stream files
+	Download Class = "download"
+	// Stream routes hold a connection open for events. They don't count
+	// toward the caller's overall limit.
+	Stream Class = "stream"
+)
+
+// ClassLimits are the in-flight requests each class allows per caller and
+// route
+var ClassLimits = map[Class]int{
+	Bulk:     1,
+	Download: 2,
+	Stream:   5,
+}
+
+// Route is one route and its handler
+type Route struct {
+	Method string
+	// Path is a gin route pattern, e.g. /api/v1/albums/:id
+	Path string
+	Auth Auth
+	// Scopes must all be granted by the caller's token; tokens without
+	// scopes are unrestricted
+	Scopes []string
+	Class  Class
+	// Description is shown in /api/routes and as the OpenAPI summary
+	Description string
+	// Middleware runs after the auth level's middleware, before Handler
+	Middleware []gin.HandlerFunc
+	Handler    gin.HandlerFunc
+}
+
+// Key identifies the route by method and path, as the per-route settings
+// of other packages are keyed
+func (r Route) Key() string {
+	return r.Method + " " + r.Path
+}
+
+// Registry collects routes and the middleware of each auth level
+type Registry struct {
+	routes []Route
+	chains map[Auth][]gin.HandlerFunc
+}
+
+// New returns an empty registry
+func New() *Registry {
+	return &Registry{chains: map[Auth][]gin.HandlerFunc{}}
+}
+
+// Add registers routes. Adding a route twice is a programming error and
+// panics.
+func (reg *Registry) Add(routes ...Route) {
+	for _, r := range routes {
+		if slices.ContainsFunc(reg.routes, func(o Route) bool { return o.Key() == r.Key() }) {
+			panic(fmt.Sprintf("routing: %s registered twice", r.Key()))
+		}
+		reg.routes = append(reg.routes, r)
+	}
+}
+
+// Use appends middleware to an auth level's chain
+func (reg *Registry) Use(auth Auth, middleware ...gin.HandlerFunc) {
+	reg.chains[auth] = append(reg.chains[auth], middleware...)
+}
+
+// Routes returns the registered routes in the order they were added
+func (reg *Registry) Routes() []Route {
+	return reg.routes
+}
+
+// chain returns the middleware run before a route's own
+func (reg *Registry) chain(r Route) []gin.HandlerFunc {
+	var handlers []gin.HandlerFunc
+	if r.Auth == Admin {
+		handlers = append(handlers, reg.chains[User]...)
+	}
+	handlers = append(handlers, reg.chains[r.Auth]...)
+	if len(r.Scopes) > 0 {
+		handlers = append(handlers, RequireScopes(r.Scopes...))
+	}
+	return append(handlers, r.Middleware...)
+}
+
+// Mount registers every route on e
+func (reg *Registry) Mount(e gin.IRoutes) {
+	for _, r := range reg.routes {
+		e.Handle(r.Method, r.Path, append(reg.chain(r), r.Handler)...)
+	}
+}
+
+// Limits returns the in-flight limit of each route with a class, keyed by
+// Route.Key, and the routes exempt from callers' overall limit
+func (reg *Registry) Limits() (map[string]int, []string) {
+	limits := map[string]int{}
+	var exempt []string
+	for _, r := range reg.routes {
+		if n, ok := ClassLimits[r.Class]; ok {
+			limits[r.Key()] = n
+		}
+		if r.Class == Stream {
+			exempt = append(exempt, r.Key())
+		}
+	}
+	return limits, exempt
+}
+
+// RequireScopes refuses callers whose token doesn't grant every scope. It
+// must run after authentication.
+func RequireScopes(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v := viewer.FromContext(c.Request.Context())
+		for _, s := range scopes {
+			if !v.HasScope(s) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("token lacks the %s scope", s)})
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// Endpoint describes a route in /api/routes
+type Endpoint struct {
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	Description string   `json:"description"`
+	Auth        Auth     `json:"auth"`
+	Scopes      []string `json:"scopes,omitempty"`
+	Class       string   `json:"class"`
+}
+
+// Endpoints describes the registered routes
+func (reg *Registry) Endpoints() []Endpoint {
+	endpoints := make([]Endpoint, len(reg.routes))
+	for i, r := range reg.routes {
+		class := string(r.Class)
+		if r.Class == Standard {
+			class = "standard"
+		}
+		endpoints[i] = Endpoint{
+			Method:      r.Method,
+			Path:        r.Path,
+			Description: r.Description,
+			Auth:        r.Auth,
+			Scopes:      r.Scopes,
+			Class:       class,
+		}
+	}
+	return endpoints
+}
+
+// Serve lists the registered routes
+func (reg *Registry) Serve() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"endpoints": reg.Endpoints()})
+	}
+}
@@ -0,0 +1,40 @@
+// Package savepoint lets hooks run statements whose failure they tolerate
+// inside their caller's transaction. In Postgres a failed statement aborts
+// the whole transaction, so without a savepoint to roll back to the
+// caller's next statement would fail instead.
+package savepoint
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+
+	"streamify/ent"
+)
+
+// Conn is where the statements run; Ent mutations are one
+type Conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+	Tx() (*ent.Tx, error)
+}
+
+// Run calls fn, which runs its statements through conn. Inside a
+// transaction, it runs within the savepoint name, released when fn succeeds
+// and rolled back to when it fails, leaving the transaction usable either
+// way. Outside a transaction there is nothing to protect and fn just runs.
+func Run(ctx context.Context, conn Conn, name string, fn func() error) error {
+	if _, err := conn.Tx(); err != nil {
+		return fn()
+	}
+	if _, err := conn.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if _, rerr := conn.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+	_, err := conn.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
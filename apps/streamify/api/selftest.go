@@ -0,0 +1,283 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	stdsql "database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"streamify/auth"
+	"streamify/config"
+
+	"github.com/lib/pq"
+)
+
+// selfTestEnv is cleared for the self-test server so it doesn't share
+// listeners, replicas, regional databases, storage or outside services with
+// the deployment it's checking, nor ask for a captcha or an invitation
+var selfTestEnv = []string{
+	"ANALYTICS_CLICKHOUSE_URL",
+	"CAPTCHA_PROVIDER",
+	"DATABASE_LISTEN_URL",
+	"DATABASE_STANDBY_URLS",
+	"DIAGNOSTICS_ADDR",
+	"INVITE_ONLY",
+	"PLAY_BUFFER_WAL_DIR",
+	"REGION_DATABASES",
+	"SMTP_ADDR",
+}
+
+// selfTestTimeout bounds the whole self-test, startup included
+const selfTestTimeout = 2 * time.Minute
+
+// runSelfTest creates a scratch database on cfg's server, serves the API from
+// it on a free port and walks the golden path over HTTP: register, log in,
+// create an artist, an album and a track, play it and find it again. The
+// scratch database is dropped afterwards. An error means the deployment is
+// broken.
+func runSelfTest(cfg *config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), selfTestTimeout)
+	defer cancel()
+
+	server, err := stdsql.Open("postgres", cfg.DriverDSN(cfg.DSN))
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+	name := "streamify_selftest_" + randomHex(6)
+	if _, err := server.ExecContext(ctx, "CREATE DATABASE "+pq.QuoteIdentifier(name)); err != nil {
+		return fmt.Errorf("creating scratch database (the user needs CREATEDB): %w", err)
+	}
+	defer dropScratchDB(server, name)
+
+	scratch := *cfg
+	if scratch.DSN, err = config.WithDatabase(cfg.DSN, name); err != nil {
+		return err
+	}
+	if scratch.Port, err = freePort(); err != nil {
+		return err
+	}
+	storageDir, err := os.MkdirTemp("", "streamify-selftest-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(storageDir)
+	for _, key := range selfTestEnv {
+		os.Unsetenv(key)
+	}
+	os.Setenv("STORAGE_DIR", storageDir)
+
+	log.Printf("selftest: serving from scratch database %s on port %d", name, scratch.Port)
+	served := make(chan error, 1)
+	go func() { served <- serve(&scratch) }()
+
+	t := &selfTest{base: fmt.Sprintf("http://127.0.0.1:%d", scratch.Port), http: &http.Client{Timeout: 30 * time.Second}}
+	if err := t.waitHealthy(ctx, served); err != nil {
+		return err
+	}
+	return t.goldenPath(ctx, &scratch)
+}
+
+// selfTest calls the self-test server as one user
+type selfTest struct {
+	base  string
+	http  *http.Client
+	token string
+}
+
+// waitHealthy waits for the server to answer /health
+func (t *selfTest) waitHealthy(ctx context.Context, served <-chan error) error {
+	tick := time.NewTicker(250 * time.Millisecond)
+	defer tick.Stop()
+	for {
+		select {
+		case err := <-served:
+			return fmt.Errorf("server stopped while starting: %w", err)
+		case <-ctx.Done():
+			return fmt.Errorf("server did not become healthy: %w", ctx.Err())
+		case <-tick.C:
+		}
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, t.base+"/health", nil)
+		if resp, err := t.http.Do(req); err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+	}
+}
+
+// entity is the part of the API's entities the self-test looks at
+type entity struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Edges struct {
+		Albums []entity `json:"albums"`
+		Tracks []entity `json:"tracks"`
+	} `json:"edges"`
+	TrackID string `json:"track_id"`
+}
+
+func (t *selfTest) goldenPath(ctx context.Context, cfg *config.Config) error {
+	suffix := randomHex(4)
+	email := "selftest-" + suffix + "@example.com"
+	password := randomHex(16)
+
+	var registered struct{ User entity }
+	if err := t.step(ctx, "register", http.MethodPost, "/api/auth/register", jsonBody{"email": email, "password": password}, http.StatusCreated, &registered); err != nil {
+		return err
+	}
+	// Only admins change the catalog
+	client, err := openClient(cfg)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if _, _, err := auth.CreateAdmin(ctx, client, email, ""); err != nil {
+		return fmt.Errorf("selftest: promoting %s to admin: %w", email, err)
+	}
+
+	var login auth.AuthResponse
+	if err := t.step(ctx, "login", http.MethodPost, "/api/auth/login", jsonBody{"email": email, "password": password}, http.StatusOK, &login); err != nil {
+		return err
+	}
+	t.token = login.AccessToken
+
+	var artist, album, track entity
+	if err := t.step(ctx, "create artist", http.MethodPost, "/api/v1/artists", jsonBody{"name": "Self Test " + suffix}, http.StatusCreated, &artist); err != nil {
+		return err
+	}
+	if err := t.step(ctx, "create album", http.MethodPost, "/api/v1/albums", jsonBody{"title": "Self Test Album", "artist_id": artist.ID}, http.StatusCreated, &album); err != nil {
+		return err
+	}
+	if err := t.step(ctx, "create track", http.MethodPost, "/api/v1/tracks", jsonBody{"title": "Self Test Track", "album_id": album.ID}, http.StatusCreated, &track); err != nil {
+		return err
+	}
+	// With PLAY_BUFFER_INTERVAL the play is accepted and written later
+	if err := t.step(ctx, "play", http.MethodPost, "/api/v1/plays", jsonBody{"track_id": track.ID}, 0, nil); err != nil {
+		return err
+	}
+
+	// The catalog has no search endpoint, so the track is found by browsing
+	// from its artist
+	var artists []entity
+	if err := t.step(ctx, "find artist", http.MethodGet, "/api/v1/artists", nil, http.StatusOK, &artists); err != nil {
+		return err
+	}
+	i := slices.IndexFunc(artists, func(a entity) bool { return a.ID == artist.ID })
+	if i < 0 || !slices.ContainsFunc(artists[i].Edges.Albums, func(a entity) bool { return a.ID == album.ID }) {
+		return fmt.Errorf("selftest: find artist: artist %s with album %s not listed", artist.ID, album.ID)
+	}
+	var listed entity
+	if err := t.step(ctx, "find track", http.MethodGet, "/api/v1/albums/"+album.ID+"/tracks", nil, http.StatusOK, &listed); err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(listed.Edges.Tracks, func(tr entity) bool { return tr.ID == track.ID }) {
+		return fmt.Errorf("selftest: find track: track %s not on album %s", track.ID, album.ID)
+	}
+	for {
+		var plays []entity
+		if err := t.step(ctx, "find play", http.MethodGet, "/api/v1/users/"+registered.User.ID+"/plays", nil, http.StatusOK, &plays); err != nil {
+			return err
+		}
+		if slices.ContainsFunc(plays, func(p entity) bool { return p.TrackID == track.ID }) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("selftest: find play: play of track %s never recorded", track.ID)
+		case <-time.After(time.Second):
+		}
+	}
+	log.Println("selftest: passed")
+	return nil
+}
+
+// jsonBody is a JSON request body
+type jsonBody = map[string]any
+
+// step calls the API and decodes its response into out, failing unless it
+// answers with want (any 2xx when want is 0)
+func (t *selfTest) step(ctx context.Context, name, method, path string, body any, want int, out any) error {
+	start := time.Now()
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, t.base+path, r)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	resp, err := t.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("selftest: %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("selftest: %s: %w", name, err)
+	}
+	if (want != 0 && resp.StatusCode != want) || (want == 0 && resp.StatusCode/100 != 2) {
+		return fmt.Errorf("selftest: %s: %s %s answered %d: %s", name, method, path, resp.StatusCode, bytes.TrimSpace(payload))
+	}
+	if out != nil {
+		if err := json.Unmarshal(payload, out); err != nil {
+			return fmt.Errorf("selftest: %s: decoding response: %w", name, err)
+		}
+	}
+	log.Printf("selftest: %s ok (%s)", name, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// dropScratchDB drops the self-test's database, closing the server's
+// connections to it first
+func dropScratchDB(server *stdsql.DB, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if _, err := server.ExecContext(ctx, `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()`, name); err != nil {
+		log.Printf("selftest: closing connections to %s: %v", name, err)
+	}
+	if _, err := server.ExecContext(ctx, "DROP DATABASE IF EXISTS "+pq.QuoteIdentifier(name)); err != nil {
+		log.Printf("selftest: dropping scratch database %s failed, drop it by hand: %v", name, err)
+	}
+}
+
+// freePort returns a TCP port nothing is listening on
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
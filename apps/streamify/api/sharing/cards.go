@@ -0,0 +1,205 @@
+package sharing
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/playlist"
+	"streamify/ent/sharelink"
+	"streamify/ent/track"
+	"streamify/ids"
+	"streamify/privacy"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotShareable is returned when the target does not exist or is not visible to anonymous viewers
+var ErrNotShareable = errors.New("item cannot be shared")
+
+const (
+	tokenLength   = 8
+	tokenAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// newToken returns a random token from an alphabet without easily confused characters
+func newToken() (string, error) {
+	max := big.NewInt(int64(len(tokenAlphabet)))
+	b := make([]byte, tokenLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = tokenAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// Card is the metadata rendered into Open Graph tags and the card image for a shared item
+type Card struct {
+	Type        string // og:type
+	Title       string
+	Description string
+	// Label names the kind of item on the card image
+	Label string
+	// Subtitle is the artist or owner shown under the title on the card image
+	Subtitle string
+	// Artwork is the cover drawn on the card image; a playlist has one per
+	// album, up to four
+	Artwork []string
+	// Palette tints the card image, see images.Palette
+	Palette []string
+	// Path is the frontend route for the item, relative to the app URL
+	Path string
+}
+
+// CardFor builds the card for a shared item as an anonymous visitor would see it.
+// Deleted items and playlists that are private or whose owner hides playlists
+// return ErrNotShareable.
+func CardFor(ctx context.Context, client *ent.Client, kind sharelink.Kind, id uuid.UUID) (*Card, error) {
+	var (
+		card *Card
+		err  error
+	)
+	switch kind {
+	case sharelink.KindTrack:
+		card, err = trackCard(ctx, client, id)
+	case sharelink.KindAlbum:
+		card, err = albumCard(ctx, client, id)
+	case sharelink.KindPlaylist:
+		card, err = playlistCard(ctx, client, id)
+	default:
+		return nil, fmt.Errorf("unknown share kind %q", kind)
+	}
+	if ent.IsNotFound(err) {
+		return nil, ErrNotShareable
+	}
+	return card, err
+}
+
+func trackCard(ctx context.Context, client *ent.Client, id uuid.UUID) (*Card, error) {
+	t, err := client.Track.Query().
+		Where(track.IDEQ(id), track.DeletedAtIsNil()).
+		WithAlbum(func(q *ent.AlbumQuery) {
+			q.Where(album.DeletedAtIsNil()).WithArtist()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	al := t.Edges.Album
+	if al == nil {
+		return nil, ErrNotShareable
+	}
+	card := &Card{
+		Type:        "music.song",
+		Title:       t.Title,
+		Description: fmt.Sprintf("Song · %s", al.Title),
+		Label:       "SONG",
+		Subtitle:    al.Title,
+		Path:        "/album/" + ids.Public(al.ID),
+	}
+	withArtwork(card, al)
+	if ar := al.Edges.Artist; ar != nil {
+		card.Description = fmt.Sprintf("Song · %s · %s", ar.Name, al.Title)
+		card.Subtitle = ar.Name
+	}
+	return card, nil
+}
+
+func albumCard(ctx context.Context, client *ent.Client, id uuid.UUID) (*Card, error) {
+	al, err := client.Album.Query().
+		Where(album.IDEQ(id), album.DeletedAtIsNil()).
+		WithArtist().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := al.QueryTracks().Where(track.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	card := &Card{
+		Type:        "music.album",
+		Title:       al.Title,
+		Description: fmt.Sprintf("Album · %d tracks", count),
+		Label:       strings.ToUpper(string(al.AlbumType)),
+		Path:        "/album/" + ids.Public(al.ID),
+	}
+	withArtwork(card, al)
+	if ar := al.Edges.Artist; ar != nil {
+		card.Description = fmt.Sprintf("Album · %s · %d tracks", ar.Name, count)
+		card.Subtitle = ar.Name
+	}
+	return card, nil
+}
+
+// withArtwork sets the card's artwork to an album's cover, falling back to
+// its artist's image when it has none
+func withArtwork(card *Card, al *ent.Album) {
+	switch {
+	case al.ImageURL != "":
+		card.Artwork, card.Palette = []string{al.ImageURL}, al.Palette
+	case al.Edges.Artist != nil && al.Edges.Artist.ImageURL != "":
+		card.Artwork, card.Palette = []string{al.Edges.Artist.ImageURL}, al.Edges.Artist.Palette
+	}
+}
+
+func playlistCard(ctx context.Context, client *ent.Client, id uuid.UUID) (*Card, error) {
+	p, err := client.Playlist.Query().
+		Where(playlist.IDEQ(id), playlist.Public(true)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !privacy.CanView(nil, p.Edges.Owner, privacy.SectionPlaylists) {
+		return nil, ErrNotShareable
+	}
+	count, err := p.QueryTracks().Where(track.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	owner := p.Edges.Owner
+	by := owner.FirstName
+	if owner.LastName != "" {
+		by += " " + owner.LastName
+	}
+	desc := fmt.Sprintf("Playlist · %d tracks", count)
+	if by != "" {
+		desc = fmt.Sprintf("Playlist by %s · %d tracks", by, count)
+	}
+	card := &Card{
+		Type:        "music.playlist",
+		Title:       p.Name,
+		Description: desc,
+		Label:       "PLAYLIST",
+		Subtitle:    by,
+		Path:        "/playlist/" + ids.Public(p.ID),
+	}
+
+	// The covers of the first albums make up the playlist's artwork
+	albums, err := p.QueryTracks().
+		Where(track.DeletedAtIsNil()).
+		QueryAlbum().
+		Where(album.DeletedAtIsNil(), album.ImageURLNEQ("")).
+		Order(ent.Asc(album.FieldID)).
+		Limit(4).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, al := range albums {
+		if i == 0 {
+			card.Palette = al.Palette
+		}
+		card.Artwork = append(card.Artwork, al.ImageURL)
+	}
+	return card, nil
+}
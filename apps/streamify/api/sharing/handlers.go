@@ -0,0 +1,314 @@
+package sharing
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/sharelink"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Config holds the public URLs used to build share links and redirects
+type Config struct {
+	// BaseURL is where GET /s/:token is served, e.g. https://api.streamify.example
+	BaseURL string
+	// AppURL is the frontend that visitors are redirected to
+	AppURL string
+}
+
+func (cfg Config) linkURL(token string) string {
+	return strings.TrimRight(cfg.BaseURL, "/") + "/s/" + token
+}
+
+func (cfg Config) imageURL(token string) string {
+	return cfg.linkURL(token) + "/image.png"
+}
+
+// parseLinkURL returns the token of a share link URL built by linkURL
+func (cfg Config) parseLinkURL(u string) (string, bool) {
+	u, _, _ = strings.Cut(u, "?")
+	token, ok := strings.CutPrefix(u, strings.TrimRight(cfg.BaseURL, "/")+"/s/")
+	if !ok || token == "" || strings.Contains(token, "/") {
+		return "", false
+	}
+	return token, true
+}
+
+// CreateLinkRequest is the request body for CreateLink
+type CreateLinkRequest struct {
+	Type string `json:"type" binding:"required,oneof=track album playlist"`
+	ID   string `json:"id" binding:"required"`
+}
+
+// CreateLink returns a share link for a track, album or playlist, reusing the
+// caller's existing link for the same item
+func CreateLink(client *ent.Client, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var body CreateLinkRequest
+
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		targetID, err := uuid.Parse(body.ID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id format"})
+			return
+		}
+
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		kind := sharelink.Kind(body.Type)
+		ctx := c.Request.Context()
+
+		// Only items an anonymous visitor could open are shareable
+		if _, err := CardFor(ctx, client, kind, targetID); err != nil {
+			if errors.Is(err, ErrNotShareable) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "item not found or not public"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		link, err := client.ShareLink.Query().
+			Where(
+				sharelink.CreatedByEQ(userID),
+				sharelink.KindEQ(kind),
+				sharelink.TargetIDEQ(targetID),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			link, err = createLink(ctx, client, userID, kind, targetID)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"token": link.Token,
+			"url":   cfg.linkURL(link.Token),
+			"type":  link.Kind,
+			"id":    link.TargetID,
+		})
+	}
+}
+
+// createLink stores a new link, retrying on the unlikely event of a token collision
+func createLink(ctx context.Context, client *ent.Client, userID uuid.UUID, kind sharelink.Kind, targetID uuid.UUID) (*ent.ShareLink, error) {
+	var err error
+	for attempt := 0; attempt < 3; attempt++ {
+		var token string
+		token, err = newToken()
+		if err != nil {
+			return nil, err
+		}
+		var link *ent.ShareLink
+		link, err = client.ShareLink.Create().
+			SetToken(token).
+			SetKind(kind).
+			SetTargetID(targetID).
+			SetCreatedBy(userID).
+			Save(ctx)
+		if !ent.IsConstraintError(err) {
+			return link, err
+		}
+	}
+	return nil, err
+}
+
+var cardTemplate = template.Must(template.New("card").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Card.Title}} · Streamify</title>
+<meta name="description" content="{{.Card.Description}}">
+<meta property="og:site_name" content="Streamify">
+<meta property="og:type" content="{{.Card.Type}}">
+<meta property="og:title" content="{{.Card.Title}}">
+<meta property="og:description" content="{{.Card.Description}}">
+<meta property="og:url" content="{{.URL}}">
+<meta property="og:image" content="{{.ImageURL}}">
+<meta property="og:image:type" content="image/png">
+<meta property="og:image:width" content="{{.ImageWidth}}">
+<meta property="og:image:height" content="{{.ImageHeight}}">
+<meta name="twitter:card" content="summary_large_image">
+<meta name="twitter:image" content="{{.ImageURL}}">
+<meta name="twitter:title" content="{{.Card.Title}}">
+<meta name="twitter:description" content="{{.Card.Description}}">
+<link rel="alternate" type="application/json+oembed" href="{{.OEmbedURL}}" title="{{.Card.Title}}">
+<link rel="canonical" href="{{.AppURL}}">
+<meta http-equiv="refresh" content="0; url={{.AppURL}}">
+</head>
+<body>
+<p><a href="{{.AppURL}}">Open {{.Card.Title}} in Streamify</a></p>
+</body>
+</html>
+`))
+
+// findCard returns the share link with token and the card of the item it
+// points to. Unknown tokens and items that can no longer be shared are
+// ErrNotShareable.
+func findCard(ctx context.Context, client *ent.Client, token string) (*ent.ShareLink, *Card, error) {
+	link, err := client.ShareLink.Query().
+		Where(sharelink.TokenEQ(token)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrNotShareable
+		}
+		return nil, nil, err
+	}
+	card, err := CardFor(ctx, client, link.Kind, link.TargetID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return link, card, nil
+}
+
+// Resolve renders the Open Graph page for a share token. Crawlers read the
+// meta tags for unfurls and browsers are redirected to the frontend.
+func Resolve(client *ent.Client, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		token := c.Param("token")
+
+		link, card, err := findCard(ctx, client, token)
+		if err != nil {
+			if errors.Is(err, ErrNotShareable) {
+				c.String(http.StatusNotFound, "link not found")
+				return
+			}
+			c.String(http.StatusInternalServerError, "internal error")
+			return
+		}
+
+		if err := link.Update().AddVisits(1).Exec(ctx); err != nil {
+			c.String(http.StatusInternalServerError, "internal error")
+			return
+		}
+
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Status(http.StatusOK)
+		cardTemplate.Execute(c.Writer, struct {
+			Card        *Card
+			URL         string
+			AppURL      string
+			ImageURL    string
+			ImageWidth  int
+			ImageHeight int
+			OEmbedURL   string
+		}{
+			card,
+			cfg.linkURL(token),
+			strings.TrimRight(cfg.AppURL, "/") + card.Path,
+			cfg.imageURL(token),
+			CardImageWidth,
+			CardImageHeight,
+			strings.TrimRight(cfg.BaseURL, "/") + "/oembed?format=json&url=" + url.QueryEscape(cfg.linkURL(token)),
+		})
+	}
+}
+
+// CardImage serves the share image of a share token. Unlike Resolve it
+// doesn't count a visit, since crawlers fetch it along with the page.
+func CardImage(client *ent.Client, renderer *Renderer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		_, card, err := findCard(ctx, client, c.Param("token"))
+		if err != nil {
+			if errors.Is(err, ErrNotShareable) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		data, err := renderer.Image(ctx, card)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		// The item may be renamed or get new artwork, so the image isn't
+		// immutable like the artwork it is made from
+		c.Header("Cache-Control", "public, max-age=86400")
+		c.Data(http.StatusOK, "image/png", data)
+	}
+}
+
+// OEmbedResponse is an oEmbed "link" response describing a share link, see
+// https://oembed.com
+type OEmbedResponse struct {
+	Version         string `json:"version"`
+	Type            string `json:"type"`
+	Title           string `json:"title"`
+	AuthorName      string `json:"author_name,omitempty"`
+	ProviderName    string `json:"provider_name"`
+	ProviderURL     string `json:"provider_url"`
+	CacheAge        int    `json:"cache_age"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty"`
+}
+
+// OEmbed answers oEmbed requests for share links (?url=, JSON only). The
+// share image is the thumbnail, left out when it exceeds ?maxwidth= or
+// ?maxheight= as the spec requires.
+func OEmbed(client *ent.Client, cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if format := c.DefaultQuery("format", "json"); format != "json" {
+			c.JSON(http.StatusNotImplemented, gin.H{"error": "only the json format is supported"})
+			return
+		}
+		token, ok := cfg.parseLinkURL(c.Query("url"))
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": "url is not a share link"})
+			return
+		}
+
+		_, card, err := findCard(c.Request.Context(), client, token)
+		if err != nil {
+			if errors.Is(err, ErrNotShareable) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "link not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		res := OEmbedResponse{
+			Version:      "1.0",
+			Type:         "link",
+			Title:        card.Title,
+			AuthorName:   card.Subtitle,
+			ProviderName: "Streamify",
+			ProviderURL:  cfg.AppURL,
+			CacheAge:     86400,
+		}
+		maxWidth, _ := strconv.Atoi(c.Query("maxwidth"))
+		maxHeight, _ := strconv.Atoi(c.Query("maxheight"))
+		if (maxWidth <= 0 || maxWidth >= CardImageWidth) && (maxHeight <= 0 || maxHeight >= CardImageHeight) {
+			res.ThumbnailURL = cfg.imageURL(token)
+			res.ThumbnailWidth = CardImageWidth
+			res.ThumbnailHeight = CardImageHeight
+		}
+		c.JSON(http.StatusOK, res)
+	}
+}
@@ -0,0 +1,210 @@
+package sharing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+
+	"streamify/images"
+	"streamify/logging"
+	"streamify/storage"
+)
+
+var logger = logging.For("sharing")
+
+const (
+	// CardImageWidth and CardImageHeight are the size of share images, the
+	// 1.91:1 ratio social networks show large previews at
+	CardImageWidth  = 1200
+	CardImageHeight = 630
+
+	// cardLayout is part of the cache key; bump it when the layout changes so
+	// cached images are rendered again
+	cardLayout = 1
+
+	cardMargin    = 80
+	artworkSize   = CardImageHeight - 2*cardMargin
+	textLeft      = cardMargin + artworkSize + 60
+	textWidth     = CardImageWidth - textLeft - cardMargin
+	labelScale    = 3
+	titleScale    = 5
+	titleLines    = 3
+	subtitleScale = 4
+)
+
+var (
+	defaultBackground  = color.RGBA{0x12, 0x12, 0x12, 0xff}
+	placeholderColor   = color.RGBA{0xff, 0xff, 0xff, 0x1a}
+	textColor          = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	secondaryTextColor = color.RGBA{0xb3, 0xb3, 0xb3, 0xff}
+)
+
+// Renderer draws the image shown in link previews of a shared item: its
+// artwork next to its title and artist. Images are PNGs cached in storage
+// under cards/, keyed by what is drawn on them.
+type Renderer struct {
+	store   storage.Storage
+	artwork *images.Service
+}
+
+// NewRenderer returns a Renderer caching images in store and drawing
+// artwork from the image service
+func NewRenderer(store storage.Storage, artwork *images.Service) *Renderer {
+	return &Renderer{store: store, artwork: artwork}
+}
+
+// Image returns the PNG share image for card, rendering it on first use.
+// Renaming an item or changing its artwork changes the cache key, so stale
+// images are never served.
+func (r *Renderer) Image(ctx context.Context, card *Card) ([]byte, error) {
+	key := cardKey(card)
+	if rc, err := r.store.Get(ctx, key); err == nil {
+		defer rc.Close()
+		return io.ReadAll(rc)
+	} else if !storage.IsNotFound(err) {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, r.render(ctx, card)); err != nil {
+		return nil, err
+	}
+	if err := r.store.Put(ctx, key, bytes.NewReader(buf.Bytes())); err != nil {
+		logger.Warn("caching share image failed", "key", key, "error", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// cardKey returns the storage key of card's image
+func cardKey(card *Card) string {
+	data, _ := json.Marshal(struct {
+		Layout   int
+		Label    string
+		Title    string
+		Subtitle string
+		Artwork  []string
+		Palette  []string
+	}{cardLayout, card.Label, card.Title, card.Subtitle, card.Artwork, card.Palette})
+	sum := sha256.Sum256(data)
+	return "cards/" + hex.EncodeToString(sum[:]) + ".png"
+}
+
+func (r *Renderer) render(ctx context.Context, card *Card) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, CardImageWidth, CardImageHeight))
+
+	// The background is the artwork's dominant color, darkened so white text
+	// reads on it, fading darker towards the bottom
+	bg := defaultBackground
+	if len(card.Palette) > 0 {
+		if c, ok := parseHex(card.Palette[0]); ok {
+			bg = shade(c, 0.45)
+		}
+	}
+	for y := 0; y < CardImageHeight; y++ {
+		row := shade(bg, 1-0.5*float64(y)/CardImageHeight)
+		draw.Draw(dst, image.Rect(0, y, CardImageWidth, y+1), image.NewUniform(row), image.Point{}, draw.Src)
+	}
+
+	r.drawArtwork(ctx, dst, image.Rect(cardMargin, cardMargin, cardMargin+artworkSize, cardMargin+artworkSize), card.Artwork)
+
+	y := cardMargin + 20
+	if card.Label != "" {
+		images.DrawText(dst, image.Pt(textLeft, y), card.Label, labelScale, secondaryTextColor)
+		y += images.TextHeight(labelScale) + 30
+	}
+	for _, line := range images.Wrap(card.Title, textWidth, titleScale, titleLines) {
+		images.DrawText(dst, image.Pt(textLeft, y), line, titleScale, textColor)
+		y += images.TextHeight(titleScale) + 16
+	}
+	y += 14
+	for _, line := range images.Wrap(card.Subtitle, textWidth, subtitleScale, 2) {
+		images.DrawText(dst, image.Pt(textLeft, y), line, subtitleScale, secondaryTextColor)
+		y += images.TextHeight(subtitleScale) + 12
+	}
+
+	footer := image.Pt(textLeft, CardImageHeight-cardMargin-images.TextHeight(labelScale))
+	images.DrawText(dst, footer, "STREAMIFY", labelScale, textColor)
+	return dst
+}
+
+// drawArtwork fills box with the artwork: a single cover, or a 2×2 mosaic
+// when there are four. Only images from the image service are drawn; other
+// URLs aren't fetched, and missing artwork leaves a placeholder.
+func (r *Renderer) drawArtwork(ctx context.Context, dst *image.RGBA, box image.Rectangle, artwork []string) {
+	var covers []image.Image
+	for _, u := range artwork {
+		id, ok := images.ParseURL(u)
+		if !ok {
+			continue
+		}
+		data, _, err := r.artwork.Variant(ctx, id, images.Snap(box.Dx()), images.Snap(box.Dy()), images.FitCover)
+		if err != nil {
+			logger.Warn("loading share image artwork failed", "image_id", id, "error", err)
+			continue
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			logger.Warn("decoding share image artwork failed", "image_id", id, "error", err)
+			continue
+		}
+		covers = append(covers, img)
+	}
+
+	switch {
+	case len(covers) >= 4:
+		half := box.Dx() / 2
+		for i, img := range covers[:4] {
+			at := box.Min.Add(image.Pt(i%2*half, i/2*half))
+			drawCover(dst, image.Rectangle{Min: at, Max: at.Add(image.Pt(half, half))}, img)
+		}
+	case len(covers) > 0:
+		drawCover(dst, box, covers[0])
+	default:
+		draw.Draw(dst, box, image.NewUniform(placeholderColor), image.Point{}, draw.Over)
+	}
+}
+
+// drawCover scales img to cover box and draws it there
+func drawCover(dst *image.RGBA, box image.Rectangle, img image.Image) {
+	b := img.Bounds()
+	// Crop to the box's aspect ratio around the center
+	crop := b
+	if b.Dx()*box.Dy() > b.Dy()*box.Dx() {
+		w := b.Dy() * box.Dx() / box.Dy()
+		crop.Min.X += (b.Dx() - w) / 2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := b.Dx() * box.Dy() / box.Dx()
+		crop.Min.Y += (b.Dy() - h) / 2
+		crop.Max.Y = crop.Min.Y + h
+	}
+	scaled := images.Resize(img, crop, box.Size())
+	draw.Draw(dst, box, scaled, image.Point{}, draw.Over)
+}
+
+// parseHex parses a #rrggbb color
+func parseHex(s string) (color.RGBA, bool) {
+	s, ok := strings.CutPrefix(s, "#")
+	if !ok || len(s) != 6 {
+		return color.RGBA{}, false
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}, true
+}
+
+// shade scales c's channels by f
+func shade(c color.RGBA, f float64) color.RGBA {
+	return color.RGBA{uint8(float64(c.R) * f), uint8(float64(c.G) * f), uint8(float64(c.B) * f), c.A}
+}
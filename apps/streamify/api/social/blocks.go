@@ -0,0 +1,106 @@
+package social
+
+import (
+	"context"
+
+	"streamify/ent"
+	"streamify/ent/block"
+	"streamify/ent/follow"
+	"streamify/privacy"
+	"streamify/residency"
+	"streamify/viewer"
+
+	"github.com/google/uuid"
+)
+
+// Blocked reports whether either user has blocked the other
+func Blocked(ctx context.Context, client *ent.Client, a, b uuid.UUID) (bool, error) {
+	if a == b {
+		return false, nil
+	}
+	return client.Block.Query().
+		Where(block.Or(
+			block.And(block.BlockerIDEQ(a), block.BlockedIDEQ(b)),
+			block.And(block.BlockerIDEQ(b), block.BlockedIDEQ(a)),
+		)).
+		Exist(ctx)
+}
+
+// BlockSet returns every user that viewer has blocked or been blocked by, in a single query.
+// Callers exclude these IDs from listings with the generated *IDNotIn predicates.
+func BlockSet(ctx context.Context, client *ent.Client, viewer uuid.UUID) ([]uuid.UUID, error) {
+	blocks, err := client.Block.Query().
+		Where(block.Or(block.BlockerIDEQ(viewer), block.BlockedIDEQ(viewer))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(blocks))
+	for _, b := range blocks {
+		if b.BlockerID == viewer {
+			ids = append(ids, b.BlockedID)
+		} else {
+			ids = append(ids, b.BlockerID)
+		}
+	}
+	return ids, nil
+}
+
+// CanView reports whether v may see the given section of owner's profile,
+// taking both owner's privacy settings and blocks in either direction into account.
+// Playlists and activity of an owner pinned to another region than the
+// request's are never visible.
+func CanView(ctx context.Context, client *ent.Client, v *viewer.Viewer, owner *ent.User, section privacy.Section) (bool, error) {
+	if !privacy.CanView(v, owner, section) {
+		return false, nil
+	}
+	if section != privacy.SectionFollowers && residency.Check(ctx, owner.Region) != nil {
+		return false, nil
+	}
+	if v == nil || v.UserID == uuid.Nil {
+		return true, nil
+	}
+	blocked, err := Blocked(ctx, client, v.UserID, owner.ID)
+	if err != nil {
+		return false, err
+	}
+	return !blocked, nil
+}
+
+// blockUser records that blocker has blocked blocked and removes any follow relationship
+// between the two users, in a single transaction
+func blockUser(ctx context.Context, client *ent.Client, blocker, blocked uuid.UUID) (*ent.Block, error) {
+	tx, err := client.Tx(residency.Home(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	b, err := tx.Block.Query().
+		Where(block.BlockerIDEQ(blocker), block.BlockedIDEQ(blocked)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		b, err = tx.Block.Create().
+			SetBlockerID(blocker).
+			SetBlockedID(blocked).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Follow.Delete().
+		Where(follow.Or(
+			follow.And(follow.FollowerIDEQ(blocker), follow.FolloweeIDEQ(blocked)),
+			follow.And(follow.FollowerIDEQ(blocked), follow.FolloweeIDEQ(blocker)),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
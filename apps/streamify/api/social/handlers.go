@@ -0,0 +1,225 @@
+package social
+
+import (
+	"net/http"
+
+	"streamify/ent"
+	"streamify/ent/block"
+	"streamify/ent/follow"
+	"streamify/ent/user"
+	"streamify/events"
+	"streamify/ids"
+	"streamify/privacy"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// target resolves the :id path parameter and the authenticated user, writing an error
+// response and returning ok=false when either is missing
+func target(c *gin.Context, client *ent.Client) (v *viewer.Viewer, owner *ent.User, ok bool) {
+	id, err := ids.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return nil, nil, false
+	}
+	v = viewer.FromContext(c.Request.Context())
+	if v == nil || v.UserID == uuid.Nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return nil, nil, false
+	}
+	owner, err = client.User.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+			return nil, nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil, nil, false
+	}
+	return v, owner, true
+}
+
+// BlockUser blocks the user in the path for the authenticated user and drops any follows between them
+func BlockUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, owner, ok := target(c, client)
+		if !ok {
+			return
+		}
+		if v.UserID == owner.ID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "cannot block yourself"})
+			return
+		}
+
+		b, err := blockUser(c.Request.Context(), client, v.UserID, owner.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, b)
+	}
+}
+
+// UnblockUser removes the authenticated user's block on the user in the path
+func UnblockUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, owner, ok := target(c, client)
+		if !ok {
+			return
+		}
+
+		n, err := client.Block.Delete().
+			Where(block.BlockerIDEQ(v.UserID), block.BlockedIDEQ(owner.ID)).
+			Exec(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "user is not blocked"})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"message": "user unblocked"})
+	}
+}
+
+// ListBlocks returns the users the authenticated user has blocked
+func ListBlocks(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		blocks, err := client.Block.Query().
+			Where(block.BlockerIDEQ(userID)).
+			WithBlocked().
+			Order(ent.Desc(block.FieldCreatedAt)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		profiles := make([]privacy.Profile, len(blocks))
+		for i, b := range blocks {
+			profiles[i] = privacy.PublicProfile(b.Edges.Blocked)
+		}
+		c.JSON(http.StatusOK, profiles)
+	}
+}
+
+// FollowUser makes the authenticated user follow the user in the path.
+// Blocks in either direction are rejected.
+func FollowUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, owner, ok := target(c, client)
+		if !ok {
+			return
+		}
+		if v.UserID == owner.ID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "cannot follow yourself"})
+			return
+		}
+
+		blocked, err := Blocked(c.Request.Context(), client, v.UserID, owner.ID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if blocked {
+			c.JSON(http.StatusForbidden, gin.H{"error": "cannot follow this user"})
+			return
+		}
+
+		f, err := client.Follow.Create().
+			SetFollowerID(v.UserID).
+			SetFolloweeID(owner.ID).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "already following"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		events.Emit(c.Request.Context(), events.UserFollowed{FollowerID: v.UserID, FolloweeID: owner.ID})
+		c.JSON(http.StatusCreated, f)
+	}
+}
+
+// UnfollowUser makes the authenticated user stop following the user in the path
+func UnfollowUser(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, owner, ok := target(c, client)
+		if !ok {
+			return
+		}
+
+		n, err := client.Follow.Delete().
+			Where(follow.FollowerIDEQ(v.UserID), follow.FolloweeIDEQ(owner.ID)).
+			Exec(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if n == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "not following"})
+			return
+		}
+
+		events.Emit(c.Request.Context(), events.UserUnfollowed{FollowerID: v.UserID, FolloweeID: owner.ID})
+		c.JSON(http.StatusOK, gin.H{"message": "user unfollowed"})
+	}
+}
+
+// ListFollowers returns the followers of the user in the path if their privacy settings allow it.
+// Users the viewer has blocked or been blocked by are left out.
+func ListFollowers(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		v, owner, ok := target(c, client)
+		if !ok {
+			return
+		}
+
+		allowed, err := CanView(c.Request.Context(), client, v, owner, privacy.SectionFollowers)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if !allowed {
+			c.JSON(http.StatusForbidden, gin.H{"error": "followers are private"})
+			return
+		}
+
+		hidden, err := BlockSet(c.Request.Context(), client, v.UserID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		followers, err := client.User.Query().
+			Where(
+				user.HasFollowingWith(follow.FolloweeIDEQ(owner.ID)),
+				user.IDNotIn(hidden...),
+			).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		profiles := make([]privacy.Profile, len(followers))
+		for i, u := range followers {
+			profiles[i] = privacy.PublicProfile(u)
+		}
+		c.JSON(http.StatusOK, profiles)
+	}
+}
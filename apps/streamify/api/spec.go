@@ -0,0 +1,166 @@
+package main
+
+import (
+	"net/http"
+
+	"streamify/apikeys"
+	"streamify/audio"
+	"streamify/auth"
+	"streamify/changelog"
+	"streamify/consent"
+	"streamify/diagnostics"
+	"streamify/directory"
+	"streamify/dlq"
+	"streamify/ent/schema"
+	"streamify/entitlements"
+	"streamify/invites"
+	"streamify/librarysync"
+	"streamify/logging"
+	"streamify/openapi"
+	"streamify/playback"
+	"streamify/privacy"
+	"streamify/promos"
+	"streamify/residency"
+	"streamify/routing"
+	"streamify/sharing"
+	"streamify/sso"
+	"streamify/support"
+)
+
+// contract describes the payloads of one route for the OpenAPI document
+type contract struct {
+	body     any // request struct, reflected with openapi.SchemaOf
+	status   int
+	response *openapi.Schema
+}
+
+// buildSpec adds routes to doc with the request types the handlers bind and
+// the Ent schemas the handlers serialize. SCIM routes are left out; they
+// follow the SCIM schemas rather than this API's.
+func buildSpec(doc *openapi.Document, routes []routing.Route) {
+	var (
+		userSchema     = openapi.FromEnt(schema.User{}.Fields())
+		artistSchema   = openapi.FromEnt(schema.Artist{}.Fields())
+		albumSchema    = openapi.FromEnt(schema.Album{}.Fields())
+		trackSchema    = openapi.FromEnt(schema.Track{}.Fields())
+		playSchema     = openapi.FromEnt(schema.Play{}.Fields())
+		playlistSchema = openapi.FromEnt(schema.Playlist{}.Fields())
+		likeSchema     = openapi.FromEnt(schema.Like{}.Fields())
+		message        = openapi.Object(map[string]*openapi.Schema{"message": {Type: "string"}}, "message")
+	)
+	// pageOf describes a page of a list, see paging.Page
+	pageOf := func(items *openapi.Schema) *openapi.Schema {
+		link := &openapi.Schema{Type: "string", Nullable: true}
+		return openapi.Object(map[string]*openapi.Schema{
+			"items":  openapi.ArrayOf(items),
+			"total":  {Type: "integer"},
+			"limit":  {Type: "integer"},
+			"offset": {Type: "integer"},
+			"next":   link,
+			"prev":   link,
+		}, "items", "total", "limit", "offset", "next", "prev")
+	}
+	// Shareable entities carry their short public ID too, see ids.Public
+	for _, s := range []*openapi.Schema{artistSchema, albumSchema, trackSchema, playlistSchema} {
+		s.Properties["public_id"] = &openapi.Schema{Type: "string"}
+		s.Required = append(s.Required, "public_id")
+	}
+
+	contracts := map[string]contract{
+		"GET /api/v1/me/likes":                            {status: http.StatusOK, response: openapi.ArrayOf(likeSchema)},
+		"POST /api/v1/me/likes":                           {body: likeTrackRequest{}, status: http.StatusCreated, response: likeSchema},
+		"DELETE /api/v1/me/likes/:track_id":               {status: http.StatusOK, response: message},
+		"POST /api/v1/sync/merge":                         {body: librarysync.MergeRequest{}, status: http.StatusOK},
+		"PUT /api/v1/me/queue":                            {body: replaceQueueRequest{}, status: http.StatusOK},
+		"PATCH /api/v1/me/privacy":                        {body: privacy.UpdateRequest{}, status: http.StatusOK},
+		"GET /api/v1/users":                               {status: http.StatusOK, response: pageOf(userSchema)},
+		"GET /api/v1/users/:id":                           {status: http.StatusOK, response: userSchema},
+		"POST /api/v1/users/lookup":                       {body: directory.LookupRequest{}, status: http.StatusOK},
+		"GET /api/v1/users/:id/plays":                     {status: http.StatusOK, response: openapi.ArrayOf(playSchema)},
+		"GET /api/v1/users/:id/playlists":                 {status: http.StatusOK, response: openapi.ArrayOf(playlistSchema)},
+		"POST /api/v1/users":                              {body: createUserRequest{}, status: http.StatusCreated, response: userSchema},
+		"DELETE /api/v1/users/:id":                        {status: http.StatusOK, response: message},
+		"GET /api/v1/artists":                             {status: http.StatusOK, response: pageOf(artistSchema)},
+		"GET /api/v1/artists/:id":                         {status: http.StatusOK, response: artistSchema},
+		"POST /api/v1/artists":                            {body: createArtistRequest{}, status: http.StatusCreated, response: artistSchema},
+		"PUT /api/v1/artists/:id/artwork":                 {status: http.StatusOK, response: artistSchema},
+		"GET /api/v1/artists/:id/albums":                  {status: http.StatusOK, response: pageOf(albumSchema)},
+		"GET /api/v1/albums/:id":                          {status: http.StatusOK, response: albumSchema},
+		"POST /api/v1/albums":                             {body: createAlbumRequest{}, status: http.StatusCreated, response: albumSchema},
+		"PUT /api/v1/albums/:id/artwork":                  {status: http.StatusOK, response: albumSchema},
+		"GET /api/v1/albums/:id/tracks":                   {status: http.StatusOK, response: openapi.Object(map[string]*openapi.Schema{"album": albumSchema, "tracks": pageOf(trackSchema)}, "album", "tracks")},
+		"PUT /api/v1/albums/:id/tracklist":                {body: setAlbumTracklistRequest{}, status: http.StatusOK, response: openapi.ArrayOf(trackSchema)},
+		"POST /api/v1/tracks":                             {body: createTrackRequest{}, status: http.StatusCreated, response: trackSchema},
+		"POST /api/v1/plays":                              {body: createPlayRequest{}, status: http.StatusCreated, response: playSchema},
+		"POST /api/v1/plays/:id/heartbeat":                {body: playback.HeartbeatRequest{}, status: http.StatusAccepted},
+		"POST /api/v1/playlists":                          {body: createPlaylistRequest{}, status: http.StatusCreated, response: playlistSchema},
+		"GET /api/v1/playlists/:id":                       {status: http.StatusOK, response: playlistSchema},
+		"POST /api/v1/playlists/:id/tracks":               {body: addPlaylistTrackRequest{}, status: http.StatusOK, response: playlistSchema},
+		"DELETE /api/v1/playlists/:id":                    {status: http.StatusNoContent},
+		"POST /api/v1/share":                              {body: sharing.CreateLinkRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/integrity/fix":                {body: fixIntegrityRequest{}, status: http.StatusAccepted},
+		"POST /api/v1/admin/albums/bulk-archive":          {body: bulkDeleteAlbumsRequest{}, status: http.StatusAccepted},
+		"POST /api/v1/admin/diagnostics/captures":         {body: diagnostics.CaptureRequest{}, status: http.StatusAccepted},
+		"POST /api/v1/admin/albums/bulk-delete":           {body: bulkDeleteAlbumsRequest{}, status: http.StatusAccepted},
+		"POST /api/v1/admin/backups":                      {status: http.StatusAccepted},
+		"POST /api/v1/admin/users/:id/impersonate":        {body: auth.ImpersonateRequest{}, status: http.StatusCreated},
+		"POST /api/v1/admin/users/:id/entitlements":       {body: entitlements.GrantRequest{}, status: http.StatusCreated},
+		"PUT /api/v1/admin/users/:id/region":              {body: residency.PinUserRequest{}, status: http.StatusOK},
+		"POST /api/v1/developer/keys":                     {body: apikeys.CreateRequest{}, status: http.StatusCreated},
+		"POST /api/v1/me/confirm":                         {body: auth.ConfirmRequest{}, status: http.StatusCreated},
+		"POST /api/v1/device/confirm":                     {body: auth.ConfirmDeviceRequest{}, status: http.StatusOK},
+		"PUT /api/v1/me/password":                         {body: auth.ChangePasswordRequest{}, status: http.StatusOK},
+		"PUT /api/v1/me/email":                            {body: auth.ChangeEmailRequest{}, status: http.StatusOK},
+		"POST /api/v1/me/consent":                         {body: consent.AcceptRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/policies":                     {body: consent.PublishRequest{}, status: http.StatusCreated},
+		"POST /api/v1/admin/invites":                      {body: invites.CreateInvitesRequest{}, status: http.StatusCreated},
+		"POST /api/v1/admin/waitlist/release":             {body: invites.ReleaseWaitlistRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/promo-codes":                  {body: promos.CreateRequest{}, status: http.StatusCreated},
+		"POST /api/v1/me/redemptions":                     {body: promos.RedeemRequest{}, status: http.StatusCreated},
+		"POST /api/v1/admin/changelog":                    {body: changelog.CreateRequest{}, status: http.StatusCreated},
+		"PATCH /api/v1/admin/changelog/:id":               {body: changelog.UpdateRequest{}, status: http.StatusOK},
+		"POST /api/v1/support/tickets":                    {body: support.OpenRequest{}, status: http.StatusCreated},
+		"POST /api/v1/support/tickets/:id/replies":        {body: support.ReplyRequest{}, status: http.StatusCreated},
+		"POST /api/v1/admin/support/tickets/:id/replies":  {body: support.ReplyRequest{}, status: http.StatusCreated},
+		"PUT /api/v1/admin/support/tickets/:id/status":    {body: support.SetStatusRequest{}, status: http.StatusOK},
+		"PUT /api/v1/admin/log-levels":                    {body: logging.SetLevelRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/signing-keys":                 {status: http.StatusCreated},
+		"POST /api/v1/admin/signing-keys/:id/retire":      {body: auth.RetireKeyRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/sso-providers":                {body: sso.ProviderRequest{}, status: http.StatusCreated},
+		"PATCH /api/v1/admin/sso-providers/:id":           {body: sso.UpdateProviderRequest{}, status: http.StatusOK},
+		"DELETE /api/v1/admin/sso-providers/:id":          {status: http.StatusNoContent},
+		"POST /api/v1/admin/security-alerts/:id/resolve":  {status: http.StatusOK},
+		"POST /api/v1/admin/sso-providers/:id/scim-token": {status: http.StatusCreated},
+		"POST /api/v1/admin/tokens/revoke":                {body: auth.RevokeTokenRequest{}, status: http.StatusNoContent},
+		"POST /api/v1/admin/dead-letters/replay":          {body: dlq.ReplayRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/dead-letters/purge":           {body: dlq.PurgeRequest{}, status: http.StatusOK},
+		"PATCH /api/v1/admin/api-keys/:id":                {body: apikeys.UpdateLimitsRequest{}, status: http.StatusOK},
+		"POST /api/v1/admin/data/:entity":                 {status: http.StatusCreated},
+		"DELETE /api/v1/admin/data/:entity/:id":           {status: http.StatusNoContent},
+		"POST /api/v1/admin/duplicates/:id/resolve":       {body: audio.ResolveReviewRequest{}, status: http.StatusOK},
+		"POST /api/v1/images":                             {status: http.StatusCreated},
+		"OPTIONS /api/v1/uploads":                         {status: http.StatusNoContent},
+		"POST /api/v1/uploads":                            {status: http.StatusCreated},
+		"PATCH /api/v1/uploads/:id":                       {status: http.StatusNoContent},
+		"DELETE /api/v1/uploads/:id":                      {status: http.StatusNoContent},
+		"POST /api/users":                                 {body: createUserRequest{}, status: http.StatusCreated, response: userSchema},
+		"PUT /api/v1/guest/state":                         {body: auth.GuestStateRequest{}, status: http.StatusOK},
+		"POST /api/v1/users/:id/follow":                   {status: http.StatusCreated},
+		"POST /api/v1/users/:id/block":                    {status: http.StatusCreated},
+	}
+
+	for _, r := range routes {
+		if r.Auth == routing.SCIM {
+			continue
+		}
+		c, ok := contracts[r.Key()]
+		if !ok {
+			c.status = http.StatusOK
+		}
+		var body *openapi.Schema
+		if c.body != nil {
+			body = openapi.SchemaOf(c.body)
+		}
+		doc.Add(r.Method, r.Path, r.Description, body, c.status, c.response)
+	}
+}
@@ -0,0 +1,250 @@
+package sso
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"streamify/ent"
+	"streamify/ent/externalidentity"
+	"streamify/ent/scimgroup"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/user"
+	"streamify/ids"
+	"streamify/residency"
+)
+
+// ProviderRequest is the request body for CreateProvider
+type ProviderRequest struct {
+	Slug         string            `json:"slug" binding:"required,max=64"`
+	Name         string            `json:"name" binding:"required,max=255"`
+	Issuer       string            `json:"issuer" binding:"required,url"`
+	ClientID     string            `json:"client_id" binding:"required"`
+	ClientSecret string            `json:"client_secret" binding:"required"`
+	GroupsClaim  string            `json:"groups_claim"`
+	GroupRoles   map[string]string `json:"group_roles"`
+	EmailDomains []string          `json:"email_domains"`
+	Region       *string           `json:"region"`
+	Provisioning *bool             `json:"provisioning"`
+	Enabled      *bool             `json:"enabled"`
+}
+
+// UpdateProviderRequest is the request body for UpdateProvider. Omitted fields
+// are left alone.
+type UpdateProviderRequest struct {
+	Name         *string           `json:"name" binding:"omitempty,max=255"`
+	Issuer       *string           `json:"issuer" binding:"omitempty,url"`
+	ClientID     *string           `json:"client_id"`
+	ClientSecret *string           `json:"client_secret"`
+	GroupsClaim  *string           `json:"groups_claim"`
+	GroupRoles   map[string]string `json:"group_roles"`
+	EmailDomains []string          `json:"email_domains"`
+	Region       *string           `json:"region"`
+	Provisioning *bool             `json:"provisioning"`
+	Enabled      *bool             `json:"enabled"`
+}
+
+func validateGroupRoles(roles map[string]string) error {
+	for group, role := range roles {
+		if err := user.RoleValidator(user.Role(role)); err != nil {
+			return fmt.Errorf("group %q maps to unknown role %q", group, role)
+		}
+	}
+	return nil
+}
+
+// validateRegion checks that new users of the tenant can be pinned to region
+func validateRegion(region *string) error {
+	if region != nil && *region != "" && !residency.Known(*region) {
+		return fmt.Errorf("unknown region %q", *region)
+	}
+	return nil
+}
+
+// checkIssuer makes sure issuer serves a usable discovery document, so a typo
+// is caught before anyone tries to sign in
+func (s *Service) checkIssuer(ctx context.Context, issuer string) error {
+	_, err := s.providers.get(ctx, issuer)
+	return err
+}
+
+// ListProviders returns every tenant's SSO provider, without secrets (admin)
+func ListProviders(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		providers, err := client.SSOProvider.Query().
+			Order(ent.Asc(ssoprovider.FieldSlug)).
+			All(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, providers)
+	}
+}
+
+// CreateProvider sets up the SSO provider of a new tenant (admin)
+func CreateProvider(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ProviderRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validateGroupRoles(req.GroupRoles); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validateRegion(req.Region); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx := c.Request.Context()
+		if err := s.checkIssuer(ctx, req.Issuer); err != nil {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+
+		create := s.client.SSOProvider.Create().
+			SetSlug(req.Slug).
+			SetName(req.Name).
+			SetIssuer(req.Issuer).
+			SetClientID(req.ClientID).
+			SetClientSecret(req.ClientSecret).
+			SetGroupRoles(req.GroupRoles).
+			SetEmailDomains(req.EmailDomains).
+			SetNillableProvisioning(req.Provisioning).
+			SetNillableEnabled(req.Enabled)
+		if req.GroupsClaim != "" {
+			create.SetGroupsClaim(req.GroupsClaim)
+		}
+		if req.Region != nil && *req.Region != "" {
+			create.SetRegion(*req.Region)
+		}
+		p, err := create.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				c.JSON(http.StatusConflict, gin.H{"error": "A tenant with this slug already exists"})
+				return
+			}
+			if ent.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("SSO provider created", "tenant", p.Slug)
+		c.JSON(http.StatusCreated, p)
+	}
+}
+
+// UpdateProvider changes a tenant's SSO provider (admin)
+func UpdateProvider(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid provider ID"})
+			return
+		}
+		var req UpdateProviderRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validateGroupRoles(req.GroupRoles); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if err := validateRegion(req.Region); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx := c.Request.Context()
+		if req.Issuer != nil {
+			if err := s.checkIssuer(ctx, *req.Issuer); err != nil {
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		update := s.client.SSOProvider.UpdateOneID(id).
+			SetNillableName(req.Name).
+			SetNillableIssuer(req.Issuer).
+			SetNillableClientID(req.ClientID).
+			SetNillableClientSecret(req.ClientSecret).
+			SetNillableGroupsClaim(req.GroupsClaim).
+			SetNillableProvisioning(req.Provisioning).
+			SetNillableEnabled(req.Enabled)
+		if req.GroupRoles != nil {
+			update.SetGroupRoles(req.GroupRoles)
+		}
+		if req.EmailDomains != nil {
+			update.SetEmailDomains(req.EmailDomains)
+		}
+		if req.Region != nil {
+			if *req.Region == "" {
+				update.ClearRegion()
+			} else {
+				update.SetRegion(*req.Region)
+			}
+		}
+		p, err := update.Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "SSO provider not found"})
+				return
+			}
+			if ent.IsValidationError(err) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("SSO provider updated", "tenant", p.Slug)
+		c.JSON(http.StatusOK, p)
+	}
+}
+
+// DeleteProvider removes a tenant's SSO provider, its SCIM groups and its links to local users
+// (admin). The users keep their accounts and can set a password with the reset flow.
+func DeleteProvider(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := ids.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid provider ID"})
+			return
+		}
+		ctx := c.Request.Context()
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer tx.Rollback()
+		if _, err := tx.ExternalIdentity.Delete().Where(externalidentity.ProviderIDEQ(id)).Exec(ctx); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if _, err := tx.SCIMGroup.Delete().Where(scimgroup.ProviderIDEQ(id)).Exec(ctx); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := tx.SSOProvider.DeleteOneID(id).Exec(ctx); err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "SSO provider not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("SSO provider deleted", "provider_id", id)
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,232 @@
+package sso
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/ssoprovider"
+)
+
+// SCIM 2.0 (RFC 7643, RFC 7644) lets a tenant's identity provider create,
+// update and deactivate the accounts of its people, and push the groups that
+// decide their roles. Each provider authenticates with its own bearer token
+// and only sees the users linked to it.
+
+const scimContentType = "application/scim+json"
+
+const (
+	scimUserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimPatchSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// scimMaxResults caps the page size of list requests
+const scimMaxResults = 200
+
+// scimProviderKey holds the authenticated provider in the gin context
+const scimProviderKey = "scim_provider"
+
+func hashSCIMToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// scimJSON writes v with SCIM's media type
+func scimJSON(c *gin.Context, status int, v any) {
+	c.Header("Content-Type", scimContentType)
+	c.JSON(status, v)
+}
+
+// scimError writes a SCIM error response. scimType is one of RFC 7644's
+// detail error keywords, or empty.
+func scimError(c *gin.Context, status int, scimType, detail string) {
+	body := gin.H{
+		"schemas": []string{scimErrorSchema},
+		"status":  strconv.Itoa(status),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	scimJSON(c, status, body)
+}
+
+// SCIMAuth authenticates a tenant's SCIM client by its bearer token
+func SCIMAuth(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			scimError(c, http.StatusUnauthorized, "", "Bearer token required")
+			c.Abort()
+			return
+		}
+		p, err := client.SSOProvider.Query().
+			Where(ssoprovider.ScimTokenHashEQ(hashSCIMToken(token)), ssoprovider.EnabledEQ(true)).
+			Only(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				scimError(c, http.StatusUnauthorized, "", "Invalid bearer token")
+			} else {
+				scimError(c, http.StatusInternalServerError, "", err.Error())
+			}
+			c.Abort()
+			return
+		}
+		c.Set(scimProviderKey, p)
+		c.Next()
+	}
+}
+
+func scimProvider(c *gin.Context) *ent.SSOProvider {
+	return c.MustGet(scimProviderKey).(*ent.SSOProvider)
+}
+
+// RotateSCIMToken issues a new SCIM bearer token for a tenant's provider,
+// replacing any previous one. The token is only shown once. (admin)
+func RotateSCIMToken(client *ent.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid provider ID"})
+			return
+		}
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+			return
+		}
+		token := "scim_" + base64.RawURLEncoding.EncodeToString(b)
+		p, err := client.SSOProvider.UpdateOneID(id).
+			SetScimTokenHash(hashSCIMToken(token)).
+			Save(c.Request.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "SSO provider not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Info("SCIM token rotated", "tenant", p.Slug)
+		c.JSON(http.StatusCreated, gin.H{"token": token})
+	}
+}
+
+// ServiceProviderConfig describes which parts of SCIM are supported
+func ServiceProviderConfig() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scimJSON(c, http.StatusOK, gin.H{
+			"schemas":        []string{"urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"},
+			"patch":          gin.H{"supported": true},
+			"bulk":           gin.H{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+			"filter":         gin.H{"supported": true, "maxResults": scimMaxResults},
+			"changePassword": gin.H{"supported": false},
+			"sort":           gin.H{"supported": false},
+			"etag":           gin.H{"supported": false},
+			"authenticationSchemes": []gin.H{{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer token",
+				"description": "Token issued with POST /api/v1/admin/sso-providers/:id/scim-token",
+			}},
+		})
+	}
+}
+
+// scimMeta is the meta attribute of a resource
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+func (s *Service) scimLocation(kind, id string) string {
+	return strings.TrimRight(s.cfg.BaseURL, "/") + "/scim/v2/" + kind + "/" + id
+}
+
+// scimList is a list response
+type scimList struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+// page reads startIndex (1-based) and count from the query
+func page(c *gin.Context) (offset, limit int) {
+	start, err := strconv.Atoi(c.Query("startIndex"))
+	if err != nil || start < 1 {
+		start = 1
+	}
+	limit, err = strconv.Atoi(c.Query("count"))
+	if err != nil || limit > scimMaxResults {
+		limit = scimMaxResults
+	}
+	return start - 1, max(limit, 0)
+}
+
+// filterPattern matches the one filter form identity providers send, attr eq "value"
+var filterPattern = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// parseFilter returns the attribute and value of an equality filter, or ok
+// false for any other filter. An empty filter is an empty attribute.
+func parseFilter(filter string) (attr, value string, ok bool) {
+	if strings.TrimSpace(filter) == "" {
+		return "", "", true
+	}
+	m := filterPattern.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", false
+	}
+	value, err := strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return "", "", false
+	}
+	return strings.ToLower(m[1]), value, true
+}
+
+// PatchRequest is a SCIM PATCH request body
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations" binding:"required,min=1"`
+}
+
+// PatchOperation is one change of a PatchRequest
+type PatchOperation struct {
+	Op    string          `json:"op" binding:"required"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// scimString decodes a string value
+func scimString(raw json.RawMessage) (string, bool) {
+	var s string
+	err := json.Unmarshal(raw, &s)
+	return s, err == nil
+}
+
+// scimBool decodes a boolean value. Some providers send booleans as the
+// strings "True" and "False".
+func scimBool(raw json.RawMessage) (bool, bool) {
+	var b bool
+	if json.Unmarshal(raw, &b) == nil {
+		return b, true
+	}
+	if s, ok := scimString(raw); ok {
+		b, err := strconv.ParseBool(s)
+		return b, err == nil
+	}
+	return false, false
+}
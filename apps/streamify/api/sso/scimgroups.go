@@ -0,0 +1,478 @@
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/externalidentity"
+	"streamify/ent/scimgroup"
+	"streamify/ent/user"
+)
+
+// SCIMMember is a member of a GroupResource, by user ID
+type SCIMMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// GroupResource is a SCIM Group. The provider's group_roles maps its
+// displayName to a role, which its members get.
+type GroupResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName" binding:"required,max=255"`
+	Members     []SCIMMember `json:"members"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+func (s *Service) groupResource(g *ent.SCIMGroup) GroupResource {
+	r := GroupResource{
+		Schemas:     []string{scimGroupSchema},
+		ID:          g.ID.String(),
+		DisplayName: g.DisplayName,
+		Members:     make([]SCIMMember, len(g.Members)),
+		Meta:        &scimMeta{ResourceType: "Group", Location: s.scimLocation("Groups", g.ID.String())},
+	}
+	if g.ExternalID != nil {
+		r.ExternalID = *g.ExternalID
+	}
+	for i, id := range g.Members {
+		r.Members[i] = SCIMMember{Value: id.String()}
+	}
+	return r
+}
+
+// memberIDs parses members' user IDs, reporting false when one isn't a user
+// of p's tenant
+func memberIDs(ctx context.Context, client *ent.Client, p *ent.SSOProvider, members []SCIMMember) ([]uuid.UUID, bool, error) {
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		id, err := uuid.Parse(m.Value)
+		if err != nil {
+			return nil, false, nil
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return ids, true, nil
+	}
+	idents, err := client.ExternalIdentity.Query().
+		Where(externalidentity.ProviderIDEQ(p.ID), externalidentity.UserIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, id := range ids {
+		if !slices.ContainsFunc(idents, func(i *ent.ExternalIdentity) bool { return i.UserID == id }) {
+			return nil, false, nil
+		}
+	}
+	return ids, true, nil
+}
+
+// syncRoles sets the role p's group_roles gives each of users, by the groups
+// they are in. Providers without group_roles leave roles alone.
+func syncRoles(ctx context.Context, tx *ent.Tx, p *ent.SSOProvider, users []uuid.UUID) error {
+	if len(p.GroupRoles) == 0 || len(users) == 0 {
+		return nil
+	}
+	groups, err := tx.SCIMGroup.Query().Where(scimgroup.ProviderIDEQ(p.ID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range users {
+		var names []string
+		for _, g := range groups {
+			if slices.Contains(g.Members, id) {
+				names = append(names, g.DisplayName)
+			}
+		}
+		role, _ := mappedRole(p, names)
+		n, err := tx.User.Update().
+			Where(user.IDEQ(id), user.RoleNEQ(role)).
+			SetRole(role).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Info("role synced from SCIM groups", "tenant", p.Slug, "user_id", id, "role", role)
+		}
+	}
+	return nil
+}
+
+// tenantGroup returns the group of p with the ID in the path
+func tenantGroup(ctx context.Context, client *ent.Client, p *ent.SSOProvider, id string) (*ent.SCIMGroup, error) {
+	groupID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, &ent.NotFoundError{}
+	}
+	return client.SCIMGroup.Query().
+		Where(scimgroup.IDEQ(groupID), scimgroup.ProviderIDEQ(p.ID)).
+		Only(ctx)
+}
+
+// ListSCIMGroups lists the tenant's groups, optionally filtered by displayName or externalId
+func ListSCIMGroups(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := scimProvider(c)
+		attr, value, ok := parseFilter(c.Query("filter"))
+		if !ok {
+			scimError(c, http.StatusBadRequest, "invalidFilter", "Only attribute eq \"value\" filters are supported")
+			return
+		}
+		q := s.client.SCIMGroup.Query().Where(scimgroup.ProviderIDEQ(p.ID))
+		switch attr {
+		case "":
+		case "displayname":
+			q.Where(scimgroup.DisplayNameEQ(value))
+		case "externalid":
+			q.Where(scimgroup.ExternalIDEQ(value))
+		case "id":
+			id, err := uuid.Parse(value)
+			if err != nil {
+				id = uuid.Nil
+			}
+			q.Where(scimgroup.IDEQ(id))
+		default:
+			scimError(c, http.StatusBadRequest, "invalidFilter", "Groups can be filtered by displayName, externalId or id")
+			return
+		}
+
+		ctx := c.Request.Context()
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		offset, limit := page(c)
+		groups, err := q.
+			Order(ent.Asc(scimgroup.FieldDisplayName)).
+			Offset(offset).
+			Limit(limit).
+			All(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		resources := make([]GroupResource, len(groups))
+		for i, g := range groups {
+			resources[i] = s.groupResource(g)
+		}
+		scimJSON(c, http.StatusOK, scimList{
+			Schemas:      []string{scimListSchema},
+			TotalResults: total,
+			StartIndex:   offset + 1,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+	}
+}
+
+// GetSCIMGroup returns one of the tenant's groups
+func GetSCIMGroup(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		g, err := tenantGroup(c.Request.Context(), s.client, scimProvider(c), c.Param("id"))
+		if err != nil {
+			if ent.IsNotFound(err) {
+				scimError(c, http.StatusNotFound, "", "Group not found")
+				return
+			}
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		scimJSON(c, http.StatusOK, s.groupResource(g))
+	}
+}
+
+// CreateSCIMGroup adds a group to the tenant and gives its members the role it maps to
+func CreateSCIMGroup(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req GroupResource
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		p := scimProvider(c)
+		ctx := c.Request.Context()
+		members, ok, err := memberIDs(ctx, s.client, p, req.Members)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if !ok {
+			scimError(c, http.StatusBadRequest, "invalidValue", "Members must be users of this tenant")
+			return
+		}
+
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		defer tx.Rollback()
+		g, err := tx.SCIMGroup.Create().
+			SetProviderID(p.ID).
+			SetDisplayName(req.DisplayName).
+			SetNillableExternalID(nilIfEmpty(req.ExternalID)).
+			SetMembers(members).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				scimError(c, http.StatusConflict, "uniqueness", "A group with this displayName already exists")
+				return
+			}
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := syncRoles(ctx, tx, p, members); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		c.Header("Location", s.scimLocation("Groups", g.ID.String()))
+		scimJSON(c, http.StatusCreated, s.groupResource(g))
+	}
+}
+
+// groupChanges are what a PUT or PATCH sets on a group
+type groupChanges struct {
+	displayName *string
+	externalID  *string
+	members     []uuid.UUID
+}
+
+// updateSCIMGroup saves what change decides to set on the group in the path,
+// then syncs the roles of everyone who joined or left it
+func (s *Service) updateSCIMGroup(c *gin.Context, change func(ctx context.Context, g *ent.SCIMGroup) (groupChanges, string)) {
+	p := scimProvider(c)
+	ctx := c.Request.Context()
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	defer tx.Rollback()
+
+	g, err := tenantGroup(ctx, tx.Client(), p, c.Param("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			scimError(c, http.StatusNotFound, "", "Group not found")
+			return
+		}
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	ch, problem := change(ctx, g)
+	if problem != "" {
+		scimError(c, http.StatusBadRequest, "invalidValue", problem)
+		return
+	}
+
+	before := g.Members
+	update := tx.SCIMGroup.UpdateOne(g).
+		SetNillableDisplayName(ch.displayName).
+		SetMembers(ch.members)
+	if ch.externalID != nil {
+		if *ch.externalID == "" {
+			update.ClearExternalID()
+		} else {
+			update.SetExternalID(*ch.externalID)
+		}
+	}
+	if g, err = update.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			scimError(c, http.StatusConflict, "uniqueness", "A group with this displayName already exists")
+			return
+		}
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	// A rename can change the role of every member
+	affected := ch.members
+	if ch.displayName == nil {
+		affected = symmetricDifference(before, ch.members)
+	} else {
+		affected = append(slices.Clone(affected), before...)
+	}
+	if err := syncRoles(ctx, tx, p, affected); err != nil {
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	scimJSON(c, http.StatusOK, s.groupResource(g))
+}
+
+// symmetricDifference returns the IDs in only one of a and b
+func symmetricDifference(a, b []uuid.UUID) []uuid.UUID {
+	var diff []uuid.UUID
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			diff = append(diff, id)
+		}
+	}
+	for _, id := range b {
+		if !slices.Contains(a, id) {
+			diff = append(diff, id)
+		}
+	}
+	return diff
+}
+
+// ReplaceSCIMGroup replaces a group's name and members
+func ReplaceSCIMGroup(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req GroupResource
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		p := scimProvider(c)
+		s.updateSCIMGroup(c, func(ctx context.Context, g *ent.SCIMGroup) (groupChanges, string) {
+			members, ok, err := memberIDs(ctx, s.client, p, req.Members)
+			if err != nil || !ok {
+				return groupChanges{}, "Members must be users of this tenant"
+			}
+			return groupChanges{displayName: &req.DisplayName, externalID: &req.ExternalID, members: members}, ""
+		})
+	}
+}
+
+// memberFilterPath matches a path removing one member, members[value eq "id"]
+var memberFilterPath = regexp.MustCompile(`(?i)^members\[value eq "([^"]+)"\]$`)
+
+// PatchSCIMGroup adds or removes members, or renames a group
+func PatchSCIMGroup(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req PatchRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		p := scimProvider(c)
+		s.updateSCIMGroup(c, func(ctx context.Context, g *ent.SCIMGroup) (groupChanges, string) {
+			ch := groupChanges{members: slices.Clone(g.Members)}
+			for _, op := range req.Operations {
+				verb := strings.ToLower(op.Op)
+				path := strings.ToLower(op.Path)
+				switch {
+				case (verb == "add" || verb == "replace") && path == "members":
+					var members []SCIMMember
+					if json.Unmarshal(op.Value, &members) != nil {
+						return ch, "members must be a list of members"
+					}
+					ids, ok, err := memberIDs(ctx, s.client, p, members)
+					if err != nil || !ok {
+						return ch, "Members must be users of this tenant"
+					}
+					if verb == "replace" {
+						ch.members = nil
+					}
+					for _, id := range ids {
+						if !slices.Contains(ch.members, id) {
+							ch.members = append(ch.members, id)
+						}
+					}
+				case verb == "remove" && path == "members":
+					// Without a value every member goes
+					var members []SCIMMember
+					if len(op.Value) > 0 && json.Unmarshal(op.Value, &members) != nil {
+						return ch, "members must be a list of members"
+					}
+					if len(members) == 0 {
+						ch.members = nil
+					}
+					for _, m := range members {
+						ch.members = slices.DeleteFunc(ch.members, func(id uuid.UUID) bool { return id.String() == m.Value })
+					}
+				case verb == "remove" && memberFilterPath.MatchString(op.Path):
+					value := memberFilterPath.FindStringSubmatch(op.Path)[1]
+					ch.members = slices.DeleteFunc(ch.members, func(id uuid.UUID) bool { return id.String() == value })
+				case verb == "replace" && path == "displayname":
+					name, ok := scimString(op.Value)
+					if !ok || name == "" {
+						return ch, "displayName must be a string"
+					}
+					ch.displayName = &name
+				case verb == "replace" && path == "externalid":
+					id, ok := scimString(op.Value)
+					if !ok {
+						return ch, "externalId must be a string"
+					}
+					ch.externalID = &id
+				case verb == "replace" && path == "":
+					var attrs struct {
+						DisplayName *string `json:"displayName"`
+						ExternalID  *string `json:"externalId"`
+					}
+					if json.Unmarshal(op.Value, &attrs) != nil {
+						return ch, "value must be an object"
+					}
+					if attrs.DisplayName != nil && *attrs.DisplayName != g.DisplayName {
+						ch.displayName = attrs.DisplayName
+					}
+					if attrs.ExternalID != nil {
+						ch.externalID = attrs.ExternalID
+					}
+				default:
+					return ch, "Unsupported operation " + op.Op + " " + op.Path
+				}
+			}
+			return ch, ""
+		})
+	}
+}
+
+// DeleteSCIMGroup removes a group from the tenant; its members lose the role it gave them
+func DeleteSCIMGroup(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := scimProvider(c)
+		ctx := c.Request.Context()
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		defer tx.Rollback()
+		g, err := tenantGroup(ctx, tx.Client(), p, c.Param("id"))
+		if err != nil {
+			if ent.IsNotFound(err) {
+				scimError(c, http.StatusNotFound, "", "Group not found")
+				return
+			}
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := tx.SCIMGroup.DeleteOne(g).Exec(ctx); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := syncRoles(ctx, tx, p, g.Members); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,541 @@
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"streamify/ent"
+	"streamify/ent/externalidentity"
+	"streamify/ent/scimgroup"
+	"streamify/ent/user"
+	"streamify/events"
+)
+
+// SCIMName is the name attribute of a UserResource
+type SCIMName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// SCIMEmail is one of a UserResource's emails
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// UserResource is a SCIM User. userName is the user's email.
+type UserResource struct {
+	Schemas    []string    `json:"schemas"`
+	ID         string      `json:"id,omitempty"`
+	ExternalID string      `json:"externalId,omitempty"`
+	UserName   string      `json:"userName" binding:"required"`
+	Name       *SCIMName   `json:"name,omitempty"`
+	Emails     []SCIMEmail `json:"emails,omitempty"`
+	Active     *bool       `json:"active,omitempty"`
+	Meta       *scimMeta   `json:"meta,omitempty"`
+}
+
+// userChanges are the attributes a PUT or PATCH sets; nil ones are left alone
+type userChanges struct {
+	email      *string
+	givenName  *string
+	familyName *string
+	externalID *string
+	active     *bool
+}
+
+// email picks the address to sign in with: userName when it is one, or else
+// the primary email
+func (r UserResource) email() string {
+	if strings.Contains(r.UserName, "@") {
+		return r.UserName
+	}
+	for _, e := range r.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(r.Emails) > 0 {
+		return r.Emails[0].Value
+	}
+	return ""
+}
+
+func (r UserResource) changes() userChanges {
+	email := r.email()
+	ch := userChanges{email: &email, externalID: &r.ExternalID, active: r.Active}
+	name := SCIMName{}
+	if r.Name != nil {
+		name = *r.Name
+	}
+	ch.givenName, ch.familyName = &name.GivenName, &name.FamilyName
+	return ch
+}
+
+// apply sets one attribute from a PATCH operation. Attributes streamify has no
+// use for are ignored, as identity providers send many.
+func (ch *userChanges) apply(path string, raw json.RawMessage) bool {
+	switch strings.ToLower(path) {
+	case "active":
+		b, ok := scimBool(raw)
+		ch.active = &b
+		return ok
+	case "username", `emails[type eq "work"].value`:
+		s, ok := scimString(raw)
+		if ok && strings.Contains(s, "@") {
+			ch.email = &s
+		}
+		return ok
+	case "externalid":
+		s, ok := scimString(raw)
+		ch.externalID = &s
+		return ok
+	case "name.givenname":
+		s, ok := scimString(raw)
+		ch.givenName = &s
+		return ok
+	case "name.familyname":
+		s, ok := scimString(raw)
+		ch.familyName = &s
+		return ok
+	case "name":
+		var name SCIMName
+		if json.Unmarshal(raw, &name) != nil {
+			return false
+		}
+		ch.givenName, ch.familyName = &name.GivenName, &name.FamilyName
+	}
+	return true
+}
+
+// applyAll sets every attribute of a PATCH operation without a path
+func (ch *userChanges) applyAll(raw json.RawMessage) bool {
+	var attrs map[string]json.RawMessage
+	if json.Unmarshal(raw, &attrs) != nil {
+		return false
+	}
+	for path, v := range attrs {
+		if !ch.apply(path, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// save applies ch to the user and their identity
+func (ch userChanges) save(ctx context.Context, tx *ent.Tx, ident *ent.ExternalIdentity) (*ent.User, *ent.ExternalIdentity, error) {
+	update := tx.User.UpdateOneID(ident.UserID)
+	if ch.email != nil && *ch.email != "" {
+		update.SetEmail(*ch.email)
+	}
+	if ch.givenName != nil {
+		update.SetFirstName(*ch.givenName)
+	}
+	if ch.familyName != nil {
+		update.SetLastName(*ch.familyName)
+	}
+	if ch.active != nil {
+		if *ch.active {
+			update.ClearDeactivatedAt()
+		} else {
+			update.SetDeactivatedAt(time.Now())
+		}
+	}
+	u, err := update.Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ch.externalID != nil {
+		identUpdate := tx.ExternalIdentity.UpdateOne(ident)
+		if *ch.externalID == "" {
+			identUpdate.ClearExternalID()
+		} else {
+			identUpdate.SetExternalID(*ch.externalID)
+		}
+		if ident, err = identUpdate.Save(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return u, ident, nil
+}
+
+func (s *Service) userResource(ident *ent.ExternalIdentity, u *ent.User) UserResource {
+	active := u.DeactivatedAt == nil
+	r := UserResource{
+		Schemas:  []string{scimUserSchema},
+		ID:       u.ID.String(),
+		UserName: u.Email,
+		Emails:   []SCIMEmail{{Value: u.Email, Type: "work", Primary: true}},
+		Active:   &active,
+		Meta:     &scimMeta{ResourceType: "User", Location: s.scimLocation("Users", u.ID.String())},
+	}
+	if ident.ExternalID != nil {
+		r.ExternalID = *ident.ExternalID
+	}
+	if u.FirstName != "" || u.LastName != "" {
+		r.Name = &SCIMName{GivenName: u.FirstName, FamilyName: u.LastName}
+	}
+	return r
+}
+
+// tenantUser returns the identity linking p to the user with the ID in the path
+func tenantUser(ctx context.Context, client *ent.Client, p *ent.SSOProvider, id string) (*ent.ExternalIdentity, error) {
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, &ent.NotFoundError{}
+	}
+	return client.ExternalIdentity.Query().
+		Where(externalidentity.ProviderIDEQ(p.ID), externalidentity.UserIDEQ(userID)).
+		WithUser().
+		First(ctx)
+}
+
+// ListSCIMUsers lists the tenant's users, optionally filtered by userName or externalId
+func ListSCIMUsers(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := scimProvider(c)
+		attr, value, ok := parseFilter(c.Query("filter"))
+		if !ok {
+			scimError(c, http.StatusBadRequest, "invalidFilter", "Only attribute eq \"value\" filters are supported")
+			return
+		}
+		q := s.client.ExternalIdentity.Query().Where(externalidentity.ProviderIDEQ(p.ID))
+		switch attr {
+		case "":
+		case "username":
+			q.Where(externalidentity.HasUserWith(user.EmailEqualFold(value)))
+		case "externalid":
+			q.Where(externalidentity.ExternalIDEQ(value))
+		case "id":
+			id, err := uuid.Parse(value)
+			if err != nil {
+				id = uuid.Nil
+			}
+			q.Where(externalidentity.UserIDEQ(id))
+		default:
+			scimError(c, http.StatusBadRequest, "invalidFilter", "Users can be filtered by userName, externalId or id")
+			return
+		}
+
+		ctx := c.Request.Context()
+		total, err := q.Clone().Count(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		offset, limit := page(c)
+		idents, err := q.
+			Order(ent.Asc(externalidentity.FieldCreatedAt), ent.Asc(externalidentity.FieldID)).
+			Offset(offset).
+			Limit(limit).
+			WithUser().
+			All(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		resources := make([]UserResource, len(idents))
+		for i, ident := range idents {
+			resources[i] = s.userResource(ident, ident.Edges.User)
+		}
+		scimJSON(c, http.StatusOK, scimList{
+			Schemas:      []string{scimListSchema},
+			TotalResults: total,
+			StartIndex:   offset + 1,
+			ItemsPerPage: len(resources),
+			Resources:    resources,
+		})
+	}
+}
+
+// GetSCIMUser returns one of the tenant's users
+func GetSCIMUser(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ident, err := tenantUser(c.Request.Context(), s.client, scimProvider(c), c.Param("id"))
+		if err != nil {
+			if ent.IsNotFound(err) {
+				scimError(c, http.StatusNotFound, "", "User not found")
+				return
+			}
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		scimJSON(c, http.StatusOK, s.userResource(ident, ident.Edges.User))
+	}
+}
+
+// CreateSCIMUser provisions an account for one of the tenant's people. An
+// existing account with the email is linked when the provider vouches for the
+// email's domain.
+func CreateSCIMUser(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req UserResource
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		p := scimProvider(c)
+		email := req.email()
+		if email == "" {
+			scimError(c, http.StatusBadRequest, "invalidValue", "userName or emails must hold an email address")
+			return
+		}
+		if len(p.EmailDomains) > 0 && !inDomains(email, p.EmailDomains) {
+			scimError(c, http.StatusBadRequest, "invalidValue", "Email domain isn't allowed for this tenant")
+			return
+		}
+
+		ctx := c.Request.Context()
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		defer tx.Rollback()
+
+		created := false
+		u, err := tx.User.Query().Where(user.EmailEqualFold(email)).Only(ctx)
+		switch {
+		case err == nil:
+			linked, err := tx.ExternalIdentity.Query().
+				Where(externalidentity.ProviderIDEQ(p.ID), externalidentity.UserIDEQ(u.ID)).
+				Exist(ctx)
+			if err != nil {
+				scimError(c, http.StatusInternalServerError, "", err.Error())
+				return
+			}
+			// Only a provider that vouches for the domain may take over an account
+			if linked || len(p.EmailDomains) == 0 {
+				scimError(c, http.StatusConflict, "uniqueness", "A user with this userName already exists")
+				return
+			}
+		case ent.IsNotFound(err):
+			// New accounts are pinned to the tenant's region
+			create := tx.User.Create().SetEmail(email).SetNillableRegion(p.Region)
+			if req.Name != nil {
+				create.SetFirstName(req.Name.GivenName).SetLastName(req.Name.FamilyName)
+			}
+			if u, err = create.Save(ctx); err != nil {
+				scimError(c, http.StatusInternalServerError, "", err.Error())
+				return
+			}
+			created = true
+		default:
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+
+		ident, err := tx.ExternalIdentity.Create().
+			SetProviderID(p.ID).
+			SetUserID(u.ID).
+			SetNillableExternalID(nilIfEmpty(req.ExternalID)).
+			SetProvisioned(true).
+			Save(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if req.Active != nil && !*req.Active {
+			if u, err = u.Update().SetDeactivatedAt(time.Now()).Save(ctx); err != nil {
+				scimError(c, http.StatusInternalServerError, "", err.Error())
+				return
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if created {
+			events.Emit(ctx, events.UserRegistered{UserID: u.ID})
+		}
+		logger.Info("user provisioned through SCIM", "tenant", p.Slug, "user_id", u.ID, "created", created)
+		c.Header("Location", s.scimLocation("Users", u.ID.String()))
+		scimJSON(c, http.StatusCreated, s.userResource(ident, u.Unwrap()))
+	}
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// updateSCIMUser saves what change decides to set on the user in the path
+func (s *Service) updateSCIMUser(c *gin.Context, change func() (userChanges, bool)) {
+	p := scimProvider(c)
+	ctx := c.Request.Context()
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	defer tx.Rollback()
+
+	ident, err := tenantUser(ctx, tx.Client(), p, c.Param("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			scimError(c, http.StatusNotFound, "", "User not found")
+			return
+		}
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	ch, ok := change()
+	if !ok {
+		scimError(c, http.StatusBadRequest, "invalidValue", "Unsupported operation or value")
+		return
+	}
+	if ch.email != nil && len(p.EmailDomains) > 0 && !inDomains(*ch.email, p.EmailDomains) {
+		scimError(c, http.StatusBadRequest, "invalidValue", "Email domain isn't allowed for this tenant")
+		return
+	}
+	u, ident, err := ch.save(ctx, tx, ident)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			scimError(c, http.StatusConflict, "uniqueness", "A user with this userName already exists")
+			return
+		}
+		if ent.IsValidationError(err) {
+			scimError(c, http.StatusBadRequest, "invalidValue", err.Error())
+			return
+		}
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		scimError(c, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	if ch.active != nil {
+		logger.Info("user activation changed through SCIM", "tenant", p.Slug, "user_id", u.ID, "active", *ch.active)
+	}
+	scimJSON(c, http.StatusOK, s.userResource(ident, u.Unwrap()))
+}
+
+// ReplaceSCIMUser replaces a user's attributes
+func ReplaceSCIMUser(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req UserResource
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		if req.email() == "" {
+			scimError(c, http.StatusBadRequest, "invalidValue", "userName or emails must hold an email address")
+			return
+		}
+		s.updateSCIMUser(c, func() (userChanges, bool) {
+			return req.changes(), true
+		})
+	}
+}
+
+// PatchSCIMUser changes some of a user's attributes; setting active to false
+// deprovisions them
+func PatchSCIMUser(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req PatchRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			scimError(c, http.StatusBadRequest, "invalidSyntax", err.Error())
+			return
+		}
+		s.updateSCIMUser(c, func() (userChanges, bool) {
+			var ch userChanges
+			for _, op := range req.Operations {
+				switch strings.ToLower(op.Op) {
+				case "add", "replace":
+					if op.Path == "" {
+						if !ch.applyAll(op.Value) {
+							return ch, false
+						}
+					} else if !ch.apply(op.Path, op.Value) {
+						return ch, false
+					}
+				case "remove":
+					if strings.EqualFold(op.Path, "externalId") {
+						empty := ""
+						ch.externalID = &empty
+					}
+				default:
+					return ch, false
+				}
+			}
+			return ch, true
+		})
+	}
+}
+
+// DeleteSCIMUser deprovisions a user: they are deactivated, unlinked from the
+// tenant and dropped from its groups. Their data is kept.
+func DeleteSCIMUser(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p := scimProvider(c)
+		ctx := c.Request.Context()
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		defer tx.Rollback()
+
+		ident, err := tenantUser(ctx, tx.Client(), p, c.Param("id"))
+		if err != nil {
+			if ent.IsNotFound(err) {
+				scimError(c, http.StatusNotFound, "", "User not found")
+				return
+			}
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		err = errors.Join(
+			tx.User.UpdateOneID(ident.UserID).SetDeactivatedAt(time.Now()).Exec(ctx),
+			deleteIdentities(ctx, tx, p, ident.UserID),
+			removeFromGroups(ctx, tx, p, ident.UserID),
+		)
+		if err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			scimError(c, http.StatusInternalServerError, "", err.Error())
+			return
+		}
+		logger.Info("user deprovisioned through SCIM", "tenant", p.Slug, "user_id", ident.UserID)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func deleteIdentities(ctx context.Context, tx *ent.Tx, p *ent.SSOProvider, userID uuid.UUID) error {
+	_, err := tx.ExternalIdentity.Delete().
+		Where(externalidentity.ProviderIDEQ(p.ID), externalidentity.UserIDEQ(userID)).
+		Exec(ctx)
+	return err
+}
+
+// removeFromGroups drops userID from every group of p
+func removeFromGroups(ctx context.Context, tx *ent.Tx, p *ent.SSOProvider, userID uuid.UUID) error {
+	groups, err := tx.SCIMGroup.Query().Where(scimgroup.ProviderIDEQ(p.ID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if !slices.Contains(g.Members, userID) {
+			continue
+		}
+		members := slices.DeleteFunc(slices.Clone(g.Members), func(id uuid.UUID) bool { return id == userID })
+		if err := tx.SCIMGroup.UpdateOne(g).SetMembers(members).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+// Package sso signs members of enterprise tenants, such as labels, in through
+// their organization's OpenID Connect provider. Each tenant has its own
+// provider, set up through the admin API. People signing in for the first time
+// get an account on the spot, and the provider's groups can decide their role.
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"streamify/anomaly"
+	"streamify/auth"
+	"streamify/ent"
+	"streamify/ent/externalidentity"
+	"streamify/ent/ssoprovider"
+	"streamify/ent/user"
+	"streamify/events"
+	"streamify/logging"
+)
+
+var logger = logging.For("sso")
+
+// loginTTL is how long a user has to sign in at their provider
+const loginTTL = 10 * time.Minute
+
+// stateCookie carries a sign-in's state, nonce and PKCE verifier from Login to Callback
+const stateCookie = "streamify_sso"
+
+// Errors returned by signIn; Callback reports each to the app by its code
+var (
+	errNotAllowed    = errors.New("not_allowed")
+	errAccountExists = errors.New("account_exists")
+)
+
+// Config holds the URLs a sign-in redirects between
+type Config struct {
+	// BaseURL is where the API is served; providers redirect back to it
+	BaseURL string
+	// AppURL is the frontend that receives the tokens
+	AppURL string
+}
+
+// Service signs users in through their tenant's provider
+type Service struct {
+	client    *ent.Client
+	cfg       Config
+	providers *providers
+}
+
+// NewService returns a service redirecting between the URLs in cfg
+func NewService(client *ent.Client, cfg Config) *Service {
+	return &Service{client: client, cfg: cfg, providers: newProviders()}
+}
+
+func (s *Service) redirectURI(slug string) string {
+	return strings.TrimRight(s.cfg.BaseURL, "/") + "/api/auth/sso/" + slug + "/callback"
+}
+
+// finish sends the browser back to the app with the URL fragment set to
+// values, which keeps tokens out of server logs and Referer headers
+func (s *Service) finish(c *gin.Context, values url.Values) {
+	c.Redirect(http.StatusFound, strings.TrimRight(s.cfg.AppURL, "/")+"/sso/callback#"+values.Encode())
+}
+
+func (s *Service) fail(c *gin.Context, code string) {
+	s.finish(c, url.Values{"error": {code}})
+}
+
+// enabledProvider returns the enabled provider of the tenant named slug
+func (s *Service) enabledProvider(ctx context.Context, slug string) (*ent.SSOProvider, error) {
+	return s.client.SSOProvider.Query().
+		Where(ssoprovider.SlugEQ(slug), ssoprovider.EnabledEQ(true)).
+		Only(ctx)
+}
+
+// loginState is what Callback needs to finish a sign-in started by Login
+type loginState struct {
+	Slug     string `json:"slug"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Login sends the browser to sign in at the tenant's provider
+func Login(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		p, err := s.enabledProvider(ctx, c.Param("tenant"))
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Unknown SSO tenant"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		op, err := s.providers.get(ctx, p.Issuer)
+		if err != nil {
+			logger.Error("SSO provider unavailable", "tenant", p.Slug, "error", err)
+			c.JSON(http.StatusBadGateway, gin.H{"error": "The sign-in provider is unavailable"})
+			return
+		}
+
+		st := loginState{Slug: p.Slug}
+		for _, v := range []*string{&st.State, &st.Nonce, &st.Verifier} {
+			if *v, err = randomString(); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start sign-in"})
+				return
+			}
+		}
+		raw, _ := json.Marshal(st)
+		c.SetSameSite(http.SameSiteLaxMode)
+		c.SetCookie(stateCookie, base64.RawURLEncoding.EncodeToString(raw), int(loginTTL/time.Second),
+			"/api/auth/sso/", "", strings.HasPrefix(s.cfg.BaseURL, "https://"), true)
+		c.Redirect(http.StatusFound, op.authCodeURL(p.ClientID, s.redirectURI(p.Slug), st.State, st.Nonce, st.Verifier))
+	}
+}
+
+// readState returns the sign-in state Login left in the cookie, if it belongs
+// to this tenant and the state the provider sent back
+func readState(c *gin.Context) (loginState, bool) {
+	var st loginState
+	cookie, err := c.Cookie(stateCookie)
+	if err != nil {
+		return st, false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cookie)
+	if err != nil || json.Unmarshal(raw, &st) != nil {
+		return st, false
+	}
+	ok := st.Slug == c.Param("tenant") && st.State != "" &&
+		subtle.ConstantTimeCompare([]byte(st.State), []byte(c.Query("state"))) == 1
+	return st, ok
+}
+
+// Callback is where the provider sends the browser back. It signs the user
+// in and redirects to the app's /sso/callback with access_token,
+// refresh_token and expires_in in the URL fragment, or with an error code:
+// access_denied, invalid_state, provider_unavailable, not_allowed,
+// account_exists or server_error.
+func Callback(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		st, ok := readState(c)
+		c.SetCookie(stateCookie, "", -1, "/api/auth/sso/", "", strings.HasPrefix(s.cfg.BaseURL, "https://"), true)
+		if !ok {
+			s.fail(c, "invalid_state")
+			return
+		}
+		if e := c.Query("error"); e != "" {
+			logger.Info("SSO sign-in refused by provider", "tenant", st.Slug, "error", e)
+			s.fail(c, "access_denied")
+			return
+		}
+
+		ctx := c.Request.Context()
+		p, err := s.enabledProvider(ctx, st.Slug)
+		if err != nil {
+			s.fail(c, "invalid_state")
+			return
+		}
+		op, err := s.providers.get(ctx, p.Issuer)
+		if err != nil {
+			logger.Error("SSO provider unavailable", "tenant", p.Slug, "error", err)
+			s.fail(c, "provider_unavailable")
+			return
+		}
+		idToken, err := op.exchange(ctx, p.ClientID, p.ClientSecret, s.redirectURI(p.Slug), c.Query("code"), st.Verifier)
+		if err != nil {
+			logger.Error("exchanging SSO code failed", "tenant", p.Slug, "error", err)
+			s.fail(c, "provider_unavailable")
+			return
+		}
+		claims, err := op.verify(ctx, idToken, p.ClientID, st.Nonce)
+		if err != nil {
+			logger.Warn("SSO ID token rejected", "tenant", p.Slug, "error", err)
+			s.fail(c, "access_denied")
+			return
+		}
+
+		u, err := s.signIn(ctx, p, claims)
+		if err != nil {
+			if errors.Is(err, errNotAllowed) || errors.Is(err, errAccountExists) {
+				logger.Info("SSO sign-in refused", "tenant", p.Slug, "reason", err)
+				s.fail(c, err.Error())
+				return
+			}
+			logger.Error("SSO sign-in failed", "tenant", p.Slug, "error", err)
+			s.fail(c, "server_error")
+			return
+		}
+		tokens, err := auth.IssueTokens(u.ID.String())
+		if err != nil {
+			s.fail(c, "server_error")
+			return
+		}
+		anomaly.Observe(c, anomaly.Login, u.ID)
+		s.finish(c, url.Values{
+			"access_token":  {tokens.AccessToken},
+			"refresh_token": {tokens.RefreshToken},
+			"expires_in":    {strconv.FormatInt(tokens.ExpiresIn, 10)},
+		})
+	}
+}
+
+// identity is what signIn reads from the provider's ID token
+type identity struct {
+	subject       string
+	email         string
+	emailVerified bool
+	firstName     string
+	lastName      string
+	groups        []string
+	hasGroups     bool
+}
+
+func identityFromClaims(claims jwt.MapClaims, groupsClaim string) identity {
+	id := identity{}
+	id.subject, _ = claims["sub"].(string)
+	id.email, _ = claims["email"].(string)
+	// Some providers send email_verified as a string
+	id.emailVerified = claims["email_verified"] == true || claims["email_verified"] == "true"
+	id.firstName, _ = claims["given_name"].(string)
+	id.lastName, _ = claims["family_name"].(string)
+	_, id.hasGroups = claims[groupsClaim]
+	switch g := claims[groupsClaim].(type) {
+	case string:
+		id.groups = []string{g}
+	case []interface{}:
+		for _, v := range g {
+			if s, ok := v.(string); ok {
+				id.groups = append(id.groups, s)
+			}
+		}
+	}
+	return id
+}
+
+// inDomains reports whether email is at one of domains
+func inDomains(email string, domains []string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+	return slices.ContainsFunc(domains, func(d string) bool {
+		return strings.EqualFold(d, domain)
+	})
+}
+
+// mappedRole returns the role p's group mapping gives to groups, and false when
+// p maps no groups
+func mappedRole(p *ent.SSOProvider, groups []string) (user.Role, bool) {
+	if len(p.GroupRoles) == 0 {
+		return "", false
+	}
+	role := user.RoleUser
+	for _, g := range groups {
+		if user.Role(p.GroupRoles[g]) == user.RoleAdmin {
+			role = user.RoleAdmin
+		}
+	}
+	return role, true
+}
+
+// signIn returns the user the provider's account signs in as. A first sign-in
+// links an existing account with the same email when p vouches for the email's
+// domain, or else creates one when p provisions accounts.
+func (s *Service) signIn(ctx context.Context, p *ent.SSOProvider, claims jwt.MapClaims) (*ent.User, error) {
+	id := identityFromClaims(claims, p.GroupsClaim)
+	if id.subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	if len(p.EmailDomains) > 0 && !(id.emailVerified && inDomains(id.email, p.EmailDomains)) {
+		return nil, errNotAllowed
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var u *ent.User
+	created := false
+	ident, err := tx.ExternalIdentity.Query().
+		Where(externalidentity.ProviderIDEQ(p.ID), externalidentity.SubjectEQ(id.subject)).
+		WithUser().
+		Only(ctx)
+	switch {
+	case err == nil:
+		u = ident.Edges.User
+		if err := tx.ExternalIdentity.UpdateOne(ident).SetLastLoginAt(time.Now()).Exec(ctx); err != nil {
+			return nil, err
+		}
+	case ent.IsNotFound(err):
+		if id.email == "" {
+			return nil, errNotAllowed
+		}
+		// Accounts the provider provisioned through SCIM are linked at their
+		// first sign-in
+		if id.emailVerified {
+			ident, err = tx.ExternalIdentity.Query().
+				Where(
+					externalidentity.ProviderIDEQ(p.ID),
+					externalidentity.SubjectIsNil(),
+					externalidentity.HasUserWith(user.EmailEqualFold(id.email)),
+				).
+				WithUser().
+				First(ctx)
+			if err == nil {
+				u = ident.Edges.User
+				err = tx.ExternalIdentity.UpdateOne(ident).
+					SetSubject(id.subject).
+					SetLastLoginAt(time.Now()).
+					Exec(ctx)
+				if err != nil {
+					return nil, err
+				}
+				break
+			}
+			if !ent.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		u, err = tx.User.Query().Where(user.EmailEqualFold(id.email)).Only(ctx)
+		switch {
+		case err == nil:
+			// Only a provider that vouches for the domain may take over an account
+			if len(p.EmailDomains) == 0 {
+				return nil, errAccountExists
+			}
+		case ent.IsNotFound(err):
+			if !p.Provisioning {
+				return nil, errNotAllowed
+			}
+			// New accounts are pinned to the tenant's region
+			create := tx.User.Create().SetEmail(id.email).SetNillableRegion(p.Region)
+			if id.firstName != "" {
+				create = create.SetFirstName(id.firstName)
+			}
+			if id.lastName != "" {
+				create = create.SetLastName(id.lastName)
+			}
+			if u, err = create.Save(ctx); err != nil {
+				return nil, err
+			}
+			created = true
+		default:
+			return nil, err
+		}
+		err = tx.ExternalIdentity.Create().
+			SetProviderID(p.ID).
+			SetSubject(id.subject).
+			SetUserID(u.ID).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+	if u.DeactivatedAt != nil {
+		return nil, errNotAllowed
+	}
+
+	// Providers that push groups through SCIM may leave them out of ID tokens
+	if role, ok := mappedRole(p, id.groups); ok && id.hasGroups && u.Role != role {
+		if u, err = tx.User.UpdateOne(u).SetRole(role).Save(ctx); err != nil {
+			return nil, err
+		}
+		logger.Info("role synced from SSO groups", "tenant", p.Slug, "user_id", u.ID, "role", role)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if created {
+		events.Emit(ctx, events.UserRegistered{UserID: u.ID})
+	}
+	return u.Unwrap(), nil
+}
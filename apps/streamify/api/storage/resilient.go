@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"streamify/logging"
+	"streamify/resilience"
+)
+
+var logger = logging.For("storage")
+
+// logOp records the outcome of one storage call: failures as warnings, the rest at debug
+func logOp(op, key string, start time.Time, err error) {
+	switch {
+	case err == nil:
+		logger.Debug(op, "key", key, "elapsed", time.Since(start))
+	case IsNotFound(err):
+		logger.Debug(op, "key", key, "elapsed", time.Since(start), "error", err)
+	default:
+		logger.Warn(op+" failed", "key", key, "elapsed", time.Since(start), "error", err)
+	}
+}
+
+// resilient routes every storage call through a dependency's breaker and retry policy
+type resilient struct {
+	s   Storage
+	dep *resilience.Dependency
+}
+
+// Resilient wraps s with dep. Uploads are only retried when the reader can be rewound.
+func Resilient(s Storage, dep *resilience.Dependency) Storage {
+	return &resilient{s: s, dep: dep}
+}
+
+// IsNotFound reports whether err is ErrNotFound; use it as resilience.Policy.Permanent
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// Put implements Storage
+func (r *resilient) Put(ctx context.Context, key string, body io.Reader) error {
+	start := time.Now()
+	err := r.put(ctx, key, body)
+	logOp("put", key, start, err)
+	return err
+}
+
+func (r *resilient) put(ctx context.Context, key string, body io.Reader) error {
+	seeker, ok := body.(io.Seeker)
+	if !ok {
+		return r.dep.DoOnce(ctx, func(ctx context.Context) error {
+			return r.s.Put(ctx, key, body)
+		})
+	}
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	return r.dep.Do(ctx, func(ctx context.Context) error {
+		if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		return r.s.Put(ctx, key, body)
+	})
+}
+
+// Get implements Storage
+func (r *resilient) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	start := time.Now()
+	var rc io.ReadCloser
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		var err error
+		rc, err = r.s.Get(ctx, key)
+		return err
+	})
+	logOp("get", key, start, err)
+	return rc, err
+}
+
+// List implements Storage
+func (r *resilient) List(ctx context.Context, prefix string) ([]Object, error) {
+	start := time.Now()
+	var objects []Object
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		var err error
+		objects, err = r.s.List(ctx, prefix)
+		return err
+	})
+	logOp("list", prefix, start, err)
+	return objects, err
+}
+
+// Delete implements Storage
+func (r *resilient) Delete(ctx context.Context, key string) error {
+	start := time.Now()
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		return r.s.Delete(ctx, key)
+	})
+	logOp("delete", key, start, err)
+	return err
+}
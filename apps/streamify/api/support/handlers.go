@@ -0,0 +1,306 @@
+package support
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/predicate"
+	"streamify/ent/schema"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportreply"
+	"streamify/ent/supportticket"
+	"streamify/filtering"
+	"streamify/ids"
+	"streamify/paging"
+	"streamify/storage"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpenRequest is the request body for OpenTicket
+type OpenRequest struct {
+	Subject  string `json:"subject" binding:"required,max=200"`
+	Body     string `json:"body" binding:"required,max=10000"`
+	Category string `json:"category" binding:"required,oneof=account billing playback catalog bug other"`
+}
+
+// OpenTicket files a support ticket for the current user. Files are added
+// afterwards with POST /api/v1/support/tickets/:id/attachments.
+func OpenTicket(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		var body OpenRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		t, err := s.Open(c.Request.Context(), userID, strings.TrimSpace(body.Subject), body.Body, supportticket.Category(body.Category))
+		switch {
+		case errors.Is(err, ErrTooManyOpen):
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusCreated, t)
+		}
+	}
+}
+
+// MyTickets lists the current user's tickets, newest first, a page at a time
+func MyTickets(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := viewer.UserID(c.Request.Context())
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+		q := s.client.SupportTicket.Query().Where(supportticket.UserIDEQ(userID))
+		listTickets(c, q, ent.Desc(supportticket.FieldCreatedAt), ent.Desc(supportticket.FieldID))
+	}
+}
+
+// ListTickets lists every user's tickets, least recently updated first so
+// the longest-waiting come first, a page at a time (admin)
+func ListTickets(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		listTickets(c, s.client.SupportTicket.Query(), ent.Asc(supportticket.FieldUpdatedAt), ent.Asc(supportticket.FieldID))
+	}
+}
+
+// ticketFields are what ticket lists may be filtered and sorted by
+var ticketFields = filtering.Fields{
+	"subject":  {Column: supportticket.FieldSubject, Kind: filtering.Text},
+	"category": {Column: supportticket.FieldCategory, Kind: filtering.Enum, Values: filtering.EnumValues(schema.SupportTicket{}.Fields(), supportticket.FieldCategory)},
+	"status":   {Column: supportticket.FieldStatus, Kind: filtering.Enum, Values: filtering.EnumValues(schema.SupportTicket{}.Fields(), supportticket.FieldStatus)},
+	"created":  {Column: supportticket.FieldCreatedAt, Kind: filtering.Time},
+	"updated":  {Column: supportticket.FieldUpdatedAt, Kind: filtering.Time},
+}
+
+// listTickets writes the page of q's tickets the request asked for, filtered
+// and sorted by ticketFields, in order unless the request sorts them
+func listTickets(c *gin.Context, q *ent.SupportTicketQuery, order ...supportticket.OrderOption) {
+	p, ok := paging.Parse(c)
+	if !ok {
+		return
+	}
+	f, ok := filtering.Parse(c, ticketFields)
+	if !ok {
+		return
+	}
+	q = q.Where(filtering.Where[predicate.SupportTicket](f)...)
+	all := q.Clone()
+	tickets, err := q.Order(filtering.Order(f, order...)...).Limit(p.Limit).Offset(p.Offset).All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	total, err := paging.Total(c.Request.Context(), p, len(tickets), all)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, paging.New(c, p, tickets, total))
+}
+
+// ticket loads the ticket in :id, writing an error response and returning
+// nil unless it exists and the viewer filed it or is an admin
+func ticket(c *gin.Context, s *Service) *ent.SupportTicket {
+	id, err := ids.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid ticket ID"})
+		return nil
+	}
+	v := viewer.FromContext(c.Request.Context())
+	t, err := s.client.SupportTicket.Get(c.Request.Context(), id)
+	if ent.IsNotFound(err) || err == nil && !v.IsAdmin() && (v == nil || t.UserID != v.UserID) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "ticket not found"})
+		return nil
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return nil
+	}
+	return t
+}
+
+// GetTicket returns a ticket with its replies, oldest first, and its
+// attachments, to the user who filed it or an admin
+func GetTicket(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t := ticket(c, s)
+		if t == nil {
+			return
+		}
+		ctx := c.Request.Context()
+		replies, err := t.QueryReplies().Order(ent.Asc(supportreply.FieldCreatedAt)).All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		attachments, err := t.QueryAttachments().Order(ent.Asc(supportattachment.FieldCreatedAt)).All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		t.Edges.Replies, t.Edges.Attachments = replies, attachments
+		c.JSON(http.StatusOK, t)
+	}
+}
+
+// ReplyRequest is the request body for Reply and StaffReply
+type ReplyRequest struct {
+	Body string `json:"body" binding:"required,max=10000"`
+}
+
+// reply adds the request's reply to the ticket in :id
+func reply(c *gin.Context, s *Service, staff bool) {
+	t := ticket(c, s)
+	if t == nil {
+		return
+	}
+	var body ReplyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	authorID, _ := viewer.UserID(c.Request.Context())
+	r, err := s.Reply(c.Request.Context(), t, authorID, staff, body.Body)
+	switch {
+	case errors.Is(err, ErrClosed):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusCreated, r)
+	}
+}
+
+// Reply adds the current user's reply to their ticket, reopening it
+func Reply(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reply(c, s, false)
+	}
+}
+
+// StaffReply answers a ticket as support and emails its user (admin)
+func StaffReply(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reply(c, s, true)
+	}
+}
+
+// SetStatusRequest is the request body for SetStatus
+type SetStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=open pending resolved closed"`
+}
+
+// SetStatus moves a ticket through its lifecycle (admin)
+func SetStatus(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t := ticket(c, s)
+		if t == nil {
+			return
+		}
+		var body SetStatusRequest
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		t, err := s.SetStatus(c.Request.Context(), t, supportticket.Status(body.Status))
+		switch {
+		case errors.Is(err, ErrClosed):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusOK, t)
+		}
+	}
+}
+
+// AddAttachment adds the raw request body to a ticket as a file named by
+// ?filename=
+func AddAttachment(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t := ticket(c, s)
+		if t == nil {
+			return
+		}
+		filename := path.Base(strings.ReplaceAll(strings.TrimSpace(c.Query("filename")), `\`, "/"))
+		if filename == "" || filename == "." || filename == "/" || len(filename) > 255 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "filename is required and at most 255 characters"})
+			return
+		}
+		if c.Request.ContentLength > MaxAttachmentSize {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": ErrTooLarge.Error()})
+			return
+		}
+		uploaderID, _ := viewer.UserID(c.Request.Context())
+		a, err := s.Attach(c.Request.Context(), t, uploaderID, filename, c.Request.Body)
+		switch {
+		case errors.Is(err, ErrClosed), errors.Is(err, ErrTooManyAttachments):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.Is(err, ErrTooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case errors.Is(err, ErrEmpty):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, ErrUnsupported):
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		case err != nil:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusCreated, a)
+		}
+	}
+}
+
+// GetAttachment downloads one of a ticket's files, to the user who filed it
+// or an admin
+func GetAttachment(s *Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t := ticket(c, s)
+		if t == nil {
+			return
+		}
+		id, err := ids.Parse(c.Param("attachment_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid attachment ID"})
+			return
+		}
+		ctx := c.Request.Context()
+		a, err := t.QueryAttachments().Where(supportattachment.IDEQ(id)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "attachment not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		body, err := s.Contents(ctx, a)
+		if err != nil {
+			if storage.IsNotFound(err) {
+				c.JSON(http.StatusNotFound, gin.H{"error": "attachment not found"})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		defer body.Close()
+		// Always a download, so an uploaded file never renders in the app's origin
+		c.DataFromReader(http.StatusOK, a.Size, a.ContentType, body, map[string]string{
+			"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename}),
+			"X-Content-Type-Options": "nosniff",
+			"Cache-Control":          "private, no-store",
+		})
+	}
+}
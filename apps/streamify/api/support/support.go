@@ -0,0 +1,220 @@
+// Package support takes users' requests for help as tickets, with files such
+// as screenshots attached, and lets admins answer them through the API
+// rather than by email. A ticket is open while support owes an answer and
+// pending while the user does; admins resolve it once answered, a reply from
+// the user reopens it, and a closed ticket takes no more replies or files.
+package support
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"streamify/ent"
+	"streamify/ent/supportattachment"
+	"streamify/ent/supportticket"
+	"streamify/logging"
+	"streamify/mail"
+	"streamify/storage"
+
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("support")
+
+const (
+	// MaxOpenTickets is how many open or pending tickets a user may have
+	MaxOpenTickets = 10
+	// MaxAttachments is how many files a ticket may have
+	MaxAttachments = 5
+	// MaxAttachmentSize caps the size of one file
+	MaxAttachmentSize = 10 << 20
+)
+
+var (
+	// ErrTooManyOpen is returned when the user has MaxOpenTickets already
+	ErrTooManyOpen = fmt.Errorf("at most %d tickets can be open at once", MaxOpenTickets)
+	// ErrClosed is returned for changes to a closed ticket
+	ErrClosed = errors.New("ticket is closed")
+	// ErrTooManyAttachments is returned when a ticket has MaxAttachments already
+	ErrTooManyAttachments = fmt.Errorf("a ticket can have at most %d attachments", MaxAttachments)
+	// ErrTooLarge is returned for files over MaxAttachmentSize
+	ErrTooLarge = fmt.Errorf("attachment exceeds %d MB", MaxAttachmentSize>>20)
+	// ErrEmpty is returned for empty files
+	ErrEmpty = errors.New("attachment is empty")
+	// ErrUnsupported is returned for files that aren't images, PDFs or text
+	ErrUnsupported = errors.New("attachments must be PNG, JPEG, GIF or WebP images, PDFs or plain text")
+)
+
+// attachmentTypes are the accepted file types, as detected from their contents
+var attachmentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf", "text/plain; charset=utf-8"}
+
+// attachmentKey returns the storage key of an attachment's contents
+func attachmentKey(a *ent.SupportAttachment) string {
+	return "support/" + a.TicketID.String() + "/" + a.ID.String()
+}
+
+// Service keeps tickets in the database and their files in object storage
+type Service struct {
+	client *ent.Client
+	store  storage.Storage
+	mailer mail.Mailer
+	// appURL is the frontend that reply notifications link to
+	appURL string
+}
+
+// NewService returns a Service. Users are emailed a link into appURL when
+// support replies.
+func NewService(client *ent.Client, store storage.Storage, mailer mail.Mailer, appURL string) *Service {
+	return &Service{client: client, store: store, mailer: mailer, appURL: appURL}
+}
+
+// Open files a ticket for userID
+func (s *Service) Open(ctx context.Context, userID uuid.UUID, subject, body string, category supportticket.Category) (*ent.SupportTicket, error) {
+	n, err := s.client.SupportTicket.Query().
+		Where(
+			supportticket.UserIDEQ(userID),
+			supportticket.StatusIn(supportticket.StatusOpen, supportticket.StatusPending),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n >= MaxOpenTickets {
+		return nil, ErrTooManyOpen
+	}
+	t, err := s.client.SupportTicket.Create().
+		SetUserID(userID).
+		SetSubject(subject).
+		SetBody(body).
+		SetCategory(category).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("support ticket opened", "ticket_id", t.ID, "user_id", userID, "category", category)
+	return t, nil
+}
+
+// Attach adds the file in r, named filename, to t
+func (s *Service) Attach(ctx context.Context, t *ent.SupportTicket, uploaderID uuid.UUID, filename string, r io.Reader) (*ent.SupportAttachment, error) {
+	if t.Status == supportticket.StatusClosed {
+		return nil, ErrClosed
+	}
+	n, err := s.client.SupportAttachment.Query().
+		Where(supportattachment.TicketIDEQ(t.ID)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n >= MaxAttachments {
+		return nil, ErrTooManyAttachments
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, MaxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(data) == 0:
+		return nil, ErrEmpty
+	case len(data) > MaxAttachmentSize:
+		return nil, ErrTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(attachmentTypes, contentType) {
+		return nil, ErrUnsupported
+	}
+
+	a, err := s.client.SupportAttachment.Create().
+		SetTicketID(t.ID).
+		SetUploadedBy(uploaderID).
+		SetFilename(filename).
+		SetContentType(contentType).
+		SetSize(int64(len(data))).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(ctx, attachmentKey(a), bytes.NewReader(data)); err != nil {
+		// The row is the viewer's own, so they may remove it again
+		if derr := s.client.SupportAttachment.DeleteOne(a).Exec(ctx); derr != nil {
+			logger.Warn("removing attachment that failed to store", "attachment_id", a.ID, "error", derr)
+		}
+		return nil, fmt.Errorf("storing attachment: %w", err)
+	}
+	return a, nil
+}
+
+// Contents returns the contents of attachment a
+func (s *Service) Contents(ctx context.Context, a *ent.SupportAttachment) (io.ReadCloser, error) {
+	return s.store.Get(ctx, attachmentKey(a))
+}
+
+// Reply adds a reply by authorID to t. Replies from support leave the ticket
+// pending on the user, who is emailed about them; replies from the user
+// reopen it.
+func (s *Service) Reply(ctx context.Context, t *ent.SupportTicket, authorID uuid.UUID, staff bool, body string) (*ent.SupportReply, error) {
+	if t.Status == supportticket.StatusClosed {
+		return nil, ErrClosed
+	}
+	status := supportticket.StatusOpen
+	if staff {
+		status = supportticket.StatusPending
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	r, err := tx.SupportReply.Create().
+		SetTicketID(t.ID).
+		SetAuthorID(authorID).
+		SetStaff(staff).
+		SetBody(body).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Touches updated_at even when the status stays the same
+	if err := tx.SupportTicket.UpdateOneID(t.ID).SetStatus(status).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	r = r.Unwrap()
+
+	if staff {
+		s.notify(ctx, t)
+	}
+	return r, nil
+}
+
+// notify emails t's user that support replied; failures are only logged
+func (s *Service) notify(ctx context.Context, t *ent.SupportTicket) {
+	u, err := s.client.User.Get(ctx, t.UserID)
+	if err == nil {
+		var b strings.Builder
+		fmt.Fprintf(&b, "Support replied to your request %q.\n\n", t.Subject)
+		fmt.Fprintf(&b, "Read the reply and answer it here:\n%s/support/tickets/%s\n", strings.TrimRight(s.appURL, "/"), t.ID)
+		err = s.mailer.Send(ctx, mail.Message{To: []string{u.Email}, Subject: "Re: " + t.Subject, Text: b.String()})
+	}
+	if err != nil {
+		logger.Warn("notifying user of support reply failed", "ticket_id", t.ID, "error", err)
+	}
+}
+
+// SetStatus moves t to status. A closed ticket stays closed.
+func (s *Service) SetStatus(ctx context.Context, t *ent.SupportTicket, status supportticket.Status) (*ent.SupportTicket, error) {
+	if t.Status == supportticket.StatusClosed && status != supportticket.StatusClosed {
+		return nil, ErrClosed
+	}
+	return s.client.SupportTicket.UpdateOne(t).SetStatus(status).Save(ctx)
+}
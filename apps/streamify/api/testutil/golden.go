@@ -0,0 +1,70 @@
+// Package testutil holds helpers shared by tests: golden files and fakes wiring.
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"streamify/mail"
+	"streamify/storage"
+)
+
+// Update rewrites golden files instead of comparing against them.
+// Set UPDATE_GOLDEN=1 when running go test after an intended output change.
+var Update = os.Getenv("UPDATE_GOLDEN") == "1"
+
+// Golden compares got with testdata/<name>.golden in the calling package's directory
+func Golden(t testing.TB, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if Update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with UPDATE_GOLDEN=1 to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s (run with UPDATE_GOLDEN=1 to accept)\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+// GoldenJSON marshals v with stable indentation and compares it with the golden file
+func GoldenJSON(t testing.TB, name string, v any) {
+	t.Helper()
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("marshaling %s: %v", name, err)
+	}
+	Golden(t, name, append(got, '\n'))
+}
+
+// Fakes bundles in-memory doubles for the service's external integrations
+type Fakes struct {
+	Storage *storage.Memory
+	Mail    *mail.Memory
+}
+
+// NewFakes returns empty in-memory storage and mail doubles
+func NewFakes() *Fakes {
+	return &Fakes{Storage: storage.NewMemory(), Mail: mail.NewMemory()}
+}
+
+// TempStorage returns filesystem storage rooted in a directory removed after the test
+func TempStorage(t testing.TB) *storage.Local {
+	t.Helper()
+	s, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("creating temp storage: %v", err)
+	}
+	return s
+}
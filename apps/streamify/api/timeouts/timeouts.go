@@ -0,0 +1,118 @@
+package timeouts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Config sets how long each route may run. Routes are keyed by method and gin
+// route pattern, e.g. "POST /api/v1/admin/backups/:id/restore"; a zero duration
+// disables the timeout for that route.
+type Config struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+// For returns the timeout for a method and route pattern
+func (cfg Config) For(method, route string) time.Duration {
+	if d, ok := cfg.Routes[method+" "+route]; ok {
+		return d
+	}
+	return cfg.Default
+}
+
+// ParseRoutes parses overrides of the form "METHOD /path=duration", separated by
+// semicolons, e.g. "GET /api/v1/artists=2s;POST /api/v1/admin/integrity/fix=5m"
+func ParseRoutes(s string) (map[string]time.Duration, error) {
+	routes := make(map[string]time.Duration)
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("timeouts: %q is not METHOD /path=duration", entry)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("timeouts: %q: %w", entry, err)
+		}
+		routes[strings.Join(strings.Fields(route), " ")] = d
+	}
+	return routes, nil
+}
+
+// timeoutWriter drops the handler's response once the deadline has passed, so the
+// middleware can answer 504 instead of whatever error the cancelled work produced
+type timeoutWriter struct {
+	gin.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+}
+
+func (w *timeoutWriter) expired() bool {
+	if !w.timedOut && !w.ResponseWriter.Written() && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+	}
+	return w.timedOut
+}
+
+func (w *timeoutWriter) WriteHeader(code int) {
+	if !w.expired() {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *timeoutWriter) WriteHeaderNow() {
+	if !w.expired() {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *timeoutWriter) Write(b []byte) (int, error) {
+	if w.expired() {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	if w.expired() {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Middleware bounds each request's context by its route's timeout. Handlers pass
+// c.Request.Context() to the database, so expiry cancels their queries; if nothing
+// was written before the deadline the client gets 504.
+func Middleware(cfg Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		d := cfg.For(c.Request.Method, c.FullPath())
+		if d <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), d)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		inner := c.Writer
+		w := &timeoutWriter{ResponseWriter: inner, ctx: ctx}
+		c.Writer = w
+		c.Next()
+		c.Writer = inner
+
+		if w.expired() {
+			c.AbortWithStatusJSON(http.StatusGatewayTimeout, gin.H{"error": "request timed out"})
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Package tombstones records deletions and serves them as a sync feed, so
+// clients keeping an offline copy of the catalog or their library learn what
+// to drop. Tombstones older than the retention window are purged; a client
+// that has been away longer must sync from scratch.
+package tombstones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/tombstone"
+	"streamify/jobs"
+	"streamify/logging"
+	"streamify/viewer"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var logger = logging.For("tombstones")
+
+// DefaultRetention is how long tombstones are kept unless configured otherwise
+const DefaultRetention = 30 * 24 * time.Hour
+
+const (
+	defaultPageSize = 500
+	maxPageSize     = 1000
+)
+
+// Record writes a tombstone for each of ids. Pass a transaction's client so
+// the tombstones commit with the deletion. owner is the user the entities
+// belonged to, or nil for catalog entities.
+func Record(ctx context.Context, client *ent.Client, typ tombstone.EntityType, owner *uuid.UUID, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	// Postgres keeps microseconds; truncating here makes cursors taken from
+	// the returned rows compare equal to what is stored
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	builders := make([]*ent.TombstoneCreate, len(ids))
+	for i, id := range ids {
+		builders[i] = client.Tombstone.Create().
+			SetEntityType(typ).
+			SetEntityID(id).
+			SetNillableOwnerID(owner).
+			SetDeletedAt(now)
+	}
+	if err := client.Tombstone.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("recording %s tombstones: %w", typ, err)
+	}
+	return nil
+}
+
+// Entry is a tombstone as sent to clients
+type Entry struct {
+	Type      tombstone.EntityType `json:"type"`
+	ID        uuid.UUID            `json:"id"`
+	DeletedAt time.Time            `json:"deleted_at"`
+}
+
+// cursor is a position in the feed: after the tombstone id deleted at t
+type cursor struct {
+	t  time.Time
+	id uuid.UUID
+}
+
+var errBadCursor = errors.New("invalid cursor")
+
+func (c cursor) String() string {
+	return strconv.FormatInt(c.t.UnixMicro(), 10) + "_" + c.id.String()
+}
+
+func parseCursor(s string) (cursor, error) {
+	micros, id, ok := strings.Cut(s, "_")
+	if !ok {
+		return cursor{}, errBadCursor
+	}
+	n, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return cursor{}, errBadCursor
+	}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return cursor{}, errBadCursor
+	}
+	return cursor{t: time.UnixMicro(n).UTC(), id: uid}, nil
+}
+
+// Feed pages through the tombstones the caller may see: catalog deletions
+// and deletions from their own library. Without ?cursor= it only returns the
+// current position: a client asks for it before fetching everything, so
+// nothing deleted meanwhile is missed, and then passes each response's
+// cursor back to get what was deleted since.
+// Cursors older than the retention window get 410 Gone, telling the client
+// to sync from scratch.
+func Feed(client *ent.Client, retention time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		userID, ok := viewer.UserID(ctx)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+			return
+		}
+
+		limit := defaultPageSize
+		if v := c.Query("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > maxPageSize {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and %d", maxPageSize)})
+				return
+			}
+			limit = n
+		}
+
+		now := time.Now().UTC()
+		v := c.Query("cursor")
+		if v == "" {
+			c.JSON(http.StatusOK, gin.H{
+				"tombstones": []Entry{},
+				"cursor":     cursor{t: now.Truncate(time.Microsecond)}.String(),
+				"has_more":   false,
+			})
+			return
+		}
+		after, err := parseCursor(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if after.t.Before(now.Add(-retention)) {
+			c.JSON(http.StatusGone, gin.H{
+				"error":     "cursor is older than the tombstone retention window; sync from scratch",
+				"retention": int64(retention / time.Second),
+			})
+			return
+		}
+
+		rows, err := client.Tombstone.Query().
+			Where(
+				tombstone.Or(
+					tombstone.DeletedAtGT(after.t),
+					tombstone.And(tombstone.DeletedAtEQ(after.t), tombstone.IDGT(after.id)),
+				),
+				tombstone.Or(tombstone.OwnerIDIsNil(), tombstone.OwnerIDEQ(userID)),
+			).
+			Order(ent.Asc(tombstone.FieldDeletedAt), ent.Asc(tombstone.FieldID)).
+			Limit(limit + 1).
+			All(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		hasMore := len(rows) > limit
+		if hasMore {
+			rows = rows[:limit]
+		}
+
+		entries := make([]Entry, len(rows))
+		for i, t := range rows {
+			entries[i] = Entry{Type: t.EntityType, ID: t.EntityID, DeletedAt: t.DeletedAt}
+		}
+		next := after
+		if len(rows) > 0 {
+			last := rows[len(rows)-1]
+			next = cursor{t: last.DeletedAt.UTC(), id: last.ID}
+		}
+		c.JSON(http.StatusOK, gin.H{"tombstones": entries, "cursor": next.String(), "has_more": hasMore})
+	}
+}
+
+// Purge returns a job deleting tombstones older than retention
+func Purge(client *ent.Client, retention time.Duration) jobs.Func {
+	return func(ctx context.Context) error {
+		n, err := client.Tombstone.Delete().
+			Where(tombstone.DeletedAtLT(time.Now().Add(-retention))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Info("purged tombstones", "count", n)
+		}
+		return nil
+	}
+}
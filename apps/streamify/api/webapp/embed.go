@@ -0,0 +1,21 @@
+//go:build webapp
+
+package webapp
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// dist is written by `npm run build:embed` in the frontend
+//
+//go:embed all:dist
+var dist embed.FS
+
+func init() {
+	sub, err := fs.Sub(dist, "dist")
+	if err != nil {
+		panic(err)
+	}
+	Files = sub
+}
@@ -0,0 +1,75 @@
+// Package webapp serves the built frontend under /app, so the API and the
+// app can ship as a single binary. The build is embedded with the webapp
+// build tag; without it Files is nil and nothing is served.
+package webapp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Prefix is the path the frontend is served under, matching the base of its
+// vite build
+const Prefix = "/app"
+
+// Files is the built frontend, or nil when the binary was built without it
+var Files fs.FS
+
+// Serve serves files for GET /app/*path. Files that exist are served as is,
+// with the content-hashed ones vite writes to assets/ cached for good; any
+// other path without an extension gets index.html so that the app's own
+// router handles it on reload.
+func Serve(files fs.FS) gin.HandlerFunc {
+	index, err := fs.ReadFile(files, "index.html")
+	if err != nil {
+		panic(fmt.Sprintf("webapp: the build has no index.html: %v", err))
+	}
+	sum := sha256.Sum256(index)
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	fsys := http.FS(files)
+
+	return func(c *gin.Context) {
+		name := strings.TrimPrefix(path.Clean("/"+c.Param("path")), "/")
+		if name != "" && name != "index.html" {
+			if info, err := fs.Stat(files, name); err == nil && !info.IsDir() {
+				if strings.HasPrefix(name, "assets/") {
+					c.Header("Cache-Control", "public, max-age=31536000, immutable")
+				} else {
+					c.Header("Cache-Control", "public, max-age=3600")
+				}
+				c.FileFromFS(name, fsys)
+				return
+			}
+			// A missing script or stylesheet is a 404, not the app's HTML,
+			// e.g. when a page open across a deploy loads an old chunk
+			if path.Ext(name) != "" {
+				c.Header("Cache-Control", "no-store")
+				c.Status(http.StatusNotFound)
+				return
+			}
+		}
+
+		// index.html names the current assets, so it is revalidated every time
+		c.Header("Cache-Control", "no-cache")
+		c.Header("ETag", etag)
+		if c.GetHeader("If-None-Match") == etag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+		c.Data(http.StatusOK, "text/html; charset=utf-8", index)
+	}
+}
+
+// Redirect sends visitors of / to the app
+func Redirect() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Redirect(http.StatusFound, Prefix+"/")
+	}
+}
@@ -0,0 +1,118 @@
+// Package webtypes writes the TypeScript interfaces the web app types API
+// responses with, from the entities' Ent schemas, so the app's idea of an
+// entity can't drift from what the API serves. The generated file is
+// checked in; `streamify gen-types --check` fails when it is out of date.
+package webtypes
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Entity is an entity to write an interface for
+type Entity struct {
+	Name   string
+	Fields []ent.Field
+	Edges  []ent.Edge
+	// PublicID is set on shareable entities, whose JSON carries their public
+	// ID too, see ids.Public
+	PublicID bool
+}
+
+const header = `// Code generated by "streamify gen-types"; DO NOT EDIT.
+// The API's entities as it serves them, from its Ent schemas. Every field but
+// the ID is left out of responses when it is empty.
+`
+
+// Generate returns the TypeScript module declaring an interface for each
+// entity. Edges to entities that aren't among them are left out.
+func Generate(entities []Entity) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	names := make([]string, len(entities))
+	for i, e := range entities {
+		names[i] = e.Name
+	}
+	for _, e := range entities {
+		fmt.Fprintf(&b, "\nexport interface %s {\n", e.Name)
+		for _, f := range e.Fields {
+			d := f.Descriptor()
+			// Sensitive fields and fields tagged json:"-" are never serialized
+			if d.Sensitive || strings.Contains(d.Tag, `json:"-"`) {
+				continue
+			}
+			if d.Comment != "" {
+				fmt.Fprintf(&b, "  /** %s */\n", strings.ReplaceAll(d.Comment, "*/", "*\\/"))
+			}
+			optional := "?"
+			if d.Name == "id" {
+				optional = ""
+			}
+			fmt.Fprintf(&b, "  %s%s: %s;\n", d.Name, optional, tsType(d))
+		}
+		if e.PublicID {
+			b.WriteString("  public_id: string;\n")
+		}
+		var edges []string
+		for _, ed := range e.Edges {
+			d := ed.Descriptor()
+			if !slices.Contains(names, d.Type) {
+				continue
+			}
+			// An edge.To(...).From(...) pair is described by its inverse,
+			// which refers to the other
+			for _, d := range []*edge.Descriptor{d.Ref, d} {
+				if d == nil {
+					continue
+				}
+				t := d.Type
+				if !d.Unique {
+					t += "[]"
+				}
+				edges = append(edges, fmt.Sprintf("    %s?: %s;\n", d.Name, t))
+			}
+		}
+		if len(edges) > 0 {
+			b.WriteString("  edges?: {\n")
+			for _, ed := range edges {
+				b.WriteString(ed)
+			}
+			b.WriteString("  };\n")
+		}
+		b.WriteString("}\n")
+	}
+	return b.Bytes()
+}
+
+// tsType returns the TypeScript type of a field's JSON
+func tsType(d *field.Descriptor) string {
+	switch d.Info.Type {
+	case field.TypeUUID, field.TypeString, field.TypeTime:
+		return "string"
+	case field.TypeEnum:
+		values := make([]string, len(d.Enums))
+		for i, e := range d.Enums {
+			values[i] = strconv.Quote(e.V)
+		}
+		return strings.Join(values, " | ")
+	case field.TypeBool:
+		return "boolean"
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64,
+		field.TypeFloat32, field.TypeFloat64:
+		return "number"
+	case field.TypeJSON:
+		// Other JSON fields hold arbitrary documents and stay untyped
+		if d.Info.RType != nil && d.Info.RType.Ident == "[]string" {
+			return "string[]"
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"streamify/webtypes"
+)
+
+// TestWebTypesUpToDate fails when a schema the web app reads changed without
+// its entity types being regenerated
+func TestWebTypesUpToDate(t *testing.T) {
+	current, err := os.ReadFile(webTypesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(current, webtypes.Generate(webEntities)) {
+		t.Fatalf("%s is out of date with the Ent schemas; run go run . gen-types", webTypesPath)
+	}
+}
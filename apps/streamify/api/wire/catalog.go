@@ -0,0 +1,313 @@
+package wire
+
+import (
+	"streamify/ent"
+	"streamify/ids"
+	"streamify/paging"
+)
+
+// Append encodes the catalog types served by the hot list routes. It reports
+// false for any other type so the caller can fall back to encoding/json.
+func Append(dst []byte, v any) ([]byte, bool) {
+	switch v := v.(type) {
+	case []*ent.Artist:
+		return appendArtists(dst, v), true
+	case *ent.Artist:
+		return appendArtist(dst, v), true
+	case []*ent.Album:
+		return appendAlbums(dst, v), true
+	case *ent.Album:
+		return appendAlbum(dst, v), true
+	case []*ent.Track:
+		return appendTracks(dst, v), true
+	case *ent.Track:
+		return appendTrack(dst, v), true
+	case []*ent.Play:
+		return appendPlays(dst, v), true
+	case *ent.Play:
+		return appendPlay(dst, v), true
+	case *paging.Page[*ent.Artist]:
+		return appendPage(dst, v, appendArtists), true
+	case *paging.Page[*ent.Album]:
+		return appendPage(dst, v, appendAlbums), true
+	case *paging.Page[*ent.Track]:
+		return appendPage(dst, v, appendTracks), true
+	case *AlbumTracks:
+		return appendAlbumTracks(dst, v), true
+	}
+	return dst, false
+}
+
+func appendArtists(dst []byte, artists []*ent.Artist) []byte {
+	if artists == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, '[')
+	for i, a := range artists {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendArtist(dst, a)
+	}
+	return append(dst, ']')
+}
+
+func appendArtist(dst []byte, a *ent.Artist) []byte {
+	if a == nil {
+		return append(dst, "null"...)
+	}
+	first := true
+	dst = append(dst, '{')
+	dst = appendKey(dst, &first, "id")
+	dst = appendUUID(dst, a.ID)
+	if a.Name != "" {
+		dst = appendKey(dst, &first, "name")
+		dst = appendString(dst, a.Name)
+	}
+	if a.ImageURL != "" {
+		dst = appendKey(dst, &first, "image_url")
+		dst = appendString(dst, a.ImageURL)
+	}
+	if len(a.Palette) > 0 {
+		dst = appendKey(dst, &first, "palette")
+		dst = appendStrings(dst, a.Palette)
+	}
+	dst = appendKey(dst, &first, "created_at")
+	dst = appendTime(dst, a.CreatedAt)
+	if a.DeletedAt != nil {
+		dst = appendKey(dst, &first, "deleted_at")
+		dst = appendTime(dst, *a.DeletedAt)
+	}
+	dst = appendKey(dst, &first, "edges")
+	dst = append(dst, '{')
+	if len(a.Edges.Albums) > 0 {
+		dst = append(dst, `"albums":`...)
+		dst = appendAlbums(dst, a.Edges.Albums)
+	}
+	dst = append(dst, `},"public_id":`...)
+	dst = appendString(dst, ids.Public(a.ID))
+	return append(dst, '}')
+}
+
+func appendAlbums(dst []byte, albums []*ent.Album) []byte {
+	if albums == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, '[')
+	for i, a := range albums {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendAlbum(dst, a)
+	}
+	return append(dst, ']')
+}
+
+func appendAlbum(dst []byte, a *ent.Album) []byte {
+	if a == nil {
+		return append(dst, "null"...)
+	}
+	first := true
+	dst = append(dst, '{')
+	dst = appendKey(dst, &first, "id")
+	dst = appendUUID(dst, a.ID)
+	if a.Title != "" {
+		dst = appendKey(dst, &first, "title")
+		dst = appendString(dst, a.Title)
+	}
+	dst = appendKey(dst, &first, "artist_id")
+	dst = appendUUID(dst, a.ArtistID)
+	if a.ImageURL != "" {
+		dst = appendKey(dst, &first, "image_url")
+		dst = appendString(dst, a.ImageURL)
+	}
+	if len(a.Palette) > 0 {
+		dst = appendKey(dst, &first, "palette")
+		dst = appendStrings(dst, a.Palette)
+	}
+	if a.Label != "" {
+		dst = appendKey(dst, &first, "label")
+		dst = appendString(dst, a.Label)
+	}
+	if a.AlbumType != "" {
+		dst = appendKey(dst, &first, "album_type")
+		dst = appendString(dst, string(a.AlbumType))
+	}
+	if a.ReleaseDate != nil {
+		dst = appendKey(dst, &first, "release_date")
+		dst = appendTime(dst, *a.ReleaseDate)
+	}
+	if a.TrackCount != 0 {
+		dst = appendKey(dst, &first, "track_count")
+		dst = appendInt(dst, a.TrackCount)
+	}
+	if a.TotalDurationMs != 0 {
+		dst = appendKey(dst, &first, "total_duration_ms")
+		dst = appendInt64(dst, a.TotalDurationMs)
+	}
+	dst = appendKey(dst, &first, "created_at")
+	dst = appendTime(dst, a.CreatedAt)
+	if a.DeletedAt != nil {
+		dst = appendKey(dst, &first, "deleted_at")
+		dst = appendTime(dst, *a.DeletedAt)
+	}
+	dst = appendKey(dst, &first, "edges")
+	first = true
+	dst = append(dst, '{')
+	if a.Edges.Artist != nil {
+		dst = appendKey(dst, &first, "artist")
+		dst = appendArtist(dst, a.Edges.Artist)
+	}
+	if len(a.Edges.Tracks) > 0 {
+		dst = appendKey(dst, &first, "tracks")
+		dst = appendTracks(dst, a.Edges.Tracks)
+	}
+	dst = append(dst, `},"public_id":`...)
+	dst = appendString(dst, ids.Public(a.ID))
+	return append(dst, '}')
+}
+
+func appendTracks(dst []byte, tracks []*ent.Track) []byte {
+	if tracks == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, '[')
+	for i, t := range tracks {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendTrack(dst, t)
+	}
+	return append(dst, ']')
+}
+
+func appendTrack(dst []byte, t *ent.Track) []byte {
+	if t == nil {
+		return append(dst, "null"...)
+	}
+	first := true
+	dst = append(dst, '{')
+	dst = appendKey(dst, &first, "id")
+	dst = appendUUID(dst, t.ID)
+	if t.Title != "" {
+		dst = appendKey(dst, &first, "title")
+		dst = appendString(dst, t.Title)
+	}
+	dst = appendKey(dst, &first, "album_id")
+	dst = appendUUID(dst, t.AlbumID)
+	if t.TrackNumber != 0 {
+		dst = appendKey(dst, &first, "track_number")
+		dst = appendInt(dst, t.TrackNumber)
+	}
+	if t.DiscNumber != 0 {
+		dst = appendKey(dst, &first, "disc_number")
+		dst = appendInt(dst, t.DiscNumber)
+	}
+	if t.URL != "" {
+		dst = appendKey(dst, &first, "url")
+		dst = appendString(dst, t.URL)
+	}
+	if t.AudioKey != "" {
+		dst = appendKey(dst, &first, "audio_key")
+		dst = appendString(dst, t.AudioKey)
+	}
+	if t.DurationMs != nil {
+		dst = appendKey(dst, &first, "duration_ms")
+		dst = appendInt64(dst, *t.DurationMs)
+	}
+	if t.CanonicalTrackID != nil {
+		dst = appendKey(dst, &first, "canonical_track_id")
+		dst = appendUUID(dst, *t.CanonicalTrackID)
+	}
+	if t.VersionType != "" {
+		dst = appendKey(dst, &first, "version_type")
+		dst = appendString(dst, string(t.VersionType))
+	}
+	dst = appendKey(dst, &first, "created_at")
+	dst = appendTime(dst, t.CreatedAt)
+	if t.DeletedAt != nil {
+		dst = appendKey(dst, &first, "deleted_at")
+		dst = appendTime(dst, *t.DeletedAt)
+	}
+	dst = appendKey(dst, &first, "edges")
+	first = true
+	dst = append(dst, '{')
+	if t.Edges.Album != nil {
+		dst = appendKey(dst, &first, "album")
+		dst = appendAlbum(dst, t.Edges.Album)
+	}
+	if len(t.Edges.Plays) > 0 {
+		dst = appendKey(dst, &first, "plays")
+		dst = appendPlays(dst, t.Edges.Plays)
+	}
+	// Playlists are never eager-loaded on the hot routes
+	if len(t.Edges.Playlists) > 0 {
+		dst = appendKey(dst, &first, "playlists")
+		dst = appendStd(dst, t.Edges.Playlists)
+	}
+	if t.Edges.Canonical != nil {
+		dst = appendKey(dst, &first, "canonical")
+		dst = appendTrack(dst, t.Edges.Canonical)
+	}
+	if len(t.Edges.Versions) > 0 {
+		dst = appendKey(dst, &first, "versions")
+		dst = appendTracks(dst, t.Edges.Versions)
+	}
+	dst = append(dst, `},"public_id":`...)
+	dst = appendString(dst, ids.Public(t.ID))
+	return append(dst, '}')
+}
+
+func appendPlays(dst []byte, plays []*ent.Play) []byte {
+	if plays == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, '[')
+	for i, p := range plays {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendPlay(dst, p)
+	}
+	return append(dst, ']')
+}
+
+func appendPlay(dst []byte, p *ent.Play) []byte {
+	if p == nil {
+		return append(dst, "null"...)
+	}
+	first := true
+	dst = append(dst, '{')
+	dst = appendKey(dst, &first, "id")
+	dst = appendUUID(dst, p.ID)
+	dst = appendKey(dst, &first, "user_id")
+	dst = appendUUID(dst, p.UserID)
+	dst = appendKey(dst, &first, "track_id")
+	dst = appendUUID(dst, p.TrackID)
+	if p.Territory != "" {
+		dst = appendKey(dst, &first, "territory")
+		dst = appendString(dst, p.Territory)
+	}
+	dst = appendKey(dst, &first, "played_at")
+	dst = appendTime(dst, p.PlayedAt)
+	if p.ProgressMs != 0 {
+		dst = appendKey(dst, &first, "progress_ms")
+		dst = appendInt(dst, p.ProgressMs)
+	}
+	if p.ProgressAt != nil {
+		dst = appendKey(dst, &first, "progress_at")
+		dst = appendTime(dst, *p.ProgressAt)
+	}
+	dst = appendKey(dst, &first, "edges")
+	first = true
+	dst = append(dst, '{')
+	if p.Edges.User != nil {
+		dst = appendKey(dst, &first, "user")
+		dst = appendStd(dst, p.Edges.User)
+	}
+	if p.Edges.Track != nil {
+		dst = appendKey(dst, &first, "track")
+		dst = appendTrack(dst, p.Edges.Track)
+	}
+	return append(dst, '}', '}')
+}
@@ -0,0 +1,168 @@
+// Package wire serializes the hottest catalog responses without going through
+// reflection. The encoders append into pooled buffers and produce exactly the
+// bytes encoding/json would, so clients cannot tell which path served them.
+package wire
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UseStdlib routes every response through encoding/json instead of the
+// hand-rolled encoders; set it at startup to rule the fast path out when
+// debugging a serialization difference
+var UseStdlib bool
+
+const contentType = "application/json; charset=utf-8"
+
+var bufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 16<<10)
+		return &b
+	},
+}
+
+// maxPooled keeps one oversized response from pinning a huge buffer in the pool
+const maxPooled = 1 << 20
+
+// JSON writes v with the given status, using a hand-rolled encoder when one
+// exists for v's type and encoding/json otherwise
+func JSON(c *gin.Context, status int, v any) {
+	if UseStdlib {
+		c.JSON(status, v)
+		return
+	}
+	bp := bufPool.Get().(*[]byte)
+	buf, ok := Append((*bp)[:0], v)
+	if !ok {
+		bufPool.Put(bp)
+		c.JSON(status, v)
+		return
+	}
+	c.Data(status, contentType, buf)
+	if cap(buf) <= maxPooled {
+		*bp = buf
+		bufPool.Put(bp)
+	}
+}
+
+// appendStd falls back to encoding/json for values the encoders do not cover,
+// such as edges the hot routes never load
+func appendStd(dst []byte, v any) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		// Mirror gin, which would fail the whole response; the ent types
+		// marshalled here cannot produce an error in practice
+		return append(dst, "null"...)
+	}
+	return append(dst, b...)
+}
+
+func appendUUID(dst []byte, id uuid.UUID) []byte {
+	const hex = "0123456789abcdef"
+	dst = append(dst, '"')
+	for i, b := range id {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			dst = append(dst, '-')
+		}
+		dst = append(dst, hex[b>>4], hex[b&0x0f])
+	}
+	return append(dst, '"')
+}
+
+func appendTime(dst []byte, t time.Time) []byte {
+	dst = append(dst, '"')
+	dst = t.AppendFormat(dst, time.RFC3339Nano)
+	return append(dst, '"')
+}
+
+func appendInt(dst []byte, n int) []byte {
+	return strconv.AppendInt(dst, int64(n), 10)
+}
+
+func appendInt64(dst []byte, n int64) []byte {
+	return strconv.AppendInt(dst, n, 10)
+}
+
+// appendKey writes a separator when needed followed by "key":
+func appendKey(dst []byte, first *bool, key string) []byte {
+	if !*first {
+		dst = append(dst, ',')
+	}
+	*first = false
+	dst = append(dst, '"')
+	dst = append(dst, key...)
+	return append(dst, '"', ':')
+}
+
+// appendString quotes s the way encoding/json does with HTML escaping on,
+// which is what gin's c.JSON uses
+func appendString(dst []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// appendStrings encodes ss as a JSON array of strings
+func appendStrings(dst []byte, ss []string) []byte {
+	dst = append(dst, '[')
+	for i, s := range ss {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = appendString(dst, s)
+	}
+	return append(dst, ']')
+}
@@ -0,0 +1,50 @@
+package wire
+
+import (
+	"streamify/ent"
+	"streamify/paging"
+)
+
+// appendPage encodes a page of a list, its items with items
+func appendPage[T any](dst []byte, p *paging.Page[T], items func([]byte, []T) []byte) []byte {
+	if p == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, `{"items":`...)
+	dst = items(dst, p.Items)
+	dst = append(dst, `,"total":`...)
+	dst = appendInt(dst, p.Total)
+	dst = append(dst, `,"limit":`...)
+	dst = appendInt(dst, p.Limit)
+	dst = append(dst, `,"offset":`...)
+	dst = appendInt(dst, p.Offset)
+	dst = append(dst, `,"next":`...)
+	dst = appendOptString(dst, p.Next)
+	dst = append(dst, `,"prev":`...)
+	dst = appendOptString(dst, p.Prev)
+	return append(dst, '}')
+}
+
+func appendOptString(dst []byte, s *string) []byte {
+	if s == nil {
+		return append(dst, "null"...)
+	}
+	return appendString(dst, *s)
+}
+
+// AlbumTracks is an album with a page of its tracks
+type AlbumTracks struct {
+	Album  *ent.Album               `json:"album"`
+	Tracks *paging.Page[*ent.Track] `json:"tracks"`
+}
+
+func appendAlbumTracks(dst []byte, v *AlbumTracks) []byte {
+	if v == nil {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, `{"album":`...)
+	dst = appendAlbum(dst, v.Album)
+	dst = append(dst, `,"tracks":`...)
+	dst = appendPage(dst, v.Tracks, appendTracks)
+	return append(dst, '}')
+}
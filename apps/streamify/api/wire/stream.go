@@ -0,0 +1,113 @@
+package wire
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// flushThreshold is how much encoded output is buffered before it is written
+// to the client, so a stream costs one buffer no matter how many rows it has
+const flushThreshold = 32 << 10
+
+// Pager fetches the page of rows following last, which is the zero value for
+// the first page. An empty page ends the stream.
+type Pager[T any] func(ctx context.Context, last T) ([]T, error)
+
+// StreamArray writes a JSON array of every row next yields, encoding and
+// flushing each page before fetching the following one. Only one page is held
+// in memory at a time, which keeps exports of million-row tables flat.
+//
+// The status line is sent before the first page is fetched, so a failure part
+// way through cannot be reported as an error status. The array is left
+// unterminated instead, which makes every JSON parser reject the body rather
+// than accept a silently truncated export.
+func StreamArray[T any](c *gin.Context, next Pager[T]) {
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+
+	count, err := WriteArray(c.Request.Context(), c.Writer, next)
+	if err != nil && !errors.Is(err, errWrite) {
+		log.Printf("wire: stream %s aborted after %d rows: %v", c.FullPath(), count, err)
+	}
+}
+
+// errWrite wraps failures writing to the destination, as opposed to fetching rows
+var errWrite = errors.New("write failed")
+
+// WriteArray writes a JSON array of every row next yields to w, one page at a
+// time, and returns how many rows were written. w is flushed after each page
+// when it has a Flush method. On error the array is left unterminated.
+func WriteArray[T any](ctx context.Context, w io.Writer, next Pager[T]) (int, error) {
+	bp := bufPool.Get().(*[]byte)
+	defer bufPool.Put(bp)
+	buf := append((*bp)[:0], '[')
+	flusher, _ := w.(interface{ Flush() })
+
+	var (
+		last  T
+		count int
+	)
+	for {
+		rows, err := next(ctx, last)
+		if err != nil {
+			w.Write(buf)
+			return count, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, row := range rows {
+			if count > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendElem(buf, row)
+			count++
+			if len(buf) >= flushThreshold {
+				if _, err := w.Write(buf); err != nil {
+					return count, fmt.Errorf("%w: %w", errWrite, err)
+				}
+				buf = buf[:0]
+			}
+		}
+		if len(buf) > 0 {
+			if _, err := w.Write(buf); err != nil {
+				return count, fmt.Errorf("%w: %w", errWrite, err)
+			}
+			buf = buf[:0]
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		last = rows[len(rows)-1]
+	}
+	buf = append(buf, ']')
+	_, err := w.Write(buf)
+	if cap(buf) <= maxPooled {
+		*bp = buf
+	}
+	if err != nil {
+		return count, fmt.Errorf("%w: %w", errWrite, err)
+	}
+	return count, nil
+}
+
+// appendElem encodes one streamed row, honouring UseStdlib
+func appendElem(dst []byte, v any) []byte {
+	if !UseStdlib {
+		if out, ok := Append(dst, v); ok {
+			return out
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return append(dst, "null"...)
+	}
+	return append(dst, b...)
+}
@@ -0,0 +1,219 @@
+package wire
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"streamify/ent"
+	"streamify/ent/album"
+	"streamify/ent/track"
+	"streamify/paging"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// catalogFixture mirrors the shape of GET /api/v1/artists: artists with their
+// albums eager-loaded, and tracks hanging off the first album
+func catalogFixture(artists, albums, tracks int) []*ent.Artist {
+	created := time.Date(2024, 3, 1, 12, 30, 15, 123456789, time.UTC)
+	deleted := created.Add(time.Hour)
+	out := make([]*ent.Artist, 0, artists)
+	for i := range artists {
+		a := &ent.Artist{
+			ID:        uuid.New(),
+			Name:      fmt.Sprintf("Artist %d <&> \"quoted\" é  ", i),
+			CreatedAt: created,
+		}
+		if i%2 == 0 {
+			a.ImageURL = "https://cdn.example.com/a.jpg?w=300&h=300"
+			a.Palette = []string{"#1a2b3c", "#ffffff"}
+		}
+		for j := range albums {
+			al := &ent.Album{
+				ID:        uuid.New(),
+				Title:     fmt.Sprintf("Album\t%d\n\x01", j),
+				ArtistID:  a.ID,
+				Label:     "Label\\Name",
+				AlbumType: album.AlbumTypeAlbum,
+				CreatedAt: created.In(time.FixedZone("", -5*3600)),
+			}
+			if j == 1 {
+				al.AlbumType = album.AlbumTypeEp
+				al.ImageURL = "/img/0b7c1a52-5d0e-4f4e-9a57-d0c0ffee0001"
+				al.Palette = []string{"#000000"}
+				al.ReleaseDate = &created
+			}
+			if j == 0 {
+				al.DeletedAt = &deleted
+				al.TrackCount = tracks
+				for k := range tracks {
+					t := &ent.Track{
+						ID:          uuid.New(),
+						Title:       "Track \xff invalid",
+						AlbumID:     al.ID,
+						TrackNumber: k,
+						DiscNumber:  1,
+						VersionType: track.VersionTypeOriginal,
+						URL:         "https://cdn.example.com/t.mp3",
+						CreatedAt:   created,
+					}
+					if k%2 == 1 {
+						ms := int64(k) * 61_500
+						t.DurationMs = &ms
+						al.TotalDurationMs += ms
+					}
+					al.Edges.Tracks = append(al.Edges.Tracks, t)
+				}
+			}
+			a.Edges.Albums = append(a.Edges.Albums, al)
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+func TestAppendMatchesEncodingJSON(t *testing.T) {
+	artists := catalogFixture(4, 3, 5)
+	withArtist := *artists[0].Edges.Albums[0]
+	withArtist.Edges.Artist = artists[1]
+	withPlays := *artists[0].Edges.Albums[0].Edges.Tracks[0]
+	progressAt := time.Date(2024, 3, 2, 8, 15, 0, 0, time.UTC)
+	withPlays.Edges.Plays = []*ent.Play{{ID: uuid.New(), Territory: "US"}, {ID: uuid.New(), ProgressMs: 93000, ProgressAt: &progressAt}}
+	withVersions := *artists[0].Edges.Albums[0].Edges.Tracks[1]
+	remaster := *artists[0].Edges.Albums[0].Edges.Tracks[2]
+	remaster.CanonicalTrackID = &withVersions.ID
+	remaster.VersionType = track.VersionTypeRemaster
+	remaster.AudioKey = "audio/tracks/remaster"
+	withVersions.Edges.Versions = []*ent.Track{&remaster}
+
+	next, prev := "/api/v1/albums?limit=3&offset=6", "/api/v1/albums?limit=3&offset=0"
+	cases := map[string]any{
+		"artists":        artists,
+		"empty artists":  []*ent.Artist{},
+		"nil artists":    []*ent.Artist(nil),
+		"artist":         artists[0],
+		"albums":         artists[0].Edges.Albums,
+		"album":          &withArtist,
+		"tracks":         artists[0].Edges.Albums[0].Edges.Tracks,
+		"track":          &withPlays,
+		"track versions": &withVersions,
+		"bare track":     &ent.Track{},
+		"page":           &paging.Page[*ent.Album]{Items: artists[0].Edges.Albums, Total: 7, Limit: 3, Offset: 3, Next: &next, Prev: &prev},
+		"last page":      &paging.Page[*ent.Track]{Items: []*ent.Track{}, Total: 2, Limit: 50},
+		"album tracks":   &AlbumTracks{Album: artists[0].Edges.Albums[0], Tracks: &paging.Page[*ent.Track]{Items: artists[0].Edges.Albums[0].Edges.Tracks, Total: 3, Limit: 50}},
+	}
+	for name, v := range cases {
+		want, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := Append(nil, v)
+		if !ok {
+			t.Fatalf("%s: no encoder", name)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: output differs from encoding/json\n got: %s\nwant: %s", name, got, want)
+		}
+	}
+
+	if _, ok := Append(nil, map[string]string{}); ok {
+		t.Error("unsupported types must report false")
+	}
+}
+
+func BenchmarkEncodeArtists(b *testing.B) {
+	artists := catalogFixture(20, 3, 0)
+	b.Run("encoding/json", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(artists); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("wire", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, 0, 16<<10)
+		for b.Loop() {
+			buf, _ = Append(buf[:0], artists)
+		}
+	})
+}
+
+func BenchmarkEncodeAlbumTracks(b *testing.B) {
+	album := catalogFixture(1, 1, 12)[0].Edges.Albums[0]
+	b.Run("encoding/json", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := json.Marshal(album); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("wire", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, 0, 16<<10)
+		for b.Loop() {
+			buf, _ = Append(buf[:0], album)
+		}
+	})
+}
+
+// pagesOf serves tracks in fixed-size pages keyed by the last row, like the
+// keyset queries behind the export endpoints
+func pagesOf(tracks []*ent.Track, size int, failAfter int) Pager[*ent.Track] {
+	return func(ctx context.Context, last *ent.Track) ([]*ent.Track, error) {
+		start := 0
+		if last != nil {
+			for i, t := range tracks {
+				if t == last {
+					start = i + 1
+				}
+			}
+		}
+		if failAfter >= 0 && start >= failAfter {
+			return nil, errors.New("connection reset")
+		}
+		return tracks[start:min(start+size, len(tracks))], nil
+	}
+}
+
+func streamTracks(pager Pager[*ent.Track]) *httptest.ResponseRecorder {
+	gin.SetMode(gin.ReleaseMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("GET", "/export", nil)
+	StreamArray(c, pager)
+	return w
+}
+
+func TestStreamArray(t *testing.T) {
+	tracks := catalogFixture(1, 1, 2500)[0].Edges.Albums[0].Edges.Tracks
+
+	for _, stdlib := range []bool{false, true} {
+		UseStdlib = stdlib
+		w := streamTracks(pagesOf(tracks, 1000, -1))
+		want, _ := json.Marshal(tracks)
+		if !bytes.Equal(w.Body.Bytes(), want) {
+			t.Errorf("stdlib=%v: streamed array differs from encoding/json", stdlib)
+		}
+	}
+	UseStdlib = false
+
+	if w := streamTracks(pagesOf(nil, 1000, -1)); w.Body.String() != "[]" {
+		t.Errorf("empty stream: got %q, want []", w.Body.String())
+	}
+
+	w := streamTracks(pagesOf(tracks, 1000, 2000))
+	var decoded []json.RawMessage
+	if err := json.Unmarshal(w.Body.Bytes(), &decoded); err == nil {
+		t.Error("a stream that fails part way must not decode as a complete array")
+	}
+}
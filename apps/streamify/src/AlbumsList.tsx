@@ -1,13 +1,7 @@
 import { useState, useEffect } from "react";
 import { useNavigate } from "react-router-dom";
-import { apiFetch } from "@/lib/api";
-
-interface Album {
-  id: string;
-  title: string;
-  artist_id: string;
-  created_at: string;
-}
+import { fetchAll } from "@/lib/api";
+import type { Album } from "@/lib/entities";
 
 function AlbumsList() {
   const navigate = useNavigate();
@@ -21,12 +15,7 @@ function AlbumsList() {
     const fetchAlbums = async () => {
       try {
         setLoading(true);
-        const response = await apiFetch(`/api/v1/artists/${artistId}/albums`);
-        if (!response.ok) {
-          throw new Error(`Failed to fetch albums: ${response.statusText}`);
-        }
-        const data = await response.json();
-        setAlbums(data);
+        setAlbums(await fetchAll<Album>(`/api/v1/artists/${artistId}/albums?limit=100`));
         setError(null);
       } catch (err) {
         setError(err instanceof Error ? err.message : "Failed to fetch albums");
@@ -74,7 +63,7 @@ function AlbumsList() {
             {albums.map(album => (
               <div
                 key={album.id}
-                onClick={() => navigate(`/album/${album.id}`)}
+                onClick={() => navigate(`/album/${album.public_id}`)}
                 className="group bg-card rounded-lg p-4 hover:bg-accent transition-colors duration-200 cursor-pointer"
               >
                 {/* Album Art Placeholder */}
@@ -1,21 +1,10 @@
 import { useState, useEffect } from "react";
 import { useNavigate } from "react-router-dom";
 import { Button } from "@/components/ui/button";
-import { apiFetch } from "@/lib/api";
+import { fetchAll } from "@/lib/api";
+import type { Artist } from "@/lib/entities";
 import { useAuth } from "@/contexts/AuthContext";
 
-interface Artist {
-  id: string;
-  name: string;
-  created_at: string;
-  edges?: {
-    albums?: Array<{
-      id: string;
-      title: string;
-    }>;
-  };
-}
-
 function ArtistsList() {
   const navigate = useNavigate();
   const { user, logout } = useAuth();
@@ -27,12 +16,7 @@ function ArtistsList() {
     const fetchArtists = async () => {
       try {
         setLoading(true);
-        const response = await apiFetch(`/api/v1/artists`);
-        if (!response.ok) {
-          throw new Error(`Failed to fetch artists: ${response.statusText}`);
-        }
-        const data = await response.json();
-        setArtists(data);
+        setArtists(await fetchAll<Artist>(`/api/v1/artists?limit=100`));
         setError(null);
       } catch (err) {
         setError(err instanceof Error ? err.message : "Failed to fetch artists");
@@ -89,7 +73,7 @@ function ArtistsList() {
             {artists.map(artist => (
               <div
                 key={artist.id}
-                onClick={() => navigate(`/artist/${artist.id}`)}
+                onClick={() => navigate(`/artist/${artist.public_id}`)}
                 className="group bg-card rounded-lg p-4 hover:bg-accent transition-colors duration-200 cursor-pointer"
               >
                 {/* Artist Avatar Placeholder */}
@@ -1,28 +1,12 @@
 import { useState, useEffect } from "react";
 import { useParams, useNavigate } from "react-router-dom";
 import { apiFetch } from "@/lib/api";
-
-interface Track {
-  id: string;
-  title: string;
-  album_id: string;
-  created_at: string;
-}
-
-interface Album {
-  id: string;
-  title: string;
-  artist_id: string;
-  created_at: string;
-  edges?: {
-    tracks?: Track[];
-  };
-}
+import type { Album as AlbumData } from "@/lib/entities";
 
 function Album() {
   const { albumId } = useParams<{ albumId: string }>();
   const navigate = useNavigate();
-  const [album, setAlbum] = useState<Album | null>(null);
+  const [album, setAlbum] = useState<AlbumData | null>(null);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
 
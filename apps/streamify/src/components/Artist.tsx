@@ -1,27 +1,12 @@
 import { useState, useEffect } from "react";
 import { useParams, useNavigate } from "react-router-dom";
 import { apiFetch } from "@/lib/api";
-
-interface Album {
-  id: string;
-  title: string;
-  artist_id: string;
-  created_at: string;
-}
-
-interface Artist {
-  id: string;
-  name: string;
-  created_at: string;
-  edges?: {
-    albums?: Album[];
-  };
-}
+import type { Artist as ArtistData } from "@/lib/entities";
 
 function Artist() {
   const { artistId } = useParams<{ artistId: string }>();
   const navigate = useNavigate();
-  const [artist, setArtist] = useState<Artist | null>(null);
+  const [artist, setArtist] = useState<ArtistData | null>(null);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
 
@@ -112,7 +97,7 @@ function Artist() {
             {albums.map(album => (
               <div
                 key={album.id}
-                onClick={() => navigate(`/album/${album.id}`)}
+                onClick={() => navigate(`/album/${album.public_id}`)}
                 className="group bg-card rounded-lg p-4 hover:bg-accent transition-colors duration-200 cursor-pointer"
               >
                 {/* Album Art Placeholder */}
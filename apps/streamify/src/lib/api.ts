@@ -26,3 +26,29 @@ export const apiFetch = async (url: string, options: RequestInit = {}): Promise<
 
   return response;
 };
+
+// Page is one page of a list endpoint; next and prev are null at either end
+export interface Page<T> {
+  items: T[];
+  total: number;
+  limit: number;
+  offset: number;
+  next: string | null;
+  prev: string | null;
+}
+
+// fetchAll follows a list endpoint's next links to the last page and returns every item
+export const fetchAll = async <T>(url: string, options: RequestInit = {}): Promise<T[]> => {
+  const items: T[] = [];
+  let next: string | null = url;
+  while (next) {
+    const response = await apiFetch(next, options);
+    if (!response.ok) {
+      throw new Error(`Failed to fetch ${next}: ${response.statusText}`);
+    }
+    const page: Page<T> = await response.json();
+    items.push(...page.items);
+    next = page.next;
+  }
+  return items;
+};
@@ -3,7 +3,10 @@ import react from "@vitejs/plugin-react";
 import path from "path";
 
 // https://vite.dev/config/
-export default defineConfig({
+// Builds are served by the API under /app; `npm run build:embed` writes one
+// into the API's webapp package for `go build -tags webapp`
+export default defineConfig(({ command }) => ({
+  base: command === "build" ? "/app/" : "/",
   plugins: [react()],
   resolve: {
     alias: {
@@ -18,4 +21,4 @@ export default defineConfig({
       }
     }
   }
-});
+}));